@@ -25,7 +25,9 @@ import (
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/apiserver/pkg/server/dynamiccertificates"
 	"k8s.io/apiserver/pkg/server/options"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/informers"
 	coreinformers "k8s.io/client-go/informers/core/v1"
 	"k8s.io/client-go/tools/cache"
@@ -46,6 +48,7 @@ import (
 	"antrea.io/antrea/pkg/agent/controller/serviceexternalip"
 	"antrea.io/antrea/pkg/agent/controller/traceflow"
 	"antrea.io/antrea/pkg/agent/controller/trafficcontrol"
+	"antrea.io/antrea/pkg/agent/controller/wireguardkey"
 	"antrea.io/antrea/pkg/agent/externalnode"
 	"antrea.io/antrea/pkg/agent/flowexporter"
 	"antrea.io/antrea/pkg/agent/flowexporter/exporter"
@@ -66,6 +69,7 @@ import (
 	"antrea.io/antrea/pkg/agent/stats"
 	support "antrea.io/antrea/pkg/agent/supportbundlecollection"
 	agenttypes "antrea.io/antrea/pkg/agent/types"
+	"antrea.io/antrea/pkg/apis"
 	"antrea.io/antrea/pkg/apis/controlplane"
 	crdinformers "antrea.io/antrea/pkg/client/informers/externalversions"
 	crdv1alpha1informers "antrea.io/antrea/pkg/client/informers/externalversions/crd/v1alpha1"
@@ -169,6 +173,7 @@ func run(o *Options) error {
 		o.enableAntreaProxy,
 		features.DefaultFeatureGate.Enabled(features.AntreaPolicy),
 		l7NetworkPolicyEnabled,
+		features.DefaultFeatureGate.Enabled(features.AntreaPolicyAuditMode),
 		o.enableEgress,
 		features.DefaultFeatureGate.Enabled(features.EgressTrafficShaping),
 		enableFlowExporter,
@@ -202,6 +207,7 @@ func run(o *Options) error {
 		encryptionMode = config.TrafficEncryptionModeIPSec
 	}
 	_, ipsecAuthenticationMode := config.GetIPsecAuthenticationModeFromStr(o.config.IPsec.AuthenticationMode)
+	_, ipsecCertificateIssuerType := config.GetIPsecCertificateIssuerTypeFromStr(o.config.IPsec.CertificateIssuer.Type)
 
 	networkConfig := &config.NetworkConfig{
 		TunnelType:            ovsconfig.TunnelType(o.config.TunnelType),
@@ -213,13 +219,31 @@ func run(o *Options) error {
 		TransportIfaceCIDRs:   o.config.TransportInterfaceCIDRs,
 		IPsecConfig: config.IPsecConfig{
 			AuthenticationMode: ipsecAuthenticationMode,
+			CertificateIssuer: config.IPsecCertificateIssuerConfig{
+				Type: ipsecCertificateIssuerType,
+				CertManager: config.IPsecCertManagerIssuerConfig{
+					Namespace:   o.config.IPsec.CertificateIssuer.CertManager.Namespace,
+					IssuerName:  o.config.IPsec.CertificateIssuer.CertManager.IssuerName,
+					IssuerKind:  o.config.IPsec.CertificateIssuer.CertManager.IssuerKind,
+					IssuerGroup: o.config.IPsec.CertificateIssuer.CertManager.IssuerGroup,
+					Duration:    o.ipsecCertManagerDuration,
+				},
+				EST: config.IPsecESTIssuerConfig{
+					ServerURL:    o.config.IPsec.CertificateIssuer.EST.ServerURL,
+					ServerCAFile: o.config.IPsec.CertificateIssuer.EST.ServerCAFile,
+					Username:     o.config.IPsec.CertificateIssuer.EST.Username,
+				},
+			},
 		},
 		EnableMulticlusterGW:       enableMulticlusterGW,
 		MulticlusterEncryptionMode: multiclusterEncryptionMode,
 	}
 
 	wireguardConfig := &config.WireGuardConfig{
-		Port: o.config.WireGuard.Port,
+		Port:                 o.config.WireGuard.Port,
+		KeyRotationInterval:  o.wireGuardKeyRotationInterval,
+		KeyRotationOverlap:   o.wireGuardKeyRotationOverlap,
+		VerifyPeerPublicKeys: o.config.WireGuard.VerifyPeerPublicKeys,
 	}
 	exceptCIDRs := []net.IPNet{}
 	for _, cidr := range o.config.Egress.ExceptCIDRs {
@@ -313,7 +337,49 @@ func run(o *Options) error {
 
 	if networkConfig.TrafficEncryptionMode == config.TrafficEncryptionModeIPSec &&
 		networkConfig.IPsecConfig.AuthenticationMode == config.IPsecAuthenticationModeCert {
-		ipsecCertController = ipseccertificate.NewIPSecCertificateController(k8sClient, ovsBridgeClient, nodeConfig.Name)
+		issuerConfig := &networkConfig.IPsecConfig.CertificateIssuer
+		var dynamicClient dynamic.Interface
+		if issuerConfig.Type == config.IPsecCertificateIssuerCertManager {
+			kubeConfig, err := k8s.CreateRestConfig(o.config.ClientConnection, o.config.KubeAPIServerOverride)
+			if err != nil {
+				return fmt.Errorf("error creating Kubernetes client config: %v", err)
+			}
+			dynamicClient, err = dynamic.NewForConfig(kubeConfig)
+			if err != nil {
+				return fmt.Errorf("error creating dynamic client: %v", err)
+			}
+		}
+		ipsecCertController, err = ipseccertificate.NewIPSecCertificateController(k8sClient, dynamicClient, ovsBridgeClient, nodeConfig.Name, issuerConfig)
+		if err != nil {
+			return fmt.Errorf("error creating IPsec certificate controller: %v", err)
+		}
+		if *o.config.EnablePrometheusMetrics {
+			metrics.InitializeIPsecCertificateMetrics()
+		}
+	}
+
+	var wireGuardKeyController *wireguardkey.Controller
+	var wireGuardCAController *dynamiccertificates.ConfigMapCAController
+	// wireGuardCAProvider must stay a nil interface if the WireGuard public keys of peer Nodes need not be verified.
+	var wireGuardCAProvider dynamiccertificates.CAContentProvider
+	if o.nodeType == config.K8sNode && networkConfig.TrafficEncryptionMode == config.TrafficEncryptionModeWireGuard {
+		if wireguardConfig.VerifyPeerPublicKeys {
+			// The CA certificate may not be published yet, the controller will watch the ConfigMap and
+			// NodeRouteController will retry peer Nodes until their public keys can be verified.
+			wireGuardCAController, err = dynamiccertificates.NewDynamicCAFromConfigMapController(
+				"antrea-wireguard-ca",
+				env.GetAntreaNamespace(),
+				apis.AntreaWireGuardCAName,
+				apis.AntreaWireGuardCAConfigMapKey,
+				k8sClient)
+			if err != nil {
+				return fmt.Errorf("error creating WireGuard CA controller: %v", err)
+			}
+			wireGuardCAProvider = wireGuardCAController
+		}
+		if wireguardConfig.KeyRotationInterval > 0 || wireguardConfig.VerifyPeerPublicKeys {
+			wireGuardKeyController = wireguardkey.NewWireGuardKeyController(k8sClient, agentInitializer.GetWireGuardClient(), nodeConfig.Name, wireguardConfig)
+		}
 	}
 
 	var nodeRouteController *noderoute.Controller
@@ -329,6 +395,7 @@ func run(o *Options) error {
 			nodeConfig,
 			agentInitializer.GetWireGuardClient(),
 			ipsecCertController,
+			wireGuardCAProvider,
 		)
 	}
 
@@ -362,6 +429,7 @@ func run(o *Options) error {
 	var mcDefaultRouteController *mcroute.MCDefaultRouteController
 	var mcStrechedNetworkPolicyController *mcroute.StretchedNetworkPolicyController
 	var mcPodRouteController *mcroute.MCPodRouteController
+	var mcGatewayProber *mcroute.GatewayProber
 	var mcInformerFactory mcinformers.SharedInformerFactory
 	var mcInformerFactoryWithNamespaceOption mcinformers.SharedInformerFactory
 
@@ -386,13 +454,25 @@ func run(o *Options) error {
 			routeClient,
 			o.config.Multicluster,
 		)
-		if networkConfig.TrafficEncapMode != config.TrafficEncapModeEncap {
-			mcPodRouteController = mcroute.NewMCPodRouteController(
-				k8sClient,
-				gwInformer,
-				ofClient,
-				nodeConfig,
-			)
+		// MCPodRouteController installs the flows translating Pod global IPs in all modes,
+		// and L3 forwarding flows to Pods on other Nodes in non-encap modes.
+		mcPodRouteController = mcroute.NewMCPodRouteController(
+			k8sClient,
+			gwInformer,
+			ofClient,
+			nodeConfig,
+			networkConfig.TrafficEncapMode != config.TrafficEncapModeEncap,
+		)
+		mcGatewayProber = mcroute.NewGatewayProber(
+			mcClient,
+			gwInformer,
+			ciImportInformer,
+			nodeConfig,
+			networkConfig.MTUDeduction,
+			o.config.Multicluster.Namespace,
+		)
+		if *o.config.EnablePrometheusMetrics {
+			metrics.InitializeMulticlusterGatewayMetrics()
 		}
 	}
 	if enableMulticlusterNP {
@@ -476,9 +556,12 @@ func run(o *Options) error {
 	if o.nodeType == config.ExternalNode {
 		nodeKey = k8s.NamespacedName(o.config.ExternalNode.ExternalNodeNamespace, nodeKey)
 	}
-	var l7Reconciler *l7engine.Reconciler
+	var l7Engine l7engine.Engine
 	if l7NetworkPolicyEnabled || l7FlowExporterEnabled {
-		l7Reconciler = l7engine.NewReconciler()
+		l7Engine, err = l7engine.NewEngine(o.config.L7NetworkPolicy.Engine)
+		if err != nil {
+			return fmt.Errorf("error creating L7 engine: %v", err)
+		}
 	}
 	networkPolicyController, err := networkpolicy.NewNetworkPolicyController(
 		antreaClientProvider,
@@ -493,6 +576,7 @@ func run(o *Options) error {
 		groupIDUpdates,
 		antreaPolicyEnabled,
 		l7NetworkPolicyEnabled,
+		features.DefaultFeatureGate.Enabled(features.AntreaPolicyAuditMode),
 		nodeNetworkPolicyEnabled,
 		o.enableAntreaProxy,
 		statusManagerEnabled,
@@ -507,7 +591,7 @@ func run(o *Options) error {
 		tunPort,
 		nodeConfig,
 		podNetworkWait,
-		l7Reconciler,
+		l7Engine,
 	)
 	if err != nil {
 		return fmt.Errorf("error creating new NetworkPolicy controller: %v", err)
@@ -519,7 +603,7 @@ func run(o *Options) error {
 			ifaceStore,
 			localPodInformer.Get(),
 			namespaceInformer,
-			l7Reconciler,
+			l7Engine,
 		)
 		go l7FlowExporterController.Run(stopCh)
 	}
@@ -652,7 +736,14 @@ func run(o *Options) error {
 
 	var flowExporter *exporter.FlowExporter
 	if enableFlowExporter {
-		podStore := podstore.NewPodStore(localPodInformer.Get())
+		var podStore podstore.Interface
+		if o.nodeType == config.ExternalNode {
+			// There are no Pods on an ExternalNode, the connections are attributed to the
+			// ExternalEntities of the interfaces instead.
+			podStore = externalnode.NewExternalEntityStore(ifaceStore)
+		} else {
+			podStore = podstore.NewPodStore(localPodInformer.Get())
+		}
 		flowExporterOptions := &flowexporter.FlowExporterOptions{
 			FlowCollectorAddr:      o.flowCollectorAddr,
 			FlowCollectorProto:     o.flowCollectorProto,
@@ -702,6 +793,14 @@ func run(o *Options) error {
 		go ipsecCertController.Run(stopCh)
 	}
 
+	if wireGuardCAController != nil {
+		go wireGuardCAController.Run(ctx, 1)
+	}
+
+	if wireGuardKeyController != nil {
+		go wireGuardKeyController.Run(stopCh)
+	}
+
 	go antreaClientProvider.Run(ctx)
 
 	// Initialize the NPL agent.
@@ -831,9 +930,8 @@ func run(o *Options) error {
 	if enableMulticlusterGW {
 		mcInformerFactoryWithNamespaceOption.Start(stopCh)
 		go mcDefaultRouteController.Run(stopCh)
-		if mcPodRouteController != nil {
-			go mcPodRouteController.Run(stopCh)
-		}
+		go mcPodRouteController.Run(stopCh)
+		go mcGatewayProber.Run(stopCh)
 	}
 
 	if enableMulticlusterNP {
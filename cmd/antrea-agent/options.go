@@ -17,6 +17,7 @@ package main
 import (
 	"fmt"
 	"net"
+	"net/url"
 	"os"
 	"strings"
 	"time"
@@ -30,6 +31,7 @@ import (
 	"k8s.io/utils/pointer"
 
 	"antrea.io/antrea/pkg/agent/config"
+	"antrea.io/antrea/pkg/agent/controller/networkpolicy/l7engine"
 	"antrea.io/antrea/pkg/apis"
 	"antrea.io/antrea/pkg/cni"
 	agentconfig "antrea.io/antrea/pkg/config/agent"
@@ -61,6 +63,12 @@ const (
 	defaultAuditLogsMaxAge         = 28
 	defaultAuditLogsCompressed     = true
 	defaultPacketInRate            = 500
+
+	defaultWireGuardKeyRotationInterval = "0"
+	defaultWireGuardKeyRotationOverlap  = "1m"
+
+	defaultCertManagerIssuerKind  = "Issuer"
+	defaultCertManagerIssuerGroup = "cert-manager.io"
 )
 
 var defaultIGMPQueryVersions = []int{1, 2, 3}
@@ -90,6 +98,11 @@ type Options struct {
 	nplEndPort             int
 	dnsServerOverride      string
 	nodeType               config.NodeType
+	// WireGuard key rotation interval and overlap
+	wireGuardKeyRotationInterval time.Duration
+	wireGuardKeyRotationOverlap  time.Duration
+	// Requested lifetime of IPsec certificates issued by cert-manager
+	ipsecCertManagerDuration time.Duration
 
 	// enableEgress represents whether Egress should run or not, calculated from its feature gate configuration and
 	// whether the traffic mode supports it.
@@ -432,10 +445,25 @@ func (o *Options) setK8sNodeDefaultOptions() {
 	if o.config.WireGuard.Port == 0 {
 		o.config.WireGuard.Port = apis.WireGuardListenPort
 	}
+	if o.config.WireGuard.KeyRotationInterval == "" {
+		o.config.WireGuard.KeyRotationInterval = defaultWireGuardKeyRotationInterval
+	}
+	if o.config.WireGuard.KeyRotationOverlap == "" {
+		o.config.WireGuard.KeyRotationOverlap = defaultWireGuardKeyRotationOverlap
+	}
 
 	if o.config.IPsec.AuthenticationMode == "" {
 		o.config.IPsec.AuthenticationMode = config.IPsecAuthenticationModePSK.String()
 	}
+	if o.config.IPsec.CertificateIssuer.Type == "" {
+		o.config.IPsec.CertificateIssuer.Type = config.IPsecCertificateIssuerKubernetesCSR.String()
+	}
+	if o.config.IPsec.CertificateIssuer.CertManager.IssuerKind == "" {
+		o.config.IPsec.CertificateIssuer.CertManager.IssuerKind = defaultCertManagerIssuerKind
+	}
+	if o.config.IPsec.CertificateIssuer.CertManager.IssuerGroup == "" {
+		o.config.IPsec.CertificateIssuer.CertManager.IssuerGroup = defaultCertManagerIssuerGroup
+	}
 
 	if features.DefaultFeatureGate.Enabled(features.FlowExporter) {
 		if o.config.FlowExporter.FlowCollectorAddr == "" {
@@ -506,6 +534,10 @@ func (o *Options) setK8sNodeDefaultOptions() {
 			o.config.Egress.MaxEgressIPsPerNode = defaultMaxEgressIPsPerNode
 		}
 	}
+
+	if o.config.L7NetworkPolicy.Engine == "" {
+		o.config.L7NetworkPolicy.Engine = l7engine.EngineSuricata
+	}
 }
 
 func (o *Options) validateEgressConfig(encapMode config.TrafficEncapModeType) error {
@@ -549,6 +581,11 @@ func (o *Options) validateK8sNodeOptions() error {
 	if ipsecAuthMode == config.IPsecAuthenticationModeCert && !features.DefaultFeatureGate.Enabled(features.IPsecCertAuth) {
 		return fmt.Errorf("IPsec AuthenticationMode %s requires feature gate %s to be enabled", o.config.TrafficEncapMode, features.IPsecCertAuth)
 	}
+	if encryptionMode == config.TrafficEncryptionModeIPSec && ipsecAuthMode == config.IPsecAuthenticationModeCert {
+		if err := o.validateIPsecCertificateIssuerConfig(); err != nil {
+			return fmt.Errorf("failed to validate IPsec certificateIssuer config: %v", err)
+		}
+	}
 
 	// Check if the enabled features are supported on the OS.
 	if err := o.checkUnsupportedFeatures(); err != nil {
@@ -599,6 +636,9 @@ func (o *Options) validateK8sNodeOptions() error {
 	if err := o.validateNodePortLocalConfig(); err != nil {
 		return fmt.Errorf("failed to validate nodePortLocal config: %v", err)
 	}
+	if err := o.validateWireGuardConfig(encryptionMode); err != nil {
+		return fmt.Errorf("failed to validate wireGuard config: %v", err)
+	}
 	if err := o.validateAntreaIPAMConfig(); err != nil {
 		return fmt.Errorf("failed to validate AntreaIPAM config: %v", err)
 	}
@@ -615,6 +655,9 @@ func (o *Options) validateK8sNodeOptions() error {
 	if err := o.validateSecondaryNetworkConfig(); err != nil {
 		return fmt.Errorf("failed to validate secondary network config: %v", err)
 	}
+	if err := o.validateL7NetworkPolicyConfig(); err != nil {
+		return fmt.Errorf("failed to validate L7NetworkPolicy config: %v", err)
+	}
 
 	return nil
 }
@@ -746,6 +789,23 @@ func (o *Options) validateSecondaryNetworkConfig() error {
 	return nil
 }
 
+func (o *Options) validateL7NetworkPolicyConfig() error {
+	if !features.DefaultFeatureGate.Enabled(features.L7NetworkPolicy) {
+		return nil
+	}
+	switch o.config.L7NetworkPolicy.Engine {
+	case l7engine.EngineSuricata:
+	case l7engine.EngineNative:
+		// The L7FlowExporter feature exports the logs of Suricata.
+		if features.DefaultFeatureGate.Enabled(features.L7FlowExporter) {
+			return fmt.Errorf("L7 engine %s doesn't work with feature gate %s", l7engine.EngineNative, features.L7FlowExporter)
+		}
+	default:
+		return fmt.Errorf("L7 engine %s is unknown", o.config.L7NetworkPolicy.Engine)
+	}
+	return nil
+}
+
 func (o *Options) validateNodePortLocalConfig() error {
 	o.enableNodePortLocal = o.config.NodePortLocal.Enable && features.DefaultFeatureGate.Enabled(features.NodePortLocal)
 	if !features.DefaultFeatureGate.Enabled(features.NodePortLocal) {
@@ -761,3 +821,61 @@ func (o *Options) validateNodePortLocalConfig() error {
 	}
 	return nil
 }
+
+func (o *Options) validateWireGuardConfig(encryptionMode config.TrafficEncryptionModeType) error {
+	if encryptionMode != config.TrafficEncryptionModeWireGuard {
+		return nil
+	}
+	var err error
+	o.wireGuardKeyRotationInterval, err = time.ParseDuration(o.config.WireGuard.KeyRotationInterval)
+	if err != nil {
+		return fmt.Errorf("keyRotationInterval is not valid: %v", err)
+	}
+	o.wireGuardKeyRotationOverlap, err = time.ParseDuration(o.config.WireGuard.KeyRotationOverlap)
+	if err != nil {
+		return fmt.Errorf("keyRotationOverlap is not valid: %v", err)
+	}
+	if o.wireGuardKeyRotationInterval < 0 || o.wireGuardKeyRotationOverlap < 0 {
+		return fmt.Errorf("keyRotationInterval and keyRotationOverlap must not be negative")
+	}
+	if o.wireGuardKeyRotationInterval > 0 && o.wireGuardKeyRotationOverlap >= o.wireGuardKeyRotationInterval {
+		return fmt.Errorf("keyRotationOverlap must be less than keyRotationInterval")
+	}
+	return nil
+}
+
+func (o *Options) validateIPsecCertificateIssuerConfig() error {
+	issuerConfig := o.config.IPsec.CertificateIssuer
+	ok, issuerType := config.GetIPsecCertificateIssuerTypeFromStr(issuerConfig.Type)
+	if !ok {
+		return fmt.Errorf("certificate issuer type %s is unknown", issuerConfig.Type)
+	}
+	switch issuerType {
+	case config.IPsecCertificateIssuerCertManager:
+		if issuerConfig.CertManager.IssuerName == "" {
+			return fmt.Errorf("issuerName must be set for cert-manager issuer")
+		}
+		if issuerConfig.CertManager.IssuerKind != "Issuer" && issuerConfig.CertManager.IssuerKind != "ClusterIssuer" {
+			return fmt.Errorf("issuerKind %s is invalid, it must be Issuer or ClusterIssuer", issuerConfig.CertManager.IssuerKind)
+		}
+		if issuerConfig.CertManager.Duration != "" {
+			duration, err := time.ParseDuration(issuerConfig.CertManager.Duration)
+			if err != nil {
+				return fmt.Errorf("duration is not valid: %v", err)
+			}
+			if duration <= 0 {
+				return fmt.Errorf("duration must be positive")
+			}
+			o.ipsecCertManagerDuration = duration
+		}
+	case config.IPsecCertificateIssuerEST:
+		serverURL, err := url.Parse(issuerConfig.EST.ServerURL)
+		if err != nil {
+			return fmt.Errorf("serverURL is not valid: %v", err)
+		}
+		if serverURL.Scheme != "https" || serverURL.Host == "" {
+			return fmt.Errorf("serverURL %s is invalid, it must be an https URL", issuerConfig.EST.ServerURL)
+		}
+	}
+	return nil
+}
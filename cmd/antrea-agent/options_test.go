@@ -17,6 +17,7 @@ package main
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -276,6 +277,72 @@ func TestOptionsValidateMulticastConfig(t *testing.T) {
 	}
 }
 
+func TestOptionsValidateWireGuardConfig(t *testing.T) {
+	tests := []struct {
+		name                        string
+		encryptionMode              config.TrafficEncryptionModeType
+		keyRotationInterval         string
+		keyRotationOverlap          string
+		expectedErr                 string
+		expectedKeyRotationInterval time.Duration
+		expectedKeyRotationOverlap  time.Duration
+	}{
+		{
+			name:                "not WireGuard",
+			encryptionMode:      config.TrafficEncryptionModeNone,
+			keyRotationInterval: "invalid",
+			keyRotationOverlap:  "1m",
+		},
+		{
+			name:                       "rotation disabled",
+			encryptionMode:             config.TrafficEncryptionModeWireGuard,
+			keyRotationInterval:        "0",
+			keyRotationOverlap:         "1m",
+			expectedKeyRotationOverlap: time.Minute,
+		},
+		{
+			name:                        "rotation enabled",
+			encryptionMode:              config.TrafficEncryptionModeWireGuard,
+			keyRotationInterval:         "24h",
+			keyRotationOverlap:          "2m",
+			expectedKeyRotationInterval: 24 * time.Hour,
+			expectedKeyRotationOverlap:  2 * time.Minute,
+		},
+		{
+			name:                "invalid interval",
+			encryptionMode:      config.TrafficEncryptionModeWireGuard,
+			keyRotationInterval: "1d",
+			keyRotationOverlap:  "1m",
+			expectedErr:         "keyRotationInterval is not valid",
+		},
+		{
+			name:                "overlap longer than interval",
+			encryptionMode:      config.TrafficEncryptionModeWireGuard,
+			keyRotationInterval: "1m",
+			keyRotationOverlap:  "2m",
+			expectedErr:         "keyRotationOverlap must be less than keyRotationInterval",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &Options{config: &agentconfig.AgentConfig{
+				WireGuard: agentconfig.WireGuardConfig{
+					KeyRotationInterval: tt.keyRotationInterval,
+					KeyRotationOverlap:  tt.keyRotationOverlap,
+				},
+			}}
+			err := o.validateWireGuardConfig(tt.encryptionMode)
+			if tt.expectedErr != "" {
+				assert.ErrorContains(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedKeyRotationInterval, o.wireGuardKeyRotationInterval)
+			assert.Equal(t, tt.expectedKeyRotationOverlap, o.wireGuardKeyRotationOverlap)
+		})
+	}
+}
+
 func TestOptionsValidateSecondaryNetworkConfig(t *testing.T) {
 	tests := []struct {
 		name               string
@@ -343,3 +410,140 @@ func TestOptionsValidateSecondaryNetworkConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestOptionsValidateL7NetworkPolicyConfig(t *testing.T) {
+	tests := []struct {
+		name                  string
+		l7NetworkPolicyEnable bool
+		l7FlowExporterEnable  bool
+		engine                string
+		expectedErr           string
+	}{
+		{
+			name:   "featureGate off",
+			engine: "foo",
+		},
+		{
+			name:                  "Suricata engine",
+			l7NetworkPolicyEnable: true,
+			l7FlowExporterEnable:  true,
+			engine:                "Suricata",
+		},
+		{
+			name:                  "Native engine",
+			l7NetworkPolicyEnable: true,
+			engine:                "Native",
+		},
+		{
+			name:                  "Native engine with L7FlowExporter",
+			l7NetworkPolicyEnable: true,
+			l7FlowExporterEnable:  true,
+			engine:                "Native",
+			expectedErr:           "L7 engine Native doesn't work with feature gate L7FlowExporter",
+		},
+		{
+			name:                  "unknown engine",
+			l7NetworkPolicyEnable: true,
+			engine:                "foo",
+			expectedErr:           "L7 engine foo is unknown",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			defer featuregatetesting.SetFeatureGateDuringTest(t, features.DefaultFeatureGate, features.L7NetworkPolicy, tc.l7NetworkPolicyEnable)()
+			defer featuregatetesting.SetFeatureGateDuringTest(t, features.DefaultFeatureGate, features.L7FlowExporter, tc.l7FlowExporterEnable)()
+
+			o := &Options{config: &agentconfig.AgentConfig{}}
+			o.config.L7NetworkPolicy.Engine = tc.engine
+
+			err := o.validateL7NetworkPolicyConfig()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestOptionsValidateIPsecCertificateIssuerConfig(t *testing.T) {
+	tests := []struct {
+		name             string
+		issuerConfig     agentconfig.IPsecCertificateIssuerConfig
+		expectedErr      string
+		expectedDuration time.Duration
+	}{
+		{
+			name:         "Kubernetes CSR",
+			issuerConfig: agentconfig.IPsecCertificateIssuerConfig{Type: "kubernetesCSR"},
+		},
+		{
+			name:         "unknown type",
+			issuerConfig: agentconfig.IPsecCertificateIssuerConfig{Type: "acme"},
+			expectedErr:  "certificate issuer type acme is unknown",
+		},
+		{
+			name: "cert-manager",
+			issuerConfig: agentconfig.IPsecCertificateIssuerConfig{
+				Type: "certManager",
+				CertManager: agentconfig.IPsecCertManagerIssuerConfig{
+					IssuerName: "corp-ca",
+					IssuerKind: "ClusterIssuer",
+					Duration:   "720h",
+				},
+			},
+			expectedDuration: 720 * time.Hour,
+		},
+		{
+			name: "cert-manager without issuer name",
+			issuerConfig: agentconfig.IPsecCertificateIssuerConfig{
+				Type:        "certManager",
+				CertManager: agentconfig.IPsecCertManagerIssuerConfig{IssuerKind: "Issuer"},
+			},
+			expectedErr: "issuerName must be set for cert-manager issuer",
+		},
+		{
+			name: "cert-manager with invalid issuer kind",
+			issuerConfig: agentconfig.IPsecCertificateIssuerConfig{
+				Type: "certManager",
+				CertManager: agentconfig.IPsecCertManagerIssuerConfig{
+					IssuerName: "corp-ca",
+					IssuerKind: "Certificate",
+				},
+			},
+			expectedErr: "issuerKind Certificate is invalid",
+		},
+		{
+			name: "EST",
+			issuerConfig: agentconfig.IPsecCertificateIssuerConfig{
+				Type: "est",
+				EST:  agentconfig.IPsecESTIssuerConfig{ServerURL: "https://est.example.com/.well-known/est"},
+			},
+		},
+		{
+			name: "EST with http URL",
+			issuerConfig: agentconfig.IPsecCertificateIssuerConfig{
+				Type: "est",
+				EST:  agentconfig.IPsecESTIssuerConfig{ServerURL: "http://est.example.com/.well-known/est"},
+			},
+			expectedErr: "it must be an https URL",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &Options{config: &agentconfig.AgentConfig{
+				IPsec: agentconfig.IPsecConfig{
+					AuthenticationMode: "cert",
+					CertificateIssuer:  tt.issuerConfig,
+				},
+			}}
+			err := o.validateIPsecCertificateIssuerConfig()
+			if tt.expectedErr != "" {
+				assert.ErrorContains(t, err, tt.expectedErr)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedDuration, o.ipsecCertManagerDuration)
+			}
+		})
+	}
+}
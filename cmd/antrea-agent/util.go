@@ -73,6 +73,10 @@ func parsePortRange(portRangeStr string) (start, end int, err error) {
 		return 0, 0, err
 	}
 
+	if start < 1 || end > 65535 {
+		return 0, 0, fmt.Errorf("port range must be within 1-65535: %s", portRangeStr)
+	}
+
 	if end <= start {
 		return 0, 0, fmt.Errorf("start port must be smaller than end port: %s", portRangeStr)
 	}
@@ -97,6 +97,16 @@ func TestParsePortRange(t *testing.T) {
 			expectedStart: 61000,
 			expectedEnd:   62000,
 		},
+		{
+			name:         "port range's start out of bounds",
+			portRangeStr: "0-6200",
+			expectedErr:  "port range must be within 1-65535: 0-6200",
+		},
+		{
+			name:         "port range's end out of bounds",
+			portRangeStr: "61000-70000",
+			expectedErr:  "port range must be within 1-65535: 61000-70000",
+		},
 		{
 			name:         "port range's end smaller than port range's start",
 			portRangeStr: "6000-5000",
@@ -138,6 +138,7 @@ func run(o *Options) error {
 	podInformer := informerFactory.Core().V1().Pods()
 	namespaceInformer := informerFactory.Core().V1().Namespaces()
 	serviceInformer := informerFactory.Core().V1().Services()
+	endpointSliceInformer := informerFactory.Discovery().V1().EndpointSlices()
 	networkPolicyInformer := informerFactory.Networking().V1().NetworkPolicies()
 	nodeInformer := informerFactory.Core().V1().Nodes()
 	statefulSetInformer := informerFactory.Apps().V1().StatefulSets()
@@ -186,6 +187,7 @@ func run(o *Options) error {
 		labelIdentityIndex,
 		namespaceInformer,
 		serviceInformer,
+		endpointSliceInformer,
 		networkPolicyInformer,
 		nodeInformer,
 		acnpInformer,
@@ -235,10 +237,10 @@ func run(o *Options) error {
 	}
 
 	var csrApprovingController *certificatesigningrequest.CSRApprovingController
-	var csrSigningController *certificatesigningrequest.IPsecCSRSigningController
+	var csrSigningController *certificatesigningrequest.CSRSigningController
 	var csrInformer cache.SharedIndexInformer
 	var csrLister csrlisters.CertificateSigningRequestLister
-	if features.DefaultFeatureGate.Enabled(features.IPsecCertAuth) {
+	if features.DefaultFeatureGate.Enabled(features.IPsecCertAuth) && *o.config.IPsecCSRSignerConfig.Enable {
 		csrInformer = csrinformers.NewFilteredCertificateSigningRequestInformer(client, 0, nil, func(listOptions *metav1.ListOptions) {
 			listOptions.FieldSelector = fields.OneTermEqualSelector("spec.signerName", antreaapis.AntreaIPsecCSRSignerName).String()
 		})
@@ -250,6 +252,21 @@ func run(o *Options) error {
 		csrSigningController = certificatesigningrequest.NewIPsecCSRSigningController(client, csrInformer, csrLister, *o.config.IPsecCSRSignerConfig.SelfSignedCA)
 	}
 
+	var wireGuardCSRApprovingController *certificatesigningrequest.CSRApprovingController
+	var wireGuardCSRSigningController *certificatesigningrequest.CSRSigningController
+	var wireGuardCSRInformer cache.SharedIndexInformer
+	if o.config.WireGuardKeySignerConfig.Enable {
+		wireGuardCSRInformer = csrinformers.NewFilteredCertificateSigningRequestInformer(client, 0, nil, func(listOptions *metav1.ListOptions) {
+			listOptions.FieldSelector = fields.OneTermEqualSelector("spec.signerName", antreaapis.AntreaWireGuardCSRSignerName).String()
+		})
+		wireGuardCSRLister := csrlisters.NewCertificateSigningRequestLister(wireGuardCSRInformer.GetIndexer())
+
+		if *o.config.WireGuardKeySignerConfig.AutoApprove {
+			wireGuardCSRApprovingController = certificatesigningrequest.NewCSRApprovingController(client, wireGuardCSRInformer, wireGuardCSRLister)
+		}
+		wireGuardCSRSigningController = certificatesigningrequest.NewWireGuardCSRSigningController(client, wireGuardCSRInformer, wireGuardCSRLister, *o.config.WireGuardKeySignerConfig.SelfSignedCA)
+	}
+
 	if features.DefaultFeatureGate.Enabled(features.Egress) {
 		egressController = egress.NewEgressController(crdClient, groupEntityIndex, egressInformer, externalIPPoolController, egressGroupStore)
 	}
@@ -426,7 +443,7 @@ func run(o *Options) error {
 		go antreaIPAMController.Run(stopCh)
 	}
 
-	if features.DefaultFeatureGate.Enabled(features.IPsecCertAuth) {
+	if features.DefaultFeatureGate.Enabled(features.IPsecCertAuth) && *o.config.IPsecCSRSignerConfig.Enable {
 		go csrInformer.Run(stopCh)
 		if *o.config.IPsecCSRSignerConfig.AutoApprove {
 			go csrApprovingController.Run(stopCh)
@@ -434,6 +451,14 @@ func run(o *Options) error {
 		go csrSigningController.Run(stopCh)
 	}
 
+	if o.config.WireGuardKeySignerConfig.Enable {
+		go wireGuardCSRInformer.Run(stopCh)
+		if *o.config.WireGuardKeySignerConfig.AutoApprove {
+			go wireGuardCSRApprovingController.Run(stopCh)
+		}
+		go wireGuardCSRSigningController.Run(stopCh)
+	}
+
 	<-stopCh
 	klog.Info("Stopping Antrea controller")
 	return nil
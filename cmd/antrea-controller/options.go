@@ -184,12 +184,21 @@ func (o *Options) setDefaults() {
 	if o.config.NodeIPAM.NodeCIDRMaskSizeIPv6 == 0 {
 		o.config.NodeIPAM.NodeCIDRMaskSizeIPv6 = ipamIPv6MaskDefault
 	}
+	if o.config.IPsecCSRSignerConfig.Enable == nil {
+		o.config.IPsecCSRSignerConfig.Enable = ptrBool(true)
+	}
 	if o.config.IPsecCSRSignerConfig.SelfSignedCA == nil {
 		o.config.IPsecCSRSignerConfig.SelfSignedCA = ptrBool(true)
 	}
 	if o.config.IPsecCSRSignerConfig.AutoApprove == nil {
 		o.config.IPsecCSRSignerConfig.AutoApprove = ptrBool(true)
 	}
+	if o.config.WireGuardKeySignerConfig.SelfSignedCA == nil {
+		o.config.WireGuardKeySignerConfig.SelfSignedCA = ptrBool(true)
+	}
+	if o.config.WireGuardKeySignerConfig.AutoApprove == nil {
+		o.config.WireGuardKeySignerConfig.AutoApprove = ptrBool(true)
+	}
 }
 
 func ptrBool(value bool) *bool {
@@ -31,8 +31,12 @@ func TestNewOptions(t *testing.T) {
 	assert.Equal(t, true, *op.config.SelfSignedCert)
 	assert.Equal(t, ipamIPv4MaskDefault, op.config.NodeIPAM.NodeCIDRMaskSizeIPv4)
 	assert.Equal(t, ipamIPv6MaskDefault, op.config.NodeIPAM.NodeCIDRMaskSizeIPv6)
+	assert.Equal(t, true, *op.config.IPsecCSRSignerConfig.Enable)
 	assert.Equal(t, true, *op.config.IPsecCSRSignerConfig.SelfSignedCA)
 	assert.Equal(t, true, *op.config.IPsecCSRSignerConfig.AutoApprove)
+	assert.Equal(t, false, op.config.WireGuardKeySignerConfig.Enable)
+	assert.Equal(t, true, *op.config.WireGuardKeySignerConfig.SelfSignedCA)
+	assert.Equal(t, true, *op.config.WireGuardKeySignerConfig.AutoApprove)
 }
 
 func TestValidateNodeIPAMControllerOptions(t *testing.T) {
@@ -23,6 +23,8 @@ const (
 	LabelIdentityKind              = "LabelIdentity"
 	ServiceImportKind              = "ServiceImport"
 	ClusterInfoKind                = "ClusterInfo"
+	TraceflowKind                  = "Traceflow"
+	TraceflowResultKind            = "TraceflowResult"
 
 	ResourceExportFinalizer = "resourceexport.finalizers.antrea.io"
 
@@ -23,6 +23,9 @@ import (
 // GatewayInfo includes information of a Gateway.
 type GatewayInfo struct {
 	GatewayIP string `json:"gatewayIP,omitempty"`
+	// IP of the antrea-gw0 interface of the Gateway Node, which answers the probes sent
+	// through the cross-cluster tunnel.
+	ProbeIP string `json:"probeIP,omitempty"`
 }
 
 // WireGuardInfo includes information of a WireGuard tunnel.
@@ -31,6 +34,55 @@ type WireGuardInfo struct {
 	PublicKey string `json:"publicKey,omitempty"`
 }
 
+// GatewayConnectivity is the connectivity to a Gateway of a peer member cluster.
+type GatewayConnectivity string
+
+const (
+	// All probes sent to the peer Gateway were answered.
+	GatewayConnectivityHealthy GatewayConnectivity = "Healthy"
+	// Some probes sent to the peer Gateway were lost.
+	GatewayConnectivityDegraded GatewayConnectivity = "Degraded"
+	// None of the probes sent to the peer Gateway was answered.
+	GatewayConnectivityUnreachable GatewayConnectivity = "Unreachable"
+)
+
+// PeerGatewayStatus is the result of probing a Gateway of a peer member cluster. The tunnel
+// probes are ICMP echo requests sent from the local Gateway Node to the probe IP of the peer
+// Gateway through the cross-cluster tunnel, so they detect a broken tunnel. The underlay
+// probes are sent to the peer Gateway IP over the underlay network, to tell an unreachable
+// peer Gateway from a broken tunnel and to discover the path MTU.
+type PeerGatewayStatus struct {
+	// ClusterID of the peer member cluster.
+	ClusterID string `json:"clusterID,omitempty"`
+	// Cross-cluster tunnel IP of the peer Gateway.
+	GatewayIP string `json:"gatewayIP,omitempty"`
+	// Connectivity through the cross-cluster tunnel. It is empty if the peer Gateway has
+	// no probe IP.
+	TunnelConnectivity GatewayConnectivity `json:"tunnelConnectivity,omitempty"`
+	// Average round-trip time of the answered tunnel probes.
+	TunnelLatency *metav1.Duration `json:"tunnelLatency,omitempty"`
+	// Percentage of the tunnel probes which were not answered.
+	TunnelPacketLoss     int32               `json:"tunnelPacketLoss,omitempty"`
+	UnderlayConnectivity GatewayConnectivity `json:"underlayConnectivity,omitempty"`
+	// Average round-trip time of the answered underlay probes.
+	UnderlayLatency *metav1.Duration `json:"underlayLatency,omitempty"`
+	// Percentage of the underlay probes which were not answered.
+	UnderlayPacketLoss int32 `json:"underlayPacketLoss,omitempty"`
+	// Largest IP packet size which can reach the peer Gateway over the underlay without
+	// fragmentation. It is 0 if the path MTU could not be discovered.
+	UnderlayPathMTU int32 `json:"underlayPathMTU,omitempty"`
+	// Largest IP packet size which can be sent through the tunnel to the peer Gateway, i.e.
+	// the underlay path MTU minus the tunnel overhead. It is 0 if the underlay path MTU
+	// could not be discovered.
+	TunnelMTU     int32       `json:"tunnelMTU,omitempty"`
+	LastProbeTime metav1.Time `json:"lastProbeTime,omitempty"`
+}
+
+// GatewayStatus includes the results of probing the Gateways of peer member clusters.
+type GatewayStatus struct {
+	PeerGateways []PeerGatewayStatus `json:"peerGateways,omitempty"`
+}
+
 // +genclient
 //+kubebuilder:object:root=true
 //+kubebuilder:subresource:status
@@ -47,9 +99,14 @@ type Gateway struct {
 	GatewayIP string `json:"gatewayIP,omitempty"`
 	// In-cluster tunnel IP of the Gateway.
 	InternalIP string `json:"internalIP,omitempty"`
+	// IP of the antrea-gw0 interface of the Gateway Node, which answers the probes sent
+	// through the cross-cluster tunnel.
+	ProbeIP string `json:"probeIP,omitempty"`
 	// Service CIDR of the local member cluster.
 	ServiceCIDR string         `json:"serviceCIDR,omitempty"`
 	WireGuard   *WireGuardInfo `json:"wireGuard,omitempty"`
+
+	Status GatewayStatus `json:"status,omitempty"`
 }
 
 type ClusterInfo struct {
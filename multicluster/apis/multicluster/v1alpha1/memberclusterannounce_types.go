@@ -37,6 +37,13 @@ type MemberClusterAnnounce struct {
 	ClusterSetID string `json:"clusterSetID,omitempty"`
 	// Leader cluster this member has selected.
 	LeaderClusterID string `json:"leaderClusterID,omitempty"`
+	// Name of the ServiceAccount of the bootstrap token the member cluster used to join
+	// the ClusterSet. The leader cluster issues a short-lived credential to the member
+	// cluster, and grants this ServiceAccount access to it.
+	BootstrapServiceAccount string `json:"bootstrapServiceAccount,omitempty"`
+	// Results of probing the Gateways of peer member clusters from the Gateway of the
+	// member cluster.
+	PeerGateways []PeerGatewayStatus `json:"peerGateways,omitempty"`
 }
 
 //+kubebuilder:object:root=true
@@ -53,6 +53,17 @@ type MultiClusterConfig struct {
 	// PodIP type requires Multi-cluster Gateway too when there is no direct Pod-to-Pod
 	// connectivity across member clusters.
 	EndpointIPType string `json:"endpointIPType,omitempty"`
+	// GlobalPodCIDR is a CIDR which is unique in the ClusterSet. When it is set, an IP from
+	// the CIDR is allocated to each Pod backing an exported Service and used as the Endpoint
+	// of the Multi-cluster Service, and the Multi-cluster Gateway translates it to the Pod IP.
+	// It allows member clusters to have overlapping Pod CIDRs, and requires PodIP
+	// EndpointIPType. It is incompatible with EnableStretchedNetworkPolicy, as the source
+	// Pod IPs of cross-cluster connections are translated to the Gateway IP.
+	GlobalPodCIDR string `json:"globalPodCIDR,omitempty"`
+	// ClusterSetDNSBindAddress is the address on which the member cluster controller
+	// answers DNS queries for the ClusterSet-wide names `<svc>.<ns>.svc.clusterset.local`
+	// of imported Services, e.g. ":5353". The DNS server is disabled if it's empty.
+	ClusterSetDNSBindAddress string `json:"clusterSetDNSBindAddress,omitempty"`
 	// Enable StretchedNetworkPolicy which will export and import labelIdentities in the
 	// ClusterSet and allow Antrea-native policies to select peers from other clusters
 	// in a ClusterSet.
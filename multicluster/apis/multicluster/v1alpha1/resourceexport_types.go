@@ -46,6 +46,12 @@ type LabelIdentityExport struct {
 // RawResourceExport exports opaque resources.
 type RawResourceExport struct {
 	Data []byte `json:"data,omitempty"`
+	// ClusterIDs specifies the member clusters to import the resource to.
+	// When not specified, import to all member clusters.
+	ClusterIDs []string `json:"clusterIDs,omitempty"`
+	// ConflictPolicy specifies how to handle an existing resource with the same
+	// name in the importing member clusters.
+	ConflictPolicy ConflictPolicy `json:"conflictPolicy,omitempty"`
 }
 
 // ResourceExportSpec defines the desired state of ResourceExport.
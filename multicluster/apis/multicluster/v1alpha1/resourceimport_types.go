@@ -38,6 +38,9 @@ type ExternalEntityImport struct {
 // RawResourceImport imports opaque resources.
 type RawResourceImport struct {
 	Data []byte `json:"data,omitempty"`
+	// ConflictPolicy specifies how to handle an existing resource with the same
+	// name in the importing member cluster.
+	ConflictPolicy ConflictPolicy `json:"conflictPolicy,omitempty"`
 }
 
 // ResourceImportSpec defines the desired state of ResourceImport.
@@ -0,0 +1,127 @@
+/*
+Copyright 2024 Antrea Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// ConflictPolicy specifies how a propagated resource is handled in a member cluster
+// when a resource of the same kind and name, which is not created by the propagation,
+// already exists in the member cluster.
+type ConflictPolicy string
+
+const (
+	// ConflictPolicySkip leaves the existing resource unchanged and reports a conflict.
+	ConflictPolicySkip ConflictPolicy = "Skip"
+	// ConflictPolicyOverwrite overwrites the existing resource with the propagated one.
+	ConflictPolicyOverwrite ConflictPolicy = "Overwrite"
+)
+
+// ResourcePropagationSpec defines the desired state of ResourcePropagation.
+type ResourcePropagationSpec struct {
+	// Resource is the definition of the resource to propagate, which must include
+	// apiVersion, kind, metadata.name, and metadata.namespace for a Namespaced kind.
+	// Supported kinds are Tier, ClusterGroup, Egress, ExternalIPPool, ConfigMap and Secret.
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +kubebuilder:validation:EmbeddedResource
+	// +kubebuilder:validation:Required
+	Resource runtime.RawExtension `json:"resource"`
+	// ClusterSelector selects member clusters by the labels of their MemberClusterAnnounces
+	// in the leader cluster.
+	ClusterSelector *metav1.LabelSelector `json:"clusterSelector,omitempty"`
+	// ClusterIDs selects member clusters by their ClusterIDs. The resource is propagated
+	// to the union of the member clusters selected by ClusterSelector and ClusterIDs, and
+	// to all member clusters when neither of them is set.
+	ClusterIDs []string `json:"clusterIDs,omitempty"`
+	// ConflictPolicy specifies how to handle a resource with the same name which already
+	// exists in a member cluster. Defaults to Overwrite for Tier and ClusterGroup, and
+	// Skip for other kinds.
+	// +kubebuilder:validation:Enum=Skip;Overwrite
+	ConflictPolicy ConflictPolicy `json:"conflictPolicy,omitempty"`
+}
+
+type ResourcePropagationPhase string
+
+const (
+	// ResourcePropagationPending means the resource has not been propagated to the member
+	// cluster yet.
+	ResourcePropagationPending ResourcePropagationPhase = "Pending"
+	// ResourcePropagationPropagated means the resource has been created or updated in the
+	// member cluster.
+	ResourcePropagationPropagated ResourcePropagationPhase = "Propagated"
+	// ResourcePropagationConflict means the resource conflicts with an existing resource
+	// in the member cluster, and is skipped per the conflict policy.
+	ResourcePropagationConflict ResourcePropagationPhase = "Conflict"
+	// ResourcePropagationFailed means the resource failed to be propagated to the member
+	// cluster.
+	ResourcePropagationFailed ResourcePropagationPhase = "Failed"
+)
+
+// ResourcePropagationClusterStatus indicates the propagation status of the resource in
+// a member cluster.
+type ResourcePropagationClusterStatus struct {
+	// ClusterID is the unique identifier of the member cluster.
+	ClusterID string                   `json:"clusterID,omitempty"`
+	Phase     ResourcePropagationPhase `json:"phase,omitempty"`
+	// +optional
+	// Last time the phase transited from one to another.
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
+	// +optional
+	// A human readable message indicating details about the phase.
+	Message string `json:"message,omitempty"`
+}
+
+// ResourcePropagationStatus defines the observed state of ResourcePropagation.
+type ResourcePropagationStatus struct {
+	// Conditions indicate whether the ResourcePropagation is valid and converged into
+	// a ResourceExport.
+	Conditions []ResourceCondition `json:"conditions,omitempty"`
+	// ClusterStatuses are the propagation statuses of the selected member clusters.
+	ClusterStatuses []ResourcePropagationClusterStatus `json:"clusterStatuses,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+
+// +kubebuilder:printcolumn:name="Kind",type=string,JSONPath=`.spec.resource.kind`,description="Kind of the propagated resource"
+// +kubebuilder:printcolumn:name="Namespace",type=string,JSONPath=`.spec.resource.metadata.namespace`,description="Namespace of the propagated resource"
+// +kubebuilder:printcolumn:name="Name",type=string,JSONPath=`.spec.resource.metadata.name`,description="Name of the propagated resource"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=`.metadata.creationTimestamp`
+// ResourcePropagation propagates a resource from the leader cluster to the selected
+// member clusters in a ClusterSet.
+type ResourcePropagation struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ResourcePropagationSpec   `json:"spec,omitempty"`
+	Status ResourcePropagationStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// ResourcePropagationList contains a list of ResourcePropagation.
+type ResourcePropagationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ResourcePropagation `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ResourcePropagation{}, &ResourcePropagationList{})
+}
@@ -23,6 +23,7 @@ import (
 	"antrea.io/antrea/pkg/apis/crd/v1alpha2"
 	"antrea.io/antrea/pkg/apis/crd/v1beta1"
 	"k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 	apisv1alpha1 "sigs.k8s.io/mcs-api/pkg/apis/v1alpha1"
 )
@@ -395,6 +396,7 @@ func (in *Gateway) DeepCopyInto(out *Gateway) {
 		*out = new(WireGuardInfo)
 		**out = **in
 	}
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Gateway.
@@ -462,6 +464,28 @@ func (in *GatewayList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GatewayStatus) DeepCopyInto(out *GatewayStatus) {
+	*out = *in
+	if in.PeerGateways != nil {
+		in, out := &in.PeerGateways, &out.PeerGateways
+		*out = make([]PeerGatewayStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayStatus.
+func (in *GatewayStatus) DeepCopy() *GatewayStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(GatewayStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LabelIdentity) DeepCopyInto(out *LabelIdentity) {
 	*out = *in
@@ -570,6 +594,13 @@ func (in *MemberClusterAnnounce) DeepCopyInto(out *MemberClusterAnnounce) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	if in.PeerGateways != nil {
+		in, out := &in.PeerGateways, &out.PeerGateways
+		*out = make([]PeerGatewayStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemberClusterAnnounce.
@@ -652,6 +683,32 @@ func (in *MultiClusterConfig) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PeerGatewayStatus) DeepCopyInto(out *PeerGatewayStatus) {
+	*out = *in
+	if in.TunnelLatency != nil {
+		in, out := &in.TunnelLatency, &out.TunnelLatency
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.UnderlayLatency != nil {
+		in, out := &in.UnderlayLatency, &out.UnderlayLatency
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	in.LastProbeTime.DeepCopyInto(&out.LastProbeTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PeerGatewayStatus.
+func (in *PeerGatewayStatus) DeepCopy() *PeerGatewayStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PeerGatewayStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RawResourceExport) DeepCopyInto(out *RawResourceExport) {
 	*out = *in
@@ -660,6 +717,11 @@ func (in *RawResourceExport) DeepCopyInto(out *RawResourceExport) {
 		*out = make([]byte, len(*in))
 		copy(*out, *in)
 	}
+	if in.ClusterIDs != nil {
+		in, out := &in.ClusterIDs, &out.ClusterIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RawResourceExport.
@@ -1029,6 +1091,136 @@ func (in *ResourceImportStatus) DeepCopy() *ResourceImportStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourcePropagation) DeepCopyInto(out *ResourcePropagation) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourcePropagation.
+func (in *ResourcePropagation) DeepCopy() *ResourcePropagation {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourcePropagation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ResourcePropagation) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourcePropagationClusterStatus) DeepCopyInto(out *ResourcePropagationClusterStatus) {
+	*out = *in
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourcePropagationClusterStatus.
+func (in *ResourcePropagationClusterStatus) DeepCopy() *ResourcePropagationClusterStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourcePropagationClusterStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourcePropagationList) DeepCopyInto(out *ResourcePropagationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ResourcePropagation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourcePropagationList.
+func (in *ResourcePropagationList) DeepCopy() *ResourcePropagationList {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourcePropagationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ResourcePropagationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourcePropagationSpec) DeepCopyInto(out *ResourcePropagationSpec) {
+	*out = *in
+	in.Resource.DeepCopyInto(&out.Resource)
+	if in.ClusterSelector != nil {
+		in, out := &in.ClusterSelector, &out.ClusterSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ClusterIDs != nil {
+		in, out := &in.ClusterIDs, &out.ClusterIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourcePropagationSpec.
+func (in *ResourcePropagationSpec) DeepCopy() *ResourcePropagationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourcePropagationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourcePropagationStatus) DeepCopyInto(out *ResourcePropagationStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]ResourceCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ClusterStatuses != nil {
+		in, out := &in.ClusterStatuses, &out.ClusterStatuses
+		*out = make([]ResourcePropagationClusterStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourcePropagationStatus.
+func (in *ResourcePropagationStatus) DeepCopy() *ResourcePropagationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourcePropagationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServiceExport) DeepCopyInto(out *ServiceExport) {
 	*out = *in
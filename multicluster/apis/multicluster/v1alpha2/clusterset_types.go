@@ -19,6 +19,8 @@ package v1alpha2
 import (
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	mcv1alpha1 "antrea.io/antrea/multicluster/apis/multicluster/v1alpha1"
 )
 
 // LeaderClusterInfo specifies information of a leader cluster.
@@ -47,7 +49,7 @@ type ClusterSetSpec struct {
 	ClusterID string `json:"clusterID"`
 	// Leaders include leader clusters known to the member clusters.
 	// +kubebuilder:validation:MinItems=1
-	// +kubebuilder:validation:MaxItems=1
+	// +kubebuilder:validation:MaxItems=3
 	// +kubebuilder:validation:Required
 	Leaders []LeaderClusterInfo `json:"leaders"`
 	// The leader cluster Namespace in which the ClusterSet is defined.
@@ -115,6 +117,14 @@ type ClusterStatus struct {
 	Conditions []ClusterCondition `json:"conditions,omitempty"`
 }
 
+// ClusterConnectivity is the underlay connectivity from the Gateway of a member cluster to
+// the Gateways of the peer member clusters.
+type ClusterConnectivity struct {
+	// ClusterID of the member cluster which probes the peer Gateways.
+	ClusterID    string                         `json:"clusterID,omitempty"`
+	PeerGateways []mcv1alpha1.PeerGatewayStatus `json:"peerGateways,omitempty"`
+}
+
 // ClusterSetStatus defines the observed state of ClusterSet.
 type ClusterSetStatus struct {
 	// Total number of member clusters configured in the ClusterSet.
@@ -125,6 +135,9 @@ type ClusterSetStatus struct {
 	Conditions []ClusterSetCondition `json:"conditions,omitempty"`
 	// The status of individual member clusters.
 	ClusterStatuses []ClusterStatus `json:"clusterStatuses,omitempty"`
+	// The underlay connectivity between the Gateways of member clusters. In a member
+	// cluster, it only includes the connectivity from the local cluster.
+	Connectivity []ClusterConnectivity `json:"connectivity,omitempty"`
 	// The generation observed by the controller.
 	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 }
@@ -20,6 +20,7 @@
 package v1alpha2
 
 import (
+	"antrea.io/antrea/multicluster/apis/multicluster/v1alpha1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -96,6 +97,28 @@ func (in *ClusterCondition) DeepCopy() *ClusterCondition {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterConnectivity) DeepCopyInto(out *ClusterConnectivity) {
+	*out = *in
+	if in.PeerGateways != nil {
+		in, out := &in.PeerGateways, &out.PeerGateways
+		*out = make([]v1alpha1.PeerGatewayStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterConnectivity.
+func (in *ClusterConnectivity) DeepCopy() *ClusterConnectivity {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterConnectivity)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterSet) DeepCopyInto(out *ClusterSet) {
 	*out = *in
@@ -208,6 +231,13 @@ func (in *ClusterSetStatus) DeepCopyInto(out *ClusterSetStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Connectivity != nil {
+		in, out := &in.Connectivity, &out.Connectivity
+		*out = make([]ClusterConnectivity, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterSetStatus.
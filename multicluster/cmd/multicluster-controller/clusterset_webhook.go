@@ -23,6 +23,7 @@ import (
 	"net/http"
 
 	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/klog/v2"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
@@ -63,6 +64,11 @@ func (v *clusterSetValidator) Handle(ctx context.Context, req admission.Request)
 		return admission.Errored(http.StatusBadRequest, err)
 	}
 
+	if err := validateLeaders(clusterSet.Spec.Leaders); err != nil {
+		klog.ErrorS(err, "Invalid leaders in ClusterSet", "ClusterSet", klog.KObj(clusterSet))
+		return admission.Denied(err.Error())
+	}
+
 	oldClusterSet := &mcv1alpha2.ClusterSet{}
 	if req.OldObject.Raw != nil {
 		if err := json.Unmarshal(req.OldObject.Raw, &oldClusterSet); err != nil {
@@ -74,10 +80,12 @@ func (v *clusterSetValidator) Handle(ctx context.Context, req admission.Request)
 			klog.ErrorS(err, "the field 'clusterID' is immutable", "ClusterSet", klog.KObj(clusterSet))
 			return admission.Denied("the field 'clusterID' is immutable")
 		}
-		// The `Leaders` is a required field, and OpenAPI spec also limits only a single item is allowed.
-		if oldClusterSet.Spec.Leaders[0].ClusterID != clusterSet.Spec.Leaders[0].ClusterID {
-			klog.ErrorS(err, "the field 'clusterID' of the leader is immutable", "ClusterSet", klog.KObj(clusterSet))
-			return admission.Denied("the field 'clusterID' of the leader is immutable")
+		// Leaders can be added or removed, e.g. to replace a failed leader cluster, but at
+		// least one existing leader must be kept so that the member cluster can keep its
+		// exported and imported resources while switching to the new leaders.
+		if !hasCommonLeader(oldClusterSet.Spec.Leaders, clusterSet.Spec.Leaders) {
+			klog.ErrorS(err, "at least one existing leader must be kept", "ClusterSet", klog.KObj(clusterSet))
+			return admission.Denied("at least one existing leader must be kept when updating the leaders")
 		}
 
 		return admission.Allowed("")
@@ -98,6 +106,33 @@ func (v *clusterSetValidator) Handle(ctx context.Context, req admission.Request)
 	return admission.Allowed("")
 }
 
+// validateLeaders checks that every leader has a ClusterID and that leader ClusterIDs
+// are unique in the ClusterSet.
+func validateLeaders(leaders []mcv1alpha2.LeaderClusterInfo) error {
+	leaderIDs := sets.New[string]()
+	for _, leader := range leaders {
+		if leader.ClusterID == "" {
+			return fmt.Errorf("the field 'clusterID' of the leader is required")
+		}
+		if leaderIDs.Has(leader.ClusterID) {
+			return fmt.Errorf("duplicate leader clusterID %s", leader.ClusterID)
+		}
+		leaderIDs.Insert(leader.ClusterID)
+	}
+	return nil
+}
+
+func hasCommonLeader(oldLeaders, newLeaders []mcv1alpha2.LeaderClusterInfo) bool {
+	for _, oldLeader := range oldLeaders {
+		for _, newLeader := range newLeaders {
+			if oldLeader.ClusterID == newLeader.ClusterID {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func (v *clusterSetValidator) InjectDecoder(d *admission.Decoder) error {
 	v.decoder = d
 	return nil
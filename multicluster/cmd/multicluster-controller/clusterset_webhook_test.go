@@ -62,11 +62,20 @@ func TestWebhookClusterSetEvents(t *testing.T) {
 		{ClusterID: "leader1-1"},
 	}
 
+	leaderAddedClusterSet := newClusterSet.DeepCopy()
+	leaderAddedClusterSet.Spec.Leaders = append(leaderAddedClusterSet.Spec.Leaders,
+		mcv1alpha2.LeaderClusterInfo{ClusterID: "leader2"})
+	duplicateLeaderClusterSet := newClusterSet.DeepCopy()
+	duplicateLeaderClusterSet.Spec.Leaders = append(duplicateLeaderClusterSet.Spec.Leaders,
+		mcv1alpha2.LeaderClusterInfo{ClusterID: "leader1"})
+
 	clusterIDUpdatedClusterSet := newClusterSet.DeepCopy()
 	clusterIDUpdatedClusterSet.Spec.ClusterID = "newclusterid"
 
 	newCS, _ := j.Marshal(newClusterSet)
 	leaderUpdatedCS, _ := j.Marshal(leaderUpdatedClusterSet)
+	leaderAddedCS, _ := j.Marshal(leaderAddedClusterSet)
+	duplicateLeaderCS, _ := j.Marshal(duplicateLeaderClusterSet)
 	clusterIDUpdatedCS, _ := j.Marshal(clusterIDUpdatedClusterSet)
 
 	newReq := admission.Request{
@@ -101,6 +110,21 @@ func TestWebhookClusterSetEvents(t *testing.T) {
 		AdmissionRequest: *leaderNewReqCopy,
 	}
 
+	leaderAddedReqCopy := leaderNewReqCopy.DeepCopy()
+	leaderAddedReqCopy.Object = runtime.RawExtension{
+		Raw: leaderAddedCS,
+	}
+	leaderAddedReq := admission.Request{
+		AdmissionRequest: *leaderAddedReqCopy,
+	}
+	duplicateLeaderReqCopy := newReq.DeepCopy()
+	duplicateLeaderReqCopy.Object = runtime.RawExtension{
+		Raw: duplicateLeaderCS,
+	}
+	duplicateLeaderReq := admission.Request{
+		AdmissionRequest: *duplicateLeaderReqCopy,
+	}
+
 	deleteReq := admission.Request{
 		AdmissionRequest: v1.AdmissionRequest{
 			Name:      "clusterset1",
@@ -155,6 +179,19 @@ func TestWebhookClusterSetEvents(t *testing.T) {
 			role:               leaderRole,
 			isAllowed:          false,
 		},
+		{
+			name:               "add a leader to an existing ClusterSet",
+			existingClusterSet: existingClusterSet1,
+			req:                leaderAddedReq,
+			role:               memberRole,
+			isAllowed:          true,
+		},
+		{
+			name:      "create a new ClusterSet with duplicate leaders",
+			req:       duplicateLeaderReq,
+			role:      memberRole,
+			isAllowed: false,
+		},
 		{
 			name: "fail to delete a ClusterSet with a MemberClusterAnnounce in a leader cluster",
 			existingMemberClusterAnnounce: &mcv1alpha1.MemberClusterAnnounce{
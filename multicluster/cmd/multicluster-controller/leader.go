@@ -97,6 +97,10 @@ func runLeader(o *Options) error {
 	if err = resExportReconciler.SetupWithManager(mgr); err != nil {
 		return fmt.Errorf("error creating ResourceExport controller: %v", err)
 	}
+	resPropagationReconciler := leader.NewResourcePropagationReconciler(mgrClient, mgrScheme)
+	if err = resPropagationReconciler.SetupWithManager(mgr); err != nil {
+		return fmt.Errorf("error creating ResourcePropagation controller: %v", err)
+	}
 	if o.EnableStretchedNetworkPolicy {
 		labelExportReconciler := leader.NewLabelIdentityExportReconciler(
 			mgrClient,
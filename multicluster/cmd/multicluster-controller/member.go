@@ -71,6 +71,23 @@ func runMember(o *Options) error {
 			role:      memberRole},
 		})
 
+	var globalIPReconciler *member.GlobalIPReconciler
+	if o.GlobalPodCIDR != "" {
+		globalIPReconciler, err = member.NewGlobalIPReconciler(mgrClient, mgrScheme, o.GlobalPodCIDR)
+		if err != nil {
+			return fmt.Errorf("error creating global IP controller: %v", err)
+		}
+		if err = globalIPReconciler.SetupWithManager(mgr); err != nil {
+			return fmt.Errorf("error creating global IP controller: %v", err)
+		}
+	}
+
+	if o.ClusterSetDNSBindAddress != "" {
+		if err = mgr.Add(member.NewClusterSetDNSServer(mgrClient, o.ClusterSetDNSBindAddress)); err != nil {
+			return fmt.Errorf("error creating ClusterSet DNS server: %v", err)
+		}
+	}
+
 	commonAreaCreationCh := make(chan struct{}, 1)
 	clusterSetReconciler := member.NewMemberClusterSetReconciler(mgr.GetClient(),
 		mgr.GetScheme(),
@@ -78,6 +95,7 @@ func runMember(o *Options) error {
 		o.EnableStretchedNetworkPolicy,
 		o.ClusterCalimCRDAvailable,
 		commonAreaCreationCh,
+		globalIPReconciler,
 	)
 	if err = clusterSetReconciler.SetupWithManager(mgr); err != nil {
 		return fmt.Errorf("error creating ClusterSet controller: %v", err)
@@ -91,10 +109,19 @@ func runMember(o *Options) error {
 		o.EndpointIPType,
 		o.EnableEndpointSlice,
 		podNamespace,
+		globalIPReconciler,
 	)
 	if err = svcExportReconciler.SetupWithManager(mgr); err != nil {
 		return fmt.Errorf("error creating ServiceExport controller: %v", err)
 	}
+	traceflowReconciler := member.NewTraceflowReconciler(
+		mgrClient,
+		mgrScheme,
+		commonAreaGetter,
+		podNamespace)
+	if err = traceflowReconciler.SetupWithManager(mgr); err != nil {
+		return fmt.Errorf("error creating Traceflow controller: %v", err)
+	}
 	if o.EnableStretchedNetworkPolicy {
 		labelIdentityReconciler := member.NewLabelIdentityReconciler(
 			mgrClient,
@@ -107,11 +134,17 @@ func runMember(o *Options) error {
 		go labelIdentityReconciler.Run(stopCh)
 	}
 
+	// Other member clusters route the global Pod CIDR instead of the Pod CIDRs, which
+	// might overlap across member clusters, to the local cluster.
+	podCIDRs := opts.PodCIDRs
+	if opts.GlobalPodCIDR != "" {
+		podCIDRs = []string{opts.GlobalPodCIDR}
+	}
 	gwReconciler := member.NewGatewayReconciler(
 		mgrClient,
 		mgrScheme,
 		podNamespace,
-		opts.PodCIDRs,
+		podCIDRs,
 		commonAreaGetter)
 	if err = gwReconciler.SetupWithManager(mgr); err != nil {
 		return fmt.Errorf("error creating Gateway controller: %v", err)
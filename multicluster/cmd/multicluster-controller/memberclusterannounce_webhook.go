@@ -21,6 +21,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strings"
 
 	admissionv1 "k8s.io/api/admission/v1"
 	v1 "k8s.io/api/core/v1"
@@ -31,6 +32,7 @@ import (
 
 	mcv1alpha1 "antrea.io/antrea/multicluster/apis/multicluster/v1alpha1"
 	mcv1alpha2 "antrea.io/antrea/multicluster/apis/multicluster/v1alpha2"
+	"antrea.io/antrea/multicluster/controllers/multicluster/common"
 )
 
 //+kubebuilder:webhook:path=/validate-multicluster-crd-antrea-io-v1alpha1-memberclusterannounce,mutating=false,failurePolicy=fail,sideEffects=None,groups=multicluster.crd.antrea.io,resources=memberclusterannounces,verbs=create;update,versions=v1alpha1,name=vmemberclusterannounce.kb.io,admissionReviewVersions={v1,v1beta1}
@@ -94,8 +96,18 @@ func (v *memberClusterAnnounceValidator) Handle(ctx context.Context, req admissi
 		if clusterSet.Name != memberClusterAnnounce.ClusterSetID {
 			return admission.Denied("Unknown ClusterSet ID")
 		}
-		if clusterSet.Spec.Leaders[0].ClusterID != memberClusterAnnounce.LeaderClusterID {
-			return admission.Denied("Leader cluster ID in the MemberClusterAnnounce does not match that in the ClusterSet")
+		leaderFound := false
+		for _, leader := range clusterSet.Spec.Leaders {
+			if leader.ClusterID == memberClusterAnnounce.LeaderClusterID {
+				leaderFound = true
+				break
+			}
+		}
+		if !leaderFound {
+			return admission.Denied("Leader cluster ID in the MemberClusterAnnounce does not match any leader in the ClusterSet")
+		}
+		if newObj.BootstrapServiceAccount != "" && newObj.BootstrapServiceAccount != saName {
+			return admission.Denied("Bootstrap ServiceAccount in the MemberClusterAnnounce does not match the requester")
 		}
 		return admission.Allowed("")
 	case admissionv1.Update:
@@ -103,6 +115,17 @@ func (v *memberClusterAnnounceValidator) Handle(ctx context.Context, req admissi
 		if newObj.ClusterSetID != oldObj.ClusterSetID || newObj.LeaderClusterID != oldObj.LeaderClusterID {
 			return admission.Denied("ClusterSet ID or Leader Cluster ID cannot be changed")
 		}
+		// The bootstrap ServiceAccount can only be reported once by the member cluster
+		// itself, e.g. after the member cluster is upgraded.
+		if newObj.BootstrapServiceAccount != oldObj.BootstrapServiceAccount &&
+			(oldObj.BootstrapServiceAccount != "" || newObj.BootstrapServiceAccount != saName) {
+			return admission.Denied("Bootstrap ServiceAccount cannot be changed")
+		}
+		// The credential issued to a member cluster can only be used to update the
+		// MemberClusterAnnounce of the member cluster.
+		if strings.HasPrefix(saName, common.MemberCredentialName("")) && saName != common.MemberCredentialName(newObj.ClusterID) {
+			return admission.Denied("The credential of another member cluster cannot be used to update the MemberClusterAnnounce")
+		}
 		return admission.Allowed("")
 	default:
 		return admission.Allowed("")
@@ -66,6 +66,12 @@ func TestMemberClusterAnnounceWebhook(t *testing.T) {
 					Name:      "west-access-sa",
 				},
 			},
+			{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "mcs1",
+					Name:      "member-credential-west",
+				},
+			},
 		},
 	}
 
@@ -102,7 +108,14 @@ func TestMemberClusterAnnounceWebhook(t *testing.T) {
 		LeaderClusterID: "different-leader",
 	}
 
+	mcaWithBootstrapSA := mca.DeepCopy()
+	mcaWithBootstrapSA.BootstrapServiceAccount = "east-access-sa"
+	mcaWithWrongBootstrapSA := mca.DeepCopy()
+	mcaWithWrongBootstrapSA.BootstrapServiceAccount = "west-access-sa"
+
 	mcaMarshaled, _ := j.Marshal(mca)
+	mcaWithBootstrapSAMarshaled, _ := j.Marshal(mcaWithBootstrapSA)
+	mcaWithWrongBootstrapSAMarshaled, _ := j.Marshal(mcaWithWrongBootstrapSA)
 	oldmcaMarshaled, _ := j.Marshal(oldmca)
 	mcaAnotherMarshaled, _ := j.Marshal(mcafromAnotherClusterSet)
 	mcaDifferentLeaderMarshaled, _ := j.Marshal(mcaDifferentLeader)
@@ -178,6 +191,59 @@ func TestMemberClusterAnnounceWebhook(t *testing.T) {
 	}
 	reqDenyUpdateClusterSetID.Operation = v1.Update
 
+	reqAllowBootstrapSA := admission.Request{
+		AdmissionRequest: *reqAllowCopy,
+	}
+	reqAllowBootstrapSA.Object = runtime.RawExtension{
+		Raw: mcaWithBootstrapSAMarshaled,
+	}
+
+	reqDenyWrongBootstrapSA := admission.Request{
+		AdmissionRequest: *reqAllowCopy,
+	}
+	reqDenyWrongBootstrapSA.Object = runtime.RawExtension{
+		Raw: mcaWithWrongBootstrapSAMarshaled,
+	}
+
+	reqAllowUpdateEmptyBootstrapSA := admission.Request{
+		AdmissionRequest: *reqAllowCopy,
+	}
+	reqAllowUpdateEmptyBootstrapSA.Operation = v1.Update
+	reqAllowUpdateEmptyBootstrapSA.Object = runtime.RawExtension{
+		Raw: mcaWithBootstrapSAMarshaled,
+	}
+	reqAllowUpdateEmptyBootstrapSA.OldObject = runtime.RawExtension{
+		Raw: mcaMarshaled,
+	}
+
+	reqDenyUpdateBootstrapSA := admission.Request{
+		AdmissionRequest: *reqAllowCopy,
+	}
+	reqDenyUpdateBootstrapSA.Operation = v1.Update
+	reqDenyUpdateBootstrapSA.Object = runtime.RawExtension{
+		Raw: mcaWithWrongBootstrapSAMarshaled,
+	}
+	reqDenyUpdateBootstrapSA.OldObject = runtime.RawExtension{
+		Raw: mcaWithBootstrapSAMarshaled,
+	}
+
+	reqDenyUpdateWithOtherCredential := admission.Request{
+		AdmissionRequest: *reqAllowCopy,
+	}
+	reqDenyUpdateWithOtherCredential.Operation = v1.Update
+	reqDenyUpdateWithOtherCredential.OldObject = runtime.RawExtension{
+		Raw: mcaMarshaled,
+	}
+	reqDenyUpdateWithOtherCredential.UserInfo = authenticationv1.UserInfo{
+		Username: "system:serviceaccount:mcs1:member-credential-west",
+		UID:      "4842eb60-68e3-4e38-adad-3abfd6117241",
+		Groups: []string{
+			"system:serviceaccounts",
+			"system:serviceaccounts:mcs1",
+			"system:authenticated",
+		},
+	}
+
 	reqDenyNoClusterSet := admission.Request{
 		AdmissionRequest: *reqAllowCopy,
 	}
@@ -238,6 +304,36 @@ func TestMemberClusterAnnounceWebhook(t *testing.T) {
 			req:                reqDenyUpdateClusterSetID,
 			isAllowed:          false,
 		},
+		{
+			name:               "Allow MemberClusterAnnounce creation with bootstrap ServiceAccount",
+			existingClusterSet: existingClusterSet,
+			req:                reqAllowBootstrapSA,
+			isAllowed:          true,
+		},
+		{
+			name:               "Deny MemberClusterAnnounce creation with bootstrap ServiceAccount of another requester",
+			existingClusterSet: existingClusterSet,
+			req:                reqDenyWrongBootstrapSA,
+			isAllowed:          false,
+		},
+		{
+			name:               "Allow MemberClusterAnnounce update which reports bootstrap ServiceAccount",
+			existingClusterSet: existingClusterSet,
+			req:                reqAllowUpdateEmptyBootstrapSA,
+			isAllowed:          true,
+		},
+		{
+			name:               "Deny MemberClusterAnnounce update with bootstrap ServiceAccount change",
+			existingClusterSet: existingClusterSet,
+			req:                reqDenyUpdateBootstrapSA,
+			isAllowed:          false,
+		},
+		{
+			name:               "Deny MemberClusterAnnounce update with credential of another member cluster",
+			existingClusterSet: existingClusterSet,
+			req:                reqDenyUpdateWithOtherCredential,
+			isAllowed:          false,
+		},
 		{
 			name:               "Allow MemberClusterAnnounce delete",
 			existingClusterSet: existingClusterSet,
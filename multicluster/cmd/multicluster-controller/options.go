@@ -41,6 +41,12 @@ type Options struct {
 	// The type of IP address (ClusterIP or PodIP) to be used as the Multi-cluster
 	// Services' Endpoints.
 	EndpointIPType string
+	// GlobalPodCIDR is the ClusterSet-unique CIDR to allocate global IPs for the Pods
+	// backing exported Services.
+	GlobalPodCIDR string
+	// ClusterSetDNSBindAddress is the address to serve DNS for the ClusterSet-wide names
+	// of imported Services.
+	ClusterSetDNSBindAddress string
 	// Enable StretchedNetworkPolicy to exchange labelIdentities info among the whole
 	// ClusterSet.
 	EnableStretchedNetworkPolicy bool
@@ -95,6 +101,26 @@ func (o *Options) complete(args []string) error {
 			}
 			o.EndpointIPType = ctrlConfig.EndpointIPType
 		}
+		if ctrlConfig.GlobalPodCIDR != "" {
+			if _, _, err := net.ParseCIDR(ctrlConfig.GlobalPodCIDR); err != nil {
+				return fmt.Errorf("failed to parse globalPodCIDR, invalid CIDR string %s", ctrlConfig.GlobalPodCIDR)
+			}
+			if o.EndpointIPType != common.EndpointIPTypePodIP {
+				return fmt.Errorf("globalPodCIDR requires 'PodIP' endpointIPType")
+			}
+			// The source Pod IPs of cross-cluster connections are translated to the Gateway IP,
+			// so policies can't select the source Pods by their labels in other member clusters.
+			if ctrlConfig.EnableStretchedNetworkPolicy {
+				return fmt.Errorf("globalPodCIDR is incompatible with enableStretchedNetworkPolicy")
+			}
+			o.GlobalPodCIDR = ctrlConfig.GlobalPodCIDR
+		}
+		if ctrlConfig.ClusterSetDNSBindAddress != "" {
+			if _, _, err := net.SplitHostPort(ctrlConfig.ClusterSetDNSBindAddress); err != nil {
+				return fmt.Errorf("failed to parse clusterSetDNSBindAddress, invalid address %s", ctrlConfig.ClusterSetDNSBindAddress)
+			}
+			o.ClusterSetDNSBindAddress = ctrlConfig.ClusterSetDNSBindAddress
+		}
 		o.EnableStretchedNetworkPolicy = ctrlConfig.EnableStretchedNetworkPolicy
 		klog.InfoS("Using config from file", "config", o.configFile)
 	} else {
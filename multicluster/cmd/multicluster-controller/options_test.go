@@ -81,6 +81,45 @@ func TestComplete(t *testing.T) {
 			},
 			exceptdErr: fmt.Errorf("invalid endpointIPType: None, only 'PodIP' or 'ClusterIP' is allowed"),
 		},
+		{
+			name: "options with globalPodCIDR and ClusterIP endpointIPType",
+			o: Options{
+				configFile:          "./testdata/antrea-mc-config-with-invalid-globalpodcidr.yml",
+				SelfSignedCert:      false,
+				options:             ctrl.Options{},
+				ServiceCIDR:         "10.100.0.0/16",
+				PodCIDRs:            nil,
+				GatewayIPPrecedence: "",
+				EndpointIPType:      "",
+			},
+			exceptdErr: fmt.Errorf("globalPodCIDR requires 'PodIP' endpointIPType"),
+		},
+		{
+			name: "options with globalPodCIDR and enableStretchedNetworkPolicy",
+			o: Options{
+				configFile:          "./testdata/antrea-mc-config-with-globalpodcidr-and-stretchednetworkpolicy.yml",
+				SelfSignedCert:      false,
+				options:             ctrl.Options{},
+				ServiceCIDR:         "10.100.0.0/16",
+				PodCIDRs:            nil,
+				GatewayIPPrecedence: "",
+				EndpointIPType:      "",
+			},
+			exceptdErr: fmt.Errorf("globalPodCIDR is incompatible with enableStretchedNetworkPolicy"),
+		},
+		{
+			name: "options with invalid clusterSetDNSBindAddress",
+			o: Options{
+				configFile:          "./testdata/antrea-mc-config-with-invalid-clustersetdnsbindaddress.yml",
+				SelfSignedCert:      false,
+				options:             ctrl.Options{},
+				ServiceCIDR:         "10.100.0.0/16",
+				PodCIDRs:            nil,
+				GatewayIPPrecedence: "",
+				EndpointIPType:      "",
+			},
+			exceptdErr: fmt.Errorf("failed to parse clusterSetDNSBindAddress, invalid address 5353"),
+		},
 	}
 
 	for _, tt := range testCases {
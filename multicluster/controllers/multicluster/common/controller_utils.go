@@ -18,6 +18,7 @@ import (
 	"context"
 	"fmt"
 	"regexp"
+	"sort"
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -25,6 +26,7 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	mcv1alpha1 "antrea.io/antrea/multicluster/apis/multicluster/v1alpha1"
 	mcv1alpha2 "antrea.io/antrea/multicluster/apis/multicluster/v1alpha2"
 )
 
@@ -118,3 +120,36 @@ func GetClusterID(clusterCalimCRDAvailable bool, req ctrl.Request, client client
 	}
 	return ClusterID(clusterSet.Spec.ClusterID), nil
 }
+
+// GetPeerGatewayStatuses returns the results of probing the Gateways of peer member clusters
+// from all Gateways in the local member cluster, sorted by ClusterID and Gateway IP. When a
+// peer Gateway is probed by multiple local Gateways, the result with the highest tunnel packet
+// loss is returned, and the underlay packet loss breaks ties.
+func GetPeerGatewayStatuses(ctx context.Context, k8sClient client.Client, namespace string) ([]mcv1alpha1.PeerGatewayStatus, error) {
+	gwList := &mcv1alpha1.GatewayList{}
+	if err := k8sClient.List(ctx, gwList, &client.ListOptions{Namespace: namespace}); err != nil {
+		return nil, err
+	}
+	peerStatusMap := map[string]mcv1alpha1.PeerGatewayStatus{}
+	for _, gw := range gwList.Items {
+		for _, peerStatus := range gw.Status.PeerGateways {
+			key := peerStatus.ClusterID + "/" + peerStatus.GatewayIP
+			if existing, ok := peerStatusMap[key]; ok && (existing.TunnelPacketLoss > peerStatus.TunnelPacketLoss ||
+				existing.TunnelPacketLoss == peerStatus.TunnelPacketLoss && existing.UnderlayPacketLoss >= peerStatus.UnderlayPacketLoss) {
+				continue
+			}
+			peerStatusMap[key] = peerStatus
+		}
+	}
+	var peerStatuses []mcv1alpha1.PeerGatewayStatus
+	for _, peerStatus := range peerStatusMap {
+		peerStatuses = append(peerStatuses, peerStatus)
+	}
+	sort.Slice(peerStatuses, func(i, j int) bool {
+		if peerStatuses[i].ClusterID != peerStatuses[j].ClusterID {
+			return peerStatuses[i].ClusterID < peerStatuses[j].ClusterID
+		}
+		return peerStatuses[i].GatewayIP < peerStatuses[j].GatewayIP
+	})
+	return peerStatuses, nil
+}
@@ -21,11 +21,13 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	mcv1alpha1 "antrea.io/antrea/multicluster/apis/multicluster/v1alpha1"
 	mcv1alpha2 "antrea.io/antrea/multicluster/apis/multicluster/v1alpha2"
 )
 
@@ -220,3 +222,31 @@ func TestGetClusterID(t *testing.T) {
 		})
 	}
 }
+
+func TestGetPeerGatewayStatuses(t *testing.T) {
+	newGateway := func(name string, peerGateways ...mcv1alpha1.PeerGatewayStatus) *mcv1alpha1.Gateway {
+		return &mcv1alpha1.Gateway{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: name},
+			Status:     mcv1alpha1.GatewayStatus{PeerGateways: peerGateways},
+		}
+	}
+	healthyB1 := mcv1alpha1.PeerGatewayStatus{ClusterID: "cluster-b", GatewayIP: "172.18.0.11", UnderlayConnectivity: mcv1alpha1.GatewayConnectivityHealthy}
+	degradedB1 := mcv1alpha1.PeerGatewayStatus{ClusterID: "cluster-b", GatewayIP: "172.18.0.11", UnderlayConnectivity: mcv1alpha1.GatewayConnectivityDegraded, UnderlayPacketLoss: 20}
+	healthyB2 := mcv1alpha1.PeerGatewayStatus{ClusterID: "cluster-b", GatewayIP: "172.18.0.12", UnderlayConnectivity: mcv1alpha1.GatewayConnectivityHealthy}
+	tunnelDegradedB2 := mcv1alpha1.PeerGatewayStatus{ClusterID: "cluster-b", GatewayIP: "172.18.0.12", UnderlayConnectivity: mcv1alpha1.GatewayConnectivityHealthy,
+		TunnelConnectivity: mcv1alpha1.GatewayConnectivityDegraded, TunnelPacketLoss: 40}
+	unreachableC1 := mcv1alpha1.PeerGatewayStatus{ClusterID: "cluster-c", GatewayIP: "172.19.0.11", UnderlayConnectivity: mcv1alpha1.GatewayConnectivityUnreachable, UnderlayPacketLoss: 100}
+
+	fakeClient := fake.NewClientBuilder().WithScheme(TestScheme).WithObjects(
+		newGateway("node-1", unreachableC1, healthyB1, tunnelDegradedB2),
+		newGateway("node-2", degradedB1, healthyB2),
+		newGateway("node-3"),
+	).Build()
+	peerStatuses, err := GetPeerGatewayStatuses(TestCtx, fakeClient, "default")
+	require.NoError(t, err)
+	assert.Equal(t, []mcv1alpha1.PeerGatewayStatus{degradedB1, tunnelDegradedB2, unreachableC1}, peerStatuses)
+
+	peerStatuses, err = GetPeerGatewayStatuses(TestCtx, fakeClient, "kube-system")
+	require.NoError(t, err)
+	assert.Empty(t, peerStatuses)
+}
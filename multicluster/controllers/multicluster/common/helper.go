@@ -42,6 +42,12 @@ func ToMCResourceName(originalResourceName string) string {
 	return AntreaMCSPrefix + originalResourceName
 }
 
+// MemberCredentialName returns the name of the ServiceAccount and the Secret created in a
+// leader cluster to issue a short-lived credential to the given member cluster.
+func MemberCredentialName(clusterID string) string {
+	return "member-credential-" + clusterID
+}
+
 func StringExistsInSlice(slice []string, s string) bool {
 	for _, item := range slice {
 		if item == s {
@@ -98,3 +104,9 @@ func HashLabelIdentity(l string) string {
 func IsMulticlusterService(service *corev1.Service) bool {
 	return service.Annotations[AntreaMCServiceAnnotation] == "true"
 }
+
+// IsMulticlusterServicePreferLocal returns true if the Service is a multi-cluster Service
+// which prefers the Endpoints of the local cluster.
+func IsMulticlusterServicePreferLocal(service *corev1.Service) bool {
+	return IsMulticlusterService(service) && service.Annotations[ServiceTrafficPolicyAnnotation] == ServiceTrafficPolicyPreferLocal
+}
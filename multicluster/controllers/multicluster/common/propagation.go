@@ -0,0 +1,79 @@
+/*
+Copyright 2024 Antrea Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	mcv1alpha1 "antrea.io/antrea/multicluster/apis/multicluster/v1alpha1"
+)
+
+// PropagatedKind describes a kind of resource which can be propagated from the leader
+// cluster to member clusters by a ResourcePropagation.
+type PropagatedKind struct {
+	Group      string
+	Namespaced bool
+	// DefaultConflictPolicy is used when the ResourcePropagation doesn't specify a
+	// conflict policy. Policy definitions are expected to be managed centrally, while
+	// other kinds might carry cluster specific data, so they are not overwritten by
+	// default.
+	DefaultConflictPolicy mcv1alpha1.ConflictPolicy
+}
+
+// PropagatedKinds are the kinds of resources supported by ResourcePropagation.
+var PropagatedKinds = map[string]PropagatedKind{
+	"Tier":           {Group: "crd.antrea.io", DefaultConflictPolicy: mcv1alpha1.ConflictPolicyOverwrite},
+	"ClusterGroup":   {Group: "crd.antrea.io", DefaultConflictPolicy: mcv1alpha1.ConflictPolicyOverwrite},
+	"Egress":         {Group: "crd.antrea.io", DefaultConflictPolicy: mcv1alpha1.ConflictPolicySkip},
+	"ExternalIPPool": {Group: "crd.antrea.io", DefaultConflictPolicy: mcv1alpha1.ConflictPolicySkip},
+	"ConfigMap":      {Group: "", Namespaced: true, DefaultConflictPolicy: mcv1alpha1.ConflictPolicySkip},
+	"Secret":         {Group: "", Namespaced: true, DefaultConflictPolicy: mcv1alpha1.ConflictPolicySkip},
+}
+
+// systemNamespaces are the Namespaces resources are never propagated into, as they hold the
+// credentials and configurations of the cluster components.
+var systemNamespaces = sets.New[string]("kube-system", "kube-public", "kube-node-lease")
+
+// ParsePropagatedResource decodes the JSON data of a propagated resource, and validates
+// that it's of a supported kind with a valid name and Namespace.
+func ParsePropagatedResource(data []byte) (*unstructured.Unstructured, PropagatedKind, error) {
+	obj := &unstructured.Unstructured{}
+	if err := obj.UnmarshalJSON(data); err != nil {
+		return nil, PropagatedKind{}, fmt.Errorf("failed to decode resource: %v", err)
+	}
+	gvk := obj.GroupVersionKind()
+	kind, ok := PropagatedKinds[gvk.Kind]
+	if !ok || kind.Group != gvk.Group {
+		return nil, PropagatedKind{}, fmt.Errorf("unsupported resource %s", gvk.GroupKind())
+	}
+	if obj.GetName() == "" {
+		return nil, PropagatedKind{}, fmt.Errorf("name of %s is empty", gvk.Kind)
+	}
+	if kind.Namespaced && obj.GetNamespace() == "" {
+		return nil, PropagatedKind{}, fmt.Errorf("namespace of %s %s is empty", gvk.Kind, obj.GetName())
+	}
+	if systemNamespaces.Has(obj.GetNamespace()) {
+		return nil, PropagatedKind{}, fmt.Errorf("%s cannot be propagated to system Namespace %s", gvk.Kind, obj.GetNamespace())
+	}
+	if !kind.Namespaced && obj.GetNamespace() != "" {
+		return nil, PropagatedKind{}, fmt.Errorf("%s is not a Namespaced kind", gvk.Kind)
+	}
+	return obj, kind, nil
+}
@@ -25,6 +25,40 @@ const (
 	GatewayAnnotation         = "multicluster.antrea.io/gateway"
 	GatewayIPAnnotation       = "multicluster.antrea.io/gateway-ip"
 
+	// ServiceTrafficPolicyAnnotation can be added to a ServiceExport to specify how the
+	// traffic of the multi-cluster Service imported to the same cluster is distributed
+	// among the member clusters.
+	ServiceTrafficPolicyAnnotation = "multicluster.antrea.io/service-traffic-policy"
+	// ServiceTrafficPolicyCluster distributes the traffic among the Endpoints of all
+	// member clusters. It's the default policy.
+	ServiceTrafficPolicyCluster = "Cluster"
+	// ServiceTrafficPolicyPreferLocal sends the traffic to the Endpoints of the local
+	// cluster, and only fails over to the Endpoints of the other member clusters when
+	// the local cluster has no ready Endpoint.
+	ServiceTrafficPolicyPreferLocal = "PreferLocal"
+
+	// PodGlobalIPAnnotation is added to a Pod backing an exported Service when the member
+	// cluster is configured with a global Pod CIDR. Its value is the ClusterSet-unique IP
+	// allocated to the Pod, which is translated to the Pod IP by the Multi-cluster Gateway.
+	PodGlobalIPAnnotation = "multicluster.antrea.io/global-ip"
+
+	// AntreaMCPropagatedAnnotation is added to a resource created or overwritten in a
+	// member cluster by a ResourcePropagation. Its value is the name of the ResourceImport.
+	AntreaMCPropagatedAnnotation = "multicluster.antrea.io/propagated-resource"
+	// PropagationNamespaceLabel must be set to "true" on a Namespace in a member cluster to
+	// allow ConfigMaps and Secrets to be propagated into it.
+	PropagationNamespaceLabel = "multicluster.antrea.io/allow-propagation"
+
+	// MemberCredentialLabel is added to the resources created in a leader cluster to issue a
+	// short-lived credential to a member cluster. Its value is the ClusterID of the member.
+	MemberCredentialLabel = "multicluster.antrea.io/member-credential"
+	// MemberCredentialExpirationAnnotation is added to a Secret which saves the credential of
+	// a member cluster. Its value is the expiration time of the credential in RFC3339 format.
+	MemberCredentialExpirationAnnotation = "multicluster.antrea.io/credential-expiration"
+	// CreatedByAntctlAnnotation is added by antctl to the resources of the member tokens it
+	// creates, e.g. with "antctl mc create membertoken".
+	CreatedByAntctlAnnotation = "multicluster.antrea.io/created-by-antctl"
+
 	AntreaMCSPrefix = "antrea-mc-"
 
 	InvalidClusterID    = ClusterID("invalid")
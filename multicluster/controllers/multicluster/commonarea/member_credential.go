@@ -0,0 +1,153 @@
+/*
+Copyright 2024 Antrea Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commonarea
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	utilnet "k8s.io/apimachinery/pkg/util/net"
+	"k8s.io/apiserver/pkg/authentication/serviceaccount"
+
+	"antrea.io/antrea/multicluster/controllers/multicluster/common"
+)
+
+// credentialKey is the key of the credential issued by the leader cluster in the Secret of
+// the member token. The credential is used instead of the member token to access the leader
+// cluster until it expires.
+const credentialKey = "credential"
+
+// MemberCredential is the bearer token used by a member cluster to access a leader cluster.
+// It's the member token at first, which works as a bootstrap token, and is replaced by the
+// short-lived credential issued by the leader cluster after the member cluster announces
+// itself. The credential is renewed before it expires.
+type MemberCredential struct {
+	mutex sync.RWMutex
+	// Namespace and name of the Secret which saves the member token and the credential.
+	secretNamespace string
+	secretName      string
+	token           string
+	// expiration is zero when the member token is used.
+	expiration time.Time
+	// renewTime is the time after which the member cluster starts to get the renewed
+	// credential from the leader cluster.
+	renewTime time.Time
+}
+
+// NewMemberCredential returns a MemberCredential with the credential saved in the Secret if it
+// has not expired, otherwise with the member token in the Secret.
+func NewMemberCredential(secretObj *v1.Secret) (*MemberCredential, error) {
+	_, token, err := getSecretCACrtAndToken(secretObj)
+	if err != nil {
+		return nil, err
+	}
+	credential := &MemberCredential{
+		secretNamespace: secretObj.Namespace,
+		secretName:      secretObj.Name,
+		token:           string(token),
+	}
+	if issuedToken, ok := secretObj.Data[credentialKey]; ok {
+		expiration, err := time.Parse(time.RFC3339, secretObj.Annotations[common.MemberCredentialExpirationAnnotation])
+		if err == nil && time.Now().Before(expiration) {
+			credential.set(string(issuedToken), expiration)
+		}
+	}
+	return credential, nil
+}
+
+func (c *MemberCredential) set(token string, expiration time.Time) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	now := time.Now()
+	c.token = token
+	c.expiration = expiration
+	c.renewTime = now.Add(expiration.Sub(now) / 2)
+}
+
+func (c *MemberCredential) getToken() string {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	return c.token
+}
+
+func (c *MemberCredential) getExpiration() time.Time {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	return c.expiration
+}
+
+// needsRenewal returns true if the member token is still in use, or the credential is going
+// to expire.
+func (c *MemberCredential) needsRenewal() bool {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	return c.expiration.IsZero() || time.Now().After(c.renewTime)
+}
+
+// WrapTransport sets the current token of the MemberCredential as the bearer token of the
+// requests to the leader cluster. It's used as the WrapTransport of the rest.Config, so the
+// renewed credential is used without re-creating the clients.
+func (c *MemberCredential) WrapTransport(rt http.RoundTripper) http.RoundTripper {
+	return &bearerTokenRoundTripper{credential: c, rt: rt}
+}
+
+type bearerTokenRoundTripper struct {
+	credential *MemberCredential
+	rt         http.RoundTripper
+}
+
+func (rt *bearerTokenRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = utilnet.CloneRequest(req)
+	req.Header.Set("Authorization", "Bearer "+rt.credential.getToken())
+	return rt.rt.RoundTrip(req)
+}
+
+func (rt *bearerTokenRoundTripper) WrappedRoundTripper() http.RoundTripper {
+	return rt.rt
+}
+
+// getServiceAccountFromToken returns the name of the ServiceAccount which the given token
+// belongs to, from the subject claim of the token. The signature of the token is not
+// verified, the leader cluster validates the name when it's reported in the
+// MemberClusterAnnounce.
+func getServiceAccountFromToken(token string) (string, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return "", fmt.Errorf("token is not a JWT")
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return "", fmt.Errorf("failed to decode token payload: %v", err)
+	}
+	claims := struct {
+		Subject string `json:"sub"`
+	}{}
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return "", fmt.Errorf("failed to unmarshal token claims: %v", err)
+	}
+	_, name, err := serviceaccount.SplitUsername(claims.Subject)
+	if err != nil {
+		return "", err
+	}
+	return name, nil
+}
@@ -0,0 +1,192 @@
+/*
+Copyright 2024 Antrea Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commonarea
+
+import (
+	"context"
+	"encoding/base64"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	mcv1alpha1 "antrea.io/antrea/multicluster/apis/multicluster/v1alpha1"
+	"antrea.io/antrea/multicluster/controllers/multicluster/common"
+)
+
+func newTestToken(subject string) string {
+	payload := base64.RawURLEncoding.EncodeToString([]byte(`{"iss":"kubernetes/serviceaccount","sub":"` + subject + `"}`))
+	return "eyJhbGciOiJSUzI1NiJ9." + payload + ".c2lnbmF0dXJl"
+}
+
+func TestNewMemberCredential(t *testing.T) {
+	expiration := time.Now().Add(time.Hour).Truncate(time.Second)
+	tests := []struct {
+		name               string
+		data               map[string][]byte
+		annotations        map[string]string
+		expectedToken      string
+		expectedExpiration time.Time
+		expectedErr        string
+	}{
+		{
+			name:          "member token",
+			data:          map[string][]byte{"ca.crt": []byte("12345"), "token": []byte("member-token")},
+			expectedToken: "member-token",
+		},
+		{
+			name: "issued credential",
+			data: map[string][]byte{"ca.crt": []byte("12345"), "token": []byte("member-token"), "credential": []byte("issued-token")},
+			annotations: map[string]string{
+				common.MemberCredentialExpirationAnnotation: expiration.Format(time.RFC3339),
+			},
+			expectedToken:      "issued-token",
+			expectedExpiration: expiration,
+		},
+		{
+			name: "expired credential",
+			data: map[string][]byte{"ca.crt": []byte("12345"), "token": []byte("member-token"), "credential": []byte("issued-token")},
+			annotations: map[string]string{
+				common.MemberCredentialExpirationAnnotation: time.Now().Add(-time.Minute).Format(time.RFC3339),
+			},
+			expectedToken: "member-token",
+		},
+		{
+			name:        "no token",
+			data:        map[string][]byte{"ca.crt": []byte("12345")},
+			expectedErr: "token not found in Secret member-token",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			secret := &v1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "member-token", Annotations: tt.annotations},
+				Data:       tt.data,
+			}
+			credential, err := NewMemberCredential(secret)
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedToken, credential.getToken())
+			assert.True(t, tt.expectedExpiration.Equal(credential.getExpiration()))
+			assert.Equal(t, tt.expectedExpiration.IsZero(), credential.needsRenewal())
+		})
+	}
+}
+
+func TestMemberCredentialWrapTransport(t *testing.T) {
+	credential := &MemberCredential{token: "member-token"}
+	var authorization string
+	rt := credential.WrapTransport(roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		authorization = req.Header.Get("Authorization")
+		return &http.Response{StatusCode: http.StatusOK}, nil
+	}))
+	req, _ := http.NewRequest(http.MethodGet, "https://leader", nil)
+	_, err := rt.RoundTrip(req)
+	require.NoError(t, err)
+	assert.Equal(t, "Bearer member-token", authorization)
+
+	credential.set("issued-token", time.Now().Add(time.Hour))
+	_, err = rt.RoundTrip(req)
+	require.NoError(t, err)
+	assert.Equal(t, "Bearer issued-token", authorization)
+	assert.Empty(t, req.Header.Get("Authorization"))
+}
+
+type roundTripperFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestGetServiceAccountFromToken(t *testing.T) {
+	name, err := getServiceAccountFromToken(newTestToken("system:serviceaccount:antrea-multicluster:member-east-token"))
+	require.NoError(t, err)
+	assert.Equal(t, "member-east-token", name)
+
+	_, err = getServiceAccountFromToken(newTestToken("system:admin"))
+	assert.Error(t, err)
+	_, err = getServiceAccountFromToken("member-token")
+	assert.EqualError(t, err, "token is not a JWT")
+}
+
+func TestSyncCredential(t *testing.T) {
+	memberToken := newTestToken("system:serviceaccount:cluster-a-ns:member-a-token")
+	memberSecret := &v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "leader-a-token"},
+		Data:       map[string][]byte{"ca.crt": []byte("12345"), "token": []byte(memberToken)},
+	}
+	credential, err := NewMemberCredential(memberSecret)
+	require.NoError(t, err)
+	fakeRemoteClient := fake.NewClientBuilder().WithScheme(common.TestScheme).Build()
+	fakeLocalClient := fake.NewClientBuilder().WithScheme(common.TestScheme).WithObjects(memberSecret).Build()
+	remoteCommonAreaUnderTest := &remoteCommonArea{
+		Client:             fakeRemoteClient,
+		ClusterSetID:       "clusterSetA",
+		ClusterID:          "leaderA",
+		localClusterID:     "clusterA",
+		scheme:             common.TestScheme,
+		Namespace:          "cluster-a-ns",
+		localClusterClient: fakeLocalClient,
+		localNamespace:     "default",
+		credential:         credential,
+	}
+	ctx := context.Background()
+
+	// The member token is used before the credential is issued.
+	require.NoError(t, remoteCommonAreaUnderTest.SendMemberAnnounce())
+	require.NoError(t, remoteCommonAreaUnderTest.syncCredential())
+	assert.Equal(t, memberToken, credential.getToken())
+	memberAnnounce := &mcv1alpha1.MemberClusterAnnounce{}
+	require.NoError(t, fakeRemoteClient.Get(ctx, types.NamespacedName{Namespace: "cluster-a-ns", Name: "member-announce-from-clusterA"}, memberAnnounce))
+	assert.Equal(t, "member-a-token", memberAnnounce.BootstrapServiceAccount)
+
+	expiration := time.Now().Add(time.Hour).Truncate(time.Second)
+	issuedSecret := &v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   "cluster-a-ns",
+			Name:        "member-credential-clusterA",
+			Annotations: map[string]string{common.MemberCredentialExpirationAnnotation: expiration.Format(time.RFC3339)},
+		},
+		Data: map[string][]byte{"token": []byte("issued-token-1")},
+	}
+	require.NoError(t, fakeRemoteClient.Create(ctx, issuedSecret))
+	require.NoError(t, remoteCommonAreaUnderTest.syncCredential())
+	assert.Equal(t, "issued-token-1", credential.getToken())
+	assert.False(t, credential.needsRenewal())
+	latestMemberSecret := &v1.Secret{}
+	require.NoError(t, fakeLocalClient.Get(ctx, types.NamespacedName{Namespace: "default", Name: "leader-a-token"}, latestMemberSecret))
+	assert.Equal(t, []byte("issued-token-1"), latestMemberSecret.Data["credential"])
+	assert.Equal(t, []byte(memberToken), latestMemberSecret.Data["token"])
+	assert.Equal(t, expiration.Format(time.RFC3339), latestMemberSecret.Annotations[common.MemberCredentialExpirationAnnotation])
+
+	// The renewed credential is used after the current one is going to expire.
+	credential.set("issued-token-1", time.Now())
+	issuedSecret.Annotations[common.MemberCredentialExpirationAnnotation] = expiration.Add(time.Hour).Format(time.RFC3339)
+	issuedSecret.Data["token"] = []byte("issued-token-2")
+	require.NoError(t, fakeRemoteClient.Update(ctx, issuedSecret))
+	require.NoError(t, remoteCommonAreaUnderTest.syncCredential())
+	assert.Equal(t, "issued-token-2", credential.getToken())
+}
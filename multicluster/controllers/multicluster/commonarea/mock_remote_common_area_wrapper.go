@@ -88,12 +88,12 @@ func NewFakeRemoteCommonArea(fakeClient client.Client, clusterID string, localCl
 	return fakeRemoteCommonArea
 }
 
-type funcGetRemoteConfigAndClient func(secretObj *v1.Secret, url string, clusterID common.ClusterID,
+type funcGetRemoteConfigAndClient func(secretObj *v1.Secret, credential *MemberCredential, url string, clusterID common.ClusterID,
 	clusterSet *mcv1alpha2.ClusterSet, scheme *runtime.Scheme) (*rest.Config,
 	manager.Manager, client.Client, error)
 
 func FuncGetFakeRemoteConfigAndClient(mgr manager.Manager) funcGetRemoteConfigAndClient {
-	return func(secretObj *v1.Secret, url string, clusterID common.ClusterID,
+	return func(secretObj *v1.Secret, credential *MemberCredential, url string, clusterID common.ClusterID,
 		clusterSet *mcv1alpha2.ClusterSet, scheme *runtime.Scheme) (*rest.Config,
 		manager.Manager, client.Client, error) {
 		_, _, err := getSecretCACrtAndToken(secretObj)
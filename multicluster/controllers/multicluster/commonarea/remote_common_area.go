@@ -69,6 +69,9 @@ type remoteCommonArea struct {
 	// config necessary to access the remoteCommonArea.
 	config *rest.Config
 
+	// credential used to access the remoteCommonArea, which is renewed periodically.
+	credential *MemberCredential
+
 	// scheme necessary to access the remoteCommonArea.
 	scheme *runtime.Scheme
 
@@ -108,7 +111,8 @@ type remoteCommonArea struct {
 // NewRemoteCommonArea returns a RemoteCommonArea instance which will use access credentials from the Secret to
 // connect to the leader cluster's CommonArea.
 func NewRemoteCommonArea(clusterID common.ClusterID, clusterSetID common.ClusterSetID, localClusterID common.ClusterID, mgr manager.Manager, remoteClient client.Client,
-	scheme *runtime.Scheme, localClusterClient client.Client, clusterSetNamespace string, localNamespace string, config *rest.Config, enableStretchedNetworkPolicy bool) (RemoteCommonArea, error) {
+	scheme *runtime.Scheme, localClusterClient client.Client, clusterSetNamespace string, localNamespace string, config *rest.Config, credential *MemberCredential,
+	enableStretchedNetworkPolicy bool) (RemoteCommonArea, error) {
 	klog.InfoS("Create a RemoteCommonArea", "cluster", clusterID)
 
 	remote := &remoteCommonArea{
@@ -117,6 +121,7 @@ func NewRemoteCommonArea(clusterID common.ClusterID, clusterSetID common.Cluster
 		ClusterSetID:                 clusterSetID,
 		ClusterID:                    clusterID,
 		config:                       config,
+		credential:                   credential,
 		scheme:                       scheme,
 		Namespace:                    clusterSetNamespace,
 		connected:                    false,
@@ -137,9 +142,11 @@ func NewRemoteCommonArea(clusterID common.ClusterID, clusterSetID common.Cluster
 	return remote, nil
 }
 
-func GetRemoteConfigAndClient(secretObj *v1.Secret, url string, clusterID common.ClusterID, clusterSet *mcv1alpha2.ClusterSet, scheme *runtime.Scheme) (*rest.Config,
-	manager.Manager, client.Client, error) {
-	crtData, token, err := getSecretCACrtAndToken(secretObj)
+// GetRemoteConfigAndClient returns the config, Manager and client to access the leader cluster with
+// the CA certificate in the Secret and the token of the MemberCredential.
+func GetRemoteConfigAndClient(secretObj *v1.Secret, credential *MemberCredential, url string, clusterID common.ClusterID, clusterSet *mcv1alpha2.ClusterSet,
+	scheme *runtime.Scheme) (*rest.Config, manager.Manager, client.Client, error) {
+	crtData, _, err := getSecretCACrtAndToken(secretObj)
 	if err != nil {
 		return nil, nil, nil, err
 	}
@@ -147,7 +154,7 @@ func GetRemoteConfigAndClient(secretObj *v1.Secret, url string, clusterID common
 	if err != nil {
 		return nil, nil, nil, err
 	}
-	config.BearerToken = string(token)
+	config.WrapTransport = credential.WrapTransport
 	config.CAData = crtData
 
 	config.QPS = common.ResourceExchangeQPS
@@ -199,6 +206,23 @@ func (r *remoteCommonArea) SendMemberAnnounce() error {
 
 	localClusterMemberAnnounceExists := err == nil
 	localClusterMemberAnnounce := *existingMemberAnnounce
+	// The results of probing the peer Gateways are reported to the leader cluster, so the
+	// connectivity between all member clusters can be shown in the leader cluster.
+	if peerGateways, err := common.GetPeerGatewayStatuses(context.TODO(), r.localClusterClient, r.localNamespace); err != nil {
+		klog.ErrorS(err, "Failed to get connectivity to peer Gateways")
+	} else {
+		localClusterMemberAnnounce.PeerGateways = peerGateways
+	}
+
+	if localClusterMemberAnnounce.BootstrapServiceAccount == "" && r.credential != nil {
+		// The leader cluster grants the ServiceAccount of the member token access to the
+		// credential issued to the local cluster.
+		if serviceAccount, err := getServiceAccountFromToken(r.credential.getToken()); err != nil {
+			klog.ErrorS(err, "Failed to get ServiceAccount of the member token, the member token will be used to access the leader cluster", "cluster", r.GetClusterID())
+		} else {
+			localClusterMemberAnnounce.BootstrapServiceAccount = serviceAccount
+		}
+	}
 
 	if localClusterMemberAnnounceExists {
 		r.updateLeaderStatus()
@@ -229,6 +253,55 @@ func (r *remoteCommonArea) SendMemberAnnounce() error {
 	return nil
 }
 
+// syncCredential gets the credential issued to the local cluster from the leader cluster, when
+// the member token is still in use or the current credential is going to expire. The renewed
+// credential is saved in the Secret of the member token, so it can be used after the
+// controller restarts.
+func (r *remoteCommonArea) syncCredential() error {
+	if r.credential == nil || !r.credential.needsRenewal() {
+		return nil
+	}
+	issuedSecret := &v1.Secret{}
+	if err := r.Get(context.TODO(), types.NamespacedName{
+		Namespace: r.GetNamespace(),
+		Name:      common.MemberCredentialName(r.GetLocalClusterID()),
+	}, issuedSecret); err != nil {
+		// The credential has not been issued yet.
+		return client.IgnoreNotFound(err)
+	}
+	token, found := issuedSecret.Data[v1.ServiceAccountTokenKey]
+	if !found {
+		return fmt.Errorf("token not found in Secret %s", issuedSecret.Name)
+	}
+	expiration, err := time.Parse(time.RFC3339, issuedSecret.Annotations[common.MemberCredentialExpirationAnnotation])
+	if err != nil {
+		return fmt.Errorf("invalid expiration time of credential in Secret %s: %v", issuedSecret.Name, err)
+	}
+	if !expiration.After(r.credential.getExpiration()) || !time.Now().Before(expiration) {
+		// The credential has not been renewed by the leader cluster yet.
+		return nil
+	}
+
+	secret := &v1.Secret{}
+	if err := r.localClusterClient.Get(context.TODO(), types.NamespacedName{
+		Namespace: r.credential.secretNamespace,
+		Name:      r.credential.secretName,
+	}, secret); err != nil {
+		return err
+	}
+	if secret.Annotations == nil {
+		secret.Annotations = make(map[string]string)
+	}
+	secret.Annotations[common.MemberCredentialExpirationAnnotation] = expiration.Format(time.RFC3339)
+	secret.Data[credentialKey] = token
+	if err := r.localClusterClient.Update(context.TODO(), secret); err != nil {
+		return err
+	}
+	r.credential.set(string(token), expiration)
+	klog.InfoS("Renewed credential to access leader cluster", "cluster", r.GetClusterID(), "expiration", expiration)
+	return nil
+}
+
 func (r *remoteCommonArea) updateRemoteCommonAreaStatus(connected bool, err error) {
 	defer r.mutex.Unlock()
 	r.mutex.Lock()
@@ -287,6 +360,8 @@ func (r *remoteCommonArea) GetNamespace() string {
 // Once connected to the RemoteCommonArea, the Start method runs a timer
 // on a go routine to periodically write MemberClusterAnnounce into the
 // RemoteCommonArea's CommonArea and also maintain its connectivity status.
+// Start does not watch ResourceImports; StartWatching is called separately
+// when the leader cluster is selected as the active leader of the member.
 func (r *remoteCommonArea) Start() context.CancelFunc {
 	stopCtx, stopFunc := context.WithCancel(context.Background())
 
@@ -295,7 +370,6 @@ func (r *remoteCommonArea) Start() context.CancelFunc {
 	go func() {
 		klog.InfoS("Starting MemberAnnounce to RemoteCommonArea", "cluster", r.GetClusterID())
 		r.doMemberAnnounce()
-		startedImporters := false
 		for {
 			select {
 			case <-stopCtx.Done():
@@ -304,14 +378,6 @@ func (r *remoteCommonArea) Start() context.CancelFunc {
 				return
 			case <-ticker.C:
 				r.doMemberAnnounce()
-				if !startedImporters && r.connected {
-					if err := r.StartWatching(); err != nil {
-						// Will retry in next tick.
-						klog.ErrorS(err, "Failed to start watching events")
-						return
-					}
-					startedImporters = true
-				}
 			}
 		}
 	}()
@@ -324,8 +390,13 @@ func (r *remoteCommonArea) doMemberAnnounce() {
 	if err := r.SendMemberAnnounce(); err != nil {
 		klog.ErrorS(err, "Error updating MemberClusterAnnounce", "cluster", r.GetClusterID())
 		r.updateRemoteCommonAreaStatus(false, err)
-	} else {
-		r.updateRemoteCommonAreaStatus(true, nil)
+		return
+	}
+	r.updateRemoteCommonAreaStatus(true, nil)
+	// The leader cluster issues or renews the credential of the local cluster when it
+	// processes the MemberClusterAnnounce.
+	if err := r.syncCredential(); err != nil {
+		klog.ErrorS(err, "Failed to get credential from leader cluster", "cluster", r.GetClusterID())
 	}
 }
 
@@ -367,9 +438,9 @@ func (r *remoteCommonArea) StartWatching() error {
 		stopCtx, stopFunc := context.WithCancel(context.Background())
 		r.managerStopFunc = stopFunc
 		// This starts the Manager and blocks; Manager performs reconciliation of resources from the RemoteCommonArea.
-		// When this RemoteCommonArea is not a leader anymore, stopCtx will be closed in StopWatching,
-		// so this blocking routine can return and finish. And the next time this RemoteCommonArea is connected as
-		// the leader again, it starts the Manager again.
+		// When this RemoteCommonArea is not the active leader anymore, stopCtx will be closed in StopWatching,
+		// so this blocking routine can return and finish. A Manager cannot be started again once stopped, so
+		// the member cluster creates a new RemoteCommonArea for the leader cluster after that.
 		err := r.ClusterManager.Start(stopCtx)
 		if err != nil {
 			klog.ErrorS(err, "Error starting ClusterManager for RemoteCommonArea", "cluster", r.ClusterID)
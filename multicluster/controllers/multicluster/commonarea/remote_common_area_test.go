@@ -51,7 +51,8 @@ func TestMemberAnnounce(t *testing.T) {
 		scheme:             common.TestScheme,
 		Namespace:          "cluster-a-ns",
 		connected:          false,
-		localClusterClient: nil, // Not used for this test
+		localClusterClient: fake.NewClientBuilder().WithScheme(common.TestScheme).Build(),
+		localNamespace:     "default",
 	}
 
 	remoteCommonAreaUnderTest.Start()
@@ -111,7 +112,8 @@ func TestMemberAnnounceWithExistingMemberAnnounce(t *testing.T) {
 		scheme:             common.TestScheme,
 		Namespace:          "cluster-a-ns",
 		connected:          false,
-		localClusterClient: nil, // Not used for this test
+		localClusterClient: fake.NewClientBuilder().WithScheme(common.TestScheme).Build(),
+		localNamespace:     "default",
 		leaderStatus: mcv1alpha2.ClusterCondition{
 			Message: "Leader cluster added",
 			Status:  v1.ConditionFalse,
@@ -194,7 +196,7 @@ func TestMemberAnnounceNewRemoteCommonArea(t *testing.T) {
 	}
 
 	actualRemoteCommonArea, err := NewRemoteCommonArea(expectedRemoteCommonArea.ClusterID, expectedRemoteCommonArea.ClusterSetID, expectedRemoteCommonArea.localClusterID, mockManager, fakeRemoteClient, common.TestScheme, nil,
-		"cluster-a-ns", "localnamespace", nil, false)
+		"cluster-a-ns", "localnamespace", nil, nil, false)
 	assert.Equal(t, nil, err)
 	clusterStatus, leaderStatus := actualRemoteCommonArea.GetStatus()[0], actualRemoteCommonArea.GetStatus()[1]
 	// Assign LastTransitionTime to clusterStatus and leaderStatus of expectedRemoteCommonArea to simply the following comparison.
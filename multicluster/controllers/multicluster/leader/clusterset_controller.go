@@ -189,6 +189,7 @@ func (r *LeaderClusterSetReconciler) updateStatus() {
 	status.ObservedGeneration = clusterSet.Generation
 	clusterStatuses := r.statusManager.GetMemberClusterStatuses()
 	status.ClusterStatuses = clusterStatuses
+	status.Connectivity = r.statusManager.GetMemberClusterConnectivity()
 	sizeOfMembers := len(clusterStatuses)
 	status.TotalClusters = int32(sizeOfMembers)
 	readyClusters := 0
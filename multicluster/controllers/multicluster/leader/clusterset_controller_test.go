@@ -29,6 +29,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	mcv1alpha1 "antrea.io/antrea/multicluster/apis/multicluster/v1alpha1"
 	mcv1alpha2 "antrea.io/antrea/multicluster/apis/multicluster/v1alpha2"
 	"antrea.io/antrea/multicluster/controllers/multicluster/common"
 )
@@ -62,6 +63,21 @@ var (
 			},
 		},
 	}
+	connectivity = []mcv1alpha2.ClusterConnectivity{
+		{
+			ClusterID: "east",
+			PeerGateways: []mcv1alpha1.PeerGatewayStatus{
+				{
+					ClusterID:            "west",
+					GatewayIP:            "172.18.0.10",
+					UnderlayConnectivity: mcv1alpha1.GatewayConnectivityHealthy,
+					UnderlayLatency:      &metav1.Duration{Duration: 2 * time.Millisecond},
+					UnderlayPathMTU:      1500,
+					LastProbeTime:        metaTime,
+				},
+			},
+		},
+	}
 	existingClusterSet = &mcv1alpha2.ClusterSet{
 		ObjectMeta: metav1.ObjectMeta{
 			Namespace:  "mcs1",
@@ -211,6 +227,7 @@ func TestLeaderClusterStatus(t *testing.T) {
 	leaderClusterSetReconcilerUnderTest.clusterSetID = common.ClusterSetID(existingClusterSet.Name)
 
 	mockStatusManager.EXPECT().GetMemberClusterStatuses().Return(statuses).Times(1)
+	mockStatusManager.EXPECT().GetMemberClusterConnectivity().Return(connectivity).Times(1)
 	leaderClusterSetReconcilerUnderTest.updateStatus()
 
 	clusterSet := &mcv1alpha2.ClusterSet{}
@@ -222,6 +239,7 @@ func TestLeaderClusterStatus(t *testing.T) {
 		ObservedGeneration: 1,
 		TotalClusters:      2,
 		ClusterStatuses:    statuses,
+		Connectivity:       connectivity,
 		Conditions: []mcv1alpha2.ClusterSetCondition{
 			{
 				Reason: "NoReadyCluster",
@@ -236,6 +254,7 @@ func TestLeaderClusterStatus(t *testing.T) {
 	assert.Equal(t, expectedStatus.ObservedGeneration, actualStatus.ObservedGeneration)
 	assert.Equal(t, expectedStatus.TotalClusters, actualStatus.TotalClusters)
 	assert.Equal(t, expectedStatus.ClusterStatuses, actualStatus.ClusterStatuses)
+	assert.Equal(t, expectedStatus.Connectivity, actualStatus.Connectivity)
 	assert.Equal(t, 1, len(actualStatus.Conditions))
 	assert.Equal(t, expectedStatus.Conditions[0].Type, actualStatus.Conditions[0].Type)
 	assert.Equal(t, expectedStatus.Conditions[0].Reason, actualStatus.Conditions[0].Reason)
@@ -0,0 +1,246 @@
+/*
+Copyright 2024 Antrea Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package leader
+
+import (
+	"context"
+	"time"
+
+	authenticationv1 "k8s.io/api/authentication/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	mcv1alpha1 "antrea.io/antrea/multicluster/apis/multicluster/v1alpha1"
+	"antrea.io/antrea/multicluster/controllers/multicluster/common"
+)
+
+// memberClusterRole is the Role which grants member clusters access to the leader cluster's
+// CommonArea. It's only bound to the ServiceAccounts of the credentials issued to member
+// clusters, the ServiceAccounts of the member tokens are bound to the member bootstrap Role,
+// which only allows member clusters to announce themselves.
+const memberClusterRole = "antrea-mc-member-cluster-role"
+
+var (
+	// MemberCredentialTTL is the lifetime of a credential issued to a member cluster. The
+	// credential is renewed when less than half of its lifetime is left.
+	MemberCredentialTTL = time.Hour
+
+	createServiceAccountToken = createTokenForServiceAccount
+)
+
+func createTokenForServiceAccount(ctx context.Context, c client.Client, serviceAccount *corev1.ServiceAccount, expirationSeconds int64) (*authenticationv1.TokenRequest, error) {
+	tokenRequest := &authenticationv1.TokenRequest{
+		Spec: authenticationv1.TokenRequestSpec{
+			ExpirationSeconds: &expirationSeconds,
+		},
+	}
+	if err := c.SubResource("token").Create(ctx, serviceAccount, tokenRequest); err != nil {
+		return nil, err
+	}
+	return tokenRequest, nil
+}
+
+// syncMemberCredential issues a short-lived credential to the member cluster of the given
+// MemberClusterAnnounce, or renews the credential when it's going to expire. The credential is
+// a token of a ServiceAccount dedicated to the member cluster, which is bound to the member
+// cluster Role. It's saved in a Secret which can only be read by the ServiceAccount itself and
+// the ServiceAccount of the bootstrap token the member cluster joined the ClusterSet with.
+func (r *MemberClusterAnnounceReconciler) syncMemberCredential(ctx context.Context, memberAnnounce *mcv1alpha1.MemberClusterAnnounce) error {
+	namespace := memberAnnounce.Namespace
+	name := common.MemberCredentialName(memberAnnounce.ClusterID)
+	labels := map[string]string{common.MemberCredentialLabel: memberAnnounce.ClusterID}
+	objectMeta := func() metav1.ObjectMeta {
+		return metav1.ObjectMeta{Namespace: namespace, Name: name, Labels: labels}
+	}
+	secretReaderMeta := objectMeta()
+	secretReaderMeta.Name = name + "-reader"
+
+	serviceAccount := &corev1.ServiceAccount{ObjectMeta: objectMeta()}
+	if _, err := controllerutil.CreateOrUpdate(ctx, r.Client, serviceAccount, func() error {
+		serviceAccount.Labels = labels
+		return nil
+	}); err != nil {
+		return err
+	}
+	roleBinding := &rbacv1.RoleBinding{ObjectMeta: objectMeta()}
+	if _, err := controllerutil.CreateOrUpdate(ctx, r.Client, roleBinding, func() error {
+		roleBinding.Labels = labels
+		roleBinding.RoleRef = rbacv1.RoleRef{APIGroup: rbacv1.GroupName, Kind: "Role", Name: memberClusterRole}
+		roleBinding.Subjects = []rbacv1.Subject{
+			{Kind: rbacv1.ServiceAccountKind, Namespace: namespace, Name: name},
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	secretReaderRole := &rbacv1.Role{ObjectMeta: secretReaderMeta}
+	if _, err := controllerutil.CreateOrUpdate(ctx, r.Client, secretReaderRole, func() error {
+		secretReaderRole.Labels = labels
+		secretReaderRole.Rules = []rbacv1.PolicyRule{
+			{APIGroups: []string{""}, Resources: []string{"secrets"}, ResourceNames: []string{name}, Verbs: []string{"get"}},
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	secretReaderRoleBinding := &rbacv1.RoleBinding{ObjectMeta: secretReaderMeta}
+	if _, err := controllerutil.CreateOrUpdate(ctx, r.Client, secretReaderRoleBinding, func() error {
+		secretReaderRoleBinding.Labels = labels
+		secretReaderRoleBinding.RoleRef = rbacv1.RoleRef{APIGroup: rbacv1.GroupName, Kind: "Role", Name: secretReaderRole.Name}
+		secretReaderRoleBinding.Subjects = []rbacv1.Subject{
+			{Kind: rbacv1.ServiceAccountKind, Namespace: namespace, Name: memberAnnounce.BootstrapServiceAccount},
+			{Kind: rbacv1.ServiceAccountKind, Namespace: namespace, Name: name},
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	secret := &corev1.Secret{}
+	secretExists := true
+	if err := r.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, secret); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return err
+		}
+		secretExists = false
+		secret.ObjectMeta = objectMeta()
+	}
+	if secretExists {
+		expiration, err := time.Parse(time.RFC3339, secret.Annotations[common.MemberCredentialExpirationAnnotation])
+		if err == nil && time.Until(expiration) > MemberCredentialTTL/2 {
+			return nil
+		}
+	}
+
+	tokenRequest, err := createServiceAccountToken(ctx, r.Client, serviceAccount, int64(MemberCredentialTTL.Seconds()))
+	if err != nil {
+		return err
+	}
+	if secret.Annotations == nil {
+		secret.Annotations = map[string]string{}
+	}
+	secret.Annotations[common.MemberCredentialExpirationAnnotation] = tokenRequest.Status.ExpirationTimestamp.Format(time.RFC3339)
+	secret.Type = corev1.SecretTypeOpaque
+	secret.Data = map[string][]byte{corev1.ServiceAccountTokenKey: []byte(tokenRequest.Status.Token)}
+	if secretExists {
+		err = r.Update(ctx, secret)
+	} else {
+		err = r.Create(ctx, secret)
+	}
+	if err != nil {
+		return err
+	}
+	klog.InfoS("Issued credential to member cluster", "cluster", memberAnnounce.ClusterID, "expiration", tokenRequest.Status.ExpirationTimestamp.Time)
+	return nil
+}
+
+// revokeMemberCredential deletes the ServiceAccount, Secret, Roles and RoleBindings created to
+// issue credentials to the given member cluster. Deleting the ServiceAccount invalidates all the
+// tokens issued for it, even if they are not expired yet. The ServiceAccount of the bootstrap
+// token which the member cluster joined the ClusterSet with is deleted too, so the bootstrap
+// token can't be used to join the ClusterSet again, unless it may be shared with other member
+// clusters (see isBootstrapServiceAccountRevocable).
+func revokeMemberCredential(ctx context.Context, c client.Client, namespace string, clusterID string, bootstrapServiceAccount string) error {
+	listOptions := []client.ListOption{
+		client.InNamespace(namespace),
+		client.MatchingLabels{common.MemberCredentialLabel: clusterID},
+	}
+	var objects []client.Object
+	serviceAccounts := &corev1.ServiceAccountList{}
+	if err := c.List(ctx, serviceAccounts, listOptions...); err != nil {
+		return err
+	}
+	for i := range serviceAccounts.Items {
+		objects = append(objects, &serviceAccounts.Items[i])
+	}
+	secrets := &corev1.SecretList{}
+	if err := c.List(ctx, secrets, listOptions...); err != nil {
+		return err
+	}
+	for i := range secrets.Items {
+		objects = append(objects, &secrets.Items[i])
+	}
+	roleBindings := &rbacv1.RoleBindingList{}
+	if err := c.List(ctx, roleBindings, listOptions...); err != nil {
+		return err
+	}
+	for i := range roleBindings.Items {
+		objects = append(objects, &roleBindings.Items[i])
+	}
+	roles := &rbacv1.RoleList{}
+	if err := c.List(ctx, roles, listOptions...); err != nil {
+		return err
+	}
+	for i := range roles.Items {
+		objects = append(objects, &roles.Items[i])
+	}
+	if bootstrapServiceAccount != "" {
+		revocable, err := isBootstrapServiceAccountRevocable(ctx, c, namespace, clusterID, bootstrapServiceAccount)
+		if err != nil {
+			return err
+		}
+		if revocable {
+			objects = append(objects, &corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: bootstrapServiceAccount}})
+		}
+	}
+
+	for _, obj := range objects {
+		if err := c.Delete(ctx, obj); err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+	}
+	if len(objects) > 0 {
+		klog.InfoS("Revoked credential of member cluster", "cluster", clusterID)
+	}
+	return nil
+}
+
+// isBootstrapServiceAccountRevocable returns whether the ServiceAccount of the bootstrap token
+// which the given member cluster joined the ClusterSet with can be deleted when the member
+// cluster leaves. Only the ServiceAccounts created by antctl for member tokens, and which are not
+// used by other member clusters, are deleted. Other ServiceAccounts, e.g. the default
+// antrea-mc-member-access-sa created by the deployment manifest, may be shared by all the member
+// clusters.
+func isBootstrapServiceAccountRevocable(ctx context.Context, c client.Client, namespace string, clusterID string, name string) (bool, error) {
+	serviceAccount := &corev1.ServiceAccount{}
+	if err := c.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, serviceAccount); err != nil {
+		return false, client.IgnoreNotFound(err)
+	}
+	if serviceAccount.Annotations[common.CreatedByAntctlAnnotation] != "true" {
+		klog.InfoS("Not deleting the ServiceAccount of the bootstrap token of member cluster as it was not created by antctl and may be shared with other member clusters, its token should be revoked manually if needed",
+			"cluster", clusterID, "serviceAccount", klog.KObj(serviceAccount))
+		return false, nil
+	}
+	memberAnnounces := &mcv1alpha1.MemberClusterAnnounceList{}
+	if err := c.List(ctx, memberAnnounces, client.InNamespace(namespace)); err != nil {
+		return false, err
+	}
+	for _, memberAnnounce := range memberAnnounces.Items {
+		if memberAnnounce.ClusterID != clusterID && memberAnnounce.BootstrapServiceAccount == name {
+			klog.InfoS("Not deleting the ServiceAccount of the bootstrap token of member cluster as it's used by another member cluster",
+				"cluster", clusterID, "serviceAccount", klog.KObj(serviceAccount), "otherCluster", memberAnnounce.ClusterID)
+			return false, nil
+		}
+	}
+	return true, nil
+}
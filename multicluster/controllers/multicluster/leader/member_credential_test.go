@@ -0,0 +1,178 @@
+/*
+Copyright 2024 Antrea Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package leader
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	mcv1alpha1 "antrea.io/antrea/multicluster/apis/multicluster/v1alpha1"
+	"antrea.io/antrea/multicluster/controllers/multicluster/common"
+)
+
+func TestSyncMemberCredential(t *testing.T) {
+	var issuedTokens int
+	createServiceAccountToken = func(ctx context.Context, c client.Client, serviceAccount *corev1.ServiceAccount, expirationSeconds int64) (*authenticationv1.TokenRequest, error) {
+		issuedTokens++
+		return &authenticationv1.TokenRequest{
+			Status: authenticationv1.TokenRequestStatus{
+				Token:               fmt.Sprintf("token-%d", issuedTokens),
+				ExpirationTimestamp: metav1.NewTime(time.Now().Add(time.Duration(expirationSeconds) * time.Second)),
+			},
+		}, nil
+	}
+	defer func() {
+		createServiceAccountToken = createTokenForServiceAccount
+	}()
+
+	mca := &mcv1alpha1.MemberClusterAnnounce{
+		ObjectMeta:              metav1.ObjectMeta{Name: "member-announce-from-east", Namespace: "mcs1"},
+		ClusterID:               "east",
+		ClusterSetID:            "clusterset1",
+		BootstrapServiceAccount: "east-access-sa",
+	}
+	fakeClient := fake.NewClientBuilder().WithScheme(common.TestScheme).WithObjects(mca).Build()
+	r := NewMemberClusterAnnounceReconciler(fakeClient, common.TestScheme)
+	ctx := context.Background()
+	reconcile := func() {
+		_, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Namespace: mca.Namespace, Name: mca.Name}})
+		require.NoError(t, err)
+	}
+	getSecret := func() *corev1.Secret {
+		secret := &corev1.Secret{}
+		require.NoError(t, fakeClient.Get(ctx, types.NamespacedName{Namespace: "mcs1", Name: "member-credential-east"}, secret))
+		return secret
+	}
+
+	reconcile()
+	secret := getSecret()
+	assert.Equal(t, []byte("token-1"), secret.Data[corev1.ServiceAccountTokenKey])
+	assert.Equal(t, "east", secret.Labels[common.MemberCredentialLabel])
+
+	serviceAccount := &corev1.ServiceAccount{}
+	require.NoError(t, fakeClient.Get(ctx, types.NamespacedName{Namespace: "mcs1", Name: "member-credential-east"}, serviceAccount))
+	roleBinding := &rbacv1.RoleBinding{}
+	require.NoError(t, fakeClient.Get(ctx, types.NamespacedName{Namespace: "mcs1", Name: "member-credential-east"}, roleBinding))
+	assert.Equal(t, memberClusterRole, roleBinding.RoleRef.Name)
+	assert.Equal(t, []rbacv1.Subject{{Kind: "ServiceAccount", Namespace: "mcs1", Name: "member-credential-east"}}, roleBinding.Subjects)
+	role := &rbacv1.Role{}
+	require.NoError(t, fakeClient.Get(ctx, types.NamespacedName{Namespace: "mcs1", Name: "member-credential-east-reader"}, role))
+	assert.Equal(t, []rbacv1.PolicyRule{{APIGroups: []string{""}, Resources: []string{"secrets"}, ResourceNames: []string{"member-credential-east"}, Verbs: []string{"get"}}}, role.Rules)
+	readerRoleBinding := &rbacv1.RoleBinding{}
+	require.NoError(t, fakeClient.Get(ctx, types.NamespacedName{Namespace: "mcs1", Name: "member-credential-east-reader"}, readerRoleBinding))
+	assert.Equal(t, []rbacv1.Subject{
+		{Kind: "ServiceAccount", Namespace: "mcs1", Name: "east-access-sa"},
+		{Kind: "ServiceAccount", Namespace: "mcs1", Name: "member-credential-east"},
+	}, readerRoleBinding.Subjects)
+
+	// The credential is not renewed before half of its lifetime is passed.
+	reconcile()
+	assert.Equal(t, []byte("token-1"), getSecret().Data[corev1.ServiceAccountTokenKey])
+
+	secret.Annotations[common.MemberCredentialExpirationAnnotation] = time.Now().Add(MemberCredentialTTL / 4).Format(time.RFC3339)
+	require.NoError(t, fakeClient.Update(ctx, secret))
+	reconcile()
+	assert.Equal(t, []byte("token-2"), getSecret().Data[corev1.ServiceAccountTokenKey])
+	assert.Equal(t, 2, issuedTokens)
+}
+
+func antctlObjectMeta(name string) metav1.ObjectMeta {
+	return metav1.ObjectMeta{
+		Namespace:   "mcs1",
+		Name:        name,
+		Annotations: map[string]string{common.CreatedByAntctlAnnotation: "true"},
+	}
+}
+
+func listServiceAccountNames(t *testing.T, c client.Client) []string {
+	serviceAccounts := &corev1.ServiceAccountList{}
+	require.NoError(t, c.List(context.Background(), serviceAccounts))
+	var serviceAccountNames []string
+	for _, sa := range serviceAccounts.Items {
+		serviceAccountNames = append(serviceAccountNames, sa.Name)
+	}
+	return serviceAccountNames
+}
+
+func TestRevokeMemberCredential(t *testing.T) {
+	objectMeta := func(name, clusterID string) metav1.ObjectMeta {
+		return metav1.ObjectMeta{
+			Namespace: "mcs1",
+			Name:      name,
+			Labels:    map[string]string{common.MemberCredentialLabel: clusterID},
+		}
+	}
+	fakeClient := fake.NewClientBuilder().WithScheme(common.TestScheme).WithObjects(
+		&corev1.ServiceAccount{ObjectMeta: objectMeta("member-credential-east", "east")},
+		&corev1.Secret{ObjectMeta: objectMeta("member-credential-east", "east")},
+		&rbacv1.RoleBinding{ObjectMeta: objectMeta("member-credential-east", "east")},
+		&rbacv1.Role{ObjectMeta: objectMeta("member-credential-east-reader", "east")},
+		&rbacv1.RoleBinding{ObjectMeta: objectMeta("member-credential-east-reader", "east")},
+		&corev1.ServiceAccount{ObjectMeta: objectMeta("member-credential-west", "west")},
+		&corev1.ServiceAccount{ObjectMeta: antctlObjectMeta("east-access-sa")},
+		&corev1.ServiceAccount{ObjectMeta: antctlObjectMeta("west-access-sa")},
+	).Build()
+	ctx := context.Background()
+
+	require.NoError(t, revokeMemberCredential(ctx, fakeClient, "mcs1", "east", "east-access-sa"))
+
+	assert.ElementsMatch(t, []string{"member-credential-west", "west-access-sa"}, listServiceAccountNames(t, fakeClient))
+	secrets := &corev1.SecretList{}
+	require.NoError(t, fakeClient.List(ctx, secrets))
+	assert.Empty(t, secrets.Items)
+	roles := &rbacv1.RoleList{}
+	require.NoError(t, fakeClient.List(ctx, roles))
+	assert.Empty(t, roles.Items)
+	roleBindings := &rbacv1.RoleBindingList{}
+	require.NoError(t, fakeClient.List(ctx, roleBindings))
+	assert.Empty(t, roleBindings.Items)
+}
+
+func TestRevokeMemberCredentialWithSharedBootstrapServiceAccount(t *testing.T) {
+	fakeClient := fake.NewClientBuilder().WithScheme(common.TestScheme).WithObjects(
+		// The ServiceAccount created by the deployment manifest is not deleted.
+		&corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Namespace: "mcs1", Name: "antrea-mc-member-access-sa"}},
+		// The ServiceAccount created by antctl is not deleted while another member cluster uses it.
+		&corev1.ServiceAccount{ObjectMeta: antctlObjectMeta("shared-access-sa")},
+		&mcv1alpha1.MemberClusterAnnounce{
+			ObjectMeta:              metav1.ObjectMeta{Namespace: "mcs1", Name: "member-announce-from-west"},
+			ClusterID:               "west",
+			BootstrapServiceAccount: "shared-access-sa",
+		},
+	).Build()
+	ctx := context.Background()
+
+	require.NoError(t, revokeMemberCredential(ctx, fakeClient, "mcs1", "east", "antrea-mc-member-access-sa"))
+	require.NoError(t, revokeMemberCredential(ctx, fakeClient, "mcs1", "east", "shared-access-sa"))
+	assert.ElementsMatch(t, []string{"antrea-mc-member-access-sa", "shared-access-sa"}, listServiceAccountNames(t, fakeClient))
+
+	require.NoError(t, revokeMemberCredential(ctx, fakeClient, "mcs1", "west", "shared-access-sa"))
+	assert.ElementsMatch(t, []string{"antrea-mc-member-access-sa"}, listServiceAccountNames(t, fakeClient))
+}
@@ -20,6 +20,7 @@ package leader
 import (
 	"context"
 	"fmt"
+	"sort"
 	"sync"
 	"time"
 
@@ -48,6 +49,8 @@ var (
 type memberData struct {
 	lastUpdateTime time.Time
 	status         *mcv1alpha2.ClusterStatus
+	// Results of probing the Gateways of peer member clusters reported by the member.
+	peerGateways []mcv1alpha1.PeerGatewayStatus
 }
 
 // MemberClusterAnnounceReconciler reconciles a MemberClusterAnnounce object
@@ -61,6 +64,7 @@ type MemberClusterAnnounceReconciler struct {
 
 type MemberClusterStatusManager interface {
 	GetMemberClusterStatuses() []mcv1alpha2.ClusterStatus
+	GetMemberClusterConnectivity() []mcv1alpha2.ClusterConnectivity
 }
 
 func NewMemberClusterAnnounceReconciler(client client.Client, scheme *runtime.Scheme) *MemberClusterAnnounceReconciler {
@@ -74,6 +78,9 @@ func NewMemberClusterAnnounceReconciler(client client.Client, scheme *runtime.Sc
 //+kubebuilder:rbac:groups=multicluster.crd.antrea.io,resources=memberclusterannounces,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=multicluster.crd.antrea.io,resources=memberclusterannounces/status,verbs=get;update;patch
 //+kubebuilder:rbac:groups=multicluster.crd.antrea.io,resources=memberclusterannounces/finalizers,verbs=update
+//+kubebuilder:rbac:groups="",resources=serviceaccounts;secrets,verbs=get;list;watch;create;update;delete
+//+kubebuilder:rbac:groups="",resources=serviceaccounts/token,verbs=create
+//+kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=roles;rolebindings,verbs=get;list;watch;create;update;delete
 
 // Reconcile implements cluster status management on the leader cluster
 func (r *MemberClusterAnnounceReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
@@ -93,16 +100,26 @@ func (r *MemberClusterAnnounceReconciler) Reconcile(ctx context.Context, req ctr
 	}
 
 	r.addOrUpdateMemberStatus(memberID)
-	if common.StringExistsInSlice(memberAnnounce.Finalizers, finalizer) {
-		return ctrl.Result{}, nil
-	}
-	klog.InfoS("Adding finalizer to MemberClusterAnnounce", "MemberClusterAnnounce", klog.KObj(memberAnnounce))
-	memberAnnounce.Finalizers = append(memberAnnounce.Finalizers, finalizer)
-	if err := r.Update(context.TODO(), memberAnnounce); err != nil {
-		klog.ErrorS(err, "Failed to update MemberClusterAnnounce", "MemberClusterAnnounce", klog.KObj(memberAnnounce))
-		return ctrl.Result{}, err
+	r.updateMemberPeerGateways(memberID, memberAnnounce.PeerGateways)
+	if !common.StringExistsInSlice(memberAnnounce.Finalizers, finalizer) {
+		klog.InfoS("Adding finalizer to MemberClusterAnnounce", "MemberClusterAnnounce", klog.KObj(memberAnnounce))
+		memberAnnounce.Finalizers = append(memberAnnounce.Finalizers, finalizer)
+		if err := r.Update(context.TODO(), memberAnnounce); err != nil {
+			klog.ErrorS(err, "Failed to update MemberClusterAnnounce", "MemberClusterAnnounce", klog.KObj(memberAnnounce))
+			return ctrl.Result{}, err
+		}
 	}
 
+	// A member cluster which doesn't report its bootstrap ServiceAccount keeps using the
+	// member token to access the leader cluster. The credential issued to the member
+	// cluster is revoked by StaleResCleanupController when the MemberClusterAnnounce is
+	// deleted.
+	if memberAnnounce.BootstrapServiceAccount != "" {
+		if err := r.syncMemberCredential(ctx, memberAnnounce); err != nil {
+			klog.ErrorS(err, "Failed to issue credential to member cluster", "cluster", memberID)
+			return ctrl.Result{}, err
+		}
+	}
 	return ctrl.Result{}, nil
 }
 
@@ -202,6 +219,14 @@ func (r *MemberClusterAnnounceReconciler) addOrUpdateMemberStatus(memberID commo
 	klog.InfoS("Added member cluster", "cluster", memberID)
 }
 
+func (r *MemberClusterAnnounceReconciler) updateMemberPeerGateways(memberID common.ClusterID, peerGateways []mcv1alpha1.PeerGatewayStatus) {
+	r.mapLock.Lock()
+	defer r.mapLock.Unlock()
+	if data, ok := r.memberStatusMap[memberID]; ok {
+		data.peerGateways = peerGateways
+	}
+}
+
 func (r *MemberClusterAnnounceReconciler) removeMemberStatus(memberID common.ClusterID) {
 	r.mapLock.Lock()
 	defer r.mapLock.Unlock()
@@ -226,3 +251,30 @@ func (r *MemberClusterAnnounceReconciler) GetMemberClusterStatuses() []mcv1alpha
 
 	return status
 }
+
+// GetMemberClusterConnectivity returns the connectivity from the Gateway of every member
+// cluster to the Gateways of the peer member clusters, sorted by ClusterID of the member
+// clusters.
+func (r *MemberClusterAnnounceReconciler) GetMemberClusterConnectivity() []mcv1alpha2.ClusterConnectivity {
+	r.mapLock.RLock()
+	defer r.mapLock.RUnlock()
+
+	var connectivity []mcv1alpha2.ClusterConnectivity
+	for memberID, data := range r.memberStatusMap {
+		if len(data.peerGateways) == 0 {
+			continue
+		}
+		clusterConnectivity := mcv1alpha2.ClusterConnectivity{
+			ClusterID:    string(memberID),
+			PeerGateways: make([]mcv1alpha1.PeerGatewayStatus, len(data.peerGateways)),
+		}
+		for i := range data.peerGateways {
+			data.peerGateways[i].DeepCopyInto(&clusterConnectivity.PeerGateways[i])
+		}
+		connectivity = append(connectivity, clusterConnectivity)
+	}
+	sort.Slice(connectivity, func(i, j int) bool {
+		return connectivity[i].ClusterID < connectivity[j].ClusterID
+	})
+	return connectivity
+}
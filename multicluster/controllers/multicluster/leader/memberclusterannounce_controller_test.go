@@ -20,6 +20,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/klog/v2"
@@ -92,6 +93,48 @@ func TestStatusAfterDelete(t *testing.T) {
 	assert.Equal(t, 0, len(actualStatus))
 }
 
+func TestConnectivityAfterReconcile(t *testing.T) {
+	setup()
+
+	peerGateways := []mcv1alpha1.PeerGatewayStatus{
+		{
+			ClusterID:            "west",
+			GatewayIP:            "172.18.0.10",
+			UnderlayConnectivity: mcv1alpha1.GatewayConnectivityDegraded,
+			UnderlayLatency:      &metav1.Duration{Duration: 2 * time.Millisecond},
+			UnderlayPacketLoss:   20,
+			UnderlayPathMTU:      1450,
+			LastProbeTime:        metav1.Time{Time: time.Date(2024, 1, 1, 0, 0, 0, 0, time.Local)},
+		},
+	}
+	for _, mca := range []*mcv1alpha1.MemberClusterAnnounce{
+		{
+			ObjectMeta:   metav1.ObjectMeta{Name: "member-announce-from-west", Namespace: "mcs1"},
+			ClusterID:    "west",
+			ClusterSetID: "clusterset1",
+		},
+		{
+			ObjectMeta:   metav1.ObjectMeta{Name: "member-announce-from-east", Namespace: "mcs1"},
+			ClusterID:    "east",
+			ClusterSetID: "clusterset1",
+			PeerGateways: peerGateways,
+		},
+	} {
+		require.NoError(t, mcaTestFakeRemoteClient.Create(context.TODO(), mca, &client.CreateOptions{}))
+		_, err := memberClusterAnnounceReconcilerUnderTest.Reconcile(context.Background(), ctrl.Request{
+			NamespacedName: types.NamespacedName{Namespace: mca.Namespace, Name: mca.Name},
+		})
+		require.NoError(t, err)
+	}
+
+	// The member cluster which hasn't reported any probe result is not included.
+	expectedConnectivity := []mcv1alpha2.ClusterConnectivity{{ClusterID: "east", PeerGateways: peerGateways}}
+	assert.Equal(t, expectedConnectivity, memberClusterAnnounceReconcilerUnderTest.GetMemberClusterConnectivity())
+
+	memberClusterAnnounceReconcilerUnderTest.removeMemberStatus("east")
+	assert.Empty(t, memberClusterAnnounceReconcilerUnderTest.GetMemberClusterConnectivity())
+}
+
 func TestStatusAfterReconcile(t *testing.T) {
 	TestStatusAfterAdd(t)
 
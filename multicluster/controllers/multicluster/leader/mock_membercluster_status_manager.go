@@ -48,6 +48,20 @@ func (m *MockMemberClusterStatusManager) EXPECT() *MockMemberClusterStatusManage
 	return m.recorder
 }
 
+// GetMemberClusterConnectivity mocks base method
+func (m *MockMemberClusterStatusManager) GetMemberClusterConnectivity() []v1alpha2.ClusterConnectivity {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMemberClusterConnectivity")
+	ret0, _ := ret[0].([]v1alpha2.ClusterConnectivity)
+	return ret0
+}
+
+// GetMemberClusterConnectivity indicates an expected call of GetMemberClusterConnectivity
+func (mr *MockMemberClusterStatusManagerMockRecorder) GetMemberClusterConnectivity() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMemberClusterConnectivity", reflect.TypeOf((*MockMemberClusterStatusManager)(nil).GetMemberClusterConnectivity))
+}
+
 // GetMemberClusterStatuses mocks base method
 func (m *MockMemberClusterStatusManager) GetMemberClusterStatuses() []v1alpha2.ClusterStatus {
 	m.ctrl.T.Helper()
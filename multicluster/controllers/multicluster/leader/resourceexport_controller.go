@@ -91,8 +91,11 @@ func (r *ResourceExportReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 	case constants.ClusterInfoKind:
 		return r.handleClusterInfo(ctx, req, resExport)
 	default:
-		klog.InfoS("It's not expected kind, skip reconciling ResourceExport", "resourceexport", req.NamespacedName)
-		return ctrl.Result{}, nil
+		if resExport.Spec.Raw == nil {
+			klog.InfoS("It's not expected kind, skip reconciling ResourceExport", "resourceexport", req.NamespacedName)
+			return ctrl.Result{}, nil
+		}
+		klog.V(2).InfoS("Reconciling raw ResourceExport", "resourceexport", req.NamespacedName, "kind", resExport.Spec.Kind)
 	}
 
 	// We are using Finalizers to implement asynchronous pre-delete hooks.
@@ -125,6 +128,8 @@ func (r *ResourceExportReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 		resImport, changed, err = r.refreshEndpointsResourceImport(&resExport, existingResImport, createResImport)
 	case constants.AntreaClusterNetworkPolicyKind:
 		resImport, changed, err = r.refreshACNPResourceImport(&resExport, existingResImport, createResImport)
+	default:
+		resImport, changed, err = r.refreshRawResourceImport(&resExport, existingResImport, createResImport)
 	}
 	if err != nil {
 		r.updateResourceExportStatus(&resExport, failed)
@@ -167,6 +172,10 @@ func (r *ResourceExportReconciler) handleUpdateEvent(ctx context.Context,
 		klog.ErrorS(err, "Failed to update ResourceImport", "resourceimport", resImpName.String())
 		return err
 	}
+	if resExport.Spec.Raw != nil {
+		// The status of a raw ResourceImport is reported by the importing member clusters.
+		return nil
+	}
 	latestResImport := &mcsv1alpha1.ResourceImport{}
 	err = r.Client.Get(ctx, resImpName, latestResImport)
 	if err != nil {
@@ -225,6 +234,9 @@ func (r *ResourceExportReconciler) handleDeleteEvent(ctx context.Context, resExp
 	if resExport.Spec.Kind == constants.ServiceKind {
 		return nil
 	}
+	if resExport.Spec.Raw != nil {
+		return r.updateRawResourceImport(ctx, &undeleteItems[0], resImportName)
+	}
 	return r.updateEndpointResourceImport(ctx, resExport, resImportName)
 }
 
@@ -259,6 +271,28 @@ func (r *ResourceExportReconciler) updateEndpointResourceImport(ctx context.Cont
 	return nil
 }
 
+// updateRawResourceImport refreshes a raw ResourceImport with the remaining ResourceExport
+// after another ResourceExport of the same resource is deleted.
+func (r *ResourceExportReconciler) updateRawResourceImport(ctx context.Context,
+	existRe *mcsv1alpha1.ResourceExport, resImpName types.NamespacedName) error {
+	resImport := &mcsv1alpha1.ResourceImport{}
+	err := r.Client.Get(ctx, resImpName, resImport)
+	if err != nil {
+		klog.ErrorS(err, "Failed to get ResourceImport", "resourceimport", resImpName)
+		return client.IgnoreNotFound(err)
+	}
+	newResImport, changed, err := r.refreshRawResourceImport(existRe, resImport, false)
+	if err != nil {
+		return err
+	}
+	if changed {
+		if err = r.handleUpdateEvent(ctx, newResImport, existRe); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (r *ResourceExportReconciler) getExistingResImport(ctx context.Context,
 	resExport mcsv1alpha1.ResourceExport) (bool, *mcsv1alpha1.ResourceImport, error) {
 	importedResNamespace := resExport.Labels[constants.SourceNamespace]
@@ -414,6 +448,37 @@ func (r *ResourceExportReconciler) refreshACNPResourceImport(
 	return newResImport, false, nil
 }
 
+// refreshRawResourceImport returns a new raw ResourceImport or updates the existing one
+// to reflect any change of the raw ResourceExport. A raw resource can only be exported
+// by one ResourceExport, as there is no way to merge opaque resources.
+func (r *ResourceExportReconciler) refreshRawResourceImport(
+	resExport *mcsv1alpha1.ResourceExport,
+	resImport *mcsv1alpha1.ResourceImport,
+	createResImport bool) (*mcsv1alpha1.ResourceImport, bool, error) {
+	newResImport := resImport.DeepCopy()
+	newResImport.Spec.Name = resExport.Spec.Name
+	newResImport.Spec.Namespace = resExport.Spec.Namespace
+	newResImport.Spec.Kind = resExport.Spec.Kind
+	undeletedItems, err := r.getNotDeletedResourceExports(resExport)
+	if err != nil {
+		klog.ErrorS(err, "Failed to list ResourceExports, retry later")
+		return newResImport, false, err
+	}
+	if len(undeletedItems) > 1 {
+		return newResImport, false, fmt.Errorf("%s %s is exported by %d ResourceExports, only one is allowed",
+			resExport.Spec.Kind, common.NamespacedName(resExport.Spec.Namespace, resExport.Spec.Name), len(undeletedItems))
+	}
+	newResImport.Spec.ClusterIDs = resExport.Spec.Raw.ClusterIDs
+	newResImport.Spec.Raw = &mcsv1alpha1.RawResourceImport{
+		Data:           resExport.Spec.Raw.Data,
+		ConflictPolicy: resExport.Spec.Raw.ConflictPolicy,
+	}
+	if createResImport {
+		return newResImport, true, nil
+	}
+	return newResImport, !apiequality.Semantic.DeepEqual(newResImport.Spec, resImport.Spec), nil
+}
+
 func (r *ResourceExportReconciler) getNotDeletedResourceExports(resExport *mcsv1alpha1.ResourceExport) ([]mcsv1alpha1.ResourceExport, error) {
 	reList := &mcsv1alpha1.ResourceExportList{}
 	err := r.Client.List(context.TODO(), reList, &client.ListOptions{
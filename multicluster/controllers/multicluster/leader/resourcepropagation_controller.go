@@ -0,0 +1,414 @@
+/*
+Copyright 2024 Antrea Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package leader
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	"antrea.io/antrea/multicluster/apis/multicluster/constants"
+	mcv1alpha1 "antrea.io/antrea/multicluster/apis/multicluster/v1alpha1"
+	"antrea.io/antrea/multicluster/controllers/multicluster/common"
+)
+
+const (
+	propagationResExportSuffix = "-propagation"
+
+	reasonInvalidResource   = "InvalidResource"
+	reasonInvalidSelector   = "InvalidClusterSelector"
+	reasonNoClusterSelected = "NoClusterSelected"
+	reasonConvergeFailure   = "ConvergeFailure"
+)
+
+// ResourcePropagationReconciler reconciles a ResourcePropagation object in the leader cluster.
+// It converts a ResourcePropagation into a raw ResourceExport, which is converged into a
+// ResourceImport by ResourceExportReconciler and then imported by the selected member
+// clusters. The propagation statuses reported by the member clusters in the ResourceImport
+// are aggregated into the ResourcePropagation status.
+type ResourcePropagationReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+}
+
+func NewResourcePropagationReconciler(
+	client client.Client,
+	scheme *runtime.Scheme) *ResourcePropagationReconciler {
+	return &ResourcePropagationReconciler{
+		Client: client,
+		Scheme: scheme,
+	}
+}
+
+//+kubebuilder:rbac:groups=multicluster.crd.antrea.io,resources=resourcepropagations,verbs=get;list;watch;update;patch
+//+kubebuilder:rbac:groups=multicluster.crd.antrea.io,resources=resourcepropagations/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=multicluster.crd.antrea.io,resources=resourcepropagations/finalizers,verbs=update
+//+kubebuilder:rbac:groups=multicluster.crd.antrea.io,resources=memberclusterannounces,verbs=get;list;watch
+
+func (r *ResourcePropagationReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	klog.V(2).InfoS("Reconciling ResourcePropagation", "resourcepropagation", req.NamespacedName)
+	propagation := &mcv1alpha1.ResourcePropagation{}
+	if err := r.Client.Get(ctx, req.NamespacedName, propagation); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+	if !propagation.DeletionTimestamp.IsZero() {
+		// The ResourceExport is deleted by the garbage collector with its owner.
+		return ctrl.Result{}, nil
+	}
+
+	resExportName := types.NamespacedName{Namespace: req.Namespace, Name: req.Name + propagationResExportSuffix}
+	obj, kind, err := common.ParsePropagatedResource(propagation.Spec.Resource.Raw)
+	if err != nil {
+		if err := r.deleteResourceExport(ctx, resExportName); err != nil {
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{}, r.updateStatus(ctx, propagation, corev1.ConditionFalse, reasonInvalidResource, err.Error(), nil)
+	}
+	var selector labels.Selector
+	if propagation.Spec.ClusterSelector != nil {
+		if selector, err = metav1.LabelSelectorAsSelector(propagation.Spec.ClusterSelector); err != nil {
+			return ctrl.Result{}, r.updateStatus(ctx, propagation, corev1.ConditionFalse, reasonInvalidSelector, err.Error(), nil)
+		}
+	}
+	allClusters, clusterIDs, err := r.selectClusters(ctx, propagation, selector)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if !allClusters && len(clusterIDs) == 0 {
+		if err := r.deleteResourceExport(ctx, resExportName); err != nil {
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{}, r.updateStatus(ctx, propagation, corev1.ConditionTrue, reasonNoClusterSelected,
+			"No member cluster is selected", nil)
+	}
+
+	conflictPolicy := propagation.Spec.ConflictPolicy
+	if conflictPolicy == "" {
+		conflictPolicy = kind.DefaultConflictPolicy
+	}
+	data, err := getPropagatedData(obj)
+	if err != nil {
+		return ctrl.Result{}, r.updateStatus(ctx, propagation, corev1.ConditionFalse, reasonInvalidResource, err.Error(), nil)
+	}
+	resExport := &mcv1alpha1.ResourceExport{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      resExportName.Name,
+			Namespace: resExportName.Namespace,
+			Labels: map[string]string{
+				constants.SourceName:      obj.GetName(),
+				constants.SourceNamespace: obj.GetNamespace(),
+				constants.SourceKind:      obj.GetKind(),
+			},
+			Finalizers: []string{constants.ResourceExportFinalizer},
+		},
+		Spec: mcv1alpha1.ResourceExportSpec{
+			Name:      obj.GetName(),
+			Namespace: obj.GetNamespace(),
+			Kind:      obj.GetKind(),
+			Raw: &mcv1alpha1.RawResourceExport{
+				Data:           data,
+				ClusterIDs:     clusterIDs,
+				ConflictPolicy: conflictPolicy,
+			},
+		},
+	}
+	if err := controllerutil.SetControllerReference(propagation, resExport, r.Scheme); err != nil {
+		return ctrl.Result{}, err
+	}
+	existingResExport, err := r.syncResourceExport(ctx, resExport)
+	if err != nil || existingResExport == nil {
+		return ctrl.Result{}, err
+	}
+
+	if conditions := existingResExport.Status.Conditions; len(conditions) > 0 && conditions[0].Status != corev1.ConditionTrue {
+		return ctrl.Result{}, r.updateStatus(ctx, propagation, corev1.ConditionFalse, reasonConvergeFailure,
+			"The resource can't be converged into a ResourceImport, it may be propagated by another ResourcePropagation", nil)
+	}
+	if allClusters {
+		if clusterIDs, err = r.getAllClusterIDs(ctx, req.Namespace); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+	clusterStatuses, err := r.getClusterStatuses(ctx, existingResExport, clusterIDs, propagation.Status.ClusterStatuses)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{}, r.updateStatus(ctx, propagation, corev1.ConditionTrue, "", "", clusterStatuses)
+}
+
+// selectClusters returns the ClusterIDs of the member clusters selected by the
+// ResourcePropagation, or true if all member clusters are selected.
+func (r *ResourcePropagationReconciler) selectClusters(ctx context.Context, propagation *mcv1alpha1.ResourcePropagation,
+	selector labels.Selector) (bool, []string, error) {
+	if propagation.Spec.ClusterSelector == nil && len(propagation.Spec.ClusterIDs) == 0 {
+		return true, nil, nil
+	}
+	clusterIDs := sets.New[string](propagation.Spec.ClusterIDs...)
+	if selector != nil {
+		memberAnnounceList := &mcv1alpha1.MemberClusterAnnounceList{}
+		if err := r.Client.List(ctx, memberAnnounceList, client.InNamespace(propagation.Namespace),
+			client.MatchingLabelsSelector{Selector: selector}); err != nil {
+			return false, nil, err
+		}
+		for _, memberAnnounce := range memberAnnounceList.Items {
+			clusterIDs.Insert(memberAnnounce.ClusterID)
+		}
+	}
+	return false, sets.List(clusterIDs), nil
+}
+
+func (r *ResourcePropagationReconciler) getAllClusterIDs(ctx context.Context, namespace string) ([]string, error) {
+	memberAnnounceList := &mcv1alpha1.MemberClusterAnnounceList{}
+	if err := r.Client.List(ctx, memberAnnounceList, client.InNamespace(namespace)); err != nil {
+		return nil, err
+	}
+	clusterIDs := sets.New[string]()
+	for _, memberAnnounce := range memberAnnounceList.Items {
+		clusterIDs.Insert(memberAnnounce.ClusterID)
+	}
+	return sets.List(clusterIDs), nil
+}
+
+// getPropagatedData returns the JSON data of the resource to propagate, with only the
+// name, Namespace, labels and annotations kept in its metadata and without status.
+func getPropagatedData(obj *unstructured.Unstructured) ([]byte, error) {
+	propagated := obj.DeepCopy()
+	metadata := map[string]interface{}{"name": obj.GetName()}
+	if obj.GetNamespace() != "" {
+		metadata["namespace"] = obj.GetNamespace()
+	}
+	propagated.Object["metadata"] = metadata
+	propagated.SetLabels(obj.GetLabels())
+	propagated.SetAnnotations(obj.GetAnnotations())
+	delete(propagated.Object, "status")
+	return propagated.MarshalJSON()
+}
+
+// syncResourceExport creates or updates the ResourceExport of the ResourcePropagation,
+// and returns the existing ResourceExport. It returns nil if the existing ResourceExport
+// is for another resource and is being deleted.
+func (r *ResourcePropagationReconciler) syncResourceExport(ctx context.Context, resExport *mcv1alpha1.ResourceExport) (*mcv1alpha1.ResourceExport, error) {
+	resExportName := types.NamespacedName{Namespace: resExport.Namespace, Name: resExport.Name}
+	existingResExport := &mcv1alpha1.ResourceExport{}
+	if err := r.Client.Get(ctx, resExportName, existingResExport); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return nil, err
+		}
+		if err := r.Client.Create(ctx, resExport, &client.CreateOptions{}); err != nil {
+			klog.ErrorS(err, "Failed to create ResourceExport", "resourceexport", resExportName)
+			return nil, err
+		}
+		klog.InfoS("Created ResourceExport for ResourcePropagation", "resourceexport", resExportName)
+		return resExport, nil
+	}
+	if !existingResExport.DeletionTimestamp.IsZero() {
+		// Wait for the deletion to complete. The ResourcePropagation will be reconciled
+		// again when the ResourceExport is deleted.
+		return nil, nil
+	}
+	if existingResExport.Spec.Kind != resExport.Spec.Kind || existingResExport.Spec.Name != resExport.Spec.Name ||
+		existingResExport.Spec.Namespace != resExport.Spec.Namespace {
+		// The propagated resource is changed to another one. Delete the ResourceExport, so
+		// the previous resource is cleaned up from the member clusters before a new one is
+		// created.
+		klog.InfoS("Deleting ResourceExport of the previously propagated resource", "resourceexport", resExportName)
+		return nil, r.deleteResourceExport(ctx, resExportName)
+	}
+	if apiequality.Semantic.DeepEqual(existingResExport.Spec, resExport.Spec) {
+		return existingResExport, nil
+	}
+	existingResExport.Spec = resExport.Spec
+	if err := r.Client.Update(ctx, existingResExport, &client.UpdateOptions{}); err != nil {
+		klog.ErrorS(err, "Failed to update ResourceExport", "resourceexport", resExportName)
+		return nil, err
+	}
+	return existingResExport, nil
+}
+
+func (r *ResourcePropagationReconciler) deleteResourceExport(ctx context.Context, resExportName types.NamespacedName) error {
+	resExport := &mcv1alpha1.ResourceExport{ObjectMeta: metav1.ObjectMeta{
+		Name:      resExportName.Name,
+		Namespace: resExportName.Namespace,
+	}}
+	return client.IgnoreNotFound(r.Client.Delete(ctx, resExport, &client.DeleteOptions{}))
+}
+
+// getClusterStatuses returns the propagation statuses of the given member clusters, which
+// are reported by the member clusters in the ResourceImport status.
+func (r *ResourcePropagationReconciler) getClusterStatuses(ctx context.Context, resExport *mcv1alpha1.ResourceExport,
+	clusterIDs []string, oldStatuses []mcv1alpha1.ResourcePropagationClusterStatus) ([]mcv1alpha1.ResourcePropagationClusterStatus, error) {
+	resImport := &mcv1alpha1.ResourceImport{}
+	if err := r.Client.Get(ctx, GetResourceImportName(resExport), resImport); err != nil && !apierrors.IsNotFound(err) {
+		return nil, err
+	}
+	importStatuses := map[string]mcv1alpha1.ResourceImportCondition{}
+	for _, clusterStatus := range resImport.Status.ClusterStatuses {
+		if len(clusterStatus.Conditions) > 0 {
+			importStatuses[clusterStatus.ClusterID] = clusterStatus.Conditions[0]
+		}
+	}
+	oldPhases := map[string]mcv1alpha1.ResourcePropagationClusterStatus{}
+	for _, oldStatus := range oldStatuses {
+		oldPhases[oldStatus.ClusterID] = oldStatus
+	}
+
+	var clusterStatuses []mcv1alpha1.ResourcePropagationClusterStatus
+	for _, clusterID := range clusterIDs {
+		status := mcv1alpha1.ResourcePropagationClusterStatus{
+			ClusterID: clusterID,
+			Phase:     mcv1alpha1.ResourcePropagationPending,
+		}
+		if condition, ok := importStatuses[clusterID]; ok {
+			status.Phase = getPropagationPhase(condition)
+			status.Message = condition.Message
+		}
+		if oldStatus, ok := oldPhases[clusterID]; ok && oldStatus.Phase == status.Phase {
+			status.LastTransitionTime = oldStatus.LastTransitionTime
+		} else {
+			status.LastTransitionTime = metav1.Now()
+		}
+		clusterStatuses = append(clusterStatuses, status)
+	}
+	return clusterStatuses, nil
+}
+
+func getPropagationPhase(condition mcv1alpha1.ResourceImportCondition) mcv1alpha1.ResourcePropagationPhase {
+	if condition.Status == corev1.ConditionTrue {
+		return mcv1alpha1.ResourcePropagationPropagated
+	}
+	if condition.Reason == string(mcv1alpha1.ResourcePropagationConflict) {
+		return mcv1alpha1.ResourcePropagationConflict
+	}
+	return mcv1alpha1.ResourcePropagationFailed
+}
+
+func (r *ResourcePropagationReconciler) updateStatus(ctx context.Context, propagation *mcv1alpha1.ResourcePropagation,
+	readyStatus corev1.ConditionStatus, reason, message string, clusterStatuses []mcv1alpha1.ResourcePropagationClusterStatus) error {
+	newStatus := mcv1alpha1.ResourcePropagationStatus{
+		Conditions: []mcv1alpha1.ResourceCondition{{
+			Type:               mcv1alpha1.ResourceReady,
+			Status:             readyStatus,
+			LastTransitionTime: metav1.Now(),
+			Reason:             reason,
+			Message:            message,
+		}},
+		ClusterStatuses: clusterStatuses,
+	}
+	if len(propagation.Status.Conditions) > 0 {
+		oldCondition := propagation.Status.Conditions[0]
+		if oldCondition.Status == readyStatus && oldCondition.Reason == reason && oldCondition.Message == message {
+			newStatus.Conditions[0].LastTransitionTime = oldCondition.LastTransitionTime
+		}
+	}
+	if apiequality.Semantic.DeepEqual(propagation.Status, newStatus) {
+		return nil
+	}
+	propagation.Status = newStatus
+	if err := r.Client.Status().Update(ctx, propagation); err != nil {
+		klog.ErrorS(err, "Failed to update ResourcePropagation status", "resourcepropagation", klog.KObj(propagation))
+		return err
+	}
+	return nil
+}
+
+// resourceImportMapFunc maps a ResourceImport to the ResourcePropagation which owns the
+// ResourceExport of the same resource.
+func (r *ResourcePropagationReconciler) resourceImportMapFunc(obj client.Object) []reconcile.Request {
+	resImport := obj.(*mcv1alpha1.ResourceImport)
+	if resImport.Spec.Raw == nil {
+		return nil
+	}
+	resExportList := &mcv1alpha1.ResourceExportList{}
+	if err := r.Client.List(context.TODO(), resExportList, client.InNamespace(resImport.Namespace),
+		client.MatchingLabels{
+			constants.SourceName:      resImport.Spec.Name,
+			constants.SourceNamespace: resImport.Spec.Namespace,
+			constants.SourceKind:      resImport.Spec.Kind,
+		}); err != nil {
+		klog.ErrorS(err, "Failed to list ResourceExports", "resourceimport", klog.KObj(resImport))
+		return nil
+	}
+	var requests []reconcile.Request
+	for _, resExport := range resExportList.Items {
+		owner := metav1.GetControllerOf(&resExport)
+		if owner != nil && owner.Kind == "ResourcePropagation" {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{Namespace: resExport.Namespace, Name: owner.Name},
+			})
+		}
+	}
+	return requests
+}
+
+// memberClusterAnnounceMapFunc maps a MemberClusterAnnounce to all ResourcePropagations
+// in its Namespace, as they may select the member cluster.
+func (r *ResourcePropagationReconciler) memberClusterAnnounceMapFunc(obj client.Object) []reconcile.Request {
+	propagationList := &mcv1alpha1.ResourcePropagationList{}
+	if err := r.Client.List(context.TODO(), propagationList, client.InNamespace(obj.GetNamespace())); err != nil {
+		klog.ErrorS(err, "Failed to list ResourcePropagations", "namespace", obj.GetNamespace())
+		return nil
+	}
+	requests := make([]reconcile.Request, 0, len(propagationList.Items))
+	for _, propagation := range propagationList.Items {
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{Namespace: propagation.Namespace, Name: propagation.Name},
+		})
+	}
+	return requests
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *ResourcePropagationReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	// MemberClusterAnnounces are updated periodically by the member clusters, while only
+	// the creation, deletion and label changes affect the selected member clusters.
+	memberAnnouncePredicate := predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			return !labels.Equals(e.ObjectOld.GetLabels(), e.ObjectNew.GetLabels())
+		},
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&mcv1alpha1.ResourcePropagation{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Owns(&mcv1alpha1.ResourceExport{}).
+		Watches(&source.Kind{Type: &mcv1alpha1.ResourceImport{}},
+			handler.EnqueueRequestsFromMapFunc(r.resourceImportMapFunc)).
+		Watches(&source.Kind{Type: &mcv1alpha1.MemberClusterAnnounce{}},
+			handler.EnqueueRequestsFromMapFunc(r.memberClusterAnnounceMapFunc),
+			builder.WithPredicates(memberAnnouncePredicate)).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: common.DefaultWorkerCount,
+		}).
+		Complete(r)
+}
@@ -0,0 +1,241 @@
+/*
+Copyright 2024 Antrea Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package leader
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	mcv1alpha1 "antrea.io/antrea/multicluster/apis/multicluster/v1alpha1"
+	"antrea.io/antrea/multicluster/controllers/multicluster/common"
+)
+
+var (
+	propagationReq = ctrl.Request{NamespacedName: types.NamespacedName{
+		Namespace: "default",
+		Name:      "app-config",
+	}}
+	propagationResExportName = types.NamespacedName{
+		Namespace: "default",
+		Name:      "app-config-propagation",
+	}
+	configMapData = []byte(`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"app-config","namespace":"app-ns",` +
+		`"resourceVersion":"100","labels":{"app":"demo"}},"data":{"key":"value"}}`)
+	propagatedConfigMapData = []byte(`{"apiVersion":"v1","data":{"key":"value"},"kind":"ConfigMap",` +
+		`"metadata":{"labels":{"app":"demo"},"name":"app-config","namespace":"app-ns"}}`)
+)
+
+func newMemberClusterAnnounce(clusterID string, labels map[string]string) *mcv1alpha1.MemberClusterAnnounce {
+	return &mcv1alpha1.MemberClusterAnnounce{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "member-announce-from-" + clusterID,
+			Labels:    labels,
+		},
+		ClusterID: clusterID,
+	}
+}
+
+func TestResourcePropagationReconciler_Reconcile(t *testing.T) {
+	memberAnnounces := []client.Object{
+		newMemberClusterAnnounce("cluster-a", map[string]string{"region": "east"}),
+		newMemberClusterAnnounce("cluster-b", map[string]string{"region": "west"}),
+		newMemberClusterAnnounce("cluster-c", map[string]string{"region": "east"}),
+	}
+	tests := []struct {
+		name                string
+		spec                mcv1alpha1.ResourcePropagationSpec
+		expectedResExport   *mcv1alpha1.RawResourceExport
+		expectedReadyStatus corev1.ConditionStatus
+		expectedReason      string
+	}{
+		{
+			name: "propagate to all member clusters",
+			spec: mcv1alpha1.ResourcePropagationSpec{
+				Resource: runtime.RawExtension{Raw: configMapData},
+			},
+			expectedResExport: &mcv1alpha1.RawResourceExport{
+				Data:           propagatedConfigMapData,
+				ConflictPolicy: mcv1alpha1.ConflictPolicySkip,
+			},
+			expectedReadyStatus: corev1.ConditionTrue,
+		},
+		{
+			name: "propagate to selected member clusters",
+			spec: mcv1alpha1.ResourcePropagationSpec{
+				Resource:        runtime.RawExtension{Raw: configMapData},
+				ClusterSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"region": "east"}},
+				ClusterIDs:      []string{"cluster-b"},
+				ConflictPolicy:  mcv1alpha1.ConflictPolicyOverwrite,
+			},
+			expectedResExport: &mcv1alpha1.RawResourceExport{
+				Data:           propagatedConfigMapData,
+				ClusterIDs:     []string{"cluster-a", "cluster-b", "cluster-c"},
+				ConflictPolicy: mcv1alpha1.ConflictPolicyOverwrite,
+			},
+			expectedReadyStatus: corev1.ConditionTrue,
+		},
+		{
+			name: "no member cluster selected",
+			spec: mcv1alpha1.ResourcePropagationSpec{
+				Resource:        runtime.RawExtension{Raw: configMapData},
+				ClusterSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"region": "south"}},
+			},
+			expectedReadyStatus: corev1.ConditionTrue,
+			expectedReason:      reasonNoClusterSelected,
+		},
+		{
+			name: "unsupported resource kind",
+			spec: mcv1alpha1.ResourcePropagationSpec{
+				Resource: runtime.RawExtension{Raw: []byte(`{"apiVersion":"v1","kind":"Pod","metadata":{"name":"pod","namespace":"default"}}`)},
+			},
+			expectedReadyStatus: corev1.ConditionFalse,
+			expectedReason:      reasonInvalidResource,
+		},
+		{
+			name: "resource in system Namespace",
+			spec: mcv1alpha1.ResourcePropagationSpec{
+				Resource: runtime.RawExtension{Raw: []byte(`{"apiVersion":"v1","kind":"Secret","metadata":{"name":"token","namespace":"kube-system"}}`)},
+			},
+			expectedReadyStatus: corev1.ConditionFalse,
+			expectedReason:      reasonInvalidResource,
+		},
+		{
+			name: "Namespaced resource without Namespace",
+			spec: mcv1alpha1.ResourcePropagationSpec{
+				Resource: runtime.RawExtension{Raw: []byte(`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"app-config"}}`)},
+			},
+			expectedReadyStatus: corev1.ConditionFalse,
+			expectedReason:      reasonInvalidResource,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			propagation := &mcv1alpha1.ResourcePropagation{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "app-config"},
+				Spec:       tt.spec,
+			}
+			fakeClient := fake.NewClientBuilder().WithScheme(common.TestScheme).
+				WithObjects(append(memberAnnounces, propagation)...).Build()
+			r := NewResourcePropagationReconciler(fakeClient, common.TestScheme)
+			_, err := r.Reconcile(common.TestCtx, propagationReq)
+			require.NoError(t, err)
+
+			resExport := &mcv1alpha1.ResourceExport{}
+			err = fakeClient.Get(common.TestCtx, propagationResExportName, resExport)
+			if tt.expectedResExport == nil {
+				assert.True(t, apierrors.IsNotFound(err))
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, "ConfigMap", resExport.Spec.Kind)
+				assert.Equal(t, "app-ns", resExport.Spec.Namespace)
+				assert.Equal(t, "app-config", resExport.Spec.Name)
+				assert.JSONEq(t, string(tt.expectedResExport.Data), string(resExport.Spec.Raw.Data))
+				assert.Equal(t, tt.expectedResExport.ClusterIDs, resExport.Spec.Raw.ClusterIDs)
+				assert.Equal(t, tt.expectedResExport.ConflictPolicy, resExport.Spec.Raw.ConflictPolicy)
+				assert.Equal(t, "app-config", metav1.GetControllerOf(resExport).Name)
+			}
+
+			latestPropagation := &mcv1alpha1.ResourcePropagation{}
+			require.NoError(t, fakeClient.Get(common.TestCtx, propagationReq.NamespacedName, latestPropagation))
+			require.Len(t, latestPropagation.Status.Conditions, 1)
+			assert.Equal(t, tt.expectedReadyStatus, latestPropagation.Status.Conditions[0].Status)
+			assert.Equal(t, tt.expectedReason, latestPropagation.Status.Conditions[0].Reason)
+		})
+	}
+}
+
+func TestResourcePropagationReconciler_ClusterStatuses(t *testing.T) {
+	propagation := &mcv1alpha1.ResourcePropagation{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "app-config"},
+		Spec: mcv1alpha1.ResourcePropagationSpec{
+			Resource:   runtime.RawExtension{Raw: configMapData},
+			ClusterIDs: []string{"cluster-a", "cluster-b", "cluster-c", "cluster-d"},
+		},
+	}
+	resImport := &mcv1alpha1.ResourceImport{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "app-ns-app-config-configmap"},
+		Spec: mcv1alpha1.ResourceImportSpec{
+			Kind:      "ConfigMap",
+			Namespace: "app-ns",
+			Name:      "app-config",
+			Raw:       &mcv1alpha1.RawResourceImport{Data: propagatedConfigMapData},
+		},
+		Status: mcv1alpha1.ResourceImportStatus{
+			ClusterStatuses: []mcv1alpha1.ResourceImportClusterStatus{
+				{
+					ClusterID: "cluster-a",
+					Conditions: []mcv1alpha1.ResourceImportCondition{{
+						Type:   mcv1alpha1.ResourceImportSucceeded,
+						Status: corev1.ConditionTrue,
+						Reason: string(mcv1alpha1.ResourcePropagationPropagated),
+					}},
+				},
+				{
+					ClusterID: "cluster-b",
+					Conditions: []mcv1alpha1.ResourceImportCondition{{
+						Type:    mcv1alpha1.ResourceImportSucceeded,
+						Status:  corev1.ConditionFalse,
+						Reason:  string(mcv1alpha1.ResourcePropagationConflict),
+						Message: "ConfigMap app-ns/app-config already exists in cluster cluster-b",
+					}},
+				},
+				{
+					ClusterID: "cluster-c",
+					Conditions: []mcv1alpha1.ResourceImportCondition{{
+						Type:    mcv1alpha1.ResourceImportSucceeded,
+						Status:  corev1.ConditionFalse,
+						Reason:  string(mcv1alpha1.ResourcePropagationFailed),
+						Message: "namespaces \"app-ns\" not found",
+					}},
+				},
+			},
+		},
+	}
+	fakeClient := fake.NewClientBuilder().WithScheme(common.TestScheme).
+		WithObjects(propagation, resImport).Build()
+	r := NewResourcePropagationReconciler(fakeClient, common.TestScheme)
+	_, err := r.Reconcile(common.TestCtx, propagationReq)
+	require.NoError(t, err)
+
+	latestPropagation := &mcv1alpha1.ResourcePropagation{}
+	require.NoError(t, fakeClient.Get(common.TestCtx, propagationReq.NamespacedName, latestPropagation))
+	expectedStatuses := map[string]mcv1alpha1.ResourcePropagationPhase{
+		"cluster-a": mcv1alpha1.ResourcePropagationPropagated,
+		"cluster-b": mcv1alpha1.ResourcePropagationConflict,
+		"cluster-c": mcv1alpha1.ResourcePropagationFailed,
+		"cluster-d": mcv1alpha1.ResourcePropagationPending,
+	}
+	actualStatuses := map[string]mcv1alpha1.ResourcePropagationPhase{}
+	for _, status := range latestPropagation.Status.ClusterStatuses {
+		actualStatuses[status.ClusterID] = status.Phase
+	}
+	assert.Equal(t, expectedStatuses, actualStatuses)
+
+	requests := r.resourceImportMapFunc(resImport)
+	assert.Equal(t, []ctrl.Request{propagationReq}, requests)
+}
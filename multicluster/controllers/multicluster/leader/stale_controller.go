@@ -45,8 +45,8 @@ var getResourceExportsByClusterIDFunc = getResourceExportsByClusterID
 // StaleResCleanupController will run periodically (memberClusterAnnounceStaleTime / 2 = 12 Hours)
 // to clean up stale MemberClusterAnnounce resources in the leader cluster if the MemberClusterAnnounce
 // timestamp annotation has not been updated for memberClusterAnnounceStaleTime (24 Hours).
-// It will remove all ResourceExports belong to a member cluster when the corresponding MemberClusterAnnounce
-// CR is deleted. It will also try to clean up all stale ResourceExports during start.
+// It will remove all ResourceExports belong to a member cluster and revoke the credential issued to the
+// member cluster when the corresponding MemberClusterAnnounce CR is deleted. It will also try to clean up all stale ResourceExports during start.
 type StaleResCleanupController struct {
 	client.Client
 	Scheme *runtime.Scheme
@@ -125,6 +125,12 @@ func (c *StaleResCleanupController) Reconcile(ctx context.Context, req ctrl.Requ
 	if !deleteResourceExports(ctx, c.Client, staleResExports) {
 		return ctrl.Result{}, fmt.Errorf("failed to clean up all stale ResourceExports for the member cluster %s, retry later", clusterID)
 	}
+	// Revoke the credential issued to the member cluster and its bootstrap token, so it
+	// can't access the leader cluster anymore after it leaves the ClusterSet.
+	if err := revokeMemberCredential(ctx, c.Client, req.Namespace, clusterID, memberAnnounce.BootstrapServiceAccount); err != nil {
+		klog.ErrorS(err, "Failed to revoke credential of member cluster", "clusterID", clusterID)
+		return ctrl.Result{}, err
+	}
 
 	// When cleanup is done, remove the Finalizer of this MemberClusterAnnounce.
 	finalizer := fmt.Sprintf("%s/%s", MemberClusterAnnounceFinalizer, memberAnnounce.ClusterID)
@@ -20,6 +20,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"reflect"
 	"sync"
 	"time"
 
@@ -28,6 +29,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/klog/v2"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -48,6 +51,11 @@ type leaderClusterInfo struct {
 
 var getRemoteConfigAndClient = commonarea.GetRemoteConfigAndClient
 
+// leaderSyncInterval is the interval to check the connectivity of leader clusters, fail over
+// to another leader cluster when the active one is disconnected, and mirror the local
+// cluster's ResourceExports to standby leader clusters.
+const leaderSyncInterval = 10 * time.Second
+
 // MemberClusterSetReconciler reconciles a ClusterSet object in the member cluster deployment.
 type MemberClusterSetReconciler struct {
 	client.Client
@@ -55,16 +63,25 @@ type MemberClusterSetReconciler struct {
 	namespace                string
 	clusterCalimCRDAvailable bool
 
-	// commonAreaLock protects the access to RemoteCommonArea.
+	// commonAreaLock protects the access to RemoteCommonAreas.
 	commonAreaLock       sync.RWMutex
 	commonAreaCreationCh chan struct{}
 
-	clusterSetID    common.ClusterSetID
-	clusterID       common.ClusterID
-	installedLeader leaderClusterInfo
-
+	clusterSetID     common.ClusterSetID
+	clusterID        common.ClusterID
+	installedLeaders map[common.ClusterID]leaderClusterInfo
+
+	// remoteCommonAreas includes a RemoteCommonArea for every leader cluster of the
+	// ClusterSet, keyed by the leader ClusterID.
+	remoteCommonAreas map[common.ClusterID]commonarea.RemoteCommonArea
+	// remoteCommonArea is the RemoteCommonArea of the active leader cluster, from which
+	// ResourceImports are reconciled and to which ResourceExports are written. The
+	// ResourceExports of the local cluster are mirrored to the other leader clusters, so
+	// that all leaders compute the same ResourceImports.
 	remoteCommonArea             commonarea.RemoteCommonArea
 	enableStretchedNetworkPolicy bool
+	// globalIPReconciler is nil when the member cluster has no global Pod CIDR.
+	globalIPReconciler *GlobalIPReconciler
 }
 
 func NewMemberClusterSetReconciler(client client.Client,
@@ -73,6 +90,7 @@ func NewMemberClusterSetReconciler(client client.Client,
 	enableStretchedNetworkPolicy bool,
 	clusterCalimCRDAvailable bool,
 	commonAreaCreationCh chan struct{},
+	globalIPReconciler *GlobalIPReconciler,
 ) *MemberClusterSetReconciler {
 	return &MemberClusterSetReconciler{
 		Client:                       client,
@@ -81,8 +99,11 @@ func NewMemberClusterSetReconciler(client client.Client,
 		enableStretchedNetworkPolicy: enableStretchedNetworkPolicy,
 		clusterCalimCRDAvailable:     clusterCalimCRDAvailable,
 		commonAreaCreationCh:         commonAreaCreationCh,
+		globalIPReconciler:           globalIPReconciler,
 		clusterID:                    common.InvalidClusterID,
 		clusterSetID:                 common.InvalidClusterSetID,
+		installedLeaders:             map[common.ClusterID]leaderClusterInfo{},
+		remoteCommonAreas:            map[common.ClusterID]commonarea.RemoteCommonArea{},
 	}
 }
 
@@ -123,11 +144,8 @@ func (r *MemberClusterSetReconciler) Reconcile(ctx context.Context, req ctrl.Req
 
 		// Handle create or update
 
-		newLeader := clusterSet.Spec.Leaders[0]
-
 		clusterSetCreated = r.clusterID != common.ClusterID(clusterSet.Spec.ClusterID) || r.clusterSetID != common.ClusterSetID(clusterSet.Name)
-		leaderChanged := r.installedLeader.clusterID != newLeader.ClusterID || r.installedLeader.serverUrl != newLeader.Server ||
-			r.installedLeader.secretName != newLeader.Secret
+		leaderChanged := !reflect.DeepEqual(r.installedLeaders, getLeaderClusterInfos(clusterSet))
 
 		if !leaderChanged && !clusterSetCreated {
 			klog.V(2).InfoS("No change for leader cluster configuration")
@@ -160,7 +178,7 @@ func (r *MemberClusterSetReconciler) Reconcile(ctx context.Context, req ctrl.Req
 				}
 			}
 		}
-		return r.createRemoteCommonArea(clusterSet)
+		return r.syncRemoteCommonAreas(ctx, clusterSet)
 	}
 
 	if err := processClusterSet(); err != nil {
@@ -181,17 +199,10 @@ func (r *MemberClusterSetReconciler) Reconcile(ctx context.Context, req ctrl.Req
 }
 
 func (r *MemberClusterSetReconciler) cleanUpResources(ctx context.Context) error {
-	if r.remoteCommonArea != nil {
-		// Any ResourceExports belong to this member cluster will be cleaned up by the leader cluster
-		// when the MemberClusterAnnounce is deleted.
-		if err := r.deleteMemberClusterAnnounce(ctx); err != nil {
-			// MemberClusterAnnounce could be kept in the leader cluster, if antrea-mc-controller crashes after the failure.
-			// Leader cluster will delete the stale MemberClusterAnnounce with a garbage collection mechanism in this case.
-			return fmt.Errorf("failed to delete MemberClusterAnnounce in the leader cluster: %v", err)
+	for leaderID := range r.remoteCommonAreas {
+		if err := r.removeRemoteCommonArea(ctx, leaderID); err != nil {
+			return err
 		}
-		r.remoteCommonArea.Stop()
-		r.remoteCommonArea = nil
-		r.installedLeader = leaderClusterInfo{}
 	}
 
 	if r.clusterID != common.InvalidClusterID {
@@ -205,14 +216,42 @@ func (r *MemberClusterSetReconciler) cleanUpResources(ctx context.Context) error
 	return nil
 }
 
-func (r *MemberClusterSetReconciler) deleteMemberClusterAnnounce(ctx context.Context) error {
+// removeRemoteCommonArea deletes the MemberClusterAnnounce of the local cluster from the given
+// leader cluster and stops the RemoteCommonArea of the leader.
+func (r *MemberClusterSetReconciler) removeRemoteCommonArea(ctx context.Context, leaderID common.ClusterID) error {
+	remoteCommonArea := r.remoteCommonAreas[leaderID]
+	// Any ResourceExports belong to this member cluster will be cleaned up by the leader cluster
+	// when the MemberClusterAnnounce is deleted.
+	if err := deleteMemberClusterAnnounce(ctx, remoteCommonArea); err != nil {
+		// MemberClusterAnnounce could be kept in the leader cluster, if antrea-mc-controller crashes after the failure.
+		// Leader cluster will delete the stale MemberClusterAnnounce with a garbage collection mechanism in this case.
+		return fmt.Errorf("failed to delete MemberClusterAnnounce in the leader cluster %s: %v", leaderID, err)
+	}
+	r.stopRemoteCommonArea(leaderID)
+	return nil
+}
+
+// stopRemoteCommonArea stops the RemoteCommonArea of the given leader cluster and forgets it,
+// so that a new RemoteCommonArea will be created for the leader in the next sync if the leader
+// is still in the ClusterSet.
+func (r *MemberClusterSetReconciler) stopRemoteCommonArea(leaderID common.ClusterID) {
+	remoteCommonArea := r.remoteCommonAreas[leaderID]
+	remoteCommonArea.Stop()
+	if r.remoteCommonArea == remoteCommonArea {
+		r.remoteCommonArea = nil
+	}
+	delete(r.remoteCommonAreas, leaderID)
+	delete(r.installedLeaders, leaderID)
+}
+
+func deleteMemberClusterAnnounce(ctx context.Context, remoteCommonArea commonarea.RemoteCommonArea) error {
 	memberClusterAnnounce := &mcv1alpha1.MemberClusterAnnounce{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      "member-announce-from-" + r.remoteCommonArea.GetLocalClusterID(),
-			Namespace: r.remoteCommonArea.GetNamespace(),
+			Name:      "member-announce-from-" + remoteCommonArea.GetLocalClusterID(),
+			Namespace: remoteCommonArea.GetNamespace(),
 		},
 	}
-	if err := r.remoteCommonArea.Delete(ctx, memberClusterAnnounce, &client.DeleteOptions{}); err != nil {
+	if err := remoteCommonArea.Delete(ctx, memberClusterAnnounce, &client.DeleteOptions{}); err != nil {
 		return client.IgnoreNotFound(err)
 	}
 	return nil
@@ -227,6 +266,7 @@ func (r *MemberClusterSetReconciler) SetupWithManager(mgr ctrl.Manager) error {
 			r.updateStatus()
 		}
 	}()
+	go wait.Until(r.syncLeaders, leaderSyncInterval, wait.NeverStop)
 
 	// Ignore status update event via GenerationChangedPredicate
 	generationPredicate := predicate.GenerationChangedPredicate{}
@@ -239,69 +279,195 @@ func (r *MemberClusterSetReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		Complete(r)
 }
 
-func (r *MemberClusterSetReconciler) createRemoteCommonArea(clusterSet *mcv1alpha2.ClusterSet) error {
-	if r.remoteCommonArea != nil {
-		r.remoteCommonArea.Stop()
-		r.remoteCommonArea = nil
+func getLeaderClusterInfos(clusterSet *mcv1alpha2.ClusterSet) map[common.ClusterID]leaderClusterInfo {
+	leaders := make(map[common.ClusterID]leaderClusterInfo, len(clusterSet.Spec.Leaders))
+	for _, leader := range clusterSet.Spec.Leaders {
+		leaders[common.ClusterID(leader.ClusterID)] = leaderClusterInfo{
+			clusterID:  leader.ClusterID,
+			serverUrl:  leader.Server,
+			secretName: leader.Secret,
+		}
+	}
+	return leaders
+}
+
+// syncRemoteCommonAreas makes sure there is a RemoteCommonArea for every leader cluster of the
+// ClusterSet, and selects the active leader cluster. When the active leader cluster is
+// disconnected and another leader cluster is connected, it fails over to the connected one.
+func (r *MemberClusterSetReconciler) syncRemoteCommonAreas(ctx context.Context, clusterSet *mcv1alpha2.ClusterSet) error {
+	leaders := getLeaderClusterInfos(clusterSet)
+	for leaderID, installedLeader := range r.installedLeaders {
+		leader, ok := leaders[leaderID]
+		if !ok {
+			klog.InfoS("Leader cluster is removed from ClusterSet", "cluster", leaderID)
+			if err := r.removeRemoteCommonArea(ctx, leaderID); err != nil {
+				return err
+			}
+		} else if leader != installedLeader {
+			klog.InfoS("Leader cluster configuration is changed", "cluster", leaderID)
+			r.stopRemoteCommonArea(leaderID)
+		}
+	}
+
+	if r.remoteCommonArea != nil && !r.remoteCommonArea.IsConnected() && r.getConnectedLeader(clusterSet) != nil {
+		// The Manager of a RemoteCommonArea cannot be started again once it is stopped, so a new
+		// RemoteCommonArea will be created for the disconnected leader cluster, and it will be a
+		// standby leader when it is connected again.
+		klog.InfoS("Active leader cluster is disconnected, failing over to another leader cluster", "cluster", r.remoteCommonArea.GetClusterID())
+		r.stopRemoteCommonArea(r.remoteCommonArea.GetClusterID())
+	}
+
+	var errs []error
+	for _, leader := range clusterSet.Spec.Leaders {
+		leaderID := common.ClusterID(leader.ClusterID)
+		if _, ok := r.remoteCommonAreas[leaderID]; ok {
+			continue
+		}
+		remoteCommonArea, err := r.createRemoteCommonArea(clusterSet, leaders[leaderID])
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		r.remoteCommonAreas[leaderID] = remoteCommonArea
+		r.installedLeaders[leaderID] = leaders[leaderID]
+	}
+	if len(r.remoteCommonAreas) == 0 {
+		return utilerrors.NewAggregate(errs)
+	}
+	if len(errs) > 0 {
+		// Some leader clusters are available, RemoteCommonAreas of other leaders will be
+		// created in the next leader sync.
+		klog.ErrorS(utilerrors.NewAggregate(errs), "Failed to create RemoteCommonArea for some leader clusters")
+	}
+
+	if r.remoteCommonArea == nil {
+		if activeLeader := r.getConnectedLeader(clusterSet); activeLeader != nil {
+			klog.InfoS("Selected active leader cluster", "cluster", activeLeader.GetClusterID())
+			if err := activeLeader.StartWatching(); err != nil {
+				return err
+			}
+			r.remoteCommonArea = activeLeader
+		}
 	}
+	return nil
+}
 
-	newLeader := clusterSet.Spec.Leaders[0]
-	clusterID := common.ClusterID(newLeader.ClusterID)
-	url := newLeader.Server
-	secretName := newLeader.Secret
+// getConnectedLeader returns the RemoteCommonArea of the first connected leader cluster in the
+// order of the ClusterSet leaders, excluding the current active leader.
+func (r *MemberClusterSetReconciler) getConnectedLeader(clusterSet *mcv1alpha2.ClusterSet) commonarea.RemoteCommonArea {
+	for _, leader := range clusterSet.Spec.Leaders {
+		remoteCommonArea, ok := r.remoteCommonAreas[common.ClusterID(leader.ClusterID)]
+		if ok && remoteCommonArea != r.remoteCommonArea && remoteCommonArea.IsConnected() {
+			return remoteCommonArea
+		}
+	}
+	return nil
+}
+
+// syncLeaders runs periodically to sync RemoteCommonAreas with the leader clusters of the
+// ClusterSet, and mirrors the local cluster's ResourceExports from the active leader to the
+// connected standby leaders.
+func (r *MemberClusterSetReconciler) syncLeaders() {
+	if r.clusterID == common.InvalidClusterID {
+		// Nothing to do.
+		return
+	}
+	ctx := context.TODO()
+	namespacedName := types.NamespacedName{
+		Namespace: r.namespace,
+		Name:      string(r.clusterSetID),
+	}
+	clusterSet := &mcv1alpha2.ClusterSet{}
+	if err := r.Get(ctx, namespacedName, clusterSet); err != nil {
+		if !apierrors.IsNotFound(err) {
+			klog.ErrorS(err, "Failed to get ClusterSet", "name", namespacedName)
+		}
+		return
+	}
+
+	r.commonAreaLock.Lock()
+	if r.clusterSetID != common.ClusterSetID(clusterSet.Name) {
+		r.commonAreaLock.Unlock()
+		return
+	}
+	if err := r.syncRemoteCommonAreas(ctx, clusterSet); err != nil {
+		klog.ErrorS(err, "Failed to sync RemoteCommonAreas with leader clusters", "clusterset", namespacedName)
+	}
+	activeLeader := r.remoteCommonArea
+	var standbyLeaders []commonarea.RemoteCommonArea
+	for _, remoteCommonArea := range r.remoteCommonAreas {
+		if remoteCommonArea != activeLeader && remoteCommonArea.IsConnected() {
+			standbyLeaders = append(standbyLeaders, remoteCommonArea)
+		}
+	}
+	localClusterID := string(r.clusterID)
+	r.commonAreaLock.Unlock()
+
+	if activeLeader == nil || !activeLeader.IsConnected() {
+		return
+	}
+	for _, standbyLeader := range standbyLeaders {
+		if err := mirrorResourceExports(ctx, localClusterID, activeLeader, standbyLeader); err != nil {
+			klog.ErrorS(err, "Failed to mirror ResourceExports to standby leader cluster", "cluster", standbyLeader.GetClusterID())
+		}
+	}
+}
+
+func (r *MemberClusterSetReconciler) createRemoteCommonArea(clusterSet *mcv1alpha2.ClusterSet, leader leaderClusterInfo) (commonarea.RemoteCommonArea, error) {
+	clusterID := common.ClusterID(leader.clusterID)
+	url := leader.serverUrl
+	secretName := leader.secretName
 
 	klog.InfoS("Creating RemoteCommonArea", "cluster", clusterID)
 	// Read Secret to access the leader cluster. Assume Secret is present in the same Namespace as the ClusterSet.
 	secret, err := r.getSecretForLeader(secretName, clusterSet.GetNamespace())
 	if err != nil {
 		klog.ErrorS(err, "Failed to get Secret to create RemoteCommonArea", "secret", secretName, "cluster", clusterID)
-		return err
+		return nil, err
 	}
 
-	config, remoteCommonAreaMgr, remoteClient, err := getRemoteConfigAndClient(secret, url, clusterID, clusterSet, r.scheme)
+	credential, err := commonarea.NewMemberCredential(secret)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	config, remoteCommonAreaMgr, remoteClient, err := getRemoteConfigAndClient(secret, credential, url, clusterID, clusterSet, r.scheme)
+	if err != nil {
+		return nil, err
 	}
 
 	remoteNamespace := clusterSet.Spec.Namespace
-	r.remoteCommonArea, err = commonarea.NewRemoteCommonArea(clusterID, r.clusterSetID, r.clusterID,
+	remoteCommonArea, err := commonarea.NewRemoteCommonArea(clusterID, r.clusterSetID, r.clusterID,
 		remoteCommonAreaMgr, remoteClient, r.scheme, r.Client, remoteNamespace, r.namespace,
-		config, r.enableStretchedNetworkPolicy)
+		config, credential, r.enableStretchedNetworkPolicy)
 	if err != nil {
 		klog.ErrorS(err, "Unable to create RemoteCommonArea", "cluster", clusterID)
-		return err
+		return nil, err
 	}
 
 	// Create import reconcilers and add them to RemoteCommonArea (to be started with
-	// RemoteCommonArea.StartWatching).
+	// RemoteCommonArea.StartWatching when the leader cluster is selected as the active leader).
 	resImportReconciler := newResourceImportReconciler(
 		r.Client,
 		string(r.clusterID),
 		r.namespace,
-		r.remoteCommonArea,
+		remoteCommonArea,
+		r.globalIPReconciler,
 	)
-	r.remoteCommonArea.AddImportReconciler(resImportReconciler)
+	remoteCommonArea.AddImportReconciler(resImportReconciler)
 
 	if r.enableStretchedNetworkPolicy {
 		labelIdentityImpReconciler := newLabelIdentityResourceImportReconciler(
 			r.Client,
 			string(clusterID),
 			remoteNamespace,
-			r.remoteCommonArea,
+			remoteCommonArea,
 		)
-		r.remoteCommonArea.AddImportReconciler(labelIdentityImpReconciler)
+		remoteCommonArea.AddImportReconciler(labelIdentityImpReconciler)
 	}
 
-	r.remoteCommonArea.Start()
+	remoteCommonArea.Start()
 	klog.InfoS("Created RemoteCommonArea", "cluster", clusterID)
-
-	r.installedLeader = leaderClusterInfo{
-		clusterID:  newLeader.ClusterID,
-		serverUrl:  newLeader.Server,
-		secretName: newLeader.Secret,
-	}
-
-	return nil
+	return remoteCommonArea, nil
 }
 
 // getSecretForLeader returns the Secret associated with this local cluster(which is a member)
@@ -347,11 +513,25 @@ func (r *MemberClusterSetReconciler) updateStatus() {
 	status.ObservedGeneration = clusterSet.Generation
 	status.ClusterStatuses = []mcv1alpha2.ClusterStatus{}
 	r.commonAreaLock.RLock()
-	if r.remoteCommonArea != nil {
+	for _, leader := range clusterSet.Spec.Leaders {
+		remoteCommonArea, ok := r.remoteCommonAreas[common.ClusterID(leader.ClusterID)]
+		if !ok {
+			continue
+		}
+		conditions := remoteCommonArea.GetStatus()
+		if remoteCommonArea != r.remoteCommonArea {
+			for i := range conditions {
+				if conditions[i].Type == mcv1alpha2.ClusterIsLeader && conditions[i].Status == v1.ConditionTrue {
+					conditions[i].Status = v1.ConditionFalse
+					conditions[i].Message = "This leader cluster is a standby leader for local cluster"
+					conditions[i].Reason = "StandbyLeader"
+				}
+			}
+		}
 		status.ClusterStatuses = append(status.ClusterStatuses,
 			mcv1alpha2.ClusterStatus{
-				ClusterID:  string(r.remoteCommonArea.GetClusterID()),
-				Conditions: r.remoteCommonArea.GetStatus(),
+				ClusterID:  leader.ClusterID,
+				Conditions: conditions,
 			},
 		)
 	}
@@ -396,6 +576,17 @@ func (r *MemberClusterSetReconciler) updateStatus() {
 		overallCondition.LastTransitionTime = metav1.Now()
 		overallCondition.Message = "Disconnected from leader"
 	}
+	peerGateways, err := common.GetPeerGatewayStatuses(context.TODO(), r.Client, r.namespace)
+	if err != nil {
+		klog.ErrorS(err, "Failed to get connectivity to peer Gateways")
+	} else if len(peerGateways) > 0 {
+		status.Connectivity = []mcv1alpha2.ClusterConnectivity{
+			{
+				ClusterID:    string(r.clusterID),
+				PeerGateways: peerGateways,
+			},
+		}
+	}
 	// The total cluster should always be 1 to include the member cluster itself.
 	status.TotalClusters = 1
 	status.ReadyClusters = int32(readyClusters)
@@ -414,16 +605,24 @@ func (r *MemberClusterSetReconciler) updateStatus() {
 
 // SetRemoteCommonArea is for testing only
 func (r *MemberClusterSetReconciler) SetRemoteCommonArea(commanArea commonarea.RemoteCommonArea) commonarea.RemoteCommonArea {
+	if r.remoteCommonAreas == nil {
+		r.remoteCommonAreas = map[common.ClusterID]commonarea.RemoteCommonArea{}
+	}
+	r.remoteCommonAreas[commanArea.GetClusterID()] = commanArea
 	r.remoteCommonArea = commanArea
 	return r.remoteCommonArea
 }
 
+// GetRemoteCommonAreaAndLocalID returns the RemoteCommonArea of the active leader cluster.
 func (r *MemberClusterSetReconciler) GetRemoteCommonAreaAndLocalID() (commonarea.RemoteCommonArea, string, error) {
 	r.commonAreaLock.RLock()
 	defer r.commonAreaLock.RUnlock()
-	if r.remoteCommonArea == nil {
+	if len(r.remoteCommonAreas) == 0 {
 		return nil, "", errors.New("ClusterSet has not been initialized, no available Common Area")
 	}
+	if r.remoteCommonArea == nil {
+		return nil, "", errors.New("no active leader cluster, no available Common Area")
+	}
 
 	if r.remoteCommonArea.IsConnected() {
 		localClusterID := string(r.remoteCommonArea.GetLocalClusterID())
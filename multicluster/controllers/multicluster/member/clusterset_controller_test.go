@@ -17,8 +17,10 @@ package member
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 	v1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -48,9 +50,11 @@ func TestMemberClusterDelete(t *testing.T) {
 	commonArea := commonarea.NewFakeRemoteCommonArea(fakeRemoteClient, "leader-cluster", common.LocalClusterID, "default", nil)
 
 	reconciler := MemberClusterSetReconciler{
-		Client:           fakeClient,
-		remoteCommonArea: commonArea,
-		clusterSetID:     common.ClusterSetID("clusterset1"),
+		Client:            fakeClient,
+		remoteCommonArea:  commonArea,
+		remoteCommonAreas: map[common.ClusterID]commonarea.RemoteCommonArea{"leader-cluster": commonArea},
+		installedLeaders:  map[common.ClusterID]leaderClusterInfo{"leader-cluster": {clusterID: "leader-cluster"}},
+		clusterSetID:      common.ClusterSetID("clusterset1"),
 	}
 
 	// Delete a different ClusterSet.
@@ -195,11 +199,12 @@ func TestMemberClusterStatus(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			commonArea := commonarea.NewFakeRemoteCommonArea(fakeRemoteClient, "leader1", common.LocalClusterID, "mcs1", tt.conditions)
 			reconciler := MemberClusterSetReconciler{
-				Client:           fakeClient,
-				remoteCommonArea: commonArea,
-				clusterSetID:     "clusterset1",
-				clusterID:        "east",
-				namespace:        "mcs1",
+				Client:            fakeClient,
+				remoteCommonArea:  commonArea,
+				remoteCommonAreas: map[common.ClusterID]commonarea.RemoteCommonArea{"leader1": commonArea},
+				clusterSetID:      "clusterset1",
+				clusterID:         "east",
+				namespace:         "mcs1",
 			}
 			reconciler.updateStatus()
 			clusterSet := &mcv1alpha2.ClusterSet{}
@@ -225,6 +230,54 @@ func TestMemberClusterStatus(t *testing.T) {
 	}
 }
 
+func TestMemberClusterStatusWithConnectivity(t *testing.T) {
+	existingClusterSet := &mcv1alpha2.ClusterSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:  "mcs1",
+			Name:       "clusterset1",
+			Generation: 1,
+		},
+		Spec: mcv1alpha2.ClusterSetSpec{
+			Leaders:   []mcv1alpha2.LeaderClusterInfo{{ClusterID: "leader1"}},
+			Namespace: "mcs1",
+		},
+	}
+	peerGateway := mcv1alpha1.PeerGatewayStatus{
+		ClusterID:            "west",
+		GatewayIP:            "172.18.0.10",
+		UnderlayConnectivity: mcv1alpha1.GatewayConnectivityHealthy,
+		UnderlayLatency:      &metav1.Duration{Duration: 2 * time.Millisecond},
+		UnderlayPathMTU:      1500,
+	}
+	gateway := &mcv1alpha1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "mcs1", Name: "node-1"},
+		GatewayIP:  "172.17.0.10",
+		InternalIP: "192.168.0.10",
+		Status:     mcv1alpha1.GatewayStatus{PeerGateways: []mcv1alpha1.PeerGatewayStatus{peerGateway}},
+	}
+	fakeClient := fake.NewClientBuilder().WithScheme(common.TestScheme).WithObjects(existingClusterSet, gateway).Build()
+	fakeRemoteClient := fake.NewClientBuilder().WithScheme(common.TestScheme).Build()
+	commonArea := commonarea.NewFakeRemoteCommonArea(fakeRemoteClient, "leader1", common.LocalClusterID, "mcs1", nil)
+	reconciler := MemberClusterSetReconciler{
+		Client:            fakeClient,
+		remoteCommonArea:  commonArea,
+		remoteCommonAreas: map[common.ClusterID]commonarea.RemoteCommonArea{"leader1": commonArea},
+		clusterSetID:      "clusterset1",
+		clusterID:         "east",
+		namespace:         "mcs1",
+	}
+	reconciler.updateStatus()
+
+	clusterSet := &mcv1alpha2.ClusterSet{}
+	require.NoError(t, fakeClient.Get(context.TODO(), types.NamespacedName{Name: "clusterset1", Namespace: "mcs1"}, clusterSet))
+	assert.Equal(t, []mcv1alpha2.ClusterConnectivity{
+		{
+			ClusterID:    "east",
+			PeerGateways: []mcv1alpha1.PeerGatewayStatus{peerGateway},
+		},
+	}, clusterSet.Status.Connectivity)
+}
+
 func TestMemberCreateOrUpdateRemoteCommonArea(t *testing.T) {
 	existingSecret := &v1.Secret{
 		ObjectMeta: metav1.ObjectMeta{
@@ -246,6 +299,10 @@ func TestMemberCreateOrUpdateRemoteCommonArea(t *testing.T) {
 				{
 					ClusterID: "leader1",
 					Secret:    "membertoken",
+				},
+				{
+					ClusterID: "leader2",
+					Secret:    "membertoken",
 				}},
 			Namespace: "mcs1",
 		},
@@ -253,16 +310,24 @@ func TestMemberCreateOrUpdateRemoteCommonArea(t *testing.T) {
 			ObservedGeneration: 1,
 		},
 	}
-	expectedInstalledLeader := leaderClusterInfo{
-		clusterID:  "leader1",
-		secretName: "membertoken",
+	expectedInstalledLeaders := map[common.ClusterID]leaderClusterInfo{
+		"leader1": {
+			clusterID:  "leader1",
+			secretName: "membertoken",
+		},
+		"leader2": {
+			clusterID:  "leader2",
+			secretName: "membertoken",
+		},
 	}
 	fakeClient := fake.NewClientBuilder().WithScheme(common.TestScheme).WithObjects(existingClusterSet, existingSecret).Build()
 	fakeRemoteClient := fake.NewClientBuilder().WithScheme(common.TestScheme).WithObjects(existingClusterSet, existingSecret).Build()
-	commonArea := commonarea.NewFakeRemoteCommonArea(fakeRemoteClient, "leader1", common.LocalClusterID, "mcs1", nil)
+	commonArea := commonarea.NewFakeRemoteCommonArea(fakeRemoteClient, "leader0", common.LocalClusterID, "mcs1", nil)
 	reconciler := MemberClusterSetReconciler{
 		Client:                       fakeClient,
 		remoteCommonArea:             commonArea,
+		remoteCommonAreas:            map[common.ClusterID]commonarea.RemoteCommonArea{"leader0": commonArea},
+		installedLeaders:             map[common.ClusterID]leaderClusterInfo{"leader0": {clusterID: "leader0"}},
 		clusterSetID:                 "clusterset1",
 		clusterID:                    "east",
 		enableStretchedNetworkPolicy: true,
@@ -271,9 +336,139 @@ func TestMemberCreateOrUpdateRemoteCommonArea(t *testing.T) {
 	mockManager := mocks.NewMockManager(mockCtrl)
 	getRemoteConfigAndClient = commonarea.FuncGetFakeRemoteConfigAndClient(mockManager)
 
-	err := reconciler.createRemoteCommonArea(existingClusterSet)
+	err := reconciler.syncRemoteCommonAreas(common.TestCtx, existingClusterSet)
 	assert.Equal(t, nil, err)
-	assert.Equal(t, expectedInstalledLeader, reconciler.installedLeader)
+	assert.Equal(t, expectedInstalledLeaders, reconciler.installedLeaders)
+	assert.Len(t, reconciler.remoteCommonAreas, 2)
+	assert.Contains(t, reconciler.remoteCommonAreas, common.ClusterID("leader1"))
+	assert.Contains(t, reconciler.remoteCommonAreas, common.ClusterID("leader2"))
+	// The new leaders are not connected yet, so there is no active leader.
+	assert.Nil(t, reconciler.remoteCommonArea)
+}
+
+// fakeLeaderCommonArea is a RemoteCommonArea with configurable connectivity, which records
+// whether it is stopped or watching ResourceImports.
+type fakeLeaderCommonArea struct {
+	commonarea.RemoteCommonArea
+	connected bool
+	stopped   bool
+	watching  bool
+}
+
+func newFakeLeaderCommonArea(clusterID string, connected bool) *fakeLeaderCommonArea {
+	fakeRemoteClient := fake.NewClientBuilder().WithScheme(common.TestScheme).Build()
+	return &fakeLeaderCommonArea{
+		RemoteCommonArea: commonarea.NewFakeRemoteCommonArea(fakeRemoteClient, clusterID, common.LocalClusterID, "mcs1", nil),
+		connected:        connected,
+	}
+}
+
+func (c *fakeLeaderCommonArea) IsConnected() bool {
+	return c.connected
+}
+
+func (c *fakeLeaderCommonArea) Stop() {
+	c.stopped = true
+}
+
+func (c *fakeLeaderCommonArea) StartWatching() error {
+	c.watching = true
+	return nil
+}
+
+func TestMemberLeaderFailover(t *testing.T) {
+	existingSecret := &v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "mcs1",
+			Name:      "membertoken",
+		},
+		Data: map[string][]byte{
+			"ca.crt": []byte(`12345`),
+			"token":  []byte(`12345`)},
+	}
+	existingClusterSet := &mcv1alpha2.ClusterSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "mcs1",
+			Name:      "clusterset1",
+		},
+		Spec: mcv1alpha2.ClusterSetSpec{
+			Leaders: []mcv1alpha2.LeaderClusterInfo{
+				{ClusterID: "leader1", Secret: "membertoken"},
+				{ClusterID: "leader2", Secret: "membertoken"},
+			},
+			Namespace: "mcs1",
+		},
+	}
+	installedLeaders := map[common.ClusterID]leaderClusterInfo{
+		"leader1": {clusterID: "leader1", secretName: "membertoken"},
+		"leader2": {clusterID: "leader2", secretName: "membertoken"},
+	}
+
+	tests := []struct {
+		name             string
+		leader1Connected bool
+		leader2Connected bool
+		expectedActive   common.ClusterID
+		expectedFailover bool
+	}{
+		{
+			name:             "active leader is connected",
+			leader1Connected: true,
+			leader2Connected: true,
+			expectedActive:   "leader1",
+		},
+		{
+			name:             "fail over to the connected standby leader",
+			leader1Connected: false,
+			leader2Connected: true,
+			expectedActive:   "leader2",
+			expectedFailover: true,
+		},
+		{
+			name:             "keep the disconnected active leader when no other leader is connected",
+			leader1Connected: false,
+			leader2Connected: false,
+			expectedActive:   "leader1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeClient := fake.NewClientBuilder().WithScheme(common.TestScheme).WithObjects(existingClusterSet, existingSecret).Build()
+			mockCtrl := gomock.NewController(t)
+			mockManager := mocks.NewMockManager(mockCtrl)
+			getRemoteConfigAndClient = commonarea.FuncGetFakeRemoteConfigAndClient(mockManager)
+
+			leader1 := newFakeLeaderCommonArea("leader1", tt.leader1Connected)
+			leader2 := newFakeLeaderCommonArea("leader2", tt.leader2Connected)
+
+			reconciler := MemberClusterSetReconciler{
+				Client:           fakeClient,
+				scheme:           common.TestScheme,
+				clusterSetID:     "clusterset1",
+				clusterID:        "east",
+				installedLeaders: installedLeaders,
+				remoteCommonArea: leader1,
+				remoteCommonAreas: map[common.ClusterID]commonarea.RemoteCommonArea{
+					"leader1": leader1,
+					"leader2": leader2,
+				},
+			}
+			err := reconciler.syncRemoteCommonAreas(common.TestCtx, existingClusterSet)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedActive, reconciler.remoteCommonArea.GetClusterID())
+			assert.Equal(t, installedLeaders, reconciler.installedLeaders)
+			assert.Len(t, reconciler.remoteCommonAreas, 2)
+			assert.Equal(t, tt.expectedFailover, leader1.stopped)
+			assert.Equal(t, tt.expectedFailover, leader2.watching)
+			if tt.expectedFailover {
+				// A new RemoteCommonArea is created for the previous active leader.
+				assert.NotSame(t, leader1, reconciler.remoteCommonAreas["leader1"])
+			} else {
+				assert.Same(t, leader1, reconciler.remoteCommonAreas["leader1"])
+			}
+			assert.Same(t, leader2, reconciler.remoteCommonAreas["leader2"])
+		})
+	}
 }
 
 func TestMemberClusterSetAddWithoutClusterID(t *testing.T) {
@@ -333,6 +528,8 @@ func TestMemberClusterSetAddWithoutClusterID(t *testing.T) {
 				Client:                   fakeClient,
 				clusterCalimCRDAvailable: true,
 				commonAreaCreationCh:     make(chan struct{}),
+				installedLeaders:         map[common.ClusterID]leaderClusterInfo{},
+				remoteCommonAreas:        map[common.ClusterID]commonarea.RemoteCommonArea{},
 			}
 			go func() {
 				<-reconciler.commonAreaCreationCh
@@ -340,7 +537,7 @@ func TestMemberClusterSetAddWithoutClusterID(t *testing.T) {
 			if tt.clusterID != "" {
 				fakeRemoteClient := fake.NewClientBuilder().WithScheme(common.TestScheme).WithObjects().Build()
 				commonArea := commonarea.NewFakeRemoteCommonArea(fakeRemoteClient, "leader1", "clusterset1", "mcs1", nil)
-				reconciler.remoteCommonArea = commonArea
+				reconciler.SetRemoteCommonArea(commonArea)
 				reconciler.clusterID = tt.clusterID
 			}
 
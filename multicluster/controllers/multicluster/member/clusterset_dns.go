@@ -0,0 +1,183 @@
+/*
+Copyright 2024 Antrea Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package member
+
+import (
+	"context"
+	"net"
+
+	"github.com/miekg/dns"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	k8smcsv1alpha1 "sigs.k8s.io/mcs-api/pkg/apis/v1alpha1"
+)
+
+const (
+	// clusterSetDomain is the domain of the ClusterSet-wide Service names
+	// `<service>.<namespace>.svc.clusterset.local` defined by the Multi-Cluster
+	// Services API.
+	clusterSetDomain = "clusterset.local."
+	// clusterSetDNSTTL is the TTL in seconds of the DNS records. It's short so that
+	// clients don't cache the records long after the ServiceImports are changed.
+	clusterSetDNSTTL = 5
+)
+
+// ClusterSetDNSServer is for member cluster only. It answers the DNS queries for
+// the ClusterSet-wide names of imported Services with the ClusterSetIPs of the
+// ServiceImports, which are the ClusterIPs of the `antrea-mc-` prefixed multi-cluster
+// Services in the local cluster. ServiceImports are read from the cache of the
+// controller manager, so the answers track ServiceImport changes.
+type ClusterSetDNSServer struct {
+	client      client.Client
+	bindAddress string
+}
+
+// NewClusterSetDNSServer creates a ClusterSetDNSServer which serves DNS over UDP and
+// TCP on bindAddress, e.g. ":5353".
+func NewClusterSetDNSServer(client client.Client, bindAddress string) *ClusterSetDNSServer {
+	return &ClusterSetDNSServer{
+		client:      client,
+		bindAddress: bindAddress,
+	}
+}
+
+// Start implements manager.Runnable. It serves DNS until the context is done.
+func (s *ClusterSetDNSServer) Start(ctx context.Context) error {
+	packetConn, err := net.ListenPacket("udp", s.bindAddress)
+	if err != nil {
+		return err
+	}
+	listener, err := net.Listen("tcp", s.bindAddress)
+	if err != nil {
+		packetConn.Close()
+		return err
+	}
+	return s.serve(ctx, packetConn, listener)
+}
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable. Every replica of the
+// controller answers DNS queries.
+func (s *ClusterSetDNSServer) NeedLeaderElection() bool {
+	return false
+}
+
+func (s *ClusterSetDNSServer) serve(ctx context.Context, packetConn net.PacketConn, listener net.Listener) error {
+	servers := []*dns.Server{
+		{PacketConn: packetConn, Handler: s},
+		{Listener: listener, Handler: s},
+	}
+	errCh := make(chan error, len(servers))
+	startedCh := make(chan struct{}, len(servers))
+	for _, server := range servers {
+		server.NotifyStartedFunc = func() { startedCh <- struct{}{} }
+		go func(server *dns.Server) {
+			errCh <- server.ActivateAndServe()
+		}(server)
+	}
+	// Wait for the servers to start, as a server can't be shut down before it starts.
+	for range servers {
+		select {
+		case <-startedCh:
+		case err := <-errCh:
+			return err
+		}
+	}
+	klog.InfoS("Started ClusterSet DNS server", "address", s.bindAddress)
+
+	var err error
+	select {
+	case <-ctx.Done():
+	case err = <-errCh:
+		klog.ErrorS(err, "ClusterSet DNS server stopped unexpectedly")
+	}
+	for _, server := range servers {
+		server.Shutdown()
+	}
+	return err
+}
+
+// ServeDNS implements dns.Handler.
+func (s *ClusterSetDNSServer) ServeDNS(w dns.ResponseWriter, req *dns.Msg) {
+	resp := s.answer(context.TODO(), req)
+	if err := w.WriteMsg(resp); err != nil {
+		klog.ErrorS(err, "Failed to write DNS response", "client", w.RemoteAddr())
+	}
+}
+
+func (s *ClusterSetDNSServer) answer(ctx context.Context, req *dns.Msg) *dns.Msg {
+	resp := new(dns.Msg)
+	resp.SetReply(req)
+	if len(req.Question) != 1 {
+		resp.Rcode = dns.RcodeFormatError
+		return resp
+	}
+	question := req.Question[0]
+	qname := dns.CanonicalName(question.Name)
+	if !dns.IsSubDomain(clusterSetDomain, qname) {
+		resp.Rcode = dns.RcodeRefused
+		return resp
+	}
+	resp.Authoritative = true
+	svcImportName, ok := parseClusterSetServiceName(qname)
+	if !ok {
+		resp.Rcode = dns.RcodeNameError
+		return resp
+	}
+	svcImport := &k8smcsv1alpha1.ServiceImport{}
+	if err := s.client.Get(ctx, svcImportName, svcImport); err != nil {
+		if apierrors.IsNotFound(err) {
+			resp.Rcode = dns.RcodeNameError
+		} else {
+			klog.ErrorS(err, "Failed to get ServiceImport", "serviceimport", svcImportName)
+			resp.Rcode = dns.RcodeServerFailure
+		}
+		return resp
+	}
+	// Other query types get an empty answer, as only IPv4 multi-cluster Services are
+	// supported.
+	if question.Qtype != dns.TypeA && question.Qtype != dns.TypeANY {
+		return resp
+	}
+	for _, ipStr := range svcImport.Spec.IPs {
+		ip := net.ParseIP(ipStr).To4()
+		if ip == nil {
+			continue
+		}
+		resp.Answer = append(resp.Answer, &dns.A{
+			Hdr: dns.RR_Header{
+				Name:   question.Name,
+				Rrtype: dns.TypeA,
+				Class:  dns.ClassINET,
+				Ttl:    clusterSetDNSTTL,
+			},
+			A: ip,
+		})
+	}
+	return resp
+}
+
+// parseClusterSetServiceName returns the Namespace and name of the ServiceImport for a
+// canonical DNS name `<service>.<namespace>.svc.clusterset.local.`.
+func parseClusterSetServiceName(qname string) (types.NamespacedName, bool) {
+	labels := dns.SplitDomainName(qname)
+	if len(labels) != 5 || labels[2] != "svc" {
+		return types.NamespacedName{}, false
+	}
+	return types.NamespacedName{Namespace: labels[1], Name: labels[0]}, true
+}
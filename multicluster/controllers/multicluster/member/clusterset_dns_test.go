@@ -0,0 +1,144 @@
+/*
+Copyright 2024 Antrea Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package member
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/miekg/dns"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	k8smcsv1alpha1 "sigs.k8s.io/mcs-api/pkg/apis/v1alpha1"
+
+	"antrea.io/antrea/multicluster/controllers/multicluster/common"
+)
+
+func TestClusterSetDNSServer(t *testing.T) {
+	svcImport := &k8smcsv1alpha1.ServiceImport{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "nginx",
+		},
+		Spec: k8smcsv1alpha1.ServiceImportSpec{
+			IPs:  []string{"10.96.10.10"},
+			Type: k8smcsv1alpha1.ClusterSetIP,
+		},
+	}
+	fakeClient := fake.NewClientBuilder().WithScheme(common.TestScheme).WithObjects(svcImport).Build()
+	server := NewClusterSetDNSServer(fakeClient, "127.0.0.1:0")
+
+	packetConn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	ctx, cancel := context.WithCancel(context.Background())
+	stoppedCh := make(chan error)
+	go func() {
+		stoppedCh <- server.serve(ctx, packetConn, listener)
+	}()
+
+	tests := []struct {
+		name            string
+		network         string
+		qname           string
+		qtype           uint16
+		expectedRcode   int
+		expectedAnswers []string
+	}{
+		{
+			name:            "A record over UDP",
+			network:         "udp",
+			qname:           "nginx.default.svc.clusterset.local.",
+			qtype:           dns.TypeA,
+			expectedRcode:   dns.RcodeSuccess,
+			expectedAnswers: []string{"nginx.default.svc.clusterset.local.\t5\tIN\tA\t10.96.10.10"},
+		},
+		{
+			name:            "A record over TCP with upper case name",
+			network:         "tcp",
+			qname:           "NGINX.default.svc.clusterset.local.",
+			qtype:           dns.TypeA,
+			expectedRcode:   dns.RcodeSuccess,
+			expectedAnswers: []string{"NGINX.default.svc.clusterset.local.\t5\tIN\tA\t10.96.10.10"},
+		},
+		{
+			name:          "AAAA record",
+			network:       "udp",
+			qname:         "nginx.default.svc.clusterset.local.",
+			qtype:         dns.TypeAAAA,
+			expectedRcode: dns.RcodeSuccess,
+		},
+		{
+			name:          "unknown ServiceImport",
+			network:       "udp",
+			qname:         "nginx.kube-system.svc.clusterset.local.",
+			qtype:         dns.TypeA,
+			expectedRcode: dns.RcodeNameError,
+		},
+		{
+			name:          "invalid name in ClusterSet domain",
+			network:       "udp",
+			qname:         "default.svc.clusterset.local.",
+			qtype:         dns.TypeA,
+			expectedRcode: dns.RcodeNameError,
+		},
+		{
+			name:          "name out of ClusterSet domain",
+			network:       "udp",
+			qname:         "nginx.default.svc.cluster.local.",
+			qtype:         dns.TypeA,
+			expectedRcode: dns.RcodeRefused,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			address := packetConn.LocalAddr().String()
+			if tt.network == "tcp" {
+				address = listener.Addr().String()
+			}
+			req := new(dns.Msg)
+			req.SetQuestion(tt.qname, tt.qtype)
+			dnsClient := &dns.Client{Net: tt.network, Timeout: 2 * time.Second}
+			var resp *dns.Msg
+			// Retry in case the server is not serving yet.
+			assert.Eventually(t, func() bool {
+				resp, _, err = dnsClient.Exchange(req, address)
+				return err == nil
+			}, 5*time.Second, 100*time.Millisecond)
+			require.NotNil(t, resp)
+			assert.Equal(t, tt.expectedRcode, resp.Rcode)
+			var answers []string
+			for _, rr := range resp.Answer {
+				answers = append(answers, rr.String())
+			}
+			assert.Equal(t, tt.expectedAnswers, answers)
+		})
+	}
+
+	cancel()
+	select {
+	case err := <-stoppedCh:
+		assert.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Errorf("ClusterSet DNS server didn't stop in time")
+	}
+}
@@ -18,6 +18,7 @@ package member
 
 import (
 	"context"
+	"sort"
 
 	v1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -30,6 +31,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 	"sigs.k8s.io/controller-runtime/pkg/source"
 
@@ -103,52 +105,60 @@ func (r *GatewayReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 		},
 	}
 
-	createOrUpdate := func(gateway *mcv1alpha1.Gateway) error {
+	createOrUpdate := func(gateways []mcv1alpha1.Gateway) error {
 		existingResExport := &mcv1alpha1.ResourceExport{}
 		err := commonArea.Get(ctx, resExportNamespacedName, existingResExport)
 		if err != nil && !apierrors.IsNotFound(err) {
 			return err
 		}
 		if apierrors.IsNotFound(err) || !existingResExport.DeletionTimestamp.IsZero() {
-			if err = r.createResourceExport(ctx, req, commonArea, gateway); err != nil {
+			if err = r.createResourceExport(ctx, req, commonArea, gateways); err != nil {
 				return err
 			}
 			return nil
 		}
 		// updateResourceExport will update latest Gateway information with the existing ResourceExport's resourceVersion.
 		// It will return an error and retry when there is a version conflict.
-		if err = r.updateResourceExport(ctx, req, commonArea, existingResExport, gateway); err != nil {
+		if err = r.updateResourceExport(ctx, req, commonArea, existingResExport, gateways); err != nil {
 			return err
 		}
 		return nil
 	}
 
-	gw := &mcv1alpha1.Gateway{}
-	if err := r.Client.Get(ctx, req.NamespacedName, gw); err != nil {
-		if !apierrors.IsNotFound(err) {
-			return ctrl.Result{}, err
+	// All Gateways in the member cluster are active, so the ClusterInfo is always
+	// generated from all existing Gateways instead of the one in the request.
+	gwList := &mcv1alpha1.GatewayList{}
+	if err := r.Client.List(ctx, gwList, &client.ListOptions{Namespace: r.namespace}); err != nil {
+		return ctrl.Result{}, err
+	}
+	var gateways []mcv1alpha1.Gateway
+	for _, gw := range gwList.Items {
+		if gw.DeletionTimestamp.IsZero() {
+			gateways = append(gateways, gw)
 		}
+	}
+	if len(gateways) == 0 {
 		if err := commonArea.Delete(ctx, resExport, &client.DeleteOptions{}); err != nil {
 			return ctrl.Result{}, client.IgnoreNotFound(err)
 		}
 		return ctrl.Result{}, nil
 	}
 
-	if err := createOrUpdate(gw); err != nil {
+	if err := createOrUpdate(gateways); err != nil {
 		return ctrl.Result{}, err
 	}
 	return ctrl.Result{}, nil
 }
 
 func (r *GatewayReconciler) updateResourceExport(ctx context.Context, req ctrl.Request,
-	commonArea commonarea.RemoteCommonArea, existingResExport *mcv1alpha1.ResourceExport, gw *mcv1alpha1.Gateway) error {
+	commonArea commonarea.RemoteCommonArea, existingResExport *mcv1alpha1.ResourceExport, gateways []mcv1alpha1.Gateway) error {
 	resExportSpec := mcv1alpha1.ResourceExportSpec{
 		Kind:      constants.ClusterInfoKind,
 		ClusterID: r.localClusterID,
 		Name:      r.localClusterID,
 		Namespace: r.namespace,
 	}
-	resExportSpec.ClusterInfo = r.getClusterInfo(gw)
+	resExportSpec.ClusterInfo = r.getClusterInfo(gateways)
 	klog.V(2).InfoS("Updating ClusterInfo kind of ResourceExport", "clusterinfo", klog.KObj(existingResExport),
 		"gateway", req.NamespacedName)
 	existingResExport.Spec = resExportSpec
@@ -159,14 +169,14 @@ func (r *GatewayReconciler) updateResourceExport(ctx context.Context, req ctrl.R
 }
 
 func (r *GatewayReconciler) createResourceExport(ctx context.Context, req ctrl.Request,
-	commonArea commonarea.RemoteCommonArea, gateway *mcv1alpha1.Gateway) error {
+	commonArea commonarea.RemoteCommonArea, gateways []mcv1alpha1.Gateway) error {
 	resExportSpec := mcv1alpha1.ResourceExportSpec{
 		Kind:      constants.ClusterInfoKind,
 		ClusterID: r.localClusterID,
 		Name:      r.localClusterID,
 		Namespace: r.namespace,
 	}
-	resExportSpec.ClusterInfo = r.getClusterInfo(gateway)
+	resExportSpec.ClusterInfo = r.getClusterInfo(gateways)
 	resExport := &mcv1alpha1.ResourceExport{
 		ObjectMeta: metav1.ObjectMeta{
 			Namespace: r.leaderNamespace,
@@ -185,7 +195,9 @@ func (r *GatewayReconciler) createResourceExport(ctx context.Context, req ctrl.R
 // SetupWithManager sets up the controller with the Manager.
 func (r *GatewayReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
-		For(&mcv1alpha1.Gateway{}).
+		// Ignore status updates of Gateways, which only include the results of probing
+		// peer Gateways.
+		For(&mcv1alpha1.Gateway{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
 		Watches(&source.Kind{Type: &mcv1alpha2.ClusterSet{}}, handler.EnqueueRequestsFromMapFunc(r.clusterSetMapFunc),
 			builder.WithPredicates(statusReadyPredicate)).
 		WithOptions(controller.Options{
@@ -222,20 +234,28 @@ func (r *GatewayReconciler) clusterSetMapFunc(a client.Object) []reconcile.Reque
 	return requests
 }
 
-func (r *GatewayReconciler) getClusterInfo(gateway *mcv1alpha1.Gateway) *mcv1alpha1.ClusterInfo {
+// getClusterInfo generates the ClusterInfo with all active Gateways sorted by
+// name. The ServiceCIDR and the WireGuard public key are taken from the first
+// Gateway, since WireGuard encryption is only supported with a single active
+// Gateway per cluster.
+func (r *GatewayReconciler) getClusterInfo(gateways []mcv1alpha1.Gateway) *mcv1alpha1.ClusterInfo {
+	sort.Slice(gateways, func(i, j int) bool {
+		return gateways[i].Name < gateways[j].Name
+	})
 	clusterInfo := &mcv1alpha1.ClusterInfo{
 		ClusterID:   r.localClusterID,
-		ServiceCIDR: gateway.ServiceCIDR,
+		ServiceCIDR: gateways[0].ServiceCIDR,
 		PodCIDRs:    r.podCIDRs,
-		GatewayInfos: []mcv1alpha1.GatewayInfo{
-			{
-				GatewayIP: gateway.GatewayIP,
-			},
-		},
 	}
-	if gateway.WireGuard != nil && gateway.WireGuard.PublicKey != "" {
+	for _, gateway := range gateways {
+		clusterInfo.GatewayInfos = append(clusterInfo.GatewayInfos, mcv1alpha1.GatewayInfo{
+			GatewayIP: gateway.GatewayIP,
+			ProbeIP:   gateway.ProbeIP,
+		})
+	}
+	if gateways[0].WireGuard != nil && gateways[0].WireGuard.PublicKey != "" {
 		clusterInfo.WireGuard = &mcv1alpha1.WireGuardInfo{
-			PublicKey: gateway.WireGuard.PublicKey,
+			PublicKey: gateways[0].WireGuard.PublicKey,
 		}
 	}
 
@@ -79,6 +79,11 @@ var (
 func TestGatewayReconciler(t *testing.T) {
 	gwNode1New := gwNode1
 	gwNode1New.GatewayIP = "10.10.10.12"
+	gwNode2 := gwNode1
+	gwNode2.Name = "node-2"
+	gwNode2.GatewayIP = "10.10.10.11"
+	gwNode2.InternalIP = "172.11.10.2"
+	gwNode2.ProbeIP = "10.244.2.1"
 	staleExistingResExport := existingResExport.DeepCopy()
 	staleExistingResExport.DeletionTimestamp = &metav1.Time{Time: time.Now()}
 	tests := []struct {
@@ -132,6 +137,27 @@ func TestGatewayReconciler(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "update a ResourceExport successfully with multiple Gateways",
+			namespacedName: types.NamespacedName{
+				Namespace: "default",
+				Name:      "node-2",
+			},
+			gateway: []mcv1alpha1.Gateway{
+				gwNode2,
+				gwNode1,
+			},
+			resExport: existingResExport,
+			expectedInfo: []mcv1alpha1.GatewayInfo{
+				{
+					GatewayIP: "10.10.10.10",
+				},
+				{
+					GatewayIP: "10.10.10.11",
+					ProbeIP:   "10.244.2.1",
+				},
+			},
+		},
 		{
 			name: "delete a ResourceExport successfully by deleting an existing Gateway",
 			namespacedName: types.NamespacedName{
@@ -154,7 +180,7 @@ func TestGatewayReconciler(t *testing.T) {
 			fakeRemoteClient = fake.NewClientBuilder().WithScheme(common.TestScheme).WithObjects(tt.resExport).Build()
 		}
 		commonArea := commonarea.NewFakeRemoteCommonArea(fakeRemoteClient, "leader-cluster", common.LocalClusterID, common.LeaderNamespace, nil)
-		mcReconciler := NewMemberClusterSetReconciler(fakeClient, common.TestScheme, "default", false, false, make(chan struct{}))
+		mcReconciler := NewMemberClusterSetReconciler(fakeClient, common.TestScheme, "default", false, false, make(chan struct{}), nil)
 		mcReconciler.SetRemoteCommonArea(commonArea)
 		commonAreaGetter := mcReconciler
 		r := NewGatewayReconciler(fakeClient, common.TestScheme, "default", []string{"10.200.1.1/16"}, commonAreaGetter)
@@ -191,9 +217,9 @@ func TestGatewayReconciler(t *testing.T) {
 func TestGetClusterInfo(t *testing.T) {
 	fakeClient := fake.NewClientBuilder().WithScheme(common.TestScheme).WithObjects().Build()
 	r := NewGatewayReconciler(fakeClient, common.TestScheme, "default", []string{"10.200.1.1/16"}, nil)
-	gw := &mcv1alpha1.Gateway{
+	gw1 := mcv1alpha1.Gateway{
 		ObjectMeta: metav1.ObjectMeta{
-			Name: "gw",
+			Name: "gw-1",
 		},
 		ServiceCIDR: "10.100.0.0/16",
 		GatewayIP:   "10.10.1.1",
@@ -202,11 +228,25 @@ func TestGetClusterInfo(t *testing.T) {
 			PublicKey: "key",
 		},
 	}
+	gw2 := mcv1alpha1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "gw-2",
+		},
+		ServiceCIDR: "10.100.0.0/16",
+		GatewayIP:   "10.10.1.2",
+		InternalIP:  "10.10.1.2",
+		WireGuard: &mcv1alpha1.WireGuardInfo{
+			PublicKey: "key2",
+		},
+	}
 	expectedClusterInfo := &mcv1alpha1.ClusterInfo{
 		GatewayInfos: []mcv1alpha1.GatewayInfo{
 			{
 				GatewayIP: "10.10.1.1",
 			},
+			{
+				GatewayIP: "10.10.1.2",
+			},
 		},
 		ServiceCIDR: "10.100.0.0/16",
 		PodCIDRs:    []string{"10.200.1.1/16"},
@@ -215,7 +255,7 @@ func TestGetClusterInfo(t *testing.T) {
 		},
 	}
 
-	assert.Equal(t, expectedClusterInfo, r.getClusterInfo(gw))
+	assert.Equal(t, expectedClusterInfo, r.getClusterInfo([]mcv1alpha1.Gateway{gw2, gw1}))
 }
 
 func TestClusterSetMapFunc_Gateway(t *testing.T) {
@@ -0,0 +1,250 @@
+/*
+Copyright 2024 Antrea Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package member
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sync"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	"antrea.io/antrea/multicluster/controllers/multicluster/common"
+	"antrea.io/antrea/pkg/ipam/ipallocator"
+)
+
+// GlobalIPReconciler is for member cluster only. It allocates global IPs from the
+// ClusterSet-unique global Pod CIDR of the member cluster to the Pods backing exported
+// Services, and records them in the Pod annotation `multicluster.antrea.io/global-ip`,
+// which is used by the Multi-cluster Gateway to translate the global IPs to Pod IPs.
+// The global IPs are released when the Pods are deleted or terminated.
+type GlobalIPReconciler struct {
+	client.Client
+	Scheme    *runtime.Scheme
+	mutex     sync.Mutex
+	allocator *ipallocator.SingleIPAllocator
+	// podGlobalIPs maps Pods to their allocated global IPs.
+	podGlobalIPs map[types.NamespacedName]string
+	// podIPs maps allocated global IPs to the Pod IPs.
+	podIPs      map[string]string
+	initialized bool
+}
+
+func NewGlobalIPReconciler(
+	client client.Client,
+	scheme *runtime.Scheme,
+	globalPodCIDR string) (*GlobalIPReconciler, error) {
+	_, cidr, err := net.ParseCIDR(globalPodCIDR)
+	if err != nil {
+		return nil, err
+	}
+	allocator, err := ipallocator.NewCIDRAllocator(cidr, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &GlobalIPReconciler{
+		Client:       client,
+		Scheme:       scheme,
+		allocator:    allocator,
+		podGlobalIPs: map[types.NamespacedName]string{},
+		podIPs:       map[string]string{},
+	}, nil
+}
+
+//+kubebuilder:rbac:groups="",resources=pods,verbs=get;list;watch;patch
+
+func (r *GlobalIPReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if err := r.initialize(ctx); err != nil {
+		return ctrl.Result{}, err
+	}
+	pod := &corev1.Pod{}
+	if err := r.Client.Get(ctx, req.NamespacedName, pod); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return ctrl.Result{}, err
+		}
+		r.release(req.NamespacedName)
+		return ctrl.Result{}, nil
+	}
+	if isTerminatedPod(pod) {
+		r.release(req.NamespacedName)
+	}
+	return ctrl.Result{}, nil
+}
+
+// initialize restores the allocated global IPs from the annotations of existing Pods
+// if it's not done yet. It must be called with the mutex held.
+func (r *GlobalIPReconciler) initialize(ctx context.Context) error {
+	if r.initialized {
+		return nil
+	}
+	podList := &corev1.PodList{}
+	if err := r.Client.List(ctx, podList, &client.ListOptions{}); err != nil {
+		return err
+	}
+	for i := range podList.Items {
+		pod := &podList.Items[i]
+		globalIP, ok := pod.Annotations[common.PodGlobalIPAnnotation]
+		if !ok || isTerminatedPod(pod) || pod.Status.PodIP == "" {
+			continue
+		}
+		ip := net.ParseIP(globalIP)
+		if ip == nil || r.allocator.AllocateIP(ip) != nil {
+			// The global IP is invalid, out of the global Pod CIDR or conflicts with another
+			// Pod. A new global IP will be allocated to the Pod when it's exported again.
+			klog.InfoS("Ignored invalid global IP of Pod", "pod", klog.KObj(pod), "globalIP", globalIP)
+			continue
+		}
+		r.podGlobalIPs[types.NamespacedName{Namespace: pod.Namespace, Name: pod.Name}] = globalIP
+		r.podIPs[globalIP] = pod.Status.PodIP
+	}
+	r.initialized = true
+	return nil
+}
+
+// translateSubsets replaces the Pod IPs in the given subsets of an exported Service with
+// the global IPs of the Pods, and allocates global IPs to the Pods which don't have one.
+// Addresses which don't belong to any Pod in the Namespace are removed, as they are not
+// reachable from other member clusters.
+func (r *GlobalIPReconciler) translateSubsets(ctx context.Context, namespace string, subsets []corev1.EndpointSubset) ([]corev1.EndpointSubset, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if err := r.initialize(ctx); err != nil {
+		return nil, err
+	}
+	podList := &corev1.PodList{}
+	if err := r.Client.List(ctx, podList, client.InNamespace(namespace)); err != nil {
+		return nil, err
+	}
+	podsByIP := map[string]*corev1.Pod{}
+	for i := range podList.Items {
+		pod := &podList.Items[i]
+		if pod.Status.PodIP != "" && !pod.Spec.HostNetwork && !isTerminatedPod(pod) {
+			podsByIP[pod.Status.PodIP] = pod
+		}
+	}
+
+	var newSubsets []corev1.EndpointSubset
+	for _, subset := range subsets {
+		var addresses []corev1.EndpointAddress
+		for _, addr := range subset.Addresses {
+			pod, ok := podsByIP[addr.IP]
+			if !ok {
+				klog.V(2).InfoS("Skipped Endpoint address without a Pod", "namespace", namespace, "ip", addr.IP)
+				continue
+			}
+			globalIP, err := r.allocate(ctx, pod)
+			if err != nil {
+				return nil, err
+			}
+			addresses = append(addresses, corev1.EndpointAddress{IP: globalIP})
+		}
+		if len(addresses) > 0 {
+			newSubsets = append(newSubsets, corev1.EndpointSubset{Addresses: addresses, Ports: subset.Ports})
+		}
+	}
+	return newSubsets, nil
+}
+
+// allocate returns the global IP of the Pod, allocating a new one and updating the Pod
+// annotation when necessary. It must be called with the mutex held.
+func (r *GlobalIPReconciler) allocate(ctx context.Context, pod *corev1.Pod) (string, error) {
+	podKey := types.NamespacedName{Namespace: pod.Namespace, Name: pod.Name}
+	globalIP, allocated := r.podGlobalIPs[podKey]
+	if allocated && pod.Annotations[common.PodGlobalIPAnnotation] == globalIP {
+		return globalIP, nil
+	}
+	if !allocated {
+		ip, err := r.allocator.AllocateNext()
+		if err != nil {
+			return "", fmt.Errorf("failed to allocate global IP for Pod %s: %v", podKey, err)
+		}
+		globalIP = ip.String()
+	}
+	patch := client.MergeFrom(pod.DeepCopy())
+	if pod.Annotations == nil {
+		pod.Annotations = map[string]string{}
+	}
+	pod.Annotations[common.PodGlobalIPAnnotation] = globalIP
+	if err := r.Client.Patch(ctx, pod, patch); err != nil {
+		if !allocated {
+			if releaseErr := r.allocator.Release(net.ParseIP(globalIP)); releaseErr != nil {
+				klog.ErrorS(releaseErr, "Failed to release global IP", "globalIP", globalIP)
+			}
+		}
+		return "", err
+	}
+	klog.V(2).InfoS("Allocated global IP to Pod", "pod", podKey, "globalIP", globalIP)
+	r.podGlobalIPs[podKey] = globalIP
+	r.podIPs[globalIP] = pod.Status.PodIP
+	return globalIP, nil
+}
+
+// release releases the global IP of the Pod. It must be called with the mutex held.
+func (r *GlobalIPReconciler) release(podKey types.NamespacedName) {
+	globalIP, ok := r.podGlobalIPs[podKey]
+	if !ok {
+		return
+	}
+	klog.V(2).InfoS("Releasing global IP of Pod", "pod", podKey, "globalIP", globalIP)
+	if err := r.allocator.Release(net.ParseIP(globalIP)); err != nil {
+		klog.ErrorS(err, "Failed to release global IP", "globalIP", globalIP)
+	}
+	delete(r.podGlobalIPs, podKey)
+	delete(r.podIPs, globalIP)
+}
+
+// getPodIP returns the Pod IP which the given global IP is allocated to.
+func (r *GlobalIPReconciler) getPodIP(ctx context.Context, globalIP string) (string, bool, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if err := r.initialize(ctx); err != nil {
+		return "", false, err
+	}
+	podIP, ok := r.podIPs[globalIP]
+	return podIP, ok, nil
+}
+
+func isTerminatedPod(pod *corev1.Pod) bool {
+	return pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *GlobalIPReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	// Only Pods with global IPs need to be reconciled to release the global IPs.
+	hasGlobalIPPredicate := predicate.NewPredicateFuncs(func(object client.Object) bool {
+		_, ok := object.GetAnnotations()[common.PodGlobalIPAnnotation]
+		return ok
+	})
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&corev1.Pod{}, builder.WithPredicates(hasGlobalIPPredicate)).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: common.DefaultWorkerCount,
+		}).
+		Complete(r)
+}
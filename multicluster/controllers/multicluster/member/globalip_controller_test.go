@@ -0,0 +1,111 @@
+/*
+Copyright 2024 Antrea Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package member
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"antrea.io/antrea/multicluster/controllers/multicluster/common"
+)
+
+func newTestPod(name, podIP string, annotations map[string]string) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   "default",
+			Name:        name,
+			Annotations: annotations,
+		},
+		Status: corev1.PodStatus{
+			Phase: corev1.PodRunning,
+			PodIP: podIP,
+		},
+	}
+}
+
+func TestGlobalIPReconciler(t *testing.T) {
+	// pod-a has a global IP allocated before the controller restarts.
+	podA := newTestPod("pod-a", "10.10.1.1", map[string]string{common.PodGlobalIPAnnotation: "172.30.0.1"})
+	podB := newTestPod("pod-b", "10.10.1.2", nil)
+	fakeClient := fake.NewClientBuilder().WithScheme(common.TestScheme).WithObjects(podA, podB).Build()
+	r, err := NewGlobalIPReconciler(fakeClient, common.TestScheme, "172.30.0.0/24")
+	require.NoError(t, err)
+
+	ports := []corev1.EndpointPort{{Name: "http", Port: 80, Protocol: corev1.ProtocolTCP}}
+	subsets := []corev1.EndpointSubset{{
+		Addresses: []corev1.EndpointAddress{{IP: "10.10.1.1"}, {IP: "10.10.1.2"}, {IP: "10.10.1.3"}},
+		Ports:     ports,
+	}}
+	expectedSubsets := []corev1.EndpointSubset{{
+		Addresses: []corev1.EndpointAddress{{IP: "172.30.0.1"}, {IP: "172.30.0.2"}},
+		Ports:     ports,
+	}}
+	newSubsets, err := r.translateSubsets(common.TestCtx, "default", subsets)
+	require.NoError(t, err)
+	assert.Equal(t, expectedSubsets, newSubsets)
+
+	// The global IP is recorded in the Pod annotation.
+	pod := &corev1.Pod{}
+	require.NoError(t, fakeClient.Get(common.TestCtx, types.NamespacedName{Namespace: "default", Name: "pod-b"}, pod))
+	assert.Equal(t, "172.30.0.2", pod.Annotations[common.PodGlobalIPAnnotation])
+
+	// The same global IPs are exported again.
+	newSubsets, err = r.translateSubsets(common.TestCtx, "default", subsets)
+	require.NoError(t, err)
+	assert.Equal(t, expectedSubsets, newSubsets)
+
+	// The global IPs of the local cluster's Pods are translated back to Pod IPs in imported Endpoints.
+	resImpReconciler := newResourceImportReconciler(fakeClient, "cluster-a", "default", nil, r)
+	importedSubsets := []corev1.EndpointSubset{{
+		Addresses: []corev1.EndpointAddress{{IP: "172.30.0.2"}, {IP: "172.31.0.1"}},
+		Ports:     ports,
+	}}
+	localSubsets, err := resImpReconciler.toLocalSubsets(common.TestCtx, importedSubsets)
+	require.NoError(t, err)
+	assert.Equal(t, []corev1.EndpointSubset{{
+		Addresses: []corev1.EndpointAddress{{IP: "10.10.1.2"}, {IP: "172.31.0.1"}},
+		Ports:     ports,
+	}}, localSubsets)
+
+	// The global IP is released after the Pod is deleted, and allocated to a new Pod.
+	require.NoError(t, fakeClient.Delete(common.TestCtx, pod))
+	_, err = r.Reconcile(common.TestCtx, ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "default", Name: "pod-b"}})
+	require.NoError(t, err)
+	_, ok, err := r.getPodIP(common.TestCtx, "172.30.0.2")
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	podC := newTestPod("pod-c", "10.10.1.3", nil)
+	require.NoError(t, fakeClient.Create(common.TestCtx, podC))
+	newSubsets, err = r.translateSubsets(common.TestCtx, "default", subsets)
+	require.NoError(t, err)
+	assert.Equal(t, []corev1.EndpointSubset{{
+		Addresses: []corev1.EndpointAddress{{IP: "172.30.0.1"}, {IP: "172.30.0.2"}},
+		Ports:     ports,
+	}}, newSubsets)
+	podIP, ok, err := r.getPodIP(common.TestCtx, "172.30.0.2")
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, "10.10.1.3", podIP)
+}
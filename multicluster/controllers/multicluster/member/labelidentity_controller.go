@@ -117,20 +117,27 @@ func (r *LabelIdentityReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 
 // checkRemoteCommonArea initializes remoteCommonArea for the reconciler if necessary,
 // or tells the Reconcile function to requeue if the remoteCommonArea is not ready.
+// remoteCommonArea is updated when the member cluster fails over to another leader cluster.
 func (r *LabelIdentityReconciler) checkRemoteCommonArea() bool {
 	r.commonAreaMutex.Lock()
 	defer r.commonAreaMutex.Unlock()
 
-	if r.remoteCommonArea == nil {
-		commonArea, localClusterID, _ := r.commonAreaGetter.GetRemoteCommonAreaAndLocalID()
-		if commonArea == nil {
-			return true
-		}
+	commonArea, localClusterID, _ := r.commonAreaGetter.GetRemoteCommonAreaAndLocalID()
+	if commonArea == nil {
+		return r.remoteCommonArea == nil
+	}
+	if r.remoteCommonArea != commonArea {
 		r.remoteCommonArea, r.localClusterID = commonArea, localClusterID
 	}
 	return false
 }
 
+func (r *LabelIdentityReconciler) getRemoteCommonArea() commonarea.RemoteCommonArea {
+	r.commonAreaMutex.Lock()
+	defer r.commonAreaMutex.Unlock()
+	return r.remoteCommonArea
+}
+
 // SetupWithManager sets up the controller with the Manager.
 func (r *LabelIdentityReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
@@ -317,7 +324,7 @@ func (r *LabelIdentityReconciler) createLabelIdentityResExport(ctx context.Conte
 	resExportName := getResourceExportNameForLabelIdentity(r.localClusterID, labelToAdd)
 	labelResExport := r.getLabelIdentityResourceExport(resExportName, labelToAdd)
 	klog.V(4).InfoS("Creating ResourceExport for label", "resourceExport", labelResExport.Name, "label", labelToAdd)
-	err := r.remoteCommonArea.Create(ctx, labelResExport, &client.CreateOptions{})
+	err := r.getRemoteCommonArea().Create(ctx, labelResExport, &client.CreateOptions{})
 	if err != nil && !apierrors.IsAlreadyExists(err) {
 		return err
 	}
@@ -329,11 +336,11 @@ func (r *LabelIdentityReconciler) deleteLabelIdentityResExport(ctx context.Conte
 	labelResExport := &mcv1alpha1.ResourceExport{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      getResourceExportNameForLabelIdentity(r.localClusterID, labelToDelete),
-			Namespace: r.remoteCommonArea.GetNamespace(),
+			Namespace: r.getRemoteCommonArea().GetNamespace(),
 		},
 	}
 	klog.V(4).InfoS("Deleting ResourceExport for label", "resourceExport", labelResExport.Name, "label", labelToDelete)
-	err := r.remoteCommonArea.Delete(ctx, labelResExport, &client.DeleteOptions{})
+	err := r.getRemoteCommonArea().Delete(ctx, labelResExport, &client.DeleteOptions{})
 	return client.IgnoreNotFound(err)
 }
 
@@ -341,7 +348,7 @@ func (r *LabelIdentityReconciler) getLabelIdentityResourceExport(name, normalize
 	return &mcv1alpha1.ResourceExport{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      name,
-			Namespace: r.remoteCommonArea.GetNamespace(),
+			Namespace: r.getRemoteCommonArea().GetNamespace(),
 			Labels: map[string]string{
 				constants.SourceKind:      constants.LabelIdentityKind,
 				constants.SourceClusterID: r.localClusterID,
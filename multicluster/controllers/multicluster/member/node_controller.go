@@ -20,8 +20,8 @@ import (
 	"context"
 	"fmt"
 	"net"
-	"sync"
 
+	"github.com/containernetworking/plugins/pkg/ip"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -75,23 +75,21 @@ type (
 	// NodeReconciler is for member cluster only.
 	NodeReconciler struct {
 		client.Client
-		Scheme             *runtime.Scheme
-		namespace          string
-		precedence         mcv1alpha1.Precedence
-		gatewayCandidates  map[string]bool
-		activeGatewayMutex sync.Mutex
-		commonAreaGetter   commonarea.RemoteCommonAreaGetter
-		activeGateway      string
-		serviceCIDR        string
-		initialized        bool
+		Scheme           *runtime.Scheme
+		namespace        string
+		precedence       mcv1alpha1.Precedence
+		commonAreaGetter commonarea.RemoteCommonAreaGetter
+		serviceCIDR      string
+		initialized      bool
 	}
 )
 
 // NewNodeReconciler creates a NodeReconciler to watch Node resource changes.
-// It's responsible for creating a Gateway for the first ready Node with
-// annotation `multicluster.antrea.io/gateway:true` if there is no existing Gateway.
-// It guarantees there is always only one Gateway CR when there are multiple Nodes
-// with annotation `multicluster.antrea.io/gateway:true`.
+// It's responsible for creating a Gateway for every ready Node with annotation
+// `multicluster.antrea.io/gateway:true` and a valid Gateway IP, and deleting
+// the Gateway when the Node is removed, not ready, or no longer annotated.
+// All Gateways in a member cluster are active, and cross-cluster traffic is
+// distributed across them by Antrea Agents.
 func NewNodeReconciler(
 	client client.Client,
 	scheme *runtime.Scheme,
@@ -103,13 +101,12 @@ func NewNodeReconciler(
 		precedence = mcv1alpha1.PrecedenceInternal
 	}
 	reconciler := &NodeReconciler{
-		Client:            client,
-		Scheme:            scheme,
-		namespace:         namespace,
-		serviceCIDR:       serviceCIDR,
-		precedence:        precedence,
-		gatewayCandidates: make(map[string]bool),
-		commonAreaGetter:  commonAreaGetter,
+		Client:           client,
+		Scheme:           scheme,
+		namespace:        namespace,
+		serviceCIDR:      serviceCIDR,
+		precedence:       precedence,
+		commonAreaGetter: commonAreaGetter,
 	}
 	return reconciler
 }
@@ -141,121 +138,81 @@ func (r *NodeReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.
 		},
 	}
 
-	r.activeGatewayMutex.Lock()
-	defer r.activeGatewayMutex.Unlock()
-	noActiveGateway := r.activeGateway == ""
-	isActiveGateway := r.activeGateway == req.Name
-	stillGatewayNode := false
-
+	isValidGateway := false
 	node := &corev1.Node{}
 	if err := r.Client.Get(ctx, req.NamespacedName, node); err != nil {
 		if !apierrors.IsNotFound(err) {
 			klog.ErrorS(err, "Failed to get Node", "node", req.Name)
 			return ctrl.Result{}, err
 		}
-	} else {
-		_, hasGWAnnotation := node.Annotations[common.GatewayAnnotation]
-		stillGatewayNode = hasGWAnnotation
-	}
-
-	if stillGatewayNode {
-		r.gatewayCandidates[req.Name] = true
-	} else {
-		delete(r.gatewayCandidates, req.Name)
-	}
-
-	var err error
-	var isValidGateway bool
-
-	if stillGatewayNode {
+	} else if _, hasGWAnnotation := node.Annotations[common.GatewayAnnotation]; hasGWAnnotation {
+		var err error
 		gw.ServiceCIDR = r.serviceCIDR
 		gw.InternalIP, gw.GatewayIP, err = r.getGatawayNodeIP(node)
 		if err != nil {
 			klog.ErrorS(err, "There is no valid Gateway IP for Node", "node", node.Name)
 		}
-		isValidGateway = err == nil
+		gw.ProbeIP = getGatewayProbeIP(node)
+		isValidGateway = err == nil && isReadyNode(node)
 	}
 
-	if isActiveGateway {
-		if !isValidGateway || !isReadyNode(node) {
-			if err := r.recreateActiveGateway(ctx, gw); err != nil {
-				return ctrl.Result{}, err
-			}
-		} else {
-			if err := r.updateActiveGateway(ctx, gw); err != nil {
-				return ctrl.Result{}, err
-			}
+	if !isValidGateway {
+		if err := r.Client.Delete(ctx, gw, &client.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+			return ctrl.Result{}, err
 		}
 		return ctrl.Result{}, nil
 	}
-
-	if noActiveGateway && isValidGateway && isReadyNode(node) {
-		if err := r.createGateway(gw); err != nil {
-			return ctrl.Result{}, err
-		}
+	if err := r.createOrUpdateGateway(ctx, gw); err != nil {
+		return ctrl.Result{}, err
 	}
 	return ctrl.Result{}, nil
 }
 
-// initialize initializes 'activeGateway' and 'gatewayCandidates' and removes
-// stale Gateway during controller startup.
+// initialize removes stale Gateways whose Nodes have been deleted during
+// controller startup.
 func (r *NodeReconciler) initialize() error {
 	ctx := context.Background()
-	nodeList := &corev1.NodeList{}
-	if err := r.Client.List(ctx, nodeList, &client.ListOptions{}); err != nil {
-		return err
-	}
-
 	gwList := &mcv1alpha1.GatewayList{}
-	if err := r.Client.List(ctx, gwList, &client.ListOptions{}); err != nil {
+	if err := r.Client.List(ctx, gwList, &client.ListOptions{Namespace: r.namespace}); err != nil {
 		return err
 	}
-	// Gateway webhook guarantees that there is at most one Gateway in the member cluster.
-	if len(gwList.Items) > 0 {
-		existingGWName := gwList.Items[0].Name
+	for i := range gwList.Items {
+		gw := &gwList.Items[i]
 		node := &corev1.Node{}
-		if err := r.Client.Get(ctx, types.NamespacedName{Name: existingGWName}, node); err != nil {
+		if err := r.Client.Get(ctx, types.NamespacedName{Name: gw.Name}, node); err != nil {
 			if !apierrors.IsNotFound(err) {
 				return err
 			}
-			staleGateway := &mcv1alpha1.Gateway{
-				ObjectMeta: metav1.ObjectMeta{
-					Namespace: r.namespace,
-					Name:      existingGWName},
-			}
-			err := r.Client.Delete(ctx, staleGateway, &client.DeleteOptions{})
-			if err != nil && !apierrors.IsNotFound(err) {
+			klog.InfoS("Deleting stale Gateway", "gateway", klog.KObj(gw))
+			if err := r.Client.Delete(ctx, gw, &client.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
 				return err
 			}
-		} else {
-			r.activeGateway = existingGWName
-		}
-	}
-	for _, n := range nodeList.Items {
-		if _, isGW := n.Annotations[common.GatewayAnnotation]; isGW {
-			r.gatewayCandidates[n.Name] = true
 		}
 	}
 	return nil
 }
 
-func (r *NodeReconciler) updateActiveGateway(ctx context.Context, newGateway *mcv1alpha1.Gateway) error {
+func (r *NodeReconciler) createOrUpdateGateway(ctx context.Context, newGateway *mcv1alpha1.Gateway) error {
 	existingGW := &mcv1alpha1.Gateway{}
 	// TODO: cache might be stale. Need to revisit here and other reconcilers to
 	// check if we can improve this with 'Owns' or other methods.
 	if err := r.Client.Get(ctx, types.NamespacedName{Name: newGateway.Name, Namespace: r.namespace}, existingGW); err != nil {
-		if apierrors.IsNotFound(err) {
-			r.activeGateway = ""
-			return nil
+		if !apierrors.IsNotFound(err) {
+			return err
 		}
-		return err
+		if err := r.Client.Create(ctx, newGateway, &client.CreateOptions{}); err != nil && !apierrors.IsAlreadyExists(err) {
+			return err
+		}
+		klog.InfoS("Created Gateway", "gateway", klog.KObj(newGateway))
+		return nil
 	}
 	if existingGW.GatewayIP == newGateway.GatewayIP && existingGW.InternalIP == newGateway.InternalIP &&
-		existingGW.ServiceCIDR == newGateway.ServiceCIDR {
+		existingGW.ProbeIP == newGateway.ProbeIP && existingGW.ServiceCIDR == newGateway.ServiceCIDR {
 		return nil
 	}
 	existingGW.GatewayIP = newGateway.GatewayIP
 	existingGW.InternalIP = newGateway.InternalIP
+	existingGW.ProbeIP = newGateway.ProbeIP
 	existingGW.ServiceCIDR = newGateway.ServiceCIDR
 	// If the Gateway version in the client cache is stale, the update operation will fail,
 	// then the reconciler will retry with latest state again.
@@ -265,74 +222,6 @@ func (r *NodeReconciler) updateActiveGateway(ctx context.Context, newGateway *mc
 	return nil
 }
 
-// recreateActiveGateway will delete the existing Gateway CR and create a new Gateway
-// from the pool of Gateway candidates.
-func (r *NodeReconciler) recreateActiveGateway(ctx context.Context, gateway *mcv1alpha1.Gateway) error {
-	err := r.Client.Delete(ctx, gateway, &client.DeleteOptions{})
-	if err != nil && !apierrors.IsNotFound(err) {
-		return err
-	}
-	r.activeGateway = ""
-	// Check remaining Gateway candidates and create a new Gateway.
-	newGateway, err := r.getValidGatewayFromCandidates()
-	if err != nil {
-		return err
-	}
-	if newGateway != nil {
-		return r.createGateway(newGateway)
-	}
-	return nil
-}
-
-// getValidGatewayFromCandidates picks a valid Node from Gateway candidates and
-// creates a Gateway. It returns no error if no good Gateway candidate.
-func (r *NodeReconciler) getValidGatewayFromCandidates() (*mcv1alpha1.Gateway, error) {
-	var activeGateway *mcv1alpha1.Gateway
-	var internalIP, gwIP string
-	var err error
-
-	gatewayNode := &corev1.Node{}
-	for name := range r.gatewayCandidates {
-		if err = r.Client.Get(context.Background(), types.NamespacedName{Name: name}, gatewayNode); err == nil {
-			if !isReadyNode(gatewayNode) {
-				continue
-			}
-			if internalIP, gwIP, err = r.getGatawayNodeIP(gatewayNode); err != nil {
-				klog.V(2).ErrorS(err, "Node has no valid IP", "node", gatewayNode.Name)
-				continue
-			}
-
-			activeGateway = &mcv1alpha1.Gateway{
-				ObjectMeta: metav1.ObjectMeta{
-					Name:      gatewayNode.Name,
-					Namespace: r.namespace,
-				},
-				GatewayIP:   gwIP,
-				InternalIP:  internalIP,
-				ServiceCIDR: r.serviceCIDR,
-			}
-			klog.InfoS("Found good Gateway candidate", "node", gatewayNode.Name)
-			return activeGateway, nil
-		}
-		if !apierrors.IsNotFound(err) {
-			return nil, err
-		}
-	}
-	return nil, nil
-}
-
-func (r *NodeReconciler) createGateway(gateway *mcv1alpha1.Gateway) error {
-	if err := r.Client.Create(context.Background(), gateway, &client.CreateOptions{}); err != nil {
-		if apierrors.IsAlreadyExists(err) {
-			r.activeGateway = gateway.Name
-			return nil
-		}
-		return err
-	}
-	r.activeGateway = gateway.Name
-	return nil
-}
-
 func (r *NodeReconciler) getGatawayNodeIP(node *corev1.Node) (string, string, error) {
 	var gatewayIP, internalIP string
 	for _, addr := range node.Status.Addresses {
@@ -362,6 +251,25 @@ func (r *NodeReconciler) getGatawayNodeIP(node *corev1.Node) (string, string, er
 	return internalIP, gatewayIP, nil
 }
 
+// getGatewayProbeIP returns the IP of the antrea-gw0 interface of the Gateway Node. Like
+// the Antrea Agent, it takes the first IP of the IPv4 PodCIDR allocated to the Node by
+// NodeIPAM. It returns an empty string if the Node has no IPv4 PodCIDR, in which case the
+// tunnel to the Gateway cannot be probed.
+func getGatewayProbeIP(node *corev1.Node) string {
+	podCIDRs := node.Spec.PodCIDRs
+	if len(podCIDRs) == 0 && node.Spec.PodCIDR != "" {
+		podCIDRs = []string{node.Spec.PodCIDR}
+	}
+	for _, podCIDR := range podCIDRs {
+		_, ipNet, err := net.ParseCIDR(podCIDR)
+		if err != nil || ipNet.IP.To4() == nil {
+			continue
+		}
+		return ip.NextIP(ipNet.IP).String()
+	}
+	return ""
+}
+
 // SetupWithManager sets up the controller with the Manager.
 func (r *NodeReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	if r.serviceCIDR == "" {
@@ -404,12 +312,6 @@ func (r *NodeReconciler) clusterSetMapFunc(a client.Object) []reconcile.Request
 				}
 			}
 		}
-	} else if apierrors.IsNotFound(err) {
-		r.activeGatewayMutex.Lock()
-		defer r.activeGatewayMutex.Unlock()
-		// All auto-generated resources will be deleted by the ClusterSet controller when a ClusterSet is
-		// deleted, so here we can set the activeGateway to empty directly.
-		r.activeGateway = ""
 	}
 	return requests
 }
@@ -127,6 +127,10 @@ func TestNodeReconciler(t *testing.T) {
 	gateway4.Name = "node-4"
 	newGateway1 := gwNode1.DeepCopy()
 	newGateway1.GatewayIP = "172.11.10.1"
+	node1WithPodCIDRs := node1.DeepCopy()
+	node1WithPodCIDRs.Spec.PodCIDRs = []string{"fd00:10:244::/64", "10.244.1.0/24"}
+	gwNode1WithProbeIP := gwNode1.DeepCopy()
+	gwNode1WithProbeIP.ProbeIP = "10.244.1.1"
 	newNode1 := node1.DeepCopy()
 	newNode1.Name = "node-1"
 	newNode1.Status.Addresses = []corev1.NodeAddress{
@@ -137,14 +141,14 @@ func TestNodeReconciler(t *testing.T) {
 	}
 
 	tests := []struct {
-		name          string
-		nodes         []*corev1.Node
-		req           reconcile.Request
-		precedence    mcv1alpha1.Precedence
-		existingGW    *mcv1alpha1.Gateway
-		expectedGW    *mcv1alpha1.Gateway
-		activeGateway string
-		candidates    map[string]bool
+		name       string
+		nodes      []*corev1.Node
+		req        reconcile.Request
+		precedence mcv1alpha1.Precedence
+		existingGW []*mcv1alpha1.Gateway
+		expectedGW *mcv1alpha1.Gateway
+		// keptGW is an existing Gateway which is expected to be kept after reconciliation.
+		keptGW string
 	}{
 		{
 			name:       "create a Gateway successfully",
@@ -157,7 +161,7 @@ func TestNodeReconciler(t *testing.T) {
 			name:       "update a Gateway successfully by changing GatewayIP",
 			nodes:      []*corev1.Node{&node1WithIPAnnotation},
 			req:        reconcile.Request{NamespacedName: types.NamespacedName{Name: node1.Name}},
-			existingGW: &gwNode1,
+			existingGW: []*mcv1alpha1.Gateway{&gwNode1},
 			expectedGW: &mcv1alpha1.Gateway{
 				ObjectMeta: metav1.ObjectMeta{
 					Name:      "node-1",
@@ -166,58 +170,68 @@ func TestNodeReconciler(t *testing.T) {
 				GatewayIP:  "11.11.10.10",
 				InternalIP: "172.11.10.1",
 			},
-			activeGateway: "node-1",
-			precedence:    mcv1alpha1.PrecedencePublic,
+			precedence: mcv1alpha1.PrecedencePublic,
 		},
 		{
-			name:          "remove a Gateway Node to delete a Gateway successfully",
-			nodes:         []*corev1.Node{},
-			req:           reconcile.Request{NamespacedName: types.NamespacedName{Name: node1.Name}},
-			existingGW:    &gwNode1,
-			activeGateway: "node-1",
-			precedence:    mcv1alpha1.PrecedencePublic,
+			name:       "update a Gateway successfully by allocating PodCIDRs",
+			nodes:      []*corev1.Node{node1WithPodCIDRs},
+			req:        reconcile.Request{NamespacedName: types.NamespacedName{Name: node1.Name}},
+			existingGW: []*mcv1alpha1.Gateway{&gwNode1},
+			expectedGW: gwNode1WithProbeIP,
+			precedence: mcv1alpha1.PrecedencePublic,
 		},
 		{
-			name:          "remove a Gateway Node's annotation to delete a Gateway successfully",
-			nodes:         []*corev1.Node{&node1NoAnnotation},
-			req:           reconcile.Request{NamespacedName: types.NamespacedName{Name: node1.Name}},
-			existingGW:    &gwNode1,
-			activeGateway: "node-1",
-			precedence:    mcv1alpha1.PrecedencePublic,
+			name:       "remove a Gateway Node to delete a Gateway successfully",
+			nodes:      []*corev1.Node{},
+			req:        reconcile.Request{NamespacedName: types.NamespacedName{Name: node1.Name}},
+			existingGW: []*mcv1alpha1.Gateway{&gwNode1},
+			precedence: mcv1alpha1.PrecedencePublic,
+		},
+		{
+			name:       "remove a Gateway Node's annotation to delete a Gateway successfully",
+			nodes:      []*corev1.Node{&node1NoAnnotation},
+			req:        reconcile.Request{NamespacedName: types.NamespacedName{Name: node1.Name}},
+			existingGW: []*mcv1alpha1.Gateway{&gwNode1},
+			precedence: mcv1alpha1.PrecedencePublic,
 		},
 		{
 			name:       "remove a Gateway due to no IPs",
 			nodes:      []*corev1.Node{newNode1},
 			req:        reconcile.Request{NamespacedName: types.NamespacedName{Name: newNode1.Name}},
-			existingGW: &gwNode1,
+			existingGW: []*mcv1alpha1.Gateway{&gwNode1},
 			precedence: mcv1alpha1.PrecedencePrivate,
 		},
 		{
-			name:          "remove a Gateway Node to create a new Gateway from candidates successfully",
-			nodes:         []*corev1.Node{node2, node4},
-			req:           reconcile.Request{NamespacedName: types.NamespacedName{Name: node1.Name}},
-			existingGW:    &gwNode1,
-			expectedGW:    updatedGateway2,
-			activeGateway: "node-1",
-			precedence:    mcv1alpha1.PrecedencePublic,
+			name:       "create a second active Gateway successfully",
+			nodes:      []*corev1.Node{node1, node2},
+			req:        reconcile.Request{NamespacedName: types.NamespacedName{Name: node2.Name}},
+			existingGW: []*mcv1alpha1.Gateway{&gwNode1},
+			expectedGW: updatedGateway2,
+			keptGW:     gwNode1.Name,
+			precedence: mcv1alpha1.PrecedencePublic,
 		},
 		{
-			name:          "create a new Gateway successfully when active Gateway Node is not ready",
-			nodes:         []*corev1.Node{node2, node3},
-			req:           reconcile.Request{NamespacedName: types.NamespacedName{Name: node3.Name}},
-			existingGW:    gateway3,
-			expectedGW:    updatedGateway2,
-			activeGateway: "node-3",
-			precedence:    mcv1alpha1.PrecedencePublic,
+			name:       "remove one of active Gateways successfully",
+			nodes:      []*corev1.Node{node2},
+			req:        reconcile.Request{NamespacedName: types.NamespacedName{Name: node1.Name}},
+			existingGW: []*mcv1alpha1.Gateway{&gwNode1, updatedGateway2},
+			keptGW:     updatedGateway2.Name,
+			precedence: mcv1alpha1.PrecedencePublic,
+		},
+		{
+			name:       "delete a Gateway successfully when Gateway Node is not ready",
+			nodes:      []*corev1.Node{node2, node3},
+			req:        reconcile.Request{NamespacedName: types.NamespacedName{Name: node3.Name}},
+			existingGW: []*mcv1alpha1.Gateway{gateway3, updatedGateway2},
+			keptGW:     updatedGateway2.Name,
+			precedence: mcv1alpha1.PrecedencePublic,
 		},
 		{
-			name:          "create a new Gateway successfully when active Gateway Node has no valid IP",
-			nodes:         []*corev1.Node{node2, node4},
-			req:           reconcile.Request{NamespacedName: types.NamespacedName{Name: node4.Name}},
-			existingGW:    gateway4,
-			expectedGW:    updatedGateway2,
-			activeGateway: "node-4",
-			precedence:    mcv1alpha1.PrecedencePublic,
+			name:       "delete a Gateway successfully when Gateway Node has no valid IP",
+			nodes:      []*corev1.Node{node4},
+			req:        reconcile.Request{NamespacedName: types.NamespacedName{Name: node4.Name}},
+			existingGW: []*mcv1alpha1.Gateway{gateway4},
+			precedence: mcv1alpha1.PrecedencePublic,
 		},
 	}
 	for _, tt := range tests {
@@ -226,25 +240,23 @@ func TestNodeReconciler(t *testing.T) {
 			for _, n := range tt.nodes {
 				obj = append(obj, n)
 			}
-			if tt.existingGW != nil {
-				obj = append(obj, tt.existingGW)
+			for _, gw := range tt.existingGW {
+				obj = append(obj, gw.DeepCopy())
 			}
 			fakeClient := fake.NewClientBuilder().WithScheme(common.TestScheme).WithObjects(obj...).Build()
 			fakeRemoteClient := fake.NewClientBuilder().WithScheme(common.TestScheme).WithObjects().Build()
 			commonArea := commonarea.NewFakeRemoteCommonArea(fakeRemoteClient, "leader-cluster", common.LocalClusterID, common.LeaderNamespace, nil)
-			mcReconciler := NewMemberClusterSetReconciler(fakeClient, common.TestScheme, "default", false, false, make(chan struct{}))
+			mcReconciler := NewMemberClusterSetReconciler(fakeClient, common.TestScheme, "default", false, false, make(chan struct{}), nil)
 			mcReconciler.SetRemoteCommonArea(commonArea)
 			commonAreaGetter := mcReconciler
 			r := NewNodeReconciler(fakeClient, common.TestScheme, "default", "10.100.0.0/16", tt.precedence, commonAreaGetter)
-			r.activeGateway = tt.activeGateway
+			// Skip the initialization to keep the existing Gateways of removed Nodes.
+			r.initialized = true
 			if _, err := r.Reconcile(common.TestCtx, tt.req); err != nil {
 				t.Errorf("Node Reconciler should handle Node events successfully but got error = %v", err)
 			} else {
 				newGW := &mcv1alpha1.Gateway{}
 				gwNamespcedName := types.NamespacedName{Name: tt.req.Name, Namespace: "default"}
-				if tt.expectedGW != nil {
-					gwNamespcedName = types.NamespacedName{Name: tt.expectedGW.Name, Namespace: "default"}
-				}
 				err := fakeClient.Get(common.TestCtx, gwNamespcedName, newGW)
 				isDelete := tt.expectedGW == nil
 				if isDelete {
@@ -255,11 +267,16 @@ func TestNodeReconciler(t *testing.T) {
 					if err != nil {
 						t.Errorf("Expected to get Gateway but got err: %v", err)
 					} else {
-						if tt.expectedGW.GatewayIP != newGW.GatewayIP || tt.expectedGW.InternalIP != newGW.InternalIP {
+						if tt.expectedGW.GatewayIP != newGW.GatewayIP || tt.expectedGW.InternalIP != newGW.InternalIP ||
+							tt.expectedGW.ProbeIP != newGW.ProbeIP {
 							t.Errorf("Expected Gateway %v but got: %v", tt.expectedGW, newGW)
 						}
 					}
 				}
+				if tt.keptGW != "" {
+					keptGW := &mcv1alpha1.Gateway{}
+					assert.NoError(t, fakeClient.Get(common.TestCtx, types.NamespacedName{Name: tt.keptGW, Namespace: "default"}, keptGW))
+				}
 			}
 		})
 	}
@@ -267,38 +284,26 @@ func TestNodeReconciler(t *testing.T) {
 
 func TestInitialize(t *testing.T) {
 	initializeCommonData()
-	node5 := node1.DeepCopy()
-	node5.Name = "node-5"
-	node5.Annotations = map[string]string{}
 	tests := []struct {
-		name                  string
-		nodes                 []*corev1.Node
-		req                   reconcile.Request
-		existingGW            *mcv1alpha1.Gateway
-		expectedActiveGateway string
-		isDelete              bool
-		candidatesSize        int
+		name       string
+		nodes      []*corev1.Node
+		existingGW *mcv1alpha1.Gateway
+		isDelete   bool
 	}{
 		{
-			name:                  "initialize and set active Gateway successfully",
-			nodes:                 []*corev1.Node{node1, node2, node5},
-			existingGW:            &gwNode1,
-			expectedActiveGateway: "node-1",
-			candidatesSize:        2,
+			name:       "initialize and keep existing Gateway successfully",
+			nodes:      []*corev1.Node{node1, node2},
+			existingGW: &gwNode1,
 		},
 		{
-			name:                  "initialize successfully without Gateway",
-			nodes:                 []*corev1.Node{node3, node4, node5},
-			expectedActiveGateway: "",
-			candidatesSize:        2,
+			name:  "initialize successfully without Gateway",
+			nodes: []*corev1.Node{node3, node4},
 		},
 		{
-			name:                  "initialize and delete Gateway successfully",
-			nodes:                 []*corev1.Node{node1, node5},
-			existingGW:            gateway3,
-			isDelete:              true,
-			expectedActiveGateway: "",
-			candidatesSize:        1,
+			name:       "initialize and delete stale Gateway successfully",
+			nodes:      []*corev1.Node{node1},
+			existingGW: gateway3,
+			isDelete:   true,
 		},
 	}
 
@@ -309,27 +314,27 @@ func TestInitialize(t *testing.T) {
 				obj = append(obj, n)
 			}
 			if tt.existingGW != nil {
-				obj = append(obj, tt.existingGW)
+				obj = append(obj, tt.existingGW.DeepCopy())
 			}
 			fakeClient := fake.NewClientBuilder().WithScheme(common.TestScheme).WithObjects(obj...).Build()
 			fakeRemoteClient := fake.NewClientBuilder().WithScheme(common.TestScheme).WithObjects().Build()
 			commonArea := commonarea.NewFakeRemoteCommonArea(fakeRemoteClient, "leader-cluster", common.LocalClusterID, common.LeaderNamespace, nil)
-			mcReconciler := NewMemberClusterSetReconciler(fakeClient, common.TestScheme, "default", false, false, make(chan struct{}))
+			mcReconciler := NewMemberClusterSetReconciler(fakeClient, common.TestScheme, "default", false, false, make(chan struct{}), nil)
 			mcReconciler.SetRemoteCommonArea(commonArea)
 			commonAreaGetter := mcReconciler
 			r := NewNodeReconciler(fakeClient, common.TestScheme, "default", "10.100.0.0/16", mcv1alpha1.PrecedencePublic, commonAreaGetter)
 			if err := r.initialize(); err != nil {
 				t.Errorf("Expected initialize() successfully but got err: %v", err)
-			} else {
-				assert.Equal(t, tt.expectedActiveGateway, r.activeGateway)
-				assert.Equal(t, tt.candidatesSize, len(r.gatewayCandidates))
+			} else if tt.existingGW != nil {
+				gw := &mcv1alpha1.Gateway{}
+				gwNamespcedName := types.NamespacedName{Name: tt.existingGW.Name, Namespace: "default"}
+				err := fakeClient.Get(common.TestCtx, gwNamespcedName, gw)
 				if tt.isDelete {
-					deletedGW := &mcv1alpha1.Gateway{}
-					gwNamespcedName := types.NamespacedName{Name: tt.existingGW.Name, Namespace: "default"}
-					err := fakeClient.Get(common.TestCtx, gwNamespcedName, deletedGW)
 					if !apierrors.IsNotFound(err) {
 						t.Errorf("Expected to get not found error but got err: %v", err)
 					}
+				} else {
+					assert.NoError(t, err)
 				}
 			}
 		})
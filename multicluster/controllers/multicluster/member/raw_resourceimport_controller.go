@@ -0,0 +1,267 @@
+/*
+Copyright 2024 Antrea Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package member
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/klog/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	multiclusterv1alpha1 "antrea.io/antrea/multicluster/apis/multicluster/v1alpha1"
+	"antrea.io/antrea/multicluster/controllers/multicluster/common"
+)
+
+// isImportedByLocalCluster returns whether the local cluster is one of the member clusters
+// the ResourceImport is imported to.
+func (r *ResourceImportReconciler) isImportedByLocalCluster(resImp *multiclusterv1alpha1.ResourceImport) bool {
+	return len(resImp.Spec.ClusterIDs) == 0 || common.StringExistsInSlice(resImp.Spec.ClusterIDs, r.localClusterID)
+}
+
+// handleResImpUpdateForRaw creates or updates the resource propagated from the leader
+// cluster by a raw ResourceImport, and reports the result in the ResourceImport status.
+func (r *ResourceImportReconciler) handleResImpUpdateForRaw(ctx context.Context, resImp *multiclusterv1alpha1.ResourceImport) (ctrl.Result, error) {
+	obj, kind, err := common.ParsePropagatedResource(resImp.Spec.Raw.Data)
+	if err == nil && kind.Namespaced {
+		err = r.validatePropagationNamespace(ctx, obj.GetNamespace())
+	}
+	if err != nil {
+		klog.ErrorS(err, "Invalid resource in ResourceImport", "resourceimport", klog.KObj(resImp))
+		return ctrl.Result{}, r.reportRawResourceImportStatus(ctx, resImp, multiclusterv1alpha1.ResourcePropagationFailed, err.Error())
+	}
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[common.AntreaMCPropagatedAnnotation] = resImp.Name
+	obj.SetAnnotations(annotations)
+	objName := types.NamespacedName{Namespace: obj.GetNamespace(), Name: obj.GetName()}
+	klog.InfoS("Updating resource corresponding to ResourceImport", "kind", obj.GetKind(),
+		"name", objName.String(), "resourceimport", klog.KObj(resImp))
+
+	existing := &unstructured.Unstructured{}
+	existing.SetGroupVersionKind(obj.GroupVersionKind())
+	err = r.localClusterClient.Get(ctx, objName, existing)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return ctrl.Result{}, err
+	}
+	if apierrors.IsNotFound(err) {
+		if err := r.localClusterClient.Create(ctx, obj, &client.CreateOptions{}); err != nil {
+			klog.ErrorS(err, "Failed to create propagated resource", "kind", obj.GetKind(), "name", objName.String())
+			if reportErr := r.reportRawResourceImportStatus(ctx, resImp, multiclusterv1alpha1.ResourcePropagationFailed, err.Error()); reportErr != nil {
+				klog.ErrorS(reportErr, "Failed to report status of ResourceImport", "resourceimport", klog.KObj(resImp))
+			}
+			return ctrl.Result{}, err
+		}
+		r.installedResImports.Add(*resImp)
+		return ctrl.Result{}, r.reportRawResourceImportStatus(ctx, resImp, multiclusterv1alpha1.ResourcePropagationPropagated, "")
+	}
+
+	var msg string
+	owner, propagated := existing.GetAnnotations()[common.AntreaMCPropagatedAnnotation]
+	if propagated && owner != resImp.Name {
+		// The resource is never taken over from another ResourceImport, regardless of the
+		// conflict policy.
+		msg = fmt.Sprintf("%s %s in cluster %s is propagated by ResourceImport %s", obj.GetKind(), objName.String(), r.localClusterID, owner)
+	} else if !propagated && resImp.Spec.Raw.ConflictPolicy != multiclusterv1alpha1.ConflictPolicyOverwrite {
+		msg = fmt.Sprintf("%s %s already exists in cluster %s", obj.GetKind(), objName.String(), r.localClusterID)
+	}
+	if msg != "" {
+		klog.InfoS("Skipped propagated resource which conflicts with existing one", "kind", obj.GetKind(),
+			"name", objName.String(), "resourceimport", klog.KObj(resImp))
+		return ctrl.Result{}, r.reportRawResourceImportStatus(ctx, resImp, multiclusterv1alpha1.ResourcePropagationConflict, msg)
+	}
+	updated := mergePropagatedResource(existing, obj)
+	if !apiequality.Semantic.DeepEqual(existing, updated) {
+		if err := r.localClusterClient.Update(ctx, updated, &client.UpdateOptions{}); err != nil {
+			klog.ErrorS(err, "Failed to update propagated resource", "kind", obj.GetKind(), "name", objName.String())
+			if reportErr := r.reportRawResourceImportStatus(ctx, resImp, multiclusterv1alpha1.ResourcePropagationFailed, err.Error()); reportErr != nil {
+				klog.ErrorS(reportErr, "Failed to report status of ResourceImport", "resourceimport", klog.KObj(resImp))
+			}
+			return ctrl.Result{}, err
+		}
+	}
+	r.installedResImports.Update(*resImp)
+	return ctrl.Result{}, r.reportRawResourceImportStatus(ctx, resImp, multiclusterv1alpha1.ResourcePropagationPropagated, "")
+}
+
+// validatePropagationNamespace checks that resources can be propagated into the Namespace,
+// which must be labeled with PropagationNamespaceLabel by the member cluster admin. The
+// Namespace of the Multi-cluster Controller, which holds the credential to access the leader
+// cluster, is never allowed.
+func (r *ResourceImportReconciler) validatePropagationNamespace(ctx context.Context, namespace string) error {
+	if namespace == r.namespace {
+		return fmt.Errorf("resources cannot be propagated to Namespace %s of Multi-cluster Controller", namespace)
+	}
+	ns := &corev1.Namespace{}
+	if err := r.localClusterClient.Get(ctx, types.NamespacedName{Name: namespace}, ns); err != nil {
+		if apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to find Namespace %s in cluster %s", namespace, r.localClusterID)
+		}
+		return err
+	}
+	if ns.Labels[common.PropagationNamespaceLabel] != "true" {
+		return fmt.Errorf("label %s=true is required on Namespace %s in cluster %s to allow propagation",
+			common.PropagationNamespaceLabel, namespace, r.localClusterID)
+	}
+	return nil
+}
+
+// mergePropagatedResource returns a copy of the existing resource with the content of the
+// propagated resource. Labels and annotations of the existing resource are kept unless they
+// are overridden by the propagated resource.
+func mergePropagatedResource(existing, propagated *unstructured.Unstructured) *unstructured.Unstructured {
+	updated := existing.DeepCopy()
+	for key := range updated.Object {
+		switch key {
+		case "apiVersion", "kind", "metadata", "status":
+		default:
+			delete(updated.Object, key)
+		}
+	}
+	for key, value := range propagated.Object {
+		switch key {
+		case "apiVersion", "kind", "metadata", "status":
+		default:
+			updated.Object[key] = value
+		}
+	}
+	updated.SetLabels(mergeStringMap(existing.GetLabels(), propagated.GetLabels()))
+	updated.SetAnnotations(mergeStringMap(existing.GetAnnotations(), propagated.GetAnnotations()))
+	return updated
+}
+
+func mergeStringMap(base, override map[string]string) map[string]string {
+	if len(base) == 0 && len(override) == 0 {
+		return nil
+	}
+	merged := make(map[string]string, len(base)+len(override))
+	for k, v := range base {
+		merged[k] = v
+	}
+	for k, v := range override {
+		merged[k] = v
+	}
+	return merged
+}
+
+// handleResImpDeleteForRaw deletes the resource propagated by a raw ResourceImport, when the
+// ResourceImport is deleted or the local cluster is no longer selected. A resource which is
+// not created or overwritten by the propagation is never deleted.
+func (r *ResourceImportReconciler) handleResImpDeleteForRaw(ctx context.Context, resImp *multiclusterv1alpha1.ResourceImport, isDeleted bool) (ctrl.Result, error) {
+	obj, _, err := common.ParsePropagatedResource(resImp.Spec.Raw.Data)
+	if err != nil {
+		r.installedResImports.Delete(*resImp)
+		return ctrl.Result{}, nil
+	}
+	objName := types.NamespacedName{Namespace: obj.GetNamespace(), Name: obj.GetName()}
+	existing := &unstructured.Unstructured{}
+	existing.SetGroupVersionKind(obj.GroupVersionKind())
+	err = r.localClusterClient.Get(ctx, objName, existing)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return ctrl.Result{}, err
+	}
+	if err == nil && existing.GetAnnotations()[common.AntreaMCPropagatedAnnotation] == resImp.Name {
+		klog.InfoS("Deleting resource corresponding to ResourceImport", "kind", obj.GetKind(),
+			"name", objName.String(), "resourceimport", klog.KObj(resImp))
+		if err := client.IgnoreNotFound(r.localClusterClient.Delete(ctx, existing, &client.DeleteOptions{})); err != nil {
+			klog.ErrorS(err, "Failed to delete propagated resource", "kind", obj.GetKind(), "name", objName.String())
+			return ctrl.Result{}, err
+		}
+	}
+	r.installedResImports.Delete(*resImp)
+	if isDeleted {
+		return ctrl.Result{}, nil
+	}
+	return ctrl.Result{}, r.removeRawResourceImportStatus(ctx, resImp)
+}
+
+// reportRawResourceImportStatus updates the status of the local cluster in the raw
+// ResourceImport, which is aggregated into the ResourcePropagation status in the leader
+// cluster.
+func (r *ResourceImportReconciler) reportRawResourceImportStatus(ctx context.Context, resImp *multiclusterv1alpha1.ResourceImport,
+	phase multiclusterv1alpha1.ResourcePropagationPhase, message string) error {
+	status := corev1.ConditionFalse
+	if phase == multiclusterv1alpha1.ResourcePropagationPropagated {
+		status = corev1.ConditionTrue
+	}
+	newStatus := multiclusterv1alpha1.ResourceImportClusterStatus{
+		ClusterID: r.localClusterID,
+		Conditions: []multiclusterv1alpha1.ResourceImportCondition{{
+			Type:               multiclusterv1alpha1.ResourceImportSucceeded,
+			Status:             status,
+			LastTransitionTime: metav1.Now(),
+			Reason:             string(phase),
+			Message:            message,
+		}},
+	}
+	return r.updateRawResourceImportStatus(ctx, resImp, func(clusterStatuses []multiclusterv1alpha1.ResourceImportClusterStatus) ([]multiclusterv1alpha1.ResourceImportClusterStatus, bool) {
+		for i, clusterStatus := range clusterStatuses {
+			if clusterStatus.ClusterID != r.localClusterID {
+				continue
+			}
+			if len(clusterStatus.Conditions) > 0 {
+				oldCondition := clusterStatus.Conditions[0]
+				if oldCondition.Status == status && oldCondition.Reason == string(phase) && oldCondition.Message == message {
+					return clusterStatuses, false
+				}
+			}
+			clusterStatuses[i] = newStatus
+			return clusterStatuses, true
+		}
+		return append(clusterStatuses, newStatus), true
+	})
+}
+
+// removeRawResourceImportStatus removes the status of the local cluster from the raw
+// ResourceImport when the local cluster is no longer selected.
+func (r *ResourceImportReconciler) removeRawResourceImportStatus(ctx context.Context, resImp *multiclusterv1alpha1.ResourceImport) error {
+	return r.updateRawResourceImportStatus(ctx, resImp, func(clusterStatuses []multiclusterv1alpha1.ResourceImportClusterStatus) ([]multiclusterv1alpha1.ResourceImportClusterStatus, bool) {
+		for i, clusterStatus := range clusterStatuses {
+			if clusterStatus.ClusterID == r.localClusterID {
+				return append(clusterStatuses[:i], clusterStatuses[i+1:]...), true
+			}
+		}
+		return clusterStatuses, false
+	})
+}
+
+func (r *ResourceImportReconciler) updateRawResourceImportStatus(ctx context.Context, resImp *multiclusterv1alpha1.ResourceImport,
+	updateFunc func([]multiclusterv1alpha1.ResourceImportClusterStatus) ([]multiclusterv1alpha1.ResourceImportClusterStatus, bool)) error {
+	resImpName := types.NamespacedName{Namespace: resImp.Namespace, Name: resImp.Name}
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		latestResImport := &multiclusterv1alpha1.ResourceImport{}
+		if err := r.remoteCommonArea.Get(ctx, resImpName, latestResImport); err != nil {
+			return client.IgnoreNotFound(err)
+		}
+		clusterStatuses, changed := updateFunc(latestResImport.Status.ClusterStatuses)
+		if !changed {
+			return nil
+		}
+		latestResImport.Status.ClusterStatuses = clusterStatuses
+		return r.remoteCommonArea.Status().Update(ctx, latestResImport, &client.SubResourceUpdateOptions{})
+	})
+}
@@ -0,0 +1,240 @@
+/*
+Copyright 2024 Antrea Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package member
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	mcsv1alpha1 "antrea.io/antrea/multicluster/apis/multicluster/v1alpha1"
+	"antrea.io/antrea/multicluster/controllers/multicluster/common"
+	"antrea.io/antrea/multicluster/controllers/multicluster/commonarea"
+)
+
+var (
+	rawResImportName = "app-ns-app-config-configmap"
+	rawResImportReq  = ctrl.Request{NamespacedName: types.NamespacedName{
+		Namespace: leaderNamespace,
+		Name:      rawResImportName,
+	}}
+	appConfigName = types.NamespacedName{Namespace: "app-ns", Name: "app-config"}
+)
+
+func newRawResourceImport(clusterIDs []string, conflictPolicy mcsv1alpha1.ConflictPolicy) *mcsv1alpha1.ResourceImport {
+	return &mcsv1alpha1.ResourceImport{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: leaderNamespace,
+			Name:      rawResImportName,
+		},
+		Spec: mcsv1alpha1.ResourceImportSpec{
+			ClusterIDs: clusterIDs,
+			Kind:       "ConfigMap",
+			Namespace:  "app-ns",
+			Name:       "app-config",
+			Raw: &mcsv1alpha1.RawResourceImport{
+				Data: []byte(`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"app-config","namespace":"app-ns",` +
+					`"labels":{"app":"demo"}},"data":{"key":"new-value"}}`),
+				ConflictPolicy: conflictPolicy,
+			},
+		},
+	}
+}
+
+func TestResourceImportReconciler_handleRawResImpUpdateEvent(t *testing.T) {
+	propagatedConfigMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   "app-ns",
+			Name:        "app-config",
+			Labels:      map[string]string{"local": "true"},
+			Annotations: map[string]string{common.AntreaMCPropagatedAnnotation: rawResImportName},
+		},
+		Data: map[string]string{"key": "value", "stale-key": "value"},
+	}
+	localConfigMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "app-ns",
+			Name:      "app-config",
+		},
+		Data: map[string]string{"key": "value"},
+	}
+	otherPropagatedConfigMap := localConfigMap.DeepCopy()
+	otherPropagatedConfigMap.Annotations = map[string]string{common.AntreaMCPropagatedAnnotation: "other-resourceimport"}
+	allowedNamespaceLabels := map[string]string{common.PropagationNamespaceLabel: "true"}
+	tests := []struct {
+		name              string
+		namespaceLabels   map[string]string
+		existingConfigMap *corev1.ConfigMap
+		conflictPolicy    mcsv1alpha1.ConflictPolicy
+		expectedData      map[string]string
+		expectedLabels    map[string]string
+		expectedPhase     mcsv1alpha1.ResourcePropagationPhase
+	}{
+		{
+			name:            "create resource",
+			namespaceLabels: allowedNamespaceLabels,
+			conflictPolicy:  mcsv1alpha1.ConflictPolicySkip,
+			expectedData:    map[string]string{"key": "new-value"},
+			expectedLabels:  map[string]string{"app": "demo"},
+			expectedPhase:   mcsv1alpha1.ResourcePropagationPropagated,
+		},
+		{
+			name:              "update previously propagated resource",
+			namespaceLabels:   allowedNamespaceLabels,
+			existingConfigMap: propagatedConfigMap,
+			conflictPolicy:    mcsv1alpha1.ConflictPolicySkip,
+			expectedData:      map[string]string{"key": "new-value"},
+			expectedLabels:    map[string]string{"app": "demo", "local": "true"},
+			expectedPhase:     mcsv1alpha1.ResourcePropagationPropagated,
+		},
+		{
+			name:              "skip conflicting resource",
+			namespaceLabels:   allowedNamespaceLabels,
+			existingConfigMap: localConfigMap,
+			conflictPolicy:    mcsv1alpha1.ConflictPolicySkip,
+			expectedData:      map[string]string{"key": "value"},
+			expectedPhase:     mcsv1alpha1.ResourcePropagationConflict,
+		},
+		{
+			name:              "overwrite conflicting resource",
+			namespaceLabels:   allowedNamespaceLabels,
+			existingConfigMap: localConfigMap,
+			conflictPolicy:    mcsv1alpha1.ConflictPolicyOverwrite,
+			expectedData:      map[string]string{"key": "new-value"},
+			expectedLabels:    map[string]string{"app": "demo"},
+			expectedPhase:     mcsv1alpha1.ResourcePropagationPropagated,
+		},
+		{
+			name:              "never take over resource propagated by another ResourceImport",
+			namespaceLabels:   allowedNamespaceLabels,
+			existingConfigMap: otherPropagatedConfigMap,
+			conflictPolicy:    mcsv1alpha1.ConflictPolicyOverwrite,
+			expectedData:      map[string]string{"key": "value"},
+			expectedPhase:     mcsv1alpha1.ResourcePropagationConflict,
+		},
+		{
+			name:           "Namespace not allowing propagation",
+			conflictPolicy: mcsv1alpha1.ConflictPolicyOverwrite,
+			expectedPhase:  mcsv1alpha1.ResourcePropagationFailed,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			objects := []client.Object{&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "app-ns", Labels: tt.namespaceLabels}}}
+			if tt.existingConfigMap != nil {
+				objects = append(objects, tt.existingConfigMap.DeepCopy())
+			}
+			fakeClient := fake.NewClientBuilder().WithScheme(common.TestScheme).WithObjects(objects...).Build()
+			fakeRemoteClient := fake.NewClientBuilder().WithScheme(common.TestScheme).
+				WithObjects(newRawResourceImport([]string{localClusterID, "cluster-b"}, tt.conflictPolicy)).Build()
+			remoteCluster := commonarea.NewFakeRemoteCommonArea(fakeRemoteClient, "leader-cluster", localClusterID, leaderNamespace, nil)
+			r := newResourceImportReconciler(fakeClient, localClusterID, leaderNamespace, remoteCluster, nil)
+
+			_, err := r.Reconcile(ctx, rawResImportReq)
+			require.NoError(t, err)
+
+			configMap := &corev1.ConfigMap{}
+			err = fakeClient.Get(ctx, appConfigName, configMap)
+			if tt.expectedData == nil {
+				assert.True(t, apierrors.IsNotFound(err))
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedData, configMap.Data)
+				assert.Equal(t, tt.expectedLabels, configMap.Labels)
+			}
+
+			resImport := &mcsv1alpha1.ResourceImport{}
+			require.NoError(t, fakeRemoteClient.Get(ctx, rawResImportReq.NamespacedName, resImport))
+			require.Len(t, resImport.Status.ClusterStatuses, 1)
+			clusterStatus := resImport.Status.ClusterStatuses[0]
+			assert.Equal(t, localClusterID, clusterStatus.ClusterID)
+			assert.Equal(t, string(tt.expectedPhase), clusterStatus.Conditions[0].Reason)
+		})
+	}
+}
+
+func TestResourceImportReconciler_handleRawResImpDeleteEvent(t *testing.T) {
+	propagatedConfigMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   "app-ns",
+			Name:        "app-config",
+			Annotations: map[string]string{common.AntreaMCPropagatedAnnotation: rawResImportName},
+		},
+	}
+	localConfigMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "app-ns",
+			Name:      "app-config",
+		},
+	}
+	tests := []struct {
+		name              string
+		existingConfigMap *corev1.ConfigMap
+		existingResImport *mcsv1alpha1.ResourceImport
+		expectedDeleted   bool
+	}{
+		{
+			name:              "ResourceImport deleted",
+			existingConfigMap: propagatedConfigMap,
+			expectedDeleted:   true,
+		},
+		{
+			name:              "local cluster deselected",
+			existingConfigMap: propagatedConfigMap,
+			existingResImport: newRawResourceImport([]string{"cluster-b"}, mcsv1alpha1.ConflictPolicySkip),
+			expectedDeleted:   true,
+		},
+		{
+			name:              "resource not created by propagation",
+			existingConfigMap: localConfigMap,
+			expectedDeleted:   false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeClient := fake.NewClientBuilder().WithScheme(common.TestScheme).WithObjects(tt.existingConfigMap.DeepCopy()).Build()
+			fakeRemoteClientBuilder := fake.NewClientBuilder().WithScheme(common.TestScheme)
+			if tt.existingResImport != nil {
+				fakeRemoteClientBuilder.WithObjects(tt.existingResImport)
+			}
+			fakeRemoteClient := fakeRemoteClientBuilder.Build()
+			remoteCluster := commonarea.NewFakeRemoteCommonArea(fakeRemoteClient, "leader-cluster", localClusterID, leaderNamespace, nil)
+			r := newResourceImportReconciler(fakeClient, localClusterID, leaderNamespace, remoteCluster, nil)
+			r.installedResImports.Add(*newRawResourceImport(nil, mcsv1alpha1.ConflictPolicySkip))
+
+			_, err := r.Reconcile(ctx, rawResImportReq)
+			require.NoError(t, err)
+
+			err = fakeClient.Get(ctx, appConfigName, &corev1.ConfigMap{})
+			if tt.expectedDeleted {
+				assert.True(t, apierrors.IsNotFound(err))
+			} else {
+				assert.NoError(t, err)
+			}
+			_, exists, _ := r.installedResImports.GetByKey(rawResImportReq.NamespacedName.String())
+			assert.False(t, exists)
+		})
+	}
+}
@@ -0,0 +1,105 @@
+/*
+Copyright 2024 Antrea Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package member
+
+import (
+	"context"
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	mcv1alpha1 "antrea.io/antrea/multicluster/apis/multicluster/v1alpha1"
+	"antrea.io/antrea/multicluster/controllers/multicluster/commonarea"
+)
+
+// mirrorResourceExports copies the ResourceExports of the local cluster from the active leader
+// cluster to a standby leader cluster, and removes the ResourceExports of the local cluster which
+// no longer exist in the active leader cluster from the standby leader cluster. With the same
+// ResourceExports from all member clusters, every leader cluster computes the same ResourceImports,
+// so failing over to a standby leader cluster does not change any imported resources.
+func mirrorResourceExports(ctx context.Context, localClusterID string, activeLeader, standbyLeader commonarea.RemoteCommonArea) error {
+	activeResExports := &mcv1alpha1.ResourceExportList{}
+	if err := activeLeader.List(ctx, activeResExports, client.InNamespace(activeLeader.GetNamespace())); err != nil {
+		return err
+	}
+	desiredResExports := map[string]*mcv1alpha1.ResourceExport{}
+	for i := range activeResExports.Items {
+		resExport := &activeResExports.Items[i]
+		if resExport.Spec.ClusterID != localClusterID || !resExport.DeletionTimestamp.IsZero() {
+			continue
+		}
+		desiredResExports[resExport.Name] = resExport
+	}
+
+	standbyResExports := &mcv1alpha1.ResourceExportList{}
+	if err := standbyLeader.List(ctx, standbyResExports, client.InNamespace(standbyLeader.GetNamespace())); err != nil {
+		return err
+	}
+	for i := range standbyResExports.Items {
+		resExport := &standbyResExports.Items[i]
+		if resExport.Spec.ClusterID != localClusterID {
+			continue
+		}
+		desiredResExport, ok := desiredResExports[resExport.Name]
+		delete(desiredResExports, resExport.Name)
+		if !resExport.DeletionTimestamp.IsZero() {
+			// Wait for the leader cluster to finish the deletion, the ResourceExport will be
+			// created again in the next mirroring if it's still desired.
+			continue
+		}
+		if !ok {
+			klog.V(2).InfoS("Deleting mirrored ResourceExport from standby leader cluster", "resourceexport", klog.KObj(resExport),
+				"cluster", standbyLeader.GetClusterID())
+			if err := standbyLeader.Delete(ctx, resExport, &client.DeleteOptions{}); err != nil {
+				if err = client.IgnoreNotFound(err); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+		if reflect.DeepEqual(resExport.Spec, desiredResExport.Spec) && reflect.DeepEqual(resExport.Labels, desiredResExport.Labels) {
+			continue
+		}
+		klog.V(2).InfoS("Updating mirrored ResourceExport in standby leader cluster", "resourceexport", klog.KObj(resExport),
+			"cluster", standbyLeader.GetClusterID())
+		resExport.Spec = desiredResExport.Spec
+		resExport.Labels = desiredResExport.Labels
+		if err := standbyLeader.Update(ctx, resExport, &client.UpdateOptions{}); err != nil {
+			return err
+		}
+	}
+
+	for _, desiredResExport := range desiredResExports {
+		resExport := &mcv1alpha1.ResourceExport{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       desiredResExport.Name,
+				Namespace:  standbyLeader.GetNamespace(),
+				Labels:     desiredResExport.Labels,
+				Finalizers: desiredResExport.Finalizers,
+			},
+			Spec: desiredResExport.Spec,
+		}
+		klog.V(2).InfoS("Creating mirrored ResourceExport in standby leader cluster", "resourceexport", klog.KObj(resExport),
+			"cluster", standbyLeader.GetClusterID())
+		if err := standbyLeader.Create(ctx, resExport, &client.CreateOptions{}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
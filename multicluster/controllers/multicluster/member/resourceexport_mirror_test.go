@@ -0,0 +1,115 @@
+/*
+Copyright 2024 Antrea Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package member
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"antrea.io/antrea/multicluster/apis/multicluster/constants"
+	mcv1alpha1 "antrea.io/antrea/multicluster/apis/multicluster/v1alpha1"
+	"antrea.io/antrea/multicluster/controllers/multicluster/common"
+	"antrea.io/antrea/multicluster/controllers/multicluster/commonarea"
+)
+
+func newTestLabelIdentityResExport(clusterID, name, normalizedLabel string) *mcv1alpha1.ResourceExport {
+	return &mcv1alpha1.ResourceExport{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      name,
+			Labels: map[string]string{
+				constants.SourceKind:      constants.LabelIdentityKind,
+				constants.SourceClusterID: clusterID,
+			},
+			Finalizers: []string{constants.ResourceExportFinalizer},
+		},
+		Spec: mcv1alpha1.ResourceExportSpec{
+			ClusterID: clusterID,
+			Kind:      constants.LabelIdentityKind,
+			LabelIdentity: &mcv1alpha1.LabelIdentityExport{
+				NormalizedLabel: normalizedLabel,
+			},
+		},
+	}
+}
+
+func TestMirrorResourceExports(t *testing.T) {
+	localClusterID := "cluster-a"
+	deletingResExport := newTestLabelIdentityResExport(localClusterID, "deleting", "ns:kubernetes.io/metadata.name=ns&pod:app=deleting")
+	deletingResExport.DeletionTimestamp = &metav1.Time{Time: metav1.Now().Time}
+	activeResExports := []*mcv1alpha1.ResourceExport{
+		newTestLabelIdentityResExport(localClusterID, "new", "ns:kubernetes.io/metadata.name=ns&pod:app=new"),
+		newTestLabelIdentityResExport(localClusterID, "updated", "ns:kubernetes.io/metadata.name=ns&pod:app=updated"),
+		newTestLabelIdentityResExport(localClusterID, "unchanged", "ns:kubernetes.io/metadata.name=ns&pod:app=unchanged"),
+		newTestLabelIdentityResExport("cluster-b", "from-cluster-b", "ns:kubernetes.io/metadata.name=ns&pod:app=b"),
+		deletingResExport,
+	}
+	standbyResExports := []*mcv1alpha1.ResourceExport{
+		newTestLabelIdentityResExport(localClusterID, "updated", "ns:kubernetes.io/metadata.name=ns&pod:app=outdated"),
+		newTestLabelIdentityResExport(localClusterID, "unchanged", "ns:kubernetes.io/metadata.name=ns&pod:app=unchanged"),
+		newTestLabelIdentityResExport(localClusterID, "stale", "ns:kubernetes.io/metadata.name=ns&pod:app=stale"),
+		newTestLabelIdentityResExport(localClusterID, "deleting", "ns:kubernetes.io/metadata.name=ns&pod:app=deleting"),
+		newTestLabelIdentityResExport("cluster-c", "from-cluster-c", "ns:kubernetes.io/metadata.name=ns&pod:app=c"),
+	}
+
+	activeClientBuilder := fake.NewClientBuilder().WithScheme(common.TestScheme)
+	for _, resExport := range activeResExports {
+		activeClientBuilder.WithObjects(resExport)
+	}
+	standbyClientBuilder := fake.NewClientBuilder().WithScheme(common.TestScheme)
+	for _, resExport := range standbyResExports {
+		standbyClientBuilder.WithObjects(resExport)
+	}
+	standbyClient := standbyClientBuilder.Build()
+	activeLeader := commonarea.NewFakeRemoteCommonArea(activeClientBuilder.Build(), "leader1", localClusterID, "default", nil)
+	standbyLeader := commonarea.NewFakeRemoteCommonArea(standbyClient, "leader2", localClusterID, "default", nil)
+
+	require.NoError(t, mirrorResourceExports(common.TestCtx, localClusterID, activeLeader, standbyLeader))
+
+	expectedLabels := map[string]string{
+		"new":            "ns:kubernetes.io/metadata.name=ns&pod:app=new",
+		"updated":        "ns:kubernetes.io/metadata.name=ns&pod:app=updated",
+		"unchanged":      "ns:kubernetes.io/metadata.name=ns&pod:app=unchanged",
+		"from-cluster-c": "ns:kubernetes.io/metadata.name=ns&pod:app=c",
+	}
+	for name, normalizedLabel := range expectedLabels {
+		resExport := &mcv1alpha1.ResourceExport{}
+		require.NoError(t, standbyClient.Get(common.TestCtx, types.NamespacedName{Namespace: "default", Name: name}, resExport))
+		assert.Equal(t, normalizedLabel, resExport.Spec.LabelIdentity.NormalizedLabel)
+	}
+	mirroredResExport := &mcv1alpha1.ResourceExport{}
+	require.NoError(t, standbyClient.Get(common.TestCtx, types.NamespacedName{Namespace: "default", Name: "new"}, mirroredResExport))
+	assert.Equal(t, []string{constants.ResourceExportFinalizer}, mirroredResExport.Finalizers)
+	assert.Equal(t, localClusterID, mirroredResExport.Labels[constants.SourceClusterID])
+
+	for _, name := range []string{"stale", "deleting", "from-cluster-b"} {
+		resExport := &mcv1alpha1.ResourceExport{}
+		err := standbyClient.Get(common.TestCtx, types.NamespacedName{Namespace: "default", Name: name}, resExport)
+		if err == nil {
+			// The fake client keeps an object with finalizers and sets its DeletionTimestamp.
+			assert.False(t, resExport.DeletionTimestamp.IsZero(), "ResourceExport %s should be deleted", name)
+		} else {
+			assert.True(t, apierrors.IsNotFound(err), "ResourceExport %s should be deleted", name)
+		}
+	}
+}
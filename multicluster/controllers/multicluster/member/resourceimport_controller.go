@@ -62,17 +62,23 @@ type ResourceImportReconciler struct {
 	namespace           string
 	remoteCommonArea    commonarea.RemoteCommonArea
 	installedResImports cache.Indexer
+	// globalIPReconciler is used to translate the global IPs of the local cluster's Pods
+	// in imported Endpoints back to Pod IPs. It's nil when the member cluster has no
+	// global Pod CIDR.
+	globalIPReconciler *GlobalIPReconciler
 	// Saved Manager to indicate SetupWithManager() is done or not.
 	manager ctrl.Manager
 }
 
 func newResourceImportReconciler(localClusterClient client.Client,
-	localClusterID string, namespace string, remoteCommonArea commonarea.RemoteCommonArea) *ResourceImportReconciler {
+	localClusterID string, namespace string, remoteCommonArea commonarea.RemoteCommonArea,
+	globalIPReconciler *GlobalIPReconciler) *ResourceImportReconciler {
 	return &ResourceImportReconciler{
 		localClusterClient: localClusterClient,
 		localClusterID:     localClusterID,
 		namespace:          namespace,
 		remoteCommonArea:   remoteCommonArea,
+		globalIPReconciler: globalIPReconciler,
 		installedResImports: cache.NewIndexer(resImportIndexerKeyFunc, cache.Indexers{
 			resImportIndexer: resImportIndexerFunc,
 		}),
@@ -80,7 +86,12 @@ func newResourceImportReconciler(localClusterClient client.Client,
 }
 
 // +kubebuilder:rbac:groups=crd.antrea.io,resources=clusternetworkpolicies,verbs=get;list;watch;create;update;patch;delete
-// +kubebuilder:rbac:groups=crd.antrea.io,resources=tiers,verbs=get;list;watch
+// +kubebuilder:rbac:groups=crd.antrea.io,resources=tiers,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=crd.antrea.io,resources=clustergroups;egresses;externalippools,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="",resources=configmaps;secrets,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=crd.antrea.io,resources=traceflows,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=crd.antrea.io,resources=traceflows/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups="",resources=pods,verbs=get;list;watch
 // +kubebuilder:rbac:groups=multicluster.crd.antrea.io,resources=resourceimports,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=multicluster.crd.antrea.io,resources=resourceimports/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=multicluster.crd.antrea.io,resources=resourceimports/finalizers,verbs=update
@@ -135,8 +146,28 @@ func (r *ResourceImportReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 			return r.handleResImpDeleteForClusterInfo(ctx, req, &resImp)
 		}
 		return r.handleResImpUpdateForClusterInfo(ctx, req, &resImp)
+	case constants.TraceflowKind:
+		if resImp.Spec.Raw == nil {
+			return ctrl.Result{}, nil
+		}
+		if isDeleted || !r.isImportedByLocalCluster(&resImp) {
+			return r.handleResImpDeleteForTraceflow(ctx, &resImp)
+		}
+		return r.handleResImpUpdateForTraceflow(ctx, &resImp)
+	case constants.TraceflowResultKind:
+		if resImp.Spec.Raw == nil || isDeleted || !r.isImportedByLocalCluster(&resImp) {
+			return ctrl.Result{}, nil
+		}
+		return r.handleResImpUpdateForTraceflowResult(ctx, &resImp)
+	default:
+		if resImp.Spec.Raw == nil {
+			return ctrl.Result{}, nil
+		}
+		if isDeleted || !r.isImportedByLocalCluster(&resImp) {
+			return r.handleResImpDeleteForRaw(ctx, &resImp, isDeleted)
+		}
+		return r.handleResImpUpdateForRaw(ctx, &resImp)
 	}
-	return ctrl.Result{}, nil
 }
 
 func (r *ResourceImportReconciler) handleResImpUpdateForService(ctx context.Context, resImp *multiclusterv1alpha1.ResourceImport) (ctrl.Result, error) {
@@ -160,7 +191,13 @@ func (r *ResourceImportReconciler) handleResImpUpdateForService(ctx context.Cont
 			return ctrl.Result{}, err
 		}
 	}
-	svcObj := getMCService(resImp)
+	// The traffic policy of the multi-cluster Service is specified by the ServiceExport in
+	// the local cluster, if the local cluster exports the Service too.
+	svcExport := &k8smcsv1alpha1.ServiceExport{}
+	if err := r.localClusterClient.Get(ctx, svcImpName, svcExport); err != nil && !apierrors.IsNotFound(err) {
+		return ctrl.Result{}, err
+	}
+	svcObj := getMCService(resImp, getServiceTrafficPolicy(svcExport))
 	if svcNotFound {
 		err := r.localClusterClient.Create(ctx, svcObj, &client.CreateOptions{})
 		if err != nil {
@@ -172,6 +209,17 @@ func (r *ResourceImportReconciler) handleResImpUpdateForService(ctx context.Cont
 			// and ServiceImport are created later
 			klog.ErrorS(err, "Failed to get latest imported Service", "service", klog.KObj(svcObj))
 		}
+	} else {
+		// TODO: check label difference ?
+		policyChanged := setServiceTrafficPolicy(svc, svcObj.Annotations[common.ServiceTrafficPolicyAnnotation])
+		if policyChanged || !apiequality.Semantic.DeepEqual(svc.Spec.Ports, svcObj.Spec.Ports) {
+			svc.Spec.Ports = svcObj.Spec.Ports
+			err = r.localClusterClient.Update(ctx, svc, &client.UpdateOptions{})
+			if err != nil {
+				klog.ErrorS(err, "Failed to update imported Service", "service", svcName.String())
+				return ctrl.Result{}, err
+			}
+		}
 	}
 
 	svcImp := &k8smcsv1alpha1.ServiceImport{}
@@ -199,16 +247,6 @@ func (r *ResourceImportReconciler) handleResImpUpdateForService(ctx context.Cont
 		return ctrl.Result{}, nil
 	}
 
-	// TODO: check label difference ?
-	if !apiequality.Semantic.DeepEqual(svc.Spec.Ports, svcObj.Spec.Ports) {
-		svc.Spec.Ports = svcObj.Spec.Ports
-		err = r.localClusterClient.Update(ctx, svc, &client.UpdateOptions{})
-		if err != nil {
-			klog.ErrorS(err, "Failed to update imported Service", "service", svcName.String())
-			return ctrl.Result{}, err
-		}
-	}
-
 	if !apiequality.Semantic.DeepEqual(svcImp.Spec, svcImpObj.Spec) {
 		svcImp.Spec = svcImpObj.Spec
 		err = r.localClusterClient.Update(ctx, svcImp, &client.UpdateOptions{})
@@ -274,7 +312,10 @@ func (r *ResourceImportReconciler) handleResImpUpdateForEndpoints(ctx context.Co
 		}
 	}
 
-	newSubsets := resImp.Spec.Endpoints.Subsets
+	newSubsets, err := r.toLocalSubsets(ctx, resImp.Spec.Endpoints.Subsets)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
 	mcsEpObj := &corev1.Endpoints{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:        epName,
@@ -309,6 +350,30 @@ func (r *ResourceImportReconciler) handleResImpUpdateForEndpoints(ctx context.Co
 	return ctrl.Result{}, nil
 }
 
+// toLocalSubsets translates the global IPs of the local cluster's Pods in the imported
+// Endpoints subsets to Pod IPs, as the local cluster's global Pod CIDR is only routed by
+// the other member clusters.
+func (r *ResourceImportReconciler) toLocalSubsets(ctx context.Context, subsets []corev1.EndpointSubset) ([]corev1.EndpointSubset, error) {
+	if r.globalIPReconciler == nil {
+		return subsets, nil
+	}
+	newSubsets := make([]corev1.EndpointSubset, 0, len(subsets))
+	for _, subset := range subsets {
+		newSubset := *subset.DeepCopy()
+		for i := range newSubset.Addresses {
+			podIP, ok, err := r.globalIPReconciler.getPodIP(ctx, newSubset.Addresses[i].IP)
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				newSubset.Addresses[i].IP = podIP
+			}
+		}
+		newSubsets = append(newSubsets, newSubset)
+	}
+	return newSubsets, nil
+}
+
 func (r *ResourceImportReconciler) handleResImpDeleteForEndpoints(ctx context.Context, resImp *multiclusterv1alpha1.ResourceImport) (ctrl.Result, error) {
 	epName := common.ToMCResourceName(resImp.Spec.Name)
 	epNamespacedName := common.NamespacedName(resImp.Spec.Namespace, epName)
@@ -330,7 +395,7 @@ func (r *ResourceImportReconciler) handleResImpDeleteForEndpoints(ctx context.Co
 	return ctrl.Result{}, nil
 }
 
-func getMCService(resImp *multiclusterv1alpha1.ResourceImport) *corev1.Service {
+func getMCService(resImp *multiclusterv1alpha1.ResourceImport, trafficPolicy string) *corev1.Service {
 	var mcsPorts []corev1.ServicePort
 	for _, p := range resImp.Spec.ServiceImport.Spec.Ports {
 		mcsPorts = append(mcsPorts, corev1.ServicePort{
@@ -352,6 +417,7 @@ func getMCService(resImp *multiclusterv1alpha1.ResourceImport) *corev1.Service {
 			Ports: mcsPorts,
 		},
 	}
+	setServiceTrafficPolicy(mcs, trafficPolicy)
 	return mcs
 }
 
@@ -135,7 +135,7 @@ func TestResourceImportReconciler_handleCreateEvent(t *testing.T) {
 		},
 	}
 
-	r := newResourceImportReconciler(fakeClient, localClusterID, "default", remoteCluster)
+	r := newResourceImportReconciler(fakeClient, localClusterID, "default", remoteCluster, nil)
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			if _, err := r.Reconcile(ctx, tt.req); err != nil {
@@ -164,6 +164,73 @@ func TestResourceImportReconciler_handleCreateEvent(t *testing.T) {
 	}
 }
 
+func TestResourceImportReconciler_ServiceTrafficPolicy(t *testing.T) {
+	svcExportPreferLocal := &k8smcsapi.ServiceExport{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   "default",
+			Name:        "nginx",
+			Annotations: map[string]string{common.ServiceTrafficPolicyAnnotation: common.ServiceTrafficPolicyPreferLocal},
+		},
+	}
+	importedSvc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   "default",
+			Name:        "antrea-mc-nginx",
+			Annotations: map[string]string{common.AntreaMCServiceAnnotation: "true"},
+		},
+		Spec: corev1.ServiceSpec{
+			Type:      corev1.ServiceTypeClusterIP,
+			ClusterIP: "10.96.10.10",
+			Ports: []corev1.ServicePort{
+				{
+					Name:     "http",
+					Protocol: corev1.ProtocolTCP,
+					Port:     80,
+				},
+			},
+		},
+	}
+	importedSvcPreferLocal := importedSvc.DeepCopy()
+	importedSvcPreferLocal.Annotations[common.ServiceTrafficPolicyAnnotation] = common.ServiceTrafficPolicyPreferLocal
+
+	tests := []struct {
+		name           string
+		existingObjs   []client.Object
+		expectedPolicy string
+	}{
+		{
+			name:           "create Service with the policy of local ServiceExport",
+			existingObjs:   []client.Object{svcExportPreferLocal},
+			expectedPolicy: common.ServiceTrafficPolicyPreferLocal,
+		},
+		{
+			name:           "update Service with the policy of local ServiceExport",
+			existingObjs:   []client.Object{svcExportPreferLocal, importedSvc},
+			expectedPolicy: common.ServiceTrafficPolicyPreferLocal,
+		},
+		{
+			name:           "remove the policy without local ServiceExport",
+			existingObjs:   []client.Object{importedSvcPreferLocal},
+			expectedPolicy: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeClient := fake.NewClientBuilder().WithScheme(common.TestScheme).WithObjects(tt.existingObjs...).Build()
+			fakeRemoteClient := fake.NewClientBuilder().WithScheme(common.TestScheme).WithObjects(svcResImport).Build()
+			remoteCluster := commonarea.NewFakeRemoteCommonArea(fakeRemoteClient, "leader-cluster", localClusterID, "default", nil)
+			r := newResourceImportReconciler(fakeClient, localClusterID, "default", remoteCluster, nil)
+			if _, err := r.Reconcile(ctx, svcImportReq); err != nil {
+				assert.Contains(t, err.Error(), "ClusterSetIP is empty")
+			}
+			svc := &corev1.Service{}
+			assert.NoError(t, fakeClient.Get(ctx, types.NamespacedName{Namespace: "default", Name: "antrea-mc-nginx"}, svc))
+			assert.Equal(t, tt.expectedPolicy, svc.Annotations[common.ServiceTrafficPolicyAnnotation])
+		})
+	}
+}
+
 func TestResourceImportReconciler_handleDeleteEvent(t *testing.T) {
 	existSvc := &corev1.Service{
 		ObjectMeta: metav1.ObjectMeta{
@@ -205,7 +272,7 @@ func TestResourceImportReconciler_handleDeleteEvent(t *testing.T) {
 		},
 	}
 
-	r := newResourceImportReconciler(fakeClient, localClusterID, "default", remoteCluster)
+	r := newResourceImportReconciler(fakeClient, localClusterID, "default", remoteCluster, nil)
 	r.installedResImports.Add(*svcResImport)
 	r.installedResImports.Add(*epResImport)
 
@@ -446,7 +513,7 @@ func TestResourceImportReconciler_handleUpdateEvent(t *testing.T) {
 		},
 	}
 
-	r := newResourceImportReconciler(fakeClient, localClusterID, "default", remoteCluster)
+	r := newResourceImportReconciler(fakeClient, localClusterID, "default", remoteCluster, nil)
 	r.installedResImports.Add(*svcResImport)
 	r.installedResImports.Add(*epResImport)
 
@@ -551,7 +618,7 @@ func TestStaleControllerNoRaceWithResourceImportReconciler(t *testing.T) {
 	fakeRemoteClient := fake.NewClientBuilder().WithScheme(common.TestScheme).WithLists().Build()
 	ca := commonarea.NewFakeRemoteCommonArea(fakeRemoteClient, "leader-cluster", common.LocalClusterID, "antrea-mcs", nil)
 
-	mcReconciler := NewMemberClusterSetReconciler(fakeClient, common.TestScheme, "default", true, false, make(chan struct{}))
+	mcReconciler := NewMemberClusterSetReconciler(fakeClient, common.TestScheme, "default", true, false, make(chan struct{}), nil)
 	mcReconciler.SetRemoteCommonArea(ca)
 	c := NewStaleResCleanupController(fakeClient, common.TestScheme, make(chan struct{}), "default", mcReconciler)
 	go func() {
@@ -79,6 +79,9 @@ type (
 		localClusterID       string
 		endpointIPType       string
 		endpointSliceEnabled bool
+		// globalIPReconciler allocates global IPs to the Pods backing exported Services.
+		// It's nil when the member cluster has no global Pod CIDR.
+		globalIPReconciler *GlobalIPReconciler
 	}
 )
 
@@ -99,7 +102,8 @@ func NewServiceExportReconciler(
 	commonAreaGetter commonarea.RemoteCommonAreaGetter,
 	endpointIPType string,
 	endpointSliceEnabled bool,
-	namespace string) *ServiceExportReconciler {
+	namespace string,
+	globalIPReconciler *GlobalIPReconciler) *ServiceExportReconciler {
 	reconciler := &ServiceExportReconciler{
 		Client:               client,
 		Scheme:               scheme,
@@ -107,6 +111,7 @@ func NewServiceExportReconciler(
 		commonAreaGetter:     commonAreaGetter,
 		endpointIPType:       endpointIPType,
 		endpointSliceEnabled: endpointSliceEnabled,
+		globalIPReconciler:   globalIPReconciler,
 		installedSvcs:        cache.NewIndexer(svcInfoKeyFunc, cache.Indexers{}),
 		installedEps:         cache.NewIndexer(epInfoKeyFunc, cache.Indexers{}),
 	}
@@ -158,6 +163,7 @@ func (r *ServiceExportReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 	if requeue := r.checkRemoteCommonArea(); requeue {
 		return ctrl.Result{Requeue: true}, nil
 	}
+	commonArea := r.getRemoteCommonArea()
 	var svcExport k8smcsv1alpha1.ServiceExport
 	svcObj, svcInstalled, _ := r.installedSvcs.GetByKey(req.String())
 	epsObj, epsInstalled, _ := r.installedEps.GetByKey(req.String())
@@ -168,11 +174,11 @@ func (r *ServiceExportReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 		// When controller restarts, the Service is not in cache, but it is still possible
 		// we need to remove ResourceExports. So leave it to the caller to check the 'svcInstalled'
 		// before deletion or try to delete any way.
-		err = r.handleServiceDeleteEvent(ctx, req, r.remoteCommonArea)
+		err = r.handleServiceDeleteEvent(ctx, req, commonArea)
 		if err != nil {
 			return err
 		}
-		err = r.handleEndpointDeleteEvent(ctx, req, r.remoteCommonArea)
+		err = r.handleEndpointDeleteEvent(ctx, req, commonArea)
 		if err != nil {
 			return err
 		}
@@ -190,6 +196,9 @@ func (r *ServiceExportReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 			klog.ErrorS(err, "Unable to fetch ServiceExport", "serviceexport", req.String())
 			return ctrl.Result{}, err
 		}
+		if err := r.syncMCServiceTrafficPolicy(ctx, req, ""); err != nil {
+			return ctrl.Result{}, err
+		}
 		// Stale resources will be cleaned up by stale controller if controller restart,
 		// so here we check if Service is installed or not to avoid unnecessary deletion.
 		if svcInstalled {
@@ -200,6 +209,10 @@ func (r *ServiceExportReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 		return ctrl.Result{}, nil
 	}
 
+	if err := r.syncMCServiceTrafficPolicy(ctx, req, getServiceTrafficPolicy(&svcExport)); err != nil {
+		return ctrl.Result{}, err
+	}
+
 	// If the corresponding Service doesn't exist, update ServiceExport's status reason to
 	// 'service_not_found', and clean up remote ResourceExport.
 	svc := &corev1.Service{}
@@ -264,6 +277,16 @@ func (r *ServiceExportReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 			return ctrl.Result{}, err
 		}
 	}
+	if hasReadyEndpoints && r.endpointIPType == common.EndpointIPTypePodIP && r.globalIPReconciler != nil {
+		// Export the global IPs of the Pods instead of the Pod IPs, which might overlap with
+		// the Pod IPs of other member clusters.
+		newSubsets, err = r.globalIPReconciler.translateSubsets(ctx, req.Namespace, newSubsets)
+		if err != nil {
+			klog.ErrorS(err, "Failed to translate Endpoints to global IPs", "service", req.String())
+			return ctrl.Result{}, err
+		}
+		hasReadyEndpoints = len(newSubsets) > 0
+	}
 
 	if !hasReadyEndpoints {
 		// When the controller restarts, `svcInstalled` is false as the cache will be empty, but the available Endpoints of
@@ -340,7 +363,7 @@ func (r *ServiceExportReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 	if !skipUpdateSvcResourceExport {
 		klog.InfoS("Service has new changes, update ResourceExport", "service", req.String(),
 			"resourceexport", svcExportNSName)
-		err := r.serviceHandler(ctx, req, svc, svcResExportName, re, r.remoteCommonArea)
+		err := r.serviceHandler(ctx, req, svc, svcResExportName, re, commonArea)
 		if err != nil {
 			klog.ErrorS(err, "Failed to handle Service change", "service", req.String())
 			return ctrl.Result{}, err
@@ -356,7 +379,7 @@ func (r *ServiceExportReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 		eps.Subsets = newSubsets
 		klog.InfoS("Endpoints or EndpointSlices has new changes, update ResourceExport", "Service",
 			req.String(), "resourceexport", epExportNSName)
-		err = r.endpointsHandler(ctx, req, eps, epResExportName, re, r.remoteCommonArea)
+		err = r.endpointsHandler(ctx, req, eps, epResExportName, re, commonArea)
 		if err != nil {
 			klog.ErrorS(err, "Failed to handle Endpoints or EndpointSlices change", "service", req.String())
 			return ctrl.Result{}, err
@@ -366,17 +389,38 @@ func (r *ServiceExportReconciler) Reconcile(ctx context.Context, req ctrl.Reques
 	return ctrl.Result{}, nil
 }
 
+// syncMCServiceTrafficPolicy sets the traffic policy of the ServiceExport to the multi-cluster
+// Service imported to the local cluster, which is used by AntreaProxy to select the Endpoints
+// of the multi-cluster Service.
+func (r *ServiceExportReconciler) syncMCServiceTrafficPolicy(ctx context.Context, req ctrl.Request, policy string) error {
+	mcSvc := &corev1.Service{}
+	mcSvcName := types.NamespacedName{Namespace: req.Namespace, Name: common.ToMCResourceName(req.Name)}
+	if err := r.Client.Get(ctx, mcSvcName, mcSvc); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	if !common.IsMulticlusterService(mcSvc) || !setServiceTrafficPolicy(mcSvc, policy) {
+		return nil
+	}
+	klog.InfoS("Updating traffic policy of multi-cluster Service", "service", mcSvcName.String(), "policy", policy)
+	if err := r.Client.Update(ctx, mcSvc, &client.UpdateOptions{}); err != nil {
+		klog.ErrorS(err, "Failed to update traffic policy of multi-cluster Service", "service", mcSvcName.String())
+		return client.IgnoreNotFound(err)
+	}
+	return nil
+}
+
 // checkRemoteCommonArea initializes remoteCommonArea for the reconciler if necessary,
 // or tells the Reconcile function to requeue if the remoteCommonArea is not ready.
+// remoteCommonArea is updated when the member cluster fails over to another leader cluster.
 func (r *ServiceExportReconciler) checkRemoteCommonArea() bool {
 	r.mutex.Lock()
 	defer r.mutex.Unlock()
 
-	if r.remoteCommonArea == nil {
-		commonArea, localClusterID, _ := r.commonAreaGetter.GetRemoteCommonAreaAndLocalID()
-		if commonArea == nil {
-			return true
-		}
+	commonArea, localClusterID, _ := r.commonAreaGetter.GetRemoteCommonAreaAndLocalID()
+	if commonArea == nil {
+		return r.remoteCommonArea == nil
+	}
+	if r.remoteCommonArea != commonArea {
 		r.leaderClusterID, r.localClusterID = string(commonArea.GetClusterID()), localClusterID
 		r.leaderNamespace = commonArea.GetNamespace()
 		r.remoteCommonArea = commonArea
@@ -384,6 +428,12 @@ func (r *ServiceExportReconciler) checkRemoteCommonArea() bool {
 	return false
 }
 
+func (r *ServiceExportReconciler) getRemoteCommonArea() commonarea.RemoteCommonArea {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return r.remoteCommonArea
+}
+
 func (r *ServiceExportReconciler) handleServiceDeleteEvent(ctx context.Context, req ctrl.Request,
 	commonArea commonarea.RemoteCommonArea) error {
 	svcResExportName := getResourceExportName(r.localClusterID, req, "service")
@@ -812,6 +862,35 @@ func ipsToEndpointAddresses(ips []string) []corev1.EndpointAddress {
 	return addresses
 }
 
+// getServiceTrafficPolicy returns the traffic policy specified in the annotation of the
+// ServiceExport. An invalid policy is ignored and the default policy will be used.
+func getServiceTrafficPolicy(svcExport *k8smcsv1alpha1.ServiceExport) string {
+	policy := svcExport.Annotations[common.ServiceTrafficPolicyAnnotation]
+	switch policy {
+	case "", common.ServiceTrafficPolicyCluster, common.ServiceTrafficPolicyPreferLocal:
+		return policy
+	}
+	klog.InfoS("Ignored invalid traffic policy of ServiceExport", "serviceexport", klog.KObj(svcExport), "policy", policy)
+	return ""
+}
+
+// setServiceTrafficPolicy sets the traffic policy annotation of a multi-cluster Service. It
+// returns true if the annotation is changed.
+func setServiceTrafficPolicy(svc *corev1.Service, policy string) bool {
+	if svc.Annotations[common.ServiceTrafficPolicyAnnotation] == policy {
+		return false
+	}
+	if policy == "" {
+		delete(svc.Annotations, common.ServiceTrafficPolicyAnnotation)
+		return true
+	}
+	if svc.Annotations == nil {
+		svc.Annotations = map[string]string{}
+	}
+	svc.Annotations[common.ServiceTrafficPolicyAnnotation] = policy
+	return true
+}
+
 func getResourceExportName(clusterID string, req ctrl.Request, kind string) string {
 	return clusterID + "-" + req.Namespace + "-" + req.Name + "-" + kind
 }
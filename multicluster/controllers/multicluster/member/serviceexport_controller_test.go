@@ -80,9 +80,9 @@ func TestServiceExportReconciler_handleDeleteEvent(t *testing.T) {
 	fakeRemoteClient := fake.NewClientBuilder().WithScheme(common.TestScheme).WithObjects(existSvcResExport, existEpResExport).Build()
 
 	commonArea := commonarea.NewFakeRemoteCommonArea(fakeRemoteClient, "leader-cluster", common.LocalClusterID, "default", nil)
-	mcReconciler := NewMemberClusterSetReconciler(fakeClient, common.TestScheme, "default", false, false, make(chan struct{}))
+	mcReconciler := NewMemberClusterSetReconciler(fakeClient, common.TestScheme, "default", false, false, make(chan struct{}), nil)
 	mcReconciler.SetRemoteCommonArea(commonArea)
-	r := NewServiceExportReconciler(fakeClient, common.TestScheme, mcReconciler, "ClusterIP", false, "default")
+	r := NewServiceExportReconciler(fakeClient, common.TestScheme, mcReconciler, "ClusterIP", false, "default", nil)
 	r.installedSvcs.Add(&svcInfo{
 		name:      common.SvcNginx.Name,
 		namespace: common.SvcNginx.Namespace,
@@ -275,9 +275,9 @@ func TestServiceExportReconciler_CheckExportStatus(t *testing.T) {
 	fakeRemoteClient := fake.NewClientBuilder().WithScheme(common.TestScheme).Build()
 	commonArea := commonarea.NewFakeRemoteCommonArea(fakeRemoteClient, "leader-cluster", common.LocalClusterID, "default", nil)
 
-	mcReconciler := NewMemberClusterSetReconciler(fakeClient, common.TestScheme, "default", false, false, make(chan struct{}))
+	mcReconciler := NewMemberClusterSetReconciler(fakeClient, common.TestScheme, "default", false, false, make(chan struct{}), nil)
 	mcReconciler.SetRemoteCommonArea(commonArea)
-	r := NewServiceExportReconciler(fakeClient, common.TestScheme, mcReconciler, "ClusterIP", false, "default")
+	r := NewServiceExportReconciler(fakeClient, common.TestScheme, mcReconciler, "ClusterIP", false, "default", nil)
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			if _, err := r.Reconcile(common.TestCtx, tt.req); err != nil {
@@ -351,9 +351,9 @@ func TestServiceExportReconciler_handleServiceExportCreateEvent(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			fakeRemoteClient := fake.NewClientBuilder().WithScheme(common.TestScheme).Build()
 			commonArea := commonarea.NewFakeRemoteCommonArea(fakeRemoteClient, "leader-cluster", common.LocalClusterID, "default", nil)
-			mcReconciler := NewMemberClusterSetReconciler(tt.fakeClient, common.TestScheme, "default", false, false, make(chan struct{}))
+			mcReconciler := NewMemberClusterSetReconciler(tt.fakeClient, common.TestScheme, "default", false, false, make(chan struct{}), nil)
 			mcReconciler.SetRemoteCommonArea(commonArea)
-			r := NewServiceExportReconciler(tt.fakeClient, common.TestScheme, mcReconciler, tt.endpointIPType, tt.endpointSliceEnabled, "default")
+			r := NewServiceExportReconciler(tt.fakeClient, common.TestScheme, mcReconciler, tt.endpointIPType, tt.endpointSliceEnabled, "default", nil)
 			if _, err := r.Reconcile(common.TestCtx, nginxReq); err != nil {
 				t.Errorf("ServiceExport Reconciler should create ResourceExports but got error = %v", err)
 			} else {
@@ -381,6 +381,71 @@ func TestServiceExportReconciler_handleServiceExportCreateEvent(t *testing.T) {
 	}
 }
 
+func TestServiceExportReconciler_syncMCServiceTrafficPolicy(t *testing.T) {
+	mcSvcNginx := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   "default",
+			Name:        "antrea-mc-nginx",
+			Annotations: map[string]string{common.AntreaMCServiceAnnotation: "true"},
+		},
+	}
+	mcSvcNginxPreferLocal := mcSvcNginx.DeepCopy()
+	mcSvcNginxPreferLocal.Annotations[common.ServiceTrafficPolicyAnnotation] = common.ServiceTrafficPolicyPreferLocal
+	svcExportPreferLocal := existSvcExport.DeepCopy()
+	svcExportPreferLocal.Annotations = map[string]string{common.ServiceTrafficPolicyAnnotation: common.ServiceTrafficPolicyPreferLocal}
+	svcExportInvalidPolicy := existSvcExport.DeepCopy()
+	svcExportInvalidPolicy.Annotations = map[string]string{common.ServiceTrafficPolicyAnnotation: "Local"}
+	otherSvcExport := &k8smcv1alpha1.ServiceExport{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "other",
+		},
+	}
+
+	tests := []struct {
+		name           string
+		existingObjs   []client.Object
+		expectedPolicy string
+	}{
+		{
+			name:           "set PreferLocal policy to multi-cluster Service",
+			existingObjs:   []client.Object{common.SvcNginx.DeepCopy(), common.EPNginx.DeepCopy(), svcExportPreferLocal, mcSvcNginx.DeepCopy()},
+			expectedPolicy: common.ServiceTrafficPolicyPreferLocal,
+		},
+		{
+			name:           "remove policy when ServiceExport has no policy",
+			existingObjs:   []client.Object{common.SvcNginx.DeepCopy(), common.EPNginx.DeepCopy(), existSvcExport.DeepCopy(), mcSvcNginxPreferLocal.DeepCopy()},
+			expectedPolicy: "",
+		},
+		{
+			name:           "remove policy when ServiceExport has invalid policy",
+			existingObjs:   []client.Object{common.SvcNginx.DeepCopy(), common.EPNginx.DeepCopy(), svcExportInvalidPolicy, mcSvcNginxPreferLocal.DeepCopy()},
+			expectedPolicy: "",
+		},
+		{
+			name:           "remove policy when ServiceExport is deleted",
+			existingObjs:   []client.Object{common.SvcNginx.DeepCopy(), common.EPNginx.DeepCopy(), otherSvcExport, mcSvcNginxPreferLocal.DeepCopy()},
+			expectedPolicy: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeClient := fake.NewClientBuilder().WithScheme(common.TestScheme).WithObjects(tt.existingObjs...).Build()
+			fakeRemoteClient := fake.NewClientBuilder().WithScheme(common.TestScheme).Build()
+			commonArea := commonarea.NewFakeRemoteCommonArea(fakeRemoteClient, "leader-cluster", common.LocalClusterID, "default", nil)
+			mcReconciler := NewMemberClusterSetReconciler(fakeClient, common.TestScheme, "default", false, false, make(chan struct{}), nil)
+			mcReconciler.SetRemoteCommonArea(commonArea)
+			r := NewServiceExportReconciler(fakeClient, common.TestScheme, mcReconciler, "ClusterIP", false, "default", nil)
+			_, err := r.Reconcile(common.TestCtx, nginxReq)
+			assert.NoError(t, err)
+			mcSvc := &corev1.Service{}
+			assert.NoError(t, fakeClient.Get(common.TestCtx, types.NamespacedName{Namespace: "default", Name: "antrea-mc-nginx"}, mcSvc))
+			assert.Equal(t, tt.expectedPolicy, mcSvc.Annotations[common.ServiceTrafficPolicyAnnotation])
+		})
+	}
+}
+
 func TestServiceExportReconciler_handleUpdateEvent(t *testing.T) {
 	sinfo := &svcInfo{
 		name:       common.SvcNginx.Name,
@@ -534,9 +599,9 @@ func TestServiceExportReconciler_handleUpdateEvent(t *testing.T) {
 			fakeRemoteClient := fake.NewClientBuilder().WithScheme(common.TestScheme).WithObjects(existSvcRe, existEpRe).Build()
 
 			commonArea := commonarea.NewFakeRemoteCommonArea(fakeRemoteClient, "leader-cluster", common.LocalClusterID, "default", nil)
-			mcReconciler := NewMemberClusterSetReconciler(fakeClient, common.TestScheme, "default", false, false, make(chan struct{}))
+			mcReconciler := NewMemberClusterSetReconciler(fakeClient, common.TestScheme, "default", false, false, make(chan struct{}), nil)
 			mcReconciler.SetRemoteCommonArea(commonArea)
-			r := NewServiceExportReconciler(fakeClient, common.TestScheme, mcReconciler, tt.endpointIPType, false, "default")
+			r := NewServiceExportReconciler(fakeClient, common.TestScheme, mcReconciler, tt.endpointIPType, false, "default", nil)
 			r.installedSvcs.Add(sinfo)
 			r.installedEps.Add(epInfo)
 			if _, err := r.Reconcile(common.TestCtx, nginxReq); err != nil {
@@ -697,16 +762,16 @@ func TestClusterSetMapFunc_ServiceExport(t *testing.T) {
 		},
 	}
 	fakeClient := fake.NewClientBuilder().WithScheme(common.TestScheme).WithObjects(clusterSet).WithLists(serviceExports).Build()
-	r := NewServiceExportReconciler(fakeClient, common.TestScheme, nil, "PodIP", true, clusterSet.Namespace)
+	r := NewServiceExportReconciler(fakeClient, common.TestScheme, nil, "PodIP", true, clusterSet.Namespace, nil)
 	requests := r.clusterSetMapFunc(clusterSet)
 	assert.Equal(t, expectedReqs, requests)
 
-	r = NewServiceExportReconciler(fakeClient, common.TestScheme, nil, "PodIP", true, "mismatch_ns")
+	r = NewServiceExportReconciler(fakeClient, common.TestScheme, nil, "PodIP", true, "mismatch_ns", nil)
 	requests = r.clusterSetMapFunc(clusterSet)
 	assert.Equal(t, []reconcile.Request{}, requests)
 
 	// non-existing ClusterSet
-	r = NewServiceExportReconciler(fakeClient, common.TestScheme, nil, "PodIP", true, "default")
+	r = NewServiceExportReconciler(fakeClient, common.TestScheme, nil, "PodIP", true, "default", nil)
 	r.installedSvcs.Add(&svcInfo{name: "nginx-stale", namespace: "default"})
 	r.installedEps.Add(&epInfo{name: "nginx-stale", namespace: "default"})
 	requests = r.clusterSetMapFunc(clusterSet2)
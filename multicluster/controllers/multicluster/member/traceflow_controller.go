@@ -0,0 +1,309 @@
+/*
+Copyright 2024 Antrea Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package member
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	"antrea.io/antrea/multicluster/apis/multicluster/constants"
+	mcv1alpha1 "antrea.io/antrea/multicluster/apis/multicluster/v1alpha1"
+	mcv1alpha2 "antrea.io/antrea/multicluster/apis/multicluster/v1alpha2"
+	"antrea.io/antrea/multicluster/controllers/multicluster/common"
+	"antrea.io/antrea/multicluster/controllers/multicluster/commonarea"
+	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
+)
+
+// TraceflowReconciler is for member cluster only. For a cross-cluster Traceflow, whose
+// destination is in another member cluster, it exports a linked Traceflow to the leader
+// cluster, which is created in the destination member cluster by the ResourceImportReconciler
+// with the same data plane tag. For a linked Traceflow, it exports the status back to the
+// source member cluster, where the results are merged into the cross-cluster Traceflow.
+type TraceflowReconciler struct {
+	client.Client
+	Scheme           *runtime.Scheme
+	commonAreaMutex  sync.Mutex
+	commonAreaGetter commonarea.RemoteCommonAreaGetter
+	remoteCommonArea commonarea.RemoteCommonArea
+	namespace        string
+	localClusterID   string
+}
+
+func NewTraceflowReconciler(
+	client client.Client,
+	scheme *runtime.Scheme,
+	commonAreaGetter commonarea.RemoteCommonAreaGetter,
+	namespace string) *TraceflowReconciler {
+	return &TraceflowReconciler{
+		Client:           client,
+		Scheme:           scheme,
+		namespace:        namespace,
+		commonAreaGetter: commonAreaGetter,
+	}
+}
+
+// +kubebuilder:rbac:groups=crd.antrea.io,resources=traceflows,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=crd.antrea.io,resources=traceflows/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=multicluster.crd.antrea.io,resources=resourceexports,verbs=get;list;watch;create;update;patch;delete
+func (r *TraceflowReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	klog.V(2).InfoS("Reconciling Traceflow", "traceflow", req.Name)
+	if skip := r.checkRemoteCommonArea(); skip {
+		return ctrl.Result{}, nil
+	}
+	tf := &crdv1beta1.Traceflow{}
+	if err := r.Client.Get(ctx, req.NamespacedName, tf); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return ctrl.Result{}, err
+		}
+		// The Traceflow could be either a cross-cluster Traceflow or a linked Traceflow.
+		if err := r.deleteResourceExport(ctx, getTraceflowResourceExportName(r.localClusterID, req.Name, constants.TraceflowKind)); err != nil {
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{}, r.deleteResourceExport(ctx, getTraceflowResourceExportName(r.localClusterID, req.Name, constants.TraceflowResultKind))
+	}
+	if tf.Spec.Destination.ClusterID != "" {
+		return ctrl.Result{}, r.handleCrossClusterTraceflow(ctx, tf)
+	}
+	if tf.Spec.Source.ClusterID != "" {
+		return ctrl.Result{}, r.handleLinkedTraceflow(ctx, tf)
+	}
+	return ctrl.Result{}, nil
+}
+
+// handleCrossClusterTraceflow exports the linked Traceflow of a cross-cluster Traceflow to the
+// destination member cluster, once the data plane tag is allocated by antrea-controller, and
+// deletes the exported linked Traceflow when the cross-cluster Traceflow is completed.
+func (r *TraceflowReconciler) handleCrossClusterTraceflow(ctx context.Context, tf *crdv1beta1.Traceflow) error {
+	resExportName := getTraceflowResourceExportName(r.localClusterID, tf.Name, constants.TraceflowKind)
+	switch tf.Status.Phase {
+	case crdv1beta1.Pending:
+		if tf.Spec.Destination.ClusterID == r.localClusterID {
+			update := tf.DeepCopy()
+			update.Status.Phase = crdv1beta1.Failed
+			update.Status.Reason = fmt.Sprintf("destination cluster %s is the local cluster", r.localClusterID)
+			return r.Client.Status().Update(ctx, update, &client.SubResourceUpdateOptions{})
+		}
+		if tf.Status.DataplaneTag == 0 {
+			return nil
+		}
+		linkedTF := &crdv1beta1.Traceflow{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: getLinkedTraceflowName(r.localClusterID, tf.Name),
+			},
+			Spec: crdv1beta1.TraceflowSpec{
+				Source: crdv1beta1.Source{
+					Namespace: tf.Spec.Source.Namespace,
+					Pod:       tf.Spec.Source.Pod,
+					ClusterID: r.localClusterID,
+				},
+				Destination: crdv1beta1.Destination{
+					Namespace: tf.Spec.Destination.Namespace,
+					Pod:       tf.Spec.Destination.Pod,
+					Service:   tf.Spec.Destination.Service,
+				},
+				Packet:  tf.Spec.Packet,
+				Timeout: tf.Spec.Timeout,
+			},
+			Status: crdv1beta1.TraceflowStatus{
+				DataplaneTag: tf.Status.DataplaneTag,
+			},
+		}
+		return r.updateOrCreateResourceExport(ctx, resExportName, constants.TraceflowKind, linkedTF.Name, linkedTF, tf.Spec.Destination.ClusterID)
+	case crdv1beta1.Succeeded, crdv1beta1.Failed:
+		return r.deleteResourceExport(ctx, resExportName)
+	}
+	return nil
+}
+
+// handleLinkedTraceflow exports the status of a linked Traceflow to the source member cluster
+// as a Traceflow with the name of the cross-cluster Traceflow.
+func (r *TraceflowReconciler) handleLinkedTraceflow(ctx context.Context, tf *crdv1beta1.Traceflow) error {
+	if tf.Status.Phase == "" {
+		return nil
+	}
+	sourceClusterID := tf.Spec.Source.ClusterID
+	result := &crdv1beta1.Traceflow{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: strings.TrimPrefix(tf.Name, sourceClusterID+"-"),
+		},
+		Status: *tf.Status.DeepCopy(),
+	}
+	for i := range result.Status.Results {
+		result.Status.Results[i].ClusterID = r.localClusterID
+	}
+	resExportName := getTraceflowResourceExportName(r.localClusterID, tf.Name, constants.TraceflowResultKind)
+	return r.updateOrCreateResourceExport(ctx, resExportName, constants.TraceflowResultKind, tf.Name, result, sourceClusterID)
+}
+
+// updateOrCreateResourceExport exports the Traceflow as a raw ResourceExport, which is imported
+// only by the given member cluster. Both kinds of ResourceExports are named after the linked
+// Traceflow, which is unique in the ClusterSet as it's prefixed with the source ClusterID.
+func (r *TraceflowReconciler) updateOrCreateResourceExport(ctx context.Context, resExportName, kind, linkedName string,
+	tf *crdv1beta1.Traceflow, clusterID string) error {
+	tf.SetGroupVersionKind(crdv1beta1.SchemeGroupVersion.WithKind(constants.TraceflowKind))
+	data, err := json.Marshal(tf)
+	if err != nil {
+		return err
+	}
+	remoteCommonArea := r.getRemoteCommonArea()
+	resExportNamespaced := types.NamespacedName{Namespace: remoteCommonArea.GetNamespace(), Name: resExportName}
+	existingResExport := &mcv1alpha1.ResourceExport{}
+	err = remoteCommonArea.Get(ctx, resExportNamespaced, existingResExport)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	if apierrors.IsNotFound(err) {
+		resExport := &mcv1alpha1.ResourceExport{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      resExportName,
+				Namespace: remoteCommonArea.GetNamespace(),
+				Labels: map[string]string{
+					constants.SourceKind:      kind,
+					constants.SourceName:      linkedName,
+					constants.SourceNamespace: "",
+					constants.SourceClusterID: r.localClusterID,
+				},
+				Finalizers: []string{constants.ResourceExportFinalizer},
+			},
+			Spec: mcv1alpha1.ResourceExportSpec{
+				ClusterID: r.localClusterID,
+				Name:      linkedName,
+				Kind:      kind,
+				Raw: &mcv1alpha1.RawResourceExport{
+					Data:       data,
+					ClusterIDs: []string{clusterID},
+				},
+			},
+		}
+		klog.InfoS("Creating ResourceExport for Traceflow", "resourceexport", resExportNamespaced.String(), "kind", kind)
+		return remoteCommonArea.Create(ctx, resExport, &client.CreateOptions{})
+	}
+	if existingResExport.Spec.Raw != nil && bytes.Equal(existingResExport.Spec.Raw.Data, data) {
+		return nil
+	}
+	existingResExport.Spec.Raw = &mcv1alpha1.RawResourceExport{
+		Data:       data,
+		ClusterIDs: []string{clusterID},
+	}
+	klog.V(2).InfoS("Updating ResourceExport for Traceflow", "resourceexport", resExportNamespaced.String(), "kind", kind)
+	return remoteCommonArea.Update(ctx, existingResExport, &client.UpdateOptions{})
+}
+
+func (r *TraceflowReconciler) deleteResourceExport(ctx context.Context, resExportName string) error {
+	remoteCommonArea := r.getRemoteCommonArea()
+	resExport := &mcv1alpha1.ResourceExport{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      resExportName,
+			Namespace: remoteCommonArea.GetNamespace(),
+		},
+	}
+	return client.IgnoreNotFound(remoteCommonArea.Delete(ctx, resExport, &client.DeleteOptions{}))
+}
+
+// checkRemoteCommonArea initializes remoteCommonArea for the reconciler if necessary,
+// or tells the Reconcile function to skip if the remoteCommonArea is not ready.
+// remoteCommonArea is updated when the member cluster fails over to another leader cluster.
+func (r *TraceflowReconciler) checkRemoteCommonArea() bool {
+	r.commonAreaMutex.Lock()
+	defer r.commonAreaMutex.Unlock()
+
+	commonArea, localClusterID, _ := r.commonAreaGetter.GetRemoteCommonAreaAndLocalID()
+	if commonArea == nil {
+		return r.remoteCommonArea == nil
+	}
+	if r.remoteCommonArea != commonArea {
+		r.remoteCommonArea, r.localClusterID = commonArea, localClusterID
+	}
+	return false
+}
+
+func (r *TraceflowReconciler) getRemoteCommonArea() commonarea.RemoteCommonArea {
+	r.commonAreaMutex.Lock()
+	defer r.commonAreaMutex.Unlock()
+	return r.remoteCommonArea
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *TraceflowReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&crdv1beta1.Traceflow{}).
+		Watches(&source.Kind{Type: &mcv1alpha2.ClusterSet{}},
+			handler.EnqueueRequestsFromMapFunc(r.clusterSetMapFunc),
+			builder.WithPredicates(statusReadyPredicate)).
+		WithEventFilter(predicate.ResourceVersionChangedPredicate{}).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: common.DefaultWorkerCount,
+		}).
+		Complete(r)
+}
+
+// clusterSetMapFunc handles ClusterSet events by enqueuing all cross-cluster and linked
+// Traceflows into the reconciler processing queue.
+func (r *TraceflowReconciler) clusterSetMapFunc(a client.Object) []reconcile.Request {
+	requests := []reconcile.Request{}
+	if a.GetNamespace() != r.namespace {
+		return requests
+	}
+	clusterSet := &mcv1alpha2.ClusterSet{}
+	ctx := context.TODO()
+	if err := r.Client.Get(ctx, types.NamespacedName{Namespace: a.GetNamespace(), Name: a.GetName()}, clusterSet); err != nil {
+		return requests
+	}
+	if len(clusterSet.Status.Conditions) == 0 || clusterSet.Status.Conditions[0].Status != v1.ConditionTrue {
+		return requests
+	}
+	tfList := &crdv1beta1.TraceflowList{}
+	if err := r.Client.List(ctx, tfList); err != nil {
+		klog.ErrorS(err, "Failed to list Traceflows")
+		return requests
+	}
+	for _, tf := range tfList.Items {
+		if tf.Spec.Destination.ClusterID != "" || tf.Spec.Source.ClusterID != "" {
+			requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Name: tf.Name}})
+		}
+	}
+	return requests
+}
+
+// getLinkedTraceflowName returns the name of the linked Traceflow in the destination member
+// cluster for a cross-cluster Traceflow.
+func getLinkedTraceflowName(sourceClusterID, name string) string {
+	return sourceClusterID + "-" + name
+}
+
+func getTraceflowResourceExportName(clusterID, name, kind string) string {
+	return clusterID + "-" + name + "-" + strings.ToLower(kind)
+}
@@ -0,0 +1,190 @@
+/*
+Copyright 2024 Antrea Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package member
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"antrea.io/antrea/multicluster/apis/multicluster/constants"
+	mcv1alpha1 "antrea.io/antrea/multicluster/apis/multicluster/v1alpha1"
+	"antrea.io/antrea/multicluster/controllers/multicluster/common"
+	"antrea.io/antrea/multicluster/controllers/multicluster/commonarea"
+	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
+)
+
+func newTraceflowReconciler(fakeClient, fakeRemoteClient client.Client) *TraceflowReconciler {
+	commonArea := commonarea.NewFakeRemoteCommonArea(fakeRemoteClient, "leader-cluster", common.LocalClusterID, common.LeaderNamespace, nil)
+	mcReconciler := NewMemberClusterSetReconciler(fakeClient, common.TestScheme, "default", false, false, make(chan struct{}), nil)
+	mcReconciler.SetRemoteCommonArea(commonArea)
+	return NewTraceflowReconciler(fakeClient, common.TestScheme, mcReconciler, "default")
+}
+
+func TestTraceflowReconciler_CrossClusterTraceflow(t *testing.T) {
+	resExportName := types.NamespacedName{Namespace: common.LeaderNamespace, Name: "cluster-a-tf1-traceflow"}
+	newTraceflow := func(phase crdv1beta1.TraceflowPhase, dstClusterID string) *crdv1beta1.Traceflow {
+		return &crdv1beta1.Traceflow{
+			ObjectMeta: metav1.ObjectMeta{Name: "tf1"},
+			Spec: crdv1beta1.TraceflowSpec{
+				Source:      crdv1beta1.Source{Namespace: "ns1", Pod: "pod1"},
+				Destination: crdv1beta1.Destination{Namespace: "ns2", Pod: "pod2", ClusterID: dstClusterID},
+				Timeout:     10,
+			},
+			Status: crdv1beta1.TraceflowStatus{
+				Phase:        phase,
+				DataplaneTag: 7,
+			},
+		}
+	}
+	existingResExport := &mcv1alpha1.ResourceExport{
+		ObjectMeta: metav1.ObjectMeta{Namespace: common.LeaderNamespace, Name: "cluster-a-tf1-traceflow"},
+		Spec: mcv1alpha1.ResourceExportSpec{
+			Kind: constants.TraceflowKind,
+			Name: "cluster-a-tf1",
+			Raw:  &mcv1alpha1.RawResourceExport{Data: []byte(`{}`)},
+		},
+	}
+	tests := []struct {
+		name                 string
+		traceflow            *crdv1beta1.Traceflow
+		existingResExport    *mcv1alpha1.ResourceExport
+		expectedResExport    bool
+		expectedFailedReason string
+	}{
+		{
+			name:              "export linked Traceflow",
+			traceflow:         newTraceflow(crdv1beta1.Pending, "cluster-b"),
+			expectedResExport: true,
+		},
+		{
+			name:              "delete linked Traceflow when completed",
+			traceflow:         newTraceflow(crdv1beta1.Succeeded, "cluster-b"),
+			existingResExport: existingResExport,
+		},
+		{
+			name:              "delete linked Traceflow when deleted",
+			existingResExport: existingResExport,
+		},
+		{
+			name:                 "destination in local cluster",
+			traceflow:            newTraceflow(crdv1beta1.Pending, "cluster-a"),
+			expectedFailedReason: "destination cluster cluster-a is the local cluster",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeClientBuilder := fake.NewClientBuilder().WithScheme(common.TestScheme)
+			if tt.traceflow != nil {
+				fakeClientBuilder.WithObjects(tt.traceflow)
+			}
+			fakeClient := fakeClientBuilder.Build()
+			fakeRemoteClientBuilder := fake.NewClientBuilder().WithScheme(common.TestScheme)
+			if tt.existingResExport != nil {
+				fakeRemoteClientBuilder.WithObjects(tt.existingResExport.DeepCopy())
+			}
+			fakeRemoteClient := fakeRemoteClientBuilder.Build()
+			r := newTraceflowReconciler(fakeClient, fakeRemoteClient)
+
+			_, err := r.Reconcile(common.TestCtx, ctrl.Request{NamespacedName: types.NamespacedName{Name: "tf1"}})
+			require.NoError(t, err)
+
+			resExport := &mcv1alpha1.ResourceExport{}
+			err = fakeRemoteClient.Get(common.TestCtx, resExportName, resExport)
+			if !tt.expectedResExport {
+				assert.True(t, apierrors.IsNotFound(err))
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, constants.TraceflowKind, resExport.Spec.Kind)
+				assert.Equal(t, "cluster-a-tf1", resExport.Spec.Name)
+				assert.Equal(t, "cluster-a-tf1", resExport.Labels[constants.SourceName])
+				assert.Equal(t, []string{"cluster-b"}, resExport.Spec.Raw.ClusterIDs)
+				assert.Equal(t, []string{constants.ResourceExportFinalizer}, resExport.Finalizers)
+				linkedTF, err := parseTraceflow(resExport.Spec.Raw.Data)
+				require.NoError(t, err)
+				assert.Equal(t, "cluster-a-tf1", linkedTF.Name)
+				assert.Equal(t, crdv1beta1.Source{Namespace: "ns1", Pod: "pod1", ClusterID: "cluster-a"}, linkedTF.Spec.Source)
+				assert.Equal(t, crdv1beta1.Destination{Namespace: "ns2", Pod: "pod2"}, linkedTF.Spec.Destination)
+				assert.Equal(t, int32(10), linkedTF.Spec.Timeout)
+				assert.Equal(t, int8(7), linkedTF.Status.DataplaneTag)
+			}
+
+			if tt.expectedFailedReason != "" {
+				tf := &crdv1beta1.Traceflow{}
+				require.NoError(t, fakeClient.Get(common.TestCtx, types.NamespacedName{Name: "tf1"}, tf))
+				assert.Equal(t, crdv1beta1.Failed, tf.Status.Phase)
+				assert.Equal(t, tt.expectedFailedReason, tf.Status.Reason)
+			}
+		})
+	}
+}
+
+func TestTraceflowReconciler_LinkedTraceflow(t *testing.T) {
+	linkedTF := &crdv1beta1.Traceflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "cluster-b-tf1"},
+		Spec: crdv1beta1.TraceflowSpec{
+			Source:      crdv1beta1.Source{Namespace: "ns1", Pod: "pod1", ClusterID: "cluster-b"},
+			Destination: crdv1beta1.Destination{Namespace: "ns2", Pod: "pod2"},
+		},
+		Status: crdv1beta1.TraceflowStatus{
+			Phase:         crdv1beta1.Succeeded,
+			DestinationIP: "10.10.1.2",
+			Results: []crdv1beta1.NodeResult{{
+				Node:         "node-a",
+				Observations: []crdv1beta1.Observation{{Action: crdv1beta1.ActionDelivered}},
+			}},
+		},
+	}
+	fakeClient := fake.NewClientBuilder().WithScheme(common.TestScheme).WithObjects(linkedTF).Build()
+	fakeRemoteClient := fake.NewClientBuilder().WithScheme(common.TestScheme).Build()
+	r := newTraceflowReconciler(fakeClient, fakeRemoteClient)
+
+	_, err := r.Reconcile(common.TestCtx, ctrl.Request{NamespacedName: types.NamespacedName{Name: "cluster-b-tf1"}})
+	require.NoError(t, err)
+
+	resExport := &mcv1alpha1.ResourceExport{}
+	resExportName := types.NamespacedName{Namespace: common.LeaderNamespace, Name: "cluster-a-cluster-b-tf1-traceflowresult"}
+	require.NoError(t, fakeRemoteClient.Get(common.TestCtx, resExportName, resExport))
+	assert.Equal(t, constants.TraceflowResultKind, resExport.Spec.Kind)
+	assert.Equal(t, "cluster-b-tf1", resExport.Spec.Name)
+	assert.Equal(t, []string{"cluster-b"}, resExport.Spec.Raw.ClusterIDs)
+	result, err := parseTraceflow(resExport.Spec.Raw.Data)
+	require.NoError(t, err)
+	assert.Equal(t, "tf1", result.Name)
+	assert.Equal(t, crdv1beta1.Succeeded, result.Status.Phase)
+	assert.Equal(t, "10.10.1.2", result.Status.DestinationIP)
+	require.Len(t, result.Status.Results, 1)
+	assert.Equal(t, "cluster-a", result.Status.Results[0].ClusterID)
+
+	// The exported result is deleted when the linked Traceflow is deleted.
+	require.NoError(t, fakeClient.Delete(common.TestCtx, linkedTF))
+	_, err = r.Reconcile(common.TestCtx, ctrl.Request{NamespacedName: types.NamespacedName{Name: "cluster-b-tf1"}})
+	require.NoError(t, err)
+	err = fakeRemoteClient.Get(common.TestCtx, resExportName, resExport)
+	if err == nil {
+		assert.False(t, resExport.DeletionTimestamp.IsZero())
+	} else {
+		assert.True(t, apierrors.IsNotFound(err))
+	}
+}
@@ -0,0 +1,216 @@
+/*
+Copyright 2024 Antrea Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package member
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/klog/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	multiclusterv1alpha1 "antrea.io/antrea/multicluster/apis/multicluster/v1alpha1"
+	"antrea.io/antrea/multicluster/controllers/multicluster/common"
+	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
+)
+
+// handleResImpUpdateForTraceflow creates the linked Traceflow of a cross-cluster Traceflow in
+// the destination member cluster. The destination IP is resolved in the local cluster, and the
+// data plane tag allocated in the source member cluster is set in the status, so the linked
+// Traceflow is started by antrea-controller and antrea-agents with the same tag.
+func (r *ResourceImportReconciler) handleResImpUpdateForTraceflow(ctx context.Context, resImp *multiclusterv1alpha1.ResourceImport) (ctrl.Result, error) {
+	linkedTF, err := parseTraceflow(resImp.Spec.Raw.Data)
+	if err != nil {
+		klog.ErrorS(err, "Invalid Traceflow in ResourceImport", "resourceimport", klog.KObj(resImp))
+		return ctrl.Result{}, nil
+	}
+	tf := &crdv1beta1.Traceflow{}
+	err = r.localClusterClient.Get(ctx, types.NamespacedName{Name: linkedTF.Name}, tf)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return ctrl.Result{}, err
+	}
+	if apierrors.IsNotFound(err) {
+		tf = &crdv1beta1.Traceflow{
+			ObjectMeta: metav1.ObjectMeta{Name: linkedTF.Name},
+			Spec:       linkedTF.Spec,
+		}
+		klog.InfoS("Creating linked Traceflow corresponding to ResourceImport", "traceflow", tf.Name, "resourceimport", klog.KObj(resImp))
+		if err := r.localClusterClient.Create(ctx, tf, &client.CreateOptions{}); err != nil {
+			klog.ErrorS(err, "Failed to create linked Traceflow", "traceflow", tf.Name)
+			return ctrl.Result{}, err
+		}
+	} else if tf.Spec.Source.ClusterID == "" {
+		klog.InfoS("Skipped linked Traceflow which conflicts with existing one", "traceflow", tf.Name, "resourceimport", klog.KObj(resImp))
+		return ctrl.Result{}, nil
+	}
+	r.installedResImports.Add(*resImp)
+	if tf.Status.Phase != "" {
+		return ctrl.Result{}, nil
+	}
+
+	// The status of a linked Traceflow is initialized here, as the data plane tag must be
+	// the same as the one allocated in the source member cluster.
+	update := tf.DeepCopy()
+	update.Status.DataplaneTag = linkedTF.Status.DataplaneTag
+	update.Status.Phase = crdv1beta1.Pending
+	destinationIP, err := r.getTraceflowDestinationIP(ctx, tf)
+	if err != nil {
+		update.Status.Phase = crdv1beta1.Failed
+		update.Status.Reason = err.Error()
+		update.Status.DataplaneTag = 0
+	} else {
+		update.Status.DestinationIP = destinationIP
+	}
+	if err := r.localClusterClient.Status().Update(ctx, update, &client.SubResourceUpdateOptions{}); err != nil {
+		klog.ErrorS(err, "Failed to update status of linked Traceflow", "traceflow", tf.Name)
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{}, nil
+}
+
+// getTraceflowDestinationIP returns the IP of the destination Pod or Service of a linked
+// Traceflow, which is reachable from the source member cluster through the Multi-cluster
+// Gateways. The global IP is used for a Pod if it has one allocated.
+func (r *ResourceImportReconciler) getTraceflowDestinationIP(ctx context.Context, tf *crdv1beta1.Traceflow) (string, error) {
+	isIPv6 := tf.Spec.Packet.IPv6Header != nil
+	family := "IPv4"
+	if isIPv6 {
+		family = "IPv6"
+	}
+	matchFamily := func(ip string) bool {
+		parsedIP := net.ParseIP(ip)
+		return parsedIP != nil && (parsedIP.To4() == nil) == isIPv6
+	}
+	dst := tf.Spec.Destination
+	if dst.Pod != "" {
+		pod := &corev1.Pod{}
+		if err := r.localClusterClient.Get(ctx, types.NamespacedName{Namespace: dst.Namespace, Name: dst.Pod}, pod); err != nil {
+			return "", fmt.Errorf("failed to get destination Pod %s in cluster %s: %v", common.NamespacedName(dst.Namespace, dst.Pod), r.localClusterID, err)
+		}
+		if globalIP := pod.Annotations[common.PodGlobalIPAnnotation]; globalIP != "" && matchFamily(globalIP) {
+			return globalIP, nil
+		}
+		for _, podIP := range pod.Status.PodIPs {
+			if matchFamily(podIP.IP) {
+				return podIP.IP, nil
+			}
+		}
+		return "", fmt.Errorf("destination Pod %s does not have an %s address", common.NamespacedName(dst.Namespace, dst.Pod), family)
+	}
+	svc := &corev1.Service{}
+	if err := r.localClusterClient.Get(ctx, types.NamespacedName{Namespace: dst.Namespace, Name: dst.Service}, svc); err != nil {
+		return "", fmt.Errorf("failed to get destination Service %s in cluster %s: %v", common.NamespacedName(dst.Namespace, dst.Service), r.localClusterID, err)
+	}
+	for _, clusterIP := range svc.Spec.ClusterIPs {
+		if matchFamily(clusterIP) {
+			return clusterIP, nil
+		}
+	}
+	return "", fmt.Errorf("destination Service %s does not have an %s ClusterIP", common.NamespacedName(dst.Namespace, dst.Service), family)
+}
+
+// handleResImpDeleteForTraceflow deletes the linked Traceflow when the cross-cluster Traceflow
+// is completed or deleted in the source member cluster.
+func (r *ResourceImportReconciler) handleResImpDeleteForTraceflow(ctx context.Context, resImp *multiclusterv1alpha1.ResourceImport) (ctrl.Result, error) {
+	tf := &crdv1beta1.Traceflow{}
+	err := r.localClusterClient.Get(ctx, types.NamespacedName{Name: resImp.Spec.Name}, tf)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return ctrl.Result{}, err
+	}
+	if err == nil && tf.Spec.Source.ClusterID != "" {
+		klog.InfoS("Deleting linked Traceflow corresponding to ResourceImport", "traceflow", tf.Name, "resourceimport", klog.KObj(resImp))
+		if err := client.IgnoreNotFound(r.localClusterClient.Delete(ctx, tf, &client.DeleteOptions{})); err != nil {
+			klog.ErrorS(err, "Failed to delete linked Traceflow", "traceflow", tf.Name)
+			return ctrl.Result{}, err
+		}
+	}
+	r.installedResImports.Delete(*resImp)
+	return ctrl.Result{}, nil
+}
+
+// handleResImpUpdateForTraceflowResult merges the results of a linked Traceflow into the
+// cross-cluster Traceflow in the source member cluster. The cross-cluster Traceflow is moved
+// to the Running phase when the linked Traceflow is started, and fails when the linked
+// Traceflow fails.
+func (r *ResourceImportReconciler) handleResImpUpdateForTraceflowResult(ctx context.Context, resImp *multiclusterv1alpha1.ResourceImport) (ctrl.Result, error) {
+	result, err := parseTraceflow(resImp.Spec.Raw.Data)
+	if err != nil {
+		klog.ErrorS(err, "Invalid Traceflow result in ResourceImport", "resourceimport", klog.KObj(resImp))
+		return ctrl.Result{}, nil
+	}
+	err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		tf := &crdv1beta1.Traceflow{}
+		if err := r.localClusterClient.Get(ctx, types.NamespacedName{Name: result.Name}, tf); err != nil {
+			return client.IgnoreNotFound(err)
+		}
+		dstClusterID := tf.Spec.Destination.ClusterID
+		if dstClusterID == "" || (tf.Status.Phase != crdv1beta1.Pending && tf.Status.Phase != crdv1beta1.Running) {
+			return nil
+		}
+		update := tf.DeepCopy()
+		results := make([]crdv1beta1.NodeResult, 0, len(tf.Status.Results)+len(result.Status.Results))
+		for _, nodeResult := range tf.Status.Results {
+			if nodeResult.ClusterID != dstClusterID {
+				results = append(results, nodeResult)
+			}
+		}
+		for _, nodeResult := range result.Status.Results {
+			nodeResult.ClusterID = dstClusterID
+			results = append(results, nodeResult)
+		}
+		update.Status.Results = results
+		switch result.Status.Phase {
+		case crdv1beta1.Running, crdv1beta1.Succeeded:
+			if update.Status.Phase == crdv1beta1.Pending {
+				startTime := metav1.Now()
+				update.Status.Phase = crdv1beta1.Running
+				update.Status.StartTime = &startTime
+			}
+			update.Status.DestinationIP = result.Status.DestinationIP
+		case crdv1beta1.Failed:
+			update.Status.Phase = crdv1beta1.Failed
+			update.Status.Reason = fmt.Sprintf("linked Traceflow failed in cluster %s: %s", dstClusterID, result.Status.Reason)
+		}
+		if apiequality.Semantic.DeepEqual(tf.Status, update.Status) {
+			return nil
+		}
+		klog.V(2).InfoS("Updating cross-cluster Traceflow with linked Traceflow status", "traceflow", tf.Name,
+			"phase", update.Status.Phase, "resourceimport", klog.KObj(resImp))
+		return r.localClusterClient.Status().Update(ctx, update, &client.SubResourceUpdateOptions{})
+	})
+	return ctrl.Result{}, err
+}
+
+func parseTraceflow(data []byte) (*crdv1beta1.Traceflow, error) {
+	tf := &crdv1beta1.Traceflow{}
+	if err := json.Unmarshal(data, tf); err != nil {
+		return nil, fmt.Errorf("failed to decode Traceflow: %v", err)
+	}
+	if tf.Name == "" {
+		return nil, fmt.Errorf("name of Traceflow is empty")
+	}
+	return tf, nil
+}
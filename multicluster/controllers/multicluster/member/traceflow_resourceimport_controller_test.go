@@ -0,0 +1,263 @@
+/*
+Copyright 2024 Antrea Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package member
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"antrea.io/antrea/multicluster/apis/multicluster/constants"
+	mcsv1alpha1 "antrea.io/antrea/multicluster/apis/multicluster/v1alpha1"
+	"antrea.io/antrea/multicluster/controllers/multicluster/common"
+	"antrea.io/antrea/multicluster/controllers/multicluster/commonarea"
+	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
+)
+
+func newTraceflowResourceImport(t *testing.T, name, kind string, tf *crdv1beta1.Traceflow, clusterIDs []string) *mcsv1alpha1.ResourceImport {
+	data, err := json.Marshal(tf)
+	require.NoError(t, err)
+	return &mcsv1alpha1.ResourceImport{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: leaderNamespace,
+			Name:      name + "-" + kind,
+		},
+		Spec: mcsv1alpha1.ResourceImportSpec{
+			ClusterIDs: clusterIDs,
+			Kind:       kind,
+			Name:       name,
+			Raw:        &mcsv1alpha1.RawResourceImport{Data: data},
+		},
+	}
+}
+
+func TestResourceImportReconciler_handleTraceflowResImpEvent(t *testing.T) {
+	newLinkedTraceflow := func(dst crdv1beta1.Destination, ipv6 bool) *crdv1beta1.Traceflow {
+		tf := &crdv1beta1.Traceflow{
+			ObjectMeta: metav1.ObjectMeta{Name: "cluster-b-tf1"},
+			Spec: crdv1beta1.TraceflowSpec{
+				Source:      crdv1beta1.Source{Namespace: "ns1", Pod: "pod1", ClusterID: "cluster-b"},
+				Destination: dst,
+			},
+			Status: crdv1beta1.TraceflowStatus{DataplaneTag: 7},
+		}
+		if ipv6 {
+			tf.Spec.Packet.IPv6Header = &crdv1beta1.IPv6Header{}
+		}
+		return tf
+	}
+	dstPod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns2", Name: "pod2"},
+		Status:     corev1.PodStatus{PodIPs: []corev1.PodIP{{IP: "10.10.1.2"}, {IP: "fd00:10:10::2"}}},
+	}
+	dstPodWithGlobalIP := dstPod.DeepCopy()
+	dstPodWithGlobalIP.Annotations = map[string]string{common.PodGlobalIPAnnotation: "100.64.0.2"}
+	dstSvc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns2", Name: "svc2"},
+		Spec:       corev1.ServiceSpec{ClusterIP: "10.96.0.10", ClusterIPs: []string{"10.96.0.10"}},
+	}
+	tests := []struct {
+		name                  string
+		existingObjs          []client.Object
+		linkedTraceflow       *crdv1beta1.Traceflow
+		expectedPhase         crdv1beta1.TraceflowPhase
+		expectedDestinationIP string
+		expectedReason        string
+	}{
+		{
+			name:                  "destination Pod",
+			existingObjs:          []client.Object{dstPod},
+			linkedTraceflow:       newLinkedTraceflow(crdv1beta1.Destination{Namespace: "ns2", Pod: "pod2"}, false),
+			expectedPhase:         crdv1beta1.Pending,
+			expectedDestinationIP: "10.10.1.2",
+		},
+		{
+			name:                  "destination Pod with IPv6",
+			existingObjs:          []client.Object{dstPod},
+			linkedTraceflow:       newLinkedTraceflow(crdv1beta1.Destination{Namespace: "ns2", Pod: "pod2"}, true),
+			expectedPhase:         crdv1beta1.Pending,
+			expectedDestinationIP: "fd00:10:10::2",
+		},
+		{
+			name:                  "destination Pod with global IP",
+			existingObjs:          []client.Object{dstPodWithGlobalIP},
+			linkedTraceflow:       newLinkedTraceflow(crdv1beta1.Destination{Namespace: "ns2", Pod: "pod2"}, false),
+			expectedPhase:         crdv1beta1.Pending,
+			expectedDestinationIP: "100.64.0.2",
+		},
+		{
+			name:                  "destination Service",
+			existingObjs:          []client.Object{dstSvc},
+			linkedTraceflow:       newLinkedTraceflow(crdv1beta1.Destination{Namespace: "ns2", Service: "svc2"}, false),
+			expectedPhase:         crdv1beta1.Pending,
+			expectedDestinationIP: "10.96.0.10",
+		},
+		{
+			name:            "destination Service without IPv6 ClusterIP",
+			existingObjs:    []client.Object{dstSvc},
+			linkedTraceflow: newLinkedTraceflow(crdv1beta1.Destination{Namespace: "ns2", Service: "svc2"}, true),
+			expectedPhase:   crdv1beta1.Failed,
+			expectedReason:  "destination Service ns2/svc2 does not have an IPv6 ClusterIP",
+		},
+		{
+			name:            "destination Pod not found",
+			linkedTraceflow: newLinkedTraceflow(crdv1beta1.Destination{Namespace: "ns2", Pod: "pod2"}, false),
+			expectedPhase:   crdv1beta1.Failed,
+			expectedReason:  "failed to get destination Pod ns2/pod2 in cluster cluster-a: pods \"pod2\" not found",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resImp := newTraceflowResourceImport(t, "cluster-b-tf1", "traceflow", tt.linkedTraceflow, []string{localClusterID})
+			resImp.Spec.Kind = constants.TraceflowKind
+			fakeClient := fake.NewClientBuilder().WithScheme(common.TestScheme).WithObjects(tt.existingObjs...).Build()
+			fakeRemoteClient := fake.NewClientBuilder().WithScheme(common.TestScheme).WithObjects(resImp).Build()
+			remoteCluster := commonarea.NewFakeRemoteCommonArea(fakeRemoteClient, "leader-cluster", localClusterID, leaderNamespace, nil)
+			r := newResourceImportReconciler(fakeClient, localClusterID, leaderNamespace, remoteCluster, nil)
+
+			resImpReq := ctrl.Request{NamespacedName: types.NamespacedName{Namespace: leaderNamespace, Name: resImp.Name}}
+			_, err := r.Reconcile(ctx, resImpReq)
+			require.NoError(t, err)
+
+			tf := &crdv1beta1.Traceflow{}
+			require.NoError(t, fakeClient.Get(ctx, types.NamespacedName{Name: "cluster-b-tf1"}, tf))
+			assert.Equal(t, tt.linkedTraceflow.Spec, tf.Spec)
+			assert.Equal(t, tt.expectedPhase, tf.Status.Phase)
+			assert.Equal(t, tt.expectedDestinationIP, tf.Status.DestinationIP)
+			assert.Equal(t, tt.expectedReason, tf.Status.Reason)
+			if tt.expectedPhase == crdv1beta1.Pending {
+				assert.Equal(t, int8(7), tf.Status.DataplaneTag)
+			}
+
+			// The linked Traceflow is deleted with the ResourceImport.
+			require.NoError(t, fakeRemoteClient.Delete(ctx, resImp))
+			_, err = r.Reconcile(ctx, resImpReq)
+			require.NoError(t, err)
+			err = fakeClient.Get(ctx, types.NamespacedName{Name: "cluster-b-tf1"}, tf)
+			assert.True(t, apierrors.IsNotFound(err))
+			_, exists, _ := r.installedResImports.GetByKey(resImpReq.NamespacedName.String())
+			assert.False(t, exists)
+		})
+	}
+}
+
+func TestResourceImportReconciler_handleTraceflowResultResImpEvent(t *testing.T) {
+	localResults := []crdv1beta1.NodeResult{{
+		Node: "node-a",
+		Observations: []crdv1beta1.Observation{
+			{Component: crdv1beta1.ComponentSpoofGuard},
+			{Action: crdv1beta1.ActionForwardedOutOfOverlay},
+		},
+	}}
+	remoteResults := []crdv1beta1.NodeResult{{
+		Node:         "node-b",
+		Observations: []crdv1beta1.Observation{{Action: crdv1beta1.ActionDelivered}},
+	}}
+	mergedResults := append(append([]crdv1beta1.NodeResult{}, localResults...), crdv1beta1.NodeResult{
+		ClusterID:    "cluster-b",
+		Node:         "node-b",
+		Observations: []crdv1beta1.Observation{{Action: crdv1beta1.ActionDelivered}},
+	})
+	tests := []struct {
+		name            string
+		phase           crdv1beta1.TraceflowPhase
+		result          crdv1beta1.TraceflowStatus
+		expectedPhase   crdv1beta1.TraceflowPhase
+		expectedResults []crdv1beta1.NodeResult
+		expectedReason  string
+	}{
+		{
+			name:            "linked Traceflow started",
+			phase:           crdv1beta1.Pending,
+			result:          crdv1beta1.TraceflowStatus{Phase: crdv1beta1.Running, DestinationIP: "10.10.1.2"},
+			expectedPhase:   crdv1beta1.Running,
+			expectedResults: localResults,
+		},
+		{
+			name:            "linked Traceflow succeeded",
+			phase:           crdv1beta1.Running,
+			result:          crdv1beta1.TraceflowStatus{Phase: crdv1beta1.Succeeded, DestinationIP: "10.10.1.2", Results: remoteResults},
+			expectedPhase:   crdv1beta1.Running,
+			expectedResults: mergedResults,
+		},
+		{
+			name:            "linked Traceflow failed",
+			phase:           crdv1beta1.Pending,
+			result:          crdv1beta1.TraceflowStatus{Phase: crdv1beta1.Failed, Reason: "Traceflow data plane tag in use"},
+			expectedPhase:   crdv1beta1.Failed,
+			expectedResults: localResults,
+			expectedReason:  "linked Traceflow failed in cluster cluster-b: Traceflow data plane tag in use",
+		},
+		{
+			name:            "cross-cluster Traceflow completed",
+			phase:           crdv1beta1.Succeeded,
+			result:          crdv1beta1.TraceflowStatus{Phase: crdv1beta1.Succeeded, DestinationIP: "10.10.1.2", Results: remoteResults},
+			expectedPhase:   crdv1beta1.Succeeded,
+			expectedResults: localResults,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tf := &crdv1beta1.Traceflow{
+				ObjectMeta: metav1.ObjectMeta{Name: "tf1"},
+				Spec: crdv1beta1.TraceflowSpec{
+					Source:      crdv1beta1.Source{Namespace: "ns1", Pod: "pod1"},
+					Destination: crdv1beta1.Destination{Namespace: "ns2", Pod: "pod2", ClusterID: "cluster-b"},
+				},
+				Status: crdv1beta1.TraceflowStatus{
+					Phase:        tt.phase,
+					DataplaneTag: 7,
+					Results:      localResults,
+				},
+			}
+			result := &crdv1beta1.Traceflow{
+				ObjectMeta: metav1.ObjectMeta{Name: "tf1"},
+				Status:     tt.result,
+			}
+			resImp := newTraceflowResourceImport(t, "cluster-a-tf1", "traceflowresult", result, []string{localClusterID})
+			resImp.Spec.Kind = constants.TraceflowResultKind
+			fakeClient := fake.NewClientBuilder().WithScheme(common.TestScheme).WithObjects(tf).Build()
+			fakeRemoteClient := fake.NewClientBuilder().WithScheme(common.TestScheme).WithObjects(resImp).Build()
+			remoteCluster := commonarea.NewFakeRemoteCommonArea(fakeRemoteClient, "leader-cluster", localClusterID, leaderNamespace, nil)
+			r := newResourceImportReconciler(fakeClient, localClusterID, leaderNamespace, remoteCluster, nil)
+
+			_, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Namespace: leaderNamespace, Name: resImp.Name}})
+			require.NoError(t, err)
+
+			latestTF := &crdv1beta1.Traceflow{}
+			require.NoError(t, fakeClient.Get(ctx, types.NamespacedName{Name: "tf1"}, latestTF))
+			assert.Equal(t, tt.expectedPhase, latestTF.Status.Phase)
+			assert.Equal(t, tt.expectedResults, latestTF.Status.Results)
+			assert.Equal(t, tt.expectedReason, latestTF.Status.Reason)
+			if tt.expectedPhase == crdv1beta1.Running {
+				assert.Equal(t, "10.10.1.2", latestTF.Status.DestinationIP)
+			}
+			if tt.phase == crdv1beta1.Pending && tt.expectedPhase == crdv1beta1.Running {
+				assert.NotNil(t, latestTF.Status.StartTime)
+			}
+		})
+	}
+}
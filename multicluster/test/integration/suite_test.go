@@ -148,6 +148,7 @@ var _ = BeforeSuite(func() {
 		false,
 		false,
 		commonAreaCreationCh,
+		nil,
 	)
 	err = clusterSetReconciler.SetupWithManager(k8sManager)
 	Expect(err).ToNot(HaveOccurred())
@@ -159,7 +160,8 @@ var _ = BeforeSuite(func() {
 		clusterSetReconciler,
 		"ClusterIP",
 		false,
-		testNamespace)
+		testNamespace,
+		nil)
 	err = svcExportReconciler.SetupWithManager(k8sManager)
 	Expect(err).ToNot(HaveOccurred())
 
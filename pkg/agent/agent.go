@@ -1108,8 +1108,13 @@ func (i *Initializer) initializeWireGuard() error {
 
 	patch, _ := json.Marshal(map[string]interface{}{
 		"metadata": map[string]interface{}{
-			"annotations": map[string]string{
+			"annotations": map[string]interface{}{
 				types.NodeWireGuardPublicAnnotationKey: publicKey,
+				// The private key of the next key announced before restart, if any, is lost. Remove the next
+				// key so that peer Nodes won't switch to it.
+				types.NodeWireGuardNextPublicKeyAnnotationKey:               nil,
+				types.NodeWireGuardNextPublicKeyCertificateAnnotationKey:    nil,
+				types.NodeWireGuardNextPublicKeyActivationTimeAnnotationKey: nil,
 			},
 		},
 	})
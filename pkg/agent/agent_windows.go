@@ -428,7 +428,7 @@ func (i *Initializer) setInterfaceMTU(iface string, mtu int) error {
 }
 
 func (i *Initializer) setVMNodeConfig(en *v1alpha1.ExternalNode, nodeName string) error {
-	// TODO: Handle for multiple interfaces
+	// The host interface of the first NetworkInterface is used as the uplink of the ExternalNode.
 	var uplinkInterface *net.Interface
 	foundNetDevice := false
 	for _, addr := range en.Spec.Interfaces[0].IPs {
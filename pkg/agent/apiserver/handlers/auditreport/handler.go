@@ -0,0 +1,96 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auditreport
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"antrea.io/antrea/pkg/agent/types"
+	"antrea.io/antrea/pkg/antctl/transform/common"
+	"antrea.io/antrea/pkg/features"
+	"antrea.io/antrea/pkg/querier"
+)
+
+// Response describes the response struct of auditreport command.
+type Response struct {
+	Policy          string `json:"policy,omitempty"`
+	Rule            string `json:"rule,omitempty"`
+	Direction       string `json:"direction,omitempty"`
+	Disposition     string `json:"disposition,omitempty"`
+	SourceIP        string `json:"sourceIP,omitempty"`
+	DestinationIP   string `json:"destinationIP,omitempty"`
+	Protocol        string `json:"protocol,omitempty"`
+	DestinationPort string `json:"destinationPort,omitempty"`
+	Count           int64  `json:"count,omitempty"`
+	LastSeen        string `json:"lastSeen,omitempty"`
+}
+
+func generateResponse(record *types.AuditRecord) Response {
+	return Response{
+		Policy:          record.PolicyRef,
+		Rule:            record.RuleName,
+		Direction:       record.Direction,
+		Disposition:     record.Disposition,
+		SourceIP:        record.SourceIP,
+		DestinationIP:   record.DestinationIP,
+		Protocol:        record.Protocol,
+		DestinationPort: record.DestinationPort,
+		Count:           record.Count,
+		LastSeen:        record.LastSeen.UTC().Format(time.RFC3339),
+	}
+}
+
+// HandleFunc returns the function which can handle queries issued by the auditreport command. It returns the traffic
+// which would have been denied by the rules of Antrea-native policies in Audit mode on the local Node. The records can
+// be filtered by policy, using the same reference format as in the audit logs (e.g.
+// "AntreaClusterNetworkPolicy:acnp1").
+func HandleFunc(npq querier.AgentNetworkPolicyInfoQuerier) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !features.DefaultFeatureGate.Enabled(features.AntreaPolicyAuditMode) {
+			http.Error(w, "AntreaPolicyAuditMode is not enabled", http.StatusServiceUnavailable)
+			return
+		}
+		policy := r.URL.Query().Get("policy")
+		responses := []Response{}
+		records := npq.GetAuditRecords()
+		for i := range records {
+			if policy != "" && records[i].PolicyRef != policy {
+				continue
+			}
+			responses = append(responses, generateResponse(&records[i]))
+		}
+		if err := json.NewEncoder(w).Encode(responses); err != nil {
+			http.Error(w, "Failed to encode response: "+err.Error(), http.StatusInternalServerError)
+		}
+	}
+}
+
+var _ common.TableOutput = (*Response)(nil)
+
+func (r Response) GetTableHeader() []string {
+	return []string{"POLICY", "RULE", "DIRECTION", "DISPOSITION", "SOURCE", "DESTINATION", "PROTOCOL", "PORT", "COUNT", "LAST-SEEN"}
+}
+
+func (r Response) GetTableRow(_ int) []string {
+	return []string{r.Policy, r.Rule, r.Direction, r.Disposition, r.SourceIP, r.DestinationIP, r.Protocol, r.DestinationPort, strconv.FormatInt(r.Count, 10), r.LastSeen}
+}
+
+// SortRows returns false as the records are already sorted, the most recently seen first.
+func (r Response) SortRows() bool {
+	return false
+}
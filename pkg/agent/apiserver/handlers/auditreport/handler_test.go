@@ -0,0 +1,144 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auditreport
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	featuregatetesting "k8s.io/component-base/featuregate/testing"
+
+	"antrea.io/antrea/pkg/agent/types"
+	"antrea.io/antrea/pkg/features"
+	queriertest "antrea.io/antrea/pkg/querier/testing"
+)
+
+func TestAuditReportQuery(t *testing.T) {
+	lastSeen := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	records := []types.AuditRecord{
+		{
+			PolicyRef:       "AntreaClusterNetworkPolicy:acnp1",
+			RuleName:        "drop-web",
+			Direction:       "Ingress",
+			Disposition:     "AuditDrop",
+			SourceIP:        "10.10.0.5",
+			DestinationIP:   "10.10.1.6",
+			Protocol:        "TCP",
+			DestinationPort: "80",
+			Count:           3,
+			LastSeen:        lastSeen,
+		},
+		{
+			PolicyRef:       "AntreaNetworkPolicy:ns1/annp1",
+			RuleName:        "reject-db",
+			Direction:       "Egress",
+			Disposition:     "AuditReject",
+			SourceIP:        "10.10.0.5",
+			DestinationIP:   "10.10.1.7",
+			Protocol:        "TCP",
+			DestinationPort: "5432",
+			Count:           1,
+			LastSeen:        lastSeen.Add(-time.Minute),
+		},
+	}
+	acnp1Response := Response{
+		Policy:          "AntreaClusterNetworkPolicy:acnp1",
+		Rule:            "drop-web",
+		Direction:       "Ingress",
+		Disposition:     "AuditDrop",
+		SourceIP:        "10.10.0.5",
+		DestinationIP:   "10.10.1.6",
+		Protocol:        "TCP",
+		DestinationPort: "80",
+		Count:           3,
+		LastSeen:        "2024-03-01T10:00:00Z",
+	}
+	annp1Response := Response{
+		Policy:          "AntreaNetworkPolicy:ns1/annp1",
+		Rule:            "reject-db",
+		Direction:       "Egress",
+		Disposition:     "AuditReject",
+		SourceIP:        "10.10.0.5",
+		DestinationIP:   "10.10.1.7",
+		Protocol:        "TCP",
+		DestinationPort: "5432",
+		Count:           1,
+		LastSeen:        "2024-03-01T09:59:00Z",
+	}
+
+	tests := []struct {
+		name             string
+		featureEnabled   bool
+		query            string
+		expectedStatus   int
+		expectedResponse []Response
+	}{
+		{
+			name:           "feature disabled",
+			featureEnabled: false,
+			expectedStatus: http.StatusServiceUnavailable,
+		},
+		{
+			name:             "get all records",
+			featureEnabled:   true,
+			expectedStatus:   http.StatusOK,
+			expectedResponse: []Response{acnp1Response, annp1Response},
+		},
+		{
+			name:             "get records of a policy",
+			featureEnabled:   true,
+			query:            "?policy=AntreaNetworkPolicy:ns1/annp1",
+			expectedStatus:   http.StatusOK,
+			expectedResponse: []Response{annp1Response},
+		},
+		{
+			name:             "get records of a policy without records",
+			featureEnabled:   true,
+			query:            "?policy=AntreaClusterNetworkPolicy:acnp2",
+			expectedStatus:   http.StatusOK,
+			expectedResponse: []Response{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer featuregatetesting.SetFeatureGateDuringTest(t, features.DefaultFeatureGate, features.AntreaPolicyAuditMode, tt.featureEnabled)()
+			ctrl := gomock.NewController(t)
+			q := queriertest.NewMockAgentNetworkPolicyInfoQuerier(ctrl)
+			if tt.featureEnabled {
+				q.EXPECT().GetAuditRecords().Return(records)
+			}
+			handler := HandleFunc(q)
+
+			req, err := http.NewRequest(http.MethodGet, tt.query, nil)
+			require.NoError(t, err)
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, req)
+			assert.Equal(t, tt.expectedStatus, recorder.Code)
+
+			if tt.expectedStatus == http.StatusOK {
+				var received []Response
+				err = json.Unmarshal(recorder.Body.Bytes(), &received)
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedResponse, received)
+			}
+		})
+	}
+}
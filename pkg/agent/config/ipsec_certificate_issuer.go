@@ -0,0 +1,59 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import "strings"
+
+type IPsecCertificateIssuerType int
+
+const (
+	IPsecCertificateIssuerKubernetesCSR IPsecCertificateIssuerType = iota
+	IPsecCertificateIssuerCertManager
+	IPsecCertificateIssuerEST
+	IPsecCertificateIssuerInvalid = -1
+)
+
+var supportedIPsecCertificateIssuerStrs = [...]string{
+	"kubernetesCSR",
+	"certManager",
+	"est",
+}
+
+func GetIPsecCertificateIssuerTypes() []IPsecCertificateIssuerType {
+	return []IPsecCertificateIssuerType{
+		IPsecCertificateIssuerKubernetesCSR,
+		IPsecCertificateIssuerCertManager,
+		IPsecCertificateIssuerEST,
+	}
+}
+
+// String returns value in string.
+func (t IPsecCertificateIssuerType) String() string {
+	if t == IPsecCertificateIssuerInvalid {
+		return "invalid"
+	}
+	return supportedIPsecCertificateIssuerStrs[t]
+}
+
+// GetIPsecCertificateIssuerTypeFromStr returns true and IPsecCertificateIssuerType corresponding to input string.
+// Otherwise, false and undefined value is returned
+func GetIPsecCertificateIssuerTypeFromStr(str string) (bool, IPsecCertificateIssuerType) {
+	for idx, ts := range supportedIPsecCertificateIssuerStrs {
+		if strings.EqualFold(ts, str) {
+			return true, IPsecCertificateIssuerType(idx)
+		}
+	}
+	return false, IPsecCertificateIssuerInvalid
+}
@@ -0,0 +1,61 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetIPsecCertificateIssuerTypeFromStr(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		expBool bool
+		expType IPsecCertificateIssuerType
+	}{
+		{"Kubernetes CSR", "kubernetesCSR", true, IPsecCertificateIssuerKubernetesCSR},
+		{"cert-manager", "certManager", true, IPsecCertificateIssuerCertManager},
+		{"EST", "est", true, IPsecCertificateIssuerEST},
+		{"Capital case", "EST", true, IPsecCertificateIssuerEST},
+		{"Invalid string", "acme", false, IPsecCertificateIssuerInvalid},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ok, issuerType := GetIPsecCertificateIssuerTypeFromStr(tt.input)
+			assert.Equal(t, tt.expBool, ok)
+			assert.Equal(t, tt.expType, issuerType)
+		})
+	}
+}
+
+func TestIPsecCertificateIssuerType_String(t *testing.T) {
+	tests := []struct {
+		name string
+		t    IPsecCertificateIssuerType
+		want string
+	}{
+		{"Kubernetes CSR", IPsecCertificateIssuerKubernetesCSR, "kubernetesCSR"},
+		{"cert-manager", IPsecCertificateIssuerCertManager, "certManager"},
+		{"EST", IPsecCertificateIssuerEST, "est"},
+		{"Invalid", IPsecCertificateIssuerInvalid, "invalid"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.t.String())
+		})
+	}
+}
@@ -17,6 +17,7 @@ package config
 import (
 	"fmt"
 	"net"
+	"time"
 
 	"antrea.io/antrea/pkg/ovs/ovsconfig"
 )
@@ -137,6 +138,12 @@ type WireGuardConfig struct {
 	Port int
 	// The MTU of WireGuard interface.
 	MTU int
+	// KeyRotationInterval is the interval at which the WireGuard private key is rotated. 0 disables key rotation.
+	KeyRotationInterval time.Duration
+	// KeyRotationOverlap is how long the next public key is announced to peer Nodes before it takes effect.
+	KeyRotationOverlap time.Duration
+	// VerifyPeerPublicKeys indicates whether only WireGuard public keys certified by antrea-controller are accepted.
+	VerifyPeerPublicKeys bool
 }
 
 type EgressConfig struct {
@@ -198,6 +205,35 @@ func (n *NodeConfig) String() string {
 type IPsecConfig struct {
 	AuthenticationMode IPsecAuthenticationMode
 	PSK                string
+	CertificateIssuer  IPsecCertificateIssuerConfig
+}
+
+// IPsecCertificateIssuerConfig includes the configurations of the backend which
+// issues IPsec certificates when the authentication mode is cert.
+type IPsecCertificateIssuerConfig struct {
+	Type        IPsecCertificateIssuerType
+	CertManager IPsecCertManagerIssuerConfig
+	EST         IPsecESTIssuerConfig
+}
+
+// IPsecCertManagerIssuerConfig includes the configurations to request IPsec
+// certificates with cert-manager CertificateRequests.
+type IPsecCertManagerIssuerConfig struct {
+	Namespace   string
+	IssuerName  string
+	IssuerKind  string
+	IssuerGroup string
+	// Duration is the requested lifetime of certificates. 0 means the
+	// default lifetime of the issuer.
+	Duration time.Duration
+}
+
+// IPsecESTIssuerConfig includes the configurations to request IPsec
+// certificates from an Enrollment over Secure Transport (EST) server.
+type IPsecESTIssuerConfig struct {
+	ServerURL    string
+	ServerCAFile string
+	Username     string
 }
 
 // NetworkConfig includes user provided network configuration parameters.
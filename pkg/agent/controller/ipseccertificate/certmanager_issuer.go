@@ -0,0 +1,171 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ipseccertificate
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/klog/v2"
+
+	"antrea.io/antrea/pkg/agent/config"
+)
+
+const (
+	// certificateRequestPollInterval is the interval at which the status of a cert-manager
+	// CertificateRequest is checked.
+	certificateRequestPollInterval = 2 * time.Second
+
+	certificateRequestConditionReady          = "Ready"
+	certificateRequestConditionDenied         = "Denied"
+	certificateRequestConditionInvalidRequest = "InvalidRequest"
+	certificateRequestReasonFailed            = "Failed"
+)
+
+var certificateRequestGVR = schema.GroupVersionResource{
+	Group:    "cert-manager.io",
+	Version:  "v1",
+	Resource: "certificaterequests",
+}
+
+// certManagerIssuer requests certificates with cert-manager CertificateRequests, which are
+// signed by a cert-manager Issuer or ClusterIssuer. cert-manager is accessed with a dynamic
+// client to avoid depending on its API module.
+type certManagerIssuer struct {
+	client   dynamic.Interface
+	nodeName string
+	config   *config.IPsecCertManagerIssuerConfig
+}
+
+func newCertManagerIssuer(client dynamic.Interface, nodeName string, config *config.IPsecCertManagerIssuerConfig) *certManagerIssuer {
+	return &certManagerIssuer{
+		client:   client,
+		nodeName: nodeName,
+		config:   config,
+	}
+}
+
+func (i *certManagerIssuer) newCertificateRequest(csrPEM []byte) *unstructured.Unstructured {
+	spec := map[string]interface{}{
+		"request": base64.StdEncoding.EncodeToString(csrPEM),
+		"issuerRef": map[string]interface{}{
+			"name":  i.config.IssuerName,
+			"kind":  i.config.IssuerKind,
+			"group": i.config.IssuerGroup,
+		},
+		"usages": []interface{}{"digital signature", "key encipherment", "ipsec tunnel"},
+	}
+	if i.config.Duration > 0 {
+		spec["duration"] = i.config.Duration.String()
+	}
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": certificateRequestGVR.GroupVersion().String(),
+		"kind":       "CertificateRequest",
+		"metadata": map[string]interface{}{
+			"generateName": fmt.Sprintf("%s-ipsec-", i.nodeName),
+			"namespace":    i.config.Namespace,
+		},
+		"spec": spec,
+	}}
+}
+
+func (i *certManagerIssuer) issueCertificate(ctx context.Context, csrPEM []byte) ([]byte, error) {
+	client := i.client.Resource(certificateRequestGVR).Namespace(i.config.Namespace)
+	cr, err := client.Create(ctx, i.newCertificateRequest(csrPEM), metav1.CreateOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create CertificateRequest: %w", err)
+	}
+	name := cr.GetName()
+	klog.InfoS("Created CertificateRequest for IPsec certificate", "certificateRequest", klog.KRef(i.config.Namespace, name))
+	// Unlike Kubernetes CSRs, CertificateRequests are not garbage collected. The certificate
+	// is stored by antrea-agent, so the CertificateRequest is no longer needed once done.
+	defer func() {
+		if err := client.Delete(context.TODO(), name, metav1.DeleteOptions{}); err != nil {
+			klog.ErrorS(err, "Failed to delete CertificateRequest", "certificateRequest", klog.KRef(i.config.Namespace, name))
+		}
+	}()
+
+	var certificate []byte
+	if err := wait.PollImmediateUntilWithContext(ctx, certificateRequestPollInterval, func(ctx context.Context) (bool, error) {
+		cr, err := client.Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			klog.ErrorS(err, "Failed to get CertificateRequest", "certificateRequest", klog.KRef(i.config.Namespace, name))
+			return false, nil
+		}
+		certificate, err = certificateFromCertificateRequest(cr)
+		if err != nil {
+			return false, err
+		}
+		return certificate != nil, nil
+	}); err != nil {
+		return nil, fmt.Errorf("CertificateRequest %s/%s was not issued: %w", i.config.Namespace, name, err)
+	}
+	return certificate, nil
+}
+
+// certificateFromCertificateRequest returns the PEM-encoded certificate of the
+// CertificateRequest if it has been issued, and nil if it is still pending. An error is
+// returned if the CertificateRequest will never be issued.
+func certificateFromCertificateRequest(cr *unstructured.Unstructured) ([]byte, error) {
+	conditions, _, err := unstructured.NestedSlice(cr.Object, "status", "conditions")
+	if err != nil {
+		return nil, err
+	}
+	ready := false
+	for _, c := range conditions {
+		condition, ok := c.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		conditionType, _, _ := unstructured.NestedString(condition, "type")
+		status, _, _ := unstructured.NestedString(condition, "status")
+		reason, _, _ := unstructured.NestedString(condition, "reason")
+		message, _, _ := unstructured.NestedString(condition, "message")
+		switch conditionType {
+		case certificateRequestConditionDenied, certificateRequestConditionInvalidRequest:
+			if status == string(metav1.ConditionTrue) {
+				return nil, fmt.Errorf("CertificateRequest is %s: %s", conditionType, message)
+			}
+		case certificateRequestConditionReady:
+			if status == string(metav1.ConditionTrue) {
+				ready = true
+			} else if reason == certificateRequestReasonFailed {
+				return nil, fmt.Errorf("CertificateRequest failed: %s", message)
+			}
+		}
+	}
+	if !ready {
+		return nil, nil
+	}
+	encoded, _, err := unstructured.NestedString(cr.Object, "status", "certificate")
+	if err != nil {
+		return nil, err
+	}
+	if encoded == "" {
+		return nil, nil
+	}
+	certificate, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode certificate: %w", err)
+	}
+	return certificate, nil
+}
@@ -0,0 +1,141 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ipseccertificate
+
+import (
+	"context"
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	"antrea.io/antrea/pkg/agent/config"
+)
+
+func newFakeDynamicClient(status map[string]interface{}) *dynamicfake.FakeDynamicClient {
+	client := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{
+		certificateRequestGVR: "CertificateRequestList",
+	})
+	// Fill the name in the Create request, and simulate cert-manager by setting the status.
+	client.PrependReactor("create", "certificaterequests", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		cr := action.(k8stesting.CreateAction).GetObject().(*unstructured.Unstructured)
+		cr.SetName(cr.GetGenerateName() + "abcde")
+		if status != nil {
+			cr.Object["status"] = status
+		}
+		return false, cr, nil
+	})
+	return client
+}
+
+func TestCertManagerIssuer(t *testing.T) {
+	certificate := []byte("fake-certificate")
+	tests := []struct {
+		name                string
+		status              map[string]interface{}
+		expectedCertificate []byte
+		expectedErr         string
+	}{
+		{
+			name: "issued",
+			status: map[string]interface{}{
+				"conditions": []interface{}{
+					map[string]interface{}{"type": "Approved", "status": "True"},
+					map[string]interface{}{"type": "Ready", "status": "True", "reason": "Issued"},
+				},
+				"certificate": base64.StdEncoding.EncodeToString(certificate),
+			},
+			expectedCertificate: certificate,
+		},
+		{
+			name: "denied",
+			status: map[string]interface{}{
+				"conditions": []interface{}{
+					map[string]interface{}{"type": "Denied", "status": "True", "message": "not allowed"},
+					map[string]interface{}{"type": "Ready", "status": "False", "reason": "Denied"},
+				},
+			},
+			expectedErr: "CertificateRequest is Denied: not allowed",
+		},
+		{
+			name: "failed",
+			status: map[string]interface{}{
+				"conditions": []interface{}{
+					map[string]interface{}{"type": "Ready", "status": "False", "reason": "Failed", "message": "CA expired"},
+				},
+			},
+			expectedErr: "CertificateRequest failed: CA expired",
+		},
+		{
+			name: "pending",
+			status: map[string]interface{}{
+				"conditions": []interface{}{
+					map[string]interface{}{"type": "Ready", "status": "False", "reason": "Pending"},
+				},
+			},
+			expectedErr: "was not issued",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newFakeDynamicClient(tt.status)
+			issuerConfig := &config.IPsecCertManagerIssuerConfig{
+				Namespace:   "kube-system",
+				IssuerName:  "corp-ca",
+				IssuerKind:  "ClusterIssuer",
+				IssuerGroup: "cert-manager.io",
+				Duration:    720 * time.Hour,
+			}
+			issuer := newCertManagerIssuer(client, fakeNodeName, issuerConfig)
+			ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+			defer cancel()
+			csrPEM := []byte("fake-request")
+			cert, err := issuer.issueCertificate(ctx, csrPEM)
+			if tt.expectedErr != "" {
+				assert.ErrorContains(t, err, tt.expectedErr)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedCertificate, cert)
+			}
+
+			var created *unstructured.Unstructured
+			for _, action := range client.Actions() {
+				if action.GetVerb() == "create" {
+					created = action.(k8stesting.CreateAction).GetObject().(*unstructured.Unstructured)
+				}
+			}
+			require.NotNil(t, created)
+			assert.Equal(t, "kube-system", created.GetNamespace())
+			request, _, _ := unstructured.NestedString(created.Object, "spec", "request")
+			assert.Equal(t, base64.StdEncoding.EncodeToString(csrPEM), request)
+			issuerRef, _, _ := unstructured.NestedStringMap(created.Object, "spec", "issuerRef")
+			assert.Equal(t, map[string]string{"name": "corp-ca", "kind": "ClusterIssuer", "group": "cert-manager.io"}, issuerRef)
+			duration, _, _ := unstructured.NestedString(created.Object, "spec", "duration")
+			assert.Equal(t, "720h0m0s", duration)
+			// The CertificateRequest should always be deleted.
+			list, err := client.Resource(certificateRequestGVR).Namespace("kube-system").List(context.TODO(), metav1.ListOptions{})
+			require.NoError(t, err)
+			assert.Empty(t, list.Items)
+		})
+	}
+}
@@ -0,0 +1,211 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ipseccertificate
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	certutil "k8s.io/client-go/util/cert"
+	"k8s.io/klog/v2"
+
+	"antrea.io/antrea/pkg/agent/config"
+)
+
+const (
+	// estPasswordEnvKey is the environment variable which holds the password for HTTP basic
+	// authentication with the EST server.
+	estPasswordEnvKey = "ANTREA_IPSEC_EST_PASSWORD"
+
+	estSimpleEnrollPath = "/simpleenroll"
+	// estDefaultRetryAfter is used when the EST server accepts an enrollment request without
+	// specifying when to retry.
+	estDefaultRetryAfter = 10 * time.Second
+	estRequestTimeout    = 30 * time.Second
+	// estMaxResponseSize limits the size of responses read from the EST server.
+	estMaxResponseSize = 1 << 20
+)
+
+var (
+	oidPKCS7SignedData = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 7, 2}
+)
+
+// estIssuer requests certificates from an Enrollment over Secure Transport (EST) server, using
+// the simple enrollment operation described in RFC 7030.
+type estIssuer struct {
+	client    *http.Client
+	serverURL string
+	username  string
+	password  string
+}
+
+func newESTIssuer(config *config.IPsecESTIssuerConfig) (*estIssuer, error) {
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+	}
+	if config.ServerCAFile != "" {
+		caPEM, err := os.ReadFile(config.ServerCAFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read EST server CA file %s: %w", config.ServerCAFile, err)
+		}
+		roots := x509.NewCertPool()
+		if !roots.AppendCertsFromPEM(caPEM) {
+			return nil, fmt.Errorf("no valid certificate found in EST server CA file %s", config.ServerCAFile)
+		}
+		tlsConfig.RootCAs = roots
+	}
+	return &estIssuer{
+		client: &http.Client{
+			Transport: &http.Transport{
+				Proxy:           http.ProxyFromEnvironment,
+				TLSClientConfig: tlsConfig,
+			},
+			Timeout: estRequestTimeout,
+		},
+		serverURL: strings.TrimSuffix(config.ServerURL, "/"),
+		username:  config.Username,
+		password:  os.Getenv(estPasswordEnvKey),
+	}, nil
+}
+
+func (i *estIssuer) issueCertificate(ctx context.Context, csrPEM []byte) ([]byte, error) {
+	block, _ := pem.Decode(csrPEM)
+	if block == nil {
+		return nil, fmt.Errorf("failed to decode certificate request")
+	}
+	body := []byte(base64.StdEncoding.EncodeToString(block.Bytes))
+	for {
+		certificate, retryAfter, err := i.simpleEnroll(ctx, body)
+		if err != nil {
+			return nil, err
+		}
+		if certificate != nil {
+			return certificate, nil
+		}
+		klog.InfoS("EST server accepted the enrollment request, waiting for the certificate to be issued", "retryAfter", retryAfter)
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(retryAfter):
+		}
+	}
+}
+
+// simpleEnroll sends an enrollment request to the EST server. It returns the PEM-encoded
+// certificate chain if the certificate is issued, or the duration after which the request
+// should be retried if the server has accepted but not yet processed it.
+func (i *estIssuer) simpleEnroll(ctx context.Context, body []byte) ([]byte, time.Duration, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, i.serverURL+estSimpleEnrollPath, bytes.NewReader(body))
+	if err != nil {
+		return nil, 0, err
+	}
+	req.Header.Set("Content-Type", "application/pkcs10")
+	req.Header.Set("Content-Transfer-Encoding", "base64")
+	if i.username != "" {
+		req.SetBasicAuth(i.username, i.password)
+	}
+	resp, err := i.client.Do(req)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to send enrollment request to EST server: %w", err)
+	}
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(io.LimitReader(resp.Body, estMaxResponseSize))
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to read enrollment response from EST server: %w", err)
+	}
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusAccepted:
+		retryAfter := estDefaultRetryAfter
+		if seconds, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil && seconds > 0 {
+			retryAfter = time.Duration(seconds) * time.Second
+		}
+		return nil, retryAfter, nil
+	default:
+		return nil, 0, fmt.Errorf("EST server rejected the enrollment request with status %s: %s", resp.Status, strings.TrimSpace(string(respBody)))
+	}
+	der, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(string(respBody)), ""))
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to decode enrollment response from EST server: %w", err)
+	}
+	certs, err := parsePKCS7Certificates(der)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to parse enrollment response from EST server: %w", err)
+	}
+	certificate, err := certutil.EncodeCertificates(certs...)
+	if err != nil {
+		return nil, 0, err
+	}
+	return certificate, 0, nil
+}
+
+type pkcs7ContentInfo struct {
+	ContentType asn1.ObjectIdentifier
+	Content     asn1.RawValue `asn1:"explicit,optional,tag:0"`
+}
+
+type pkcs7SignedData struct {
+	Version          int
+	DigestAlgorithms asn1.RawValue
+	ContentInfo      asn1.RawValue
+	Certificates     asn1.RawValue `asn1:"optional,tag:0"`
+	CRLs             asn1.RawValue `asn1:"optional,tag:1"`
+	SignerInfos      asn1.RawValue
+}
+
+// parsePKCS7Certificates parses the certificates from a DER-encoded "certs-only" PKCS#7
+// SignedData structure, which is what EST servers return for enrollment requests. The
+// certificate issued for the request is moved to the front of the returned slice.
+func parsePKCS7Certificates(der []byte) ([]*x509.Certificate, error) {
+	var contentInfo pkcs7ContentInfo
+	if _, err := asn1.Unmarshal(der, &contentInfo); err != nil {
+		return nil, err
+	}
+	if !contentInfo.ContentType.Equal(oidPKCS7SignedData) {
+		return nil, fmt.Errorf("unexpected PKCS#7 content type %s", contentInfo.ContentType)
+	}
+	var signedData pkcs7SignedData
+	if _, err := asn1.Unmarshal(contentInfo.Content.Bytes, &signedData); err != nil {
+		return nil, err
+	}
+	certs, err := x509.ParseCertificates(signedData.Certificates.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	if len(certs) == 0 {
+		return nil, fmt.Errorf("no certificate found")
+	}
+	// The order of certificates in a PKCS#7 SET is not significant. Put the leaf certificate,
+	// which is not a CA, first.
+	for idx, cert := range certs {
+		if !cert.IsCA {
+			certs[0], certs[idx] = certs[idx], certs[0]
+			break
+		}
+	}
+	return certs, nil
+}
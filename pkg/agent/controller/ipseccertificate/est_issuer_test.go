@@ -0,0 +1,162 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ipseccertificate
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/base64"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	certutil "k8s.io/client-go/util/cert"
+
+	"antrea.io/antrea/pkg/agent/config"
+)
+
+// marshalPKCS7Certificates returns a DER-encoded "certs-only" PKCS#7 SignedData structure
+// containing the provided certificates.
+func marshalPKCS7Certificates(t *testing.T, certs ...*x509.Certificate) []byte {
+	var raw []byte
+	for _, cert := range certs {
+		raw = append(raw, cert.Raw...)
+	}
+	contentInfo, err := asn1.Marshal(struct {
+		ContentType asn1.ObjectIdentifier
+	}{asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 7, 1}})
+	require.NoError(t, err)
+	signedData, err := asn1.Marshal(pkcs7SignedData{
+		Version:          1,
+		DigestAlgorithms: asn1.RawValue{Class: asn1.ClassUniversal, Tag: asn1.TagSet, IsCompound: true},
+		ContentInfo:      asn1.RawValue{FullBytes: contentInfo},
+		Certificates:     asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true, Bytes: raw},
+		CRLs:             asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 1, IsCompound: true},
+		SignerInfos:      asn1.RawValue{Class: asn1.ClassUniversal, Tag: asn1.TagSet, IsCompound: true},
+	})
+	require.NoError(t, err)
+	der, err := asn1.Marshal(pkcs7ContentInfo{
+		ContentType: oidPKCS7SignedData,
+		Content:     asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true, Bytes: signedData},
+	})
+	require.NoError(t, err)
+	return der
+}
+
+func TestESTIssuer(t *testing.T) {
+	caKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	caCert, err := certutil.NewSelfSignedCACert(certutil.Config{CommonName: "corp-ca"}, caKey)
+	require.NoError(t, err)
+
+	var requests int
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/est/simpleenroll", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		username, password, ok := r.BasicAuth()
+		if !ok || username != "antrea" || password != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if r.Method != http.MethodPost || r.Header.Get("Content-Type") != "application/pkcs10" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		// Accept the first request without issuing the certificate.
+		if requests == 1 {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusAccepted)
+			return
+		}
+		body, _ := io.ReadAll(r.Body)
+		der, err := base64.StdEncoding.DecodeString(string(body))
+		require.NoError(t, err)
+		req, err := x509.ParseCertificateRequest(der)
+		require.NoError(t, err)
+		certPEM := createCertificate(t, req.Subject.CommonName, caCert, caKey, req.PublicKey, time.Now(), time.Hour)
+		certs, err := certutil.ParseCertsPEM(certPEM)
+		require.NoError(t, err)
+		w.Header().Set("Content-Type", "application/pkcs7-mime; smime-type=certs-only")
+		w.Header().Set("Content-Transfer-Encoding", "base64")
+		// The CA certificate comes first to verify that the issued certificate is reordered.
+		w.Write([]byte(base64.StdEncoding.EncodeToString(marshalPKCS7Certificates(t, caCert, certs[0]))))
+	})
+	server := httptest.NewTLSServer(mux)
+	defer server.Close()
+
+	serverCAFile := filepath.Join(t.TempDir(), "est-ca.crt")
+	serverCAPEM, err := certutil.EncodeCertificates(server.Certificate())
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(serverCAFile, serverCAPEM, 0600))
+
+	t.Setenv(estPasswordEnvKey, "secret")
+	issuer, err := newESTIssuer(&config.IPsecESTIssuerConfig{
+		ServerURL:    server.URL + "/.well-known/est/",
+		ServerCAFile: serverCAFile,
+		Username:     "antrea",
+	})
+	require.NoError(t, err)
+
+	key, _, err := newRSAPrivateKey()
+	require.NoError(t, err)
+	csrPEM, err := newCertificateRequest(fakeNodeName, key)
+	require.NoError(t, err)
+	certPEM, err := issuer.issueCertificate(context.Background(), csrPEM)
+	require.NoError(t, err)
+	assert.Equal(t, 2, requests)
+
+	certs, err := certutil.ParseCertsPEM(certPEM)
+	require.NoError(t, err)
+	require.Len(t, certs, 2)
+	assert.Equal(t, fakeNodeName, certs[0].Subject.CommonName)
+	assert.True(t, bytes.Equal(caCert.Raw, certs[1].Raw))
+}
+
+func TestESTIssuerRejected(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "unknown client", http.StatusForbidden)
+	}))
+	defer server.Close()
+
+	issuer, err := newESTIssuer(&config.IPsecESTIssuerConfig{ServerURL: server.URL})
+	require.NoError(t, err)
+	issuer.client = server.Client()
+	key, _, err := newRSAPrivateKey()
+	require.NoError(t, err)
+	csrPEM, err := newCertificateRequest(fakeNodeName, key)
+	require.NoError(t, err)
+	_, err = issuer.issueCertificate(context.Background(), csrPEM)
+	assert.ErrorContains(t, err, "EST server rejected the enrollment request with status 403 Forbidden: unknown client")
+}
+
+func TestParsePKCS7CertificatesInvalidContentType(t *testing.T) {
+	der, err := asn1.Marshal(pkcs7ContentInfo{
+		ContentType: asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 7, 1},
+		Content:     asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true, Bytes: []byte{0x04, 0x00}},
+	})
+	require.NoError(t, err)
+	_, err = parsePKCS7Certificates(der)
+	assert.ErrorContains(t, err, "unexpected PKCS#7 content type")
+}
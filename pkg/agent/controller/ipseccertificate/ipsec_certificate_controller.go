@@ -28,17 +28,17 @@ import (
 	"sync/atomic"
 	"time"
 
-	certificatesv1 "k8s.io/api/certificates/v1"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/dynamic"
 	clientset "k8s.io/client-go/kubernetes"
 	certutil "k8s.io/client-go/util/cert"
-	csrutil "k8s.io/client-go/util/certificate/csr"
 	"k8s.io/client-go/util/keyutil"
 	"k8s.io/client-go/util/workqueue"
 	"k8s.io/klog/v2"
 	"k8s.io/utils/clock"
 
+	"antrea.io/antrea/pkg/agent/config"
+	"antrea.io/antrea/pkg/agent/metrics"
 	antreaapis "antrea.io/antrea/pkg/apis"
 	"antrea.io/antrea/pkg/ovs/ovsconfig"
 )
@@ -66,12 +66,14 @@ const (
 
 var defaultCertificatesPath = "/var/run/openvswitch"
 
-// Controller is responsible for requesting certificates by CertificateSigningRequest and configure them to OVS
+// Controller is responsible for requesting certificates from the configured issuer and configure them to OVS
 type Controller struct {
 	kubeClient      clientset.Interface
 	ovsBridgeClient ovsconfig.OVSBridgeClient
 	nodeName        string
 	queue           workqueue.RateLimitingInterface
+	// issuer issues certificates for the certificate requests of the local Node.
+	issuer certificateIssuer
 
 	rotateCertificate  func() (*certificateKeyPair, error)
 	certificateKeyPair *certificateKeyPair
@@ -95,21 +97,30 @@ type Manager interface {
 
 var _ Manager = (*Controller)(nil)
 
+// NewIPSecCertificateController returns a Controller which requests IPsec certificates from the
+// issuer described by issuerConfig. dynamicClient is only required by the cert-manager issuer.
 func NewIPSecCertificateController(
 	kubeClient clientset.Interface,
+	dynamicClient dynamic.Interface,
 	ovsBridgeClient ovsconfig.OVSBridgeClient,
 	nodeName string,
-) *Controller {
-	return newIPSecCertificateControllerWithCustomClock(kubeClient, ovsBridgeClient, nodeName, clock.RealClock{})
+	issuerConfig *config.IPsecCertificateIssuerConfig,
+) (*Controller, error) {
+	issuer, err := newCertificateIssuer(kubeClient, dynamicClient, nodeName, issuerConfig)
+	if err != nil {
+		return nil, err
+	}
+	return newIPSecCertificateControllerWithCustomClock(kubeClient, ovsBridgeClient, nodeName, issuer, clock.RealClock{}), nil
 }
 
 func newIPSecCertificateControllerWithCustomClock(kubeClient clientset.Interface,
 	ovsBridgeClient ovsconfig.OVSBridgeClient,
-	nodeName string, clock clock.WithTicker) *Controller {
+	nodeName string, issuer certificateIssuer, clock clock.WithTicker) *Controller {
 	controller := &Controller{
 		kubeClient:      kubeClient,
 		ovsBridgeClient: ovsBridgeClient,
 		nodeName:        nodeName,
+		issuer:          issuer,
 		queue: workqueue.NewRateLimitingQueueWithDelayingInterface(workqueue.NewDelayingQueueWithCustomClock(clock, "IPsecCertificateController"),
 			workqueue.NewItemExponentialFailureRateLimiter(minRetryDelay, maxRetryDelay)),
 		clock:                 clock,
@@ -296,6 +307,8 @@ func (c *Controller) syncConfigurations() error {
 		// Calculate the rotation deadline of new certificate.
 		deadline = c.certificateKeyPair.nextRotationDeadline()
 	}
+	metrics.IPsecCertificateExpirationTime.Set(float64(c.certificateKeyPair.certificate[0].NotAfter.Unix()))
+	metrics.IPsecCertificateRotationDeadline.Set(float64(deadline.Unix()))
 	// Re-queue after the interval to renew the certificate.
 	addAfter := deadline.Sub(c.clock.Now())
 	c.queue.AddAfter(workerItemKey, addAfter)
@@ -398,25 +411,13 @@ func (c *Controller) syncOVSConfigurations(certPath, keyPath, caPath string) err
 	return c.ovsBridgeClient.UpdateOVSOtherConfig(ovsConfig)
 }
 
-func newCSR(csrNamePrefix, commonName string, privateKey crypto.Signer) (*certificatesv1.CertificateSigningRequest, error) {
+// newCertificateRequest returns a PEM-encoded PKCS#10 certificate request for the given Node.
+func newCertificateRequest(commonName string, privateKey crypto.Signer) ([]byte, error) {
 	subject := &pkix.Name{
 		CommonName:   commonName,
 		Organization: []string{antreaapis.AntreaOrganizationName},
 	}
-	csrBytes, err := certutil.MakeCSR(privateKey, subject, []string{commonName}, nil)
-	if err != nil {
-		return nil, err
-	}
-	return &certificatesv1.CertificateSigningRequest{
-		ObjectMeta: metav1.ObjectMeta{
-			GenerateName: csrNamePrefix,
-		},
-		Spec: certificatesv1.CertificateSigningRequestSpec{
-			Request:    csrBytes,
-			SignerName: antreaapis.AntreaIPsecCSRSignerName,
-			Usages:     []certificatesv1.KeyUsage{certificatesv1.UsageIPsecTunnel},
-		},
-	}, nil
+	return certutil.MakeCSR(privateKey, subject, []string{commonName}, nil)
 }
 
 func (c *Controller) Run(stopCh <-chan struct{}) {
@@ -453,19 +454,13 @@ func (c *Controller) newCertificateKeyPair() (*certificateKeyPair, error) {
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate new private key: %w", err)
 	}
-	// Always create a new CSR for certificate rotation. The old ones will be GCed automatically.
-	csrNamePrefix := fmt.Sprintf("%s-", c.nodeName)
-	csr, err := newCSR(csrNamePrefix, c.nodeName, key)
-	if err != nil {
-		return nil, err
-	}
-	csr, err = c.kubeClient.CertificatesV1().CertificateSigningRequests().Create(context.TODO(), csr, metav1.CreateOptions{})
+	csrPEM, err := newCertificateRequest(c.nodeName, key)
 	if err != nil {
 		return nil, err
 	}
 	ctx, cancel := context.WithTimeout(context.Background(), certificateWaitTimeout)
 	defer cancel()
-	rawCert, err := csrutil.WaitForCertificate(ctx, c.kubeClient, csr.Name, csr.UID)
+	rawCert, err := c.issuer.issueCertificate(ctx, csrPEM)
 	if err != nil {
 		return nil, err
 	}
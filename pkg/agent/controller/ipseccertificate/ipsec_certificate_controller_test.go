@@ -133,7 +133,7 @@ func newFakeController(t *testing.T, clock clock.WithTicker) *fakeController {
 	err = certutil.WriteCert(filepath.Join(defaultCertificatesPath, "ca", "ca.crt"), caData)
 	require.NoError(t, err)
 
-	c := newIPSecCertificateControllerWithCustomClock(fakeClient, mockOVSBridgeClient, fakeNodeName, clock)
+	c := newIPSecCertificateControllerWithCustomClock(fakeClient, mockOVSBridgeClient, fakeNodeName, newKubernetesCSRIssuer(fakeClient, fakeNodeName), clock)
 	return &fakeController{
 		Controller:       c,
 		mockController:   mockController,
@@ -0,0 +1,95 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ipseccertificate
+
+import (
+	"context"
+	"fmt"
+
+	certificatesv1 "k8s.io/api/certificates/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/dynamic"
+	clientset "k8s.io/client-go/kubernetes"
+	csrutil "k8s.io/client-go/util/certificate/csr"
+
+	"antrea.io/antrea/pkg/agent/config"
+	antreaapis "antrea.io/antrea/pkg/apis"
+	"antrea.io/antrea/pkg/util/env"
+)
+
+// certificateIssuer issues IPsec certificates for the local Node.
+type certificateIssuer interface {
+	// issueCertificate submits the PEM-encoded PKCS#10 certificate request and blocks until
+	// the certificate is issued or ctx is done. It returns the PEM-encoded certificate chain,
+	// the first certificate of which is the one issued for the request.
+	issueCertificate(ctx context.Context, csrPEM []byte) ([]byte, error)
+}
+
+func newCertificateIssuer(
+	kubeClient clientset.Interface,
+	dynamicClient dynamic.Interface,
+	nodeName string,
+	issuerConfig *config.IPsecCertificateIssuerConfig,
+) (certificateIssuer, error) {
+	switch issuerConfig.Type {
+	case config.IPsecCertificateIssuerKubernetesCSR:
+		return newKubernetesCSRIssuer(kubeClient, nodeName), nil
+	case config.IPsecCertificateIssuerCertManager:
+		if dynamicClient == nil {
+			return nil, fmt.Errorf("dynamic client is required for cert-manager issuer")
+		}
+		certManagerConfig := issuerConfig.CertManager
+		if certManagerConfig.Namespace == "" {
+			certManagerConfig.Namespace = env.GetAntreaNamespace()
+		}
+		return newCertManagerIssuer(dynamicClient, nodeName, &certManagerConfig), nil
+	case config.IPsecCertificateIssuerEST:
+		return newESTIssuer(&issuerConfig.EST)
+	}
+	return nil, fmt.Errorf("unsupported certificate issuer type %s", issuerConfig.Type)
+}
+
+// kubernetesCSRIssuer requests certificates with Kubernetes CertificateSigningRequests, which
+// are signed by the Antrea signer for IPsec certificates.
+type kubernetesCSRIssuer struct {
+	kubeClient clientset.Interface
+	nodeName   string
+}
+
+func newKubernetesCSRIssuer(kubeClient clientset.Interface, nodeName string) *kubernetesCSRIssuer {
+	return &kubernetesCSRIssuer{
+		kubeClient: kubeClient,
+		nodeName:   nodeName,
+	}
+}
+
+func (i *kubernetesCSRIssuer) issueCertificate(ctx context.Context, csrPEM []byte) ([]byte, error) {
+	// Always create a new CSR for certificate rotation. The old ones will be GCed automatically.
+	csr := &certificatesv1.CertificateSigningRequest{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: fmt.Sprintf("%s-", i.nodeName),
+		},
+		Spec: certificatesv1.CertificateSigningRequestSpec{
+			Request:    csrPEM,
+			SignerName: antreaapis.AntreaIPsecCSRSignerName,
+			Usages:     []certificatesv1.KeyUsage{certificatesv1.UsageIPsecTunnel},
+		},
+	}
+	csr, err := i.kubeClient.CertificatesV1().CertificateSigningRequests().Create(ctx, csr, metav1.CreateOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return csrutil.WaitForCertificate(ctx, i.kubeClient, csr.Name, csr.UID)
+}
@@ -63,7 +63,7 @@ type L7FlowExporterController struct {
 	namespaceLister       corelisters.NamespaceLister
 	namespaceListerSynced cache.InformerSynced
 
-	l7Reconciler           *l7engine.Reconciler
+	l7Engine               l7engine.Engine
 	podToDirectionMap      map[string]v1alpha2.Direction
 	podToDirectionMapMutex sync.RWMutex
 
@@ -77,7 +77,7 @@ func NewL7FlowExporterController(
 	interfaceStore interfacestore.InterfaceStore,
 	podInformer cache.SharedIndexInformer,
 	namespaceInformer coreinformers.NamespaceInformer,
-	l7Reconciler *l7engine.Reconciler) *L7FlowExporterController {
+	l7Engine l7engine.Engine) *L7FlowExporterController {
 	l7c := &L7FlowExporterController{
 		ofClient:              ofClient,
 		interfaceStore:        interfaceStore,
@@ -87,7 +87,7 @@ func NewL7FlowExporterController(
 		namespaceInformer:     namespaceInformer.Informer(),
 		namespaceLister:       namespaceInformer.Lister(),
 		namespaceListerSynced: namespaceInformer.Informer().HasSynced,
-		l7Reconciler:          l7Reconciler,
+		l7Engine:              l7Engine,
 		podToDirectionMap:     make(map[string]v1alpha2.Direction),
 		queue:                 workqueue.NewNamedRateLimitingQueue(workqueue.NewItemExponentialFailureRateLimiter(minRetryDelay, maxRetryDelay), "L7FlowExporterController"),
 	}
@@ -326,7 +326,7 @@ func (l7c *L7FlowExporterController) syncPod(podNN string) error {
 	sourceOfPort := []uint32{uint32(podInterfaces[0].OFPort)}
 
 	// Start Suricata before starting traffic control mark flows
-	l7c.l7Reconciler.StartSuricataOnce()
+	l7c.l7Engine.Start()
 
 	oldDirection, exists := l7c.getMirroredDirection(podNN)
 	if exists {
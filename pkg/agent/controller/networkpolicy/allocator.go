@@ -222,11 +222,13 @@ type l7VlanIDAllocator struct {
 	idCounter      uint32
 	recycled       []uint32
 	ruleIDToVlanID map[string]uint32
+	vlanIDToRuleID map[uint32]string
 }
 
 func newL7VlanIDAllocator() *l7VlanIDAllocator {
 	return &l7VlanIDAllocator{
 		ruleIDToVlanID: make(map[string]uint32),
+		vlanIDToRuleID: make(map[uint32]string),
 	}
 }
 
@@ -247,6 +249,7 @@ func (l *l7VlanIDAllocator) allocate(ruleID string) uint32 {
 		vlanID = l.idCounter
 	}
 	l.ruleIDToVlanID[ruleID] = vlanID
+	l.vlanIDToRuleID[vlanID] = ruleID
 	return vlanID
 }
 
@@ -261,6 +264,7 @@ func (l *l7VlanIDAllocator) release(ruleID string) {
 
 	l.recycled = append(l.recycled, vlanID)
 	delete(l.ruleIDToVlanID, ruleID)
+	delete(l.vlanIDToRuleID, vlanID)
 }
 
 func (l *l7VlanIDAllocator) query(ruleID string) uint32 {
@@ -273,3 +277,12 @@ func (l *l7VlanIDAllocator) query(ruleID string) uint32 {
 	}
 	return 0
 }
+
+// queryRuleID returns the ID of the rule which the VLAN ID is allocated for. It returns an empty
+// string if the VLAN ID is not allocated.
+func (l *l7VlanIDAllocator) queryRuleID(vlanID uint32) string {
+	l.RLock()
+	defer l.RUnlock()
+
+	return l.vlanIDToRuleID[vlanID]
+}
@@ -264,12 +264,14 @@ func TestVlanIDAllocator(t *testing.T) {
 
 	vlanID1 := vlanIDAllocator.allocate(ruleID1)
 	assert.Equal(t, vlanID1, vlanIDAllocator.query(ruleID1))
+	assert.Equal(t, ruleID1, vlanIDAllocator.queryRuleID(vlanID1))
 
 	vlanID2 := vlanIDAllocator.allocate(ruleID2)
 	assert.Equal(t, vlanID2, vlanIDAllocator.query(ruleID2))
 
 	vlanIDAllocator.release(ruleID1)
 	assert.Equal(t, uint32(0), vlanIDAllocator.query(ruleID1))
+	assert.Empty(t, vlanIDAllocator.queryRuleID(vlanID1))
 
 	vlanIDAllocator.release(ruleID2)
 	assert.Equal(t, uint32(0), vlanIDAllocator.query(ruleID2))
@@ -281,4 +283,5 @@ func TestVlanIDAllocator(t *testing.T) {
 	vlanID4 := vlanIDAllocator.allocate(ruleID4)
 	assert.Equal(t, vlanID4, vlanIDAllocator.query(ruleID4))
 	assert.Equal(t, vlanID1, vlanID4)
+	assert.Equal(t, ruleID4, vlanIDAllocator.queryRuleID(vlanID4))
 }
@@ -41,6 +41,9 @@ const (
 	logfileSubdir   string = "networkpolicy"
 	logfileName     string = "np.log"
 	nullPlaceholder        = "<nil>"
+	// auditDispositionPrefix is prepended to the disposition of the traffic matching the rules of policies in Audit
+	// mode, e.g. "AuditDrop".
+	auditDispositionPrefix = "Audit"
 )
 
 // AuditLogger is used for network policy audit logging.
@@ -75,6 +78,7 @@ type logInfo struct {
 	destPort     string // destination port of the traffic logged
 	pktLength    string // packet length of packetin
 	protocolStr  string // protocol of the traffic logged
+	l7Request    string // layer 7 request of the traffic logged, only set for layer 7 NetworkPolicy rules
 }
 
 // logDedupRecord will be used as 1 sec buffer for log deduplication.
@@ -132,7 +136,7 @@ func (l *AuditLogger) updateLogKey(logMsg string, bufferLength time.Duration) bo
 }
 
 func buildLogMsg(ob *logInfo) string {
-	return strings.Join([]string{
+	fields := []string{
 		ob.tableName,
 		ob.npRef,
 		ob.ruleName,
@@ -147,7 +151,12 @@ func buildLogMsg(ob *logInfo) string {
 		ob.protocolStr,
 		ob.pktLength,
 		ob.logLabel,
-	}, " ")
+	}
+	if ob.l7Request != "" {
+		// The request is quoted as it may contain spaces.
+		fields = append(fields, strconv.Quote(ob.l7Request))
+	}
+	return strings.Join(fields, " ")
 }
 
 // LogDedupPacket logs information in ob based on disposition and duplication conditions.
@@ -250,8 +259,12 @@ func getNetworkPolicyInfo(pktIn *ofctrl.PacketIn, packet *binding.Packet, c *Con
 		}
 	}
 
-	// Get K8s default deny action, if traffic is default deny, no conjunction could be matched.
-	if match = getMatchRegField(matchers, openflow.APDenyRegMark.GetField()); match != nil {
+	// The traffic matching the rules of policies in Audit mode is never denied, the disposition is the one which
+	// would have been applied if the policies were enforced.
+	if isAntreaPolicyAuditTable(tableID) {
+		ob.disposition = auditDispositionPrefix + ob.disposition
+	} else if match = getMatchRegField(matchers, openflow.APDenyRegMark.GetField()); match != nil {
+		// Get K8s default deny action, if traffic is default deny, no conjunction could be matched.
 		apDenyRegVal, err := getInfoInReg(match, openflow.APDenyRegMark.GetField().GetRange().ToNXRange())
 		if err != nil {
 			return fmt.Errorf("received error while unloading deny mark from reg: %v", err)
@@ -327,6 +340,10 @@ func (c *Controller) logPacket(pktIn *ofctrl.PacketIn) error {
 	}
 	getPacketInfo(packet, ob)
 
+	// Record the traffic which would have been denied by policies in Audit mode.
+	if c.auditReport != nil && isAuditedDenyDisposition(ob.disposition) {
+		c.auditReport.addRecord(ob)
+	}
 	// Log the ob info to corresponding file w/ deduplication.
 	c.auditLogger.LogDedupPacket(ob)
 	return nil
@@ -0,0 +1,131 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkpolicy
+
+import (
+	"sort"
+	"sync"
+
+	"k8s.io/utils/clock"
+
+	"antrea.io/antrea/pkg/agent/openflow"
+	"antrea.io/antrea/pkg/agent/types"
+)
+
+// maxAuditRecords is the maximum number of records kept in the audit report. When it is reached, the least recently
+// seen record is evicted to make room for a new one.
+const maxAuditRecords = 1000
+
+// isAuditedDenyDisposition returns whether the disposition logged for the traffic matching a rule of a policy in Audit
+// mode means the traffic would have been dropped or rejected if the policy was enforced.
+func isAuditedDenyDisposition(disposition string) bool {
+	return disposition == auditDispositionPrefix+openflow.DispositionToString[openflow.DispositionDrop] ||
+		disposition == auditDispositionPrefix+openflow.DispositionToString[openflow.DispositionRej]
+}
+
+// auditRecordKey identifies the traffic audited by a rule. The source port is not part of it on purpose, so that all
+// the connections from a client to the same destination are aggregated in a single record.
+type auditRecordKey struct {
+	policyRef       string
+	ruleName        string
+	direction       string
+	disposition     string
+	sourceIP        string
+	destinationIP   string
+	protocol        string
+	destinationPort string
+}
+
+// auditReport keeps track of the traffic which would have been denied by the rules of Antrea-native policies in Audit
+// mode.
+type auditReport struct {
+	mutex      sync.RWMutex
+	clock      clock.Clock
+	maxRecords int
+	records    map[auditRecordKey]*types.AuditRecord
+}
+
+func newAuditReport(clock clock.Clock, maxRecords int) *auditReport {
+	return &auditReport{
+		clock:      clock,
+		maxRecords: maxRecords,
+		records:    map[auditRecordKey]*types.AuditRecord{},
+	}
+}
+
+// addRecord records a connection audited by a rule of a policy in Audit mode.
+func (r *auditReport) addRecord(ob *logInfo) {
+	key := auditRecordKey{
+		policyRef:       ob.npRef,
+		ruleName:        ob.ruleName,
+		direction:       ob.direction,
+		disposition:     ob.disposition,
+		sourceIP:        ob.srcIP,
+		destinationIP:   ob.destIP,
+		protocol:        ob.protocolStr,
+		destinationPort: ob.destPort,
+	}
+	now := r.clock.Now()
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if record, ok := r.records[key]; ok {
+		record.Count++
+		record.LastSeen = now
+		return
+	}
+	if len(r.records) >= r.maxRecords {
+		r.evictOldestRecord()
+	}
+	r.records[key] = &types.AuditRecord{
+		PolicyRef:       ob.npRef,
+		RuleName:        ob.ruleName,
+		Direction:       ob.direction,
+		Disposition:     ob.disposition,
+		SourceIP:        ob.srcIP,
+		DestinationIP:   ob.destIP,
+		Protocol:        ob.protocolStr,
+		DestinationPort: ob.destPort,
+		Count:           1,
+		LastSeen:        now,
+	}
+}
+
+// evictOldestRecord deletes the least recently seen record. The caller must hold the lock.
+func (r *auditReport) evictOldestRecord() {
+	var oldestKey auditRecordKey
+	var oldest *types.AuditRecord
+	for key, record := range r.records {
+		if oldest == nil || record.LastSeen.Before(oldest.LastSeen) {
+			oldestKey, oldest = key, record
+		}
+	}
+	if oldest != nil {
+		delete(r.records, oldestKey)
+	}
+}
+
+// getRecords returns copies of all the records, the most recently seen first.
+func (r *auditReport) getRecords() []types.AuditRecord {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	records := make([]types.AuditRecord, 0, len(r.records))
+	for _, record := range r.records {
+		records = append(records, *record)
+	}
+	sort.Slice(records, func(i, j int) bool {
+		return records[i].LastSeen.After(records[j].LastSeen)
+	})
+	return records
+}
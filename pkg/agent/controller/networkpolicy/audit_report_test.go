@@ -0,0 +1,89 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkpolicy
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	"antrea.io/antrea/pkg/agent/types"
+)
+
+func newAuditLogInfo(ruleName, srcIP string) *logInfo {
+	return &logInfo{
+		npRef:       "AntreaNetworkPolicy:default/test-anp",
+		ruleName:    ruleName,
+		direction:   "Ingress",
+		disposition: "AuditDrop",
+		srcIP:       srcIP,
+		srcPort:     "35402",
+		destIP:      "10.10.1.2",
+		destPort:    "80",
+		protocolStr: "TCP",
+	}
+}
+
+func TestIsAuditedDenyDisposition(t *testing.T) {
+	assert.True(t, isAuditedDenyDisposition("AuditDrop"))
+	assert.True(t, isAuditedDenyDisposition("AuditReject"))
+	assert.False(t, isAuditedDenyDisposition("AuditAllow"))
+	assert.False(t, isAuditedDenyDisposition("AuditPass"))
+	assert.False(t, isAuditedDenyDisposition("Drop"))
+}
+
+func TestAuditReportAddRecord(t *testing.T) {
+	startTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	clock := clocktesting.NewFakeClock(startTime)
+	report := newAuditReport(clock, 2)
+
+	report.addRecord(newAuditLogInfo("rule1", "10.10.1.1"))
+	clock.Step(time.Second)
+	// A connection from a different source port is aggregated in the same record.
+	ob := newAuditLogInfo("rule1", "10.10.1.1")
+	ob.srcPort = "35403"
+	report.addRecord(ob)
+	clock.Step(time.Second)
+	report.addRecord(newAuditLogInfo("rule2", "10.10.1.3"))
+
+	expectedRecord := func(ruleName, srcIP string, count int64, lastSeen time.Time) types.AuditRecord {
+		return types.AuditRecord{
+			PolicyRef:       "AntreaNetworkPolicy:default/test-anp",
+			RuleName:        ruleName,
+			Direction:       "Ingress",
+			Disposition:     "AuditDrop",
+			SourceIP:        srcIP,
+			DestinationIP:   "10.10.1.2",
+			Protocol:        "TCP",
+			DestinationPort: "80",
+			Count:           count,
+			LastSeen:        lastSeen,
+		}
+	}
+	assert.Equal(t, []types.AuditRecord{
+		expectedRecord("rule2", "10.10.1.3", 1, startTime.Add(2*time.Second)),
+		expectedRecord("rule1", "10.10.1.1", 2, startTime.Add(time.Second)),
+	}, report.getRecords())
+
+	// The least recently seen record is evicted when the report is full.
+	clock.Step(time.Second)
+	report.addRecord(newAuditLogInfo("rule3", "10.10.1.4"))
+	assert.Equal(t, []types.AuditRecord{
+		expectedRecord("rule3", "10.10.1.4", 1, startTime.Add(3*time.Second)),
+		expectedRecord("rule2", "10.10.1.3", 1, startTime.Add(2*time.Second)),
+	}, report.getRecords())
+}
@@ -100,6 +100,13 @@ type rule struct {
 	EnableLogging bool
 	// LogLabel is a string associated to the NetworkPolicy rule. Used for logging.
 	LogLabel string
+	// L7DenyResponse specifies the responses sent to clients whose layer 7 requests are denied by this rule.
+	L7DenyResponse *v1beta.L7DenyResponse `json:",omitempty"`
+	// RateLimit specifies the rate above which the traffic matching this rule is dropped.
+	RateLimit *v1beta.RuleRateLimit `json:",omitempty"`
+	// EnforcementMode of the NetworkPolicy to which this rule belongs. Rules of policies in Audit mode are only
+	// audited and not enforced.
+	EnforcementMode crdv1beta1.PolicyEnforcementMode `json:",omitempty"`
 }
 
 func (r *rule) Less(r2 *rule) bool {
@@ -171,6 +178,10 @@ func (r *CompletedRule) isAntreaNetworkPolicyRule() bool {
 	return r.SourceRef.Type != v1beta.K8sNetworkPolicy
 }
 
+func (r *CompletedRule) isAuditModeRule() bool {
+	return r.EnforcementMode == crdv1beta1.PolicyEnforcementModeAudit
+}
+
 func (r *CompletedRule) isIGMPEgressPolicyRule() bool {
 	if r.Direction == v1beta.DirectionOut {
 		for _, svc := range r.Services {
@@ -721,6 +732,9 @@ func toRule(r *v1beta.NetworkPolicyRule, policy *v1beta.NetworkPolicy, maxPriori
 		SourceRef:       policy.SourceRef,
 		EnableLogging:   r.EnableLogging,
 		LogLabel:        r.LogLabel,
+		L7DenyResponse:  r.L7DenyResponse,
+		RateLimit:       r.RateLimit,
+		EnforcementMode: policy.EnforcementMode,
 	}
 	rule.ID = hashRule(rule)
 	rule.PolicyName = policy.Name
@@ -872,6 +886,15 @@ func (c *ruleCache) deleteNetworkPolicyLocked(uid string) {
 	metrics.NetworkPolicyCount.Dec()
 }
 
+// getRule returns the rule of the given ruleID without resolving its groups.
+func (c *ruleCache) getRule(ruleID string) (*rule, bool) {
+	obj, exists, _ := c.rules.GetByKey(ruleID)
+	if !exists {
+		return nil, false
+	}
+	return obj.(*rule), true
+}
+
 // GetCompletedRule constructs a *CompletedRule for the provided ruleID.
 // If the rule is not effective or not realizable due to missing group data, the return value will indicate it.
 // A rule is considered effective when any of its AppliedToGroups can be populated.
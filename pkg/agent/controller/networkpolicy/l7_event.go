@@ -0,0 +1,172 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkpolicy
+
+import (
+	"fmt"
+	"strconv"
+
+	"antrea.io/libOpenflow/protocol"
+	"k8s.io/klog/v2"
+
+	"antrea.io/antrea/pkg/agent/controller/networkpolicy/l7engine"
+	"antrea.io/antrea/pkg/agent/interfacestore"
+	"antrea.io/antrea/pkg/agent/openflow"
+	"antrea.io/antrea/pkg/apis/controlplane/v1beta2"
+	binding "antrea.io/antrea/pkg/ovs/openflow"
+)
+
+const (
+	// l7NetworkPolicyTableName is used as the table name of the audit logs of layer 7 requests, which are not
+	// logged by any OVS table.
+	l7NetworkPolicyTableName = "L7NetworkPolicy"
+
+	tcpFlagFIN uint8 = 0b000001
+	tcpFlagRST uint8 = 0b000100
+	tcpFlagPSH uint8 = 0b001000
+	tcpFlagACK uint8 = 0b010000
+)
+
+// handleL7Event is called with every layer 7 request reported by the L7 engine. It sends the deny response of the
+// matched L7 rule to the client if the request is blocked, and logs the request if logging is enabled for the rule.
+func (c *Controller) handleL7Event(event *l7engine.Event) {
+	ruleID := c.l7VlanIDAllocator.queryRuleID(event.VlanID)
+	if ruleID == "" {
+		klog.V(2).InfoS("No L7 rule found for the VLAN ID of the event", "vlanID", event.VlanID)
+		return
+	}
+	rule, exists := c.ruleCache.getRule(ruleID)
+	if !exists {
+		klog.V(2).InfoS("L7 rule not found in cache", "ruleID", ruleID)
+		return
+	}
+	if !event.Allowed && rule.L7DenyResponse != nil {
+		if err := c.sendL7DenyResponse(rule.L7DenyResponse, event); err != nil {
+			klog.ErrorS(err, "Failed to send deny response for the blocked L7 request", "ruleID", ruleID,
+				"srcIP", event.SrcIP, "srcPort", event.SrcPort, "destIP", event.DestIP, "destPort", event.DestPort)
+		}
+	}
+	if rule.EnableLogging && c.auditLogger != nil {
+		c.auditLogger.LogDedupPacket(c.getL7LogInfo(rule, event))
+	}
+}
+
+// getL7LogInfo fills in logInfo with the L7 rule and the layer 7 request.
+func (c *Controller) getL7LogInfo(rule *rule, event *l7engine.Event) *logInfo {
+	ob := &logInfo{
+		tableName:   l7NetworkPolicyTableName,
+		npRef:       rule.SourceRef.ToString(),
+		ruleName:    rule.Name,
+		logLabel:    rule.LogLabel,
+		srcIP:       event.SrcIP.String(),
+		srcPort:     strconv.FormatUint(uint64(event.SrcPort), 10),
+		destIP:      event.DestIP.String(),
+		destPort:    strconv.FormatUint(uint64(event.DestPort), 10),
+		protocolStr: event.Proto,
+		l7Request:   event.Request,
+	}
+	ob.disposition = openflow.DispositionToString[openflow.DispositionAllow]
+	if !event.Allowed {
+		ob.disposition = openflow.DispositionToString[openflow.DispositionRej]
+	}
+	localIP := event.SrcIP.String()
+	ob.direction = "Egress"
+	if rule.Direction == v1beta2.DirectionIn {
+		localIP = event.DestIP.String()
+		ob.direction = "Ingress"
+	}
+	iface, ok := c.ifaceStore.GetInterfaceByIP(localIP)
+	if ok && iface.Type == interfacestore.ContainerInterface {
+		ob.appliedToRef = fmt.Sprintf("%s/%s", iface.ContainerInterfaceConfig.PodNamespace, iface.ContainerInterfaceConfig.PodName)
+	}
+	// The OpenFlow priority and the packet length are not relevant to layer 7 requests.
+	fillLogInfoPlaceholders([]*string{&ob.ruleName, &ob.logLabel, &ob.ofPriority, &ob.appliedToRef, &ob.pktLength})
+	return ob
+}
+
+// sendL7DenyResponse sends the deny response to the client of a blocked request and resets the connection to the
+// server, using the packet which set off the alert to get the addresses and the sequence numbers of the connection.
+func (c *Controller) sendL7DenyResponse(denyResponse *v1beta2.L7DenyResponse, event *l7engine.Event) error {
+	var payload []byte
+	switch {
+	case event.AppProto == "http" && denyResponse.HTTP != nil:
+		payload = l7engine.HTTPDenyResponse(denyResponse.HTTP)
+	case event.AppProto == "tls" && denyResponse.TLS != nil:
+		payload = l7engine.TLSDenyResponse(denyResponse.TLS)
+	default:
+		return nil
+	}
+	if len(event.Packet) == 0 {
+		return fmt.Errorf("packet of the blocked request is not available")
+	}
+
+	ethernetPkt := protocol.NewEthernet()
+	if err := ethernetPkt.UnmarshalBinary(event.Packet); err != nil {
+		return fmt.Errorf("error parsing packet of the blocked request: %w", err)
+	}
+	var isIPv6 bool
+	switch ethernetPkt.Data.(type) {
+	case *protocol.IPv4:
+		isIPv6 = false
+	case *protocol.IPv6:
+		isIPv6 = true
+	default:
+		return fmt.Errorf("packet of the blocked request is not an IP packet")
+	}
+	tcpPkt, err := binding.GetTCPPacketFromIPMessage(ethernetPkt.Data)
+	if err != nil {
+		return fmt.Errorf("error parsing TCP segment of the blocked request: %w", err)
+	}
+	// TCP.Data includes the TCP options.
+	payloadLen := len(tcpPkt.Data) - (int(tcpPkt.HdrLen)-5)*4
+	if payloadLen < 0 {
+		return fmt.Errorf("invalid TCP header length %d of the blocked request", tcpPkt.HdrLen)
+	}
+
+	clientMAC, serverMAC := ethernetPkt.HWSrc.String(), ethernetPkt.HWDst.String()
+	clientIP, serverIP := event.SrcIP.String(), event.DestIP.String()
+	// Send the deny response with the connection closed on behalf of the server. The server hasn't received the
+	// blocked request, hence it is acknowledged in the response.
+	if err := c.sendL7DenyPacketOut(serverMAC, clientMAC, serverIP, clientIP, isIPv6, tcpPkt.PortDst, tcpPkt.PortSrc,
+		tcpPkt.AckNum, tcpPkt.SeqNum+uint32(payloadLen), tcpFlagPSH|tcpFlagACK|tcpFlagFIN, payload); err != nil {
+		return fmt.Errorf("error sending deny response to client %s: %w", clientIP, err)
+	}
+	// Reset the connection on behalf of the client, as Suricata drops the remaining packets of the flow.
+	if err := c.sendL7DenyPacketOut(clientMAC, serverMAC, clientIP, serverIP, isIPv6, tcpPkt.PortSrc, tcpPkt.PortDst,
+		tcpPkt.SeqNum, 0, tcpFlagRST, nil); err != nil {
+		return fmt.Errorf("error sending reset to server %s: %w", serverIP, err)
+	}
+	return nil
+}
+
+// sendL7DenyPacketOut sends a TCP packet to the destination the same way as the reject responses of Antrea-native
+// policy rules.
+func (c *Controller) sendL7DenyPacketOut(srcMAC, dstMAC, srcIP, dstIP string, isIPv6 bool, srcPort, dstPort uint16,
+	seqNum, ackNum uint32, flags uint8, data []byte) error {
+	sIface, srcIsLocal := c.ifaceStore.GetInterfaceByIP(srcIP)
+	dIface, dstIsLocal := c.ifaceStore.GetInterfaceByIP(dstIP)
+	packetOutType := getRejectType(false, c.antreaProxyEnabled, srcIsLocal, dstIsLocal)
+	if packetOutType == unsupported {
+		return fmt.Errorf("neither %s nor %s is on this Node", srcIP, dstIP)
+	}
+	if packetOutType == rejectPodLocal {
+		srcMAC = sIface.MAC.String()
+		dstMAC = dIface.MAC.String()
+	}
+	inPort, outPort := getRejectOFPorts(packetOutType, sIface, dIface, c.gwPort, c.tunPort)
+	mutateFunc := getRejectPacketOutMutateFunc(packetOutType, c.nodeType, false, false, 0)
+	return c.ofClient.SendTCPPacketOut(srcMAC, dstMAC, srcIP, dstIP, inPort, outPort, isIPv6, srcPort, dstPort,
+		seqNum, ackNum, 0, flags, 0, data, mutateFunc)
+}
@@ -0,0 +1,201 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkpolicy
+
+import (
+	"encoding/binary"
+	"net"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"k8s.io/utils/clock"
+
+	"antrea.io/antrea/pkg/agent/config"
+	"antrea.io/antrea/pkg/agent/controller/networkpolicy/l7engine"
+	"antrea.io/antrea/pkg/agent/interfacestore"
+	openflowtesting "antrea.io/antrea/pkg/agent/openflow/testing"
+	"antrea.io/antrea/pkg/agent/util"
+	"antrea.io/antrea/pkg/apis/controlplane/v1beta2"
+	"antrea.io/antrea/pkg/util/channel"
+)
+
+var (
+	l7ClientMAC, _ = net.ParseMAC("aa:bb:cc:dd:ee:01")
+	l7ServerMAC, _ = net.ParseMAC("aa:bb:cc:dd:ee:02")
+	l7ClientIP     = netip.MustParseAddr("10.10.0.1")
+	l7ServerIP     = netip.MustParseAddr("10.10.0.2")
+)
+
+// newL7RequestFrame returns an Ethernet frame carrying a TCP segment with the payload from the client to the server.
+func newL7RequestFrame(seqNum, ackNum uint32, payload []byte) []byte {
+	frame := append([]byte{}, l7ServerMAC...)
+	frame = append(frame, l7ClientMAC...)
+	frame = binary.BigEndian.AppendUint16(frame, 0x0800)
+	// IPv4 header without options.
+	frame = append(frame, 0x45, 0)
+	frame = binary.BigEndian.AppendUint16(frame, uint16(20+20+len(payload)))
+	frame = append(frame, 0, 0, 0, 0, 64, 6, 0, 0)
+	frame = append(frame, l7ClientIP.AsSlice()...)
+	frame = append(frame, l7ServerIP.AsSlice()...)
+	// TCP header without options.
+	frame = binary.BigEndian.AppendUint16(frame, 34567)
+	frame = binary.BigEndian.AppendUint16(frame, 80)
+	frame = binary.BigEndian.AppendUint32(frame, seqNum)
+	frame = binary.BigEndian.AppendUint32(frame, ackNum)
+	frame = append(frame, 5<<4, tcpFlagPSH|tcpFlagACK, 0xff, 0xff, 0, 0, 0, 0)
+	return append(frame, payload...)
+}
+
+func newTestL7EventController(t *testing.T, l7Rule *rule) (*Controller, *openflowtesting.MockClient, *mockLogger) {
+	ctrl := gomock.NewController(t)
+	mockOFClient := openflowtesting.NewMockClient(ctrl)
+	auditLogger, mockNPLogger := newTestAuditLogger(testBufferLength, clock.RealClock{})
+	ifaceStore := interfacestore.NewInterfaceStore()
+	ifaceStore.AddInterface(&interfacestore.InterfaceConfig{
+		InterfaceName:            util.GenerateContainerInterfaceName("client", "default", "c1"),
+		Type:                     interfacestore.ContainerInterface,
+		IPs:                      []net.IP{l7ClientIP.AsSlice()},
+		MAC:                      l7ClientMAC,
+		ContainerInterfaceConfig: &interfacestore.ContainerInterfaceConfig{PodName: "client", PodNamespace: "default", ContainerID: "c1"},
+		OVSPortConfig:            &interfacestore.OVSPortConfig{OFPort: 1},
+	})
+	ifaceStore.AddInterface(&interfacestore.InterfaceConfig{
+		InterfaceName:            util.GenerateContainerInterfaceName("server", "default", "c2"),
+		Type:                     interfacestore.ContainerInterface,
+		IPs:                      []net.IP{l7ServerIP.AsSlice()},
+		MAC:                      l7ServerMAC,
+		ContainerInterfaceConfig: &interfacestore.ContainerInterfaceConfig{PodName: "server", PodNamespace: "default", ContainerID: "c2"},
+		OVSPortConfig:            &interfacestore.OVSPortConfig{OFPort: 2},
+	})
+	c := &Controller{
+		ofClient:           mockOFClient,
+		antreaProxyEnabled: true,
+		ifaceStore:         ifaceStore,
+		auditLogger:        auditLogger,
+		l7VlanIDAllocator:  newL7VlanIDAllocator(),
+		ruleCache:          newRuleCache(func(string) {}, channel.NewSubscribableChannel("PodUpdate", 100), nil, make(chan string, 100), config.K8sNode),
+	}
+	c.ruleCache.rules.Add(l7Rule)
+	c.l7VlanIDAllocator.allocate(l7Rule.ID)
+	return c, mockOFClient, mockNPLogger
+}
+
+func TestHandleL7Event(t *testing.T) {
+	denyResponse := &v1beta2.L7DenyResponse{
+		HTTP: &v1beta2.HTTPDenyResponse{Body: "denied"},
+	}
+	payload := []byte("GET /admin HTTP/1.1\r\nHost: foo.bar.com\r\n\r\n")
+
+	testCases := []struct {
+		name           string
+		rule           *rule
+		event          *l7engine.Event
+		expectedCalls  func(mockOFClient *openflowtesting.MockClientMockRecorder)
+		expectedLogMsg string
+	}{
+		{
+			name: "allowed request logged",
+			rule: &rule{
+				ID:            "rule1",
+				Direction:     v1beta2.DirectionIn,
+				Name:          "allow-http",
+				SourceRef:     testANNPRef,
+				EnableLogging: true,
+				LogLabel:      "test-label",
+			},
+			event: &l7engine.Event{
+				Allowed:  true,
+				SrcIP:    l7ClientIP,
+				SrcPort:  34567,
+				DestIP:   l7ServerIP,
+				DestPort: 80,
+				Proto:    "TCP",
+				AppProto: "http",
+				Request:  "GET foo.bar.com/public",
+			},
+			expectedLogMsg: `L7NetworkPolicy AntreaNetworkPolicy:default/test allow-http Ingress Allow <nil> default/server 10.10.0.1 34567 10.10.0.2 80 TCP <nil> test-label "GET foo.bar.com/public"`,
+		},
+		{
+			name: "blocked request with deny response",
+			rule: &rule{
+				ID:             "rule2",
+				Direction:      v1beta2.DirectionOut,
+				SourceRef:      testANNPRef,
+				EnableLogging:  true,
+				L7DenyResponse: denyResponse,
+			},
+			event: &l7engine.Event{
+				SrcIP:    l7ClientIP,
+				SrcPort:  34567,
+				DestIP:   l7ServerIP,
+				DestPort: 80,
+				Proto:    "TCP",
+				AppProto: "http",
+				Request:  "GET foo.bar.com/admin",
+				Packet:   newL7RequestFrame(1000, 2000, payload),
+			},
+			expectedCalls: func(mockOFClient *openflowtesting.MockClientMockRecorder) {
+				mockOFClient.SendTCPPacketOut(l7ServerMAC.String(), l7ClientMAC.String(), l7ServerIP.String(), l7ClientIP.String(), uint32(2), uint32(1), false,
+					uint16(80), uint16(34567), uint32(2000), uint32(1000+len(payload)), uint8(0), tcpFlagPSH|tcpFlagACK|tcpFlagFIN, uint16(0),
+					l7engine.HTTPDenyResponse(denyResponse.HTTP), gomock.Any())
+				mockOFClient.SendTCPPacketOut(l7ClientMAC.String(), l7ServerMAC.String(), l7ClientIP.String(), l7ServerIP.String(), uint32(1), uint32(2), false,
+					uint16(34567), uint16(80), uint32(1000), uint32(0), uint8(0), tcpFlagRST, uint16(0), nil, gomock.Any())
+			},
+			expectedLogMsg: `L7NetworkPolicy AntreaNetworkPolicy:default/test <nil> Egress Reject <nil> default/client 10.10.0.1 34567 10.10.0.2 80 TCP <nil> <nil> "GET foo.bar.com/admin"`,
+		},
+		{
+			name: "blocked request without logging",
+			rule: &rule{
+				ID:        "rule3",
+				Direction: v1beta2.DirectionOut,
+				SourceRef: testANNPRef,
+			},
+			event: &l7engine.Event{
+				SrcIP:    l7ClientIP,
+				SrcPort:  34567,
+				DestIP:   l7ServerIP,
+				DestPort: 443,
+				Proto:    "TCP",
+				AppProto: "tls",
+				Request:  "foo.bar.com",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			c, mockOFClient, mockNPLogger := newTestL7EventController(t, tc.rule)
+			if tc.expectedCalls != nil {
+				tc.expectedCalls(mockOFClient.EXPECT())
+			}
+			tc.event.VlanID = c.l7VlanIDAllocator.query(tc.rule.ID)
+			c.handleL7Event(tc.event)
+			if tc.expectedLogMsg != "" {
+				actual := <-mockNPLogger.logged
+				assert.Contains(t, actual, tc.expectedLogMsg)
+			} else {
+				assert.Empty(t, mockNPLogger.logged)
+			}
+		})
+	}
+}
+
+func TestHandleL7EventUnknownVlanID(t *testing.T) {
+	c, _, mockNPLogger := newTestL7EventController(t, &rule{ID: "rule1", SourceRef: testANNPRef, EnableLogging: true})
+	c.handleL7Event(&l7engine.Event{VlanID: 100, Allowed: true, SrcIP: l7ClientIP, DestIP: l7ServerIP})
+	assert.Empty(t, mockNPLogger.logged)
+}
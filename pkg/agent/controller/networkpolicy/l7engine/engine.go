@@ -0,0 +1,52 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package l7engine
+
+import (
+	"fmt"
+
+	v1beta "antrea.io/antrea/pkg/apis/controlplane/v1beta2"
+)
+
+const (
+	// EngineSuricata is the L7 engine backed by a Suricata instance running in IPS mode.
+	EngineSuricata = "Suricata"
+	// EngineNative is the L7 engine implemented in Go and running in antrea-agent, which supports HTTP and TLS.
+	EngineNative = "Native"
+)
+
+// Engine enforces L7 rules on the packets redirected to it by OVS. The packets matched by an L7 rule are tagged with
+// the VLAN ID allocated for the rule, and the allowed packets are sent back to OVS with the same VLAN tag.
+type Engine interface {
+	// Start starts the engine. It is safe to be called multiple times, and is called implicitly when a rule is added.
+	Start()
+	// AddRule adds the L7 rule enforced on the packets tagged with the VLAN ID, or updates it if it already exists.
+	AddRule(ruleID, policyName string, vlanID uint32, l7Protocols []v1beta.L7Protocol, l7DenyResponse *v1beta.L7DenyResponse, enableLogging bool) error
+	// DeleteRule deletes the L7 rule enforced on the packets tagged with the VLAN ID.
+	DeleteRule(ruleID string, vlanID uint32) error
+	// RegisterEventHandler registers a handler which is called with every layer 7 request reported by the engine.
+	RegisterEventHandler(handler EventHandler)
+}
+
+// NewEngine returns the L7 engine of the given type.
+func NewEngine(engineType string) (Engine, error) {
+	switch engineType {
+	case EngineSuricata:
+		return NewSuricataEngine(), nil
+	case EngineNative:
+		return NewNativeEngine(), nil
+	}
+	return nil, fmt.Errorf("unsupported L7 engine %q", engineType)
+}
@@ -0,0 +1,157 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package l7engine
+
+import (
+	"fmt"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"antrea.io/antrea/pkg/agent/config"
+	v1beta "antrea.io/antrea/pkg/apis/controlplane/v1beta2"
+)
+
+// fakeFrameConn is a frameConn whose received frames are fed by the test, and whose sent frames are recorded.
+type fakeFrameConn struct {
+	received chan []byte
+	sent     chan []byte
+}
+
+func newFakeFrameConn() *fakeFrameConn {
+	return &fakeFrameConn{
+		received: make(chan []byte, 10),
+		sent:     make(chan []byte, 10),
+	}
+}
+
+func (c *fakeFrameConn) readFrame(buf []byte) ([]byte, uint32, error) {
+	frame, ok := <-c.received
+	if !ok {
+		return nil, 0, io.EOF
+	}
+	frame, vlanID, _ := untagFrame(frame)
+	return frame[:copy(buf, frame)], vlanID, nil
+}
+
+func (c *fakeFrameConn) writeFrame(frame []byte) error {
+	c.sent <- frame
+	return nil
+}
+
+func (c *fakeFrameConn) close() error {
+	return nil
+}
+
+func TestNewEngine(t *testing.T) {
+	engine, err := NewEngine(EngineSuricata)
+	require.NoError(t, err)
+	assert.IsType(t, &SuricataEngine{}, engine)
+	engine, err = NewEngine(EngineNative)
+	require.NoError(t, err)
+	assert.IsType(t, &NativeEngine{}, engine)
+	_, err = NewEngine("Foo")
+	assert.Error(t, err)
+}
+
+// TestEngines verifies that the engines enforce an L7 rule and report the requests through the Engine interface. The
+// requests are fed to the engines in their own way: an eve event for Suricata, and frames for the native engine.
+func TestEngines(t *testing.T) {
+	ruleID := "123456"
+	vlanID := uint32(1)
+	policyName := "AntreaNetworkPolicy:test-l7"
+	l7Protocols := []v1beta.L7Protocol{{HTTP: &v1beta.HTTPProtocol{Host: "foo.bar.com"}}}
+	request := []byte("GET /api HTTP/1.1\r\nHost: foo.bar.com\r\n\r\n")
+	eveEvent := fmt.Sprintf(`{"timestamp":"2024-01-02T15:04:05.123456+0000","flow_id":1,"event_type":"http","vlan":[%d],"src_ip":"10.10.0.1","src_port":34567,"dest_ip":"10.10.0.2","dest_port":80,"proto":"TCP","app_proto":"http","http":{"hostname":"foo.bar.com","url":"/api","http_method":"GET"}}`, vlanID)
+
+	testCases := []struct {
+		name string
+		// newEngine returns the engine to test, and a function which makes the engine see the request.
+		newEngine func(t *testing.T) (Engine, func())
+	}{
+		{
+			name: EngineSuricata,
+			newEngine: func(t *testing.T) (Engine, func()) {
+				defaultFS = afero.NewMemMapFs()
+				t.Cleanup(func() {
+					defaultFS = afero.NewOsFs()
+				})
+				_, err := defaultFS.Create(defaultSuricataConfigPath)
+				require.NoError(t, err)
+
+				e := NewSuricataEngine()
+				fs := newFakeSuricata()
+				e.suricataScFn = fs.suricataScFunc
+				e.startSuricataFn = fs.startSuricataFn
+				e.listenEventsFn = func() {}
+				return e, func() {
+					require.NoError(t, e.processEvent([]byte(eveEvent)))
+				}
+			},
+		},
+		{
+			name: EngineNative,
+			newEngine: func(t *testing.T) (Engine, func()) {
+				conns := map[string]*fakeFrameConn{
+					config.L7RedirectTargetPortName: newFakeFrameConn(),
+					config.L7RedirectReturnPortName: newFakeFrameConn(),
+				}
+				t.Cleanup(func() {
+					close(conns[config.L7RedirectTargetPortName].received)
+				})
+				e := NewNativeEngine()
+				e.openFrameConnFn = func(ifaceName string) (frameConn, error) {
+					return conns[ifaceName], nil
+				}
+				return e, func() {
+					frame := newClientFrame(1000, 2000, tcpFlagPSH|tcpFlagACK, request)
+					conns[config.L7RedirectTargetPortName].received <- tagFrame(frame, vlanID)
+					select {
+					case sent := <-conns[config.L7RedirectReturnPortName].sent:
+						assert.Equal(t, tagFrame(frame, vlanID), sent)
+					case <-time.After(time.Second):
+						t.Fatal("Allowed request was not forwarded")
+					}
+				}
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			engine, feedRequest := tc.newEngine(t)
+			events := make(chan *Event, 1)
+			engine.RegisterEventHandler(func(event *Event) {
+				events <- event
+			})
+			require.NoError(t, engine.AddRule(ruleID, policyName, vlanID, l7Protocols, nil, false))
+
+			feedRequest()
+			select {
+			case event := <-events:
+				assert.Equal(t, vlanID, event.VlanID)
+				assert.True(t, event.Allowed)
+				assert.Equal(t, "GET foo.bar.com/api", event.Request)
+			case <-time.After(time.Second):
+				t.Fatal("Event of the request was not reported")
+			}
+
+			require.NoError(t, engine.DeleteRule(ruleID, vlanID))
+		})
+	}
+}
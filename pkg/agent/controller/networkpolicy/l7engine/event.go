@@ -0,0 +1,98 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package l7engine
+
+import (
+	"encoding/binary"
+	"fmt"
+	"net/netip"
+	"sync"
+	"time"
+
+	v1beta "antrea.io/antrea/pkg/apis/controlplane/v1beta2"
+)
+
+const (
+	// Values of the TLS alert descriptions defined in RFC 8446.
+	tlsAlertHandshakeFailure uint8 = 40
+	tlsAlertAccessDenied     uint8 = 49
+	tlsAlertUnrecognizedName uint8 = 112
+)
+
+// Event is a layer 7 request reported by an L7 engine, which is either allowed by an L7 rule, or blocked by the
+// default reject rule of an L7 rule.
+type Event struct {
+	Timestamp time.Time
+	// VlanID is the VLAN ID allocated for the L7 rule the request is matched with.
+	VlanID   uint32
+	Allowed  bool
+	SrcIP    netip.Addr
+	SrcPort  uint16
+	DestIP   netip.Addr
+	DestPort uint16
+	Proto    string
+	AppProto string
+	// Request is a summary of the request, e.g. "GET foo.bar.com/api" for HTTP, the SNI for TLS, or
+	// "A foo.bar.com" for DNS. It may be empty if the L7 engine didn't parse the request.
+	Request string
+	// Packet is the Ethernet frame which set off the alert. It is only set for blocked requests.
+	Packet []byte
+}
+
+// EventHandler is called with every layer 7 event reported by an L7 engine.
+type EventHandler func(event *Event)
+
+// eventDispatcher dispatches the events reported by an L7 engine to the registered handlers.
+type eventDispatcher struct {
+	handlersMutex sync.RWMutex
+	handlers      []EventHandler
+}
+
+// RegisterEventHandler registers a handler which is called with every layer 7 request reported by the engine.
+func (d *eventDispatcher) RegisterEventHandler(handler EventHandler) {
+	d.handlersMutex.Lock()
+	defer d.handlersMutex.Unlock()
+	d.handlers = append(d.handlers, handler)
+}
+
+func (d *eventDispatcher) dispatch(event *Event) {
+	d.handlersMutex.RLock()
+	defer d.handlersMutex.RUnlock()
+	for _, handler := range d.handlers {
+		handler(event)
+	}
+}
+
+// HTTPDenyResponse returns the HTTP response sent to clients whose HTTP requests are denied.
+func HTTPDenyResponse(response *v1beta.HTTPDenyResponse) []byte {
+	return []byte(fmt.Sprintf("HTTP/1.1 403 Forbidden\r\nContent-Type: text/plain\r\nContent-Length: %d\r\nConnection: close\r\n\r\n%s",
+		len(response.Body), response.Body))
+}
+
+// TLSDenyResponse returns the TLS alert record sent to clients whose TLS handshakes are denied.
+func TLSDenyResponse(response *v1beta.TLSDenyResponse) []byte {
+	description := tlsAlertAccessDenied
+	switch response.Alert {
+	case v1beta.TLSAlertHandshakeFailure:
+		description = tlsAlertHandshakeFailure
+	case v1beta.TLSAlertUnrecognizedName:
+		description = tlsAlertUnrecognizedName
+	}
+	// The record header: content type alert(21), version TLS 1.2 and length of the alert. The alert: level fatal(2)
+	// and the description.
+	record := []byte{21, 3, 3}
+	record = binary.BigEndian.AppendUint16(record, 2)
+	return append(record, 2, description)
+}
@@ -0,0 +1,56 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package l7engine
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	v1beta "antrea.io/antrea/pkg/apis/controlplane/v1beta2"
+)
+
+func TestHTTPDenyResponse(t *testing.T) {
+	expected := "HTTP/1.1 403 Forbidden\r\nContent-Type: text/plain\r\nContent-Length: 13\r\nConnection: close\r\n\r\nAccess denied"
+	assert.Equal(t, expected, string(HTTPDenyResponse(&v1beta.HTTPDenyResponse{Body: "Access denied"})))
+}
+
+func TestTLSDenyResponse(t *testing.T) {
+	testCases := []struct {
+		alert    v1beta.TLSAlert
+		expected []byte
+	}{
+		{
+			alert:    v1beta.TLSAlertAccessDenied,
+			expected: []byte{0x15, 0x03, 0x03, 0x00, 0x02, 0x02, 49},
+		},
+		{
+			alert:    v1beta.TLSAlertHandshakeFailure,
+			expected: []byte{0x15, 0x03, 0x03, 0x00, 0x02, 0x02, 40},
+		},
+		{
+			alert:    v1beta.TLSAlertUnrecognizedName,
+			expected: []byte{0x15, 0x03, 0x03, 0x00, 0x02, 0x02, 112},
+		},
+		{
+			expected: []byte{0x15, 0x03, 0x03, 0x00, 0x02, 0x02, 49},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(string(tc.alert), func(t *testing.T) {
+			assert.Equal(t, tc.expected, TLSDenyResponse(&v1beta.TLSDenyResponse{Alert: tc.alert}))
+		})
+	}
+}
@@ -0,0 +1,396 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package l7engine
+
+import (
+	"fmt"
+	"net/netip"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/clock"
+
+	"antrea.io/antrea/pkg/agent/config"
+	v1beta "antrea.io/antrea/pkg/apis/controlplane/v1beta2"
+)
+
+const (
+	// nativeMaxRequestLen is the maximum length of the payload buffered to parse a request of a connection. The
+	// connection is denied if the request can't be parsed from the buffered payload.
+	nativeMaxRequestLen = 64 * 1024
+	// nativeMaxFrameLen is the maximum length of the frames read from the L7 redirect target port.
+	nativeMaxFrameLen = 64 * 1024
+	// nativeMaxFlows is the maximum number of connections tracked by the engine. When it's reached, a connection
+	// without allowed requests is evicted to track a new connection, or the new connection is dropped if there is none.
+	nativeMaxFlows = 64 * 1024
+
+	nativeFlowIdleTimeout = 5 * time.Minute
+	// nativePendingFlowIdleTimeout is the idle timeout of the connections without allowed requests, which is shorter
+	// to limit the connections tracked for SYN floods.
+	nativePendingFlowIdleTimeout = 30 * time.Second
+	nativeFlowGCInterval         = 10 * time.Second
+)
+
+type nativeFlowVerdict int
+
+const (
+	// nativeFlowPending means no request of the connection has been allowed.
+	nativeFlowPending nativeFlowVerdict = iota
+	// nativeFlowInspecting means a request of the connection has been allowed, and the subsequent requests are
+	// inspected one by one.
+	nativeFlowInspecting
+	// nativeFlowAllowed means the connection is forwarded without being inspected, e.g. after the TLS ClientHello.
+	nativeFlowAllowed
+	nativeFlowDenied
+)
+
+// frameConn sends and receives the Ethernet frames on an L7 redirect port.
+type frameConn interface {
+	// readFrame reads an Ethernet frame into buf. The returned frame has no VLAN tag, and the VLAN ID it was tagged
+	// with is returned separately.
+	readFrame(buf []byte) ([]byte, uint32, error)
+	writeFrame(frame []byte) error
+	close() error
+}
+
+type nativeFlowKey struct {
+	vlanID     uint32
+	clientIP   netip.Addr
+	serverIP   netip.Addr
+	clientPort uint16
+	serverPort uint16
+}
+
+type nativeFlow struct {
+	verdict nativeFlowVerdict
+	// nextSeq is the sequence number of the next segment expected from the client.
+	nextSeq uint32
+	// request is the payload of the request being parsed.
+	request []byte
+	// body tracks the body of the last allowed HTTP request, which is forwarded without being buffered.
+	body httpBody
+	// heldFrames are the frames held until the request being parsed is allowed, and heldSeq is the sequence number of
+	// the first byte they carry.
+	heldFrames [][]byte
+	heldSeq    uint32
+	lastSeen   time.Time
+}
+
+// NativeEngine is the L7 engine which enforces L7 rules in antrea-agent. It holds the segments sent by the client of a
+// connection until a request is parsed, then forwards them if the request is allowed by the L7 rule, or denies the
+// connection otherwise. Every HTTP request sent over a connection is inspected, while a TLS connection is forwarded as
+// a whole once its ClientHello is allowed. Only HTTP and TLS are supported.
+type NativeEngine struct {
+	eventDispatcher
+
+	// Declared as member variables for testing.
+	openFrameConnFn func(ifaceName string) (frameConn, error)
+	clock           clock.Clock
+	maxFlows        int
+
+	rulesMutex sync.RWMutex
+	// rules maps VLAN IDs to the L7 rules enforced on the packets tagged with them.
+	rules map[uint32]*nativeRule
+
+	flowsMutex sync.Mutex
+	flows      map[nativeFlowKey]*nativeFlow
+
+	returnConn frameConn
+
+	once sync.Once
+}
+
+func NewNativeEngine() *NativeEngine {
+	return &NativeEngine{
+		openFrameConnFn: openFrameConn,
+		clock:           clock.RealClock{},
+		maxFlows:        nativeMaxFlows,
+		rules:           make(map[uint32]*nativeRule),
+		flows:           make(map[nativeFlowKey]*nativeFlow),
+	}
+}
+
+// Start opens the L7 redirect ports and starts enforcing the L7 rules on the frames received from OVS. It is safe to
+// be called multiple times.
+func (e *NativeEngine) Start() {
+	e.once.Do(func() {
+		targetConn, err := e.openFrameConnFn(config.L7RedirectTargetPortName)
+		if err != nil {
+			klog.ErrorS(err, "Failed to open L7 redirect target port", "port", config.L7RedirectTargetPortName)
+			return
+		}
+		returnConn, err := e.openFrameConnFn(config.L7RedirectReturnPortName)
+		if err != nil {
+			targetConn.close()
+			klog.ErrorS(err, "Failed to open L7 redirect return port", "port", config.L7RedirectReturnPortName)
+			return
+		}
+		e.returnConn = returnConn
+		go wait.Forever(func() {
+			e.receiveFrames(targetConn)
+		}, time.Second)
+		go wait.Forever(e.gcFlows, nativeFlowGCInterval)
+		klog.InfoS("Started native L7 engine successfully")
+	})
+}
+
+// AddRule compiles the L7 rule enforced on the packets tagged with the VLAN ID. The engine reports all the requests
+// with events, hence enableLogging is not used.
+func (e *NativeEngine) AddRule(ruleID, policyName string, vlanID uint32, l7Protocols []v1beta.L7Protocol, l7DenyResponse *v1beta.L7DenyResponse, enableLogging bool) error {
+	e.Start()
+
+	klog.InfoS("Reconciling L7 rule", "RuleID", ruleID, "PolicyName", policyName)
+	rule, err := compileNativeRule(policyName, l7Protocols, l7DenyResponse)
+	if err != nil {
+		return fmt.Errorf("failed to compile L7 rule %s of %s: %w", ruleID, policyName, err)
+	}
+	e.rulesMutex.Lock()
+	defer e.rulesMutex.Unlock()
+	e.rules[vlanID] = rule
+	return nil
+}
+
+func (e *NativeEngine) DeleteRule(ruleID string, vlanID uint32) error {
+	e.rulesMutex.Lock()
+	delete(e.rules, vlanID)
+	e.rulesMutex.Unlock()
+
+	e.flowsMutex.Lock()
+	defer e.flowsMutex.Unlock()
+	for key := range e.flows {
+		if key.vlanID == vlanID {
+			delete(e.flows, key)
+		}
+	}
+	return nil
+}
+
+func (e *NativeEngine) receiveFrames(conn frameConn) {
+	buf := make([]byte, nativeMaxFrameLen)
+	for {
+		frame, vlanID, err := conn.readFrame(buf)
+		if err != nil {
+			klog.ErrorS(err, "Failed to read frame from L7 redirect target port")
+			return
+		}
+		// The frame may be held until the verdict of the connection, hence it is copied out of the buffer.
+		e.processFrame(append([]byte(nil), frame...), vlanID)
+	}
+}
+
+// processFrame enforces the L7 rule on the frame, and sends the frames to forward back to OVS via the return port.
+func (e *NativeEngine) processFrame(frame []byte, vlanID uint32) {
+	frames, events := e.handleFrame(frame, vlanID)
+	for _, f := range frames {
+		if err := e.returnConn.writeFrame(tagFrame(f, vlanID)); err != nil {
+			klog.ErrorS(err, "Failed to write frame to L7 redirect return port")
+		}
+	}
+	for _, event := range events {
+		e.dispatch(event)
+	}
+}
+
+// handleFrame returns the frames to forward for the frame tagged with the VLAN ID, and the events of the requests
+// completed by the frame.
+func (e *NativeEngine) handleFrame(frame []byte, vlanID uint32) ([][]byte, []*Event) {
+	e.rulesMutex.RLock()
+	rule, exists := e.rules[vlanID]
+	e.rulesMutex.RUnlock()
+	// Like the default tenant of Suricata, the frames tagged with no L7 rule are forwarded.
+	if !exists {
+		return [][]byte{frame}, nil
+	}
+	segment, err := parseTCPFrame(frame)
+	if err != nil {
+		// Only the requests carried by TCP are supported, the other frames are dropped.
+		klog.V(4).InfoS("Dropped frame not carrying a TCP segment", "vlanID", vlanID, "err", err)
+		return nil, nil
+	}
+
+	e.flowsMutex.Lock()
+	defer e.flowsMutex.Unlock()
+	now := e.clock.Now()
+	key := nativeFlowKey{vlanID: vlanID, clientIP: segment.srcIP, serverIP: segment.dstIP, clientPort: segment.srcPort, serverPort: segment.dstPort}
+	flow, fromClient := e.flows[key], true
+	if flow == nil {
+		reverseKey := nativeFlowKey{vlanID: vlanID, clientIP: segment.dstIP, serverIP: segment.srcIP, clientPort: segment.dstPort, serverPort: segment.srcPort}
+		flow, fromClient = e.flows[reverseKey], false
+	}
+	if flow == nil {
+		if len(e.flows) >= e.maxFlows && !e.evictFlow() {
+			klog.V(2).InfoS("Dropped frame as too many connections are tracked", "vlanID", vlanID, "client", netip.AddrPortFrom(segment.srcIP, segment.srcPort))
+			return nil, nil
+		}
+		// The sender of the first segment seen by the engine, which is the SYN unless the connection was established
+		// before the L7 rule was added, is considered as the client.
+		flow, fromClient = &nativeFlow{nextSeq: segment.seqNum}, true
+		if segment.flags&tcpFlagSYN != 0 {
+			flow.nextSeq++
+		}
+		e.flows[key] = flow
+	}
+	flow.lastSeen = now
+
+	switch flow.verdict {
+	case nativeFlowAllowed:
+		return [][]byte{frame}, nil
+	case nativeFlowDenied:
+		return nil, nil
+	}
+	// The handshake and the acknowledgements are forwarded before the verdict.
+	if !fromClient || len(segment.payload) == 0 {
+		return [][]byte{frame}, nil
+	}
+	// Retransmissions of the held segments and out-of-order segments are dropped, the client will retransmit them.
+	if segment.seqNum != flow.nextSeq {
+		return nil, nil
+	}
+	if len(flow.heldFrames) == 0 {
+		flow.heldSeq = segment.seqNum
+	}
+	flow.heldFrames = append(flow.heldFrames, frame)
+	flow.nextSeq += uint32(len(segment.payload))
+
+	var events []*Event
+	newEvent := func(request *l7Request) *Event {
+		return &Event{
+			Timestamp: now,
+			VlanID:    vlanID,
+			SrcIP:     segment.srcIP,
+			SrcPort:   segment.srcPort,
+			DestIP:    segment.dstIP,
+			DestPort:  segment.dstPort,
+			Proto:     "TCP",
+			AppProto:  request.appProto,
+			Request:   request.summary(),
+		}
+	}
+	// The payload may carry the body of the last allowed request, and one or more subsequent requests.
+	for payload := segment.payload; len(payload) > 0; {
+		if flow.body.inProgress() {
+			n, err := flow.body.consume(payload)
+			if err != nil {
+				klog.V(2).InfoS("Failed to parse HTTP request body", "vlanID", vlanID, "client", netip.AddrPortFrom(segment.srcIP, segment.srcPort), "err", err)
+				return e.denyFlow(flow, frame, segment, rule, append(events, newEvent(&l7Request{})))
+			}
+			payload = payload[n:]
+			continue
+		}
+		flow.request = append(flow.request, payload...)
+		payload = nil
+		request, err := parseRequest(flow.request)
+		if err != nil {
+			klog.V(2).InfoS("Failed to parse L7 request", "vlanID", vlanID, "client", netip.AddrPortFrom(segment.srcIP, segment.srcPort), "err", err)
+			request = &l7Request{}
+		} else if request == nil {
+			if len(flow.request) < nativeMaxRequestLen {
+				break
+			}
+			request = &l7Request{}
+		}
+		event := newEvent(request)
+		if !rule.allows(request) {
+			return e.denyFlow(flow, frame, segment, rule, append(events, event))
+		}
+		event.Allowed = true
+		events = append(events, event)
+		if request.appProto != protocolHTTP {
+			// The payload following the TLS ClientHello is encrypted, hence the connection is forwarded as a whole.
+			flow.verdict = nativeFlowAllowed
+			flow.request = nil
+			break
+		}
+		flow.verdict = nativeFlowInspecting
+		payload = flow.request[request.headerLen:]
+		flow.request = nil
+		flow.body.reset(request.contentLength)
+	}
+	// The held frames are forwarded once all the requests they carry are allowed.
+	if len(flow.request) > 0 {
+		return nil, events
+	}
+	heldFrames := flow.heldFrames
+	flow.heldFrames = nil
+	return heldFrames, events
+}
+
+// denyFlow denies the connection of the flow after a request is denied by the rule. The last event is the one of the
+// denied request.
+func (e *NativeEngine) denyFlow(flow *nativeFlow, frame []byte, segment *tcpSegment, rule *nativeRule, events []*Event) ([][]byte, []*Event) {
+	flow.verdict = nativeFlowDenied
+	flow.request, flow.heldFrames = nil, nil
+	event := events[len(events)-1]
+	event.Packet = frame
+	// The deny response is sent by the event handlers.
+	if rule.hasDenyResponse(event.AppProto) {
+		return nil, events
+	}
+	// Otherwise, reset the connection on both sides like the reject rules of Suricata.
+	resetToClient := buildTCPFrame(&tcpSegment{
+		srcMAC:  segment.dstMAC,
+		dstMAC:  segment.srcMAC,
+		srcIP:   segment.dstIP,
+		dstIP:   segment.srcIP,
+		srcPort: segment.dstPort,
+		dstPort: segment.srcPort,
+		seqNum:  segment.ackNum,
+		ackNum:  flow.nextSeq,
+		flags:   tcpFlagRST | tcpFlagACK,
+	})
+	resetToServer := buildTCPFrame(&tcpSegment{
+		srcMAC:  segment.srcMAC,
+		dstMAC:  segment.dstMAC,
+		srcIP:   segment.srcIP,
+		dstIP:   segment.dstIP,
+		srcPort: segment.srcPort,
+		dstPort: segment.dstPort,
+		seqNum:  flow.heldSeq,
+		flags:   tcpFlagRST,
+	})
+	return [][]byte{resetToClient, resetToServer}, events
+}
+
+// evictFlow deletes a flow without allowed requests to make room for a new flow, and returns false if there is none.
+// The flow is picked in the randomized map iteration order, so the flows created by a SYN flood can't keep the other
+// connections from being tracked.
+func (e *NativeEngine) evictFlow() bool {
+	for key, flow := range e.flows {
+		if flow.verdict == nativeFlowPending || flow.verdict == nativeFlowDenied {
+			delete(e.flows, key)
+			return true
+		}
+	}
+	return false
+}
+
+// gcFlows deletes the flows which have been idle for nativeFlowIdleTimeout, or nativePendingFlowIdleTimeout if they
+// have no allowed requests.
+func (e *NativeEngine) gcFlows() {
+	e.flowsMutex.Lock()
+	defer e.flowsMutex.Unlock()
+	now := e.clock.Now()
+	for key, flow := range e.flows {
+		idleTimeout := nativeFlowIdleTimeout
+		if flow.verdict == nativeFlowPending || flow.verdict == nativeFlowDenied {
+			idleTimeout = nativePendingFlowIdleTimeout
+		}
+		if now.Sub(flow.lastSeen) > idleTimeout {
+			delete(e.flows, key)
+		}
+	}
+}
@@ -0,0 +1,103 @@
+//go:build linux
+// +build linux
+
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package l7engine
+
+import (
+	"fmt"
+	"net"
+	"unsafe"
+
+	"golang.org/x/sys/unix"
+)
+
+// afPacketConn is a frameConn backed by an AF_PACKET socket bound to an interface.
+// sizeofTpacketAuxdata is the size of struct tpacket_auxdata.
+const sizeofTpacketAuxdata = int(unsafe.Sizeof(unix.TpacketAuxdata{}))
+
+type afPacketConn struct {
+	fd  int
+	oob []byte
+}
+
+func htons(v uint16) uint16 {
+	return v<<8 | v>>8
+}
+
+func openFrameConn(ifaceName string) (frameConn, error) {
+	iface, err := net.InterfaceByName(ifaceName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get interface %s: %w", ifaceName, err)
+	}
+	fd, err := unix.Socket(unix.AF_PACKET, unix.SOCK_RAW, int(htons(unix.ETH_P_ALL)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create AF_PACKET socket: %w", err)
+	}
+	// The kernel strips the VLAN tag of the received frames, which is reported in the auxiliary data.
+	if err := unix.SetsockoptInt(fd, unix.SOL_PACKET, unix.PACKET_AUXDATA, 1); err != nil {
+		unix.Close(fd)
+		return nil, fmt.Errorf("failed to enable PACKET_AUXDATA: %w", err)
+	}
+	if err := unix.Bind(fd, &unix.SockaddrLinklayer{Protocol: htons(unix.ETH_P_ALL), Ifindex: iface.Index}); err != nil {
+		unix.Close(fd)
+		return nil, fmt.Errorf("failed to bind AF_PACKET socket to interface %s: %w", ifaceName, err)
+	}
+	return &afPacketConn{
+		fd:  fd,
+		oob: make([]byte, unix.CmsgSpace(sizeofTpacketAuxdata)),
+	}, nil
+}
+
+func (c *afPacketConn) readFrame(buf []byte) ([]byte, uint32, error) {
+	for {
+		n, oobn, _, from, err := unix.Recvmsg(c.fd, buf, c.oob, 0)
+		if err != nil {
+			return nil, 0, err
+		}
+		// Ignore the frames sent by the interface.
+		if sa, ok := from.(*unix.SockaddrLinklayer); ok && sa.Pkttype == unix.PACKET_OUTGOING {
+			continue
+		}
+		frame, vlanID, tagged := untagFrame(buf[:n])
+		if tagged {
+			return frame, vlanID, nil
+		}
+		msgs, err := unix.ParseSocketControlMessage(c.oob[:oobn])
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to parse socket control messages: %w", err)
+		}
+		for _, msg := range msgs {
+			if msg.Header.Level != unix.SOL_PACKET || msg.Header.Type != unix.PACKET_AUXDATA || len(msg.Data) < sizeofTpacketAuxdata {
+				continue
+			}
+			auxdata := (*unix.TpacketAuxdata)(unsafe.Pointer(&msg.Data[0]))
+			if auxdata.Status&unix.TP_STATUS_VLAN_VALID != 0 {
+				vlanID = uint32(auxdata.Vlan_tci & 0x0fff)
+			}
+		}
+		return frame, vlanID, nil
+	}
+}
+
+func (c *afPacketConn) writeFrame(frame []byte) error {
+	_, err := unix.Write(c.fd, frame)
+	return err
+}
+
+func (c *afPacketConn) close() error {
+	return unix.Close(c.fd)
+}
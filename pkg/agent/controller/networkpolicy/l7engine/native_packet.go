@@ -0,0 +1,207 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package l7engine
+
+import (
+	"encoding/binary"
+	"fmt"
+	"net"
+	"net/netip"
+)
+
+const (
+	etherTypeIPv4  uint16 = 0x0800
+	etherTypeIPv6  uint16 = 0x86dd
+	etherTypeDot1Q uint16 = 0x8100
+
+	ipProtocolTCP uint8 = 6
+
+	ethernetHeaderLen = 14
+	vlanTagLen        = 4
+	ipv4HeaderLen     = 20
+	ipv6HeaderLen     = 40
+	tcpHeaderLen      = 20
+
+	tcpFlagFIN uint8 = 0b000001
+	tcpFlagSYN uint8 = 0b000010
+	tcpFlagRST uint8 = 0b000100
+	tcpFlagPSH uint8 = 0b001000
+	tcpFlagACK uint8 = 0b010000
+)
+
+// tcpSegment is a TCP segment parsed from an Ethernet frame without VLAN tag.
+type tcpSegment struct {
+	srcMAC  net.HardwareAddr
+	dstMAC  net.HardwareAddr
+	srcIP   netip.Addr
+	dstIP   netip.Addr
+	srcPort uint16
+	dstPort uint16
+	seqNum  uint32
+	ackNum  uint32
+	flags   uint8
+	payload []byte
+}
+
+// untagFrame removes the VLAN tag from the Ethernet frame if it has one, and returns the VLAN ID in the tag.
+func untagFrame(frame []byte) ([]byte, uint32, bool) {
+	if len(frame) < ethernetHeaderLen+vlanTagLen || binary.BigEndian.Uint16(frame[12:14]) != etherTypeDot1Q {
+		return frame, 0, false
+	}
+	vlanID := uint32(binary.BigEndian.Uint16(frame[14:16]) & 0x0fff)
+	untagged := make([]byte, 0, len(frame)-vlanTagLen)
+	untagged = append(untagged, frame[:12]...)
+	untagged = append(untagged, frame[12+vlanTagLen:]...)
+	return untagged, vlanID, true
+}
+
+// tagFrame inserts a VLAN tag with the VLAN ID into the Ethernet frame without VLAN tag.
+func tagFrame(frame []byte, vlanID uint32) []byte {
+	tagged := make([]byte, 0, len(frame)+vlanTagLen)
+	tagged = append(tagged, frame[:12]...)
+	tagged = binary.BigEndian.AppendUint16(tagged, etherTypeDot1Q)
+	tagged = binary.BigEndian.AppendUint16(tagged, uint16(vlanID&0x0fff))
+	return append(tagged, frame[12:]...)
+}
+
+// parseTCPFrame parses the TCP segment carried by the Ethernet frame without VLAN tag. An error is returned if the
+// frame doesn't carry a TCP segment.
+func parseTCPFrame(frame []byte) (*tcpSegment, error) {
+	if len(frame) < ethernetHeaderLen {
+		return nil, fmt.Errorf("frame is too short")
+	}
+	segment := &tcpSegment{
+		dstMAC: net.HardwareAddr(frame[0:6]),
+		srcMAC: net.HardwareAddr(frame[6:12]),
+	}
+	var l4 []byte
+	packet := frame[ethernetHeaderLen:]
+	switch etherType := binary.BigEndian.Uint16(frame[12:14]); etherType {
+	case etherTypeIPv4:
+		if len(packet) < ipv4HeaderLen {
+			return nil, fmt.Errorf("IPv4 packet is too short")
+		}
+		headerLen := int(packet[0]&0x0f) * 4
+		totalLen := int(binary.BigEndian.Uint16(packet[2:4]))
+		if headerLen < ipv4HeaderLen || totalLen < headerLen || totalLen > len(packet) {
+			return nil, fmt.Errorf("invalid IPv4 header")
+		}
+		if packet[9] != ipProtocolTCP {
+			return nil, fmt.Errorf("IPv4 packet doesn't carry TCP")
+		}
+		segment.srcIP = netip.AddrFrom4([4]byte(packet[12:16]))
+		segment.dstIP = netip.AddrFrom4([4]byte(packet[16:20]))
+		l4 = packet[headerLen:totalLen]
+	case etherTypeIPv6:
+		if len(packet) < ipv6HeaderLen {
+			return nil, fmt.Errorf("IPv6 packet is too short")
+		}
+		payloadLen := int(binary.BigEndian.Uint16(packet[4:6]))
+		if ipv6HeaderLen+payloadLen > len(packet) {
+			return nil, fmt.Errorf("invalid IPv6 header")
+		}
+		// IPv6 extension headers are not supported.
+		if packet[6] != ipProtocolTCP {
+			return nil, fmt.Errorf("IPv6 packet doesn't carry TCP")
+		}
+		segment.srcIP = netip.AddrFrom16([16]byte(packet[8:24]))
+		segment.dstIP = netip.AddrFrom16([16]byte(packet[24:40]))
+		l4 = packet[ipv6HeaderLen : ipv6HeaderLen+payloadLen]
+	default:
+		return nil, fmt.Errorf("unsupported EtherType 0x%04x", etherType)
+	}
+	if len(l4) < tcpHeaderLen {
+		return nil, fmt.Errorf("TCP segment is too short")
+	}
+	dataOffset := int(l4[12]>>4) * 4
+	if dataOffset < tcpHeaderLen || dataOffset > len(l4) {
+		return nil, fmt.Errorf("invalid TCP header")
+	}
+	segment.srcPort = binary.BigEndian.Uint16(l4[0:2])
+	segment.dstPort = binary.BigEndian.Uint16(l4[2:4])
+	segment.seqNum = binary.BigEndian.Uint32(l4[4:8])
+	segment.ackNum = binary.BigEndian.Uint32(l4[8:12])
+	segment.flags = l4[13] & 0x3f
+	segment.payload = l4[dataOffset:]
+	return segment, nil
+}
+
+// buildTCPFrame builds an Ethernet frame without VLAN tag carrying the TCP segment.
+func buildTCPFrame(segment *tcpSegment) []byte {
+	tcp := make([]byte, 0, tcpHeaderLen+len(segment.payload))
+	tcp = binary.BigEndian.AppendUint16(tcp, segment.srcPort)
+	tcp = binary.BigEndian.AppendUint16(tcp, segment.dstPort)
+	tcp = binary.BigEndian.AppendUint32(tcp, segment.seqNum)
+	tcp = binary.BigEndian.AppendUint32(tcp, segment.ackNum)
+	tcp = append(tcp, (tcpHeaderLen/4)<<4, segment.flags, 0xff, 0xff, 0, 0, 0, 0)
+	tcp = append(tcp, segment.payload...)
+
+	// The pseudo header used to calculate the TCP checksum.
+	pseudoHeader := append(segment.srcIP.AsSlice(), segment.dstIP.AsSlice()...)
+	if segment.srcIP.Is4() {
+		pseudoHeader = append(pseudoHeader, 0, ipProtocolTCP)
+		pseudoHeader = binary.BigEndian.AppendUint16(pseudoHeader, uint16(len(tcp)))
+	} else {
+		pseudoHeader = binary.BigEndian.AppendUint32(pseudoHeader, uint32(len(tcp)))
+		pseudoHeader = append(pseudoHeader, 0, 0, 0, ipProtocolTCP)
+	}
+	binary.BigEndian.PutUint16(tcp[16:18], checksum(pseudoHeader, tcp))
+
+	frame := make([]byte, 0, ethernetHeaderLen+ipv6HeaderLen+len(tcp))
+	frame = append(frame, segment.dstMAC...)
+	frame = append(frame, segment.srcMAC...)
+	if segment.srcIP.Is4() {
+		frame = binary.BigEndian.AppendUint16(frame, etherTypeIPv4)
+		ip := []byte{0x45, 0}
+		ip = binary.BigEndian.AppendUint16(ip, uint16(ipv4HeaderLen+len(tcp)))
+		ip = append(ip, 0, 0, 0x40, 0, 64, ipProtocolTCP, 0, 0)
+		ip = append(ip, segment.srcIP.AsSlice()...)
+		ip = append(ip, segment.dstIP.AsSlice()...)
+		binary.BigEndian.PutUint16(ip[10:12], checksum(ip))
+		frame = append(frame, ip...)
+	} else {
+		frame = binary.BigEndian.AppendUint16(frame, etherTypeIPv6)
+		frame = append(frame, 0x60, 0, 0, 0)
+		frame = binary.BigEndian.AppendUint16(frame, uint16(len(tcp)))
+		frame = append(frame, ipProtocolTCP, 64)
+		frame = append(frame, segment.srcIP.AsSlice()...)
+		frame = append(frame, segment.dstIP.AsSlice()...)
+	}
+	return append(frame, tcp...)
+}
+
+// checksum calculates the Internet checksum of the concatenated data.
+func checksum(data ...[]byte) uint16 {
+	var sum uint32
+	var odd bool
+	var prev byte
+	for _, d := range data {
+		for _, b := range d {
+			if odd {
+				sum += uint32(prev)<<8 | uint32(b)
+			} else {
+				prev = b
+			}
+			odd = !odd
+		}
+	}
+	if odd {
+		sum += uint32(prev) << 8
+	}
+	for sum>>16 != 0 {
+		sum = sum&0xffff + sum>>16
+	}
+	return ^uint16(sum)
+}
@@ -0,0 +1,536 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package l7engine
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"net"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+
+	v1beta "antrea.io/antrea/pkg/apis/controlplane/v1beta2"
+)
+
+const (
+	tlsRecordTypeHandshake      uint8  = 22
+	tlsHandshakeTypeClientHello uint8  = 1
+	tlsExtensionServerName      uint16 = 0
+	tlsServerNameTypeHostName   uint8  = 0
+
+	// httpMaxMethodLen is the maximum length of the HTTP methods recognized by the native engine.
+	httpMaxMethodLen = 16
+)
+
+// nativeRule is an L7 rule compiled by the native engine. A request is allowed if it matches any of the matchers of
+// its protocol.
+type nativeRule struct {
+	policyName   string
+	http         []*httpMatcher
+	tls          []*tlsMatcher
+	denyResponse *v1beta.L7DenyResponse
+}
+
+type httpMatcher struct {
+	// matchPath matches the request URI, which may include a query string after the path.
+	matchPath   func(uri string) bool
+	method      string
+	host        string
+	headers     []*regexp.Regexp
+	queryParams []*regexp.Regexp
+}
+
+type tlsMatcher struct {
+	sni string
+}
+
+// l7Request is a layer 7 request parsed from the payload sent by a client.
+type l7Request struct {
+	appProto string
+	method   string
+	uri      string
+	host     string
+	// header is the header section of an HTTP request, one header per line.
+	header string
+	// headerLen is the length of an HTTP request before its body, including the request line and the empty line
+	// ending the header section.
+	headerLen int
+	// contentLength is the length of the body of an HTTP request, or -1 if the body is chunked.
+	contentLength int64
+	sni           string
+}
+
+func (r *l7Request) summary() string {
+	switch r.appProto {
+	case protocolHTTP:
+		return fmt.Sprintf("%s %s%s", r.method, r.host, r.uri)
+	case protocolTLS:
+		return r.sni
+	}
+	return ""
+}
+
+func compileNativeRule(policyName string, l7Protocols []v1beta.L7Protocol, l7DenyResponse *v1beta.L7DenyResponse) (*nativeRule, error) {
+	rule := &nativeRule{
+		policyName:   policyName,
+		denyResponse: l7DenyResponse,
+	}
+	for _, protocol := range l7Protocols {
+		switch {
+		case protocol.HTTP != nil:
+			matcher, err := compileHTTPMatcher(protocol.HTTP)
+			if err != nil {
+				return nil, err
+			}
+			rule.http = append(rule.http, matcher)
+		case protocol.TLS != nil:
+			rule.tls = append(rule.tls, &tlsMatcher{sni: protocol.TLS.SNI})
+		case protocol.GRPC != nil:
+			return nil, fmt.Errorf("protocol %s is not supported by the %s L7 engine", protocolGRPC, EngineNative)
+		case protocol.DNS != nil:
+			return nil, fmt.Errorf("protocol %s is not supported by the %s L7 engine", protocolDNS, EngineNative)
+		case protocol.Kafka != nil:
+			return nil, fmt.Errorf("protocol %s is not supported by the %s L7 engine", protocolKafka, EngineNative)
+		}
+	}
+	return rule, nil
+}
+
+// compileHTTPMatcher compiles the HTTP protocol of an L7 rule with the same semantics as the Suricata keywords
+// generated by convertProtocolHTTP.
+func compileHTTPMatcher(http *v1beta.HTTPProtocol) (*httpMatcher, error) {
+	matcher := &httpMatcher{
+		method: http.Method,
+		host:   http.Host,
+	}
+	if http.Path != "" {
+		path := http.Path
+		switch http.PathMatchType {
+		case v1beta.HTTPMatchTypeExact:
+			re := regexp.MustCompile(fmt.Sprintf(`^%s(?:\?|$)`, regexp.QuoteMeta(path)))
+			matcher.matchPath = re.MatchString
+		case v1beta.HTTPMatchTypePrefix:
+			matcher.matchPath = func(uri string) bool { return strings.HasPrefix(uri, path) }
+		case v1beta.HTTPMatchTypeRegex:
+			re, err := regexp.Compile(fmt.Sprintf(`^(?:%s)(?:\?|$)`, path))
+			if err != nil {
+				return nil, fmt.Errorf("invalid HTTP path regex %q: %w", path, err)
+			}
+			matcher.matchPath = re.MatchString
+		default:
+			matcher.matchPath = func(uri string) bool { return matchWildcard(path, uri) }
+		}
+	}
+	for _, header := range http.Headers {
+		pattern := fmt.Sprintf("(?m)^(?i:%s):", regexp.QuoteMeta(header.Name))
+		if header.Value != "" || header.MatchType == v1beta.HTTPMatchTypeRegex {
+			pattern += `[ \t]*` + compileHTTPValue(header.Value, header.MatchType, `[ \t]*\r?$`)
+		}
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value of HTTP header %s: %w", header.Name, err)
+		}
+		matcher.headers = append(matcher.headers, re)
+	}
+	for _, param := range http.QueryParams {
+		pattern := httpQueryParamStart + regexp.QuoteMeta(param.Name)
+		if param.Value != "" || param.MatchType == v1beta.HTTPMatchTypeRegex {
+			pattern += "=" + compileHTTPValue(param.Value, param.MatchType, "(?:&|$)")
+		} else {
+			pattern += "(?:[=&]|$)"
+		}
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value of HTTP query parameter %s: %w", param.Name, err)
+		}
+		matcher.queryParams = append(matcher.queryParams, re)
+	}
+	return matcher, nil
+}
+
+func compileHTTPValue(value string, matchType v1beta.HTTPMatchType, end string) string {
+	switch matchType {
+	case v1beta.HTTPMatchTypePrefix:
+		return regexp.QuoteMeta(value)
+	case v1beta.HTTPMatchTypeRegex:
+		return fmt.Sprintf("(?:%s)%s", value, end)
+	default:
+		return regexp.QuoteMeta(value) + end
+	}
+}
+
+// matchWildcard matches the value with the same semantics as the content keyword generated by convertContent, i.e. a
+// leading * means suffix match, a trailing * means prefix match, and no * means exact match.
+func matchWildcard(pattern, value string) bool {
+	prefixMatch, suffixMatch := strings.HasSuffix(pattern, "*"), strings.HasPrefix(pattern, "*")
+	pattern = strings.TrimSuffix(strings.TrimPrefix(pattern, "*"), "*")
+	switch {
+	case prefixMatch && suffixMatch:
+		return strings.Contains(value, pattern)
+	case prefixMatch:
+		return strings.HasPrefix(value, pattern)
+	case suffixMatch:
+		return strings.HasSuffix(value, pattern)
+	}
+	return value == pattern
+}
+
+func (m *httpMatcher) match(request *l7Request) bool {
+	if m.matchPath != nil && !m.matchPath(request.uri) {
+		return false
+	}
+	if m.method != "" && m.method != request.method {
+		return false
+	}
+	if m.host != "" && !matchWildcard(m.host, request.host) {
+		return false
+	}
+	for _, re := range m.headers {
+		if !re.MatchString(request.header) {
+			return false
+		}
+	}
+	for _, re := range m.queryParams {
+		if !re.MatchString(request.uri) {
+			return false
+		}
+	}
+	return true
+}
+
+func (m *tlsMatcher) match(request *l7Request) bool {
+	return m.sni == "" || matchWildcard(m.sni, request.sni)
+}
+
+// allows returns whether the request is allowed by the rule.
+func (r *nativeRule) allows(request *l7Request) bool {
+	switch request.appProto {
+	case protocolHTTP:
+		for _, m := range r.http {
+			if m.match(request) {
+				return true
+			}
+		}
+	case protocolTLS:
+		for _, m := range r.tls {
+			if m.match(request) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// hasDenyResponse returns whether a deny response is sent to the clients of the requests of the protocol. The engine
+// drops such requests silently, and the deny response is sent by the handlers of the events.
+func (r *nativeRule) hasDenyResponse(appProto string) bool {
+	if r.denyResponse == nil {
+		return false
+	}
+	switch appProto {
+	case protocolHTTP:
+		return r.denyResponse.HTTP != nil
+	case protocolTLS:
+		return r.denyResponse.TLS != nil
+	}
+	return false
+}
+
+// parseRequest parses the layer 7 request from the payload sent by the client at the beginning of a connection, or
+// after the previous request of the connection. It returns nil if more payload is needed to parse the request, or a
+// request with an empty appProto if the payload doesn't belong to a supported protocol.
+func parseRequest(data []byte) (*l7Request, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+	if data[0] == tlsRecordTypeHandshake {
+		return parseTLSClientHello(data)
+	}
+	methodEnd := bytes.IndexByte(data, ' ')
+	if methodEnd < 0 {
+		if len(data) < httpMaxMethodLen && isHTTPToken(data) {
+			return nil, nil
+		}
+		return &l7Request{}, nil
+	}
+	if methodEnd == 0 || methodEnd > httpMaxMethodLen || !isHTTPToken(data[:methodEnd]) {
+		return &l7Request{}, nil
+	}
+	headerLen := httpHeaderLen(data)
+	if headerLen < 0 {
+		return nil, nil
+	}
+	req, err := http.ReadRequest(bufio.NewReader(bytes.NewReader(data[:headerLen])))
+	if err != nil {
+		return nil, fmt.Errorf("error parsing HTTP request: %w", err)
+	}
+	request := &l7Request{
+		appProto: protocolHTTP,
+		method:   req.Method,
+		uri:      req.RequestURI,
+		// Suricata normalizes the host by lowercasing it and removing the port.
+		host:          strings.ToLower(req.Host),
+		headerLen:     headerLen,
+		contentLength: req.ContentLength,
+	}
+	if host, _, err := net.SplitHostPort(request.host); err == nil {
+		request.host = host
+	}
+	headerEnd := headerLen - 1
+	if data[headerEnd-1] == '\r' {
+		headerEnd--
+	}
+	if requestLineEnd := bytes.IndexByte(data, '\n'); requestLineEnd < headerEnd {
+		request.header = string(data[requestLineEnd+1 : headerEnd])
+	}
+	// The end of the request must be found the same way as the server does, otherwise the subsequent requests of the
+	// connection may be taken as the body, or the other way around. Transfer-Encoding is only honored in HTTP/1.1
+	// requests without Content-Length by Go, and the requests in which it could be honored differently are rejected.
+	if httpTransferEncodingRe.MatchString(request.header) &&
+		(len(req.TransferEncoding) == 0 || httpContentLengthRe.MatchString(request.header)) {
+		return nil, fmt.Errorf("error parsing HTTP request: ambiguous Transfer-Encoding")
+	}
+	return request, nil
+}
+
+var (
+	httpContentLengthRe    = regexp.MustCompile(`(?im)^content-length[ \t]*:`)
+	httpTransferEncodingRe = regexp.MustCompile(`(?im)^transfer-encoding[ \t]*:`)
+)
+
+// httpHeaderLen returns the length of the payload up to the empty line ending the header section of an HTTP request,
+// or -1 if the header section is incomplete. Like most servers, lines ending with a bare LF are accepted.
+func httpHeaderLen(data []byte) int {
+	for i := 0; ; {
+		lineLen := bytes.IndexByte(data[i:], '\n')
+		if lineLen < 0 {
+			return -1
+		}
+		line := data[i : i+lineLen]
+		i += lineLen + 1
+		if len(line) == 0 || (len(line) == 1 && line[0] == '\r') {
+			return i
+		}
+	}
+}
+
+type httpBodyState int
+
+const (
+	httpBodyNone httpBodyState = iota
+	httpBodyFixed
+	httpBodyChunkSize
+	httpBodyChunkData
+	httpBodyChunkDataEnd
+	httpBodyTrailer
+)
+
+// httpMaxChunkLineLen is the maximum length of the chunk size lines and the trailer lines of a chunked body.
+const httpMaxChunkLineLen = 4096
+
+// httpBody finds the end of the body of an HTTP request, so that the body can be forwarded without being buffered and
+// the next request sent over the same connection can be parsed.
+type httpBody struct {
+	state httpBodyState
+	// remaining is the length of the body or the chunk data which hasn't been consumed.
+	remaining int64
+	// line is the incomplete chunk size line or trailer line.
+	line []byte
+}
+
+// reset starts the body of a request with the content length, which is -1 if the body is chunked.
+func (b *httpBody) reset(contentLength int64) {
+	*b = httpBody{}
+	switch {
+	case contentLength < 0:
+		b.state = httpBodyChunkSize
+	case contentLength > 0:
+		b.state, b.remaining = httpBodyFixed, contentLength
+	}
+}
+
+// inProgress returns whether the end of the body hasn't been reached.
+func (b *httpBody) inProgress() bool {
+	return b.state != httpBodyNone
+}
+
+// consume consumes the payload belonging to the body, and returns the number of bytes consumed. The remaining payload
+// belongs to the next request.
+func (b *httpBody) consume(data []byte) (int, error) {
+	n := 0
+	for n < len(data) && b.state != httpBodyNone {
+		if b.state == httpBodyFixed || b.state == httpBodyChunkData {
+			consumed := min(int64(len(data)-n), b.remaining)
+			n += int(consumed)
+			b.remaining -= consumed
+			if b.remaining == 0 {
+				if b.state == httpBodyFixed {
+					b.state = httpBodyNone
+				} else {
+					b.state = httpBodyChunkDataEnd
+				}
+			}
+			continue
+		}
+		lineLen := bytes.IndexByte(data[n:], '\n')
+		if lineLen < 0 {
+			b.line = append(b.line, data[n:]...)
+			if len(b.line) > httpMaxChunkLineLen {
+				return n, fmt.Errorf("chunk line is too long")
+			}
+			return len(data), nil
+		}
+		line := bytes.TrimSuffix(append(b.line, data[n:n+lineLen]...), []byte("\r"))
+		n += lineLen + 1
+		b.line = nil
+		switch b.state {
+		case httpBodyChunkSize:
+			sizeField, _, _ := bytes.Cut(line, []byte(";"))
+			size, err := strconv.ParseUint(string(bytes.TrimRight(sizeField, " \t")), 16, 63)
+			if err != nil {
+				return n, fmt.Errorf("invalid chunk size %q", sizeField)
+			}
+			if size == 0 {
+				b.state = httpBodyTrailer
+			} else {
+				b.state, b.remaining = httpBodyChunkData, int64(size)
+			}
+		case httpBodyChunkDataEnd:
+			if len(line) != 0 {
+				return n, fmt.Errorf("chunk data is not followed by CRLF")
+			}
+			b.state = httpBodyChunkSize
+		case httpBodyTrailer:
+			if len(line) == 0 {
+				b.state = httpBodyNone
+			}
+		}
+	}
+	return n, nil
+}
+
+func isHTTPToken(data []byte) bool {
+	for _, c := range data {
+		if c < 'A' || c > 'Z' {
+			return false
+		}
+	}
+	return true
+}
+
+// parseTLSClientHello parses the SNI from the ClientHello message, which must be carried by the first TLS record.
+func parseTLSClientHello(data []byte) (*l7Request, error) {
+	if len(data) < 5 {
+		return nil, nil
+	}
+	recordLen := int(binary.BigEndian.Uint16(data[3:5]))
+	if len(data) < 5+recordLen {
+		return nil, nil
+	}
+	r := &tlsReader{data: data[5 : 5+recordLen]}
+	if r.uint8() != tlsHandshakeTypeClientHello {
+		return nil, fmt.Errorf("TLS handshake is not a ClientHello")
+	}
+	if handshakeLen := r.uint24(); handshakeLen > len(r.data) {
+		return nil, fmt.Errorf("TLS ClientHello spanning multiple records is not supported")
+	}
+	// Skip the legacy version and the random.
+	r.skip(2 + 32)
+	// Skip the legacy session ID, the cipher suites and the legacy compression methods.
+	r.skip(int(r.uint8()))
+	r.skip(int(r.uint16()))
+	r.skip(int(r.uint8()))
+	request := &l7Request{appProto: protocolTLS}
+	// The extensions are optional.
+	if r.err == nil && len(r.data) == 0 {
+		return request, nil
+	}
+	extensions := &tlsReader{data: r.bytes(int(r.uint16()))}
+	for len(extensions.data) > 0 && extensions.err == nil {
+		extType := extensions.uint16()
+		extData := &tlsReader{data: extensions.bytes(int(extensions.uint16()))}
+		if extType != tlsExtensionServerName {
+			continue
+		}
+		names := &tlsReader{data: extData.bytes(int(extData.uint16()))}
+		for len(names.data) > 0 && names.err == nil {
+			nameType := names.uint8()
+			name := names.bytes(int(names.uint16()))
+			if nameType == tlsServerNameTypeHostName {
+				request.sni = string(name)
+				break
+			}
+		}
+		if err := extData.err; err != nil {
+			return nil, err
+		}
+	}
+	if r.err != nil {
+		return nil, r.err
+	}
+	if extensions.err != nil {
+		return nil, extensions.err
+	}
+	return request, nil
+}
+
+// tlsReader reads the fields of a TLS message. Reading beyond the end of the message sets err.
+type tlsReader struct {
+	data []byte
+	err  error
+}
+
+func (r *tlsReader) bytes(n int) []byte {
+	if r.err != nil {
+		return nil
+	}
+	if n > len(r.data) {
+		r.err = fmt.Errorf("TLS message is truncated")
+		r.data = nil
+		return nil
+	}
+	b := r.data[:n]
+	r.data = r.data[n:]
+	return b
+}
+
+func (r *tlsReader) skip(n int) {
+	r.bytes(n)
+}
+
+func (r *tlsReader) uint8() uint8 {
+	if b := r.bytes(1); b != nil {
+		return b[0]
+	}
+	return 0
+}
+
+func (r *tlsReader) uint16() uint16 {
+	if b := r.bytes(2); b != nil {
+		return binary.BigEndian.Uint16(b)
+	}
+	return 0
+}
+
+func (r *tlsReader) uint24() int {
+	if b := r.bytes(3); b != nil {
+		return int(b[0])<<16 | int(b[1])<<8 | int(b[2])
+	}
+	return 0
+}
@@ -0,0 +1,612 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package l7engine
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	v1beta "antrea.io/antrea/pkg/apis/controlplane/v1beta2"
+)
+
+var (
+	nativeClientMAC, _ = net.ParseMAC("aa:bb:cc:dd:ee:01")
+	nativeServerMAC, _ = net.ParseMAC("aa:bb:cc:dd:ee:02")
+	nativeClientIP     = netip.MustParseAddr("10.10.0.1")
+	nativeServerIP     = netip.MustParseAddr("10.10.0.2")
+)
+
+// newClientHello returns the first TLS record sent by a client connecting to the server name.
+func newClientHello(t *testing.T, serverName string) []byte {
+	clientConn, serverConn := net.Pipe()
+	defer serverConn.Close()
+	go func() {
+		defer clientConn.Close()
+		tls.Client(clientConn, &tls.Config{ServerName: serverName, InsecureSkipVerify: true}).Handshake()
+	}()
+	buf := make([]byte, 4096)
+	n, err := serverConn.Read(buf)
+	require.NoError(t, err)
+	return buf[:n]
+}
+
+func newClientFrame(seqNum, ackNum uint32, flags uint8, payload []byte) []byte {
+	return buildTCPFrame(&tcpSegment{
+		srcMAC:  nativeClientMAC,
+		dstMAC:  nativeServerMAC,
+		srcIP:   nativeClientIP,
+		dstIP:   nativeServerIP,
+		srcPort: 34567,
+		dstPort: 80,
+		seqNum:  seqNum,
+		ackNum:  ackNum,
+		flags:   flags,
+		payload: payload,
+	})
+}
+
+func newServerFrame(seqNum, ackNum uint32, flags uint8) []byte {
+	return buildTCPFrame(&tcpSegment{
+		srcMAC:  nativeServerMAC,
+		dstMAC:  nativeClientMAC,
+		srcIP:   nativeServerIP,
+		dstIP:   nativeClientIP,
+		srcPort: 80,
+		dstPort: 34567,
+		seqNum:  seqNum,
+		ackNum:  ackNum,
+		flags:   flags,
+	})
+}
+
+func TestParseTCPFrame(t *testing.T) {
+	frame := newClientFrame(100, 200, tcpFlagPSH|tcpFlagACK, []byte("foo"))
+	segment, err := parseTCPFrame(frame)
+	require.NoError(t, err)
+	assert.Equal(t, &tcpSegment{
+		srcMAC:  nativeClientMAC,
+		dstMAC:  nativeServerMAC,
+		srcIP:   nativeClientIP,
+		dstIP:   nativeServerIP,
+		srcPort: 34567,
+		dstPort: 80,
+		seqNum:  100,
+		ackNum:  200,
+		flags:   tcpFlagPSH | tcpFlagACK,
+		payload: []byte("foo"),
+	}, segment)
+	// The checksums of a valid packet sum up to zero.
+	assert.Equal(t, uint16(0), checksum(frame[ethernetHeaderLen:ethernetHeaderLen+ipv4HeaderLen]))
+
+	untagged, vlanID, tagged := untagFrame(tagFrame(frame, 10))
+	assert.True(t, tagged)
+	assert.Equal(t, uint32(10), vlanID)
+	assert.Equal(t, frame, untagged)
+
+	_, err = parseTCPFrame(frame[:ethernetHeaderLen+ipv4HeaderLen])
+	assert.Error(t, err)
+}
+
+func TestParseRequest(t *testing.T) {
+	testCases := []struct {
+		name            string
+		data            []byte
+		expectedRequest *l7Request
+		expectedErr     bool
+	}{
+		{
+			name: "incomplete HTTP method",
+			data: []byte("GE"),
+		},
+		{
+			name: "incomplete HTTP header",
+			data: []byte("GET /api HTTP/1.1\r\nHost: foo.bar.com\r\n"),
+		},
+		{
+			name: "HTTP request",
+			data: []byte("GET /api?id=1 HTTP/1.1\r\nHost: Foo.bar.com:8080\r\nX-Version: v2\r\n\r\n"),
+			expectedRequest: &l7Request{
+				appProto:  protocolHTTP,
+				method:    "GET",
+				uri:       "/api?id=1",
+				host:      "foo.bar.com",
+				header:    "Host: Foo.bar.com:8080\r\nX-Version: v2\r\n",
+				headerLen: 65,
+			},
+		},
+		{
+			name: "HTTP request with body and subsequent request",
+			data: []byte("POST /api HTTP/1.1\nHost: foo.bar.com\nContent-Length: 3\n\nfooGET /admin HTTP/1.1\r\n\r\n"),
+			expectedRequest: &l7Request{
+				appProto:      protocolHTTP,
+				method:        "POST",
+				uri:           "/api",
+				host:          "foo.bar.com",
+				header:        "Host: foo.bar.com\nContent-Length: 3\n",
+				headerLen:     56,
+				contentLength: 3,
+			},
+		},
+		{
+			name: "HTTP request with chunked body",
+			data: []byte("POST /api HTTP/1.1\r\nHost: foo.bar.com\r\nTransfer-Encoding: chunked\r\n\r\n3\r\nfoo\r\n0\r\n\r\n"),
+			expectedRequest: &l7Request{
+				appProto:      protocolHTTP,
+				method:        "POST",
+				uri:           "/api",
+				host:          "foo.bar.com",
+				header:        "Host: foo.bar.com\r\nTransfer-Encoding: chunked\r\n",
+				headerLen:     69,
+				contentLength: -1,
+			},
+		},
+		{
+			name:        "HTTP request with Transfer-Encoding and Content-Length",
+			data:        []byte("POST /api HTTP/1.1\r\nTransfer-Encoding: chunked\r\nContent-Length: 3\r\n\r\n"),
+			expectedErr: true,
+		},
+		{
+			name:        "HTTP/1.0 request with Transfer-Encoding",
+			data:        []byte("POST /api HTTP/1.0\r\nTransfer-Encoding: chunked\r\n\r\n"),
+			expectedErr: true,
+		},
+		{
+			name:        "invalid HTTP request",
+			data:        []byte("GET /api\r\n\r\n"),
+			expectedErr: true,
+		},
+		{
+			name:            "unknown protocol",
+			data:            []byte{0, 1, 2, 3},
+			expectedRequest: &l7Request{},
+		},
+		{
+			name: "incomplete TLS record",
+			data: newClientHello(t, "foo.bar.com")[:10],
+		},
+		{
+			name: "TLS ClientHello",
+			data: newClientHello(t, "foo.bar.com"),
+			expectedRequest: &l7Request{
+				appProto: protocolTLS,
+				sni:      "foo.bar.com",
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			request, err := parseRequest(tc.data)
+			if tc.expectedErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedRequest, request)
+		})
+	}
+}
+
+func TestNativeRuleAllows(t *testing.T) {
+	httpRequest := &l7Request{
+		appProto: protocolHTTP,
+		method:   "GET",
+		uri:      "/api/v2?id=1&debug",
+		host:     "www.foo.com",
+		header:   "Host: www.foo.com\r\nX-Version: v2\r\n",
+	}
+	tlsRequest := &l7Request{
+		appProto: protocolTLS,
+		sni:      "www.foo.com",
+	}
+
+	testCases := []struct {
+		name          string
+		l7Protocols   []v1beta.L7Protocol
+		request       *l7Request
+		expectedAllow bool
+	}{
+		{
+			name:          "any HTTP request",
+			l7Protocols:   []v1beta.L7Protocol{{HTTP: &v1beta.HTTPProtocol{}}},
+			request:       httpRequest,
+			expectedAllow: true,
+		},
+		{
+			name:          "HTTP host suffix",
+			l7Protocols:   []v1beta.L7Protocol{{HTTP: &v1beta.HTTPProtocol{Host: "*.foo.com", Method: "GET"}}},
+			request:       httpRequest,
+			expectedAllow: true,
+		},
+		{
+			name:        "HTTP method mismatch",
+			l7Protocols: []v1beta.L7Protocol{{HTTP: &v1beta.HTTPProtocol{Method: "POST"}}},
+			request:     httpRequest,
+		},
+		{
+			name:          "HTTP exact path with query string",
+			l7Protocols:   []v1beta.L7Protocol{{HTTP: &v1beta.HTTPProtocol{Path: "/api/v2", PathMatchType: v1beta.HTTPMatchTypeExact}}},
+			request:       httpRequest,
+			expectedAllow: true,
+		},
+		{
+			name:        "HTTP wildcard path mismatch",
+			l7Protocols: []v1beta.L7Protocol{{HTTP: &v1beta.HTTPProtocol{Path: "/api/v2"}}},
+			request:     httpRequest,
+		},
+		{
+			name:          "HTTP regex path",
+			l7Protocols:   []v1beta.L7Protocol{{HTTP: &v1beta.HTTPProtocol{Path: "/api/v[0-9]+", PathMatchType: v1beta.HTTPMatchTypeRegex}}},
+			request:       httpRequest,
+			expectedAllow: true,
+		},
+		{
+			name: "HTTP headers and query parameters",
+			l7Protocols: []v1beta.L7Protocol{{HTTP: &v1beta.HTTPProtocol{
+				Headers:     []v1beta.HTTPHeaderMatch{{Name: "x-version", Value: "v2"}},
+				QueryParams: []v1beta.HTTPQueryParamMatch{{Name: "id", Value: "1"}, {Name: "debug"}},
+			}}},
+			request:       httpRequest,
+			expectedAllow: true,
+		},
+		{
+			name: "HTTP query parameter with encoded separators",
+			l7Protocols: []v1beta.L7Protocol{{HTTP: &v1beta.HTTPProtocol{
+				QueryParams: []v1beta.HTTPQueryParamMatch{{Name: "tenant", Value: "foo"}},
+			}}},
+			request: &l7Request{appProto: protocolHTTP, method: "GET", uri: "/api?x=%26tenant%3Dfoo&y=1?tenant=foo"},
+		},
+		{
+			name: "HTTP header value mismatch",
+			l7Protocols: []v1beta.L7Protocol{{HTTP: &v1beta.HTTPProtocol{
+				Headers: []v1beta.HTTPHeaderMatch{{Name: "X-Version", Value: "v1"}},
+			}}},
+			request: httpRequest,
+		},
+		{
+			name: "any of the HTTP protocols",
+			l7Protocols: []v1beta.L7Protocol{
+				{HTTP: &v1beta.HTTPProtocol{Method: "POST"}},
+				{HTTP: &v1beta.HTTPProtocol{Host: "www.foo.com"}},
+			},
+			request:       httpRequest,
+			expectedAllow: true,
+		},
+		{
+			name:          "TLS SNI",
+			l7Protocols:   []v1beta.L7Protocol{{TLS: &v1beta.TLSProtocol{SNI: "*.foo.com"}}},
+			request:       tlsRequest,
+			expectedAllow: true,
+		},
+		{
+			name:        "TLS SNI mismatch",
+			l7Protocols: []v1beta.L7Protocol{{TLS: &v1beta.TLSProtocol{SNI: "*.bar.com"}}},
+			request:     tlsRequest,
+		},
+		{
+			name:        "TLS request with HTTP rule",
+			l7Protocols: []v1beta.L7Protocol{{HTTP: &v1beta.HTTPProtocol{}}},
+			request:     tlsRequest,
+		},
+		{
+			name:        "unknown protocol",
+			l7Protocols: []v1beta.L7Protocol{{HTTP: &v1beta.HTTPProtocol{}}, {TLS: &v1beta.TLSProtocol{}}},
+			request:     &l7Request{},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rule, err := compileNativeRule("AntreaNetworkPolicy:test-l7", tc.l7Protocols, nil)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedAllow, rule.allows(tc.request))
+		})
+	}
+}
+
+func TestCompileNativeRuleUnsupportedProtocol(t *testing.T) {
+	for _, protocol := range []v1beta.L7Protocol{
+		{GRPC: &v1beta.GRPCProtocol{}},
+		{DNS: &v1beta.DNSProtocol{}},
+		{Kafka: &v1beta.KafkaProtocol{}},
+	} {
+		_, err := compileNativeRule("AntreaNetworkPolicy:test-l7", []v1beta.L7Protocol{protocol}, nil)
+		assert.Error(t, err)
+	}
+}
+
+func TestNativeEngineHandleFrame(t *testing.T) {
+	vlanID := uint32(1)
+	request := []byte("GET /admin HTTP/1.1\r\nHost: foo.bar.com\r\n\r\n")
+	syn := newClientFrame(999, 0, tcpFlagSYN, nil)
+	synAck := newServerFrame(1999, 1000, tcpFlagSYN|tcpFlagACK)
+	firstSegment := newClientFrame(1000, 2000, tcpFlagPSH|tcpFlagACK, request[:10])
+	secondSegment := newClientFrame(1010, 2000, tcpFlagPSH|tcpFlagACK, request[10:])
+
+	testCases := []struct {
+		name           string
+		l7Protocols    []v1beta.L7Protocol
+		denyResponse   *v1beta.L7DenyResponse
+		expectedFrames [][]byte
+		expectedEvent  *Event
+	}{
+		{
+			name:           "allowed request",
+			l7Protocols:    []v1beta.L7Protocol{{HTTP: &v1beta.HTTPProtocol{Path: "/admin"}}},
+			expectedFrames: [][]byte{firstSegment, secondSegment},
+			expectedEvent: &Event{
+				VlanID:   vlanID,
+				Allowed:  true,
+				SrcIP:    nativeClientIP,
+				SrcPort:  34567,
+				DestIP:   nativeServerIP,
+				DestPort: 80,
+				Proto:    "TCP",
+				AppProto: protocolHTTP,
+				Request:  "GET foo.bar.com/admin",
+			},
+		},
+		{
+			name:        "rejected request",
+			l7Protocols: []v1beta.L7Protocol{{HTTP: &v1beta.HTTPProtocol{Path: "/public"}}},
+			expectedFrames: [][]byte{
+				newServerFrame(2000, 1000+uint32(len(request)), tcpFlagRST|tcpFlagACK),
+				newClientFrame(1000, 0, tcpFlagRST, nil),
+			},
+			expectedEvent: &Event{
+				VlanID:   vlanID,
+				SrcIP:    nativeClientIP,
+				SrcPort:  34567,
+				DestIP:   nativeServerIP,
+				DestPort: 80,
+				Proto:    "TCP",
+				AppProto: protocolHTTP,
+				Request:  "GET foo.bar.com/admin",
+				Packet:   secondSegment,
+			},
+		},
+		{
+			name:         "denied request with deny response",
+			l7Protocols:  []v1beta.L7Protocol{{HTTP: &v1beta.HTTPProtocol{Path: "/public"}}},
+			denyResponse: &v1beta.L7DenyResponse{HTTP: &v1beta.HTTPDenyResponse{Body: "denied"}},
+			expectedEvent: &Event{
+				VlanID:   vlanID,
+				SrcIP:    nativeClientIP,
+				SrcPort:  34567,
+				DestIP:   nativeServerIP,
+				DestPort: 80,
+				Proto:    "TCP",
+				AppProto: protocolHTTP,
+				Request:  "GET foo.bar.com/admin",
+				Packet:   secondSegment,
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			fakeClock := clocktesting.NewFakeClock(time.Now())
+			e := NewNativeEngine()
+			e.clock = fakeClock
+			rule, err := compileNativeRule("AntreaNetworkPolicy:test-l7", tc.l7Protocols, tc.denyResponse)
+			require.NoError(t, err)
+			e.rules[vlanID] = rule
+
+			// The handshake is forwarded.
+			frames, events := e.handleFrame(syn, vlanID)
+			assert.Equal(t, [][]byte{syn}, frames)
+			assert.Empty(t, events)
+			frames, events = e.handleFrame(synAck, vlanID)
+			assert.Equal(t, [][]byte{synAck}, frames)
+			assert.Empty(t, events)
+			// The segments of the request are held until the request is parsed, and the retransmissions are dropped.
+			frames, events = e.handleFrame(firstSegment, vlanID)
+			assert.Empty(t, frames)
+			assert.Empty(t, events)
+			frames, events = e.handleFrame(firstSegment, vlanID)
+			assert.Empty(t, frames)
+			assert.Empty(t, events)
+
+			frames, events = e.handleFrame(secondSegment, vlanID)
+			assert.Equal(t, tc.expectedFrames, frames)
+			tc.expectedEvent.Timestamp = fakeClock.Now()
+			assert.Equal(t, []*Event{tc.expectedEvent}, events)
+
+			// The subsequent frames of the connection are forwarded or dropped according to the verdict.
+			ack := newServerFrame(2000, 1000+uint32(len(request)), tcpFlagACK)
+			frames, _ = e.handleFrame(ack, vlanID)
+			if tc.expectedEvent.Allowed {
+				assert.Equal(t, [][]byte{ack}, frames)
+			} else {
+				assert.Empty(t, frames)
+			}
+
+			// The flows are deleted after being idle.
+			fakeClock.Step(nativeFlowIdleTimeout + time.Second)
+			e.gcFlows()
+			assert.Empty(t, e.flows)
+		})
+	}
+}
+
+func TestNativeEngineHandleFrameWithoutRule(t *testing.T) {
+	e := NewNativeEngine()
+	frame := newClientFrame(1000, 2000, tcpFlagPSH|tcpFlagACK, []byte("foo"))
+	frames, events := e.handleFrame(frame, 1)
+	assert.Equal(t, [][]byte{frame}, frames)
+	assert.Empty(t, events)
+}
+
+func TestNativeEngineHandleSubsequentRequests(t *testing.T) {
+	vlanID := uint32(1)
+	allowedRequest := "GET /allowed HTTP/1.1\r\nHost: foo.bar.com\r\n\r\n"
+	deniedRequest := "DELETE /admin HTTP/1.1\r\nHost: foo.bar.com\r\n\r\n"
+	chunkedRequest := "POST /allowed HTTP/1.1\r\nHost: foo.bar.com\r\nTransfer-Encoding: chunked\r\n\r\n"
+	clientHello := newClientHello(t, "foo.bar.com")
+
+	type step struct {
+		payload []byte
+		// expectedFrames are the indexes of the steps whose frames are forwarded. Resets are expected if it's nil and
+		// events are expected.
+		expectedFrames []int
+		expectedEvents []string
+	}
+	testCases := []struct {
+		name  string
+		steps []step
+	}{
+		{
+			name: "keep-alive requests",
+			steps: []step{
+				{payload: []byte(allowedRequest), expectedFrames: []int{0}, expectedEvents: []string{"allowed GET foo.bar.com/allowed"}},
+				{payload: []byte(deniedRequest), expectedEvents: []string{"denied DELETE foo.bar.com/admin"}},
+			},
+		},
+		{
+			name: "pipelined requests",
+			steps: []step{
+				{payload: []byte(allowedRequest + deniedRequest), expectedEvents: []string{"allowed GET foo.bar.com/allowed", "denied DELETE foo.bar.com/admin"}},
+			},
+		},
+		{
+			name: "request body",
+			steps: []step{
+				{
+					payload:        []byte(fmt.Sprintf("POST /allowed HTTP/1.1\r\nHost: foo.bar.com\r\nContent-Length: %d\r\n\r\n%s", len(deniedRequest), deniedRequest[:10])),
+					expectedFrames: []int{0},
+					expectedEvents: []string{"allowed POST foo.bar.com/allowed"},
+				},
+				{payload: []byte(deniedRequest[10:] + allowedRequest[:10]), expectedFrames: []int{}},
+				{payload: []byte(allowedRequest[10:]), expectedFrames: []int{1, 2}, expectedEvents: []string{"allowed GET foo.bar.com/allowed"}},
+			},
+		},
+		{
+			name: "chunked request body",
+			steps: []step{
+				{payload: []byte(chunkedRequest + "6\r\nDELET"), expectedFrames: []int{0}, expectedEvents: []string{"allowed POST foo.bar.com/allowed"}},
+				{payload: []byte("E\r\n0\r\n\r\n" + deniedRequest), expectedEvents: []string{"denied DELETE foo.bar.com/admin"}},
+			},
+		},
+		{
+			name: "invalid chunked request body",
+			steps: []step{
+				{payload: []byte(chunkedRequest + "5\r\nDELETE /admin\r\n"), expectedEvents: []string{"allowed POST foo.bar.com/allowed", "denied "}},
+			},
+		},
+		{
+			name: "TLS connection",
+			steps: []step{
+				{payload: clientHello, expectedFrames: []int{0}, expectedEvents: []string{"allowed foo.bar.com"}},
+				{payload: []byte(deniedRequest), expectedFrames: []int{1}},
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			e := NewNativeEngine()
+			rule, err := compileNativeRule("AntreaNetworkPolicy:test-l7", []v1beta.L7Protocol{
+				{HTTP: &v1beta.HTTPProtocol{Path: "/allowed"}},
+				{TLS: &v1beta.TLSProtocol{SNI: "foo.bar.com"}},
+			}, nil)
+			require.NoError(t, err)
+			e.rules[vlanID] = rule
+
+			e.handleFrame(newClientFrame(999, 0, tcpFlagSYN, nil), vlanID)
+			seqNum := uint32(1000)
+			var stepFrames [][]byte
+			for _, s := range tc.steps {
+				frame := newClientFrame(seqNum, 2000, tcpFlagPSH|tcpFlagACK, s.payload)
+				seqNum += uint32(len(s.payload))
+				stepFrames = append(stepFrames, frame)
+				frames, events := e.handleFrame(frame, vlanID)
+
+				var eventStrings []string
+				for _, event := range events {
+					verdict := "denied"
+					if event.Allowed {
+						verdict = "allowed"
+					}
+					eventStrings = append(eventStrings, verdict+" "+event.Request)
+				}
+				assert.Equal(t, s.expectedEvents, eventStrings)
+				if s.expectedFrames == nil {
+					require.Len(t, frames, 2)
+					for _, frame := range frames {
+						segment, err := parseTCPFrame(frame)
+						require.NoError(t, err)
+						assert.NotZero(t, segment.flags&tcpFlagRST)
+					}
+					continue
+				}
+				var expectedFrames [][]byte
+				for _, i := range s.expectedFrames {
+					expectedFrames = append(expectedFrames, stepFrames[i])
+				}
+				assert.Equal(t, expectedFrames, frames)
+			}
+		})
+	}
+}
+
+func TestNativeEngineFlowLimit(t *testing.T) {
+	vlanID := uint32(1)
+	newSYN := func(clientPort uint16) []byte {
+		return buildTCPFrame(&tcpSegment{
+			srcMAC:  nativeClientMAC,
+			dstMAC:  nativeServerMAC,
+			srcIP:   nativeClientIP,
+			dstIP:   nativeServerIP,
+			srcPort: clientPort,
+			dstPort: 80,
+			seqNum:  999,
+			flags:   tcpFlagSYN,
+		})
+	}
+	fakeClock := clocktesting.NewFakeClock(time.Now())
+	e := NewNativeEngine()
+	e.clock = fakeClock
+	e.maxFlows = 2
+	rule, err := compileNativeRule("AntreaNetworkPolicy:test-l7", []v1beta.L7Protocol{{HTTP: &v1beta.HTTPProtocol{}}}, nil)
+	require.NoError(t, err)
+	e.rules[vlanID] = rule
+
+	// The connection with an allowed request is not evicted.
+	e.handleFrame(newSYN(34567), vlanID)
+	_, events := e.handleFrame(newClientFrame(1000, 2000, tcpFlagPSH|tcpFlagACK, []byte("GET / HTTP/1.1\r\n\r\n")), vlanID)
+	require.Len(t, events, 1)
+	// The pending connection is evicted to track the new connection.
+	for _, port := range []uint16{10001, 10002, 10003} {
+		syn := newSYN(port)
+		frames, _ := e.handleFrame(syn, vlanID)
+		assert.Equal(t, [][]byte{syn}, frames)
+		assert.Len(t, e.flows, 2)
+	}
+	// The new connection is dropped if no connection can be evicted.
+	e.flows[nativeFlowKey{vlanID: vlanID, clientIP: nativeClientIP, serverIP: nativeServerIP, clientPort: 10003, serverPort: 80}].verdict = nativeFlowInspecting
+	frames, _ := e.handleFrame(newSYN(10004), vlanID)
+	assert.Empty(t, frames)
+	assert.Len(t, e.flows, 2)
+
+	// The pending connections are deleted after a shorter idle timeout.
+	e.flows[nativeFlowKey{vlanID: vlanID, clientIP: nativeClientIP, serverIP: nativeServerIP, clientPort: 10003, serverPort: 80}].verdict = nativeFlowPending
+	fakeClock.Step(nativePendingFlowIdleTimeout + time.Second)
+	e.gcFlows()
+	assert.Len(t, e.flows, 1)
+}
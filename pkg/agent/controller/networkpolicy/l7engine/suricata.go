@@ -0,0 +1,970 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package l7engine
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/spf13/afero"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/klog/v2"
+
+	"antrea.io/antrea/pkg/agent/config"
+	v1beta "antrea.io/antrea/pkg/apis/controlplane/v1beta2"
+)
+
+const (
+	defaultSuricataConfigPath = "/etc/suricata/suricata.yaml"
+	antreaSuricataConfigPath  = "/etc/suricata/antrea.yaml"
+	antreaSuricataLogPath     = "/var/log/antrea/networkpolicy/l7engine/"
+
+	tenantConfigsDir = "/etc/suricata"
+	tenantRulesDir   = "/etc/suricata/rules"
+
+	suricataCommandSocket = "/var/run/suricata/suricata-command.socket"
+	suricataEventSocket   = "/var/run/suricata/antrea-l7engine-event.socket"
+
+	protocolHTTP  = "http"
+	protocolTLS   = "tls"
+	protocolGRPC  = "grpc"
+	protocolDNS   = "dns"
+	protocolKafka = "kafka"
+
+	scCmdOK = "OK"
+)
+
+type scCmdRet struct {
+	Message string `json:"message"`
+	Return  string `json:"return"`
+}
+
+var (
+	// Declared as a variable for testing.
+	defaultFS = afero.NewOsFs()
+
+	// Create the config file /etc/suricata/antrea.yaml for Antrea which will be included in the default Suricata config file
+	// /etc/suricata/suricata.yaml. The first two event logs in the config serve alert logging and http event logging
+	// purposes respectively. The last one reports the requests allowed or blocked by L7 rules to Antrea.
+	suricataAntreaConfigData = fmt.Sprintf(`%%YAML 1.1
+---
+outputs:
+  - eve-log:
+      enabled: yes
+      filetype: regular
+      filename: eve-%%Y-%%m-%%d.json
+      rotate-interval: day
+      pcap-file: false
+      community-id: false
+      community-id-seed: 0
+      xff:
+        enabled: no
+      types:
+        - alert:
+            tagged-packets: yes
+  - eve-log:
+      enabled: yes
+      filetype: unix_stream
+      filename: %[1]s
+      pcap-file: false
+      community-id: false
+      community-id-seed: 0
+      xff:
+        enabled: no
+      types:
+        - http:
+            extended: yes
+  - eve-log:
+      enabled: yes
+      filetype: unix_stream
+      filename: %[4]s
+      pcap-file: false
+      community-id: false
+      community-id-seed: 0
+      xff:
+        enabled: no
+      types:
+        - alert:
+            packet: yes
+            metadata: yes
+        - http
+        - tls
+        - dns:
+            version: 2
+            requests: yes
+            responses: no
+af-packet:
+  - interface: %[2]s
+    threads: auto
+    cluster-id: 80
+    cluster-type: cluster_flow
+    defrag: no
+    use-mmap: yes
+    tpacket-v2: yes
+    checksum-checks: no
+    copy-mode: ips
+    copy-iface: %[3]s
+  - interface:  %[3]s
+    threads: auto
+    cluster-id: 81
+    cluster-type: cluster_flow
+    defrag: no
+    use-mmap: yes
+    tpacket-v2: yes
+    checksum-checks: no
+    copy-mode: ips
+    copy-iface: %[2]s
+multi-detect:
+  enabled: yes
+  selector: vlan
+`, config.L7SuricataSocketPath, config.L7RedirectTargetPortName, config.L7RedirectReturnPortName, suricataEventSocket)
+)
+
+var (
+	// ruleProtocols maps the layer 7 protocols which Suricata has no app-layer parser for, or which
+	// are carried by another app-layer protocol, to the protocol used in the header of Suricata rules.
+	ruleProtocols = map[string]string{
+		protocolGRPC:  "http2",
+		protocolKafka: "tcp",
+	}
+
+	// kafkaAPIKeys maps the names of Kafka API keys to their numeric values in the request header.
+	kafkaAPIKeys = map[string]int{
+		"produce":         0,
+		"fetch":           1,
+		"listOffsets":     2,
+		"metadata":        3,
+		"offsetCommit":    8,
+		"offsetFetch":     9,
+		"findCoordinator": 10,
+		"joinGroup":       11,
+		"heartbeat":       12,
+		"leaveGroup":      13,
+		"syncGroup":       14,
+		"describeGroups":  15,
+		"listGroups":      16,
+		"saslHandshake":   17,
+		"apiVersions":     18,
+		"createTopics":    19,
+		"deleteTopics":    20,
+	}
+
+	// kafkaBootstrapAPIKeys are the API keys of the requests sent by Kafka clients before any other
+	// request on a connection. They are always allowed when a rule allows Kafka requests.
+	kafkaBootstrapAPIKeys = []string{"apiVersions", "metadata"}
+
+	// kafkaTopicRequests maps the names of Kafka API keys to the schemas of the requests carrying
+	// topic names. Requests of the other API keys or versions are not matched by rules with a topic.
+	kafkaTopicRequests = map[string][]kafkaRequestSchema{
+		"produce": {
+			{minVersion: 0, maxVersion: 2, fields: []kafkaField{kafkaFixed(6)}},
+			{minVersion: 3, maxVersion: 8, fields: []kafkaField{kafkaNullableString, kafkaFixed(6)}},
+			{minVersion: 9, maxVersion: 10, flexible: true, fields: []kafkaField{kafkaNullableString, kafkaFixed(6)}},
+		},
+		"fetch": {
+			{minVersion: 0, maxVersion: 2, fields: []kafkaField{kafkaFixed(12)}},
+			{minVersion: 3, maxVersion: 3, fields: []kafkaField{kafkaFixed(16)}},
+			{minVersion: 4, maxVersion: 6, fields: []kafkaField{kafkaFixed(17)}},
+			{minVersion: 7, maxVersion: 11, fields: []kafkaField{kafkaFixed(25)}},
+			{minVersion: 12, maxVersion: 12, flexible: true, fields: []kafkaField{kafkaFixed(25)}},
+		},
+		"listOffsets": {
+			{minVersion: 0, maxVersion: 1, fields: []kafkaField{kafkaFixed(4)}},
+			{minVersion: 2, maxVersion: 5, fields: []kafkaField{kafkaFixed(5)}},
+			{minVersion: 6, maxVersion: 7, flexible: true, fields: []kafkaField{kafkaFixed(5)}},
+		},
+		"offsetCommit": {
+			{minVersion: 0, maxVersion: 0, fields: []kafkaField{kafkaString}},
+			{minVersion: 1, maxVersion: 1, fields: []kafkaField{kafkaString, kafkaFixed(4), kafkaString}},
+			{minVersion: 2, maxVersion: 4, fields: []kafkaField{kafkaString, kafkaFixed(4), kafkaString, kafkaFixed(8)}},
+			{minVersion: 5, maxVersion: 6, fields: []kafkaField{kafkaString, kafkaFixed(4), kafkaString}},
+			{minVersion: 7, maxVersion: 7, fields: []kafkaField{kafkaString, kafkaFixed(4), kafkaString, kafkaNullableString}},
+			{minVersion: 8, maxVersion: 8, flexible: true, fields: []kafkaField{kafkaString, kafkaFixed(4), kafkaString, kafkaNullableString}},
+		},
+		"offsetFetch": {
+			{minVersion: 0, maxVersion: 5, fields: []kafkaField{kafkaString}},
+			{minVersion: 6, maxVersion: 7, flexible: true, fields: []kafkaField{kafkaString}},
+		},
+		"createTopics": {
+			{minVersion: 0, maxVersion: 4},
+			{minVersion: 5, maxVersion: 7, flexible: true},
+		},
+		"deleteTopics": {
+			{minVersion: 0, maxVersion: 3},
+			{minVersion: 4, maxVersion: 5, flexible: true},
+		},
+	}
+)
+
+// kafkaField is a field of a Kafka request body preceding the topics. A positive value is the
+// size of fixed-size fields.
+type kafkaField int
+
+const (
+	kafkaString         kafkaField = -1
+	kafkaNullableString kafkaField = -2
+)
+
+func kafkaFixed(size int) kafkaField {
+	return kafkaField(size)
+}
+
+// kafkaRequestSchema describes the layout of a Kafka request body up to the name of its first
+// topic, which is the first field of the first element of the topic array following the fields.
+type kafkaRequestSchema struct {
+	minVersion int
+	maxVersion int
+	// flexible is true for the versions using the request header v2 and compact strings and arrays.
+	flexible bool
+	fields   []kafkaField
+}
+
+type threadSafeInt32Set struct {
+	sync.RWMutex
+	cached sets.Set[int32]
+}
+
+func (g *threadSafeInt32Set) has(key uint32) bool {
+	g.RLock()
+	defer g.RUnlock()
+	return g.cached.Has(int32(key))
+}
+
+func (g *threadSafeInt32Set) insert(key uint32) {
+	g.Lock()
+	defer g.Unlock()
+	g.cached.Insert(int32(key))
+}
+
+func (g *threadSafeInt32Set) delete(key uint32) {
+	g.Lock()
+	defer g.Unlock()
+	g.cached.Delete(int32(key))
+}
+
+// SuricataEngine is the L7 engine which enforces L7 rules with Suricata running in IPS mode. Each L7 rule is
+// converted into the Suricata rules of a tenant bound to the VLAN ID allocated for the rule.
+type SuricataEngine struct {
+	eventDispatcher
+
+	// Declared as member variables for testing.
+	startSuricataFn func()
+	suricataScFn    func(scCmd string) (*scCmdRet, error)
+	listenEventsFn  func()
+
+	suricataTenantCache        *threadSafeInt32Set
+	suricataTenantHandlerCache *threadSafeInt32Set
+
+	// blockedFlows maps the IDs of the flows blocked by Suricata to the time they were blocked.
+	blockedFlows      map[int64]time.Time
+	blockedFlowsMutex sync.Mutex
+
+	once sync.Once
+}
+
+func NewSuricataEngine() *SuricataEngine {
+	r := &SuricataEngine{
+		suricataScFn:    suricataSc,
+		startSuricataFn: startSuricata,
+		suricataTenantCache: &threadSafeInt32Set{
+			cached: sets.New[int32](),
+		},
+		suricataTenantHandlerCache: &threadSafeInt32Set{
+			cached: sets.New[int32](),
+		},
+		blockedFlows: make(map[int64]time.Time),
+	}
+	r.listenEventsFn = func() {
+		wait.Forever(r.listenEvents, 5*time.Second)
+	}
+	return r
+}
+
+func generateTenantRulesData(policyName string, protoKeywords map[string]sets.Set[string], denyResponse *v1beta.L7DenyResponse, enableLogging bool) *bytes.Buffer {
+	rulesData := bytes.NewBuffer(nil)
+	sid := 1
+
+	// Enable logging of packets in the session that set off the rule, the session is tagged for 30 seconds.
+	// Refer to Suricata detect engine in codebase for detailed tag keyword configuration.
+	var tagKeyword string
+	if enableLogging {
+		tagKeyword = " tag: session, 30, seconds;"
+	}
+
+	// Requests of the protocols with a deny response are dropped silently instead of being rejected with TCP resets,
+	// and Antrea sends the deny response to the client after receiving the alert.
+	var denyResponseProtocols []string
+	if denyResponse != nil {
+		if denyResponse.HTTP != nil {
+			denyResponseProtocols = append(denyResponseProtocols, protocolHTTP)
+		}
+		if denyResponse.TLS != nil {
+			denyResponseProtocols = append(denyResponseProtocols, protocolTLS)
+		}
+	}
+
+	// Generate default reject rule.
+	var appProtoKeywords string
+	for _, proto := range denyResponseProtocols {
+		appProtoKeywords += fmt.Sprintf(" app-layer-protocol: !%s;", proto)
+	}
+	allKeywords := fmt.Sprintf(`msg: "Reject by %s"; flow: to_server, established;%s%s sid: %d;`, policyName, appProtoKeywords, tagKeyword, sid)
+	rule := fmt.Sprintf("reject ip any any -> any any (%s)\n", allKeywords)
+	rulesData.WriteString(rule)
+	sid++
+	for _, proto := range denyResponseProtocols {
+		allKeywords = fmt.Sprintf(`msg: "Reject by %s"; flow: to_server, established;%s sid: %d;`, policyName, tagKeyword, sid)
+		rule = fmt.Sprintf("drop %s any any -> any any (%s)\n", proto, allKeywords)
+		rulesData.WriteString(rule)
+		sid++
+	}
+	// DNS queries are usually carried by UDP, for which the first packet of a flow is not considered
+	// as established by Suricata, hence they need a dedicated reject rule.
+	if _, ok := protoKeywords[protocolDNS]; ok {
+		allKeywords = fmt.Sprintf(`msg: "Reject by %s"; flow: to_server;%s sid: %d;`, policyName, tagKeyword, sid)
+		rule = fmt.Sprintf("reject dns any any -> any any (%s)\n", allKeywords)
+		rulesData.WriteString(rule)
+		sid++
+	}
+
+	// Generate rules.
+	for proto, keywordsSet := range protoKeywords {
+		for _, keywords := range sets.List(keywordsSet) {
+			// It is a convention that the sid is provided as the last keyword (or second-to-last if there is a rev)
+			// of a rule.
+			if keywords != "" {
+				allKeywords = fmt.Sprintf(`msg: "Allow %s by %s"; %s%s sid: %d;`, proto, policyName, keywords, tagKeyword, sid)
+			} else {
+				allKeywords = fmt.Sprintf(`msg: "Allow %s by %s";%s sid: %d;`, proto, policyName, tagKeyword, sid)
+			}
+			ruleProto := proto
+			if p, ok := ruleProtocols[proto]; ok {
+				ruleProto = p
+			}
+			rule = fmt.Sprintf("pass %s any any -> any any (%s)\n", ruleProto, allKeywords)
+			rulesData.WriteString(rule)
+			sid++
+		}
+	}
+
+	return rulesData
+}
+
+func generateTenantRulesPath(vlanID uint32) string {
+	return fmt.Sprintf("%s/antrea-l7-networkpolicy-%d.rules", tenantRulesDir, vlanID)
+}
+
+func generateTenantConfigPath(vlanID uint32) string {
+	return fmt.Sprintf("%s/antrea-tenant-%d.yaml", tenantConfigsDir, vlanID)
+}
+
+func writeConfigFile(path string, data *bytes.Buffer) error {
+	f, err := defaultFS.OpenFile(path, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	if _, err = f.Write(data.Bytes()); err != nil {
+		return err
+	}
+	return nil
+}
+
+// escapeContent escapes the characters which have special meanings in the content keyword of Suricata rules with their
+// hexadecimal representations.
+func escapeContent(content string) string {
+	var b strings.Builder
+	for i := 0; i < len(content); i++ {
+		switch c := content[i]; c {
+		case '"', ';', '\\', '|':
+			fmt.Fprintf(&b, "|%02x|", c)
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return b.String()
+}
+
+// escapePCRE escapes the characters which terminate the pcre keyword of Suricata rules, or the pattern in it, with
+// their hexadecimal representations. Characters which are already escaped in the pattern are handled as well.
+func escapePCRE(pattern string) string {
+	var b strings.Builder
+	for i := 0; i < len(pattern); i++ {
+		c := pattern[i]
+		if c == '\\' && i+1 < len(pattern) {
+			i++
+			if next := pattern[i]; next == '"' || next == ';' || next == '/' {
+				fmt.Fprintf(&b, `\x%02x`, next)
+			} else {
+				b.WriteByte(c)
+				b.WriteByte(next)
+			}
+			continue
+		}
+		if c == '"' || c == ';' || c == '/' {
+			fmt.Fprintf(&b, `\x%02x`, c)
+			continue
+		}
+		b.WriteByte(c)
+	}
+	return b.String()
+}
+
+// By default, Suricata performs pattern-matching for provided content. To support exact match, prefix match, and suffix
+// match, we use wildcards to indicate whether an exact match is expected.
+// - A string starting with * means suffix match. For example, "*.foo.com" matches "www.foo.com".
+// - A string ending with * means prefix match. For example, "/public/*" matches "/public/index.html".
+// - A string starting with and ending with * means pattern-matching. For example, "*/v2/*" matches "/api/v2/pods".
+// - A string having no * means exact match. For example, "/index.html" can only match "/index.html".
+func convertContent(content string) string {
+	startsWith := " startswith;"
+	if strings.HasPrefix(content, "*") {
+		startsWith = ""
+		content = content[1:]
+	}
+	endsWith := " endswith;"
+	if strings.HasSuffix(content, "*") {
+		endsWith = ""
+		content = content[:len(content)-1]
+	}
+	return fmt.Sprintf(`content:"%s";%s%s`, escapeContent(content), startsWith, endsWith)
+}
+
+// convertHTTPPath converts the path to match to keywords applied to the normalized URI, which may include a query
+// string after the path.
+func convertHTTPPath(path string, matchType v1beta.HTTPMatchType) string {
+	switch matchType {
+	case v1beta.HTTPMatchTypeExact:
+		return fmt.Sprintf(`http.uri; pcre:"/^%s(?:\?|$)/";`, escapePCRE(regexp.QuoteMeta(path)))
+	case v1beta.HTTPMatchTypePrefix:
+		return fmt.Sprintf(`http.uri; content:"%s"; startswith;`, escapeContent(path))
+	case v1beta.HTTPMatchTypeRegex:
+		return fmt.Sprintf(`http.uri; pcre:"/^(?:%s)(?:\?|$)/";`, escapePCRE(path))
+	default:
+		return fmt.Sprintf("http.uri; %s", convertContent(path))
+	}
+}
+
+// convertHTTPValue converts a value to match to a PCRE pattern, which is anchored at the end of the value with the
+// provided pattern unless the value is matched by prefix.
+func convertHTTPValue(value string, matchType v1beta.HTTPMatchType, end string) string {
+	switch matchType {
+	case v1beta.HTTPMatchTypePrefix:
+		return escapePCRE(regexp.QuoteMeta(value))
+	case v1beta.HTTPMatchTypeRegex:
+		return fmt.Sprintf("(?:%s)%s", escapePCRE(value), end)
+	default:
+		return escapePCRE(regexp.QuoteMeta(value)) + end
+	}
+}
+
+// Every header is a line of the header buffer, whose name is case-insensitive.
+func convertHTTPHeader(header v1beta.HTTPHeaderMatch) string {
+	pattern := fmt.Sprintf("^(?i:%s):", escapePCRE(regexp.QuoteMeta(header.Name)))
+	if header.Value != "" || header.MatchType == v1beta.HTTPMatchTypeRegex {
+		pattern += `[ \t]*` + convertHTTPValue(header.Value, header.MatchType, `[ \t]*\r?$`)
+	}
+	return fmt.Sprintf(`http.header; pcre:"/%s/m";`, pattern)
+}
+
+// httpQueryParamStart matches the raw URI up to the start of a query parameter, i.e. the first "?" or any "&" after
+// it. Parameters are split on the raw "&" before any decoding, so that encoded "&" and "=" in a value, e.g. "%26", are
+// never taken as boundaries.
+const httpQueryParamStart = `^[^?]*\?(?:[^&]*&)*`
+
+// Query parameters are matched with the raw URI, as the normalized URI is percent-decoded.
+func convertHTTPQueryParam(param v1beta.HTTPQueryParamMatch) string {
+	pattern := httpQueryParamStart + escapePCRE(regexp.QuoteMeta(param.Name))
+	if param.Value != "" || param.MatchType == v1beta.HTTPMatchTypeRegex {
+		pattern += "=" + convertHTTPValue(param.Value, param.MatchType, "(?:&|$)")
+	} else {
+		pattern += "(?:[=&]|$)"
+	}
+	return fmt.Sprintf(`http.uri.raw; pcre:"/%s/";`, pattern)
+}
+
+func convertProtocolHTTP(http *v1beta.HTTPProtocol) string {
+	var keywords []string
+	if http.Path != "" {
+		keywords = append(keywords, convertHTTPPath(http.Path, http.PathMatchType))
+	}
+	if http.Method != "" {
+		keywords = append(keywords, fmt.Sprintf(`http.method; content:"%s";`, http.Method))
+	}
+	if http.Host != "" {
+		keywords = append(keywords, fmt.Sprintf("http.host; %s", convertContent(http.Host)))
+	}
+	for _, header := range http.Headers {
+		keywords = append(keywords, convertHTTPHeader(header))
+	}
+	for _, param := range http.QueryParams {
+		keywords = append(keywords, convertHTTPQueryParam(param))
+	}
+	return strings.Join(keywords, " ")
+}
+
+func convertProtocolTLS(tls *v1beta.TLSProtocol) string {
+	var keywords []string
+	if tls.SNI != "" {
+		keywords = append(keywords, fmt.Sprintf("tls.sni; %s", convertContent(tls.SNI)))
+	}
+	return strings.Join(keywords, " ")
+}
+
+// gRPC requests are HTTP/2 requests with the "application/grpc" content type, whose path is
+// "/<service>/<method>".
+func convertProtocolGRPC(grpc *v1beta.GRPCProtocol) string {
+	keywords := []string{`http.request_header; content:"content-type|3a 20|application/grpc"; startswith;`}
+	if grpc.Service != "" || grpc.Method != "" {
+		path := "/" + grpc.Service + "/" + grpc.Method
+		if grpc.Service == "" {
+			path = "*/" + grpc.Method
+		} else if grpc.Method == "" {
+			path += "*"
+		}
+		keywords = append(keywords, fmt.Sprintf("http.uri; %s", convertContent(path)))
+	}
+	return strings.Join(keywords, " ")
+}
+
+func convertProtocolDNS(dns *v1beta.DNSProtocol) string {
+	var keywords []string
+	if dns.QueryName != "" {
+		keywords = append(keywords, fmt.Sprintf("dns.query; %s nocase;", convertContent(dns.QueryName)))
+	}
+	return strings.Join(keywords, " ")
+}
+
+// Suricata has no app-layer parser for Kafka, hence the requests are matched with the payload of
+// TCP streams. A Kafka request starts with a 4-byte length, followed by the 2-byte API key and
+// the 2-byte API version. When a topic is specified, the request header and the fields preceding
+// the topics are skipped according to the schema of the request, so that the topic is only matched
+// at the position of the name of the first topic, and the request must carry exactly one topic.
+// Topic names are encoded either as strings with a 2-byte length, or as compact strings with an
+// unsigned varint length (plus one) in flexible versions.
+func convertProtocolKafka(kafka *v1beta.KafkaProtocol) []string {
+	keywords := []string{"flow: to_server, established;"}
+	if kafka.Topic == "" {
+		if kafka.APIKey != "" {
+			keywords = append(keywords, fmt.Sprintf("byte_test: 2, =, %d, 4;", kafkaAPIKeys[kafka.APIKey]))
+		}
+		return []string{strings.Join(keywords, " ")}
+	}
+	apiKeys := []string{kafka.APIKey}
+	if kafka.APIKey == "" {
+		apiKeys = sets.List(sets.KeySet(kafkaTopicRequests))
+	}
+	var rules []string
+	for _, apiKey := range apiKeys {
+		for _, schema := range kafkaTopicRequests[apiKey] {
+			rules = append(rules, convertKafkaTopicRequest(kafkaAPIKeys[apiKey], schema, kafka.Topic)...)
+		}
+	}
+	return rules
+}
+
+// convertKafkaTopicRequest generates the keywords matching the requests of the given API key and
+// schema whose only topic is the given one. A rule is generated for each combination of null and
+// non-null values of the nullable strings in the schema.
+func convertKafkaTopicRequest(apiKey int, schema kafkaRequestSchema, topic string) []string {
+	type variant struct {
+		keywords []string
+		// skip is the number of bytes to skip from the detection pointer before the next field.
+		skip int
+	}
+	keywords := []string{"flow: to_server, established;", fmt.Sprintf("byte_test: 2, =, %d, 4;", apiKey)}
+	if schema.minVersion > 0 {
+		keywords = append(keywords, fmt.Sprintf("byte_test: 2, >, %d, 6;", schema.minVersion-1))
+	}
+	keywords = append(keywords, fmt.Sprintf("byte_test: 2, <, %d, 6;", schema.maxVersion+1))
+	// Skip the client ID, which is a string with a 2-byte length in all request header versions.
+	// A null client ID makes the jump fail.
+	keywords = append(keywords, "byte_jump: 2, 12;")
+	if schema.flexible {
+		// Request headers v2 end with tagged fields, which are not expected to be set.
+		keywords = append(keywords, `content:"|00|"; distance: 0; within: 1;`)
+	}
+
+	skipString := func(skip int) []string {
+		if schema.flexible {
+			// The length is one byte as long as the string is shorter than 127 bytes.
+			return []string{
+				fmt.Sprintf("byte_test: 1, >, 0, %d, relative;", skip),
+				fmt.Sprintf("byte_test: 1, <, 128, %d, relative;", skip),
+				fmt.Sprintf("byte_jump: 1, %d, relative, post_offset -1;", skip),
+			}
+		}
+		return []string{
+			fmt.Sprintf("byte_test: 2, <, 32768, %d, relative;", skip),
+			fmt.Sprintf("byte_jump: 2, %d, relative;", skip),
+		}
+	}
+	nullString := func(skip int) string {
+		if schema.flexible {
+			return fmt.Sprintf(`content:"|00|"; distance: %d; within: 1;`, skip)
+		}
+		return fmt.Sprintf(`content:"|ff ff|"; distance: %d; within: 2;`, skip)
+	}
+
+	variants := []variant{{keywords: keywords}}
+	for _, field := range schema.fields {
+		var next []variant
+		for _, v := range variants {
+			switch field {
+			case kafkaString:
+				next = append(next, variant{keywords: append(slices.Clone(v.keywords), skipString(v.skip)...)})
+			case kafkaNullableString:
+				next = append(next,
+					variant{keywords: append(slices.Clone(v.keywords), skipString(v.skip)...)},
+					variant{keywords: append(slices.Clone(v.keywords), nullString(v.skip))})
+			default:
+				next = append(next, variant{keywords: v.keywords, skip: v.skip + int(field)})
+			}
+		}
+		variants = next
+	}
+
+	// The topic names accepted by the validation have no special characters, but they are escaped anyway since they
+	// are interpolated in the rules. The lengths are computed with the unescaped names.
+	escapedTopic := escapeContent(topic)
+	var topicContent string
+	if schema.flexible {
+		var topicLen []string
+		for _, b := range binary.AppendUvarint(nil, uint64(len(topic)+1)) {
+			topicLen = append(topicLen, fmt.Sprintf("%02x", b))
+		}
+		topicContent = fmt.Sprintf(`content:"|%s|%s"; distance: %%d; within: %d;`, strings.Join(topicLen, " "), escapedTopic, len(topicLen)+len(topic))
+	} else {
+		topicContent = fmt.Sprintf(`content:"|%02x %02x|%s"; distance: %%d; within: %d;`, len(topic)>>8, len(topic)&0xff, escapedTopic, 2+len(topic))
+	}
+	rules := make([]string, 0, len(variants))
+	for _, v := range variants {
+		// The topic array must have exactly one element, followed by the topic name.
+		var array string
+		if schema.flexible {
+			array = fmt.Sprintf("byte_test: 1, =, 2, %d, relative;", v.skip)
+			v.skip++
+		} else {
+			array = fmt.Sprintf("byte_test: 4, =, 1, %d, relative;", v.skip)
+			v.skip += 4
+		}
+		rules = append(rules, strings.Join(append(slices.Clone(v.keywords), array, fmt.Sprintf(topicContent, v.skip)), " "))
+	}
+	return rules
+}
+
+// Start starts Suricata and listens for the events it reports. It is safe to be called multiple times.
+func (r *SuricataEngine) Start() {
+	r.once.Do(func() {
+		r.startSuricata()
+	})
+}
+
+func (r *SuricataEngine) AddRule(ruleID, policyName string, vlanID uint32, l7Protocols []v1beta.L7Protocol, l7DenyResponse *v1beta.L7DenyResponse, enableLogging bool) error {
+	start := time.Now()
+	defer func() {
+		klog.V(5).Infof("AddRule took %v", time.Since(start))
+	}()
+
+	r.Start()
+
+	// Generate the keyword part used in Suricata rules.
+	protoKeywords := make(map[string]sets.Set[string])
+	for _, protocol := range l7Protocols {
+		if protocol.HTTP != nil {
+			httpKeywords := convertProtocolHTTP(protocol.HTTP)
+			if _, ok := protoKeywords[protocolHTTP]; !ok {
+				protoKeywords[protocolHTTP] = sets.New[string]()
+			}
+			protoKeywords[protocolHTTP].Insert(httpKeywords)
+		}
+		if protocol.TLS != nil {
+			tlsKeywords := convertProtocolTLS(protocol.TLS)
+			if _, ok := protoKeywords[protocolTLS]; !ok {
+				protoKeywords[protocolTLS] = sets.New[string]()
+			}
+			protoKeywords[protocolTLS].Insert(tlsKeywords)
+		}
+		if protocol.GRPC != nil {
+			grpcKeywords := convertProtocolGRPC(protocol.GRPC)
+			if _, ok := protoKeywords[protocolGRPC]; !ok {
+				protoKeywords[protocolGRPC] = sets.New[string]()
+			}
+			protoKeywords[protocolGRPC].Insert(grpcKeywords)
+		}
+		if protocol.DNS != nil {
+			dnsKeywords := convertProtocolDNS(protocol.DNS)
+			if _, ok := protoKeywords[protocolDNS]; !ok {
+				protoKeywords[protocolDNS] = sets.New[string]()
+			}
+			protoKeywords[protocolDNS].Insert(dnsKeywords)
+		}
+		if protocol.Kafka != nil {
+			kafkaKeywords := convertProtocolKafka(protocol.Kafka)
+			if _, ok := protoKeywords[protocolKafka]; !ok {
+				protoKeywords[protocolKafka] = sets.New[string]()
+			}
+			protoKeywords[protocolKafka].Insert(kafkaKeywords...)
+			for _, apiKey := range kafkaBootstrapAPIKeys {
+				protoKeywords[protocolKafka].Insert(convertProtocolKafka(&v1beta.KafkaProtocol{APIKey: apiKey})...)
+			}
+		}
+	}
+
+	klog.InfoS("Reconciling L7 rule", "RuleID", ruleID, "PolicyName", policyName)
+	// Write the Suricata rules to file.
+	rulesPath := generateTenantRulesPath(vlanID)
+	rulesData := generateTenantRulesData(policyName, protoKeywords, l7DenyResponse, enableLogging)
+	if err := writeConfigFile(rulesPath, rulesData); err != nil {
+		return fmt.Errorf("failed to write Suricata rules data to file %s for L7 rule %s of %s, err: %w", rulesPath, ruleID, policyName, err)
+	}
+
+	// Add a Suricata tenant.
+	if err := r.addBindingSuricataTenant(vlanID, rulesPath); err != nil {
+		return fmt.Errorf("failed to add Suricata tenant for L7 rule %s of %s: %w", ruleID, policyName, err)
+	}
+	return nil
+}
+
+func (r *SuricataEngine) DeleteRule(ruleID string, vlanID uint32) error {
+	start := time.Now()
+	defer func() {
+		klog.V(5).Infof("DeleteRule took %v", time.Since(start))
+	}()
+
+	// Delete the Suricata tenant.
+	if err := r.deleteBindingSuricataTenant(vlanID); err != nil {
+		return fmt.Errorf("failed to delete Suricata tenant %d for L7 rule %s: %w", vlanID, ruleID, err)
+	}
+
+	// Delete the Suricata rules file.
+	rulesPath := generateTenantRulesPath(vlanID)
+	if err := defaultFS.Remove(rulesPath); err != nil {
+		klog.ErrorS(err, "Failed to delete rules file", "FilePath", rulesPath, "RuleID", ruleID)
+	}
+
+	return nil
+}
+
+func (r *SuricataEngine) addBindingSuricataTenant(vlanID uint32, rulesPath string) error {
+	tenantConfigPath := generateTenantConfigPath(vlanID)
+	exists, err := afero.Exists(defaultFS, tenantConfigPath)
+	if err != nil {
+		return fmt.Errorf("failed to stat config file %s", tenantConfigPath)
+	}
+
+	// If the tenant config file exists, it means that this tenant has been added, just reload the tenant to load the
+	// updated rules.
+	if exists {
+		resp, err := r.reloadSuricataTenant(vlanID, tenantConfigPath)
+		if err != nil {
+			return err
+		}
+		if resp.Return != scCmdOK {
+			return fmt.Errorf("failed to reload Suricata tenant %d with config file %s: %v", vlanID, tenantConfigPath, resp.Message)
+		}
+		klog.V(4).InfoS("Reloaded Suricata tenant successfully", "TenantID", vlanID, "TenantConfigPath", tenantConfigPath, "ResponseMsg", resp.Message)
+		return nil
+	}
+
+	success := false
+	// If the tenant config file doesn't exist, create a config file for the tenant.
+	tenantConfigData := bytes.NewBuffer([]byte(fmt.Sprintf(`%%YAML 1.1
+
+---
+default-rule-path: /etc/suricata/rules
+rule-files:
+  - %s
+`, rulesPath)))
+	if err = writeConfigFile(tenantConfigPath, tenantConfigData); err != nil {
+		return fmt.Errorf("failed to write config file %s for Suricata tenant %d: %w", tenantConfigPath, vlanID, err)
+	}
+	defer func() {
+		if !success {
+			// Delete the config file regardless if it is created.
+			defaultFS.Remove(tenantConfigPath)
+		}
+	}()
+
+	// Register the tenant with the config file. Note that, to be simple, use the VLAN id as the tenant ID.
+	if !r.suricataTenantCache.has(vlanID) {
+		resp, err := r.registerSuricataTenant(vlanID, tenantConfigPath)
+		if err != nil {
+			return err
+		}
+		if resp.Return != scCmdOK {
+			return fmt.Errorf("failed to register Suricata tenant %d with config file %s: %v", vlanID, tenantConfigPath, resp.Message)
+		}
+		klog.V(4).InfoS("Registered Suricata tenant successfully", "TenantID", vlanID, "TenantConfigPath", tenantConfigPath, "ResponseMsg", resp.Message)
+		r.suricataTenantCache.insert(vlanID)
+	}
+
+	// Register the tenant handler by mapping the tenant to the allocated VLAN ID.
+	if !r.suricataTenantHandlerCache.has(vlanID) {
+		resp, err := r.registerSuricataTenantHandler(vlanID, vlanID)
+		if err != nil {
+			return err
+		}
+		if resp.Return != scCmdOK {
+			return fmt.Errorf("failed to register Suricata tenant %d handler to VLAN %d: %v", vlanID, vlanID, resp.Message)
+		}
+		klog.V(4).InfoS("Registered Suricata tenant handler successfully", "TenantID", vlanID, "VLANID", vlanID, "ResponseMsg", resp.Message)
+		r.suricataTenantHandlerCache.insert(vlanID)
+	}
+
+	success = true
+
+	return nil
+}
+
+func (r *SuricataEngine) deleteBindingSuricataTenant(vlanID uint32) error {
+	// Unregister the tenant handler.
+	if r.suricataTenantHandlerCache.has(vlanID) {
+		resp, err := r.unregisterSuricataTenantHandler(vlanID, vlanID)
+		if err != nil {
+			return err
+		}
+		if resp.Return != scCmdOK {
+			return fmt.Errorf("failed to unregister Suricata tenant %d handler: %v", vlanID, resp.Message)
+		}
+		klog.V(4).InfoS("Unregistered Suricata tenant handler successfully", "TenantID", vlanID, "VLANID", vlanID, "ResponseMsg", resp.Message)
+		r.suricataTenantHandlerCache.delete(vlanID)
+	}
+
+	// Unregister the tenant.
+	if r.suricataTenantCache.has(vlanID) {
+		resp, err := r.unregisterSuricataTenant(vlanID)
+		if err != nil {
+			return err
+		}
+		if resp.Return != scCmdOK {
+			return fmt.Errorf("failed to unregister Suricata tenant %d: %v", vlanID, resp.Message)
+		}
+		klog.V(4).InfoS("Unregistered Suricata tenant successfully", "TenantID", vlanID, "ResponseMsg", resp.Message)
+		r.suricataTenantCache.delete(vlanID)
+	}
+
+	// Delete the tenant config file.
+	configPath := generateTenantConfigPath(vlanID)
+	if err := defaultFS.Remove(configPath); err != nil {
+		if err != afero.ErrFileNotFound {
+			return fmt.Errorf("failed to delete config file %s: %w", configPath, err)
+		}
+	}
+	return nil
+}
+
+func (r *SuricataEngine) reloadSuricataTenant(tenantID uint32, tenantConfigPath string) (*scCmdRet, error) {
+	scCmd := fmt.Sprintf("reload-tenant %d %s", tenantID, tenantConfigPath)
+	return r.suricataScFn(scCmd)
+}
+
+func (r *SuricataEngine) registerSuricataTenant(tenantID uint32, tenantConfigPath string) (*scCmdRet, error) {
+	scCmd := fmt.Sprintf("register-tenant %d %s", tenantID, tenantConfigPath)
+	return r.suricataScFn(scCmd)
+}
+
+func (r *SuricataEngine) unregisterSuricataTenant(tenantID uint32) (*scCmdRet, error) {
+	scCmd := fmt.Sprintf("unregister-tenant %d", tenantID)
+	return r.suricataScFn(scCmd)
+}
+
+func (r *SuricataEngine) registerSuricataTenantHandler(tenantID, vlanID uint32) (*scCmdRet, error) {
+	scCmd := fmt.Sprintf("register-tenant-handler %d vlan %d", tenantID, vlanID)
+	return r.suricataScFn(scCmd)
+}
+
+func (r *SuricataEngine) unregisterSuricataTenantHandler(tenantID, vlanID uint32) (*scCmdRet, error) {
+	scCmd := fmt.Sprintf("unregister-tenant-handler %d vlan %d", tenantID, vlanID)
+	return r.suricataScFn(scCmd)
+}
+
+func (r *SuricataEngine) startSuricata() {
+	f, err := defaultFS.Create(antreaSuricataConfigPath)
+	if err != nil {
+		klog.ErrorS(err, "Failed to create Suricata config file", "FilePath", antreaSuricataConfigPath)
+		return
+	}
+	defer f.Close()
+	if _, err = f.WriteString(suricataAntreaConfigData); err != nil {
+		klog.ErrorS(err, "Failed to write Suricata config file", "FilePath", antreaSuricataConfigPath)
+		return
+	}
+
+	// Open the default Suricata config file /etc/suricata/suricata.yaml.
+	f, err = defaultFS.OpenFile(defaultSuricataConfigPath, os.O_APPEND|os.O_WRONLY, 0600)
+	if err != nil {
+		klog.ErrorS(err, "Failed to open default Suricata config file", "FilePath", defaultSuricataConfigPath)
+		return
+	}
+	defer f.Close()
+	// Include the config file /etc/suricata/antrea.yaml for Antrea in the default Suricata config file /etc/suricata/suricata.yaml.
+	if _, err = f.WriteString(fmt.Sprintf("include: %s\n", antreaSuricataConfigPath)); err != nil {
+		klog.ErrorS(err, "Failed to update default Suricata config file", "FilePath", defaultSuricataConfigPath)
+		return
+	}
+
+	// Start listening for the events before starting Suricata, which connects to the socket on startup.
+	go r.listenEventsFn()
+	r.startSuricataFn()
+
+	// Wait Suricata command socket file to be ready.
+	err = wait.PollImmediate(100*time.Millisecond, 5*time.Second, func() (bool, error) {
+		if _, err = defaultFS.Stat(suricataCommandSocket); err != nil {
+			return false, nil
+		}
+		return true, nil
+	})
+	if err != nil {
+		klog.ErrorS(err, "Failed to find Suricata command socket file")
+	} else {
+		klog.InfoS("Started Suricata instance successfully")
+	}
+}
+
+func startSuricata() {
+	// Create log directory /var/log/antrea/networkpolicy/l7engine/ for Suricata.
+	if err := os.Mkdir(antreaSuricataLogPath, os.ModePerm); err != nil {
+		klog.ErrorS(err, "Failed to create L7 Network Policy log directory", "Directory", antreaSuricataLogPath)
+	}
+	// Start Suricata with default Suricata config file /etc/suricata/suricata.yaml.
+	cmd := exec.Command("suricata", "-c", defaultSuricataConfigPath, "--af-packet", "-D", "-l", antreaSuricataLogPath)
+	if err := cmd.Start(); err != nil {
+		klog.ErrorS(err, "Failed to start Suricata instance")
+	}
+}
+
+func suricataSc(scCmd string) (*scCmdRet, error) {
+	cmd := exec.Command("suricatasc", "-c", scCmd)
+	retBytes, err := cmd.CombinedOutput()
+	if err != nil {
+		return nil, fmt.Errorf("failed to run Suricata command '%s': %w", scCmd, err)
+	}
+	var ret scCmdRet
+	if err = json.Unmarshal(retBytes, &ret); err != nil {
+		return nil, err
+	}
+	return &ret, nil
+}
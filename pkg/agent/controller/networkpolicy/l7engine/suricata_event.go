@@ -0,0 +1,212 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package l7engine
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/netip"
+	"os"
+	"path/filepath"
+	"time"
+
+	"k8s.io/klog/v2"
+)
+
+const (
+	// Suricata timestamps are formatted with a numeric zone offset without colon, e.g. 2024-01-02T15:04:05.123456+0000.
+	suricataTimestampLayout = "2006-01-02T15:04:05.999999-0700"
+
+	// The action of alerts raised by drop or reject rules in IPS mode.
+	alertActionBlocked = "blocked"
+
+	// blockedFlowTimeout is how long a flow is remembered as blocked after its alert. The app-layer events of
+	// blocked flows, which may be logged after the alert, are ignored during this period.
+	blockedFlowTimeout = time.Minute
+)
+
+type eveAlert struct {
+	Action    string `json:"action"`
+	Signature string `json:"signature"`
+}
+
+type eveHTTP struct {
+	Hostname string `json:"hostname"`
+	URL      string `json:"url"`
+	Method   string `json:"http_method"`
+}
+
+type eveTLS struct {
+	SNI string `json:"sni"`
+}
+
+type eveDNS struct {
+	Type   string `json:"type"`
+	RRName string `json:"rrname"`
+	RRType string `json:"rrtype"`
+}
+
+// eveEvent holds the values of the Suricata events used by Antrea.
+// See https://docs.suricata.io/en/latest/output/eve/eve-json-format.html.
+type eveEvent struct {
+	Timestamp string     `json:"timestamp"`
+	FlowID    int64      `json:"flow_id"`
+	EventType string     `json:"event_type"`
+	VLAN      []uint32   `json:"vlan"`
+	SrcIP     netip.Addr `json:"src_ip"`
+	SrcPort   uint16     `json:"src_port"`
+	DestIP    netip.Addr `json:"dest_ip"`
+	DestPort  uint16     `json:"dest_port"`
+	Proto     string     `json:"proto"`
+	AppProto  string     `json:"app_proto"`
+	Alert     *eveAlert  `json:"alert"`
+	HTTP      *eveHTTP   `json:"http"`
+	TLS       *eveTLS    `json:"tls"`
+	DNS       *eveDNS    `json:"dns"`
+	// Packet is base64-encoded in the event and decoded by the JSON decoder.
+	Packet []byte `json:"packet"`
+}
+
+func (r *SuricataEngine) listenEvents() {
+	// Remove the stale socket file.
+	if err := os.Remove(suricataEventSocket); err != nil && !os.IsNotExist(err) {
+		klog.ErrorS(err, "Failed to remove stale Suricata event socket", "FilePath", suricataEventSocket)
+		return
+	}
+	if err := os.MkdirAll(filepath.Dir(suricataEventSocket), 0750); err != nil {
+		klog.ErrorS(err, "Failed to create directory for Suricata event socket", "Directory", filepath.Dir(suricataEventSocket))
+		return
+	}
+	listener, err := net.Listen("unix", suricataEventSocket)
+	if err != nil {
+		klog.ErrorS(err, "Failed to listen on Suricata event socket", "FilePath", suricataEventSocket)
+		return
+	}
+	defer listener.Close()
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			klog.ErrorS(err, "Failed to accept Suricata event connection")
+			return
+		}
+		go r.handleEventConnection(conn)
+	}
+}
+
+func (r *SuricataEngine) handleEventConnection(conn net.Conn) {
+	defer conn.Close()
+	reader := bufio.NewReader(conn)
+	for {
+		data, err := reader.ReadBytes('\n')
+		if len(data) > 0 {
+			if err := r.processEvent(data); err != nil {
+				klog.ErrorS(err, "Failed to process Suricata event")
+			}
+		}
+		if err != nil {
+			if err != io.EOF {
+				klog.ErrorS(err, "Failed to read Suricata event")
+			}
+			return
+		}
+	}
+}
+
+func (r *SuricataEngine) processEvent(data []byte) error {
+	var e eveEvent
+	if err := json.Unmarshal(data, &e); err != nil {
+		return fmt.Errorf("error parsing Suricata event %s: %w", string(data), err)
+	}
+	// Traffic of L7 NetworkPolicies is always tagged with the VLAN ID allocated for the rule.
+	if len(e.VLAN) == 0 {
+		return nil
+	}
+
+	event := &Event{
+		VlanID:   e.VLAN[0],
+		SrcIP:    e.SrcIP,
+		SrcPort:  e.SrcPort,
+		DestIP:   e.DestIP,
+		DestPort: e.DestPort,
+		Proto:    e.Proto,
+		AppProto: e.AppProto,
+		Request:  requestSummary(&e),
+	}
+	var err error
+	if event.Timestamp, err = time.Parse(suricataTimestampLayout, e.Timestamp); err != nil {
+		event.Timestamp = time.Now()
+	}
+
+	switch e.EventType {
+	case "alert":
+		if e.Alert == nil || e.Alert.Action != alertActionBlocked {
+			return nil
+		}
+		r.markFlowBlocked(e.FlowID, event.Timestamp)
+		event.Packet = e.Packet
+	case "http", "tls":
+		if r.isFlowBlocked(e.FlowID) {
+			return nil
+		}
+		event.Allowed = true
+	case "dns":
+		// Only DNS queries are requests.
+		if e.DNS == nil || e.DNS.Type != "query" || r.isFlowBlocked(e.FlowID) {
+			return nil
+		}
+		event.Allowed = true
+	default:
+		return nil
+	}
+
+	r.dispatch(event)
+	return nil
+}
+
+func (r *SuricataEngine) markFlowBlocked(flowID int64, timestamp time.Time) {
+	r.blockedFlowsMutex.Lock()
+	defer r.blockedFlowsMutex.Unlock()
+	for id, t := range r.blockedFlows {
+		if timestamp.Sub(t) > blockedFlowTimeout {
+			delete(r.blockedFlows, id)
+		}
+	}
+	r.blockedFlows[flowID] = timestamp
+}
+
+func (r *SuricataEngine) isFlowBlocked(flowID int64) bool {
+	r.blockedFlowsMutex.Lock()
+	defer r.blockedFlowsMutex.Unlock()
+	_, exists := r.blockedFlows[flowID]
+	return exists
+}
+
+func requestSummary(e *eveEvent) string {
+	switch {
+	case e.HTTP != nil:
+		if e.HTTP.Method == "" {
+			return e.HTTP.Hostname + e.HTTP.URL
+		}
+		return fmt.Sprintf("%s %s%s", e.HTTP.Method, e.HTTP.Hostname, e.HTTP.URL)
+	case e.TLS != nil:
+		return e.TLS.SNI
+	case e.DNS != nil:
+		return fmt.Sprintf("%s %s", e.DNS.RRType, e.DNS.RRName)
+	}
+	return ""
+}
@@ -0,0 +1,127 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package l7engine
+
+import (
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProcessEvent(t *testing.T) {
+	timestamp, err := time.Parse(suricataTimestampLayout, "2024-01-02T15:04:05.123456+0000")
+	require.NoError(t, err)
+	srcIP := netip.MustParseAddr("10.10.0.1")
+	destIP := netip.MustParseAddr("10.10.0.2")
+
+	testCases := []struct {
+		name           string
+		events         []string
+		expectedEvents []*Event
+	}{
+		{
+			name: "allowed HTTP request",
+			events: []string{
+				`{"timestamp":"2024-01-02T15:04:05.123456+0000","flow_id":1,"event_type":"http","vlan":[1],"src_ip":"10.10.0.1","src_port":34567,"dest_ip":"10.10.0.2","dest_port":80,"proto":"TCP","app_proto":"http","http":{"hostname":"foo.bar.com","url":"/api/v2","http_method":"GET"}}`,
+			},
+			expectedEvents: []*Event{
+				{
+					Timestamp: timestamp,
+					VlanID:    1,
+					Allowed:   true,
+					SrcIP:     srcIP,
+					SrcPort:   34567,
+					DestIP:    destIP,
+					DestPort:  80,
+					Proto:     "TCP",
+					AppProto:  "http",
+					Request:   "GET foo.bar.com/api/v2",
+				},
+			},
+		},
+		{
+			name: "blocked TLS handshake",
+			events: []string{
+				`{"timestamp":"2024-01-02T15:04:05.123456+0000","flow_id":2,"event_type":"alert","vlan":[2],"src_ip":"10.10.0.1","src_port":34567,"dest_ip":"10.10.0.2","dest_port":443,"proto":"TCP","app_proto":"tls","alert":{"action":"blocked","signature":"Reject by AntreaNetworkPolicy:ns1/test"},"tls":{"sni":"foo.bar.com"},"packet":"AQID"}`,
+				`{"timestamp":"2024-01-02T15:04:05.123456+0000","flow_id":2,"event_type":"tls","vlan":[2],"src_ip":"10.10.0.1","src_port":34567,"dest_ip":"10.10.0.2","dest_port":443,"proto":"TCP","app_proto":"tls","tls":{"sni":"foo.bar.com"}}`,
+			},
+			expectedEvents: []*Event{
+				{
+					Timestamp: timestamp,
+					VlanID:    2,
+					SrcIP:     srcIP,
+					SrcPort:   34567,
+					DestIP:    destIP,
+					DestPort:  443,
+					Proto:     "TCP",
+					AppProto:  "tls",
+					Request:   "foo.bar.com",
+					Packet:    []byte{1, 2, 3},
+				},
+			},
+		},
+		{
+			name: "DNS query and answer",
+			events: []string{
+				`{"timestamp":"2024-01-02T15:04:05.123456+0000","flow_id":3,"event_type":"dns","vlan":[3],"src_ip":"10.10.0.1","src_port":34567,"dest_ip":"10.10.0.2","dest_port":53,"proto":"UDP","app_proto":"dns","dns":{"type":"query","rrname":"foo.bar.com","rrtype":"A"}}`,
+				`{"timestamp":"2024-01-02T15:04:05.123456+0000","flow_id":3,"event_type":"dns","vlan":[3],"src_ip":"10.10.0.2","src_port":53,"dest_ip":"10.10.0.1","dest_port":34567,"proto":"UDP","app_proto":"dns","dns":{"type":"answer","rrname":"foo.bar.com","rrtype":"A"}}`,
+			},
+			expectedEvents: []*Event{
+				{
+					Timestamp: timestamp,
+					VlanID:    3,
+					Allowed:   true,
+					SrcIP:     srcIP,
+					SrcPort:   34567,
+					DestIP:    destIP,
+					DestPort:  53,
+					Proto:     "UDP",
+					AppProto:  "dns",
+					Request:   "A foo.bar.com",
+				},
+			},
+		},
+		{
+			name: "ignored events",
+			events: []string{
+				`{"timestamp":"2024-01-02T15:04:05.123456+0000","flow_id":4,"event_type":"http","src_ip":"10.10.0.1","src_port":34567,"dest_ip":"10.10.0.2","dest_port":80,"proto":"TCP","app_proto":"http","http":{"hostname":"foo.bar.com","url":"/","http_method":"GET"}}`,
+				`{"timestamp":"2024-01-02T15:04:05.123456+0000","flow_id":5,"event_type":"alert","vlan":[1],"src_ip":"10.10.0.1","src_port":34567,"dest_ip":"10.10.0.2","dest_port":80,"proto":"TCP","alert":{"action":"allowed","signature":"Allow http by AntreaNetworkPolicy:ns1/test"}}`,
+				`{"timestamp":"2024-01-02T15:04:05.123456+0000","flow_id":6,"event_type":"flow","vlan":[1],"src_ip":"10.10.0.1","src_port":34567,"dest_ip":"10.10.0.2","dest_port":80,"proto":"TCP"}`,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			fe := NewSuricataEngine()
+			var events []*Event
+			fe.RegisterEventHandler(func(event *Event) {
+				events = append(events, event)
+			})
+			for _, e := range tc.events {
+				require.NoError(t, fe.processEvent([]byte(e)))
+			}
+			assert.Equal(t, tc.expectedEvents, events)
+		})
+	}
+}
+
+func TestProcessInvalidEvent(t *testing.T) {
+	fe := NewSuricataEngine()
+	assert.Error(t, fe.processEvent([]byte(`{"event_type":`)))
+}
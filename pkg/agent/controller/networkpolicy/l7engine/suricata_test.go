@@ -0,0 +1,876 @@
+// Copyright 2022 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package l7engine
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	v1beta "antrea.io/antrea/pkg/apis/controlplane/v1beta2"
+)
+
+type fakeSuricata struct {
+	calledScCommands      sets.Set[string]
+	startSuricataFnCalled bool
+}
+
+func newFakeSuricata() *fakeSuricata {
+	return &fakeSuricata{
+		calledScCommands:      sets.New[string](),
+		startSuricataFnCalled: false,
+	}
+}
+
+func (f *fakeSuricata) suricataScFunc(scCmd string) (*scCmdRet, error) {
+	f.calledScCommands.Insert(scCmd)
+	return &scCmdRet{Return: scCmdOK}, nil
+}
+
+func (f *fakeSuricata) startSuricataFn() {
+	f.startSuricataFnCalled = true
+	defaultFS.Create(suricataCommandSocket)
+}
+
+func TestConvertProtocolHTTP(t *testing.T) {
+	testCases := []struct {
+		name     string
+		http     *v1beta.HTTPProtocol
+		expected string
+	}{
+		{
+			name:     "without host,method,path",
+			http:     &v1beta.HTTPProtocol{},
+			expected: "",
+		},
+		{
+			name: "with host,method,exact path",
+			http: &v1beta.HTTPProtocol{
+				Host:   "www.google.com",
+				Method: "GET",
+				Path:   "/index.html",
+			},
+			expected: `http.uri; content:"/index.html"; startswith; endswith; http.method; content:"GET"; http.host; content:"www.google.com"; startswith; endswith;`,
+		},
+		{
+			name: "with host suffix, path prefix",
+			http: &v1beta.HTTPProtocol{
+				Host: "*.foo.com",
+				Path: "/api/v2/*",
+			},
+			expected: `http.uri; content:"/api/v2/"; startswith; http.host; content:".foo.com"; endswith;`,
+		},
+		{
+			name: "with host pattern",
+			http: &v1beta.HTTPProtocol{
+				Host: "*.foo.*",
+			},
+			expected: `http.host; content:".foo.";`,
+		},
+		{
+			name: "with path prefix, headers, query parameters",
+			http: &v1beta.HTTPProtocol{
+				Path:          "/api/",
+				PathMatchType: v1beta.HTTPMatchTypePrefix,
+				Headers: []v1beta.HTTPHeaderMatch{
+					{Name: "X-Tenant-ID", Value: "foo"},
+					{Name: "Authorization"},
+				},
+				QueryParams: []v1beta.HTTPQueryParamMatch{
+					{Name: "version", Value: "v2"},
+				},
+			},
+			expected: `http.uri; content:"/api/"; startswith; http.header; pcre:"/^(?i:X-Tenant-ID):[ \t]*foo[ \t]*\r?$/m"; http.header; pcre:"/^(?i:Authorization):/m"; http.uri.raw; pcre:"/^[^?]*\?(?:[^&]*&)*version=v2(?:&|$)/";`,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, convertProtocolHTTP(tc.http))
+		})
+	}
+}
+
+func TestEscapeContent(t *testing.T) {
+	assert.Equal(t, "/index.html", escapeContent("/index.html"))
+	assert.Equal(t, "a|22|b|3b|c|5c|d|7c|e", escapeContent(`a"b;c\d|e`))
+}
+
+func TestEscapePCRE(t *testing.T) {
+	assert.Equal(t, `[0-9]+\.html`, escapePCRE(`[0-9]+\.html`))
+	assert.Equal(t, `a\x22b\x3bc\x2fd\x2fe\.f`, escapePCRE(`a"b;c/d\/e\.f`))
+}
+
+func TestConvertHTTPPath(t *testing.T) {
+	testCases := []struct {
+		name      string
+		path      string
+		matchType v1beta.HTTPMatchType
+		expected  string
+	}{
+		{
+			name:     "wildcard",
+			path:     "/public/*",
+			expected: `http.uri; content:"/public/"; startswith;`,
+		},
+		{
+			name:      "exact",
+			path:      "/index.html",
+			matchType: v1beta.HTTPMatchTypeExact,
+			expected:  `http.uri; pcre:"/^\x2findex\.html(?:\?|$)/";`,
+		},
+		{
+			name:      "prefix",
+			path:      "/api/v1/*",
+			matchType: v1beta.HTTPMatchTypePrefix,
+			expected:  `http.uri; content:"/api/v1/*"; startswith;`,
+		},
+		{
+			name:      "regex",
+			path:      "/api/v[0-9]+/pods",
+			matchType: v1beta.HTTPMatchTypeRegex,
+			expected:  `http.uri; pcre:"/^(?:\x2fapi\x2fv[0-9]+\x2fpods)(?:\?|$)/";`,
+		},
+		{
+			name:      "exact with special characters",
+			path:      `/a";b`,
+			matchType: v1beta.HTTPMatchTypeExact,
+			expected:  `http.uri; pcre:"/^\x2fa\x22\x3bb(?:\?|$)/";`,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, convertHTTPPath(tc.path, tc.matchType))
+		})
+	}
+}
+
+func TestConvertHTTPHeader(t *testing.T) {
+	testCases := []struct {
+		name     string
+		header   v1beta.HTTPHeaderMatch
+		expected string
+	}{
+		{
+			name:     "presence",
+			header:   v1beta.HTTPHeaderMatch{Name: "Authorization"},
+			expected: `http.header; pcre:"/^(?i:Authorization):/m";`,
+		},
+		{
+			name:     "exact",
+			header:   v1beta.HTTPHeaderMatch{Name: "X-Tenant-ID", Value: "foo.bar"},
+			expected: `http.header; pcre:"/^(?i:X-Tenant-ID):[ \t]*foo\.bar[ \t]*\r?$/m";`,
+		},
+		{
+			name:     "prefix",
+			header:   v1beta.HTTPHeaderMatch{Name: "Authorization", Value: "Bearer ", MatchType: v1beta.HTTPMatchTypePrefix},
+			expected: `http.header; pcre:"/^(?i:Authorization):[ \t]*Bearer /m";`,
+		},
+		{
+			name:     "regex",
+			header:   v1beta.HTTPHeaderMatch{Name: "X-Tenant-ID", Value: "tenant-[a-z]+", MatchType: v1beta.HTTPMatchTypeRegex},
+			expected: `http.header; pcre:"/^(?i:X-Tenant-ID):[ \t]*(?:tenant-[a-z]+)[ \t]*\r?$/m";`,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, convertHTTPHeader(tc.header))
+		})
+	}
+}
+
+func TestConvertHTTPQueryParam(t *testing.T) {
+	testCases := []struct {
+		name     string
+		param    v1beta.HTTPQueryParamMatch
+		expected string
+	}{
+		{
+			name:     "presence",
+			param:    v1beta.HTTPQueryParamMatch{Name: "debug"},
+			expected: `http.uri.raw; pcre:"/^[^?]*\?(?:[^&]*&)*debug(?:[=&]|$)/";`,
+		},
+		{
+			name:     "exact",
+			param:    v1beta.HTTPQueryParamMatch{Name: "version", Value: "v2"},
+			expected: `http.uri.raw; pcre:"/^[^?]*\?(?:[^&]*&)*version=v2(?:&|$)/";`,
+		},
+		{
+			name:     "prefix",
+			param:    v1beta.HTTPQueryParamMatch{Name: "user", Value: "adm", MatchType: v1beta.HTTPMatchTypePrefix},
+			expected: `http.uri.raw; pcre:"/^[^?]*\?(?:[^&]*&)*user=adm/";`,
+		},
+		{
+			name:     "regex",
+			param:    v1beta.HTTPQueryParamMatch{Name: "id", Value: "[0-9]+", MatchType: v1beta.HTTPMatchTypeRegex},
+			expected: `http.uri.raw; pcre:"/^[^?]*\?(?:[^&]*&)*id=(?:[0-9]+)(?:&|$)/";`,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, convertHTTPQueryParam(tc.param))
+		})
+	}
+}
+
+func TestHTTPQueryParamBoundaries(t *testing.T) {
+	param := v1beta.HTTPQueryParamMatch{Name: "tenant", Value: "foo"}
+	// The pattern in the pcre keyword has no escaped delimiters, hence it can be evaluated as is.
+	pattern := strings.TrimSuffix(strings.TrimPrefix(convertHTTPQueryParam(param), `http.uri.raw; pcre:"/`), `/";`)
+	re := regexp.MustCompile(pattern)
+	testCases := []struct {
+		uri           string
+		expectedMatch bool
+	}{
+		{uri: "/api?tenant=foo", expectedMatch: true},
+		{uri: "/api?x=1&tenant=foo&y=2", expectedMatch: true},
+		{uri: "/api?x=%26tenant=foo"},
+		{uri: "/api?x=%26tenant%3Dfoo"},
+		{uri: "/api?x=1?tenant=foo"},
+		{uri: "/api/tenant=foo"},
+		{uri: "/api?tenant=foo%26admin=1"},
+		{uri: "/api?tenant=foobar"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.uri, func(t *testing.T) {
+			assert.Equal(t, tc.expectedMatch, re.MatchString(tc.uri))
+		})
+	}
+}
+
+func TestConvertProtocolTLS(t *testing.T) {
+	testCases := []struct {
+		name     string
+		tls      *v1beta.TLSProtocol
+		expected string
+	}{
+		{
+			name:     "without SNI",
+			tls:      &v1beta.TLSProtocol{},
+			expected: "",
+		},
+		{
+			name: "with SNI",
+			tls: &v1beta.TLSProtocol{
+				SNI: "google.com",
+			},
+			expected: `tls.sni; content:"google.com"; startswith; endswith;`,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, convertProtocolTLS(tc.tls))
+		})
+	}
+}
+
+func TestConvertProtocolGRPC(t *testing.T) {
+	testCases := []struct {
+		name     string
+		grpc     *v1beta.GRPCProtocol
+		expected string
+	}{
+		{
+			name:     "without service,method",
+			grpc:     &v1beta.GRPCProtocol{},
+			expected: `http.request_header; content:"content-type|3a 20|application/grpc"; startswith;`,
+		},
+		{
+			name: "with service,method",
+			grpc: &v1beta.GRPCProtocol{
+				Service: "helloworld.Greeter",
+				Method:  "SayHello",
+			},
+			expected: `http.request_header; content:"content-type|3a 20|application/grpc"; startswith; http.uri; content:"/helloworld.Greeter/SayHello"; startswith; endswith;`,
+		},
+		{
+			name: "with service",
+			grpc: &v1beta.GRPCProtocol{
+				Service: "helloworld.Greeter",
+			},
+			expected: `http.request_header; content:"content-type|3a 20|application/grpc"; startswith; http.uri; content:"/helloworld.Greeter/"; startswith;`,
+		},
+		{
+			name: "with method",
+			grpc: &v1beta.GRPCProtocol{
+				Method: "SayHello",
+			},
+			expected: `http.request_header; content:"content-type|3a 20|application/grpc"; startswith; http.uri; content:"/SayHello"; endswith;`,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, convertProtocolGRPC(tc.grpc))
+		})
+	}
+}
+
+func TestConvertProtocolDNS(t *testing.T) {
+	testCases := []struct {
+		name     string
+		dns      *v1beta.DNSProtocol
+		expected string
+	}{
+		{
+			name:     "without query name",
+			dns:      &v1beta.DNSProtocol{},
+			expected: "",
+		},
+		{
+			name: "with query name suffix",
+			dns: &v1beta.DNSProtocol{
+				QueryName: "*.svc.cluster.local",
+			},
+			expected: `dns.query; content:".svc.cluster.local"; endswith; nocase;`,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, convertProtocolDNS(tc.dns))
+		})
+	}
+}
+
+func TestConvertProtocolKafka(t *testing.T) {
+	testCases := []struct {
+		name     string
+		kafka    *v1beta.KafkaProtocol
+		expected []string
+	}{
+		{
+			name:     "without API key,topic",
+			kafka:    &v1beta.KafkaProtocol{},
+			expected: []string{"flow: to_server, established;"},
+		},
+		{
+			name: "with API key",
+			kafka: &v1beta.KafkaProtocol{
+				APIKey: "fetch",
+			},
+			expected: []string{"flow: to_server, established; byte_test: 2, =, 1, 4;"},
+		},
+		{
+			name: "with API key,topic",
+			kafka: &v1beta.KafkaProtocol{
+				APIKey: "createTopics",
+				Topic:  "orders",
+			},
+			expected: []string{
+				`flow: to_server, established; byte_test: 2, =, 19, 4; byte_test: 2, <, 5, 6; byte_jump: 2, 12; byte_test: 4, =, 1, 0, relative; content:"|00 06|orders"; distance: 4; within: 8;`,
+				`flow: to_server, established; byte_test: 2, =, 19, 4; byte_test: 2, >, 4, 6; byte_test: 2, <, 8, 6; byte_jump: 2, 12; content:"|00|"; distance: 0; within: 1; byte_test: 1, =, 2, 0, relative; content:"|07|orders"; distance: 1; within: 7;`,
+			},
+		},
+		{
+			name: "with API key,topic and nullable string",
+			kafka: &v1beta.KafkaProtocol{
+				APIKey: "produce",
+				Topic:  "orders",
+			},
+			expected: []string{
+				`flow: to_server, established; byte_test: 2, =, 0, 4; byte_test: 2, <, 3, 6; byte_jump: 2, 12; byte_test: 4, =, 1, 6, relative; content:"|00 06|orders"; distance: 10; within: 8;`,
+				`flow: to_server, established; byte_test: 2, =, 0, 4; byte_test: 2, >, 2, 6; byte_test: 2, <, 9, 6; byte_jump: 2, 12; byte_test: 2, <, 32768, 0, relative; byte_jump: 2, 0, relative; byte_test: 4, =, 1, 6, relative; content:"|00 06|orders"; distance: 10; within: 8;`,
+				`flow: to_server, established; byte_test: 2, =, 0, 4; byte_test: 2, >, 2, 6; byte_test: 2, <, 9, 6; byte_jump: 2, 12; content:"|ff ff|"; distance: 0; within: 2; byte_test: 4, =, 1, 6, relative; content:"|00 06|orders"; distance: 10; within: 8;`,
+				`flow: to_server, established; byte_test: 2, =, 0, 4; byte_test: 2, >, 8, 6; byte_test: 2, <, 11, 6; byte_jump: 2, 12; content:"|00|"; distance: 0; within: 1; byte_test: 1, >, 0, 0, relative; byte_test: 1, <, 128, 0, relative; byte_jump: 1, 0, relative, post_offset -1; byte_test: 1, =, 2, 6, relative; content:"|07|orders"; distance: 7; within: 7;`,
+				`flow: to_server, established; byte_test: 2, =, 0, 4; byte_test: 2, >, 8, 6; byte_test: 2, <, 11, 6; byte_jump: 2, 12; content:"|00|"; distance: 0; within: 1; content:"|00|"; distance: 0; within: 1; byte_test: 1, =, 2, 6, relative; content:"|07|orders"; distance: 7; within: 7;`,
+			},
+		},
+		{
+			name: "with long topic",
+			kafka: &v1beta.KafkaProtocol{
+				APIKey: "deleteTopics",
+				Topic:  strings.Repeat("a", 200),
+			},
+			expected: []string{
+				fmt.Sprintf(`flow: to_server, established; byte_test: 2, =, 20, 4; byte_test: 2, <, 4, 6; byte_jump: 2, 12; byte_test: 4, =, 1, 0, relative; content:"|00 c8|%s"; distance: 4; within: 202;`, strings.Repeat("a", 200)),
+				fmt.Sprintf(`flow: to_server, established; byte_test: 2, =, 20, 4; byte_test: 2, >, 3, 6; byte_test: 2, <, 6, 6; byte_jump: 2, 12; content:"|00|"; distance: 0; within: 1; byte_test: 1, =, 2, 0, relative; content:"|c9 01|%s"; distance: 1; within: 202;`, strings.Repeat("a", 200)),
+			},
+		},
+		{
+			name: "with topic with special characters",
+			kafka: &v1beta.KafkaProtocol{
+				APIKey: "createTopics",
+				Topic:  `a";|b`,
+			},
+			expected: []string{
+				`flow: to_server, established; byte_test: 2, =, 19, 4; byte_test: 2, <, 5, 6; byte_jump: 2, 12; byte_test: 4, =, 1, 0, relative; content:"|00 05|a|22||3b||7c|b"; distance: 4; within: 7;`,
+				`flow: to_server, established; byte_test: 2, =, 19, 4; byte_test: 2, >, 4, 6; byte_test: 2, <, 8, 6; byte_jump: 2, 12; content:"|00|"; distance: 0; within: 1; byte_test: 1, =, 2, 0, relative; content:"|06|a|22||3b||7c|b"; distance: 1; within: 6;`,
+			},
+		},
+		{
+			name: "with topic and API key without topics",
+			kafka: &v1beta.KafkaProtocol{
+				APIKey: "heartbeat",
+				Topic:  "orders",
+			},
+			expected: nil,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, convertProtocolKafka(tc.kafka))
+		})
+	}
+}
+
+// matchKeywords evaluates the payload keywords of a Suricata rule generated for Kafka against a
+// payload, following the semantics of Suricata for the subset of the keywords which are used.
+func matchKeywords(t *testing.T, keywords string, payload []byte) bool {
+	readUint := func(pos, n int) (int, bool) {
+		if pos < 0 || pos+n > len(payload) {
+			return 0, false
+		}
+		v := 0
+		for _, b := range payload[pos : pos+n] {
+			v = v<<8 | int(b)
+		}
+		return v, true
+	}
+	var parts []string
+	for _, part := range strings.Split(keywords, ";") {
+		if part = strings.TrimSpace(part); part != "" {
+			parts = append(parts, part)
+		}
+	}
+	ptr := 0
+	for i := 0; i < len(parts); i++ {
+		name, value, _ := strings.Cut(parts[i], ":")
+		var args []string
+		for _, arg := range strings.Split(value, ",") {
+			args = append(args, strings.TrimSpace(arg))
+		}
+		atoi := func(s string) int {
+			v, err := strconv.Atoi(s)
+			require.NoError(t, err)
+			return v
+		}
+		switch name {
+		case "flow":
+		case "byte_test":
+			base := 0
+			if len(args) > 4 && args[4] == "relative" {
+				base = ptr
+			}
+			v, ok := readUint(base+atoi(args[3]), atoi(args[0]))
+			if !ok {
+				return false
+			}
+			expected := atoi(args[2])
+			switch args[1] {
+			case "=":
+				ok = v == expected
+			case "<":
+				ok = v < expected
+			case ">":
+				ok = v > expected
+			default:
+				t.Fatalf("Unsupported byte_test operator %s", args[1])
+			}
+			if !ok {
+				return false
+			}
+		case "byte_jump":
+			n, pos := atoi(args[0]), atoi(args[1])
+			postOffset := 0
+			for _, arg := range args[2:] {
+				if arg == "relative" {
+					pos += ptr
+				} else if offset, found := strings.CutPrefix(arg, "post_offset "); found {
+					postOffset = atoi(offset)
+				}
+			}
+			v, ok := readUint(pos, n)
+			if !ok {
+				return false
+			}
+			ptr = pos + n + v + postOffset
+			if ptr > len(payload) {
+				return false
+			}
+		case "content":
+			var pattern []byte
+			for j, segment := range strings.Split(strings.Trim(strings.TrimSpace(value), `"`), "|") {
+				if j%2 == 0 {
+					pattern = append(pattern, segment...)
+					continue
+				}
+				for _, h := range strings.Fields(segment) {
+					b, err := strconv.ParseUint(h, 16, 8)
+					require.NoError(t, err)
+					pattern = append(pattern, byte(b))
+				}
+			}
+			start, end := 0, len(payload)
+			for ; i+1 < len(parts); i++ {
+				modifier, modifierValue, _ := strings.Cut(parts[i+1], ":")
+				if modifier == "distance" {
+					start = ptr + atoi(strings.TrimSpace(modifierValue))
+				} else if modifier == "within" {
+					end = start + atoi(strings.TrimSpace(modifierValue))
+				} else {
+					break
+				}
+			}
+			if start < 0 || start > len(payload) {
+				return false
+			}
+			end = min(end, len(payload))
+			idx := bytes.Index(payload[start:end], pattern)
+			if idx < 0 {
+				return false
+			}
+			ptr = start + idx + len(pattern)
+		default:
+			t.Fatalf("Unsupported keyword %s", name)
+		}
+	}
+	return true
+}
+
+func matchRules(t *testing.T, rules []string, payload []byte) bool {
+	for _, rule := range rules {
+		if matchKeywords(t, rule, payload) {
+			return true
+		}
+	}
+	return false
+}
+
+// encodeKafkaRequest builds a Kafka request with the given API key, version, and body.
+func encodeKafkaRequest(apiKey, version uint16, flexible bool, body ...[]byte) []byte {
+	request := binary.BigEndian.AppendUint16(nil, apiKey)
+	request = binary.BigEndian.AppendUint16(request, version)
+	request = binary.BigEndian.AppendUint32(request, 1)
+	request = append(request, encodeKafkaString("client")...)
+	if flexible {
+		request = append(request, 0)
+	}
+	request = append(request, bytes.Join(body, nil)...)
+	return append(binary.BigEndian.AppendUint32(nil, uint32(len(request))), request...)
+}
+
+func encodeKafkaString(s string) []byte {
+	return append(binary.BigEndian.AppendUint16(nil, uint16(len(s))), s...)
+}
+
+func encodeKafkaCompactString(s string) []byte {
+	return append(binary.AppendUvarint(nil, uint64(len(s)+1)), s...)
+}
+
+func encodeKafkaInt32(v uint32) []byte {
+	return binary.BigEndian.AppendUint32(nil, v)
+}
+
+func TestKafkaRulesMatchRequests(t *testing.T) {
+	// The acks and timeout fields of Produce requests.
+	produceFields := []byte{0xff, 0xff, 0, 0, 0x75, 0x30}
+	// A record batch whose data carries the encoded name of another topic.
+	records := append(encodeKafkaInt32(12), "\x00\x06orders\x00\x00\x00\x00"...)
+	partition := append(encodeKafkaInt32(1), append(encodeKafkaInt32(0), records...)...)
+	compactPartition := append([]byte{2}, append(encodeKafkaInt32(0), append([]byte{13}, records...)...)...)
+
+	testCases := []struct {
+		name          string
+		kafka         *v1beta.KafkaProtocol
+		request       []byte
+		expectedMatch bool
+	}{
+		{
+			name:          "Produce v3 with topic",
+			kafka:         &v1beta.KafkaProtocol{APIKey: "produce", Topic: "orders"},
+			request:       encodeKafkaRequest(0, 3, false, []byte{0xff, 0xff}, produceFields, encodeKafkaInt32(1), encodeKafkaString("orders"), partition),
+			expectedMatch: true,
+		},
+		{
+			name:          "Produce v3 with transactional ID",
+			kafka:         &v1beta.KafkaProtocol{Topic: "orders"},
+			request:       encodeKafkaRequest(0, 3, false, encodeKafkaString("txn"), produceFields, encodeKafkaInt32(1), encodeKafkaString("orders"), partition),
+			expectedMatch: true,
+		},
+		{
+			name:          "Produce v3 with topic in record data",
+			kafka:         &v1beta.KafkaProtocol{APIKey: "produce", Topic: "orders"},
+			request:       encodeKafkaRequest(0, 3, false, []byte{0xff, 0xff}, produceFields, encodeKafkaInt32(1), encodeKafkaString("secrets"), partition),
+			expectedMatch: false,
+		},
+		{
+			name:          "Produce v3 with topic in transactional ID",
+			kafka:         &v1beta.KafkaProtocol{APIKey: "produce", Topic: "orders"},
+			request:       encodeKafkaRequest(0, 3, false, encodeKafkaString("\x00\x06orders"), produceFields, encodeKafkaInt32(1), encodeKafkaString("secrets"), partition),
+			expectedMatch: false,
+		},
+		{
+			name:          "Produce v3 with multiple topics",
+			kafka:         &v1beta.KafkaProtocol{APIKey: "produce", Topic: "orders"},
+			request:       encodeKafkaRequest(0, 3, false, []byte{0xff, 0xff}, produceFields, encodeKafkaInt32(2), encodeKafkaString("orders"), partition, encodeKafkaString("secrets"), partition),
+			expectedMatch: false,
+		},
+		{
+			name:          "Produce v9 with topic",
+			kafka:         &v1beta.KafkaProtocol{APIKey: "produce", Topic: "orders"},
+			request:       encodeKafkaRequest(0, 9, true, []byte{0}, produceFields, []byte{2}, encodeKafkaCompactString("orders"), compactPartition, []byte{0, 0}),
+			expectedMatch: true,
+		},
+		{
+			name:          "Produce v9 with topic in record data",
+			kafka:         &v1beta.KafkaProtocol{APIKey: "produce", Topic: "orders"},
+			request:       encodeKafkaRequest(0, 9, true, []byte{0}, produceFields, []byte{2}, encodeKafkaCompactString("secrets"), compactPartition, []byte{0, 0}),
+			expectedMatch: false,
+		},
+		{
+			name:          "Produce v11",
+			kafka:         &v1beta.KafkaProtocol{APIKey: "produce", Topic: "orders"},
+			request:       encodeKafkaRequest(0, 11, true, []byte{0}, produceFields, []byte{2}, encodeKafkaCompactString("orders"), compactPartition, []byte{0, 0}),
+			expectedMatch: false,
+		},
+		{
+			name:          "Fetch v4 with topic",
+			kafka:         &v1beta.KafkaProtocol{APIKey: "fetch", Topic: "orders"},
+			request:       encodeKafkaRequest(1, 4, false, make([]byte, 17), encodeKafkaInt32(1), encodeKafkaString("orders"), encodeKafkaInt32(0)),
+			expectedMatch: true,
+		},
+		{
+			name:          "Fetch with Produce rule",
+			kafka:         &v1beta.KafkaProtocol{APIKey: "produce", Topic: "orders"},
+			request:       encodeKafkaRequest(1, 4, false, make([]byte, 17), encodeKafkaInt32(1), encodeKafkaString("orders"), encodeKafkaInt32(0)),
+			expectedMatch: false,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expectedMatch, matchRules(t, convertProtocolKafka(tc.kafka), tc.request))
+		})
+	}
+}
+
+func TestKafkaBootstrapRequestsAllowed(t *testing.T) {
+	var rules []string
+	for _, apiKey := range kafkaBootstrapAPIKeys {
+		rules = append(rules, convertProtocolKafka(&v1beta.KafkaProtocol{APIKey: apiKey})...)
+	}
+	rules = append(rules, convertProtocolKafka(&v1beta.KafkaProtocol{APIKey: "produce", Topic: "orders"})...)
+
+	apiVersionsRequest := encodeKafkaRequest(18, 3, true, encodeKafkaCompactString("client"), encodeKafkaCompactString("1.0"), []byte{0})
+	metadataRequest := encodeKafkaRequest(3, 9, true, []byte{2}, encodeKafkaCompactString("orders"), []byte{0, 1, 0, 0, 0})
+	heartbeatRequest := encodeKafkaRequest(12, 4, true, encodeKafkaCompactString("group"), encodeKafkaInt32(1), encodeKafkaCompactString("member"), []byte{0, 0})
+	assert.True(t, matchRules(t, rules, apiVersionsRequest))
+	assert.True(t, matchRules(t, rules, metadataRequest))
+	assert.False(t, matchRules(t, rules, heartbeatRequest))
+}
+
+func TestStartSuricata(t *testing.T) {
+	defaultFS = afero.NewMemMapFs()
+	defer func() {
+		defaultFS = afero.NewOsFs()
+	}()
+
+	_, err := defaultFS.Create(defaultSuricataConfigPath)
+	assert.NoError(t, err)
+
+	fe := NewSuricataEngine()
+	fs := newFakeSuricata()
+	fe.suricataScFn = fs.suricataScFunc
+	fe.startSuricataFn = fs.startSuricataFn
+	fe.listenEventsFn = func() {}
+
+	fe.startSuricata()
+
+	ok, err := afero.FileContainsBytes(defaultFS, antreaSuricataConfigPath, []byte(suricataAntreaConfigData))
+	assert.NoError(t, err)
+	assert.True(t, ok)
+
+	ok, err = afero.FileContainsBytes(defaultFS, defaultSuricataConfigPath, []byte("include: /etc/suricata/antrea.yaml"))
+	assert.NoError(t, err)
+	assert.True(t, ok)
+}
+
+func TestRuleLifecycle(t *testing.T) {
+	ruleID := "123456"
+	vlanID := uint32(1)
+	policyName := "AntreaNetworkPolicy:test-l7"
+
+	testCases := []struct {
+		name                 string
+		l7Protocols          []v1beta.L7Protocol
+		updatedL7Protocols   []v1beta.L7Protocol
+		l7DenyResponse       *v1beta.L7DenyResponse
+		expectedRules        string
+		expectedUpdatedRules string
+	}{
+		{
+			name: "protocol HTTP",
+			l7Protocols: []v1beta.L7Protocol{
+				{
+					HTTP: &v1beta.HTTPProtocol{
+						Host:   "www.google.com",
+						Method: "GET",
+						Path:   "/index.html",
+					},
+				},
+			},
+			updatedL7Protocols: []v1beta.L7Protocol{
+				{
+					HTTP: &v1beta.HTTPProtocol{},
+				},
+			},
+			expectedRules:        `pass http any any -> any any (msg: "Allow http by AntreaNetworkPolicy:test-l7"; http.uri; content:"/index.html"; startswith; endswith; http.method; content:"GET"; http.host; content:"www.google.com"; startswith; endswith; sid: 2;)`,
+			expectedUpdatedRules: `pass http any any -> any any (msg: "Allow http by AntreaNetworkPolicy:test-l7"; sid: 2;)`,
+		},
+		{
+			name: "protocol HTTP and TLS with deny responses",
+			l7Protocols: []v1beta.L7Protocol{
+				{
+					HTTP: &v1beta.HTTPProtocol{
+						Path: "/public/*",
+					},
+				},
+				{
+					TLS: &v1beta.TLSProtocol{
+						SNI: "www.google.com",
+					},
+				},
+			},
+			updatedL7Protocols: []v1beta.L7Protocol{
+				{
+					HTTP: &v1beta.HTTPProtocol{},
+				},
+			},
+			l7DenyResponse: &v1beta.L7DenyResponse{
+				HTTP: &v1beta.HTTPDenyResponse{Body: "denied"},
+				TLS:  &v1beta.TLSDenyResponse{Alert: v1beta.TLSAlertAccessDenied},
+			},
+			expectedRules: `reject ip any any -> any any (msg: "Reject by AntreaNetworkPolicy:test-l7"; flow: to_server, established; app-layer-protocol: !http; app-layer-protocol: !tls; sid: 1;)
+drop http any any -> any any (msg: "Reject by AntreaNetworkPolicy:test-l7"; flow: to_server, established; sid: 2;)
+drop tls any any -> any any (msg: "Reject by AntreaNetworkPolicy:test-l7"; flow: to_server, established; sid: 3;)`,
+			expectedUpdatedRules: `pass http any any -> any any (msg: "Allow http by AntreaNetworkPolicy:test-l7"; sid: 4;)`,
+		},
+		{
+			name: "protocol gRPC",
+			l7Protocols: []v1beta.L7Protocol{
+				{
+					GRPC: &v1beta.GRPCProtocol{
+						Service: "helloworld.Greeter",
+						Method:  "SayHello",
+					},
+				},
+			},
+			updatedL7Protocols: []v1beta.L7Protocol{
+				{
+					GRPC: &v1beta.GRPCProtocol{},
+				},
+			},
+			expectedRules:        `pass http2 any any -> any any (msg: "Allow grpc by AntreaNetworkPolicy:test-l7"; http.request_header; content:"content-type|3a 20|application/grpc"; startswith; http.uri; content:"/helloworld.Greeter/SayHello"; startswith; endswith; sid: 2;)`,
+			expectedUpdatedRules: `pass http2 any any -> any any (msg: "Allow grpc by AntreaNetworkPolicy:test-l7"; http.request_header; content:"content-type|3a 20|application/grpc"; startswith; sid: 2;)`,
+		},
+		{
+			name: "protocol DNS",
+			l7Protocols: []v1beta.L7Protocol{
+				{
+					DNS: &v1beta.DNSProtocol{
+						QueryName: "www.example.com",
+					},
+				},
+			},
+			updatedL7Protocols: []v1beta.L7Protocol{
+				{
+					DNS: &v1beta.DNSProtocol{},
+				},
+			},
+			expectedRules: `reject dns any any -> any any (msg: "Reject by AntreaNetworkPolicy:test-l7"; flow: to_server; sid: 2;)
+pass dns any any -> any any (msg: "Allow dns by AntreaNetworkPolicy:test-l7"; dns.query; content:"www.example.com"; startswith; endswith; nocase; sid: 3;)`,
+			expectedUpdatedRules: `pass dns any any -> any any (msg: "Allow dns by AntreaNetworkPolicy:test-l7"; sid: 3;)`,
+		},
+		{
+			name: "protocol Kafka",
+			l7Protocols: []v1beta.L7Protocol{
+				{
+					Kafka: &v1beta.KafkaProtocol{
+						APIKey: "metadata",
+					},
+				},
+			},
+			updatedL7Protocols: []v1beta.L7Protocol{
+				{
+					Kafka: &v1beta.KafkaProtocol{},
+				},
+			},
+			expectedRules: `pass tcp any any -> any any (msg: "Allow kafka by AntreaNetworkPolicy:test-l7"; flow: to_server, established; byte_test: 2, =, 18, 4; sid: 2;)
+pass tcp any any -> any any (msg: "Allow kafka by AntreaNetworkPolicy:test-l7"; flow: to_server, established; byte_test: 2, =, 3, 4; sid: 3;)`,
+			expectedUpdatedRules: `pass tcp any any -> any any (msg: "Allow kafka by AntreaNetworkPolicy:test-l7"; flow: to_server, established; sid: 2;)
+pass tcp any any -> any any (msg: "Allow kafka by AntreaNetworkPolicy:test-l7"; flow: to_server, established; byte_test: 2, =, 18, 4; sid: 3;)
+pass tcp any any -> any any (msg: "Allow kafka by AntreaNetworkPolicy:test-l7"; flow: to_server, established; byte_test: 2, =, 3, 4; sid: 4;)`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			defaultFS = afero.NewMemMapFs()
+			defer func() {
+				defaultFS = afero.NewOsFs()
+			}()
+
+			_, err := defaultFS.Create(defaultSuricataConfigPath)
+			assert.NoError(t, err)
+
+			fe := NewSuricataEngine()
+			fs := newFakeSuricata()
+			fe.suricataScFn = fs.suricataScFunc
+			fe.startSuricataFn = fs.startSuricataFn
+			fe.listenEventsFn = func() {}
+
+			// Test add a L7 NetworkPolicy.
+			assert.NoError(t, fe.AddRule(ruleID, policyName, vlanID, tc.l7Protocols, tc.l7DenyResponse, false))
+
+			rulesPath := generateTenantRulesPath(vlanID)
+			ok, err := afero.FileContainsBytes(defaultFS, rulesPath, []byte(tc.expectedRules))
+			assert.NoError(t, err)
+			assert.True(t, ok)
+
+			configPath := generateTenantConfigPath(vlanID)
+			ok, err = afero.FileContainsBytes(defaultFS, configPath, []byte(rulesPath))
+			assert.NoError(t, err)
+			assert.True(t, ok)
+
+			expectedScCommands := sets.New[string]("register-tenant 1 /etc/suricata/antrea-tenant-1.yaml", "register-tenant-handler 1 vlan 1")
+			assert.True(t, fs.startSuricataFnCalled)
+			assert.Equal(t, expectedScCommands, fs.calledScCommands)
+
+			// Update the added L7 NetworkPolicy.
+			assert.NoError(t, fe.AddRule(ruleID, policyName, vlanID, tc.updatedL7Protocols, tc.l7DenyResponse, false))
+			expectedScCommands.Insert("reload-tenant 1 /etc/suricata/antrea-tenant-1.yaml")
+			assert.Equal(t, expectedScCommands, fs.calledScCommands)
+
+			// Delete the L7 NetworkPolicy.
+			assert.NoError(t, fe.DeleteRule(ruleID, vlanID))
+			expectedScCommands.Insert("unregister-tenant-handler 1 vlan 1", "unregister-tenant 1")
+			assert.Equal(t, expectedScCommands, fs.calledScCommands)
+
+			exists, err := afero.Exists(defaultFS, rulesPath)
+			assert.NoError(t, err)
+			assert.False(t, exists)
+
+			exists, err = afero.Exists(defaultFS, configPath)
+			assert.NoError(t, err)
+			assert.False(t, exists)
+		})
+	}
+}
@@ -33,6 +33,7 @@ import (
 	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/util/workqueue"
 	"k8s.io/klog/v2"
+	"k8s.io/utils/clock"
 
 	"antrea.io/antrea/pkg/agent"
 	"antrea.io/antrea/pkg/agent/config"
@@ -72,7 +73,7 @@ const (
 )
 
 type L7RuleReconciler interface {
-	AddRule(ruleID, policyName string, vlanID uint32, l7Protocols []v1beta2.L7Protocol, enableLogging bool) error
+	AddRule(ruleID, policyName string, vlanID uint32, l7Protocols []v1beta2.L7Protocol, l7DenyResponse *v1beta2.L7DenyResponse, enableLogging bool) error
 	DeleteRule(ruleID string, vlanID uint32) error
 }
 
@@ -105,6 +106,8 @@ type Controller struct {
 	antreaPolicyEnabled      bool
 	l7NetworkPolicyEnabled   bool
 	nodeNetworkPolicyEnabled bool
+	// auditModeEnabled indicates whether the rules of Antrea-native policies in Audit mode can be realized.
+	auditModeEnabled bool
 	// antreaProxyEnabled indicates whether Antrea proxy is enabled.
 	antreaProxyEnabled bool
 	// statusManagerEnabled indicates whether a statusManager is configured.
@@ -139,6 +142,9 @@ type Controller struct {
 	// ofClient registers packetin for Antrea Policy logging.
 	ofClient    openflow.Client
 	auditLogger *AuditLogger
+	// auditReport records the traffic which would have been denied by the rules of Antrea-native policies in
+	// Audit mode.
+	auditReport *auditReport
 	// statusManager syncs NetworkPolicy statuses with the antrea-controller.
 	// It's only for Antrea NetworkPolicies.
 	statusManager         StatusManager
@@ -179,6 +185,7 @@ func NewNetworkPolicyController(antreaClientGetter agent.AntreaClientProvider,
 	groupIDUpdates <-chan string,
 	antreaPolicyEnabled bool,
 	l7NetworkPolicyEnabled bool,
+	auditModeEnabled bool,
 	nodeNetworkPolicyEnabled bool,
 	antreaProxyEnabled bool,
 	statusManagerEnabled bool,
@@ -192,7 +199,7 @@ func NewNetworkPolicyController(antreaClientGetter agent.AntreaClientProvider,
 	gwPort, tunPort uint32,
 	nodeConfig *config.NodeConfig,
 	podNetworkWait *utilwait.Group,
-	l7Reconciler *l7engine.Reconciler) (*Controller, error) {
+	l7Engine l7engine.Engine) (*Controller, error) {
 	idAllocator := newIDAllocator(asyncRuleDeleteInterval, dnsInterceptRuleID)
 	c := &Controller{
 		antreaClientProvider:     antreaClientGetter,
@@ -201,6 +208,7 @@ func NewNetworkPolicyController(antreaClientGetter agent.AntreaClientProvider,
 		nodeType:                 nodeType,
 		antreaPolicyEnabled:      antreaPolicyEnabled,
 		l7NetworkPolicyEnabled:   l7NetworkPolicyEnabled,
+		auditModeEnabled:         auditModeEnabled,
 		nodeNetworkPolicyEnabled: nodeNetworkPolicyEnabled,
 		antreaProxyEnabled:       antreaProxyEnabled,
 		statusManagerEnabled:     statusManagerEnabled,
@@ -211,8 +219,11 @@ func NewNetworkPolicyController(antreaClientGetter agent.AntreaClientProvider,
 		podNetworkWait:           podNetworkWait.Increment(),
 	}
 
+	if auditModeEnabled {
+		c.auditReport = newAuditReport(clock.RealClock{}, maxAuditRecords)
+	}
 	if l7NetworkPolicyEnabled {
-		c.l7RuleReconciler = l7Reconciler
+		c.l7RuleReconciler = l7Engine
 		c.l7VlanIDAllocator = newL7VlanIDAllocator()
 	}
 
@@ -227,7 +238,7 @@ func NewNetworkPolicyController(antreaClientGetter agent.AntreaClientProvider,
 		}
 	}
 	c.podReconciler = newPodReconciler(ofClient, ifaceStore, idAllocator, c.fqdnController, groupCounters,
-		v4Enabled, v6Enabled, antreaPolicyEnabled, multicastEnabled)
+		v4Enabled, v6Enabled, antreaPolicyEnabled, multicastEnabled, antreaPolicyEnabled && auditModeEnabled)
 
 	if c.nodeNetworkPolicyEnabled {
 		c.nodeReconciler = newNodeReconciler(routeClient, v4Enabled, v6Enabled)
@@ -270,6 +281,10 @@ func NewNetworkPolicyController(antreaClientGetter agent.AntreaClientProvider,
 			}
 			c.auditLogger = auditLogger
 		}
+		if l7NetworkPolicyEnabled && l7Engine != nil {
+			// Send deny responses and log the layer 7 requests reported by the L7 engine.
+			l7Engine.RegisterEventHandler(c.handleL7Event)
+		}
 	}
 
 	// Use nodeName to filter resources when watching resources.
@@ -580,6 +595,13 @@ func (c *Controller) GetRuleByFlowID(ruleFlowID uint32) *types.PolicyRule {
 	return rule
 }
 
+func (c *Controller) GetAuditRecords() []types.AuditRecord {
+	if c.auditReport == nil {
+		return nil
+	}
+	return c.auditReport.getRecords()
+}
+
 func (c *Controller) GetControllerConnectionStatus() bool {
 	// When the watchers are connected, controller connection status is true. Otherwise, it is false.
 	return c.addressGroupWatcher.isConnected() && c.appliedToGroupWatcher.isConnected() && c.networkPolicyWatcher.isConnected()
@@ -782,13 +804,17 @@ func (c *Controller) syncRule(key string) error {
 		klog.Warningf("Feature gate NodeNetworkPolicy is not enabled, skipping ruleID %s", key)
 		return nil
 	}
+	if !c.auditModeEnabled && rule.isAuditModeRule() {
+		klog.Warningf("Feature gate AntreaPolicyAuditMode is not enabled, skipping ruleID %s", key)
+		return nil
+	}
 
 	if c.l7NetworkPolicyEnabled && len(rule.L7Protocols) != 0 {
 		// Allocate VLAN ID for the L7 rule.
 		vlanID := c.l7VlanIDAllocator.allocate(key)
 		rule.L7RuleVlanID = &vlanID
 
-		if err := c.l7RuleReconciler.AddRule(key, rule.SourceRef.ToString(), vlanID, rule.L7Protocols, rule.EnableLogging); err != nil {
+		if err := c.l7RuleReconciler.AddRule(key, rule.SourceRef.ToString(), vlanID, rule.L7Protocols, rule.L7DenyResponse, rule.EnableLogging); err != nil {
 			return err
 		}
 	}
@@ -838,12 +864,16 @@ func (c *Controller) syncRules(keys []string) error {
 				klog.Warningf("Feature gate NodeNetworkPolicy is not enabled, skipping ruleID %s", key)
 				continue
 			}
+			if !c.auditModeEnabled && rule.isAuditModeRule() {
+				klog.Warningf("Feature gate AntreaPolicyAuditMode is not enabled, skipping ruleID %s", key)
+				continue
+			}
 			if c.l7NetworkPolicyEnabled && len(rule.L7Protocols) != 0 {
 				// Allocate VLAN ID for the L7 rule.
 				vlanID := c.l7VlanIDAllocator.allocate(key)
 				rule.L7RuleVlanID = &vlanID
 
-				if err := c.l7RuleReconciler.AddRule(key, rule.SourceRef.ToString(), vlanID, rule.L7Protocols, rule.EnableLogging); err != nil {
+				if err := c.l7RuleReconciler.AddRule(key, rule.SourceRef.ToString(), vlanID, rule.L7Protocols, rule.L7DenyResponse, rule.EnableLogging); err != nil {
 					return err
 				}
 			}
@@ -54,13 +54,15 @@ import (
 const testNamespace = "ns1"
 
 var mockOFTables = map[*openflow.Table]uint8{
-	openflow.AntreaPolicyEgressRuleTable:  uint8(5),
-	openflow.EgressRuleTable:              uint8(6),
-	openflow.EgressDefaultTable:           uint8(7),
-	openflow.AntreaPolicyIngressRuleTable: uint8(12),
-	openflow.IngressRuleTable:             uint8(13),
-	openflow.IngressDefaultTable:          uint8(14),
-	openflow.OutputTable:                  uint8(28),
+	openflow.AntreaPolicyEgressAuditRuleTable:  uint8(4),
+	openflow.AntreaPolicyEgressRuleTable:       uint8(5),
+	openflow.EgressRuleTable:                   uint8(6),
+	openflow.EgressDefaultTable:                uint8(7),
+	openflow.AntreaPolicyIngressAuditRuleTable: uint8(11),
+	openflow.AntreaPolicyIngressRuleTable:      uint8(12),
+	openflow.IngressRuleTable:                  uint8(13),
+	openflow.IngressDefaultTable:               uint8(14),
+	openflow.OutputTable:                       uint8(28),
 }
 
 type antreaClientGetter struct {
@@ -78,7 +80,7 @@ func newTestController() (*Controller, *fake.Clientset, *mockReconciler) {
 	groupIDAllocator := openflow.NewGroupAllocator()
 	groupCounters := []proxytypes.GroupCounter{proxytypes.NewGroupCounter(groupIDAllocator, ch2)}
 	fs := afero.NewMemMapFs()
-	l7reconciler := l7engine.NewReconciler()
+	l7Engine := l7engine.NewSuricataEngine()
 	controller, _ := NewNetworkPolicyController(&antreaClientGetter{clientset},
 		nil,
 		nil,
@@ -91,6 +93,7 @@ func newTestController() (*Controller, *fake.Clientset, *mockReconciler) {
 		ch2,
 		true,
 		true,
+		true,
 		false,
 		true,
 		true,
@@ -105,7 +108,7 @@ func newTestController() (*Controller, *fake.Clientset, *mockReconciler) {
 		config.DefaultTunOFPort,
 		&config.NodeConfig{},
 		wait.NewGroup(),
-		l7reconciler)
+		l7Engine)
 	reconciler := newMockReconciler()
 	controller.podReconciler = reconciler
 	controller.auditLogger = nil
@@ -73,6 +73,15 @@ func getMatchRegField(matchers *ofctrl.Matchers, field *binding.RegField) *ofctr
 // getMatch receives ofctrl matchers and table id, match field.
 // Modifies match field to Ingress/Egress register based on tableID.
 func getMatch(matchers *ofctrl.Matchers, tableID uint8, disposition uint32) *ofctrl.MatchField {
+	// The rules of policies in Audit mode always load the conjunction ID to ingress/egress reg, regardless of disposition.
+	for _, table := range openflow.GetAntreaPolicyAuditTables() {
+		if table.IsInitialized() && tableID == table.GetID() {
+			if table == openflow.AntreaPolicyEgressAuditRuleTable {
+				return getMatchRegField(matchers, openflow.TFEgressConjIDField)
+			}
+			return getMatchRegField(matchers, openflow.TFIngressConjIDField)
+		}
+	}
 	// Get match from CNPDenyConjIDReg if disposition is Drop or Reject.
 	if disposition == openflow.DispositionDrop || disposition == openflow.DispositionRej {
 		return getMatchRegField(matchers, openflow.APConjIDField)
@@ -197,7 +206,7 @@ func (c *Controller) storeDenyConnection(pktIn *ofctrl.PacketIn) error {
 }
 
 func isAntreaPolicyIngressTable(tableID uint8) bool {
-	for _, table := range openflow.GetAntreaPolicyIngressTables() {
+	for _, table := range append(openflow.GetAntreaPolicyIngressTables(), openflow.AntreaPolicyIngressAuditRuleTable) {
 		if table.IsInitialized() && table.GetID() == tableID {
 			return true
 		}
@@ -206,7 +215,7 @@ func isAntreaPolicyIngressTable(tableID uint8) bool {
 }
 
 func isAntreaPolicyEgressTable(tableID uint8) bool {
-	for _, table := range openflow.GetAntreaPolicyEgressTables() {
+	for _, table := range append(openflow.GetAntreaPolicyEgressTables(), openflow.AntreaPolicyEgressAuditRuleTable) {
 		if table.IsInitialized() && table.GetID() == tableID {
 			return true
 		}
@@ -277,3 +286,12 @@ func getCTTpDstValue(matchers *ofctrl.Matchers) uint16 {
 	}
 	return portValue
 }
+
+func isAntreaPolicyAuditTable(tableID uint8) bool {
+	for _, table := range openflow.GetAntreaPolicyAuditTables() {
+		if table.IsInitialized() && table.GetID() == tableID {
+			return true
+		}
+	}
+	return false
+}
@@ -230,6 +230,8 @@ type podReconciler struct {
 
 	// multicastEnabled indicates whether multicast is enabled
 	multicastEnabled bool
+	// auditModeEnabled indicates whether the rules of Antrea-native policies in Audit mode can be realized.
+	auditModeEnabled bool
 }
 
 // newPodReconciler returns a new *podReconciler.
@@ -242,6 +244,7 @@ func newPodReconciler(ofClient openflow.Client,
 	v6Enabled bool,
 	antreaPolicyEnabled bool,
 	multicastEnabled bool,
+	auditModeEnabled bool,
 ) *podReconciler {
 	priorityAssigners := map[uint8]*tablePriorityAssigner{}
 	if antreaPolicyEnabled {
@@ -255,6 +258,13 @@ func newPodReconciler(ofClient openflow.Client,
 				assigner: newPriorityAssigner(false),
 			}
 		}
+		if auditModeEnabled {
+			for _, table := range openflow.GetAntreaPolicyAuditTables() {
+				priorityAssigners[table.GetID()] = &tablePriorityAssigner{
+					assigner: newPriorityAssigner(false),
+				}
+			}
+		}
 		if multicastEnabled {
 			for _, table := range openflow.GetAntreaMulticastEgressTables() {
 				priorityAssigners[table.GetID()] = &tablePriorityAssigner{
@@ -277,6 +287,7 @@ func newPodReconciler(ofClient openflow.Client,
 		fqdnController:    fqdnController,
 		groupCounters:     groupCounters,
 		multicastEnabled:  multicastEnabled,
+		auditModeEnabled:  auditModeEnabled,
 	}
 	// Check if ofClient is nil or not to be compatible with unit tests.
 	if ofClient != nil {
@@ -361,6 +372,14 @@ func (r *podReconciler) getOFRuleTable(rule *CompletedRule) uint8 {
 			}
 			return openflow.EgressRuleTable.GetID()
 		}
+		if rule.isAuditModeRule() && r.auditModeEnabled {
+			// The rules of policies in Audit mode are evaluated in dedicated tables regardless of their Tier.
+			auditTables := openflow.GetAntreaPolicyAuditTables()
+			if rule.Direction == v1beta2.DirectionIn {
+				return auditTables[1].GetID()
+			}
+			return auditTables[0].GetID()
+		}
 		if rule.Direction == v1beta2.DirectionIn {
 			ruleTables = openflow.GetAntreaPolicyIngressTables()
 		} else {
@@ -540,6 +559,7 @@ func (r *podReconciler) computeOFRulesForAdd(rule *CompletedRule, ofPriority *ui
 			PolicyRef:     rule.SourceRef,
 			EnableLogging: rule.EnableLogging,
 			LogLabel:      rule.LogLabel,
+			RateLimit:     rule.RateLimit,
 		}
 		return ofRuleByServicesMap, lastRealized
 	} else if isIGMP {
@@ -583,6 +603,7 @@ func (r *podReconciler) computeOFRulesForAdd(rule *CompletedRule, ofPriority *ui
 				PolicyRef:     rule.SourceRef,
 				EnableLogging: rule.EnableLogging,
 				LogLabel:      rule.LogLabel,
+				RateLimit:     rule.RateLimit,
 			}
 		}
 	} else {
@@ -614,6 +635,7 @@ func (r *podReconciler) computeOFRulesForAdd(rule *CompletedRule, ofPriority *ui
 				PolicyRef:     rule.SourceRef,
 				EnableLogging: rule.EnableLogging,
 				LogLabel:      rule.LogLabel,
+				RateLimit:     rule.RateLimit,
 			}
 		}
 
@@ -639,6 +661,7 @@ func (r *podReconciler) computeOFRulesForAdd(rule *CompletedRule, ofPriority *ui
 					PolicyRef:     rule.SourceRef,
 					EnableLogging: rule.EnableLogging,
 					LogLabel:      rule.LogLabel,
+					RateLimit:     rule.RateLimit,
 				}
 				ofRuleByServicesMap[svcKey] = ofRule
 			}
@@ -739,6 +762,7 @@ func (r *podReconciler) update(lastRealized *podPolicyLastRealized, newRule *Com
 				PolicyRef:     newRule.SourceRef,
 				EnableLogging: newRule.EnableLogging,
 				LogLabel:      newRule.LogLabel,
+				RateLimit:     newRule.RateLimit,
 			}
 			err := r.idAllocator.allocateForRule(ofRule)
 			if err != nil {
@@ -797,6 +821,7 @@ func (r *podReconciler) update(lastRealized *podPolicyLastRealized, newRule *Com
 					PolicyRef:     newRule.SourceRef,
 					EnableLogging: newRule.EnableLogging,
 					LogLabel:      newRule.LogLabel,
+					RateLimit:     newRule.RateLimit,
 				}
 				err := r.idAllocator.allocateForRule(ofRule)
 				if err != nil {
@@ -869,6 +894,7 @@ func (r *podReconciler) update(lastRealized *podPolicyLastRealized, newRule *Com
 					PolicyRef:     newRule.SourceRef,
 					EnableLogging: newRule.EnableLogging,
 					LogLabel:      newRule.LogLabel,
+					RateLimit:     newRule.RateLimit,
 				}
 				// If the PolicyRule for the original services doesn't exist and IPBlocks is present, it means the
 				// podReconciler hasn't installed flows for IPBlocks, then it must be added to the new PolicyRule.
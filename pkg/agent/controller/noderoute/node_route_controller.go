@@ -15,6 +15,7 @@
 package noderoute
 
 import (
+	"crypto/x509"
 	"fmt"
 	"net"
 	"time"
@@ -23,6 +24,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/apiserver/pkg/server/dynamiccertificates"
 	coreinformers "k8s.io/client-go/informers/core/v1"
 	corelisters "k8s.io/client-go/listers/core/v1"
 	"k8s.io/client-go/tools/cache"
@@ -80,6 +82,9 @@ type Controller struct {
 	// or not when IPsec is enabled with "cert" mode. The NodeRouteController must wait for the certificate
 	// to be configured before installing routes/flows to peer Nodes to prevent unencrypted traffic across Nodes.
 	ipsecCertificateManager ipseccertificate.Manager
+	// wireGuardCAProvider provides the CA certificate used to verify the WireGuard public keys of peer Nodes.
+	// It is nil if the WireGuard public keys of peer Nodes need not be verified.
+	wireGuardCAProvider dynamiccertificates.CAContentProvider
 }
 
 // NewNodeRouteController instantiates a new Controller object which will process Node events
@@ -95,6 +100,7 @@ func NewNodeRouteController(
 	nodeConfig *config.NodeConfig,
 	wireguardClient wireguard.Interface,
 	ipsecCertificateManager ipseccertificate.Manager,
+	wireGuardCAProvider dynamiccertificates.CAContentProvider,
 ) *Controller {
 	controller := &Controller{
 		ovsBridgeClient:         ovsBridgeClient,
@@ -111,6 +117,7 @@ func NewNodeRouteController(
 		installedNodes:          cache.NewIndexer(nodeRouteInfoKeyFunc, cache.Indexers{nodeRouteInfoPodCIDRIndexName: nodeRouteInfoPodCIDRIndexFunc}),
 		wireGuardClient:         wireguardClient,
 		ipsecCertificateManager: ipsecCertificateManager,
+		wireGuardCAProvider:     wireGuardCAProvider,
 	}
 	nodeInformer.Informer().AddEventHandlerWithResyncPeriod(
 		cache.ResourceEventHandlerFuncs{
@@ -311,13 +318,43 @@ func (c *Controller) removeStaleWireGuardPeers() error {
 	}
 	currentPeerPublicKeys := make(map[string]string)
 	for _, n := range nodes {
-		if pubkey, ok := n.Annotations[types.NodeWireGuardPublicAnnotationKey]; ok {
+		if n.Name == c.nodeConfig.Name {
+			continue
+		}
+		pubkey, err := c.peerWireGuardPublicKey(n)
+		if err != nil {
+			klog.ErrorS(err, "Failed to get WireGuard public key of Node", "node", n.Name)
+			continue
+		}
+		if pubkey != "" {
 			currentPeerPublicKeys[n.Name] = pubkey
 		}
 	}
 	return c.wireGuardClient.RemoveStalePeers(currentPeerPublicKeys)
 }
 
+// peerWireGuardPublicKey returns the WireGuard public key to use for the peer Node, which must be certified by
+// antrea-controller if wireGuardCAProvider is set. If the peer Node has announced a next public key, the Node is
+// requeued at the activation time of the key.
+func (c *Controller) peerWireGuardPublicKey(node *corev1.Node) (string, error) {
+	var roots *x509.CertPool
+	if c.wireGuardCAProvider != nil {
+		verifyOptions, ok := c.wireGuardCAProvider.VerifyOptions()
+		if !ok {
+			return "", fmt.Errorf("WireGuard CA certificate is not available")
+		}
+		roots = verifyOptions.Roots
+	}
+	publicKey, nextActivationTime, err := wireguard.PeerPublicKey(node, roots, time.Now())
+	if err != nil {
+		return "", err
+	}
+	if !nextActivationTime.IsZero() {
+		c.queue.AddAfter(node.Name, time.Until(nextActivationTime))
+	}
+	return publicKey, nil
+}
+
 // Run will create defaultWorkers workers (go routines) which will process the Node events from the
 // workqueue.
 func (c *Controller) Run(stopCh <-chan struct{}) {
@@ -480,7 +517,13 @@ func (c *Controller) addNodeRoute(nodeName string, node *corev1.Node) error {
 		klog.ErrorS(err, "Failed to retrieve Node IP addresses", "node", node.Name)
 		return err
 	}
-	peerWireGuardPublicKey := node.Annotations[types.NodeWireGuardPublicAnnotationKey]
+	var peerWireGuardPublicKey string
+	if c.networkConfig.TrafficEncryptionMode == config.TrafficEncryptionModeWireGuard {
+		peerWireGuardPublicKey, err = c.peerWireGuardPublicKey(node)
+		if err != nil {
+			return fmt.Errorf("error when retrieving WireGuard public key of Node %s: %w", nodeName, err)
+		}
+	}
 
 	nrInfo, installed, _ := c.installedNodes.GetByKey(nodeName)
 	// Route is already added for this Node and Node MAC, transport IP
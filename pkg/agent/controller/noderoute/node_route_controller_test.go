@@ -16,6 +16,7 @@ package noderoute
 
 import (
 	"context"
+	"crypto/x509"
 	"net"
 	"testing"
 	"time"
@@ -27,6 +28,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/server/dynamiccertificates"
 	"k8s.io/client-go/informers"
 	"k8s.io/client-go/kubernetes/fake"
 
@@ -109,7 +111,7 @@ func newController(t *testing.T, networkConfig *config.NetworkConfig, objects ..
 	c := NewNodeRouteController(informerFactory.Core().V1().Nodes(), ofClient, ovsCtlClient, ovsClient, routeClient, interfaceStore, networkConfig, &config.NodeConfig{GatewayConfig: &config.GatewayConfig{
 		IPv4: nil,
 		MAC:  gatewayMAC,
-	}}, wireguardClient, ipsecCertificateManager)
+	}}, wireguardClient, ipsecCertificateManager, nil)
 	return &fakeController{
 		Controller:      c,
 		clientset:       clientset,
@@ -656,6 +658,54 @@ func TestRemoveStaleWireGuardPeers(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+// fakeCAProvider is a dynamiccertificates.CAContentProvider whose CA certificate is never available.
+type fakeCAProvider struct{}
+
+func (f *fakeCAProvider) Name() string {
+	return "fake"
+}
+
+func (f *fakeCAProvider) CurrentCABundleContent() []byte {
+	return nil
+}
+
+func (f *fakeCAProvider) VerifyOptions() (x509.VerifyOptions, bool) {
+	return x509.VerifyOptions{}, false
+}
+
+func (f *fakeCAProvider) AddListener(dynamiccertificates.Listener) {}
+
+func TestRemoveStaleWireGuardPeersWithKeyRotation(t *testing.T) {
+	nodeWithNextKey := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "nodeWithNextKey",
+			Annotations: map[string]string{
+				types.NodeWireGuardPublicAnnotationKey:                      "fakekey",
+				types.NodeWireGuardNextPublicKeyAnnotationKey:               "fakenextkey",
+				types.NodeWireGuardNextPublicKeyActivationTimeAnnotationKey: time.Now().Add(-time.Minute).Format(time.RFC3339),
+			},
+		},
+	}
+	c := newController(t, &config.NetworkConfig{
+		TrafficEncryptionMode: config.TrafficEncryptionModeWireGuard,
+	}, nodeWithNextKey)
+	defer c.queue.ShutDown()
+
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	c.informerFactory.Start(stopCh)
+	c.informerFactory.WaitForCacheSync(stopCh)
+
+	// The next key takes effect once its activation time has passed.
+	c.wireguardClient.EXPECT().RemoveStalePeers(map[string]string{nodeWithNextKey.Name: "fakenextkey"})
+	assert.NoError(t, c.removeStaleWireGuardPeers())
+
+	// Peers whose public keys cannot be verified are removed.
+	c.wireGuardCAProvider = &fakeCAProvider{}
+	c.wireguardClient.EXPECT().RemoveStalePeers(map[string]string{})
+	assert.NoError(t, c.removeStaleWireGuardPeers())
+}
+
 func TestDeleteNodeRoute(t *testing.T) {
 	nodeWithWireGuard := node1.DeepCopy()
 	nodeWithWireGuard.Name = "nodeWithWireGuard"
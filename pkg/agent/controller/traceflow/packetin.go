@@ -30,6 +30,8 @@ import (
 	"k8s.io/klog/v2"
 	"k8s.io/utils/pointer"
 
+	"antrea.io/antrea/pkg/agent/config"
+	"antrea.io/antrea/pkg/agent/interfacestore"
 	"antrea.io/antrea/pkg/agent/openflow"
 	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
 	binding "antrea.io/antrea/pkg/ovs/openflow"
@@ -277,62 +279,73 @@ func (c *Controller) parsePacketIn(pktIn *ofctrl.PacketIn) (*crdv1beta1.Traceflo
 				return nil, nil, nil, err
 			}
 		}
-		gatewayIP := c.nodeConfig.GatewayConfig.IPv4
-		if etherData.Ethertype == protocol.IPv6_MSG {
-			gatewayIP = c.nodeConfig.GatewayConfig.IPv6
-		}
-		gwPort := c.nodeConfig.GatewayConfig.OFPort
-		tunPort := c.nodeConfig.TunnelOFPort
-		if c.networkConfig.TrafficEncapMode.SupportsEncap() && outputPort == tunPort {
-			var isRemoteEgress uint32
-			if match := getMatchRegField(matchers, openflow.RemoteSNATRegMark.GetField()); match != nil {
-				isRemoteEgress, err = getRegValue(match, openflow.RemoteSNATRegMark.GetField().GetRange().ToNXRange())
-				if err != nil {
-					return nil, nil, nil, err
-				}
+		if c.nodeConfig.Type == config.ExternalNode {
+			// There is no gateway or tunnel on an ExternalNode. The packet is delivered if it is
+			// output to the paired host internal port of an ExternalEntity, and is forwarded if it
+			// is output to the uplink.
+			if iface, ok := c.interfaceStore.GetInterfaceByOFPort(outputPort); ok && iface.Type == interfacestore.ExternalEntityInterface {
+				ob.Action = crdv1beta1.ActionDelivered
+			} else {
+				ob.Action = crdv1beta1.ActionForwarded
 			}
-			if isRemoteEgress == 1 { // an Egress packet, currently on source Node and forwarded to Egress Node.
-				egressName, egressIP, egressNode, err := c.egressQuerier.GetEgress(ns, srcPod)
-				if err != nil {
-					return nil, nil, nil, err
-				}
-				obEgress := getEgressObservation(false, egressIP, egressName, egressNode)
-				obs = append(obs, *obEgress)
+		} else {
+			gatewayIP := c.nodeConfig.GatewayConfig.IPv4
+			if etherData.Ethertype == protocol.IPv6_MSG {
+				gatewayIP = c.nodeConfig.GatewayConfig.IPv6
 			}
-			ob.TunnelDstIP = tunnelDstIP
-			ob.Action = crdv1beta1.ActionForwarded
-		} else if ipDst == gatewayIP.String() && outputPort == gwPort {
-			ob.Action = crdv1beta1.ActionDelivered
-		} else if c.networkConfig.TrafficEncapMode.SupportsEncap() && outputPort == gwPort {
-			var pktMark uint32
-			if match := getMatchPktMarkField(matchers); match != nil {
-				pktMark, err = getMarkValue(match)
-				if err != nil {
-					return nil, nil, nil, err
+			gwPort := c.nodeConfig.GatewayConfig.OFPort
+			tunPort := c.nodeConfig.TunnelOFPort
+			if c.networkConfig.TrafficEncapMode.SupportsEncap() && outputPort == tunPort {
+				var isRemoteEgress uint32
+				if match := getMatchRegField(matchers, openflow.RemoteSNATRegMark.GetField()); match != nil {
+					isRemoteEgress, err = getRegValue(match, openflow.RemoteSNATRegMark.GetField().GetRange().ToNXRange())
+					if err != nil {
+						return nil, nil, nil, err
+					}
 				}
-			}
-			if pktMark != 0 { // Egress packet on Egress Node
-				egressName, egressIP, egressNode := "", "", ""
-				if tunnelDstIP == "" { // Egress Node is Source Node of this Egress packet
-					egressName, egressIP, egressNode, err = c.egressQuerier.GetEgress(ns, srcPod)
+				if isRemoteEgress == 1 { // an Egress packet, currently on source Node and forwarded to Egress Node.
+					egressName, egressIP, egressNode, err := c.egressQuerier.GetEgress(ns, srcPod)
 					if err != nil {
 						return nil, nil, nil, err
 					}
-				} else {
-					egressIP, err = c.egressQuerier.GetEgressIPByMark(pktMark)
+					obEgress := getEgressObservation(false, egressIP, egressName, egressNode)
+					obs = append(obs, *obEgress)
+				}
+				ob.TunnelDstIP = tunnelDstIP
+				ob.Action = crdv1beta1.ActionForwarded
+			} else if ipDst == gatewayIP.String() && outputPort == gwPort {
+				ob.Action = crdv1beta1.ActionDelivered
+			} else if c.networkConfig.TrafficEncapMode.SupportsEncap() && outputPort == gwPort {
+				var pktMark uint32
+				if match := getMatchPktMarkField(matchers); match != nil {
+					pktMark, err = getMarkValue(match)
 					if err != nil {
 						return nil, nil, nil, err
 					}
 				}
-				obEgress := getEgressObservation(true, egressIP, egressName, egressNode)
-				obs = append(obs, *obEgress)
+				if pktMark != 0 { // Egress packet on Egress Node
+					egressName, egressIP, egressNode := "", "", ""
+					if tunnelDstIP == "" { // Egress Node is Source Node of this Egress packet
+						egressName, egressIP, egressNode, err = c.egressQuerier.GetEgress(ns, srcPod)
+						if err != nil {
+							return nil, nil, nil, err
+						}
+					} else {
+						egressIP, err = c.egressQuerier.GetEgressIPByMark(pktMark)
+						if err != nil {
+							return nil, nil, nil, err
+						}
+					}
+					obEgress := getEgressObservation(true, egressIP, egressName, egressNode)
+					obs = append(obs, *obEgress)
+				}
+				ob.Action = crdv1beta1.ActionForwardedOutOfOverlay
+			} else if outputPort == gwPort { // noEncap
+				ob.Action = crdv1beta1.ActionForwarded
+			} else {
+				// Output port is Pod port, packet is delivered.
+				ob.Action = crdv1beta1.ActionDelivered
 			}
-			ob.Action = crdv1beta1.ActionForwardedOutOfOverlay
-		} else if outputPort == gwPort { // noEncap
-			ob.Action = crdv1beta1.ActionForwarded
-		} else {
-			// Output port is Pod port, packet is delivered.
-			ob.Action = crdv1beta1.ActionDelivered
 		}
 		ob.ComponentInfo = openflow.OutputTable.GetName()
 		ob.Component = crdv1beta1.ComponentForwarding
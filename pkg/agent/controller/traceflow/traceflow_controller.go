@@ -308,20 +308,31 @@ func (c *Controller) startTraceflow(tf *crdv1beta1.Traceflow) error {
 	}
 
 	receiverOnly := false
-	var pod, ns string
-	if tf.Spec.Source.Pod != "" {
+	var pod, externalEntity, ns string
+	if tf.Spec.Source.Pod != "" || tf.Spec.Source.ExternalEntity != "" {
 		pod = tf.Spec.Source.Pod
+		externalEntity = tf.Spec.Source.ExternalEntity
 		ns = tf.Spec.Source.Namespace
 	} else {
-		// Live-traffic Traceflow with only the Destination Pod specified.
+		// Live-traffic Traceflow with only the Destination Pod or ExternalEntity specified.
 		pod = tf.Spec.Destination.Pod
+		externalEntity = tf.Spec.Destination.ExternalEntity
 		ns = tf.Spec.Destination.Namespace
 		receiverOnly = true
 	}
 
 	// TODO: let controller compute the sender/receiver Node, and the sender
 	// /receiver Node can just return an error, if fails to find the Pod.
-	podInterfaces := c.interfaceStore.GetContainerInterfacesByPod(pod, ns)
+	var podInterfaces []*interfacestore.InterfaceConfig
+	// The source Pod of a linked Traceflow is in another member cluster, where the
+	// packet is injected, so all Nodes just trace the packet with the data plane tag.
+	if tf.Spec.Source.ClusterID == "" {
+		if externalEntity != "" {
+			podInterfaces = c.interfaceStore.GetInterfacesByEntity(externalEntity, ns)
+		} else {
+			podInterfaces = c.interfaceStore.GetContainerInterfacesByPod(pod, ns)
+		}
+	}
 	isSender := len(podInterfaces) > 0 && !receiverOnly
 
 	liveTraffic := tf.Spec.LiveTraffic
@@ -381,7 +392,12 @@ func (c *Controller) startTraceflow(tf *crdv1beta1.Traceflow) error {
 }
 
 func (c *Controller) validateTraceflow(tf *crdv1beta1.Traceflow) error {
-	if tf.Spec.Destination.Service != "" && !c.enableAntreaProxy {
+	if c.nodeConfig.Type == config.ExternalNode && !tf.Spec.LiveTraffic {
+		return errors.New("only live-traffic Traceflow is supported on ExternalNode")
+	}
+	// The destination Service of a cross-cluster Traceflow is resolved in the
+	// destination member cluster.
+	if tf.Spec.Destination.Service != "" && tf.Spec.Destination.ClusterID == "" && !c.enableAntreaProxy {
 		return errors.New("using Service destination requires AntreaProxy enabled")
 	}
 	if tf.Spec.Destination.IP != "" {
@@ -423,8 +439,39 @@ func (c *Controller) preparePacket(tf *crdv1beta1.Traceflow, intf *interfacestor
 				return nil, errors.New("source IP does not match the IP header family")
 			}
 		}
-		// The packet will be matched with the Pod MAC.
-		packet.DestinationMAC = intf.MAC
+		if intf.Type == interfacestore.ExternalEntityInterface {
+			// The packet will be matched with the ExternalEntity IP, as the host interface
+			// shares the MAC with the uplink.
+			if packet.IsIPv6 {
+				packet.DestinationIP = intf.GetIPv6Addr()
+			} else {
+				packet.DestinationIP = intf.GetIPv4Addr()
+			}
+			if packet.DestinationIP == nil {
+				if packet.IsIPv6 {
+					return nil, errors.New("destination ExternalEntity does not have an IPv6 address")
+				}
+				return nil, errors.New("destination ExternalEntity does not have an IPv4 address")
+			}
+		} else {
+			// The packet will be matched with the Pod MAC.
+			packet.DestinationMAC = intf.MAC
+		}
+	} else if tf.Spec.Destination.ClusterID != "" {
+		// The destination of a cross-cluster Traceflow is resolved by Antrea
+		// Multi-cluster in the destination member cluster. The packet is sent to
+		// the gateway and forwarded to the Multi-cluster Gateway.
+		if tf.Status.DestinationIP == "" {
+			return nil, errors.New("destination IP is not resolved in the destination member cluster")
+		}
+		packet.DestinationIP = net.ParseIP(tf.Status.DestinationIP)
+		if packet.DestinationIP == nil {
+			return nil, errors.New("invalid destination IP address")
+		}
+		isIPv6 := packet.DestinationIP.To4() == nil
+		if isIPv6 != packet.IsIPv6 {
+			return nil, errors.New("destination IP does not match the IP header family")
+		}
 	} else if tf.Spec.Destination.IP != "" {
 		packet.DestinationIP = net.ParseIP(tf.Spec.Destination.IP)
 		if packet.DestinationIP == nil {
@@ -474,6 +521,37 @@ func (c *Controller) preparePacket(tf *crdv1beta1.Traceflow, intf *interfacestor
 			}
 			return nil, errors.New("destination Pod does not have an IPv4 address")
 		}
+	} else if tf.Spec.Destination.ExternalEntity != "" {
+		dstInterfaces := c.interfaceStore.GetInterfacesByEntity(tf.Spec.Destination.ExternalEntity, tf.Spec.Destination.Namespace)
+		if len(dstInterfaces) > 0 {
+			if packet.IsIPv6 {
+				packet.DestinationIP = dstInterfaces[0].GetIPv6Addr()
+			} else {
+				packet.DestinationIP = dstInterfaces[0].GetIPv4Addr()
+			}
+		} else {
+			dstEntity, err := c.crdClient.CrdV1alpha2().ExternalEntities(tf.Spec.Destination.Namespace).Get(context.TODO(), tf.Spec.Destination.ExternalEntity, metav1.GetOptions{})
+			if err != nil {
+				return nil, fmt.Errorf("failed to get the destination ExternalEntity: %v", err)
+			}
+			entityIPs := make([]net.IP, 0, len(dstEntity.Spec.Endpoints))
+			for _, ep := range dstEntity.Spec.Endpoints {
+				if ip := net.ParseIP(ep.IP); ip != nil {
+					entityIPs = append(entityIPs, ip)
+				}
+			}
+			if packet.IsIPv6 {
+				packet.DestinationIP, _ = util.GetIPWithFamily(entityIPs, util.FamilyIPv6)
+			} else {
+				packet.DestinationIP = util.GetIPv4Addr(entityIPs)
+			}
+		}
+		if packet.DestinationIP == nil {
+			if packet.IsIPv6 {
+				return nil, errors.New("destination ExternalEntity does not have an IPv6 address")
+			}
+			return nil, errors.New("destination ExternalEntity does not have an IPv4 address")
+		}
 	} else if tf.Spec.Destination.Service != "" {
 		dstSvc, err := c.serviceLister.Services(tf.Spec.Destination.Namespace).Get(tf.Spec.Destination.Service)
 		if err != nil {
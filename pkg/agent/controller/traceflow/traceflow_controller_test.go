@@ -107,6 +107,9 @@ func newFakeTraceflowController(t *testing.T, initObjects []runtime.Object, netw
 	addPodInterface(ifaceStore, pod2.Namespace, pod2.Name, pod2IPv4, pod2MAC.String(), int32(ofPortPod2))
 
 	_, serviceCIDRNet, _ := net.ParseCIDR("10.96.0.0/12")
+	if nodeConfig == nil {
+		nodeConfig = &config.NodeConfig{}
+	}
 
 	tfController := &Controller{
 		kubeClient:            kubeClient,
@@ -209,6 +212,34 @@ func TestPreparePacket(t *testing.T) {
 				DestinationMAC: pod1MAC,
 			},
 		},
+		{
+			name: "receive only to destination ExternalEntity in live traffic traceflow",
+			tf: &crdv1beta1.Traceflow{
+				ObjectMeta: metav1.ObjectMeta{Name: "tf3", UID: "uid3"},
+				Spec: crdv1beta1.TraceflowSpec{
+					Destination: crdv1beta1.Destination{
+						Namespace:      "vm-ns",
+						ExternalEntity: "vm1-2cbd4",
+					},
+					LiveTraffic: true,
+					Packet:      crdv1beta1.Packet{IPHeader: &crdv1beta1.IPHeader{}},
+				},
+			},
+			intf: &interfacestore.InterfaceConfig{
+				Type:          interfacestore.ExternalEntityInterface,
+				InterfaceName: "eth1",
+				IPs:           []net.IP{net.ParseIP("10.10.0.2")},
+				OVSPortConfig: &interfacestore.OVSPortConfig{OFPort: 2},
+				EntityInterfaceConfig: &interfacestore.EntityInterfaceConfig{
+					EntityName:      "vm1-2cbd4",
+					EntityNamespace: "vm-ns",
+				},
+			},
+			receiverOnly: true,
+			expectedPacket: &binding.Packet{
+				DestinationIP: net.ParseIP("10.10.0.2"),
+			},
+		},
 		{
 			name: "tcp packet",
 			tf: &crdv1beta1.Traceflow{
@@ -884,6 +915,7 @@ func TestValidateTraceflow(t *testing.T) {
 		name               string
 		tf                 *crdv1beta1.Traceflow
 		antreaProxyEnabled bool
+		nodeType           config.NodeType
 		expectedErr        string
 	}{
 		{
@@ -908,11 +940,24 @@ func TestValidateTraceflow(t *testing.T) {
 			},
 			expectedErr: "using ClusterIP destination requires AntreaProxy enabled",
 		},
+		{
+			name:     "non-live-traffic Traceflow on ExternalNode",
+			nodeType: config.ExternalNode,
+			tf: &crdv1beta1.Traceflow{
+				Spec: crdv1beta1.TraceflowSpec{
+					Source: crdv1beta1.Source{
+						Namespace:      "vm-ns",
+						ExternalEntity: "vm1-2cbd4",
+					},
+				},
+			},
+			expectedErr: "only live-traffic Traceflow is supported on ExternalNode",
+		},
 	}
 
 	for _, tt := range tcs {
 		t.Run(tt.name, func(t *testing.T) {
-			tfc := newFakeTraceflowController(t, []runtime.Object{tt.tf}, nil, nil)
+			tfc := newFakeTraceflowController(t, []runtime.Object{tt.tf}, nil, &config.NodeConfig{Type: tt.nodeType})
 			tfc.enableAntreaProxy = tt.antreaProxyEnabled
 			err := tfc.validateTraceflow(tt.tf)
 			assert.ErrorContains(t, err, tt.expectedErr)
@@ -0,0 +1,369 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package wireguardkey
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	certificatesv1 "k8s.io/api/certificates/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apitypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	clientset "k8s.io/client-go/kubernetes"
+	certutil "k8s.io/client-go/util/cert"
+	csrutil "k8s.io/client-go/util/certificate/csr"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/clock"
+
+	"antrea.io/antrea/pkg/agent/config"
+	"antrea.io/antrea/pkg/agent/types"
+	"antrea.io/antrea/pkg/agent/wireguard"
+	antreaapis "antrea.io/antrea/pkg/apis"
+	wgutil "antrea.io/antrea/pkg/util/wireguard"
+)
+
+const (
+	controllerName = "AntreaAgentWireGuardKeyController"
+	workerItemKey  = "key"
+	minRetryDelay  = 5 * time.Second
+	maxRetryDelay  = 60 * time.Second
+
+	// certificateWaitTimeout controls the amount of time we wait for certificate approval in
+	// one iteration.
+	certificateWaitTimeout = 15 * time.Minute
+	// certificateRenewalRatio is the fraction of the certificate lifetime after which the
+	// certificate is renewed.
+	certificateRenewalRatio = 0.8
+	// minCertificateLifetime is the minimum lifetime which can be requested for a certificate by the
+	// CertificateSigningRequest API.
+	minCertificateLifetime = 10 * time.Minute
+)
+
+// Controller is responsible for rotating the WireGuard key of the Node periodically, and for requesting
+// certificates for the WireGuard public keys of the Node by CertificateSigningRequest.
+//
+// A key rotation happens in two steps: the next public key is first announced in the Node annotations along with
+// its activation time, then the WireGuard device switches to the next key at the activation time, and the next key
+// replaces the current one in the Node annotations. Peer Nodes switch to the next public key at the activation time
+// as well, so the overlap between the two steps must be long enough for all peer Nodes to learn about the next key.
+type Controller struct {
+	kubeClient      clientset.Interface
+	wireGuardClient wireguard.Interface
+	nodeName        string
+	queue           workqueue.RateLimitingInterface
+
+	keyRotationInterval time.Duration
+	keyRotationOverlap  time.Duration
+	certifyPublicKeys   bool
+
+	requestCertificate func(publicKey string) ([]byte, error)
+
+	clock clock.WithTicker
+
+	// lastRotationTime is the time at which the current key took effect. It's restored from the Node annotations
+	// by the first sync, so that restarting antrea-agent doesn't postpone the rotation.
+	lastRotationTime time.Time
+	// nextPublicKey is the announced next public key whose private key is held by wireGuardClient.
+	nextPublicKey string
+}
+
+func NewWireGuardKeyController(
+	kubeClient clientset.Interface,
+	wireGuardClient wireguard.Interface,
+	nodeName string,
+	wireGuardConfig *config.WireGuardConfig,
+) *Controller {
+	return newWireGuardKeyControllerWithCustomClock(kubeClient, wireGuardClient, nodeName, wireGuardConfig, clock.RealClock{})
+}
+
+func newWireGuardKeyControllerWithCustomClock(
+	kubeClient clientset.Interface,
+	wireGuardClient wireguard.Interface,
+	nodeName string,
+	wireGuardConfig *config.WireGuardConfig,
+	clock clock.WithTicker,
+) *Controller {
+	controller := &Controller{
+		kubeClient:      kubeClient,
+		wireGuardClient: wireGuardClient,
+		nodeName:        nodeName,
+		queue: workqueue.NewRateLimitingQueueWithDelayingInterface(workqueue.NewDelayingQueueWithCustomClock(clock, "WireGuardKeyController"),
+			workqueue.NewItemExponentialFailureRateLimiter(minRetryDelay, maxRetryDelay)),
+		keyRotationInterval: wireGuardConfig.KeyRotationInterval,
+		keyRotationOverlap:  wireGuardConfig.KeyRotationOverlap,
+		certifyPublicKeys:   wireGuardConfig.VerifyPeerPublicKeys,
+		clock:               clock,
+	}
+	controller.requestCertificate = controller.newCertificate
+	return controller
+}
+
+// worker is a long-running function that will continually call the processNextWorkItem function in
+// order to read and process a message on the workqueue.
+func (c *Controller) worker() {
+	for c.processNextWorkItem() {
+	}
+}
+
+func (c *Controller) processNextWorkItem() bool {
+	obj, quit := c.queue.Get()
+	if quit {
+		return false
+	}
+	defer c.queue.Done(obj)
+	if key, ok := obj.(string); !ok {
+		c.queue.Forget(obj)
+		klog.ErrorS(nil, "Unexpected object in work queue", "object", obj)
+		return true
+	} else if err := c.syncKey(); err == nil {
+		c.queue.Forget(key)
+	} else {
+		c.queue.AddRateLimited(key)
+		klog.ErrorS(err, "Error syncing WireGuard key, requeuing")
+	}
+	return true
+}
+
+// certificateRenewalTime returns the time at which the certificate for the provided public key should be renewed.
+// It returns the zero time if the certificate doesn't certify the public key of the Node.
+func (c *Controller) certificateRenewalTime(certificatePEM string, publicKey string) time.Time {
+	if certificatePEM == "" {
+		return time.Time{}
+	}
+	certs, err := certutil.ParseCertsPEM([]byte(certificatePEM))
+	if err != nil {
+		klog.ErrorS(err, "Failed to parse WireGuard public key certificate")
+		return time.Time{}
+	}
+	certifiedKey, err := wgutil.PublicKeyFromURIs(certs[0].URIs)
+	if err != nil || certifiedKey != publicKey || certs[0].Subject.CommonName != c.nodeName {
+		return time.Time{}
+	}
+	lifetime := certs[0].NotAfter.Sub(certs[0].NotBefore)
+	return certs[0].NotBefore.Add(time.Duration(float64(lifetime) * certificateRenewalRatio))
+}
+
+func (c *Controller) syncKey() error {
+	startTime := c.clock.Now()
+	defer func() {
+		d := time.Since(startTime)
+		klog.V(2).InfoS("Finished syncing WireGuard key", "duration", d)
+	}()
+
+	node, err := c.kubeClient.CoreV1().Nodes().Get(context.TODO(), c.nodeName, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("error getting Node %s: %w", c.nodeName, err)
+	}
+	publicKey := node.Annotations[types.NodeWireGuardPublicAnnotationKey]
+	if publicKey == "" {
+		return fmt.Errorf("WireGuard public key of Node %s is not published yet", c.nodeName)
+	}
+
+	now := c.clock.Now()
+	// annotations holds the Node annotations to patch, a nil value means the annotation should be removed.
+	annotations := map[string]interface{}{}
+	if c.keyRotationInterval > 0 {
+		if c.lastRotationTime.IsZero() {
+			// The activation time may be stale if the WireGuard device was recreated with a new key, which
+			// only makes the next rotation happen earlier.
+			activationTime, err := time.Parse(time.RFC3339, node.Annotations[types.NodeWireGuardPublicKeyActivationTimeAnnotationKey])
+			if err != nil || activationTime.After(now) {
+				activationTime = now
+			}
+			c.lastRotationTime = activationTime
+		}
+		if activationTime := c.lastRotationTime.Format(time.RFC3339); node.Annotations[types.NodeWireGuardPublicKeyActivationTimeAnnotationKey] != activationTime {
+			annotations[types.NodeWireGuardPublicKeyActivationTimeAnnotationKey] = activationTime
+		}
+	}
+	// nextSyncTime is the earliest time at which the key or the certificates need to be updated.
+	var nextSyncTime time.Time
+	updateNextSyncTime := func(t time.Time) {
+		if t.IsZero() {
+			return
+		}
+		if nextSyncTime.IsZero() || t.Before(nextSyncTime) {
+			nextSyncTime = t
+		}
+	}
+
+	if c.certifyPublicKeys {
+		renewalTime := c.certificateRenewalTime(node.Annotations[types.NodeWireGuardPublicKeyCertificateAnnotationKey], publicKey)
+		if !now.Before(renewalTime) {
+			klog.InfoS("Requesting certificate for WireGuard public key", "publicKey", publicKey)
+			certificate, err := c.requestCertificate(publicKey)
+			if err != nil {
+				return fmt.Errorf("failed to request certificate for WireGuard public key: %w", err)
+			}
+			annotations[types.NodeWireGuardPublicKeyCertificateAnnotationKey] = string(certificate)
+			renewalTime = c.certificateRenewalTime(string(certificate), publicKey)
+		}
+		updateNextSyncTime(renewalTime)
+	}
+
+	nextPublicKey := node.Annotations[types.NodeWireGuardNextPublicKeyAnnotationKey]
+	if nextPublicKey != "" && nextPublicKey != c.nextPublicKey {
+		// The next key was announced before antrea-agent restarted, its private key is lost.
+		klog.InfoS("Removing stale next WireGuard public key", "publicKey", nextPublicKey)
+		annotations[types.NodeWireGuardNextPublicKeyAnnotationKey] = nil
+		annotations[types.NodeWireGuardNextPublicKeyCertificateAnnotationKey] = nil
+		annotations[types.NodeWireGuardNextPublicKeyActivationTimeAnnotationKey] = nil
+		nextPublicKey = ""
+	}
+
+	if nextPublicKey != "" {
+		activationTime, err := time.Parse(time.RFC3339, node.Annotations[types.NodeWireGuardNextPublicKeyActivationTimeAnnotationKey])
+		if err != nil {
+			return fmt.Errorf("invalid activation time of next WireGuard public key: %w", err)
+		}
+		if now.Before(activationTime) {
+			updateNextSyncTime(activationTime)
+		} else {
+			activatedPublicKey, err := c.wireGuardClient.ActivateNextKey()
+			if err != nil {
+				return fmt.Errorf("failed to activate next WireGuard key: %w", err)
+			}
+			klog.InfoS("Activated next WireGuard key", "publicKey", activatedPublicKey)
+			c.nextPublicKey = ""
+			c.lastRotationTime = now
+			annotations[types.NodeWireGuardPublicAnnotationKey] = activatedPublicKey
+			annotations[types.NodeWireGuardPublicKeyActivationTimeAnnotationKey] = now.Format(time.RFC3339)
+			if certificate := node.Annotations[types.NodeWireGuardNextPublicKeyCertificateAnnotationKey]; certificate != "" {
+				annotations[types.NodeWireGuardPublicKeyCertificateAnnotationKey] = certificate
+				updateNextSyncTime(c.certificateRenewalTime(certificate, activatedPublicKey))
+			} else {
+				annotations[types.NodeWireGuardPublicKeyCertificateAnnotationKey] = nil
+			}
+			annotations[types.NodeWireGuardNextPublicKeyAnnotationKey] = nil
+			annotations[types.NodeWireGuardNextPublicKeyCertificateAnnotationKey] = nil
+			annotations[types.NodeWireGuardNextPublicKeyActivationTimeAnnotationKey] = nil
+			if c.keyRotationInterval > 0 {
+				updateNextSyncTime(c.lastRotationTime.Add(c.keyRotationInterval))
+			}
+		}
+	} else if c.keyRotationInterval > 0 {
+		rotationTime := c.lastRotationTime.Add(c.keyRotationInterval)
+		if now.Before(rotationTime) {
+			updateNextSyncTime(rotationTime)
+		} else {
+			nextPublicKey, err := c.wireGuardClient.GenerateNextKey()
+			if err != nil {
+				return fmt.Errorf("failed to generate next WireGuard key: %w", err)
+			}
+			if c.certifyPublicKeys {
+				certificate, err := c.requestCertificate(nextPublicKey)
+				if err != nil {
+					return fmt.Errorf("failed to request certificate for next WireGuard public key: %w", err)
+				}
+				annotations[types.NodeWireGuardNextPublicKeyCertificateAnnotationKey] = string(certificate)
+			}
+			// The activation time is rounded up to a second as it's encoded in RFC3339 format.
+			activationTime := now.Add(c.keyRotationOverlap).Add(time.Second - 1).Truncate(time.Second)
+			klog.InfoS("Announcing next WireGuard public key", "publicKey", nextPublicKey, "activationTime", activationTime)
+			annotations[types.NodeWireGuardNextPublicKeyAnnotationKey] = nextPublicKey
+			annotations[types.NodeWireGuardNextPublicKeyActivationTimeAnnotationKey] = activationTime.Format(time.RFC3339)
+			c.nextPublicKey = nextPublicKey
+			updateNextSyncTime(activationTime)
+		}
+	}
+
+	if len(annotations) > 0 {
+		if err := c.patchNodeAnnotations(annotations); err != nil {
+			return err
+		}
+	}
+	if !nextSyncTime.IsZero() {
+		c.queue.AddAfter(workerItemKey, nextSyncTime.Sub(now))
+	}
+	return nil
+}
+
+func (c *Controller) patchNodeAnnotations(annotations map[string]interface{}) error {
+	patch, _ := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": annotations,
+		},
+	})
+	if err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		_, err := c.kubeClient.CoreV1().Nodes().Patch(context.TODO(), c.nodeName, apitypes.MergePatchType, patch, metav1.PatchOptions{}, "status")
+		return err
+	}); err != nil {
+		return fmt.Errorf("error when patching the Node with WireGuard key annotations: %w", err)
+	}
+	return nil
+}
+
+// certificateExpirationSeconds returns the lifetime to request for the certificates of the WireGuard public keys. When
+// keys are rotated, a certificate is only valid until the key it certifies is superseded, so that the certificate of
+// a leaked key can't be used after the rotation. Otherwise, the signer's default lifetime is used.
+func (c *Controller) certificateExpirationSeconds() *int32 {
+	if c.keyRotationInterval <= 0 {
+		return nil
+	}
+	lifetime := c.keyRotationInterval + c.keyRotationOverlap
+	if lifetime < minCertificateLifetime {
+		lifetime = minCertificateLifetime
+	}
+	return csrutil.DurationToExpirationSeconds(lifetime)
+}
+
+func newCSR(csrNamePrefix, nodeName, publicKey string, expirationSeconds *int32) (*certificatesv1.CertificateSigningRequest, error) {
+	csrBytes, err := wgutil.NewPublicKeyCertificateRequest(nodeName, publicKey)
+	if err != nil {
+		return nil, err
+	}
+	return &certificatesv1.CertificateSigningRequest{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: csrNamePrefix,
+		},
+		Spec: certificatesv1.CertificateSigningRequestSpec{
+			Request:           csrBytes,
+			SignerName:        antreaapis.AntreaWireGuardCSRSignerName,
+			ExpirationSeconds: expirationSeconds,
+			Usages:            []certificatesv1.KeyUsage{certificatesv1.UsageDigitalSignature},
+		},
+	}, nil
+}
+
+func (c *Controller) newCertificate(publicKey string) ([]byte, error) {
+	// Always create a new CSR for certificate rotation. The old ones will be GCed automatically.
+	csr, err := newCSR(fmt.Sprintf("%s-wireguard-", c.nodeName), c.nodeName, publicKey, c.certificateExpirationSeconds())
+	if err != nil {
+		return nil, err
+	}
+	csr, err = c.kubeClient.CertificatesV1().CertificateSigningRequests().Create(context.TODO(), csr, metav1.CreateOptions{})
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), certificateWaitTimeout)
+	defer cancel()
+	return csrutil.WaitForCertificate(ctx, c.kubeClient, csr.Name, csr.UID)
+}
+
+func (c *Controller) Run(stopCh <-chan struct{}) {
+	defer c.queue.ShutDown()
+
+	klog.InfoS("Starting " + controllerName)
+	defer klog.InfoS("Shutting down " + controllerName)
+
+	c.queue.Add(workerItemKey)
+	go wait.Until(c.worker, time.Second, stopCh)
+	<-stopCh
+}
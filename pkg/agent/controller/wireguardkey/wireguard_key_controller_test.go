@@ -0,0 +1,261 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package wireguardkey
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"golang.zx2c4.com/wireguard/wgctrl/wgtypes"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	certutil "k8s.io/client-go/util/cert"
+	csrutil "k8s.io/client-go/util/certificate/csr"
+	testingclock "k8s.io/utils/clock/testing"
+
+	"antrea.io/antrea/pkg/agent/config"
+	"antrea.io/antrea/pkg/agent/types"
+	wgtest "antrea.io/antrea/pkg/agent/wireguard/testing"
+	wgutil "antrea.io/antrea/pkg/util/wireguard"
+)
+
+const fakeNodeName = "fake-node-1"
+
+type fakeController struct {
+	*Controller
+	mockWireGuardClient *wgtest.MockInterface
+	clock               *testingclock.FakeClock
+	caCert              *x509.Certificate
+	caKey               *ecdsa.PrivateKey
+}
+
+func newFakeController(t *testing.T, wireGuardConfig *config.WireGuardConfig, annotations map[string]string) *fakeController {
+	mockController := gomock.NewController(t)
+	mockWireGuardClient := wgtest.NewMockInterface(mockController)
+	node := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        fakeNodeName,
+			Annotations: annotations,
+		},
+	}
+	fakeClient := fake.NewSimpleClientset(node)
+	clock := testingclock.NewFakeClock(time.Now().UTC().Truncate(time.Second))
+
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	caCert, err := certutil.NewSelfSignedCACert(certutil.Config{
+		CommonName:   "antrea-wireguard-ca",
+		Organization: []string{"antrea.io"},
+	}, caKey)
+	require.NoError(t, err)
+
+	c := newWireGuardKeyControllerWithCustomClock(fakeClient, mockWireGuardClient, fakeNodeName, wireGuardConfig, clock)
+	fc := &fakeController{
+		Controller:          c,
+		mockWireGuardClient: mockWireGuardClient,
+		clock:               clock,
+		caCert:              caCert,
+		caKey:               caKey,
+	}
+	c.requestCertificate = fc.signCertificate
+	return fc
+}
+
+// signCertificate issues a certificate for the public key the same way as antrea-controller, without going through
+// the CertificateSigningRequest API. The certificate is valid for 10 hours unless a lifetime is requested.
+func (c *fakeController) signCertificate(publicKey string) ([]byte, error) {
+	csr, err := newCSR("", c.nodeName, publicKey, c.certificateExpirationSeconds())
+	if err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode(csr.Spec.Request)
+	req, err := x509.ParseCertificateRequest(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	now := c.clock.Now()
+	lifetime := 10 * time.Hour
+	if csr.Spec.ExpirationSeconds != nil {
+		lifetime = csrutil.ExpirationSecondsToDuration(*csr.Spec.ExpirationSeconds)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(now.UnixNano()),
+		Subject:      pkix.Name{CommonName: req.Subject.CommonName},
+		URIs:         req.URIs,
+		NotBefore:    now,
+		NotAfter:     now.Add(lifetime),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, c.caCert, req.PublicKey, c.caKey)
+	if err != nil {
+		return nil, err
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: certutil.CertificateBlockType, Bytes: der}), nil
+}
+
+func (c *fakeController) getAnnotations(t *testing.T) map[string]string {
+	node, err := c.kubeClient.CoreV1().Nodes().Get(context.TODO(), fakeNodeName, metav1.GetOptions{})
+	require.NoError(t, err)
+	return node.Annotations
+}
+
+func newTestPublicKey(t *testing.T) string {
+	privateKey, err := wgtypes.GeneratePrivateKey()
+	require.NoError(t, err)
+	return privateKey.PublicKey().String()
+}
+
+func TestController_RotateKey(t *testing.T) {
+	currentKey := newTestPublicKey(t)
+	nextKey := newTestPublicKey(t)
+	c := newFakeController(t, &config.WireGuardConfig{
+		KeyRotationInterval: time.Hour,
+		KeyRotationOverlap:  time.Minute,
+	}, map[string]string{types.NodeWireGuardPublicAnnotationKey: currentKey})
+	startTime := c.clock.Now()
+
+	// The key is not due for rotation yet, its activation time is recorded.
+	require.NoError(t, c.syncKey())
+	assert.Equal(t, map[string]string{
+		types.NodeWireGuardPublicAnnotationKey:                  currentKey,
+		types.NodeWireGuardPublicKeyActivationTimeAnnotationKey: startTime.Format(time.RFC3339),
+	}, c.getAnnotations(t))
+
+	// The next key is announced with the activation time.
+	c.clock.Step(time.Hour)
+	c.mockWireGuardClient.EXPECT().GenerateNextKey().Return(nextKey, nil)
+	require.NoError(t, c.syncKey())
+	activationTime := startTime.Add(time.Hour + time.Minute)
+	assert.Equal(t, map[string]string{
+		types.NodeWireGuardPublicAnnotationKey:                      currentKey,
+		types.NodeWireGuardPublicKeyActivationTimeAnnotationKey:     startTime.Format(time.RFC3339),
+		types.NodeWireGuardNextPublicKeyAnnotationKey:               nextKey,
+		types.NodeWireGuardNextPublicKeyActivationTimeAnnotationKey: activationTime.Format(time.RFC3339),
+	}, c.getAnnotations(t))
+
+	// The next key is not active yet.
+	c.clock.Step(30 * time.Second)
+	require.NoError(t, c.syncKey())
+	assert.Equal(t, nextKey, c.getAnnotations(t)[types.NodeWireGuardNextPublicKeyAnnotationKey])
+
+	// The next key becomes the current key.
+	c.clock.Step(30 * time.Second)
+	c.mockWireGuardClient.EXPECT().ActivateNextKey().Return(nextKey, nil)
+	require.NoError(t, c.syncKey())
+	assert.Equal(t, map[string]string{
+		types.NodeWireGuardPublicAnnotationKey:                  nextKey,
+		types.NodeWireGuardPublicKeyActivationTimeAnnotationKey: activationTime.Format(time.RFC3339),
+	}, c.getAnnotations(t))
+	assert.Equal(t, activationTime, c.lastRotationTime)
+}
+
+func TestController_RestoreRotationTime(t *testing.T) {
+	currentKey := newTestPublicKey(t)
+	nextKey := newTestPublicKey(t)
+	now := time.Now().UTC().Truncate(time.Second)
+	c := newFakeController(t, &config.WireGuardConfig{
+		KeyRotationInterval: time.Hour,
+		KeyRotationOverlap:  time.Minute,
+	}, map[string]string{
+		types.NodeWireGuardPublicAnnotationKey:                  currentKey,
+		types.NodeWireGuardPublicKeyActivationTimeAnnotationKey: now.Add(-50 * time.Minute).Format(time.RFC3339),
+	})
+
+	// The key took effect before antrea-agent restarted, the rotation is not postponed by the restart.
+	require.NoError(t, c.syncKey())
+	assert.Equal(t, now.Add(-50*time.Minute), c.lastRotationTime)
+	c.clock.SetTime(now.Add(10 * time.Minute))
+	c.mockWireGuardClient.EXPECT().GenerateNextKey().Return(nextKey, nil)
+	require.NoError(t, c.syncKey())
+	assert.Equal(t, nextKey, c.getAnnotations(t)[types.NodeWireGuardNextPublicKeyAnnotationKey])
+}
+
+func TestController_RemoveStaleNextKey(t *testing.T) {
+	currentKey := newTestPublicKey(t)
+	c := newFakeController(t, &config.WireGuardConfig{}, map[string]string{
+		types.NodeWireGuardPublicAnnotationKey:                      currentKey,
+		types.NodeWireGuardNextPublicKeyAnnotationKey:               newTestPublicKey(t),
+		types.NodeWireGuardNextPublicKeyActivationTimeAnnotationKey: time.Now().Format(time.RFC3339),
+	})
+	require.NoError(t, c.syncKey())
+	assert.Equal(t, map[string]string{types.NodeWireGuardPublicAnnotationKey: currentKey}, c.getAnnotations(t))
+}
+
+func TestController_CertifyKeys(t *testing.T) {
+	currentKey := newTestPublicKey(t)
+	nextKey := newTestPublicKey(t)
+	c := newFakeController(t, &config.WireGuardConfig{
+		KeyRotationInterval:  10*time.Hour - time.Minute,
+		KeyRotationOverlap:   time.Minute,
+		VerifyPeerPublicKeys: true,
+	}, map[string]string{types.NodeWireGuardPublicAnnotationKey: currentKey})
+	verifyCertificate := func(annotationKey, publicKey string) {
+		certificate := c.getAnnotations(t)[annotationKey]
+		require.NotEmpty(t, certificate)
+		certs, err := certutil.ParseCertsPEM([]byte(certificate))
+		require.NoError(t, err)
+		assert.Equal(t, fakeNodeName, certs[0].Subject.CommonName)
+		certifiedKey, err := wgutil.PublicKeyFromURIs(certs[0].URIs)
+		require.NoError(t, err)
+		assert.Equal(t, publicKey, certifiedKey)
+		// The certificate expires once the key is superseded.
+		assert.Equal(t, 10*time.Hour, certs[0].NotAfter.Sub(certs[0].NotBefore))
+	}
+
+	// The current key is certified.
+	require.NoError(t, c.syncKey())
+	verifyCertificate(types.NodeWireGuardPublicKeyCertificateAnnotationKey, currentKey)
+	certificate := c.getAnnotations(t)[types.NodeWireGuardPublicKeyCertificateAnnotationKey]
+
+	// The certificate is not renewed before 80% of its lifetime.
+	c.clock.Step(7 * time.Hour)
+	require.NoError(t, c.syncKey())
+	assert.Equal(t, certificate, c.getAnnotations(t)[types.NodeWireGuardPublicKeyCertificateAnnotationKey])
+
+	// The certificate is renewed after 80% of its lifetime.
+	c.clock.Step(time.Hour)
+	require.NoError(t, c.syncKey())
+	assert.NotEqual(t, certificate, c.getAnnotations(t)[types.NodeWireGuardPublicKeyCertificateAnnotationKey])
+	verifyCertificate(types.NodeWireGuardPublicKeyCertificateAnnotationKey, currentKey)
+
+	// The next key is certified before being announced.
+	c.clock.Step(2*time.Hour - time.Minute)
+	c.mockWireGuardClient.EXPECT().GenerateNextKey().Return(nextKey, nil)
+	require.NoError(t, c.syncKey())
+	verifyCertificate(types.NodeWireGuardNextPublicKeyCertificateAnnotationKey, nextKey)
+	nextCertificate := c.getAnnotations(t)[types.NodeWireGuardNextPublicKeyCertificateAnnotationKey]
+
+	// The certificate of the next key becomes the certificate of the current key.
+	c.clock.Step(time.Minute)
+	c.mockWireGuardClient.EXPECT().ActivateNextKey().Return(nextKey, nil)
+	require.NoError(t, c.syncKey())
+	assert.Equal(t, map[string]string{
+		types.NodeWireGuardPublicAnnotationKey:                  nextKey,
+		types.NodeWireGuardPublicKeyCertificateAnnotationKey:    nextCertificate,
+		types.NodeWireGuardPublicKeyActivationTimeAnnotationKey: c.clock.Now().Format(time.RFC3339),
+	}, c.getAnnotations(t))
+}
@@ -0,0 +1,56 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package externalnode
+
+import (
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"antrea.io/antrea/pkg/agent/interfacestore"
+	"antrea.io/antrea/pkg/util/podstore"
+)
+
+// externalEntityStore implements podstore.Interface on ExternalNodes, where there are no Pods.
+// It maps the IP of a host interface attached to OVS to the ExternalEntity of the interface, and
+// returns it as a Pod with the same name and Namespace. This lets the FlowExporter report
+// connections of ExternalEntities in the same way as connections of Pods.
+type externalEntityStore struct {
+	ifaceStore interfacestore.InterfaceStore
+}
+
+func NewExternalEntityStore(ifaceStore interfacestore.InterfaceStore) podstore.Interface {
+	return &externalEntityStore{ifaceStore: ifaceStore}
+}
+
+// GetPodByIPAndTime ignores startTime, as the IPs of an ExternalNode are not reused by other
+// ExternalEntities like Pod IPs are.
+func (s *externalEntityStore) GetPodByIPAndTime(ip string, _ time.Time) (*corev1.Pod, bool) {
+	iface, ok := s.ifaceStore.GetInterfaceByIP(ip)
+	if !ok || iface.Type != interfacestore.ExternalEntityInterface {
+		return nil, false
+	}
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      iface.EntityName,
+			Namespace: iface.EntityNamespace,
+		},
+	}, true
+}
+
+func (s *externalEntityStore) Run(stopCh <-chan struct{}) {
+	<-stopCh
+}
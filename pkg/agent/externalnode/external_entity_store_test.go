@@ -0,0 +1,57 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package externalnode
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"antrea.io/antrea/pkg/agent/interfacestore"
+)
+
+func TestExternalEntityStore(t *testing.T) {
+	ifaceStore := interfacestore.NewInterfaceStore()
+	ifaceStore.AddInterface(&interfacestore.InterfaceConfig{
+		Type:          interfacestore.ExternalEntityInterface,
+		InterfaceName: "eth1",
+		IPs:           []net.IP{net.ParseIP("10.10.0.2")},
+		OVSPortConfig: &interfacestore.OVSPortConfig{OFPort: 2},
+		EntityInterfaceConfig: &interfacestore.EntityInterfaceConfig{
+			EntityName:      "vm1-2cbd4",
+			EntityNamespace: "vm-ns",
+			UplinkPort:      &interfacestore.OVSPortConfig{OFPort: 3},
+		},
+	})
+	ifaceStore.AddInterface(&interfacestore.InterfaceConfig{
+		Type:          interfacestore.InternalInterface,
+		InterfaceName: "internal",
+		IPs:           []net.IP{net.ParseIP("10.10.0.3")},
+		OVSPortConfig: &interfacestore.OVSPortConfig{OFPort: 4},
+	})
+	store := NewExternalEntityStore(ifaceStore)
+
+	pod, ok := store.GetPodByIPAndTime("10.10.0.2", time.Now())
+	assert.True(t, ok)
+	assert.Equal(t, &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "vm1-2cbd4", Namespace: "vm-ns"}}, pod)
+	_, ok = store.GetPodByIPAndTime("10.10.0.3", time.Now())
+	assert.False(t, ok)
+	_, ok = store.GetPodByIPAndTime("10.10.0.4", time.Now())
+	assert.False(t, ok)
+}
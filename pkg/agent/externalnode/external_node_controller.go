@@ -259,23 +259,23 @@ func (c *ExternalNodeController) syncExternalNode(key string) error {
 
 func (c *ExternalNodeController) addExternalNode(en *v1alpha1.ExternalNode) error {
 	klog.InfoS("Adding ExternalNode", "ExternalNode", klog.KObj(en))
-	eeName, err := externalnode.GenExternalEntityName(en)
+	hostIfaces, err := getHostInterfaces(en)
 	if err != nil {
 		return err
 	}
-	ifName, ips, err := getHostInterfaceName(en.Spec.Interfaces[0])
-	if err != nil {
-		return err
-	}
-	if err := c.addInterface(ifName, en.Namespace, eeName, ips); err != nil {
-		return err
+	for _, hostIface := range hostIfaces {
+		if err := c.addInterface(hostIface.name, en.Namespace, hostIface.eeName, hostIface.ips); err != nil {
+			return err
+		}
 	}
 	c.syncedExternalNode = en
-	// Notify the ExternalEntity event to NetworkPolicyController.
-	c.externalEntityUpdateNotifier.Notify(v1beta2.ExternalEntityReference{
-		Name:      eeName,
-		Namespace: en.Namespace,
-	})
+	// Notify the ExternalEntity events to NetworkPolicyController.
+	for _, hostIface := range hostIfaces {
+		c.externalEntityUpdateNotifier.Notify(v1beta2.ExternalEntityReference{
+			Name:      hostIface.eeName,
+			Namespace: en.Namespace,
+		})
+	}
 	return nil
 }
 
@@ -315,50 +315,69 @@ func (c *ExternalNodeController) addInterface(ifName string, eeNamespace string,
 
 func (c *ExternalNodeController) updateExternalNode(preEN *v1alpha1.ExternalNode, curEN *v1alpha1.ExternalNode) error {
 	klog.InfoS("Updating ExternalNode", "ExternalNode", klog.KObj(curEN))
-	if reflect.DeepEqual(preEN.Spec.Interfaces[0], curEN.Spec.Interfaces[0]) {
-		klog.InfoS("Skip processing ExternalNode update as no changes for Interface[0]", "ExternalNode", klog.KObj(curEN))
+	if reflect.DeepEqual(preEN.Spec.Interfaces, curEN.Spec.Interfaces) {
+		klog.InfoS("Skip processing ExternalNode update as no changes for Interfaces", "ExternalNode", klog.KObj(curEN))
 		return nil
 	}
-	preEEName, err := externalnode.GenExternalEntityName(preEN)
+	preHostIfaces, err := getHostInterfaces(preEN)
 	if err != nil {
 		return err
 	}
-	preIfName, preIPs, err := getHostInterfaceName(preEN.Spec.Interfaces[0])
+	curHostIfaces, err := getHostInterfaces(curEN)
 	if err != nil {
 		return err
 	}
-	curEEName, err := externalnode.GenExternalEntityName(curEN)
-	if err != nil {
-		return err
-	}
-	curIfName, curIPs, err := getHostInterfaceName(curEN.Spec.Interfaces[0])
-	if err != nil {
-		return err
-	}
-	if preIfName != curIfName {
-		klog.InfoS("Found interface name is changed", "preName", preIfName, "curName", curIfName)
-		if err = c.addInterface(curIfName, curEN.Namespace, curEEName, curIPs); err != nil {
+	preHostIfaceMap := make(map[string]*hostInterface, len(preHostIfaces))
+	for _, preHostIface := range preHostIfaces {
+		preHostIfaceMap[preHostIface.name] = preHostIface
+	}
+	curEENames := sets.New[string]()
+	for _, curHostIface := range curHostIfaces {
+		curEENames.Insert(curHostIface.eeName)
+		preHostIface, ok := preHostIfaceMap[curHostIface.name]
+		delete(preHostIfaceMap, curHostIface.name)
+		if !ok {
+			klog.InfoS("Found interface is added", "ifName", curHostIface.name)
+		} else if !reflect.DeepEqual(preHostIface.ips, curHostIface.ips) || preHostIface.eeName != curHostIface.eeName {
+			klog.InfoS("Found interface configuration is changed", "ifName", curHostIface.name, "preIPs", preHostIface.ips, "preExternalEntity", preHostIface.eeName,
+				"curIPs", curHostIface.ips, "curExternalEntity", curHostIface.eeName)
+		} else {
+			continue
+		}
+		if err = c.addInterface(curHostIface.name, curEN.Namespace, curHostIface.eeName, curHostIface.ips); err != nil {
 			return err
 		}
-		ifaceConfig, ifaceExists := c.ifaceStore.GetInterfaceByName(preIfName)
+	}
+	// The remaining interfaces are removed from the ExternalNode, or renamed.
+	for _, preHostIface := range preHostIfaces {
+		if _, ok := preHostIfaceMap[preHostIface.name]; !ok {
+			continue
+		}
+		klog.InfoS("Found interface is removed", "ifName", preHostIface.name)
+		ifaceConfig, ifaceExists := c.ifaceStore.GetInterfaceByName(preHostIface.name)
 		if ifaceExists {
 			if err = c.deleteInterface(ifaceConfig); err != nil {
 				return err
 			}
 		}
-	} else if !reflect.DeepEqual(preIPs, curIPs) || preEEName != curEEName {
-		klog.InfoS("Found interface configuration is changed", "preIPs", preIPs, "preExternalEntity", preEEName,
-			"curIPs", curIPs, "curExternalEntity", curEEName)
-		if err = c.addInterface(curIfName, curEN.Namespace, curEEName, curIPs); err != nil {
-			return err
-		}
 	}
 	c.syncedExternalNode = curEN
-	// Notify the ExternalEntity event to NetworkPolicyController.
-	c.externalEntityUpdateNotifier.Notify(v1beta2.ExternalEntityReference{
-		Name:      curEEName,
-		Namespace: curEN.Namespace,
-	})
+	// Notify the ExternalEntity events to NetworkPolicyController, including the ExternalEntities
+	// which no longer have an interface.
+	for _, preHostIface := range preHostIfaces {
+		if !curEENames.Has(preHostIface.eeName) {
+			c.externalEntityUpdateNotifier.Notify(v1beta2.ExternalEntityReference{
+				Name:      preHostIface.eeName,
+				Namespace: preEN.Namespace,
+			})
+		}
+	}
+	for _, curHostIface := range curHostIfaces {
+		c.externalEntityUpdateNotifier.Notify(v1beta2.ExternalEntityReference{
+			Name:      curHostIface.eeName,
+			Namespace: curEN.Namespace,
+		})
+	}
 	return nil
 }
 
@@ -618,6 +637,40 @@ func (c *ExternalNodeController) removeOVSPortsAndFlows(interfaceConfig *interfa
 	return nil
 }
 
+// hostInterface is the host network interface which is attached to OVS for a NetworkInterface
+// of the ExternalNode.
+type hostInterface struct {
+	name   string
+	eeName string
+	ips    []string
+}
+
+// getHostInterfaces returns the host network interfaces for all the NetworkInterfaces of the
+// ExternalNode, in the same order.
+func getHostInterfaces(en *v1alpha1.ExternalNode) ([]*hostInterface, error) {
+	if err := externalnode.ValidateInterfaces(en); err != nil {
+		return nil, err
+	}
+	hostIfaces := make([]*hostInterface, 0, len(en.Spec.Interfaces))
+	ifNames := sets.New[string]()
+	for _, iface := range en.Spec.Interfaces {
+		ifName, ips, err := getHostInterfaceName(iface)
+		if err != nil {
+			return nil, err
+		}
+		if ifNames.Has(ifName) {
+			return nil, fmt.Errorf("multiple interfaces of ExternalNode %s are found on host interface %s", en.Name, ifName)
+		}
+		ifNames.Insert(ifName)
+		hostIfaces = append(hostIfaces, &hostInterface{
+			name:   ifName,
+			eeName: externalnode.GenExternalEntityName(en.Name, iface.Name),
+			ips:    ips,
+		})
+	}
+	return hostIfaces, nil
+}
+
 func getHostInterfaceName(iface v1alpha1.NetworkInterface) (string, []string, error) {
 	ifName := ""
 	ips := sets.New[string]()
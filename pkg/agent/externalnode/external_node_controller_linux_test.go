@@ -134,7 +134,7 @@ func TestUpdateExternalNode(t *testing.T) {
 			existingIfaceMap: map[string]bool{},
 		},
 		{
-			name:            "no change for Interface[0]",
+			name:            "no change for Interfaces",
 			preIf:           &intf1,
 			curIf:           &intf1,
 			preExternalNode: &externalNode1,
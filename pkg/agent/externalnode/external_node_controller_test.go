@@ -502,6 +502,67 @@ func TestGetHostInterfaceName(t *testing.T) {
 	}
 }
 
+func TestGetHostInterfaces(t *testing.T) {
+	for _, tt := range []struct {
+		name                       string
+		interfaces                 []v1alpha1.NetworkInterface
+		getIPNetDeviceFromIPParams []mockGetIPNetDeviceFromIPParam
+		expectedHostIfaces         []*hostInterface
+		expectedErr                string
+	}{
+		{
+			name: "multiple interfaces",
+			interfaces: []v1alpha1.NetworkInterface{
+				{Name: "eth0", IPs: []string{"1.1.1.3"}},
+				{Name: "eth1", IPs: []string{"2.2.2.3"}},
+			},
+			getIPNetDeviceFromIPParams: []mockGetIPNetDeviceFromIPParam{
+				{link: &net.Interface{Name: "eth0"}},
+				{link: &net.Interface{Name: "eth1"}},
+			},
+			expectedHostIfaces: []*hostInterface{
+				{name: "eth0", eeName: "vm1-3e616", ips: []string{"1.1.1.3"}},
+				{name: "eth1", eeName: "vm1-2cbd4", ips: []string{"2.2.2.3"}},
+			},
+		},
+		{
+			name: "interfaces on the same host interface",
+			interfaces: []v1alpha1.NetworkInterface{
+				{Name: "eth0", IPs: []string{"1.1.1.3"}},
+				{Name: "eth1", IPs: []string{"1.1.1.4"}},
+			},
+			getIPNetDeviceFromIPParams: []mockGetIPNetDeviceFromIPParam{
+				{link: &net.Interface{Name: "eth0"}},
+				{link: &net.Interface{Name: "eth0"}},
+			},
+			expectedErr: "multiple interfaces of ExternalNode vm1 are found on host interface eth0",
+		},
+		{
+			name: "interface without name",
+			interfaces: []v1alpha1.NetworkInterface{
+				{Name: "eth0", IPs: []string{"1.1.1.3"}},
+				{IPs: []string{"2.2.2.3"}},
+			},
+			expectedErr: "interface name must be specified when ExternalNode vm1 has multiple interfaces",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			defer mockGetIPNetDeviceFromIP(tt.getIPNetDeviceFromIPParams)()
+			externalNode := &v1alpha1.ExternalNode{
+				ObjectMeta: metav1.ObjectMeta{Name: "vm1", Namespace: "ns1"},
+				Spec:       v1alpha1.ExternalNodeSpec{Interfaces: tt.interfaces},
+			}
+			hostIfaces, err := getHostInterfaces(externalNode)
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedHostIfaces, hostIfaces)
+			}
+		})
+	}
+}
+
 func TestGetOVSAttachInfo(t *testing.T) {
 	uplinkUUID := uuid.NewString()
 
@@ -43,8 +43,12 @@ func InitializeConnTrackDumper(nodeConfig *config.NodeConfig, serviceCIDRv4 *net
 
 func filterAntreaConns(conns []*flowexporter.Connection, nodeConfig *config.NodeConfig, serviceCIDR netip.Prefix, zoneFilter uint16, isAntreaProxyEnabled bool) []*flowexporter.Connection {
 	filteredConns := conns[:0]
-	gwIPv4, _ := netip.AddrFromSlice(nodeConfig.GatewayConfig.IPv4)
-	gwIPv6, _ := netip.AddrFromSlice(nodeConfig.GatewayConfig.IPv6)
+	var gwIPv4, gwIPv6 netip.Addr
+	// There is no gateway on ExternalNodes.
+	if nodeConfig.GatewayConfig != nil {
+		gwIPv4, _ = netip.AddrFromSlice(nodeConfig.GatewayConfig.IPv4)
+		gwIPv6, _ = netip.AddrFromSlice(nodeConfig.GatewayConfig.IPv6)
+	}
 	for _, conn := range conns {
 		if conn.Zone != zoneFilter {
 			continue
@@ -52,7 +56,8 @@ func filterAntreaConns(conns []*flowexporter.Connection, nodeConfig *config.Node
 		srcIP := conn.FlowKey.SourceAddress
 		dstIP := conn.FlowKey.DestinationAddress
 
-		// Consider Pod-to-Pod, Pod-To-Service and Pod-To-External flows.
+		// Consider Pod-to-Pod, Pod-To-Service and Pod-To-External flows. On ExternalNodes, consider
+		// all the flows of ExternalEntities.
 		if srcIP == gwIPv4 || dstIP == gwIPv4 {
 			continue
 		}
@@ -116,6 +116,24 @@ func TestConnTrackSystem_DumpFlows(t *testing.T) {
 	assert.Equal(t, len(testFlows), totalConns, "Number of connections in conntrack table should be equal to testFlows")
 }
 
+func TestFilterAntreaConnsOnExternalNode(t *testing.T) {
+	tuple := flowexporter.Tuple{SourceAddress: srcAddr, DestinationAddress: dstAddr, Protocol: 6, SourcePort: 65280, DestinationPort: 255}
+	antreaFlow := &flowexporter.Connection{
+		FlowKey: tuple,
+		Zone:    openflow.CtZone,
+	}
+	nonAntreaFlow := &flowexporter.Connection{
+		FlowKey: tuple,
+		Zone:    100,
+	}
+	// There is no gateway on ExternalNodes.
+	nodeConfig := &config.NodeConfig{
+		Type: config.ExternalNode,
+	}
+	conns := filterAntreaConns([]*flowexporter.Connection{antreaFlow, nonAntreaFlow}, nodeConfig, netip.Prefix{}, openflow.CtZone, true)
+	assert.Equal(t, []*flowexporter.Connection{antreaFlow}, conns)
+}
+
 func TestConnTrackOvsAppCtl_DumpFlows(t *testing.T) {
 	ctrl := gomock.NewController(t)
 
@@ -183,6 +183,92 @@ var (
 			StabilityLevel: metrics.ALPHA,
 		},
 	)
+
+	IPsecCertificateExpirationTime = metrics.NewGauge(
+		&metrics.GaugeOpts{
+			Namespace:      metricNamespaceAntrea,
+			Subsystem:      metricSubsystemAgent,
+			Name:           "ipsec_certificate_expiration_timestamp_seconds",
+			Help:           "Expiration time of the IPsec certificate of local Node, in seconds since the Unix epoch.",
+			StabilityLevel: metrics.ALPHA,
+		},
+	)
+
+	IPsecCertificateRotationDeadline = metrics.NewGauge(
+		&metrics.GaugeOpts{
+			Namespace:      metricNamespaceAntrea,
+			Subsystem:      metricSubsystemAgent,
+			Name:           "ipsec_certificate_rotation_deadline_timestamp_seconds",
+			Help:           "Time at which the IPsec certificate of local Node is due for rotation, in seconds since the Unix epoch.",
+			StabilityLevel: metrics.ALPHA,
+		},
+	)
+
+	MulticlusterGatewayTunnelProbeLatency = metrics.NewGaugeVec(
+		&metrics.GaugeOpts{
+			Namespace:      metricNamespaceAntrea,
+			Subsystem:      metricSubsystemAgent,
+			Name:           "multicluster_gateway_tunnel_probe_latency_milliseconds",
+			Help:           "Average round-trip time of the probes sent through the cross-cluster tunnel from the local Multi-cluster Gateway to a Gateway of a peer member cluster.",
+			StabilityLevel: metrics.ALPHA,
+		},
+		[]string{"peer_cluster_id", "peer_gateway_ip"},
+	)
+
+	MulticlusterGatewayTunnelProbePacketLoss = metrics.NewGaugeVec(
+		&metrics.GaugeOpts{
+			Namespace:      metricNamespaceAntrea,
+			Subsystem:      metricSubsystemAgent,
+			Name:           "multicluster_gateway_tunnel_probe_packet_loss_percentage",
+			Help:           "Percentage of the probes sent through the cross-cluster tunnel from the local Multi-cluster Gateway to a Gateway of a peer member cluster which were not answered.",
+			StabilityLevel: metrics.ALPHA,
+		},
+		[]string{"peer_cluster_id", "peer_gateway_ip"},
+	)
+
+	MulticlusterGatewayUnderlayProbeLatency = metrics.NewGaugeVec(
+		&metrics.GaugeOpts{
+			Namespace:      metricNamespaceAntrea,
+			Subsystem:      metricSubsystemAgent,
+			Name:           "multicluster_gateway_underlay_probe_latency_milliseconds",
+			Help:           "Average round-trip time of the probes sent over the underlay network from the local Multi-cluster Gateway to a Gateway of a peer member cluster.",
+			StabilityLevel: metrics.ALPHA,
+		},
+		[]string{"peer_cluster_id", "peer_gateway_ip"},
+	)
+
+	MulticlusterGatewayUnderlayProbePacketLoss = metrics.NewGaugeVec(
+		&metrics.GaugeOpts{
+			Namespace:      metricNamespaceAntrea,
+			Subsystem:      metricSubsystemAgent,
+			Name:           "multicluster_gateway_underlay_probe_packet_loss_percentage",
+			Help:           "Percentage of the probes sent over the underlay network from the local Multi-cluster Gateway to a Gateway of a peer member cluster which were not answered.",
+			StabilityLevel: metrics.ALPHA,
+		},
+		[]string{"peer_cluster_id", "peer_gateway_ip"},
+	)
+
+	MulticlusterGatewayUnderlayPathMTU = metrics.NewGaugeVec(
+		&metrics.GaugeOpts{
+			Namespace:      metricNamespaceAntrea,
+			Subsystem:      metricSubsystemAgent,
+			Name:           "multicluster_gateway_underlay_path_mtu_bytes",
+			Help:           "Underlay path MTU discovered from the local Multi-cluster Gateway to a Gateway of a peer member cluster. It is 0 if the path MTU could not be discovered.",
+			StabilityLevel: metrics.ALPHA,
+		},
+		[]string{"peer_cluster_id", "peer_gateway_ip"},
+	)
+
+	MulticlusterGatewayTunnelMTU = metrics.NewGaugeVec(
+		&metrics.GaugeOpts{
+			Namespace:      metricNamespaceAntrea,
+			Subsystem:      metricSubsystemAgent,
+			Name:           "multicluster_gateway_tunnel_mtu_bytes",
+			Help:           "Underlay path MTU from the local Multi-cluster Gateway to a Gateway of a peer member cluster minus the tunnel overhead. It is 0 if the path MTU could not be discovered.",
+			StabilityLevel: metrics.ALPHA,
+		},
+		[]string{"peer_cluster_id", "peer_gateway_ip"},
+	)
 )
 
 func InitializePrometheusMetrics() {
@@ -263,3 +349,39 @@ func InitializeConnectionMetrics() {
 		klog.ErrorS(err, "Failed to register metrics with Prometheus", "metrics", "antrea_agent_conntrack_max_connection_count")
 	}
 }
+
+// InitializeIPsecCertificateMetrics registers the metrics of IPsec certificates. They are only
+// meaningful when IPsec certificate-based authentication is used, hence not registered by
+// InitializePrometheusMetrics.
+func InitializeIPsecCertificateMetrics() {
+	if err := legacyregistry.Register(IPsecCertificateExpirationTime); err != nil {
+		klog.ErrorS(err, "Failed to register metrics with Prometheus", "metrics", "antrea_agent_ipsec_certificate_expiration_timestamp_seconds")
+	}
+	if err := legacyregistry.Register(IPsecCertificateRotationDeadline); err != nil {
+		klog.ErrorS(err, "Failed to register metrics with Prometheus", "metrics", "antrea_agent_ipsec_certificate_rotation_deadline_timestamp_seconds")
+	}
+}
+
+// InitializeMulticlusterGatewayMetrics registers the metrics of probing the Gateways of peer
+// member clusters. They are only meaningful when Multi-cluster Gateway is enabled, hence not
+// registered by InitializePrometheusMetrics.
+func InitializeMulticlusterGatewayMetrics() {
+	if err := legacyregistry.Register(MulticlusterGatewayTunnelProbeLatency); err != nil {
+		klog.ErrorS(err, "Failed to register metrics with Prometheus", "metrics", "antrea_agent_multicluster_gateway_tunnel_probe_latency_milliseconds")
+	}
+	if err := legacyregistry.Register(MulticlusterGatewayTunnelProbePacketLoss); err != nil {
+		klog.ErrorS(err, "Failed to register metrics with Prometheus", "metrics", "antrea_agent_multicluster_gateway_tunnel_probe_packet_loss_percentage")
+	}
+	if err := legacyregistry.Register(MulticlusterGatewayUnderlayProbeLatency); err != nil {
+		klog.ErrorS(err, "Failed to register metrics with Prometheus", "metrics", "antrea_agent_multicluster_gateway_underlay_probe_latency_milliseconds")
+	}
+	if err := legacyregistry.Register(MulticlusterGatewayUnderlayProbePacketLoss); err != nil {
+		klog.ErrorS(err, "Failed to register metrics with Prometheus", "metrics", "antrea_agent_multicluster_gateway_underlay_probe_packet_loss_percentage")
+	}
+	if err := legacyregistry.Register(MulticlusterGatewayUnderlayPathMTU); err != nil {
+		klog.ErrorS(err, "Failed to register metrics with Prometheus", "metrics", "antrea_agent_multicluster_gateway_underlay_path_mtu_bytes")
+	}
+	if err := legacyregistry.Register(MulticlusterGatewayTunnelMTU); err != nil {
+		klog.ErrorS(err, "Failed to register metrics with Prometheus", "metrics", "antrea_agent_multicluster_gateway_tunnel_mtu_bytes")
+	}
+}
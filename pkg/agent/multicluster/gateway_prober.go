@@ -0,0 +1,325 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multicluster
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"sort"
+	"sync"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	apitypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2"
+
+	mcv1alpha1 "antrea.io/antrea/multicluster/apis/multicluster/v1alpha1"
+	mcclientset "antrea.io/antrea/multicluster/pkg/client/clientset/versioned"
+	mcinformersv1alpha1 "antrea.io/antrea/multicluster/pkg/client/informers/externalversions/multicluster/v1alpha1"
+	mclisters "antrea.io/antrea/multicluster/pkg/client/listers/multicluster/v1alpha1"
+	"antrea.io/antrea/pkg/agent/config"
+	"antrea.io/antrea/pkg/agent/metrics"
+	"antrea.io/antrea/pkg/agent/util/ping"
+)
+
+const (
+	gatewayProberName = "MCGatewayProber"
+
+	// The interval between two rounds of probing the Gateways of peer member clusters.
+	gatewayProbeInterval = 30 * time.Second
+	// Number of echo requests sent to a peer Gateway in a round to measure the latency
+	// and the packet loss.
+	gatewayProbeCount   = 5
+	gatewayProbeTimeout = time.Second
+	// Size of the echo requests used to measure the latency and the packet loss.
+	gatewayProbeSize = 64
+	// The minimum MTU of IPv4, which is the lower bound of path MTU discovery.
+	minPathMTU = 576
+)
+
+var (
+	echoFunc = ping.Echo
+)
+
+type peerGateway struct {
+	clusterID string
+	gatewayIP string
+}
+
+// GatewayProber runs on Multi-cluster Gateway Nodes. It periodically probes the Gateways
+// of all peer member clusters with ICMP echo requests. The tunnel probes are sent from the
+// host to the probe IP of the peer Gateway, i.e. the IP of its antrea-gw0 interface, which
+// is routed through the cross-cluster tunnel by MCDefaultRouteController, to measure the
+// connectivity, latency and packet loss of the tunnel. The underlay probes are sent to the
+// peer Gateway IP over the underlay network to measure the underlay latency, packet loss
+// and path MTU, which tell an unreachable peer Gateway from a broken tunnel. The results
+// are exposed as Prometheus metrics and reported in the status of the local Gateway.
+type GatewayProber struct {
+	mcClient   mcclientset.Interface
+	nodeConfig *config.NodeConfig
+	// The overhead of the cross-cluster tunnel, which is subtracted from the underlay path
+	// MTU to get the MTU of the tunnel.
+	tunnelOverhead       int
+	gwLister             mclisters.GatewayLister
+	gwListerSynced       cache.InformerSynced
+	ciImportLister       mclisters.ClusterInfoImportLister
+	ciImportListerSynced cache.InformerSynced
+	// The Namespace where Antrea Multi-cluster Controller is running.
+	namespace string
+	// probedPeers is the peer Gateways probed in the last round, which is used to delete
+	// the metrics of the peer Gateways removed from the ClusterSet.
+	probedPeers sets.Set[peerGateway]
+}
+
+func NewGatewayProber(
+	mcClient mcclientset.Interface,
+	gwInformer mcinformersv1alpha1.GatewayInformer,
+	ciImportInformer mcinformersv1alpha1.ClusterInfoImportInformer,
+	nodeConfig *config.NodeConfig,
+	tunnelOverhead int,
+	namespace string,
+) *GatewayProber {
+	return &GatewayProber{
+		mcClient:             mcClient,
+		nodeConfig:           nodeConfig,
+		tunnelOverhead:       tunnelOverhead,
+		gwLister:             gwInformer.Lister(),
+		gwListerSynced:       gwInformer.Informer().HasSynced,
+		ciImportLister:       ciImportInformer.Lister(),
+		ciImportListerSynced: ciImportInformer.Informer().HasSynced,
+		namespace:            namespace,
+		probedPeers:          sets.New[peerGateway](),
+	}
+}
+
+func (p *GatewayProber) Run(stopCh <-chan struct{}) {
+	klog.InfoS("Starting controller", "controller", gatewayProberName)
+	defer klog.InfoS("Shutting down controller", "controller", gatewayProberName)
+	if !cache.WaitForNamedCacheSync(gatewayProberName, stopCh, p.gwListerSynced, p.ciImportListerSynced) {
+		return
+	}
+	wait.Until(p.probe, gatewayProbeInterval, stopCh)
+}
+
+// probe probes all Gateways of peer member clusters concurrently if the local Node is a
+// Gateway, and reports the results.
+func (p *GatewayProber) probe() {
+	gateway, err := p.gwLister.Gateways(p.namespace).Get(p.nodeConfig.Name)
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			klog.ErrorS(err, "Failed to get Gateway", "gateway", klog.KRef(p.namespace, p.nodeConfig.Name))
+		}
+		p.deleteStaleMetrics(sets.New[peerGateway]())
+		return
+	}
+	ciImports, err := p.ciImportLister.List(labels.Everything())
+	if err != nil {
+		klog.ErrorS(err, "Failed to list ClusterInfoImports")
+		return
+	}
+	var peers []peerGateway
+	probeIPs := map[peerGateway]net.IP{}
+	for _, ciImport := range ciImports {
+		for _, gwInfo := range ciImport.Spec.GatewayInfos {
+			if net.ParseIP(gwInfo.GatewayIP) == nil {
+				continue
+			}
+			peer := peerGateway{clusterID: ciImport.Spec.ClusterID, gatewayIP: gwInfo.GatewayIP}
+			peers = append(peers, peer)
+			if probeIP := net.ParseIP(gwInfo.ProbeIP); probeIP.To4() != nil {
+				probeIPs[peer] = probeIP
+			}
+		}
+	}
+	sort.Slice(peers, func(i, j int) bool {
+		if peers[i].clusterID != peers[j].clusterID {
+			return peers[i].clusterID < peers[j].clusterID
+		}
+		return peers[i].gatewayIP < peers[j].gatewayIP
+	})
+
+	peerStatuses := make([]mcv1alpha1.PeerGatewayStatus, len(peers))
+	var wg sync.WaitGroup
+	for i := range peers {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			peerStatuses[i] = p.probePeer(peers[i], probeIPs[peers[i]])
+		}(i)
+	}
+	wg.Wait()
+
+	for i := range peerStatuses {
+		updateGatewayProbeMetrics(&peerStatuses[i])
+	}
+	p.deleteStaleMetrics(sets.New[peerGateway](peers...))
+	if err := p.updateGatewayStatus(gateway, peerStatuses); err != nil {
+		klog.ErrorS(err, "Failed to update status of Gateway", "gateway", klog.KObj(gateway))
+	}
+}
+
+// probePeer probes the peer Gateway through the cross-cluster tunnel if it has a probe IP,
+// and over the underlay. The underlay path MTU is only discovered when the peer Gateway is
+// reachable over the underlay.
+func (p *GatewayProber) probePeer(peer peerGateway, probeIP net.IP) mcv1alpha1.PeerGatewayStatus {
+	status := mcv1alpha1.PeerGatewayStatus{
+		ClusterID:     peer.clusterID,
+		GatewayIP:     peer.gatewayIP,
+		LastProbeTime: metav1.Now(),
+	}
+	if probeIP != nil {
+		var received int
+		received, status.TunnelLatency = sendProbes(peer, probeIP)
+		status.TunnelConnectivity = getConnectivity(received)
+		status.TunnelPacketLoss = getPacketLoss(received)
+	}
+	dstIP := net.ParseIP(peer.gatewayIP)
+	var received int
+	received, status.UnderlayLatency = sendProbes(peer, dstIP)
+	status.UnderlayConnectivity = getConnectivity(received)
+	status.UnderlayPacketLoss = getPacketLoss(received)
+	if received == 0 {
+		return status
+	}
+	if pathMTU := p.discoverPathMTU(dstIP); pathMTU > p.tunnelOverhead {
+		status.UnderlayPathMTU = int32(pathMTU)
+		status.TunnelMTU = int32(pathMTU - p.tunnelOverhead)
+	}
+	return status
+}
+
+// sendProbes sends gatewayProbeCount echo requests to dstIP one after another. It returns
+// the number of answered requests and their average round-trip time, which is nil if no
+// request is answered.
+func sendProbes(peer peerGateway, dstIP net.IP) (int, *metav1.Duration) {
+	received := 0
+	var totalRTT time.Duration
+	for i := 0; i < gatewayProbeCount; i++ {
+		rtt, err := echoFunc(dstIP, gatewayProbeSize, gatewayProbeTimeout)
+		if err != nil {
+			klog.V(4).InfoS("Failed to probe peer Gateway", "cluster", peer.clusterID, "gatewayIP", peer.gatewayIP, "dstIP", dstIP, "err", err)
+			continue
+		}
+		received++
+		totalRTT += rtt
+	}
+	if received == 0 {
+		return 0, nil
+	}
+	return received, &metav1.Duration{Duration: totalRTT / time.Duration(received)}
+}
+
+func getConnectivity(received int) mcv1alpha1.GatewayConnectivity {
+	switch received {
+	case 0:
+		return mcv1alpha1.GatewayConnectivityUnreachable
+	case gatewayProbeCount:
+		return mcv1alpha1.GatewayConnectivityHealthy
+	default:
+		return mcv1alpha1.GatewayConnectivityDegraded
+	}
+}
+
+func getPacketLoss(received int) int32 {
+	return int32((gatewayProbeCount - received) * 100 / gatewayProbeCount)
+}
+
+// discoverPathMTU returns the largest packet size which can reach dstIP without
+// fragmentation, with a binary search between minPathMTU and the MTU of the transport
+// interface. It returns 0 if even a packet of minPathMTU cannot reach dstIP.
+func (p *GatewayProber) discoverPathMTU(dstIP net.IP) int {
+	reachable := func(size int) bool {
+		_, err := echoFunc(dstIP, size, gatewayProbeTimeout)
+		return err == nil
+	}
+	low, high := minPathMTU, p.nodeConfig.NodeTransportInterfaceMTU
+	if high <= low {
+		if reachable(high) {
+			return high
+		}
+		return 0
+	}
+	// Check the boundaries first, as the path MTU is usually the MTU of the transport
+	// interface.
+	if reachable(high) {
+		return high
+	}
+	if !reachable(low) {
+		return 0
+	}
+	high--
+	for low < high {
+		mid := (low + high + 1) / 2
+		if reachable(mid) {
+			low = mid
+		} else {
+			high = mid - 1
+		}
+	}
+	return low
+}
+
+func (p *GatewayProber) updateGatewayStatus(gateway *mcv1alpha1.Gateway, peerStatuses []mcv1alpha1.PeerGatewayStatus) error {
+	patch, _ := json.Marshal(map[string]interface{}{
+		"status": map[string]interface{}{
+			"peerGateways": peerStatuses,
+		},
+	})
+	_, err := p.mcClient.MulticlusterV1alpha1().Gateways(gateway.Namespace).Patch(context.TODO(), gateway.Name, apitypes.MergePatchType, patch,
+		metav1.PatchOptions{}, "status")
+	return err
+}
+
+func (p *GatewayProber) deleteStaleMetrics(peers sets.Set[peerGateway]) {
+	for peer := range p.probedPeers {
+		if peers.Has(peer) {
+			continue
+		}
+		metrics.MulticlusterGatewayTunnelProbeLatency.DeleteLabelValues(peer.clusterID, peer.gatewayIP)
+		metrics.MulticlusterGatewayTunnelProbePacketLoss.DeleteLabelValues(peer.clusterID, peer.gatewayIP)
+		metrics.MulticlusterGatewayUnderlayProbeLatency.DeleteLabelValues(peer.clusterID, peer.gatewayIP)
+		metrics.MulticlusterGatewayUnderlayProbePacketLoss.DeleteLabelValues(peer.clusterID, peer.gatewayIP)
+		metrics.MulticlusterGatewayUnderlayPathMTU.DeleteLabelValues(peer.clusterID, peer.gatewayIP)
+		metrics.MulticlusterGatewayTunnelMTU.DeleteLabelValues(peer.clusterID, peer.gatewayIP)
+	}
+	p.probedPeers = peers
+}
+
+func updateGatewayProbeMetrics(status *mcv1alpha1.PeerGatewayStatus) {
+	if status.TunnelLatency != nil {
+		metrics.MulticlusterGatewayTunnelProbeLatency.WithLabelValues(status.ClusterID, status.GatewayIP).Set(float64(status.TunnelLatency.Microseconds()) / 1000)
+	} else {
+		metrics.MulticlusterGatewayTunnelProbeLatency.DeleteLabelValues(status.ClusterID, status.GatewayIP)
+	}
+	if status.TunnelConnectivity != "" {
+		metrics.MulticlusterGatewayTunnelProbePacketLoss.WithLabelValues(status.ClusterID, status.GatewayIP).Set(float64(status.TunnelPacketLoss))
+	} else {
+		metrics.MulticlusterGatewayTunnelProbePacketLoss.DeleteLabelValues(status.ClusterID, status.GatewayIP)
+	}
+	if status.UnderlayLatency != nil {
+		metrics.MulticlusterGatewayUnderlayProbeLatency.WithLabelValues(status.ClusterID, status.GatewayIP).Set(float64(status.UnderlayLatency.Microseconds()) / 1000)
+	} else {
+		metrics.MulticlusterGatewayUnderlayProbeLatency.DeleteLabelValues(status.ClusterID, status.GatewayIP)
+	}
+	metrics.MulticlusterGatewayUnderlayProbePacketLoss.WithLabelValues(status.ClusterID, status.GatewayIP).Set(float64(status.UnderlayPacketLoss))
+	metrics.MulticlusterGatewayUnderlayPathMTU.WithLabelValues(status.ClusterID, status.GatewayIP).Set(float64(status.UnderlayPathMTU))
+	metrics.MulticlusterGatewayTunnelMTU.WithLabelValues(status.ClusterID, status.GatewayIP).Set(float64(status.TunnelMTU))
+}
@@ -0,0 +1,239 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multicluster
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/component-base/metrics/testutil"
+
+	mcv1alpha1 "antrea.io/antrea/multicluster/apis/multicluster/v1alpha1"
+	mcfake "antrea.io/antrea/multicluster/pkg/client/clientset/versioned/fake"
+	mcinformers "antrea.io/antrea/multicluster/pkg/client/informers/externalversions"
+	"antrea.io/antrea/pkg/agent/config"
+	"antrea.io/antrea/pkg/agent/metrics"
+)
+
+func newFakeGatewayProber(t *testing.T, nodeName string, gateways []*mcv1alpha1.Gateway, ciImports []*mcv1alpha1.ClusterInfoImport) (*GatewayProber, mcinformers.SharedInformerFactory, *mcfake.Clientset) {
+	mcClient := mcfake.NewSimpleClientset()
+	for _, gw := range gateways {
+		_, err := mcClient.MulticlusterV1alpha1().Gateways(gw.Namespace).Create(context.TODO(), gw, metav1.CreateOptions{})
+		require.NoError(t, err)
+	}
+	for _, ciImport := range ciImports {
+		_, err := mcClient.MulticlusterV1alpha1().ClusterInfoImports(ciImport.Namespace).Create(context.TODO(), ciImport, metav1.CreateOptions{})
+		require.NoError(t, err)
+	}
+	mcInformerFactory := mcinformers.NewSharedInformerFactoryWithOptions(mcClient, 60*time.Second, mcinformers.WithNamespace(defaultNs))
+	nodeConfig := &config.NodeConfig{Name: nodeName, NodeTransportInterfaceMTU: 1500}
+	p := NewGatewayProber(mcClient,
+		mcInformerFactory.Multicluster().V1alpha1().Gateways(),
+		mcInformerFactory.Multicluster().V1alpha1().ClusterInfoImports(),
+		nodeConfig,
+		50,
+		defaultNs,
+	)
+	return p, mcInformerFactory, mcClient
+}
+
+func TestGatewayProber(t *testing.T) {
+	metrics.InitializeMulticlusterGatewayMetrics()
+	// 172.18.0.10 in cluster-b is healthy with an underlay path MTU of 1450 and its tunnel is healthy,
+	// 12.11.0.10 in cluster-c loses every second echo request and its tunnel is broken, and 12.11.0.11
+	// in cluster-c is unreachable and has no probe IP.
+	var mutex sync.Mutex
+	echoCount := map[string]int{}
+	defer func(original func(net.IP, int, time.Duration) (time.Duration, error)) {
+		echoFunc = original
+	}(echoFunc)
+	echoFunc = func(dstIP net.IP, size int, timeout time.Duration) (time.Duration, error) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		echoCount[dstIP.String()]++
+		switch dstIP.String() {
+		case "10.244.2.1":
+			return 3 * time.Millisecond, nil
+		case "172.18.0.10":
+			if size > 1450 {
+				return 0, fmt.Errorf("timeout")
+			}
+			return 2 * time.Millisecond, nil
+		case "12.11.0.10":
+			if size == gatewayProbeSize && echoCount[dstIP.String()]%2 == 0 {
+				return 0, fmt.Errorf("timeout")
+			}
+			return 4 * time.Millisecond, nil
+		}
+		return 0, fmt.Errorf("timeout")
+	}
+
+	ciImport1 := clusterInfoImport1.DeepCopy()
+	ciImport1.Spec.GatewayInfos[0].ProbeIP = "10.244.2.1"
+	ciImport2 := clusterInfoImport2.DeepCopy()
+	ciImport2.Spec.GatewayInfos[0].ProbeIP = "10.245.1.1"
+	ciImport2.Spec.GatewayInfos = append(ciImport2.Spec.GatewayInfos, mcv1alpha1.GatewayInfo{GatewayIP: "12.11.0.11"})
+	p, informerFactory, mcClient := newFakeGatewayProber(t, gateway1.Name, []*mcv1alpha1.Gateway{gateway1.DeepCopy()},
+		[]*mcv1alpha1.ClusterInfoImport{ciImport1, ciImport2})
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	informerFactory.Start(stopCh)
+	informerFactory.WaitForCacheSync(stopCh)
+
+	p.probe()
+
+	gw, err := mcClient.MulticlusterV1alpha1().Gateways(defaultNs).Get(context.TODO(), gateway1.Name, metav1.GetOptions{})
+	require.NoError(t, err)
+	peerStatuses := gw.Status.PeerGateways
+	for i := range peerStatuses {
+		assert.False(t, peerStatuses[i].LastProbeTime.IsZero())
+		peerStatuses[i].LastProbeTime = metav1.Time{}
+	}
+	assert.Equal(t, []mcv1alpha1.PeerGatewayStatus{
+		{
+			ClusterID:            "cluster-b",
+			GatewayIP:            "172.18.0.10",
+			TunnelConnectivity:   mcv1alpha1.GatewayConnectivityHealthy,
+			TunnelLatency:        &metav1.Duration{Duration: 3 * time.Millisecond},
+			UnderlayConnectivity: mcv1alpha1.GatewayConnectivityHealthy,
+			UnderlayLatency:      &metav1.Duration{Duration: 2 * time.Millisecond},
+			UnderlayPathMTU:      1450,
+			TunnelMTU:            1400,
+		},
+		{
+			ClusterID:            "cluster-c",
+			GatewayIP:            "12.11.0.10",
+			TunnelConnectivity:   mcv1alpha1.GatewayConnectivityUnreachable,
+			TunnelPacketLoss:     100,
+			UnderlayConnectivity: mcv1alpha1.GatewayConnectivityDegraded,
+			UnderlayLatency:      &metav1.Duration{Duration: 4 * time.Millisecond},
+			UnderlayPacketLoss:   40,
+			UnderlayPathMTU:      1500,
+			TunnelMTU:            1450,
+		},
+		{
+			ClusterID:            "cluster-c",
+			GatewayIP:            "12.11.0.11",
+			UnderlayConnectivity: mcv1alpha1.GatewayConnectivityUnreachable,
+			UnderlayPacketLoss:   100,
+		},
+	}, peerStatuses)
+
+	pathMTU, err := testutil.GetGaugeMetricValue(metrics.MulticlusterGatewayUnderlayPathMTU.WithLabelValues("cluster-b", "172.18.0.10"))
+	require.NoError(t, err)
+	assert.Equal(t, float64(1450), pathMTU)
+	tunnelMTU, err := testutil.GetGaugeMetricValue(metrics.MulticlusterGatewayTunnelMTU.WithLabelValues("cluster-b", "172.18.0.10"))
+	require.NoError(t, err)
+	assert.Equal(t, float64(1400), tunnelMTU)
+	latency, err := testutil.GetGaugeMetricValue(metrics.MulticlusterGatewayUnderlayProbeLatency.WithLabelValues("cluster-c", "12.11.0.10"))
+	require.NoError(t, err)
+	assert.Equal(t, float64(4), latency)
+	packetLoss, err := testutil.GetGaugeMetricValue(metrics.MulticlusterGatewayUnderlayProbePacketLoss.WithLabelValues("cluster-c", "12.11.0.11"))
+	require.NoError(t, err)
+	assert.Equal(t, float64(100), packetLoss)
+	tunnelLatency, err := testutil.GetGaugeMetricValue(metrics.MulticlusterGatewayTunnelProbeLatency.WithLabelValues("cluster-b", "172.18.0.10"))
+	require.NoError(t, err)
+	assert.Equal(t, float64(3), tunnelLatency)
+	tunnelPacketLoss, err := testutil.GetGaugeMetricValue(metrics.MulticlusterGatewayTunnelProbePacketLoss.WithLabelValues("cluster-c", "12.11.0.10"))
+	require.NoError(t, err)
+	assert.Equal(t, float64(100), tunnelPacketLoss)
+
+	// The status and the metrics of the peer Gateways are removed when the peer cluster
+	// leaves the ClusterSet.
+	require.NoError(t, mcClient.MulticlusterV1alpha1().ClusterInfoImports(defaultNs).Delete(context.TODO(), ciImport2.Name, metav1.DeleteOptions{}))
+	assert.Eventually(t, func() bool {
+		ciImports, _ := p.ciImportLister.List(labels.Everything())
+		return len(ciImports) == 1
+	}, time.Second, 10*time.Millisecond)
+	p.probe()
+	gw, err = mcClient.MulticlusterV1alpha1().Gateways(defaultNs).Get(context.TODO(), gateway1.Name, metav1.GetOptions{})
+	require.NoError(t, err)
+	require.Len(t, gw.Status.PeerGateways, 1)
+	assert.Equal(t, "cluster-b", gw.Status.PeerGateways[0].ClusterID)
+	assert.Equal(t, sets.New[peerGateway](peerGateway{clusterID: "cluster-b", gatewayIP: "172.18.0.10"}), p.probedPeers)
+}
+
+func TestGatewayProberOnRegularNode(t *testing.T) {
+	defer func(original func(net.IP, int, time.Duration) (time.Duration, error)) {
+		echoFunc = original
+	}(echoFunc)
+	echoFunc = func(dstIP net.IP, size int, timeout time.Duration) (time.Duration, error) {
+		t.Errorf("Unexpected echo request to %s", dstIP)
+		return 0, nil
+	}
+	p, informerFactory, _ := newFakeGatewayProber(t, "node-3", []*mcv1alpha1.Gateway{gateway1.DeepCopy()},
+		[]*mcv1alpha1.ClusterInfoImport{clusterInfoImport1.DeepCopy()})
+	p.probedPeers.Insert(peerGateway{clusterID: "cluster-b", gatewayIP: "172.18.0.10"})
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	informerFactory.Start(stopCh)
+	informerFactory.WaitForCacheSync(stopCh)
+
+	p.probe()
+	assert.Empty(t, p.probedPeers)
+}
+
+func TestDiscoverPathMTU(t *testing.T) {
+	defer func(original func(net.IP, int, time.Duration) (time.Duration, error)) {
+		echoFunc = original
+	}(echoFunc)
+	tests := []struct {
+		name            string
+		pathMTU         int
+		expectedPathMTU int
+	}{
+		{
+			name:            "same as transport interface",
+			pathMTU:         1500,
+			expectedPathMTU: 1500,
+		},
+		{
+			name:            "reduced by tunnel",
+			pathMTU:         1400,
+			expectedPathMTU: 1400,
+		},
+		{
+			name:            "minimum MTU",
+			pathMTU:         576,
+			expectedPathMTU: 576,
+		},
+		{
+			name:            "below minimum MTU",
+			pathMTU:         500,
+			expectedPathMTU: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			echoFunc = func(dstIP net.IP, size int, timeout time.Duration) (time.Duration, error) {
+				if size > tt.pathMTU {
+					return 0, fmt.Errorf("timeout")
+				}
+				return time.Millisecond, nil
+			}
+			p, _, _ := newFakeGatewayProber(t, gateway1.Name, nil, nil)
+			assert.Equal(t, tt.expectedPathMTU, p.discoverPathMTU(net.ParseIP("172.18.0.10")))
+		})
+	}
+}
@@ -15,10 +15,13 @@
 package multicluster
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
+	"hash/fnv"
 	"net"
+	"sort"
 	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -85,9 +88,12 @@ type MCDefaultRouteController struct {
 	// we change the number of 'defaultWorkers'.
 	installedCIImports      map[string]*mcv1alpha1.ClusterInfoImport
 	installedWireGuardPeers map[string]*mcv1alpha1.ClusterInfoImport
-	// Need to use mutex to protect 'installedActiveGW' if we change to
+	// installedProbeIPs saves the probe IPs of the peer Gateways which are routed through
+	// the cross-cluster tunnel on the local Gateway, keyed by the ClusterInfoImport name.
+	installedProbeIPs map[string]sets.Set[string]
+	// Need to use mutex to protect 'installedActiveGWs' if we change to
 	// use multiple go routines to handle events
-	installedActiveGW *mcv1alpha1.Gateway
+	installedActiveGWs []*mcv1alpha1.Gateway
 	// The Namespace where Antrea Multi-cluster Controller is running.
 	namespace                    string
 	enableStretchedNetworkPolicy bool
@@ -120,6 +126,7 @@ func NewMCDefaultRouteController(
 		queue:                        workqueue.NewNamedRateLimitingQueue(workqueue.NewItemExponentialFailureRateLimiter(minRetryDelay, maxRetryDelay), "gatewayroute"),
 		installedCIImports:           make(map[string]*mcv1alpha1.ClusterInfoImport),
 		installedWireGuardPeers:      make(map[string]*mcv1alpha1.ClusterInfoImport),
+		installedProbeIPs:            make(map[string]sets.Set[string]),
 		namespace:                    multiclusterConfig.Namespace,
 		enableStretchedNetworkPolicy: multiclusterConfig.EnableStretchedNetworkPolicy,
 		enablePodToPodConnectivity:   multiclusterConfig.EnablePodToPodConnectivity,
@@ -209,9 +216,11 @@ func (c *MCDefaultRouteController) enqueueClusterInfoImport(obj interface{}, isD
 			klog.ErrorS(nil, "Received invalid ClusterInfoImport", "object", obj)
 			return
 		}
-		if net.ParseIP(ciImp.Spec.GatewayInfos[0].GatewayIP) == nil {
-			klog.ErrorS(nil, "Received ClusterInfoImport with invalid Gateway IP", "object", obj)
-			return
+		for _, gwInfo := range ciImp.Spec.GatewayInfos {
+			if net.ParseIP(gwInfo.GatewayIP) == nil {
+				klog.ErrorS(nil, "Received ClusterInfoImport with invalid Gateway IP", "object", obj)
+				return
+			}
 		}
 	}
 
@@ -280,10 +289,15 @@ func (c *MCDefaultRouteController) processNextWorkItem() bool {
 // Note: MCDefaultRouteController runs only one worker to process Gateway and ClusterInfoImport. So we do not need
 // any synchronization mechanism.
 func (c *MCDefaultRouteController) syncWireGuard() error {
-	gateway, err := c.getActiveGateway()
+	activeGWs, err := c.getActiveGateways()
 	if err != nil {
 		return err
 	}
+	// There is at most one active Gateway when WireGuard is enabled.
+	var gateway *mcv1alpha1.Gateway
+	if len(activeGWs) > 0 {
+		gateway = activeGWs[0]
+	}
 
 	amIGateway := gateway != nil && gateway.Name == c.nodeConfig.Name
 	if c.wireGuardClient != nil && (!amIGateway || !c.wireGuardInitialized) {
@@ -428,54 +442,56 @@ func (c *MCDefaultRouteController) syncMCFlows() error {
 	defer func() {
 		klog.V(4).InfoS("Finished syncing flows for Multi-cluster", "time", time.Since(startTime))
 	}()
-	activeGW, err := c.getActiveGateway()
+	activeGWs, err := c.getActiveGateways()
 	if err != nil {
 		return err
 	}
-	if activeGW == nil && c.installedActiveGW == nil {
+	if len(activeGWs) == 0 && len(c.installedActiveGWs) == 0 {
 		klog.V(2).InfoS("No active Gateway is found")
 		return nil
 	}
 
-	klog.V(2).InfoS("Installed Gateway", "gateway", klog.KObj(c.installedActiveGW))
-	if activeGW != nil && c.installedActiveGW != nil && activeGW.Name == c.installedActiveGW.Name {
-		// Active Gateway name doesn't change but still do a full flow sync
+	klog.V(2).InfoS("Installed Gateways", "gateways", len(c.installedActiveGWs))
+	amIGateway := getGateway(activeGWs, c.nodeConfig.Name) != nil
+	wasGateway := getGateway(c.installedActiveGWs, c.nodeConfig.Name) != nil
+	if len(activeGWs) > 0 && len(c.installedActiveGWs) > 0 && amIGateway == wasGateway {
+		// The role of the Node doesn't change but still do a full flow sync
 		// for any Gateway Spec or ClusterInfoImport changes.
-		if err := c.syncMCFlowsForAllCIImps(activeGW); err != nil {
+		if err := c.syncMCFlowsForAllCIImps(activeGWs); err != nil {
 			return err
 		}
-		c.installedActiveGW = activeGW
+		c.installedActiveGWs = activeGWs
 		return nil
 	}
 
-	if c.installedActiveGW != nil {
+	if len(c.installedActiveGWs) > 0 {
 		if err := c.deleteMCFlowsForAllCIImps(); err != nil {
 			return err
 		}
-		klog.V(2).InfoS("Deleted flows for installed Gateway", "gateway", klog.KObj(c.installedActiveGW))
-		c.installedActiveGW = nil
+		klog.V(2).InfoS("Deleted flows for installed Gateways", "gateways", len(c.installedActiveGWs))
+		c.installedActiveGWs = nil
 	}
 
-	if activeGW != nil {
-		if err := c.ofClient.InstallMulticlusterClassifierFlows(config.DefaultTunOFPort, activeGW.Name == c.nodeConfig.Name); err != nil {
+	if len(activeGWs) > 0 {
+		if err := c.ofClient.InstallMulticlusterClassifierFlows(config.DefaultTunOFPort, amIGateway); err != nil {
 			return err
 		}
-		c.installedActiveGW = activeGW
-		return c.addMCFlowsForAllCIImps(activeGW)
+		c.installedActiveGWs = activeGWs
+		return c.addMCFlowsForAllCIImps(activeGWs)
 	}
 	return nil
 }
 
-func (c *MCDefaultRouteController) syncMCFlowsForAllCIImps(activeGW *mcv1alpha1.Gateway) error {
+func (c *MCDefaultRouteController) syncMCFlowsForAllCIImps(activeGWs []*mcv1alpha1.Gateway) error {
 	desiredCIImports, err := c.ciImportLister.List(labels.Everything())
 	if err != nil {
 		return err
 	}
 
-	activeGWChanged := c.checkGatewayIPChange(activeGW)
+	activeGWChanged := c.checkGatewayChange(activeGWs)
 	installedCIImportNames := sets.KeySet(c.installedCIImports)
 	for _, ciImp := range desiredCIImports {
-		if err = c.addMCFlowsForSingleCIImp(activeGW, ciImp, c.installedCIImports[ciImp.Name], activeGWChanged); err != nil {
+		if err = c.addMCFlowsForSingleCIImp(activeGWs, ciImp, c.installedCIImports[ciImp.Name], activeGWChanged); err != nil {
 			return err
 		}
 		installedCIImportNames.Delete(ciImp.Name)
@@ -489,19 +505,32 @@ func (c *MCDefaultRouteController) syncMCFlowsForAllCIImps(activeGW *mcv1alpha1.
 	return nil
 }
 
-func (c *MCDefaultRouteController) checkGatewayIPChange(activeGW *mcv1alpha1.Gateway) bool {
-	var activeGWChanged bool
-	if activeGW.Name == c.nodeConfig.Name {
-		// On a Gateway Node, the GatewayIP of the active Gateway will impact the Openflow rules.
-		activeGWChanged = activeGW.GatewayIP != c.installedActiveGW.GatewayIP
-	} else {
-		// On a regular Node, the InternalIP of the active Gateway will impact the Openflow rules.
-		activeGWChanged = activeGW.InternalIP != c.installedActiveGW.InternalIP
+// checkGatewayChange checks if there is any change of the active Gateways which
+// impacts the Openflow rules on the Node.
+func (c *MCDefaultRouteController) checkGatewayChange(activeGWs []*mcv1alpha1.Gateway) bool {
+	if localGW := getGateway(activeGWs, c.nodeConfig.Name); localGW != nil {
+		// On a Gateway Node, only the GatewayIP of the local Gateway will impact the Openflow rules.
+		return localGW.GatewayIP != getGateway(c.installedActiveGWs, c.nodeConfig.Name).GatewayIP
+	}
+	// On a regular Node, the InternalIPs of the active Gateways will impact the Openflow rules.
+	// The GatewayIPs are used to select the local Gateway for reply packets when there are
+	// multiple active Gateways.
+	if len(activeGWs) != len(c.installedActiveGWs) {
+		return true
+	}
+	for i, gw := range activeGWs {
+		installedGW := c.installedActiveGWs[i]
+		if gw.Name != installedGW.Name || gw.InternalIP != installedGW.InternalIP {
+			return true
+		}
+		if len(activeGWs) > 1 && gw.GatewayIP != installedGW.GatewayIP {
+			return true
+		}
 	}
-	return activeGWChanged
+	return false
 }
 
-func (c *MCDefaultRouteController) addMCFlowsForAllCIImps(activeGW *mcv1alpha1.Gateway) error {
+func (c *MCDefaultRouteController) addMCFlowsForAllCIImps(activeGWs []*mcv1alpha1.Gateway) error {
 	allCIImports, err := c.ciImportLister.List(labels.Everything())
 	if err != nil {
 		return err
@@ -511,7 +540,7 @@ func (c *MCDefaultRouteController) addMCFlowsForAllCIImps(activeGW *mcv1alpha1.G
 		return nil
 	}
 	for _, ciImport := range allCIImports {
-		if err := c.addMCFlowsForSingleCIImp(activeGW, ciImport, nil, true); err != nil {
+		if err := c.addMCFlowsForSingleCIImp(activeGWs, ciImport, nil, true); err != nil {
 			return err
 		}
 	}
@@ -519,63 +548,91 @@ func (c *MCDefaultRouteController) addMCFlowsForAllCIImps(activeGW *mcv1alpha1.G
 	return nil
 }
 
-func (c *MCDefaultRouteController) addMCFlowsForSingleCIImp(activeGW *mcv1alpha1.Gateway, ciImport *mcv1alpha1.ClusterInfoImport,
+func (c *MCDefaultRouteController) addMCFlowsForSingleCIImp(activeGWs []*mcv1alpha1.Gateway, ciImport *mcv1alpha1.ClusterInfoImport,
 	installedCIImp *mcv1alpha1.ClusterInfoImport, activeGWChanged bool) error {
-	tunnelPeerIPToRemoteGW := getPeerGatewayTunnelIP(ciImport.Spec, c.wireGuardConfig != nil)
-	if tunnelPeerIPToRemoteGW == nil {
+	tunnelPeerIPsToRemoteGWs := getPeerGatewayTunnelIPs(ciImport.Spec, c.wireGuardConfig != nil)
+	if len(tunnelPeerIPsToRemoteGWs) == 0 {
 		klog.ErrorS(nil, "The ClusterInfoImport has no valid Gateway IP, skip it", "clusterinfoimport", klog.KObj(ciImport))
 		return nil
 	}
 
 	var ciImportNoChange bool
 	if installedCIImp != nil {
-		oldTunnelPeerIPToRemoteGW := getPeerGatewayTunnelIP(installedCIImp.Spec, c.wireGuardConfig != nil)
-		ciImportNoChange = oldTunnelPeerIPToRemoteGW.Equal(tunnelPeerIPToRemoteGW) && installedCIImp.Spec.ServiceCIDR == ciImport.Spec.ServiceCIDR
+		oldTunnelPeerIPsToRemoteGWs := getPeerGatewayTunnelIPs(installedCIImp.Spec, c.wireGuardConfig != nil)
+		ciImportNoChange = ipsEqual(oldTunnelPeerIPsToRemoteGWs, tunnelPeerIPsToRemoteGWs) && installedCIImp.Spec.ServiceCIDR == ciImport.Spec.ServiceCIDR &&
+			sets.KeySet(getPeerGatewayProbeIPs(installedCIImp.Spec, c.wireGuardConfig != nil)).Equal(sets.KeySet(getPeerGatewayProbeIPs(ciImport.Spec, c.wireGuardConfig != nil)))
 		if c.enablePodToPodConnectivity {
 			ciImportNoChange = ciImportNoChange && sets.New[string](installedCIImp.Spec.PodCIDRs...).Equal(sets.New[string](ciImport.Spec.PodCIDRs...))
 		}
 	}
 
 	if ciImportNoChange && !activeGWChanged {
-		klog.V(2).InfoS("ClusterInfoImport and the active Gateway have no change, skip updating", "clusterinfoimport", klog.KObj(ciImport), "gateway", klog.KObj(activeGW))
+		klog.V(2).InfoS("ClusterInfoImport and the active Gateways have no change, skip updating", "clusterinfoimport", klog.KObj(ciImport))
 		return nil
 	}
 
-	klog.InfoS("Adding/updating remote Gateway Node flows for Multi-cluster", "gateway", klog.KObj(activeGW),
-		"node", c.nodeConfig.Name, "peer", tunnelPeerIPToRemoteGW)
+	klog.InfoS("Adding/updating remote Gateway Node flows for Multi-cluster", "node", c.nodeConfig.Name,
+		"clusterinfoimport", klog.KObj(ciImport), "peers", tunnelPeerIPsToRemoteGWs)
 	allCIDRs := []string{ciImport.Spec.ServiceCIDR}
 	if c.enablePodToPodConnectivity {
 		allCIDRs = append(allCIDRs, ciImport.Spec.PodCIDRs...)
 	}
-	peerConfigs, err := generatePeerConfigs(allCIDRs, tunnelPeerIPToRemoteGW)
+	peerCIDRs, err := parsePeerCIDRs(allCIDRs)
 	if err != nil {
-		klog.ErrorS(err, "Parse error for serviceCIDR from remote cluster", "clusterinfoimport", ciImport.Name, "gateway", activeGW.Name)
+		klog.ErrorS(err, "Parse error for serviceCIDR from remote cluster", "clusterinfoimport", ciImport.Name)
 		return err
 	}
-	if activeGW.Name == c.nodeConfig.Name {
-		klog.V(2).InfoS("Adding/updating flows to remote Gateway Node for Multi-cluster traffic", "clusterinfoimport", ciImport.Name, "cidrs", allCIDRs)
-		localGatewayIP := getLocalGatewayIP(activeGW, c.wireGuardConfig != nil)
+	if localGW := getGateway(activeGWs, c.nodeConfig.Name); localGW != nil {
+		klog.V(2).InfoS("Adding/updating flows to remote Gateway Nodes for Multi-cluster traffic", "clusterinfoimport", ciImport.Name, "cidrs", allCIDRs)
+		localGatewayIP := getLocalGatewayIP(localGW, c.wireGuardConfig != nil)
 		if localGatewayIP == nil {
-			klog.V(2).InfoS("Local Gateway IP has not been allocated, skip", "gateway", klog.KObj(activeGW))
+			klog.V(2).InfoS("Local Gateway IP has not been allocated, skip", "gateway", klog.KObj(localGW))
 			return nil
 		}
+		// The remote Gateway is selected by the local Gateway IP, which is the source IP of
+		// the cross-cluster connections after SNAT. The remote cluster makes the same selection
+		// for the reply packets, so that both directions go through the same pair of Gateways.
+		tunnelPeerIPToRemoteGW := tunnelPeerIPsToRemoteGWs[selectGateway(localGatewayIP, tunnelPeerIPsToRemoteGWs)]
+		// The probes to a remote Gateway are always sent to the Gateway itself, so that
+		// GatewayProber tests the tunnel to every remote Gateway.
+		probeIPPeers := getPeerGatewayProbeIPs(ciImport.Spec, c.wireGuardConfig != nil)
 		if err := c.ofClient.InstallMulticlusterGatewayFlows(
 			ciImport.Name,
-			peerConfigs,
+			peerCIDRs,
 			tunnelPeerIPToRemoteGW,
+			tunnelPeerIPsToRemoteGWs,
+			probeIPPeers,
 			localGatewayIP,
 			c.enableStretchedNetworkPolicy); err != nil {
 			return fmt.Errorf("failed to install flows to remote Gateway in ClusterInfoImport %s: %v", ciImport.Name, err)
 		}
+		if err := c.syncProbeIPRoutes(ciImport.Name, sets.KeySet(probeIPPeers)); err != nil {
+			return fmt.Errorf("failed to sync routes to the probe IPs of remote Gateways in ClusterInfoImport %s: %v", ciImport.Name, err)
+		}
 	} else {
-		klog.V(2).InfoS("Adding/updating flows to the local active Gateway for Multi-cluster traffic", "clusterinfoimport", ciImport.Name, "cidrs", allCIDRs)
-		tunnelPeerIPToLocalGW := net.ParseIP(activeGW.InternalIP)
+		if err := c.syncProbeIPRoutes(ciImport.Name, nil); err != nil {
+			return fmt.Errorf("failed to delete routes to the probe IPs of remote Gateways in ClusterInfoImport %s: %v", ciImport.Name, err)
+		}
+		klog.V(2).InfoS("Adding/updating flows to the local active Gateways for Multi-cluster traffic", "clusterinfoimport", ciImport.Name, "cidrs", allCIDRs)
+		tunnelPeerIPsToLocalGWs := make([]net.IP, 0, len(activeGWs))
+		localGatewayIPs := make([]net.IP, 0, len(activeGWs))
+		for _, gw := range activeGWs {
+			tunnelPeerIPsToLocalGWs = append(tunnelPeerIPsToLocalGWs, net.ParseIP(gw.InternalIP))
+			localGatewayIPs = append(localGatewayIPs, net.ParseIP(gw.GatewayIP))
+		}
+		// Reply packets to a remote Gateway must go through the local Gateway which received the
+		// requests from the remote Gateway and holds the conntrack states of the connections.
+		remoteGatewayPeers := make(map[string]net.IP, len(tunnelPeerIPsToRemoteGWs))
+		for _, remoteGatewayIP := range tunnelPeerIPsToRemoteGWs {
+			remoteGatewayPeers[remoteGatewayIP.String()] = tunnelPeerIPsToLocalGWs[selectGateway(remoteGatewayIP, localGatewayIPs)]
+		}
 		if err := c.ofClient.InstallMulticlusterNodeFlows(
 			ciImport.Name,
-			peerConfigs,
-			tunnelPeerIPToLocalGW,
+			peerCIDRs,
+			tunnelPeerIPsToLocalGWs,
+			remoteGatewayPeers,
 			c.enableStretchedNetworkPolicy); err != nil {
-			return fmt.Errorf("failed to install flows to Gateway %s: %v", activeGW.Name, err)
+			return fmt.Errorf("failed to install flows to local Gateways: %v", err)
 		}
 	}
 
@@ -587,10 +644,40 @@ func (c *MCDefaultRouteController) deleteMCFlowsForSingleCIImp(ciImpName string)
 	if err := c.ofClient.UninstallMulticlusterFlows(ciImpName); err != nil {
 		return fmt.Errorf("failed to uninstall multi-cluster flows to remote Gateway Node %s: %v", ciImpName, err)
 	}
+	if err := c.syncProbeIPRoutes(ciImpName, nil); err != nil {
+		return fmt.Errorf("failed to delete routes to the probe IPs of remote Gateways in ClusterInfoImport %s: %v", ciImpName, err)
+	}
 	delete(c.installedCIImports, ciImpName)
 	return nil
 }
 
+// syncProbeIPRoutes routes the probe IPs of the remote Gateways in a ClusterInfoImport via
+// antrea-gw0, so the probes sent by GatewayProber reach the flows which forward them through
+// the cross-cluster tunnel, and deletes the routes to the probe IPs which are not desired.
+func (c *MCDefaultRouteController) syncProbeIPRoutes(ciImpName string, desiredProbeIPs sets.Set[string]) error {
+	installedProbeIPs := c.installedProbeIPs[ciImpName]
+	for probeIP := range desiredProbeIPs.Difference(installedProbeIPs) {
+		if err := c.routeClient.AddExternalIPRoute(net.ParseIP(probeIP)); err != nil {
+			return err
+		}
+		if installedProbeIPs == nil {
+			installedProbeIPs = sets.New[string]()
+			c.installedProbeIPs[ciImpName] = installedProbeIPs
+		}
+		installedProbeIPs.Insert(probeIP)
+	}
+	for probeIP := range installedProbeIPs.Difference(desiredProbeIPs) {
+		if err := c.routeClient.DeleteExternalIPRoute(net.ParseIP(probeIP)); err != nil {
+			return err
+		}
+		installedProbeIPs.Delete(probeIP)
+	}
+	if installedProbeIPs != nil && installedProbeIPs.Len() == 0 {
+		delete(c.installedProbeIPs, ciImpName)
+	}
+	return nil
+}
+
 func (c *MCDefaultRouteController) deleteMCFlowsForAllCIImps() error {
 	for _, ciImp := range c.installedCIImports {
 		c.deleteMCFlowsForSingleCIImp(ciImp.Name)
@@ -598,61 +685,144 @@ func (c *MCDefaultRouteController) deleteMCFlowsForAllCIImps() error {
 	return nil
 }
 
-func (c *MCDefaultRouteController) getActiveGateway() (*mcv1alpha1.Gateway, error) {
-	activeGW, err := getActiveGateway(c.gwLister)
+// getActiveGateways returns the valid Gateways sorted by name. When WireGuard is enabled,
+// only the first Gateway is active, since the WireGuard public key and tunnel IP of a
+// member cluster are shared by all other member clusters.
+func (c *MCDefaultRouteController) getActiveGateways() ([]*mcv1alpha1.Gateway, error) {
+	gws, err := getActiveGateways(c.gwLister)
 	if err != nil {
 		return nil, err
 	}
-	if activeGW == nil {
-		return nil, nil
+	activeGWs := make([]*mcv1alpha1.Gateway, 0, len(gws))
+	for _, gw := range gws {
+		if net.ParseIP(gw.GatewayIP) == nil || net.ParseIP(gw.InternalIP) == nil {
+			klog.ErrorS(nil, "The Gateway has no valid GatewayIP or InternalIP, skip it", "gateway", klog.KObj(gw))
+			continue
+		}
+		activeGWs = append(activeGWs, gw)
 	}
-	if net.ParseIP(activeGW.GatewayIP) == nil || net.ParseIP(activeGW.InternalIP) == nil {
-		return nil, fmt.Errorf("the active Gateway %s has no valid GatewayIP or InternalIP", activeGW.Name)
+	if c.wireGuardConfig != nil && len(activeGWs) > 1 {
+		activeGWs = activeGWs[:1]
 	}
-	return activeGW, nil
+	return activeGWs, nil
 }
 
-func getActiveGateway(gwLister mclisters.GatewayLister) (*mcv1alpha1.Gateway, error) {
+// getActiveGateways returns all Gateways in the member cluster sorted by name.
+// All Gateways are active and share the cross-cluster traffic.
+func getActiveGateways(gwLister mclisters.GatewayLister) ([]*mcv1alpha1.Gateway, error) {
 	gws, err := gwLister.List(labels.Everything())
 	if err != nil {
 		return nil, err
 	}
-	if len(gws) == 0 {
-		return nil, nil
+	sort.Slice(gws, func(i, j int) bool {
+		return gws[i].Name < gws[j].Name
+	})
+	return gws, nil
+}
+
+func getGateway(gateways []*mcv1alpha1.Gateway, name string) *mcv1alpha1.Gateway {
+	for _, gw := range gateways {
+		if gw.Name == name {
+			return gw
+		}
+	}
+	return nil
+}
+
+// selectGateway returns the index of the Gateway selected for the key from the
+// candidate Gateway IPs with rendezvous hashing. The selection doesn't depend on
+// the order of the candidates, so every Node in all member clusters makes the same
+// selection for the same key, and removing a Gateway only changes the selection
+// of the keys which selected the removed Gateway.
+func selectGateway(key net.IP, candidates []net.IP) int {
+	selected := 0
+	var maxWeight uint64
+	for i, candidate := range candidates {
+		h := fnv.New64a()
+		h.Write(key.To16())
+		h.Write(candidate.To16())
+		weight := h.Sum64()
+		if i == 0 || weight > maxWeight || (weight == maxWeight && bytes.Compare(candidate.To16(), candidates[selected].To16()) < 0) {
+			selected = i
+			maxWeight = weight
+		}
 	}
-	// The Gateway webhook guarantees there will be at most one Gateway in a cluster.
-	return gws[0], nil
+	return selected
 }
 
-func generatePeerConfigs(subnets []string, gatewayIP net.IP) (map[*net.IPNet]net.IP, error) {
-	peerConfigs := make(map[*net.IPNet]net.IP, len(subnets))
+func parsePeerCIDRs(subnets []string) ([]*net.IPNet, error) {
+	peerCIDRs := make([]*net.IPNet, 0, len(subnets))
 	for _, subnet := range subnets {
 		_, peerCIDR, err := net.ParseCIDR(subnet)
 		if err != nil {
 			return nil, err
 		}
-		peerConfigs[peerCIDR] = gatewayIP
+		peerCIDRs = append(peerCIDRs, peerCIDR)
 	}
-	return peerConfigs, nil
+	return peerCIDRs, nil
 }
 
-// If WireGuard is disabled, getPeerGatewayTunnelIP will return Gateway's GatewayIP.
+// If WireGuard is disabled, getPeerGatewayTunnelIPs will return the GatewayIPs of all
+// Gateways in the peer cluster.
 // If WireGuard is enabled, the WireGuard interfaces use the first IP address of ServiceCIDR
-// as its IP address. So getPeerGatewayTunnelIP will return the first IP of the ServiceCIDR
-// as the remote Gateway tunnel IP.
-func getPeerGatewayTunnelIP(spec mcv1alpha1.ClusterInfo, enableWireGuard bool) net.IP {
+// as its IP address. So getPeerGatewayTunnelIPs will return the first IP of the ServiceCIDR
+// as the only remote Gateway tunnel IP.
+func getPeerGatewayTunnelIPs(spec mcv1alpha1.ClusterInfo, enableWireGuard bool) []net.IP {
 	if enableWireGuard {
 		if spec.ServiceCIDR == "" {
 			klog.InfoS("The ServiceCIDR of the peer cluster has not been updated, skip it", "clusterID", spec.ClusterID)
 			return nil
 		}
 		_, serviceCIDR, _ := net.ParseCIDR(spec.ServiceCIDR)
-		return serviceCIDR.IP
+		return []net.IP{serviceCIDR.IP}
 	}
-	if len(spec.GatewayInfos) == 0 {
-		return nil
+	var gatewayIPs []net.IP
+	for _, gwInfo := range spec.GatewayInfos {
+		if gatewayIP := net.ParseIP(gwInfo.GatewayIP); gatewayIP != nil {
+			gatewayIPs = append(gatewayIPs, gatewayIP)
+		}
 	}
-	return net.ParseIP(spec.GatewayInfos[0].GatewayIP)
+	return gatewayIPs
+}
+
+// getPeerGatewayProbeIPs returns the IPv4 probe IPs of the Gateways in the peer cluster,
+// mapped to the tunnel IPs of the Gateways. If WireGuard is enabled, all probe IPs are
+// mapped to the only remote Gateway tunnel IP returned by getPeerGatewayTunnelIPs.
+func getPeerGatewayProbeIPs(spec mcv1alpha1.ClusterInfo, enableWireGuard bool) map[string]net.IP {
+	var wireGuardIP net.IP
+	if enableWireGuard {
+		tunnelIPs := getPeerGatewayTunnelIPs(spec, true)
+		if len(tunnelIPs) == 0 {
+			return nil
+		}
+		wireGuardIP = tunnelIPs[0]
+	}
+	probeIPPeers := map[string]net.IP{}
+	for _, gwInfo := range spec.GatewayInfos {
+		probeIP := net.ParseIP(gwInfo.ProbeIP)
+		gatewayIP := net.ParseIP(gwInfo.GatewayIP)
+		if probeIP.To4() == nil || gatewayIP == nil {
+			continue
+		}
+		if wireGuardIP != nil {
+			probeIPPeers[probeIP.String()] = wireGuardIP
+		} else {
+			probeIPPeers[probeIP.String()] = gatewayIP
+		}
+	}
+	return probeIPPeers
+}
+
+func ipsEqual(a, b []net.IP) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !a[i].Equal(b[i]) {
+			return false
+		}
+	}
+	return true
 }
 
 func getLocalGatewayIP(gateway *mcv1alpha1.Gateway, enableWireGuard bool) net.IP {
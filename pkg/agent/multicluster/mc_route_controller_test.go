@@ -231,13 +231,13 @@ func TestMCRouteControllerAsWireGuardGateway(t *testing.T) {
 		// Create ClusterInfoImport3
 		c.mcClient.MulticlusterV1alpha1().ClusterInfoImports(clusterInfoImport3.GetNamespace()).
 			Create(context.TODO(), &clusterInfoImport3, metav1.CreateOptions{})
-		peerNodeIP3 := getPeerGatewayTunnelIP(clusterInfoImport3.Spec, true)
+		peerNodeIP3 := getPeerGatewayTunnelIPs(clusterInfoImport3.Spec, true)[0]
 		remoteWGIP, _, _ := net.ParseCIDR(clusterInfoImport3.Spec.ServiceCIDR)
 		remoteWireGuardNet := &net.IPNet{IP: remoteWGIP, Mask: net.CIDRMask(32, 32)}
 		c.wireGuardClient.EXPECT().UpdatePeer(clusterInfoImport3.Name, clusterInfoImport3.Spec.WireGuard.PublicKey,
 			net.ParseIP(clusterInfoImport3.Spec.GatewayInfos[0].GatewayIP), []*net.IPNet{remoteWireGuardNet})
 		c.ofClient.EXPECT().InstallMulticlusterGatewayFlows(clusterInfoImport3.Name,
-			gomock.Any(), peerNodeIP3, gomock.Any(), true).Times(1)
+			gomock.Any(), peerNodeIP3, gomock.Any(), gomock.Any(), gomock.Any(), true).Times(1)
 		mockInterface.EXPECT().AddRouteForLink(gomock.Any(), 0).Times(1)
 		c.processNextWorkItem()
 
@@ -255,12 +255,14 @@ func TestMCRouteControllerAsWireGuardGateway(t *testing.T) {
 }
 
 func TestMCRouteControllerAsGateway(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockInterface := routemock.NewMockInterface(ctrl)
 	c := newMCDefaultRouteController(
 		t,
 		&config.NodeConfig{Name: "node-1"},
 		&config.NetworkConfig{},
 		agent.WireGuardConfig{},
-		nil,
+		mockInterface,
 		"none",
 		nil,
 	)
@@ -284,16 +286,16 @@ func TestMCRouteControllerAsGateway(t *testing.T) {
 		// Create two ClusterInfoImports
 		c.mcClient.MulticlusterV1alpha1().ClusterInfoImports(clusterInfoImport1.GetNamespace()).
 			Create(context.TODO(), &clusterInfoImport1, metav1.CreateOptions{})
-		peerNodeIP1 := getPeerGatewayTunnelIP(clusterInfoImport1.Spec, false)
+		peerNodeIP1 := getPeerGatewayTunnelIPs(clusterInfoImport1.Spec, false)[0]
 		c.ofClient.EXPECT().InstallMulticlusterGatewayFlows(clusterInfoImport1.Name,
-			gomock.Any(), peerNodeIP1, gw1GatewayIP, true).Times(1)
+			gomock.Any(), peerNodeIP1, gomock.Any(), gomock.Any(), gw1GatewayIP, true).Times(1)
 		c.processNextWorkItem()
 
 		c.mcClient.MulticlusterV1alpha1().ClusterInfoImports(clusterInfoImport2.GetNamespace()).
 			Create(context.TODO(), &clusterInfoImport2, metav1.CreateOptions{})
-		peerNodeIP2 := getPeerGatewayTunnelIP(clusterInfoImport2.Spec, false)
+		peerNodeIP2 := getPeerGatewayTunnelIPs(clusterInfoImport2.Spec, false)[0]
 		c.ofClient.EXPECT().InstallMulticlusterGatewayFlows(clusterInfoImport2.Name,
-			gomock.Any(), peerNodeIP2, gw1GatewayIP, true).Times(1)
+			gomock.Any(), peerNodeIP2, gomock.Any(), gomock.Any(), gw1GatewayIP, true).Times(1)
 		c.processNextWorkItem()
 
 		// Update a ClusterInfoImport
@@ -301,7 +303,18 @@ func TestMCRouteControllerAsGateway(t *testing.T) {
 		c.mcClient.MulticlusterV1alpha1().ClusterInfoImports(clusterInfoImport1.GetNamespace()).
 			Update(context.TODO(), &clusterInfoImport1, metav1.UpdateOptions{})
 		c.ofClient.EXPECT().InstallMulticlusterGatewayFlows(clusterInfoImport1.Name,
-			gomock.Any(), peerNodeIP1, gw1GatewayIP, true).Times(1)
+			gomock.Any(), peerNodeIP1, gomock.Any(), gomock.Any(), gw1GatewayIP, true).Times(1)
+		c.processNextWorkItem()
+
+		// Add a probe IP to the Gateway of a ClusterInfoImport
+		probeIP1 := net.ParseIP("10.244.2.1")
+		updatedCIImport1 := clusterInfoImport1.DeepCopy()
+		updatedCIImport1.Spec.GatewayInfos[0].ProbeIP = probeIP1.String()
+		c.mcClient.MulticlusterV1alpha1().ClusterInfoImports(updatedCIImport1.GetNamespace()).
+			Update(context.TODO(), updatedCIImport1, metav1.UpdateOptions{})
+		c.ofClient.EXPECT().InstallMulticlusterGatewayFlows(clusterInfoImport1.Name,
+			gomock.Any(), peerNodeIP1, gomock.Any(), map[string]net.IP{probeIP1.String(): peerNodeIP1}, gw1GatewayIP, true).Times(1)
+		mockInterface.EXPECT().AddExternalIPRoute(probeIP1).Times(1)
 		c.processNextWorkItem()
 
 		// Delete a ClusterInfoImport
@@ -317,7 +330,7 @@ func TestMCRouteControllerAsGateway(t *testing.T) {
 		c.mcClient.MulticlusterV1alpha1().Gateways(updatedGateway1a.GetNamespace()).Update(context.TODO(),
 			updatedGateway1a, metav1.UpdateOptions{})
 		c.ofClient.EXPECT().InstallMulticlusterGatewayFlows(clusterInfoImport1.Name,
-			gomock.Any(), peerNodeIP1, updatedGateway1aIP, true).Times(1)
+			gomock.Any(), peerNodeIP1, gomock.Any(), gomock.Any(), updatedGateway1aIP, true).Times(1)
 		c.processNextWorkItem()
 
 		// Update Gateway1's InternalIP
@@ -331,13 +344,14 @@ func TestMCRouteControllerAsGateway(t *testing.T) {
 		c.mcClient.MulticlusterV1alpha1().Gateways(gateway1.GetNamespace()).Delete(context.TODO(),
 			gateway1.Name, metav1.DeleteOptions{})
 		c.ofClient.EXPECT().UninstallMulticlusterFlows(clusterInfoImport1.Name).Times(1)
+		mockInterface.EXPECT().DeleteExternalIPRoute(probeIP1).Times(1)
 		c.processNextWorkItem()
 
 		// Create Gateway2 as active Gateway
 		c.mcClient.MulticlusterV1alpha1().Gateways(gateway2.GetNamespace()).Create(context.TODO(),
 			&gateway2, metav1.CreateOptions{})
 		c.ofClient.EXPECT().InstallMulticlusterClassifierFlows(uint32(1), false).Times(1)
-		c.ofClient.EXPECT().InstallMulticlusterNodeFlows(clusterInfoImport1.Name, gomock.Any(), gw2InternalIP, true).Times(1)
+		c.ofClient.EXPECT().InstallMulticlusterNodeFlows(clusterInfoImport1.Name, gomock.Any(), []net.IP{gw2InternalIP}, gomock.Any(), true).Times(1)
 		c.processNextWorkItem()
 	}()
 	select {
@@ -380,13 +394,13 @@ func TestMCRouteControllerAsRegularNode(t *testing.T) {
 		c.mcClient.MulticlusterV1alpha1().ClusterInfoImports(clusterInfoImport1.GetNamespace()).
 			Create(context.TODO(), &clusterInfoImport1, metav1.CreateOptions{})
 		c.ofClient.EXPECT().InstallMulticlusterNodeFlows(clusterInfoImport1.Name,
-			gomock.Any(), peerNodeIP1, true).Times(1)
+			gomock.Any(), []net.IP{peerNodeIP1}, gomock.Any(), true).Times(1)
 		c.processNextWorkItem()
 
 		c.mcClient.MulticlusterV1alpha1().ClusterInfoImports(clusterInfoImport2.GetNamespace()).
 			Create(context.TODO(), &clusterInfoImport2, metav1.CreateOptions{})
 		c.ofClient.EXPECT().InstallMulticlusterNodeFlows(clusterInfoImport2.Name,
-			gomock.Any(), peerNodeIP1, true).Times(1)
+			gomock.Any(), []net.IP{peerNodeIP1}, gomock.Any(), true).Times(1)
 		c.processNextWorkItem()
 
 		// Update a ClusterInfoImport
@@ -394,7 +408,7 @@ func TestMCRouteControllerAsRegularNode(t *testing.T) {
 		c.mcClient.MulticlusterV1alpha1().ClusterInfoImports(clusterInfoImport1.GetNamespace()).
 			Update(context.TODO(), &clusterInfoImport1, metav1.UpdateOptions{})
 		c.ofClient.EXPECT().InstallMulticlusterNodeFlows(clusterInfoImport1.Name,
-			gomock.Any(), peerNodeIP1, true).Times(1)
+			gomock.Any(), []net.IP{peerNodeIP1}, gomock.Any(), true).Times(1)
 		c.processNextWorkItem()
 
 		// Delete a ClusterInfoImport
@@ -417,7 +431,7 @@ func TestMCRouteControllerAsRegularNode(t *testing.T) {
 		c.mcClient.MulticlusterV1alpha1().Gateways(updatedGateway1b.GetNamespace()).Update(context.TODO(),
 			updatedGateway1b, metav1.UpdateOptions{})
 		c.ofClient.EXPECT().InstallMulticlusterNodeFlows(clusterInfoImport1.Name,
-			gomock.Any(), updatedGateway1bIP, true).Times(1)
+			gomock.Any(), []net.IP{updatedGateway1bIP}, gomock.Any(), true).Times(1)
 		c.processNextWorkItem()
 
 		// Delete Gateway1
@@ -430,7 +444,7 @@ func TestMCRouteControllerAsRegularNode(t *testing.T) {
 		c.mcClient.MulticlusterV1alpha1().Gateways(gateway2.GetNamespace()).Create(context.TODO(),
 			&gateway2, metav1.CreateOptions{})
 		c.ofClient.EXPECT().InstallMulticlusterClassifierFlows(uint32(1), false).Times(1)
-		c.ofClient.EXPECT().InstallMulticlusterNodeFlows(clusterInfoImport1.Name, gomock.Any(), peerNodeIP2, true).Times(1)
+		c.ofClient.EXPECT().InstallMulticlusterNodeFlows(clusterInfoImport1.Name, gomock.Any(), []net.IP{peerNodeIP2}, gomock.Any(), true).Times(1)
 		c.processNextWorkItem()
 	}()
 	select {
@@ -440,6 +454,126 @@ func TestMCRouteControllerAsRegularNode(t *testing.T) {
 	}
 }
 
+func TestMCRouteControllerWithMultipleGateways(t *testing.T) {
+	c := newMCDefaultRouteController(
+		t,
+		&config.NodeConfig{Name: "node-3"},
+		&config.NetworkConfig{},
+		agent.WireGuardConfig{},
+		nil,
+		"none",
+		nil,
+	)
+	defer c.queue.ShutDown()
+
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	c.informerFactory.Start(stopCh)
+	c.informerFactory.WaitForCacheSync(stopCh)
+
+	ciImport := &mcv1alpha1.ClusterInfoImport{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "cluster-e-default-clusterinfo",
+			Namespace: "default",
+		},
+		Spec: mcv1alpha1.ClusterInfo{
+			ClusterID:   "cluster-e",
+			ServiceCIDR: "10.15.0.0/16",
+			GatewayInfos: []mcv1alpha1.GatewayInfo{
+				{GatewayIP: "172.19.0.10"},
+				{GatewayIP: "172.19.0.11"},
+				{GatewayIP: "172.19.0.12"},
+			},
+		},
+	}
+	gw1InternalIP := net.ParseIP(gateway1.InternalIP)
+	localGatewayIPs := []net.IP{net.ParseIP(gateway1.GatewayIP), net.ParseIP(gateway2.GatewayIP)}
+	tunnelPeerIPsToLocalGWs := []net.IP{gw1InternalIP, gw2InternalIP}
+	expectedRemoteGatewayPeers := func(tunnelPeerIPsToLocalGWs, localGatewayIPs []net.IP) map[string]net.IP {
+		peers := map[string]net.IP{}
+		for _, remoteGatewayIP := range getPeerGatewayTunnelIPs(ciImport.Spec, false) {
+			peers[remoteGatewayIP.String()] = tunnelPeerIPsToLocalGWs[selectGateway(remoteGatewayIP, localGatewayIPs)]
+		}
+		return peers
+	}
+
+	finishCh := make(chan struct{})
+	go func() {
+		defer close(finishCh)
+
+		// Create Gateway1 and a ClusterInfoImport with multiple Gateways
+		c.mcClient.MulticlusterV1alpha1().Gateways(gateway1.GetNamespace()).Create(context.TODO(),
+			&gateway1, metav1.CreateOptions{})
+		c.ofClient.EXPECT().InstallMulticlusterClassifierFlows(uint32(1), false).Times(1)
+		c.processNextWorkItem()
+		c.mcClient.MulticlusterV1alpha1().ClusterInfoImports(ciImport.GetNamespace()).
+			Create(context.TODO(), ciImport, metav1.CreateOptions{})
+		c.ofClient.EXPECT().InstallMulticlusterNodeFlows(ciImport.Name, gomock.Any(), []net.IP{gw1InternalIP},
+			expectedRemoteGatewayPeers([]net.IP{gw1InternalIP}, localGatewayIPs[:1]), true).Times(1)
+		c.processNextWorkItem()
+
+		// Create Gateway2 as another active Gateway
+		c.mcClient.MulticlusterV1alpha1().Gateways(gateway2.GetNamespace()).Create(context.TODO(),
+			&gateway2, metav1.CreateOptions{})
+		c.ofClient.EXPECT().InstallMulticlusterNodeFlows(ciImport.Name, gomock.Any(), tunnelPeerIPsToLocalGWs,
+			expectedRemoteGatewayPeers(tunnelPeerIPsToLocalGWs, localGatewayIPs), true).Times(1)
+		c.processNextWorkItem()
+
+		// Update Gateway2's GatewayIP
+		updatedGateway2 := gateway2.DeepCopy()
+		updatedGateway2.GatewayIP = "172.17.0.22"
+		updatedLocalGatewayIPs := []net.IP{localGatewayIPs[0], net.ParseIP(updatedGateway2.GatewayIP)}
+		c.mcClient.MulticlusterV1alpha1().Gateways(updatedGateway2.GetNamespace()).Update(context.TODO(),
+			updatedGateway2, metav1.UpdateOptions{})
+		c.ofClient.EXPECT().InstallMulticlusterNodeFlows(ciImport.Name, gomock.Any(), tunnelPeerIPsToLocalGWs,
+			expectedRemoteGatewayPeers(tunnelPeerIPsToLocalGWs, updatedLocalGatewayIPs), true).Times(1)
+		c.processNextWorkItem()
+
+		// Delete Gateway1
+		c.mcClient.MulticlusterV1alpha1().Gateways(gateway1.GetNamespace()).Delete(context.TODO(),
+			gateway1.Name, metav1.DeleteOptions{})
+		c.ofClient.EXPECT().InstallMulticlusterNodeFlows(ciImport.Name, gomock.Any(), []net.IP{gw2InternalIP},
+			expectedRemoteGatewayPeers([]net.IP{gw2InternalIP}, updatedLocalGatewayIPs[1:]), true).Times(1)
+		c.processNextWorkItem()
+	}()
+	select {
+	case <-time.After(5 * time.Second):
+		t.Errorf("Test didn't finish in time")
+	case <-finishCh:
+	}
+}
+
+func TestSelectGateway(t *testing.T) {
+	candidates := []net.IP{
+		net.ParseIP("172.17.0.11"),
+		net.ParseIP("172.17.0.12"),
+		net.ParseIP("172.17.0.13"),
+	}
+	reversedCandidates := []net.IP{candidates[2], candidates[1], candidates[0]}
+	keys := []net.IP{
+		net.ParseIP("172.18.0.10"),
+		net.ParseIP("172.18.0.11"),
+		net.ParseIP("172.18.0.12"),
+		net.ParseIP("172.18.0.13"),
+		net.ParseIP("172.18.0.14"),
+		net.ParseIP("172.18.0.15"),
+	}
+	for _, key := range keys {
+		selected := candidates[selectGateway(key, candidates)]
+		// The selection must not depend on the order of the candidates.
+		assert.Equal(t, selected, reversedCandidates[selectGateway(key, reversedCandidates)])
+		// Removing a Gateway which is not selected must not change the selection.
+		for i, candidate := range candidates {
+			if candidate.Equal(selected) {
+				continue
+			}
+			remaining := append(append([]net.IP{}, candidates[:i]...), candidates[i+1:]...)
+			assert.Equal(t, selected, remaining[selectGateway(key, remaining)])
+		}
+	}
+	assert.Equal(t, 0, selectGateway(keys[0], candidates[:1]))
+}
+
 func TestRemoveWireGuardRouteAndPeer(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	mockInterface := routemock.NewMockInterface(ctrl)
@@ -23,7 +23,6 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/util/wait"
 	coreinformers "k8s.io/client-go/informers/core/v1"
 	"k8s.io/client-go/kubernetes"
@@ -33,6 +32,7 @@ import (
 	"k8s.io/klog/v2"
 
 	mcv1alpha1 "antrea.io/antrea/multicluster/apis/multicluster/v1alpha1"
+	mccommon "antrea.io/antrea/multicluster/controllers/multicluster/common"
 	"antrea.io/antrea/multicluster/pkg/client/informers/externalversions/multicluster/v1alpha1"
 	mclisters "antrea.io/antrea/multicluster/pkg/client/listers/multicluster/v1alpha1"
 	"antrea.io/antrea/pkg/agent/config"
@@ -48,21 +48,28 @@ const (
 	podRouteControllerName = "MCPodRouteController"
 )
 
-// MCPodRouteController generates L3 forwarding flows to forward cross-cluster
-// traffic from MC Gateway to Pods on other Nodes inside a member cluster. It is
-// required when networkPolicyOnly, noEncap or hybrid mode are configured, to forward
-// the traffic through tunnels between Gateway and other Nodes, as otherwise the
-// traffic will not go through tunnels in those modes.
+// MCPodRouteController generates the flows on MC Gateway for cross-cluster traffic
+// to Pods inside a member cluster:
+//   - L3 forwarding flows to forward the traffic to Pods on other Nodes. They are
+//     required when networkPolicyOnly, noEncap or hybrid mode are configured, to
+//     forward the traffic through tunnels between Gateway and other Nodes, as
+//     otherwise the traffic will not go through tunnels in those modes.
+//   - DNAT flows to translate the global IPs of Pods, which are allocated by the
+//     Multi-cluster Controller when the member cluster is configured with a global
+//     Pod CIDR, to the Pod IPs.
 type MCPodRouteController struct {
-	k8sClient   kubernetes.Interface
-	ofClient    openflow.Client
-	nodeConfig  *config.NodeConfig
-	podQueue    workqueue.RateLimitingInterface
-	gwQueue     workqueue.RateLimitingInterface
-	podInformer cache.SharedIndexInformer
-	podLister   corelisters.PodLister
-	gwInformer  cache.SharedIndexInformer
-	gwLister    mclisters.GatewayLister
+	k8sClient  kubernetes.Interface
+	ofClient   openflow.Client
+	nodeConfig *config.NodeConfig
+	// podRouteEnabled indicates whether L3 forwarding flows to Pods on other Nodes
+	// are required.
+	podRouteEnabled bool
+	podQueue        workqueue.RateLimitingInterface
+	gwQueue         workqueue.RateLimitingInterface
+	podInformer     cache.SharedIndexInformer
+	podLister       corelisters.PodLister
+	gwInformer      cache.SharedIndexInformer
+	gwLister        mclisters.GatewayLister
 	// podWorkersStarted is a boolean which tracks if the Pod flow controller has been started.
 	podWorkersStarted      bool
 	podWorkersStartedMutex sync.RWMutex
@@ -74,11 +81,13 @@ func NewMCPodRouteController(
 	gwInformer v1alpha1.GatewayInformer,
 	client openflow.Client,
 	nodeConfig *config.NodeConfig,
+	podRouteEnabled bool,
 ) *MCPodRouteController {
 	controller := &MCPodRouteController{
 		k8sClient:       k8sClient,
 		ofClient:        client,
 		nodeConfig:      nodeConfig,
+		podRouteEnabled: podRouteEnabled,
 		podQueue:        workqueue.NewNamedRateLimitingQueue(workqueue.NewItemExponentialFailureRateLimiter(minRetryDelay, maxRetryDelay), "MCPodRouteControllerForPod"),
 		gwQueue:         workqueue.NewNamedRateLimitingQueue(workqueue.NewItemExponentialFailureRateLimiter(minRetryDelay, maxRetryDelay), "MCPodRouteControllerForGateway"),
 		gwInformer:      gwInformer.Informer(),
@@ -113,15 +122,12 @@ func podIPIndexFunc(obj interface{}) ([]string, error) {
 }
 
 func (c *MCPodRouteController) createPodInformer() {
-	listOptions := func(options *metav1.ListOptions) {
-		options.FieldSelector = fields.OneTermNotEqualSelector("spec.nodeName", c.nodeConfig.Name).String()
-	}
-	c.podInformer = coreinformers.NewFilteredPodInformer(
+	// Pods on the Gateway Node are watched too, as they may have global IPs.
+	c.podInformer = coreinformers.NewPodInformer(
 		c.k8sClient,
 		metav1.NamespaceAll,
 		0,
 		cache.Indexers{podIndexKey: podIPIndexFunc},
-		listOptions,
 	)
 	c.podInformer.AddEventHandlerWithResyncPeriod(
 		cache.ResourceEventHandlerFuncs{
@@ -191,7 +197,8 @@ func (c *MCPodRouteController) updatePod(old, cur interface{}) {
 		return
 	}
 
-	if oldPod.Status.HostIP != curPod.Status.HostIP {
+	if oldPod.Status.HostIP != curPod.Status.HostIP ||
+		oldPod.Annotations[mccommon.PodGlobalIPAnnotation] != curPod.Annotations[mccommon.PodGlobalIPAnnotation] {
 		c.podQueue.Add(curPod.Status.PodIP)
 	}
 }
@@ -263,16 +270,16 @@ func (c *MCPodRouteController) processGatewayNextWorkItem() bool {
 }
 
 func (c *MCPodRouteController) syncGateway() error {
-	activeGW, err := getActiveGateway(c.gwLister)
+	activeGWs, err := getActiveGateways(c.gwLister)
 	if err != nil {
-		klog.ErrorS(err, "Failed to get an active Gateway")
+		klog.ErrorS(err, "Failed to get active Gateways")
 		return err
 	}
 
 	c.podWorkersStartedMutex.Lock()
 	defer c.podWorkersStartedMutex.Unlock()
 
-	amIGateway := activeGW != nil && c.nodeConfig.Name == activeGW.Name
+	amIGateway := getGateway(activeGWs, c.nodeConfig.Name) != nil
 	// Stop Pod flow controller and clean up all installed Multi-cluster Pod flows,
 	// if the Node was a Gateway before.
 	if !amIGateway {
@@ -362,8 +369,22 @@ func (c *MCPodRouteController) syncPod(podIP string) error {
 
 	latestPod := c.getLatestPod(pods)
 	nodeIP := latestPod.Status.HostIP
-	klog.V(2).InfoS("Adding Multi-cluster flows for Pod", "podIP", podIP, "nodeIP", nodeIP)
-	if err := c.ofClient.InstallMulticlusterPodFlows(net.ParseIP(podIP), net.ParseIP(nodeIP)); err != nil {
+	var tunnelPeerIP, globalIP net.IP
+	if c.podRouteEnabled && latestPod.Spec.NodeName != c.nodeConfig.Name {
+		tunnelPeerIP = net.ParseIP(nodeIP)
+	}
+	if ip, ok := latestPod.Annotations[mccommon.PodGlobalIPAnnotation]; ok {
+		globalIP = net.ParseIP(ip)
+	}
+	if tunnelPeerIP == nil && globalIP == nil {
+		if err := c.ofClient.UninstallMulticlusterPodFlows(podIP); err != nil {
+			klog.ErrorS(err, "Failed to uninstall Multi-cluster flows for Pod", "podIP", podIP)
+			return err
+		}
+		return nil
+	}
+	klog.V(2).InfoS("Adding Multi-cluster flows for Pod", "podIP", podIP, "nodeIP", nodeIP, "globalIP", globalIP)
+	if err := c.ofClient.InstallMulticlusterPodFlows(net.ParseIP(podIP), tunnelPeerIP, globalIP); err != nil {
 		klog.ErrorS(err, "Failed to install Multi-cluster flows for Pod", "podIP", podIP, "nodeIP", nodeIP)
 		return err
 	}
@@ -30,6 +30,7 @@ import (
 	v1 "k8s.io/client-go/listers/core/v1"
 
 	mcv1alpha1 "antrea.io/antrea/multicluster/apis/multicluster/v1alpha1"
+	mccommon "antrea.io/antrea/multicluster/controllers/multicluster/common"
 	mcfake "antrea.io/antrea/multicluster/pkg/client/clientset/versioned/fake"
 	mcinformers "antrea.io/antrea/multicluster/pkg/client/informers/externalversions"
 	mclisters "antrea.io/antrea/multicluster/pkg/client/listers/multicluster/v1alpha1"
@@ -112,6 +113,7 @@ func newMCPodRouteController(t *testing.T, nodeConfig *config.NodeConfig,
 		gwInformer,
 		ofClient,
 		nodeConfig,
+		true,
 	)
 	return &fakeMCPodRouteController{
 		MCPodRouteController: c,
@@ -161,7 +163,7 @@ func TestGatewayEvent(t *testing.T) {
 		}
 		c.processGatewayNextWorkItem()
 
-		c.ofClient.EXPECT().InstallMulticlusterPodFlows(nginx2PodIP, nginx2HostIP)
+		c.ofClient.EXPECT().InstallMulticlusterPodFlows(nginx2PodIP, nginx2HostIP, nil)
 		c.processPodNextWorkItem()
 
 		// Delete a Gateway node-1
@@ -201,7 +203,7 @@ func TestPodEvent(t *testing.T) {
 		defer close(finishCh)
 		// Create a Gateway
 		c.mcClient.MulticlusterV1alpha1().Gateways(defaultNs).Create(ctx, &gateway1, metav1.CreateOptions{})
-		c.ofClient.EXPECT().InstallMulticlusterPodFlows(nginx2PodIP, nginx2HostIP)
+		c.ofClient.EXPECT().InstallMulticlusterPodFlows(nginx2PodIP, nginx2HostIP, nil)
 		c.processPodNextWorkItem()
 
 		// Update a Pod with empty host IP
@@ -245,7 +247,7 @@ func TestPodEvent(t *testing.T) {
 		if err := waitForPodIPUpdate(c.podLister, nginx1Updated); err != nil {
 			t.Errorf("Error when waiting for Pod '%s/%s' to be updated, err: %v", nginx1Updated.Namespace, nginx1Updated.Name, err)
 		}
-		c.ofClient.EXPECT().InstallMulticlusterPodFlows(net.ParseIP("192.168.10.11"), net.ParseIP("172.16.10.11"))
+		c.ofClient.EXPECT().InstallMulticlusterPodFlows(net.ParseIP("192.168.10.11"), net.ParseIP("172.16.10.11"), nil)
 		c.processPodNextWorkItem()
 
 		// Update a Pod with new host IP
@@ -255,7 +257,7 @@ func TestPodEvent(t *testing.T) {
 		if err := waitForPodIPUpdate(c.podLister, nginx1UpdatedHostIP); err != nil {
 			t.Errorf("Error when waiting for Pod '%s/%s' to be updated, err: %v", nginx1UpdatedHostIP.Namespace, nginx1UpdatedHostIP.Name, err)
 		}
-		c.ofClient.EXPECT().InstallMulticlusterPodFlows(net.ParseIP("192.168.10.11"), net.ParseIP("172.16.12.12"))
+		c.ofClient.EXPECT().InstallMulticlusterPodFlows(net.ParseIP("192.168.10.11"), net.ParseIP("172.16.12.12"), nil)
 		c.processPodNextWorkItem()
 
 		// Update a Pod's label
@@ -276,7 +278,7 @@ func TestPodEvent(t *testing.T) {
 			t.Errorf("Error when waiting for Pod '%s/%s' to be updated, err: %v", nginx1UpdatedWithNewIP.Namespace, nginx1UpdatedWithNewIP.Name, err)
 		}
 		c.ofClient.EXPECT().UninstallMulticlusterPodFlows("192.168.10.11")
-		c.ofClient.EXPECT().InstallMulticlusterPodFlows(net.ParseIP("192.168.110.10"), net.ParseIP("172.16.10.11"))
+		c.ofClient.EXPECT().InstallMulticlusterPodFlows(net.ParseIP("192.168.110.10"), net.ParseIP("172.16.10.11"), nil)
 		c.processPodNextWorkItem()
 		c.processPodNextWorkItem()
 
@@ -289,7 +291,7 @@ func TestPodEvent(t *testing.T) {
 		if err := waitForPodRealized(c.podLister, nginx1DupIP); err != nil {
 			t.Errorf("Error when waiting for Pod '%s/%s' to be realized, err: %v", nginx1DupIP.Namespace, nginx1DupIP.Name, err)
 		}
-		c.ofClient.EXPECT().InstallMulticlusterPodFlows(net.ParseIP("192.168.110.10"), net.ParseIP("172.16.10.11"))
+		c.ofClient.EXPECT().InstallMulticlusterPodFlows(net.ParseIP("192.168.110.10"), net.ParseIP("172.16.10.11"), nil)
 		c.processPodNextWorkItem()
 
 		// Update the old Pod with an empty IP
@@ -300,7 +302,7 @@ func TestPodEvent(t *testing.T) {
 		if err := waitForPodIPUpdate(c.podLister, nginx1UpdatedWithEmptyIP); err != nil {
 			t.Errorf("Error when waiting for Pod '%s/%s' to be updated, err: %v", nginx1UpdatedWithEmptyIP.Namespace, nginx1UpdatedWithEmptyIP.Name, err)
 		}
-		c.ofClient.EXPECT().InstallMulticlusterPodFlows(net.ParseIP("192.168.110.10"), net.ParseIP("172.16.10.11"))
+		c.ofClient.EXPECT().InstallMulticlusterPodFlows(net.ParseIP("192.168.110.10"), net.ParseIP("172.16.10.11"), nil)
 		c.processPodNextWorkItem()
 
 		// Delete the old Pod
@@ -318,6 +320,68 @@ func TestPodEvent(t *testing.T) {
 	}
 }
 
+func TestPodEventWithGlobalIP(t *testing.T) {
+	// A Pod on the Gateway Node with a global IP.
+	nginx3WithGlobalIP := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   defaultNs,
+			Name:        "nginx3",
+			Annotations: map[string]string{mccommon.PodGlobalIPAnnotation: "172.30.0.3"},
+		},
+		Spec: corev1.PodSpec{
+			NodeName: node1Name,
+		},
+		Status: corev1.PodStatus{
+			PodIP:  "192.168.1.13",
+			HostIP: "10.170.10.10",
+		},
+	}
+	k8sClient := k8sfake.NewSimpleClientset([]runtime.Object{nginx2WithIPs, nginx3WithGlobalIP}...)
+	c := newMCPodRouteController(t, &config.NodeConfig{Name: node1Name}, k8sClient)
+	defer c.podQueue.ShutDown()
+	defer c.gwQueue.ShutDown()
+
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	c.createPodInformer()
+	go c.podInformer.Run(stopCh)
+	c.podWorkersStarted = true
+
+	for _, pod := range []*corev1.Pod{nginx2WithIPs, nginx3WithGlobalIP} {
+		if err := waitForPodRealized(c.podLister, pod); err != nil {
+			t.Errorf("Error when waiting for Pod '%s/%s' to be realized, err: %v", pod.Namespace, pod.Name, err)
+		}
+	}
+
+	finishCh := make(chan struct{})
+	go func() {
+		defer close(finishCh)
+		c.ofClient.EXPECT().InstallMulticlusterPodFlows(nginx2PodIP, nginx2HostIP, nil)
+		c.ofClient.EXPECT().InstallMulticlusterPodFlows(net.ParseIP("192.168.1.13"), nil, net.ParseIP("172.30.0.3"))
+		c.processPodNextWorkItem()
+		c.processPodNextWorkItem()
+
+		// Allocate a global IP to the Pod on another Node.
+		nginx2WithGlobalIP := nginx2WithIPs.DeepCopy()
+		nginx2WithGlobalIP.Annotations = map[string]string{mccommon.PodGlobalIPAnnotation: "172.30.0.2"}
+		c.k8sClient.CoreV1().Pods(defaultNs).Update(ctx, nginx2WithGlobalIP, metav1.UpdateOptions{})
+		c.ofClient.EXPECT().InstallMulticlusterPodFlows(nginx2PodIP, nginx2HostIP, net.ParseIP("172.30.0.2"))
+		c.processPodNextWorkItem()
+
+		// Release the global IP of the Pod on the Gateway Node.
+		nginx3WithoutGlobalIP := nginx3WithGlobalIP.DeepCopy()
+		nginx3WithoutGlobalIP.Annotations = nil
+		c.k8sClient.CoreV1().Pods(defaultNs).Update(ctx, nginx3WithoutGlobalIP, metav1.UpdateOptions{})
+		c.ofClient.EXPECT().UninstallMulticlusterPodFlows("192.168.1.13")
+		c.processPodNextWorkItem()
+	}()
+	select {
+	case <-time.After(5 * time.Second):
+		t.Errorf("Test didn't finish in time")
+	case <-finishCh:
+	}
+}
+
 func waitForGatewayRealized(gwLister mclisters.GatewayLister, gateway *mcv1alpha1.Gateway) error {
 	return wait.Poll(interval, timeout, func() (bool, error) {
 		_, err := gwLister.Gateways(gateway.Namespace).Get(gateway.Name)
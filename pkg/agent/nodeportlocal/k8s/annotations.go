@@ -76,8 +76,13 @@ func compareNPLAnnotationLists(annotations1, annotations2 []npltypes.NPLAnnotati
 		return false
 	}
 	nplAnnotationLess := func(a1, a2 *npltypes.NPLAnnotation) bool {
-		return a1.NodePort < a2.NodePort
-
+		if a1.NodePort != a2.NodePort {
+			return a1.NodePort < a2.NodePort
+		}
+		if a1.NodePortEnd != a2.NodePortEnd {
+			return a1.NodePortEnd < a2.NodePortEnd
+		}
+		return a1.Protocol < a2.Protocol
 	}
 	sort.Slice(annotations1, func(i, j int) bool {
 		return nplAnnotationLess(&annotations1[i], &annotations1[j])
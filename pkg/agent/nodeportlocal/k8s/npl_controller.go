@@ -18,6 +18,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"reflect"
+	"strings"
 	"sync"
 	"time"
 
@@ -113,6 +114,11 @@ func NewNPLController(kubeClient clientset.Interface,
 	return &c
 }
 
+// nodePortRangeProtoFormat formats a Node port range and protocol to string start-end:protocol.
+func nodePortRangeProtoFormat(nodePortStart, nodePortEnd int, protocol string) string {
+	return fmt.Sprintf("%d-%d:%s", nodePortStart, nodePortEnd, protocol)
+}
+
 func podKeyFunc(pod *corev1.Pod) string {
 	return pod.Namespace + "/" + pod.Name
 }
@@ -203,12 +209,40 @@ func validateNPLService(svc *corev1.Service) {
 		return
 	}
 	for _, port := range svc.Spec.Ports {
-		if port.Protocol == corev1.ProtocolSCTP {
-			klog.InfoS("Service has NodePortLocal enabled but it includes a SCTP Service port, which will be ignored", "service", klog.KObj(svc))
+		if !rules.ProtocolSupported(strings.ToLower(string(port.Protocol))) {
+			klog.InfoS("Service has NodePortLocal enabled but it includes a Service port with an unsupported protocol, which will be ignored", "service", klog.KObj(svc), "protocol", port.Protocol)
+		}
+	}
+	if value, ok := svc.Annotations[types.NPLPortRangesAnnotationKey]; ok {
+		portRanges, err := parsePortRanges(value)
+		if err != nil {
+			klog.InfoS("Service has an invalid NodePortLocal port ranges annotation, which will be ignored", "service", klog.KObj(svc), "err", err)
+		}
+		for _, portRange := range portRanges {
+			if _, _, protocol, _ := util.ParsePortRangeProto(portRange); !rules.ProtocolSupported(protocol) {
+				klog.InfoS("Service has NodePortLocal enabled but it includes a port range with an unsupported protocol, which will be ignored", "service", klog.KObj(svc), "portRange", portRange)
+			}
 		}
 	}
 }
 
+// parsePortRanges parses the value of the NPLPortRangesAnnotationKey annotation and returns the
+// port ranges in the <start>-<end>:<protocol> format.
+func parsePortRanges(value string) ([]string, error) {
+	var portRanges []string
+	for _, portRange := range strings.Split(value, ",") {
+		if strings.TrimSpace(portRange) == "" {
+			continue
+		}
+		start, end, protocol, err := util.ParsePortRangeProto(portRange)
+		if err != nil {
+			return nil, err
+		}
+		portRanges = append(portRanges, util.BuildPortProto(fmt.Sprintf("%d-%d", start, end), protocol))
+	}
+	return portRanges, nil
+}
+
 func (c *NPLController) enqueueSvcUpdate(oldObj, newObj interface{}) {
 	// In case where the app selector in Service gets updated from one valid selector to another
 	// both sets of Pods (corresponding to old and new selector) need to be considered.
@@ -244,8 +278,9 @@ func (c *NPLController) enqueueSvcUpdate(oldObj, newObj interface{}) {
 			oldPodSet := sets.New[string](c.getPodsFromService(oldSvc)...)
 			podKeys = utilsets.SymmetricDifferenceString(oldPodSet, newPodSet)
 		}
-		if !reflect.DeepEqual(oldSvc.Spec.Ports, newSvc.Spec.Ports) {
-			// If ports in a Service are changed, all the Pods selected by the Service have to be processed.
+		if !reflect.DeepEqual(oldSvc.Spec.Ports, newSvc.Spec.Ports) ||
+			oldSvc.Annotations[types.NPLPortRangesAnnotationKey] != newSvc.Annotations[types.NPLPortRangesAnnotationKey] {
+			// If ports or port ranges in a Service are changed, all the Pods selected by the Service have to be processed.
 			podKeys = podKeys.Union(newPodSet)
 		}
 	}
@@ -294,14 +329,17 @@ func (c *NPLController) getPodsFromService(svc *corev1.Service) []string {
 	return pods
 }
 
-func (c *NPLController) getTargetPortsForServicesOfPod(obj interface{}) (sets.Set[string], sets.Set[string]) {
+// getTargetPortsForServicesOfPod returns the target ports (numbered and named) and the port
+// ranges of all the NPL-enabled Services selecting the Pod.
+func (c *NPLController) getTargetPortsForServicesOfPod(obj interface{}) (sets.Set[string], sets.Set[string], sets.Set[string]) {
 	targetPortsInt := sets.New[string]()
 	targetPortsStr := sets.New[string]()
+	targetPortRanges := sets.New[string]()
 	pod := obj.(*corev1.Pod)
 	services, err := c.svcInformer.GetIndexer().ByIndex(NPLEnabledAnnotationIndex, "true")
 	if err != nil {
 		klog.Errorf("Got error while listing Services with annotation %s: %v", types.NPLEnabledAnnotationKey, err)
-		return targetPortsInt, targetPortsStr
+		return targetPortsInt, targetPortsStr, targetPortRanges
 	}
 
 	for _, service := range services {
@@ -311,9 +349,21 @@ func (c *NPLController) getTargetPortsForServicesOfPod(obj interface{}) (sets.Se
 			continue
 		}
 		if pod.Namespace == svc.Namespace && matchSvcSelectorPodLabels(svc.Spec.Selector, pod.GetLabels()) {
+			if value, ok := svc.Annotations[types.NPLPortRangesAnnotationKey]; ok {
+				// An invalid annotation is logged when the Service is processed.
+				portRanges, _ := parsePortRanges(value)
+				for _, portRange := range portRanges {
+					if _, _, protocol, _ := util.ParsePortRangeProto(portRange); !rules.ProtocolSupported(protocol) {
+						// Not supported on this platform. A message is logged when the
+						// Service is processed.
+						continue
+					}
+					targetPortRanges.Insert(portRange)
+				}
+			}
 			for _, port := range svc.Spec.Ports {
-				if port.Protocol == corev1.ProtocolSCTP {
-					// Not supported yet. A message is logged when the
+				if !rules.ProtocolSupported(strings.ToLower(string(port.Protocol))) {
+					// Not supported on this platform. A message is logged when the
 					// Service is processed.
 					continue
 				}
@@ -332,7 +382,7 @@ func (c *NPLController) getTargetPortsForServicesOfPod(obj interface{}) (sets.Se
 			}
 		}
 	}
-	return targetPortsInt, targetPortsStr
+	return targetPortsInt, targetPortsStr, targetPortRanges
 }
 
 // matchSvcSelectorPodLabels verifies that all key/value pairs present in Service's selector
@@ -432,7 +482,7 @@ func (c *NPLController) handleAddUpdatePod(key string, obj interface{}) error {
 	}
 	c.addPodIPToCache(key, podIP)
 
-	targetPortsInt, targetPortsStr := c.getTargetPortsForServicesOfPod(obj)
+	targetPortsInt, targetPortsStr, targetPortRanges := c.getTargetPortsForServicesOfPod(obj)
 	klog.V(2).Infof("Pod %s is selected by a Service for which NodePortLocal is enabled", key)
 
 	var nodePort int
@@ -473,7 +523,7 @@ func (c *NPLController) handleAddUpdatePod(key string, obj interface{}) error {
 	// for named ports present in targetPortsStr. If it is empty, then all existing rules and annotations for the
 	// Pod have to be cleaned up. If a Service uses a named target port that doesn't match any named container port
 	// for the current Pod, no corresponding entry will be added to the targetPortsInt set by the code above.
-	if len(targetPortsInt) == 0 {
+	if len(targetPortsInt) == 0 && len(targetPortRanges) == 0 {
 		if err := c.deleteAllPortRulesIfAny(podIP); err != nil {
 			return err
 		}
@@ -483,15 +533,94 @@ func (c *NPLController) handleAddUpdatePod(key string, obj interface{}) error {
 		return nil
 	}
 
-	// first, check which rules are needed based on the target ports of the Services selecting the Pod
-	// (ignoring NPL annotations) and make sure they are present. As we do so, we build the expected list of
-	// NPL annotations for the Pod.
+	// Pods using the host network share the network namespace of the Node: their ports are
+	// directly reachable on the Node, so no Node port is allocated and no rule is installed for
+	// them. The NPL annotation simply maps each Pod port to the same Node port.
+	hostNetwork := pod.Spec.HostNetwork
+
+	// first, check which rules are needed based on the target ports and port ranges of the Services
+	// selecting the Pod (ignoring NPL annotations) and make sure they are present. As we do so, we build
+	// the expected list of NPL annotations for the Pod. Port ranges are handled first, so that target
+	// ports which belong to a range can reuse the Node port allocated for the range.
+	for _, targetPortRange := range sets.List(targetPortRanges) {
+		podPortStart, podPortEnd, protocol, err := util.ParsePortRangeProto(targetPortRange)
+		if err != nil {
+			return fmt.Errorf("failed to parse port range and protocol from %s for Pod %s: %v", targetPortRange, key, err)
+		}
+		overlapping := false
+		for port := podPortStart; port <= podPortEnd; port++ {
+			if _, ok := podPorts[util.BuildPortProto(fmt.Sprint(port), protocol)]; ok {
+				overlapping = true
+				break
+			}
+		}
+		if overlapping {
+			klog.InfoS("Ignoring NodePortLocal port range which overlaps with another port range", "pod", klog.KObj(pod), "portRange", targetPortRange)
+			continue
+		}
+		// A range of Pod ports larger than the range of Node ports available for NPL can never
+		// be mapped to contiguous Node ports.
+		if !hostNetwork && podPortEnd-podPortStart > c.portTable.EndPort-c.portTable.StartPort {
+			klog.InfoS("Ignoring NodePortLocal port range which is larger than the NodePortLocal Node port range", "pod", klog.KObj(pod), "portRange", targetPortRange,
+				"nodePortRange", fmt.Sprintf("%d-%d", c.portTable.StartPort, c.portTable.EndPort))
+			continue
+		}
+		for port := podPortStart; port <= podPortEnd; port++ {
+			podPorts[util.BuildPortProto(fmt.Sprint(port), protocol)] = struct{}{}
+		}
+		if hostNetwork {
+			nodePort = podPortStart
+		} else {
+			var ok bool
+			nodePort, ok = c.portTable.GetRangeNodePort(podIP, podPortStart, podPortEnd, protocol)
+			if !ok {
+				// Some ports in the range may have been allocated individually before, in
+				// which case they need to be released to allocate a contiguous range.
+				for port := podPortStart; port <= podPortEnd; port++ {
+					if err := c.portTable.DeleteRule(podIP, port, protocol); err != nil {
+						return fmt.Errorf("failed to delete rule for Pod IP %s, Pod Port %d, Protocol %s: %v", podIP, port, protocol, err)
+					}
+				}
+				nodePort, err = c.portTable.AddRuleRange(podIP, podPortStart, podPortEnd, protocol)
+				if err != nil {
+					return fmt.Errorf("failed to add rules for port range %s for Pod %s: %v", targetPortRange, key, err)
+				}
+			}
+		}
+		nplAnnotationsRequiredMap[nodePortRangeProtoFormat(nodePort, nodePort+podPortEnd-podPortStart, protocol)] = types.NPLAnnotation{
+			PodPort:     podPortStart,
+			PodPortEnd:  podPortEnd,
+			NodeIP:      pod.Status.HostIP,
+			NodePort:    nodePort,
+			NodePortEnd: nodePort + podPortEnd - podPortStart,
+			Protocol:    protocol,
+			Protocols:   []string{protocol},
+		}
+	}
+
 	for _, targetPortProto := range sets.List(targetPortsInt) {
 		port, protocol, err := util.ParsePortProto(targetPortProto)
 		if err != nil {
 			return fmt.Errorf("failed to parse port number and protocol from %s for Pod %s: %v", targetPortProto, key, err)
 		}
+		if _, ok := podPorts[targetPortProto]; ok {
+			// The target port belongs to a port range, whose annotation entry already maps it.
+			continue
+		}
 		podPorts[targetPortProto] = struct{}{}
+		if hostNetwork {
+			nodePort = port
+			if _, ok := nplAnnotationsRequiredMap[portcache.NodePortProtoFormat(nodePort, protocol)]; !ok {
+				nplAnnotationsRequiredMap[portcache.NodePortProtoFormat(nodePort, protocol)] = types.NPLAnnotation{
+					PodPort:   port,
+					NodeIP:    pod.Status.HostIP,
+					NodePort:  nodePort,
+					Protocol:  protocol,
+					Protocols: []string{protocol},
+				}
+			}
+			continue
+		}
 		portData := c.portTable.GetEntry(podIP, port, protocol)
 		if portData != nil && !portData.ProtocolInUse(protocol) {
 			// If the PortTable has an entry for the Pod but does not have an
@@ -575,6 +704,10 @@ func (c *NPLController) waitForRulesInitialization() {
 		//   if yes, verifiy validity of the Node port, update the port table and add a rule to the
 		//   rules buffer.
 		pod := podList[i]
+		if pod.Spec.HostNetwork {
+			// No rule is ever installed for Pods using the host network.
+			continue
+		}
 		annotations := pod.GetAnnotations()
 		nplAnnotation, ok := annotations[types.NPLAnnotationKey]
 		if !ok {
@@ -591,19 +724,26 @@ func (c *NPLController) waitForRulesInitialization() {
 		}
 
 		for _, npl := range nplData {
-			if npl.NodePort > c.portTable.EndPort || npl.NodePort < c.portTable.StartPort {
+			nodePortEnd := npl.NodePort
+			if npl.NodePortEnd != 0 {
+				nodePortEnd = npl.NodePortEnd
+			}
+			if nodePortEnd > c.portTable.EndPort || npl.NodePort < c.portTable.StartPort {
 				// ignoring annotation for now, it will be removed by the first call
 				// to handleAddUpdatePod
 				klog.V(2).InfoS("Found NodePortLocal annotation for which the allocated port doesn't fall into the configured range", "pod", klog.KObj(pod))
 				continue
 			}
-			allNPLPorts = append(allNPLPorts, rules.PodNodePort{
-				NodePort:  npl.NodePort,
-				PodPort:   npl.PodPort,
-				PodIP:     pod.Status.PodIP,
-				Protocol:  npl.Protocol,
-				Protocols: npl.Protocols,
-			})
+			// An annotation for a port range is restored as one rule per port.
+			for nodePort := npl.NodePort; nodePort <= nodePortEnd; nodePort++ {
+				allNPLPorts = append(allNPLPorts, rules.PodNodePort{
+					NodePort:  nodePort,
+					PodPort:   npl.PodPort + nodePort - npl.NodePort,
+					PodIP:     pod.Status.PodIP,
+					Protocol:  npl.Protocol,
+					Protocols: npl.Protocols,
+				})
+			}
 		}
 	}
 
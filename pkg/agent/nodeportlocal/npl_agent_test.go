@@ -61,6 +61,7 @@ const (
 	defaultAppSelectorVal = "test-pod"
 	protocolTCP           = "tcp"
 	protocolUDP           = "udp"
+	protocolSCTP          = "sctp"
 	defaultStartPort      = 61000
 	defaultEndPort        = 65000
 )
@@ -605,6 +606,131 @@ func TestPodAddHostPortWrongProtocol(t *testing.T) {
 	assert.True(t, testData.portTable.RuleExists(defaultPodIP, defaultPort, protocolTCP))
 }
 
+// TestPodPortRange creates a Service with the port ranges annotation and verifies that a single
+// NPL annotation is added to the Pod for each range, mapping it to a contiguous range of Node
+// ports, and that a rule is installed for every port in the range.
+func TestPodPortRange(t *testing.T) {
+	testSvc := getTestSvc()
+	testSvc.Annotations[types.NPLPortRangesAnnotationKey] = "10000-10009:udp"
+	testPod := getTestPod()
+	testData := setUp(t, newTestConfig(), testSvc, testPod)
+	defer testData.tearDown()
+
+	value, err := testData.pollForPodAnnotation(testPod.Name, true)
+	require.NoError(t, err, "Poll for annotation check failed")
+	expectedAnnotations := newExpectedNPLAnnotations().Add(nil, defaultPort, protocolTCP).AddRange(nil, 10000, 10009, protocolUDP)
+	expectedAnnotations.Check(t, value)
+	for port := 10000; port <= 10009; port++ {
+		assert.True(t, testData.portTable.RuleExists(defaultPodIP, port, protocolUDP))
+	}
+
+	delete(testSvc.Annotations, types.NPLPortRangesAnnotationKey)
+	testData.updateServiceOrFail(testSvc)
+	assert.Eventually(t, func() bool {
+		return !testData.portTable.RuleExists(defaultPodIP, 10000, protocolUDP)
+	}, 20*time.Second, 100*time.Millisecond, "Rules for port range should have been removed")
+	assert.True(t, testData.portTable.RuleExists(defaultPodIP, defaultPort, protocolTCP))
+}
+
+// TestPodPortRangeWithTargetPort verifies that a Service target port which belongs to a port range
+// reuses the Node port allocated for the range, and is only reported by the annotation entry of the
+// range.
+func TestPodPortRangeWithTargetPort(t *testing.T) {
+	testSvc := getTestSvc()
+	testSvc.Annotations[types.NPLPortRangesAnnotationKey] = fmt.Sprintf("%d-%d:tcp", defaultPort, defaultPort+4)
+	testPod := getTestPod()
+	testData := setUp(t, newTestConfig(), testSvc, testPod)
+	defer testData.tearDown()
+
+	value, err := testData.pollForPodAnnotation(testPod.Name, true)
+	require.NoError(t, err, "Poll for annotation check failed")
+	nodePort := defaultStartPort
+	expectedAnnotations := newExpectedNPLAnnotations().AddRange(&nodePort, defaultPort, defaultPort+4, protocolTCP)
+	expectedAnnotations.Check(t, value)
+}
+
+// TestPodPortRangeWithAllocatedTargetPort verifies that a target port which was allocated
+// individually is released and remapped when a port range including it is added to the Service,
+// and that it is only reported by the annotation entry of the range.
+func TestPodPortRangeWithAllocatedTargetPort(t *testing.T) {
+	testData, testSvc, testPod := setUpWithTestServiceAndPod(t, newTestConfig(), nil)
+	defer testData.tearDown()
+
+	testSvc.Annotations[types.NPLPortRangesAnnotationKey] = fmt.Sprintf("%d-%d:tcp", defaultPort-2, defaultPort+2)
+	testData.updateServiceOrFail(testSvc)
+	assert.Eventually(t, func() bool {
+		_, ok := testData.portTable.GetRangeNodePort(defaultPodIP, defaultPort-2, defaultPort+2, protocolTCP)
+		return ok
+	}, 20*time.Second, 100*time.Millisecond, "Rules for port range should have been added")
+
+	value, err := testData.pollForPodAnnotation(testPod.Name, true)
+	require.NoError(t, err, "Poll for annotation check failed")
+	// The Node port of the target port was released to allocate the range from the start of the
+	// Node port range.
+	rangeNodePort := defaultStartPort
+	expectedAnnotations := newExpectedNPLAnnotations().AddRange(&rangeNodePort, defaultPort-2, defaultPort+2, protocolTCP)
+	expectedAnnotations.Check(t, value)
+	portData := testData.portTable.GetEntry(defaultPodIP, defaultPort, protocolTCP)
+	require.NotNil(t, portData)
+	assert.Equal(t, defaultStartPort+2, portData.NodePort)
+}
+
+// TestPodPortRangeLargerThanNodePortRange verifies that a port range which is larger than the
+// NPL Node port range is ignored.
+func TestPodPortRangeLargerThanNodePortRange(t *testing.T) {
+	testSvc := getTestSvc()
+	testSvc.Annotations[types.NPLPortRangesAnnotationKey] = fmt.Sprintf("10000-%d:udp", 10000+defaultEndPort-defaultStartPort+1)
+	testPod := getTestPod()
+	testData := setUp(t, newTestConfig(), testSvc, testPod)
+	defer testData.tearDown()
+
+	value, err := testData.pollForPodAnnotation(testPod.Name, true)
+	require.NoError(t, err, "Poll for annotation check failed")
+	expectedAnnotations := newExpectedNPLAnnotations().Add(nil, defaultPort, protocolTCP)
+	expectedAnnotations.Check(t, value)
+	assert.False(t, testData.portTable.RuleExists(defaultPodIP, 10000, protocolUDP))
+}
+
+// TestHostNetworkPod verifies that the NPL annotation of a Pod using the host network maps each Pod
+// port to the same Node port, and that no rule is installed.
+func TestHostNetworkPod(t *testing.T) {
+	testSvc := getTestSvc()
+	testSvc.Annotations[types.NPLPortRangesAnnotationKey] = "10000-10009:udp"
+	testPod := getTestPod()
+	testPod.Spec.HostNetwork = true
+	testPod.Status.PodIP = defaultHostIP
+	testData := setUp(t, newTestConfig(), testSvc, testPod)
+	defer testData.tearDown()
+
+	value, err := testData.pollForPodAnnotation(testPod.Name, true)
+	require.NoError(t, err, "Poll for annotation check failed")
+	nodePort := defaultPort
+	rangeNodePort := 10000
+	expectedAnnotations := newExpectedNPLAnnotations().Add(&nodePort, defaultPort, protocolTCP).AddRange(&rangeNodePort, 10000, 10009, protocolUDP)
+	expectedAnnotations.Check(t, value)
+	assert.False(t, testData.portTable.RuleExists(defaultHostIP, defaultPort, protocolTCP))
+	assert.False(t, testData.portTable.RuleExists(defaultHostIP, 10000, protocolUDP))
+}
+
+// TestSCTPTargetPort verifies that NPL rules are installed for SCTP target ports on platforms which
+// support it.
+func TestSCTPTargetPort(t *testing.T) {
+	if !rules.ProtocolSupported(protocolSCTP) {
+		t.Skipf("Protocol %s is not supported by NodePortLocal on this platform", protocolSCTP)
+	}
+	testSvc := getTestSvc()
+	testSvc.Spec.Ports[0].Protocol = corev1.ProtocolSCTP
+	testPod := getTestPod()
+	testData := setUp(t, newTestConfig(), testSvc, testPod)
+	defer testData.tearDown()
+
+	value, err := testData.pollForPodAnnotation(testPod.Name, true)
+	require.NoError(t, err, "Poll for annotation check failed")
+	expectedAnnotations := newExpectedNPLAnnotations().Add(nil, defaultPort, protocolSCTP)
+	expectedAnnotations.Check(t, value)
+	assert.True(t, testData.portTable.RuleExists(defaultPodIP, defaultPort, protocolSCTP))
+}
+
 // TestTargetPortWithName creates a Service with target port name in string.
 // A Pod with matching container port name is also created and it is verified that
 // the port table is updated with desired NPL rule.
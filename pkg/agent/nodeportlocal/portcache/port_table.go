@@ -211,6 +211,85 @@ func (pt *PortTable) getEntryByPodIPPortProto(ip string, port int, protocol stri
 	return data
 }
 
+// GetRangeNodePort returns the first Node port of the range mapped to the Pod port range
+// [podPortStart, podPortEnd], if every Pod port in the range is mapped to a contiguous Node port.
+func (pt *PortTable) GetRangeNodePort(ip string, podPortStart, podPortEnd int, protocol string) (int, bool) {
+	pt.tableLock.RLock()
+	defer pt.tableLock.RUnlock()
+	first := pt.getEntryByPodIPPortProto(ip, podPortStart, protocol)
+	if first == nil || !first.ProtocolInUse(protocol) {
+		return 0, false
+	}
+	for podPort := podPortStart + 1; podPort <= podPortEnd; podPort++ {
+		data := pt.getEntryByPodIPPortProto(ip, podPort, protocol)
+		if data == nil || !data.ProtocolInUse(protocol) || data.NodePort != first.NodePort+podPort-podPortStart {
+			return 0, false
+		}
+	}
+	return first.NodePort, true
+}
+
+// AddRuleRange allocates a range of contiguous Node ports for the Pod port range
+// [podPortStart, podPortEnd] and installs one NPL rule for each port of the range. It returns
+// the first Node port of the allocated range.
+func (pt *PortTable) AddRuleRange(podIP string, podPortStart, podPortEnd int, protocol string) (int, error) {
+	pt.tableLock.Lock()
+	defer pt.tableLock.Unlock()
+	for podPort := podPortStart; podPort <= podPortEnd; podPort++ {
+		if pt.getEntryByPodIPPortProto(podIP, podPort, protocol) != nil {
+			// Only add rules if none of the entries exist.
+			return 0, fmt.Errorf("existing Nodeport entry for %s:%d:%s", podIP, podPort, protocol)
+		}
+	}
+	size := podPortEnd - podPortStart + 1
+	klog.V(2).InfoS("Looking for free Node port range", "podIP", podIP, "podPortStart", podPortStart, "podPortEnd", podPortEnd, "protocol", protocol)
+	for start := pt.StartPort; start+size-1 <= pt.EndPort; {
+		npDataList, err := pt.reserveNodePortRange(start, podIP, podPortStart, size, protocol)
+		if err != nil {
+			// Resume the search right after the Node port which could not be reserved.
+			start += len(npDataList) + 1
+			continue
+		}
+		if err := pt.installRulesForNodePortRange(npDataList); err != nil {
+			return 0, err
+		}
+		for _, npData := range npDataList {
+			pt.addPortTableCache(npData)
+		}
+		return start, nil
+	}
+	return 0, fmt.Errorf("no free port range of size %d found", size)
+}
+
+// reserveNodePortRange reserves size contiguous Node ports starting at startNodePort. In case of
+// failure, the Node ports reserved so far are released, and the returned slice indicates how many
+// of them could be reserved before the failure.
+func (pt *PortTable) reserveNodePortRange(startNodePort int, podIP string, podPortStart, size int, protocol string) ([]*NodePortData, error) {
+	npDataList := make([]*NodePortData, 0, size)
+	release := func() {
+		for _, npData := range npDataList {
+			if err := pt.releaseNodePort(npData); err != nil {
+				klog.ErrorS(err, "Failed to release Node port", "port", npData.NodePort, "protocol", protocol)
+			}
+		}
+	}
+	for i := 0; i < size; i++ {
+		nodePort := startNodePort + i
+		if _, ok := pt.getPortTableCacheFromNodePortIndex(NodePortProtoFormat(nodePort, protocol)); ok {
+			release()
+			return npDataList, fmt.Errorf("port %d is already taken", nodePort)
+		}
+		npData, err := pt.reserveNodePort(nodePort, podIP, podPortStart+i, protocol)
+		if err != nil {
+			klog.V(4).InfoS("Port cannot be reserved, moving on to the next range", "port", nodePort)
+			release()
+			return npDataList, err
+		}
+		npDataList = append(npDataList, npData)
+	}
+	return npDataList, nil
+}
+
 func (pt *PortTable) RuleExists(podIP string, podPort int, protocol string) bool {
 	pt.tableLock.RLock()
 	defer pt.tableLock.RUnlock()
@@ -229,7 +308,7 @@ func NodePortProtoFormat(nodeport int, protocol string) string {
 // This is inspired by the openLocalPort function in kube-proxy:
 // https://github.com/kubernetes/kubernetes/blob/86f8c3ee91b6faec437f97e3991107747d7fc5e8/pkg/proxy/iptables/proxier.go#L1664
 func (lpo *localPortOpener) OpenLocalPort(port int, protocol string) (io.Closer, error) {
-	// For now, NodePortLocal only supports IPv4 and TCP/UDP.
+	// For now, NodePortLocal only supports IPv4 and TCP/UDP/SCTP.
 	var network string
 	var socket io.Closer
 	switch protocol {
@@ -251,7 +330,15 @@ func (lpo *localPortOpener) OpenLocalPort(port int, protocol string) (io.Closer,
 			return nil, err
 		}
 		socket = conn
+	case "sctp":
+		sctpSocket, err := openSCTPSocket(port)
+		if err != nil {
+			return nil, err
+		}
+		socket = sctpSocket
+	default:
+		return nil, fmt.Errorf("unsupported protocol %s", protocol)
 	}
-	klog.V(2).InfoS("Opened local port", "port", port)
+	klog.V(2).InfoS("Opened local port", "port", port, "protocol", protocol)
 	return socket, nil
 }
@@ -19,8 +19,11 @@ package portcache
 
 import (
 	"fmt"
+	"io"
+	"os"
 	"time"
 
+	"golang.org/x/sys/unix"
 	"k8s.io/klog/v2"
 
 	"antrea.io/antrea/pkg/agent/nodeportlocal/rules"
@@ -54,6 +57,55 @@ func openSocketsForPort(localPortOpener LocalPortOpener, port int, protocol stri
 	return protocolData, nil
 }
 
+// openSCTPSocket binds a SCTP socket to the provided port, as a means to reserve it.
+func openSCTPSocket(port int) (io.Closer, error) {
+	fd, err := unix.Socket(unix.AF_INET, unix.SOCK_STREAM, unix.IPPROTO_SCTP)
+	if err != nil {
+		return nil, err
+	}
+	if err := unix.Bind(fd, &unix.SockaddrInet4{Port: port}); err != nil {
+		unix.Close(fd)
+		return nil, err
+	}
+	return os.NewFile(uintptr(fd), fmt.Sprintf("sctp:%d", port)), nil
+}
+
+// reserveNodePort opens a local socket for the Node port. The NPL rule is only installed by
+// installRulesForNodePortRange, once all the Node ports of a range have been reserved.
+func (pt *PortTable) reserveNodePort(nodePort int, podIP string, podPort int, protocol string) (*NodePortData, error) {
+	protocolData, err := openSocketsForPort(pt.LocalPortOpener, nodePort, protocol)
+	if err != nil {
+		return nil, err
+	}
+	return &NodePortData{
+		NodePort: nodePort,
+		PodIP:    podIP,
+		PodPort:  podPort,
+		Protocol: protocolData,
+	}, nil
+}
+
+func (pt *PortTable) releaseNodePort(npData *NodePortData) error {
+	return npData.Protocol.socket.Close()
+}
+
+func (pt *PortTable) installRulesForNodePortRange(npDataList []*NodePortData) error {
+	for i, npData := range npDataList {
+		if err := pt.PodPortRules.AddRule(npData.NodePort, npData.PodIP, npData.PodPort, npData.Protocol.Protocol); err != nil {
+			for _, installed := range npDataList[:i] {
+				if err := pt.PodPortRules.DeleteRule(installed.NodePort, installed.PodIP, installed.PodPort, installed.Protocol.Protocol); err != nil {
+					klog.ErrorS(err, "Failed to delete NPL rule", "nodePort", installed.NodePort)
+				}
+			}
+			for _, reserved := range npDataList {
+				pt.releaseNodePort(reserved)
+			}
+			return err
+		}
+	}
+	return nil
+}
+
 func (pt *PortTable) getFreePort(podIP string, podPort int, protocol string) (int, ProtocolSocketData, error) {
 	klog.V(2).InfoS("Looking for free Node port", "podIP", podIP, "podPort", podPort)
 	numPorts := pt.EndPort - pt.StartPort + 1
@@ -144,6 +196,9 @@ func (pt *PortTable) DeleteRule(podIP string, podPort int, protocol string) erro
 	if err := pt.PodPortRules.DeleteRule(data.NodePort, podIP, podPort, protocol); err != nil {
 		return err
 	}
+	// The NPL rule has been deleted, so the socket is no longer in use and can be released by
+	// CloseSockets, which refuses to release sockets in use.
+	data.Protocol.State = stateOpen
 	if err := data.CloseSockets(); err != nil {
 		return err
 	}
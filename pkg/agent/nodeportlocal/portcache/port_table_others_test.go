@@ -21,6 +21,8 @@ import (
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 
 	portcachetesting "antrea.io/antrea/pkg/agent/nodeportlocal/portcache/testing"
@@ -76,3 +78,31 @@ func TestRestoreRules(t *testing.T) {
 		t.Fatalf("Rule restoration not complete after %v", timeout)
 	}
 }
+
+type fakeSocket struct {
+	closed bool
+}
+
+func (s *fakeSocket) Close() error {
+	s.closed = true
+	return nil
+}
+
+func TestDeleteRule(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	mockIPTables := rulestesting.NewMockPodPortRules(mockCtrl)
+	mockPortOpener := portcachetesting.NewMockLocalPortOpener(mockCtrl)
+	portTable := newPortTable(mockIPTables, mockPortOpener)
+	socket := &fakeSocket{}
+
+	mockPortOpener.EXPECT().OpenLocalPort(startPort, "tcp").Return(socket, nil)
+	mockIPTables.EXPECT().AddRule(startPort, podIP, 1001, "tcp")
+	nodePort, err := portTable.AddRule(podIP, 1001, "tcp")
+	require.NoError(t, err)
+	assert.Equal(t, startPort, nodePort)
+
+	mockIPTables.EXPECT().DeleteRule(startPort, podIP, 1001, "tcp")
+	require.NoError(t, portTable.DeleteRule(podIP, 1001, "tcp"))
+	assert.True(t, socket.closed)
+	assert.False(t, portTable.RuleExists(podIP, 1001, "tcp"))
+}
@@ -19,6 +19,7 @@ package portcache
 
 import (
 	"fmt"
+	"io"
 
 	"k8s.io/klog/v2"
 
@@ -46,6 +47,34 @@ func addRuleForPort(podPortRules rules.PodPortRules, port int, podIP string, pod
 	return protocolData, nil
 }
 
+// openSCTPSocket is never called on Windows, where NPL does not support SCTP.
+func openSCTPSocket(port int) (io.Closer, error) {
+	return nil, fmt.Errorf("SCTP is not supported by NodePortLocal on Windows")
+}
+
+// reserveNodePort reserves the Node port by installing the NetNatStaticMapping rule directly.
+func (pt *PortTable) reserveNodePort(nodePort int, podIP string, podPort int, protocol string) (*NodePortData, error) {
+	protocolData, err := addRuleForPort(pt.PodPortRules, nodePort, podIP, podPort, protocol)
+	if err != nil {
+		return nil, err
+	}
+	return &NodePortData{
+		NodePort: nodePort,
+		PodIP:    podIP,
+		PodPort:  podPort,
+		Protocol: protocolData,
+	}, nil
+}
+
+func (pt *PortTable) releaseNodePort(npData *NodePortData) error {
+	return pt.PodPortRules.DeleteRule(npData.NodePort, npData.PodIP, npData.PodPort, npData.Protocol.Protocol)
+}
+
+// installRulesForNodePortRange is a no-op on Windows, as rules are installed when reserving Node ports.
+func (pt *PortTable) installRulesForNodePortRange(npDataList []*NodePortData) error {
+	return nil
+}
+
 func (pt *PortTable) addRuleforFreePort(podIP string, podPort int, protocol string) (int, ProtocolSocketData, error) {
 	klog.V(2).InfoS("Looking for free Node port on Windows", "podIP", podIP, "podPort", podPort, "protocol", protocol)
 	numPorts := pt.EndPort - pt.StartPort + 1
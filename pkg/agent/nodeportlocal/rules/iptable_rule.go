@@ -32,6 +32,16 @@ func InitRules() PodPortRules {
 	return NewIPTableRules()
 }
 
+// ProtocolSupported returns true if NPL rules can be installed for the provided
+// protocol. iptables supports DNAT for TCP, UDP and SCTP.
+func ProtocolSupported(protocol string) bool {
+	switch protocol {
+	case "tcp", "udp", "sctp":
+		return true
+	}
+	return false
+}
+
 // NodePortLocalChain is the name of the chain in IPTABLES for Node Port Local
 const NodePortLocalChain = "ANTREA-NODE-PORT-LOCAL"
 
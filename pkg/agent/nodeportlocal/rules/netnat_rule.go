@@ -38,6 +38,17 @@ func InitRules() PodPortRules {
 	return NewNetNatRules()
 }
 
+// ProtocolSupported returns true if NPL rules can be installed for the provided
+// protocol. NetNatStaticMapping only supports TCP and UDP, so SCTP ports are
+// ignored on Windows.
+func ProtocolSupported(protocol string) bool {
+	switch protocol {
+	case "tcp", "udp":
+		return true
+	}
+	return false
+}
+
 type netnatRules struct {
 	name string
 }
@@ -70,6 +81,9 @@ func (nn *netnatRules) initRules() error {
 
 // AddRule appends a NetNatStaticMapping rule.
 func (nn *netnatRules) AddRule(nodePort int, podIP string, podPort int, protocol string) error {
+	if !ProtocolSupported(protocol) {
+		return fmt.Errorf("protocol %s is not supported by NetNatStaticMapping", protocol)
+	}
 	netNatStaticMapping := &util.NetNatStaticMapping{
 		Name:         antreaNatNPL,
 		ExternalIP:   net.ParseIP("0.0.0.0"),
@@ -37,9 +37,9 @@ func NewExpectedNPLAnnotations(nodeIP *string, nplStartPort, nplEndPort int) *Ex
 	}
 }
 
-func (a *ExpectedNPLAnnotations) find(podPort int, protocol string) *types.NPLAnnotation {
+func (a *ExpectedNPLAnnotations) find(podPort, podPortEnd int, protocol string) *types.NPLAnnotation {
 	for _, annotation := range a.annotations {
-		if annotation.PodPort == podPort && annotation.Protocol == protocol {
+		if annotation.PodPort == podPort && annotation.PodPortEnd == podPortEnd && annotation.Protocol == protocol {
 			return &annotation
 		}
 	}
@@ -59,10 +59,22 @@ func (a *ExpectedNPLAnnotations) Add(nodePort *int, podPort int, protocol string
 	return a
 }
 
+// AddRange adds an expected annotation for the Pod port range [podPortStart, podPortEnd]. If
+// nodePort is not nil, it is the expected first Node port of the range.
+func (a *ExpectedNPLAnnotations) AddRange(nodePort *int, podPortStart, podPortEnd int, protocol string) *ExpectedNPLAnnotations {
+	a.Add(nodePort, podPortStart, protocol)
+	annotation := &a.annotations[len(a.annotations)-1]
+	annotation.PodPortEnd = podPortEnd
+	if nodePort != nil {
+		annotation.NodePortEnd = *nodePort + podPortEnd - podPortStart
+	}
+	return a
+}
+
 func (a *ExpectedNPLAnnotations) Check(t *testing.T, nplValue []types.NPLAnnotation) {
 	assert.Equal(t, len(a.annotations), len(nplValue), "Invalid number of NPL annotations")
 	for _, nplAnnotation := range nplValue {
-		expectedAnnotation := a.find(nplAnnotation.PodPort, nplAnnotation.Protocol)
+		expectedAnnotation := a.find(nplAnnotation.PodPort, nplAnnotation.PodPortEnd, nplAnnotation.Protocol)
 		if !assert.NotNilf(t, expectedAnnotation, "Unexpected annotation with PodPort %d", nplAnnotation.PodPort) {
 			continue
 		}
@@ -76,5 +88,9 @@ func (a *ExpectedNPLAnnotations) Check(t *testing.T, nplValue []types.NPLAnnotat
 			assert.GreaterOrEqual(t, nplAnnotation.NodePort, a.nplStartPort)
 			assert.LessOrEqual(t, nplAnnotation.NodePort, a.nplEndPort)
 		}
+		if expectedAnnotation.PodPortEnd != 0 {
+			assert.Equal(t, nplAnnotation.NodePort+expectedAnnotation.PodPortEnd-expectedAnnotation.PodPort, nplAnnotation.NodePortEnd, "NodePortEnd mismatch in annotation")
+			assert.LessOrEqual(t, nplAnnotation.NodePortEnd, a.nplEndPort)
+		}
 	}
 }
@@ -17,6 +17,10 @@ package types
 const (
 	NPLAnnotationKey        = "nodeportlocal.antrea.io"
 	NPLEnabledAnnotationKey = "nodeportlocal.antrea.io/enabled"
+	// NPLPortRangesAnnotationKey can be set on a Service for which NodePortLocal is enabled, to
+	// request that ranges of Pod ports be mapped to contiguous ranges of Node ports. The value
+	// is a comma-separated list of <start>-<end>:<protocol> entries, e.g. "10000-10099:udp".
+	NPLPortRangesAnnotationKey = "nodeportlocal.antrea.io/port-ranges"
 )
 
 // NPLAnnotation is the structure used for setting NodePortLocal annotation on the Pods.
@@ -26,4 +30,8 @@ type NPLAnnotation struct {
 	NodePort  int      `json:"nodePort"`
 	Protocol  string   `json:"protocol"`
 	Protocols []string `json:"protocols"` // deprecated, array with a single member which is equal to the Protocol field
+	// PodPortEnd and NodePortEnd are only set when the annotation maps a range of Pod ports,
+	// in which case PodPort and NodePort are the first ports of each range.
+	PodPortEnd  int `json:"podPortEnd,omitempty"`
+	NodePortEnd int `json:"nodePortEnd,omitempty"`
 }
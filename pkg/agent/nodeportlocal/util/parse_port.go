@@ -39,3 +39,31 @@ func ParsePortProto(portProtocol string) (int, string, error) {
 	protocol := portProtoSlice[1]
 	return port, protocol, err
 }
+
+// ParsePortRangeProto parses a port range and protocol from a string of the form
+// <start>-<end>:<protocol> (e.g. 10000-10099:udp). A single port is accepted as a range of size 1.
+func ParsePortRangeProto(portRangeProtocol string) (int, int, string, error) {
+	portRangeProtoSlice := strings.Split(strings.TrimSpace(portRangeProtocol), delim)
+	if len(portRangeProtoSlice) != 2 {
+		return 0, 0, "", fmt.Errorf("invalid format for port range string '%s'", portRangeProtocol)
+	}
+	protocol := strings.ToLower(portRangeProtoSlice[1])
+	bounds := strings.SplitN(portRangeProtoSlice[0], "-", 2)
+	start, err := strconv.Atoi(bounds[0])
+	if err != nil {
+		return 0, 0, "", fmt.Errorf("invalid start port in port range string '%s': %v", portRangeProtocol, err)
+	}
+	end := start
+	if len(bounds) == 2 {
+		if end, err = strconv.Atoi(bounds[1]); err != nil {
+			return 0, 0, "", fmt.Errorf("invalid end port in port range string '%s': %v", portRangeProtocol, err)
+		}
+	}
+	if start < 1 || end > 65535 {
+		return 0, 0, "", fmt.Errorf("port range in port range string '%s' must be within 1-65535", portRangeProtocol)
+	}
+	if start > end {
+		return 0, 0, "", fmt.Errorf("start port must not be greater than end port in port range string '%s'", portRangeProtocol)
+	}
+	return start, end, protocol, nil
+}
@@ -367,18 +367,25 @@ type Client interface {
 		igmp ofutil.Message) error
 
 	// InstallMulticlusterNodeFlows installs flows to handle cross-cluster packets between a regular
-	// Node and a local Gateway.
+	// Node and the local Gateways. Connections to peerCIDRs are hashed across localGatewayIPs, and
+	// reply packets to a remote Gateway IP are sent to the local Gateway given in remoteGatewayPeers.
 	InstallMulticlusterNodeFlows(
 		clusterID string,
-		peerConfigs map[*net.IPNet]net.IP,
-		tunnelPeerIP net.IP,
+		peerCIDRs []*net.IPNet,
+		localGatewayIPs []net.IP,
+		remoteGatewayPeers map[string]net.IP,
 		enableStretchedNetworkPolicy bool) error
 
 	// InstallMulticlusterGatewayFlows installs flows to handle cross-cluster packets between Gateways.
+	// Connections to peerCIDRs are sent to tunnelPeerIP, and reply packets to each of remoteGatewayIPs
+	// are sent back to the remote Gateway directly. Probes to each probe IP in remoteProbeIPPeers
+	// are sent through the tunnel to the mapped remote Gateway.
 	InstallMulticlusterGatewayFlows(
 		clusterID string,
-		peerConfigs map[*net.IPNet]net.IP,
+		peerCIDRs []*net.IPNet,
 		tunnelPeerIP net.IP,
+		remoteGatewayIPs []net.IP,
+		remoteProbeIPPeers map[string]net.IP,
 		localGatewayIP net.IP,
 		enableStretchedNetworkPolicy bool) error
 
@@ -386,8 +393,9 @@ type Client interface {
 	InstallMulticlusterClassifierFlows(tunnelOFPort uint32, isGateway bool) error
 
 	// InstallMulticlusterPodFlows installs flows to handle cross-cluster packets from Multi-cluster Gateway to
-	// regular Nodes.
-	InstallMulticlusterPodFlows(podIP net.IP, tunnelPeerIP net.IP) error
+	// regular Nodes when tunnelPeerIP is not nil, and flows to translate the global IP of the Pod to the Pod IP
+	// when globalIP is not nil.
+	InstallMulticlusterPodFlows(podIP net.IP, tunnelPeerIP net.IP, globalIP net.IP) error
 
 	// UninstallMulticlusterFlows removes cross-cluster flows matching the given cache key on
 	// a regular Node or a Gateway.
@@ -948,6 +956,7 @@ func (c *client) generatePipelines() {
 	if c.nodeType == config.ExternalNode {
 		c.featureExternalNodeConnectivity = newFeatureExternalNodeConnectivity(c.cookieAllocator, c.ipProtocols)
 		c.activatedFeatures = append(c.activatedFeatures, c.featureExternalNodeConnectivity)
+		c.traceableFeatures = append(c.traceableFeatures, c.featureExternalNodeConnectivity)
 	}
 
 	c.featureNetworkPolicy = newFeatureNetworkPolicy(c.cookieAllocator,
@@ -958,6 +967,7 @@ func (c *client) generatePipelines() {
 		c.enableDenyTracking,
 		c.enableAntreaPolicy,
 		c.enableL7NetworkPolicy,
+		c.enableAuditMode,
 		c.enableMulticast,
 		c.proxyAll,
 		c.connectUplinkToBridge,
@@ -983,7 +993,7 @@ func (c *client) generatePipelines() {
 	}
 
 	if c.enableMulticluster {
-		c.featureMulticluster = newFeatureMulticluster(c.cookieAllocator, []binding.Protocol{binding.ProtocolIP})
+		c.featureMulticluster = newFeatureMulticluster(c.cookieAllocator, []binding.Protocol{binding.ProtocolIP}, c.bridge, c.groupIDAllocator)
 		c.activatedFeatures = append(c.activatedFeatures, c.featureMulticluster)
 	}
 
@@ -1575,26 +1585,75 @@ func (c *client) UninstallMulticastGroup(groupID binding.GroupIDType) error {
 }
 
 // InstallMulticlusterNodeFlows installs flows to handle cross-cluster packets between a regular
-// Node and a local Gateway.
+// Node and the local Gateways.
 func (c *client) InstallMulticlusterNodeFlows(clusterID string,
-	peerConfigs map[*net.IPNet]net.IP,
-	tunnelPeerIP net.IP,
+	peerCIDRs []*net.IPNet,
+	localGatewayIPs []net.IP,
+	remoteGatewayPeers map[string]net.IP,
 	enableStretchedNetworkPolicy bool) error {
 	c.replayMutex.RLock()
 	defer c.replayMutex.RUnlock()
+	if len(localGatewayIPs) == 0 {
+		return fmt.Errorf("no local Gateway for cross-cluster traffic to cluster %s", clusterID)
+	}
+	// The slots are assigned even when there is only one Gateway, so the connections
+	// through it are kept on it when more Gateways are added.
+	c.featureMulticluster.gatewaySlots = assignGatewaySlots(c.featureMulticluster.gatewaySlots, localGatewayIPs)
+	var groupID binding.GroupIDType
+	if len(localGatewayIPs) > 1 {
+		var err error
+		if groupID, err = c.installMulticlusterGatewayGroup(c.featureMulticluster.gatewaySlots); err != nil {
+			return err
+		}
+	}
 	cacheKey := fmt.Sprintf("cluster_%s", clusterID)
 	var flows []binding.Flow
 	localGatewayMAC := c.nodeConfig.GatewayConfig.MAC
-	for peerCIDR, remoteGatewayIP := range peerConfigs {
-		flows = append(flows, c.featureMulticluster.l3FwdFlowToRemoteGateway(localGatewayMAC, *peerCIDR, tunnelPeerIP, remoteGatewayIP, enableStretchedNetworkPolicy)...)
+	for _, peerCIDR := range peerCIDRs {
+		flows = append(flows, c.featureMulticluster.l3FwdFlowToRemoteCIDR(localGatewayMAC, *peerCIDR, localGatewayIPs[0], groupID))
+		// Keep the established connections on the local Gateways selected by their first
+		// packets. The Gateway of a connection is only stored when it has an IPv4 address.
+		for _, localGatewayIP := range localGatewayIPs {
+			if localGatewayIP.To4() != nil {
+				flows = append(flows, c.featureMulticluster.l3FwdFlowToSelectedGateway(localGatewayMAC, *peerCIDR, localGatewayIP))
+			}
+		}
+	}
+	for remoteGatewayIP, tunnelPeerIP := range remoteGatewayPeers {
+		flows = append(flows, c.featureMulticluster.l3FwdFlowsToRemoteGateway(localGatewayMAC, net.ParseIP(remoteGatewayIP), tunnelPeerIP, enableStretchedNetworkPolicy)...)
 	}
 	return c.modifyFlows(c.featureMulticluster.cachedFlows, cacheKey, flows)
 }
 
+// installMulticlusterGatewayGroup installs or updates the select group which hashes
+// cross-cluster connections across the local Gateways assigned to the slots. The group
+// is shared by the flows of all remote clusters and is kept once installed, since
+// deleting it would also delete the flows referring to it.
+func (c *client) installMulticlusterGatewayGroup(gatewaySlots []net.IP) (binding.GroupIDType, error) {
+	f := c.featureMulticluster
+	if f.gatewayGroupID == 0 {
+		f.gatewayGroupID = f.groupAllocator.Allocate()
+	}
+	group := f.localGatewaysGroup(f.gatewayGroupID, gatewaySlots)
+	if _, installed := f.groupCache.Load(f.gatewayGroupID); !installed {
+		if err := c.ofEntryOperations.AddOFEntries([]binding.OFEntry{group}); err != nil {
+			return 0, fmt.Errorf("error when installing Multi-cluster Gateway Group %d: %w", f.gatewayGroupID, err)
+		}
+	} else {
+		if err := c.ofEntryOperations.ModifyOFEntries([]binding.OFEntry{group}); err != nil {
+			return 0, fmt.Errorf("error when modifying Multi-cluster Gateway Group %d: %w", f.gatewayGroupID, err)
+		}
+	}
+	f.groupCache.Store(f.gatewayGroupID, group)
+	return f.gatewayGroupID, nil
+}
+
 // InstallMulticlusterGatewayFlows installs flows to handle cross-cluster packets between Gateways.
 func (c *client) InstallMulticlusterGatewayFlows(clusterID string,
-	peerConfigs map[*net.IPNet]net.IP,
+	peerCIDRs []*net.IPNet,
 	tunnelPeerIP net.IP,
+	remoteGatewayIPs []net.IP,
+	remoteProbeIPPeers map[string]net.IP,
 	localGatewayIP net.IP,
 	enableStretchedNetworkPolicy bool,
 ) error {
@@ -1603,11 +1662,19 @@ func (c *client) InstallMulticlusterGatewayFlows(clusterID string,
 	cacheKey := fmt.Sprintf("cluster_%s", clusterID)
 	var flows []binding.Flow
 	localGatewayMAC := c.nodeConfig.GatewayConfig.MAC
-	for peerCIDR, remoteGatewayIP := range peerConfigs {
-		flows = append(flows, c.featureMulticluster.l3FwdFlowToRemoteGateway(localGatewayMAC, *peerCIDR, tunnelPeerIP, remoteGatewayIP, enableStretchedNetworkPolicy)...)
+	for _, peerCIDR := range peerCIDRs {
+		flows = append(flows, c.featureMulticluster.l3FwdFlowToRemoteCIDR(localGatewayMAC, *peerCIDR, tunnelPeerIP, 0))
 		// Add SNAT flows to change cross-cluster packets' source IP to local Gateway IP.
 		flows = append(flows, c.featureMulticluster.snatConntrackFlows(*peerCIDR, localGatewayIP)...)
 	}
+	// The remote Gateways which performed SNAT for the connections hold the conntrack states,
+	// so reply packets are sent to the remote Gateways directly.
+	for _, remoteGatewayIP := range remoteGatewayIPs {
+		flows = append(flows, c.featureMulticluster.l3FwdFlowsToRemoteGateway(localGatewayMAC, remoteGatewayIP, remoteGatewayIP, enableStretchedNetworkPolicy)...)
+	}
+	for probeIP, tunnelPeer := range remoteProbeIPPeers {
+		flows = append(flows, c.featureMulticluster.l3FwdFlowToRemoteProbeIP(localGatewayMAC, net.ParseIP(probeIP), tunnelPeer))
+	}
 	return c.modifyFlows(c.featureMulticluster.cachedFlows, cacheKey, flows)
 }
 
@@ -1617,6 +1684,8 @@ func (c *client) InstallMulticlusterGatewayFlows(clusterID string,
 //   - One flow in ClassifierTable for the tunnel traffic if it's not Encap mode.
 //   - One flow to match MC virtual MAC 'aa:bb:cc:dd:ee:f0' in ClassifierTable for Gateway only.
 //   - One flow in OutputTable to allow multicluster hairpin traffic for Gateway only.
+//   - One flow in ConntrackCommitTable to store the local Gateway selected for a cross-cluster
+//     connection in the ct_label for regular Node only.
 func (c *client) InstallMulticlusterClassifierFlows(tunnelOFPort uint32, isGateway bool) error {
 	c.replayMutex.RLock()
 	defer c.replayMutex.RUnlock()
@@ -1630,15 +1699,23 @@ func (c *client) InstallMulticlusterClassifierFlows(tunnelOFPort uint32, isGatew
 			c.featureMulticluster.tunnelClassifierFlow(tunnelOFPort),
 			c.featureMulticluster.outputHairpinTunnelFlow(tunnelOFPort),
 		)
+	} else {
+		flows = append(flows, c.featureMulticluster.gatewayCommitFlows()...)
 	}
 	return c.modifyFlows(c.featureMulticluster.cachedFlows, "multicluster-classifier", flows)
 }
 
-func (c *client) InstallMulticlusterPodFlows(podIP net.IP, tunnelPeerIP net.IP) error {
+func (c *client) InstallMulticlusterPodFlows(podIP net.IP, tunnelPeerIP net.IP, globalIP net.IP) error {
 	c.replayMutex.RLock()
 	defer c.replayMutex.RUnlock()
 	localGatewayMAC := c.nodeConfig.GatewayConfig.MAC
-	flows := []binding.Flow{c.featureMulticluster.l3FwdFlowToPodViaTun(localGatewayMAC, podIP, tunnelPeerIP)}
+	var flows []binding.Flow
+	if tunnelPeerIP != nil {
+		flows = append(flows, c.featureMulticluster.l3FwdFlowToPodViaTun(localGatewayMAC, podIP, tunnelPeerIP))
+	}
+	if globalIP != nil {
+		flows = append(flows, c.featureMulticluster.podGlobalIPDNATFlow(globalIP, podIP))
+	}
 	return c.modifyFlows(c.featureMulticluster.cachedPodFlows, podIP.String(), flows)
 }
 
@@ -1679,7 +1756,8 @@ func getFlowModMessage(flow binding.Flow, op binding.OFOperation) *openflow15.Fl
 }
 
 // getMeterStats sends a multipart request to get all the meter statistics and
-// sets values for antrea_agent_ovs_meter_packet_dropped_count.
+// sets values for antrea_agent_ovs_meter_packet_dropped_count. It also updates
+// the number of packets dropped by the meters of rules with the RateLimit action.
 func (c *client) getMeterStats() {
 	handleMeterStatsReply := func(meterID int, packetCount int64) {
 		switch meterID {
@@ -1690,6 +1768,10 @@ func (c *client) getMeterStats() {
 		case PacketInMeterIDDNS:
 			metrics.OVSMeterPacketDroppedCount.WithLabelValues(metrics.LabelPacketInMeterDNSInterception).Set(float64(packetCount))
 		default:
+			if isRuleRateLimitMeterID(meterID) {
+				c.featureNetworkPolicy.rateLimitedPackets.Store(binding.MeterIDType(meterID), uint64(packetCount))
+				return
+			}
 			klog.V(4).InfoS("Received unexpected meterID", "meterID", meterID)
 		}
 	}
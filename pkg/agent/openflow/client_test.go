@@ -18,6 +18,7 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"testing"
@@ -93,6 +94,7 @@ type clientOptions struct {
 	enableTrafficControl       bool
 	enableMulticluster         bool
 	enableL7NetworkPolicy      bool
+	enableAuditMode            bool
 	enableL7FlowExporter       bool
 	trafficEncryptionMode      config.TrafficEncryptionModeType
 }
@@ -160,6 +162,10 @@ func enableL7NetworkPolicy(o *clientOptions) {
 	o.enableL7NetworkPolicy = true
 }
 
+func enableAuditMode(o *clientOptions) {
+	o.enableAuditMode = true
+}
+
 func enableTrafficControl(o *clientOptions) {
 	o.enableTrafficControl = true
 }
@@ -407,6 +413,7 @@ func newFakeClientWithBridge(
 		o.enableProxy,
 		o.enableAntreaPolicy,
 		o.enableL7NetworkPolicy,
+		o.enableAuditMode,
 		o.enableEgress,
 		o.enableEgressTrafficShaping,
 		false,
@@ -2031,7 +2038,7 @@ func Test_client_setBasePacketOutBuilder(t *testing.T) {
 }
 
 func prepareSetBasePacketOutBuilder(ctrl *gomock.Controller, success bool) *client {
-	ofClient := NewClient(bridgeName, bridgeMgmtAddr, nodeiptest.NewFakeNodeIPChecker(), true, true, false, false, false, false, false, false, false, false, false, false, false, nil, false, defaultPacketInRate)
+	ofClient := NewClient(bridgeName, bridgeMgmtAddr, nodeiptest.NewFakeNodeIPChecker(), true, true, false, false, false, false, false, false, false, false, false, false, false, false, nil, false, defaultPacketInRate)
 	m := ovsoftest.NewMockBridge(ctrl)
 	ofClient.bridge = m
 	bridge := binding.OFBridge{}
@@ -2577,22 +2584,60 @@ func Test_client_InstallMulticastGroup(t *testing.T) {
 func Test_client_InstallMulticlusterNodeFlows(t *testing.T) {
 	clusterID := "test_cluster"
 	_, peerServiceCIDRIPv4, _ := net.ParseCIDR("10.97.0.0/16")
-	tunnelPeerIPv4 := net.ParseIP("192.168.78.101")
+	localGatewayIPv4 := net.ParseIP("192.168.78.101")
+	localGatewayIPv4b := net.ParseIP("192.168.78.102")
 
 	testCases := []struct {
-		name          string
-		peerConfigs   map[*net.IPNet]net.IP
-		tunnelPeerIP  net.IP
-		expectedFlows []string
+		name               string
+		peerCIDRs          []*net.IPNet
+		localGatewayIPs    []net.IP
+		remoteGatewayPeers map[string]net.IP
+		expectedFlows      func(groupID binding.GroupIDType) []string
+		expectedGroup      func(groupID binding.GroupIDType) string
 	}{
 		{
-			name:         "IPv4",
-			peerConfigs:  map[*net.IPNet]net.IP{peerServiceCIDRIPv4: tunnelPeerIPv4},
-			tunnelPeerIP: tunnelPeerIPv4,
-			expectedFlows: []string{
-				"cookie=0x1060000000000, table=L3Forwarding, priority=200,ip,nw_dst=10.97.0.0/16 actions=set_field:0a:00:00:00:00:01->eth_src,set_field:aa:bb:cc:dd:ee:f0->eth_dst,set_field:192.168.78.101->tun_dst,set_field:0x10/0xf0->reg0,goto_table:L3DecTTL",
-				"cookie=0x1060000000000, table=L3Forwarding, priority=200,ct_state=+rpl+trk,ip,nw_dst=192.168.78.101 actions=set_field:0a:00:00:00:00:01->eth_src,set_field:aa:bb:cc:dd:ee:f0->eth_dst,set_field:192.168.78.101->tun_dst,set_field:0x10/0xf0->reg0,goto_table:L3DecTTL",
-				"cookie=0x1060000000000, table=L3Forwarding, priority=199,ip,reg0=0x2000/0x2000,nw_dst=192.168.78.101 actions=set_field:0a:00:00:00:00:01->eth_src,set_field:aa:bb:cc:dd:ee:f0->eth_dst,set_field:192.168.78.101->tun_dst,set_field:0x10/0xf0->reg0,goto_table:L3DecTTL",
+			name:               "IPv4 with one Gateway",
+			peerCIDRs:          []*net.IPNet{peerServiceCIDRIPv4},
+			localGatewayIPs:    []net.IP{localGatewayIPv4},
+			remoteGatewayPeers: map[string]net.IP{"172.18.0.10": localGatewayIPv4},
+			expectedFlows: func(_ binding.GroupIDType) []string {
+				return []string{
+					"cookie=0x1060000000000, table=L3Forwarding, priority=200,ip,nw_dst=10.97.0.0/16 actions=set_field:0a:00:00:00:00:01->eth_src,set_field:aa:bb:cc:dd:ee:f0->eth_dst,set_field:192.168.78.101->tun_dst,set_field:0x10/0xf0->reg0,goto_table:L3DecTTL",
+					"cookie=0x1060000000000, table=L3Forwarding, priority=210,ct_state=-new-rpl+trk,ct_label=0xc0a84e65000000000000000000000000/0xffffffff000000000000000000000000,ip,nw_dst=10.97.0.0/16 actions=set_field:0a:00:00:00:00:01->eth_src,set_field:aa:bb:cc:dd:ee:f0->eth_dst,set_field:192.168.78.101->tun_dst,set_field:0x10/0xf0->reg0,goto_table:L3DecTTL",
+					"cookie=0x1060000000000, table=L3Forwarding, priority=200,ct_state=+rpl+trk,ip,nw_dst=172.18.0.10 actions=set_field:0a:00:00:00:00:01->eth_src,set_field:aa:bb:cc:dd:ee:f0->eth_dst,set_field:192.168.78.101->tun_dst,set_field:0x10/0xf0->reg0,goto_table:L3DecTTL",
+					"cookie=0x1060000000000, table=L3Forwarding, priority=199,ip,reg0=0x2000/0x2000,nw_dst=172.18.0.10 actions=set_field:0a:00:00:00:00:01->eth_src,set_field:aa:bb:cc:dd:ee:f0->eth_dst,set_field:192.168.78.101->tun_dst,set_field:0x10/0xf0->reg0,goto_table:L3DecTTL",
+				}
+			},
+		},
+		{
+			name:            "IPv4 with multiple Gateways",
+			peerCIDRs:       []*net.IPNet{peerServiceCIDRIPv4},
+			localGatewayIPs: []net.IP{localGatewayIPv4, localGatewayIPv4b},
+			remoteGatewayPeers: map[string]net.IP{
+				"172.18.0.10": localGatewayIPv4b,
+				"172.18.0.11": localGatewayIPv4,
+			},
+			expectedFlows: func(groupID binding.GroupIDType) []string {
+				return []string{
+					fmt.Sprintf("cookie=0x1060000000000, table=L3Forwarding, priority=200,ip,nw_dst=10.97.0.0/16 actions=set_field:0a:00:00:00:00:01->eth_src,set_field:aa:bb:cc:dd:ee:f0->eth_dst,set_field:0x10/0xf0->reg0,group:%d", groupID),
+					"cookie=0x1060000000000, table=L3Forwarding, priority=210,ct_state=-new-rpl+trk,ct_label=0xc0a84e65000000000000000000000000/0xffffffff000000000000000000000000,ip,nw_dst=10.97.0.0/16 actions=set_field:0a:00:00:00:00:01->eth_src,set_field:aa:bb:cc:dd:ee:f0->eth_dst,set_field:192.168.78.101->tun_dst,set_field:0x10/0xf0->reg0,goto_table:L3DecTTL",
+					"cookie=0x1060000000000, table=L3Forwarding, priority=210,ct_state=-new-rpl+trk,ct_label=0xc0a84e66000000000000000000000000/0xffffffff000000000000000000000000,ip,nw_dst=10.97.0.0/16 actions=set_field:0a:00:00:00:00:01->eth_src,set_field:aa:bb:cc:dd:ee:f0->eth_dst,set_field:192.168.78.102->tun_dst,set_field:0x10/0xf0->reg0,goto_table:L3DecTTL",
+					"cookie=0x1060000000000, table=L3Forwarding, priority=200,ct_state=+rpl+trk,ip,nw_dst=172.18.0.10 actions=set_field:0a:00:00:00:00:01->eth_src,set_field:aa:bb:cc:dd:ee:f0->eth_dst,set_field:192.168.78.102->tun_dst,set_field:0x10/0xf0->reg0,goto_table:L3DecTTL",
+					"cookie=0x1060000000000, table=L3Forwarding, priority=199,ip,reg0=0x2000/0x2000,nw_dst=172.18.0.10 actions=set_field:0a:00:00:00:00:01->eth_src,set_field:aa:bb:cc:dd:ee:f0->eth_dst,set_field:192.168.78.102->tun_dst,set_field:0x10/0xf0->reg0,goto_table:L3DecTTL",
+					"cookie=0x1060000000000, table=L3Forwarding, priority=200,ct_state=+rpl+trk,ip,nw_dst=172.18.0.11 actions=set_field:0a:00:00:00:00:01->eth_src,set_field:aa:bb:cc:dd:ee:f0->eth_dst,set_field:192.168.78.101->tun_dst,set_field:0x10/0xf0->reg0,goto_table:L3DecTTL",
+					"cookie=0x1060000000000, table=L3Forwarding, priority=199,ip,reg0=0x2000/0x2000,nw_dst=172.18.0.11 actions=set_field:0a:00:00:00:00:01->eth_src,set_field:aa:bb:cc:dd:ee:f0->eth_dst,set_field:192.168.78.101->tun_dst,set_field:0x10/0xf0->reg0,goto_table:L3DecTTL",
+				}
+			},
+			expectedGroup: func(groupID binding.GroupIDType) string {
+				var buckets []string
+				for i := 0; i < multiclusterGatewaySlots; i++ {
+					tunnelPeer := "192.168.78.101"
+					if i%2 == 1 {
+						tunnelPeer = "192.168.78.102"
+					}
+					buckets = append(buckets, fmt.Sprintf("bucket=bucket_id:%d,weight:100,actions=set_field:%s->tun_dst,resubmit:L3DecTTL", i, tunnelPeer))
+				}
+				return fmt.Sprintf("group_id=%d,type=select,", groupID) + strings.Join(buckets, ",")
 			},
 		},
 		//TODO: IPv6
@@ -2605,14 +2650,23 @@ func Test_client_InstallMulticlusterNodeFlows(t *testing.T) {
 			fc := newFakeClient(m, true, true, config.K8sNode, config.TrafficEncapModeEncap, enableMulticluster)
 			defer resetPipelines()
 
+			if tc.expectedGroup != nil {
+				m.EXPECT().AddOFEntries(gomock.Any()).Return(nil).Times(1)
+			}
 			m.EXPECT().AddAll(gomock.Any()).Return(nil).Times(1)
 			m.EXPECT().DeleteAll(gomock.Any()).Return(nil).Times(1)
 
-			assert.NoError(t, fc.InstallMulticlusterNodeFlows(clusterID, tc.peerConfigs, tc.tunnelPeerIP, true))
+			assert.NoError(t, fc.InstallMulticlusterNodeFlows(clusterID, tc.peerCIDRs, tc.localGatewayIPs, tc.remoteGatewayPeers, true))
+			groupID := fc.featureMulticluster.gatewayGroupID
 			cacheKey := fmt.Sprintf("cluster_%s", clusterID)
 			fCacheI, ok := fc.featureMulticluster.cachedFlows.Load(cacheKey)
 			require.True(t, ok)
-			assert.ElementsMatch(t, tc.expectedFlows, getFlowStrings(fCacheI))
+			assert.ElementsMatch(t, tc.expectedFlows(groupID), getFlowStrings(fCacheI))
+			if tc.expectedGroup != nil {
+				gCacheI, ok := fc.featureMulticluster.groupCache.Load(groupID)
+				require.True(t, ok)
+				assert.Equal(t, tc.expectedGroup(groupID), getGroupFromCache(gCacheI.(binding.Group)))
+			}
 
 			assert.NoError(t, fc.UninstallMulticlusterFlows(clusterID))
 			_, ok = fc.featureMulticluster.cachedFlows.Load(cacheKey)
@@ -2621,29 +2675,95 @@ func Test_client_InstallMulticlusterNodeFlows(t *testing.T) {
 	}
 }
 
+func TestAssignGatewaySlots(t *testing.T) {
+	gatewayA := net.ParseIP("192.168.78.101")
+	gatewayB := net.ParseIP("192.168.78.102")
+	gatewayC := net.ParseIP("192.168.78.103")
+	countSlots := func(slots []net.IP) map[string]int {
+		counts := map[string]int{}
+		for _, slot := range slots {
+			counts[slot.String()]++
+		}
+		return counts
+	}
+
+	slots := assignGatewaySlots(nil, []net.IP{gatewayA})
+	assert.Equal(t, map[string]int{"192.168.78.101": 64}, countSlots(slots))
+
+	// Adding Gateways only moves the slots taken over by the new Gateways.
+	twoGatewaySlots := assignGatewaySlots(slots, []net.IP{gatewayA, gatewayB})
+	assert.Equal(t, map[string]int{"192.168.78.101": 32, "192.168.78.102": 32}, countSlots(twoGatewaySlots))
+	threeGatewaySlots := assignGatewaySlots(twoGatewaySlots, []net.IP{gatewayA, gatewayB, gatewayC})
+	assert.Equal(t, map[string]int{"192.168.78.101": 22, "192.168.78.102": 21, "192.168.78.103": 21}, countSlots(threeGatewaySlots))
+	moved := 0
+	for i := range threeGatewaySlots {
+		if !threeGatewaySlots[i].Equal(twoGatewaySlots[i]) {
+			assert.True(t, threeGatewaySlots[i].Equal(gatewayC))
+			moved++
+		}
+	}
+	assert.Equal(t, 21, moved)
+
+	// Losing a Gateway only moves the slots of that Gateway.
+	afterLossSlots := assignGatewaySlots(threeGatewaySlots, []net.IP{gatewayA, gatewayC})
+	assert.Equal(t, map[string]int{"192.168.78.101": 32, "192.168.78.103": 32}, countSlots(afterLossSlots))
+	for i := range afterLossSlots {
+		if !threeGatewaySlots[i].Equal(gatewayB) {
+			assert.True(t, afterLossSlots[i].Equal(threeGatewaySlots[i]))
+		}
+	}
+}
+
 func Test_client_InstallMulticlusterGatewayFlows(t *testing.T) {
 	clusterID := "test_cluster"
 	_, peerServiceCIDRIPv4, _ := net.ParseCIDR("10.97.0.0/16")
 	tunnelPeerIPv4 := net.ParseIP("192.168.78.101")
+	remoteGatewayIPv4b := net.ParseIP("192.168.78.102")
 	localGatewayIPv4 := net.ParseIP("192.168.77.100")
 
 	testCases := []struct {
-		name           string
-		peerConfigs    map[*net.IPNet]net.IP
-		tunnelPeerIP   net.IP
-		localGatewayIP net.IP
-		expectedFlows  []string
+		name               string
+		peerCIDRs          []*net.IPNet
+		tunnelPeerIP       net.IP
+		remoteGatewayIPs   []net.IP
+		remoteProbeIPPeers map[string]net.IP
+		localGatewayIP     net.IP
+		expectedFlows      []string
 	}{
 		{
-			name:           "IPv4",
-			peerConfigs:    map[*net.IPNet]net.IP{peerServiceCIDRIPv4: tunnelPeerIPv4},
-			tunnelPeerIP:   tunnelPeerIPv4,
+			name:             "IPv4 with one remote Gateway",
+			peerCIDRs:        []*net.IPNet{peerServiceCIDRIPv4},
+			tunnelPeerIP:     tunnelPeerIPv4,
+			remoteGatewayIPs: []net.IP{tunnelPeerIPv4},
+			localGatewayIP:   localGatewayIPv4,
+			expectedFlows: []string{
+				"cookie=0x1060000000000, table=UnSNAT, priority=200,ip,nw_dst=192.168.77.100 actions=ct(table=ConntrackZone,zone=65521,nat)",
+				"cookie=0x1060000000000, table=L3Forwarding, priority=200,ip,nw_dst=10.97.0.0/16 actions=set_field:0a:00:00:00:00:01->eth_src,set_field:aa:bb:cc:dd:ee:f0->eth_dst,set_field:192.168.78.101->tun_dst,set_field:0x10/0xf0->reg0,goto_table:L3DecTTL",
+				"cookie=0x1060000000000, table=L3Forwarding, priority=200,ct_state=+rpl+trk,ip,nw_dst=192.168.78.101 actions=set_field:0a:00:00:00:00:01->eth_src,set_field:aa:bb:cc:dd:ee:f0->eth_dst,set_field:192.168.78.101->tun_dst,set_field:0x10/0xf0->reg0,goto_table:L3DecTTL",
+				"cookie=0x1060000000000, table=L3Forwarding, priority=199,ip,reg0=0x2000/0x2000,nw_dst=192.168.78.101 actions=set_field:0a:00:00:00:00:01->eth_src,set_field:aa:bb:cc:dd:ee:f0->eth_dst,set_field:192.168.78.101->tun_dst,set_field:0x10/0xf0->reg0,goto_table:L3DecTTL",
+				"cookie=0x1060000000000, table=SNATMark, priority=210,ct_state=+new+trk,ip,nw_dst=10.97.0.0/16 actions=ct(commit,table=SNAT,zone=65520,exec(set_field:0x20/0x20->ct_mark))",
+				"cookie=0x1060000000000, table=SNAT, priority=200,ct_state=+new+trk,ip,nw_dst=10.97.0.0/16 actions=ct(commit,table=L2ForwardingCalc,zone=65521,nat(src=192.168.77.100))",
+			},
+		},
+		{
+			name:             "IPv4 with multiple remote Gateways",
+			peerCIDRs:        []*net.IPNet{peerServiceCIDRIPv4},
+			tunnelPeerIP:     tunnelPeerIPv4,
+			remoteGatewayIPs: []net.IP{tunnelPeerIPv4, remoteGatewayIPv4b},
+			remoteProbeIPPeers: map[string]net.IP{
+				"10.245.1.1": tunnelPeerIPv4,
+				"10.245.2.1": remoteGatewayIPv4b,
+			},
 			localGatewayIP: localGatewayIPv4,
 			expectedFlows: []string{
+				"cookie=0x1060000000000, table=L3Forwarding, priority=210,ip,nw_dst=10.245.1.1 actions=set_field:0a:00:00:00:00:01->eth_src,set_field:aa:bb:cc:dd:ee:f0->eth_dst,set_field:192.168.78.101->tun_dst,set_field:0x10/0xf0->reg0,goto_table:L3DecTTL",
+				"cookie=0x1060000000000, table=L3Forwarding, priority=210,ip,nw_dst=10.245.2.1 actions=set_field:0a:00:00:00:00:01->eth_src,set_field:aa:bb:cc:dd:ee:f0->eth_dst,set_field:192.168.78.102->tun_dst,set_field:0x10/0xf0->reg0,goto_table:L3DecTTL",
 				"cookie=0x1060000000000, table=UnSNAT, priority=200,ip,nw_dst=192.168.77.100 actions=ct(table=ConntrackZone,zone=65521,nat)",
 				"cookie=0x1060000000000, table=L3Forwarding, priority=200,ip,nw_dst=10.97.0.0/16 actions=set_field:0a:00:00:00:00:01->eth_src,set_field:aa:bb:cc:dd:ee:f0->eth_dst,set_field:192.168.78.101->tun_dst,set_field:0x10/0xf0->reg0,goto_table:L3DecTTL",
 				"cookie=0x1060000000000, table=L3Forwarding, priority=200,ct_state=+rpl+trk,ip,nw_dst=192.168.78.101 actions=set_field:0a:00:00:00:00:01->eth_src,set_field:aa:bb:cc:dd:ee:f0->eth_dst,set_field:192.168.78.101->tun_dst,set_field:0x10/0xf0->reg0,goto_table:L3DecTTL",
 				"cookie=0x1060000000000, table=L3Forwarding, priority=199,ip,reg0=0x2000/0x2000,nw_dst=192.168.78.101 actions=set_field:0a:00:00:00:00:01->eth_src,set_field:aa:bb:cc:dd:ee:f0->eth_dst,set_field:192.168.78.101->tun_dst,set_field:0x10/0xf0->reg0,goto_table:L3DecTTL",
+				"cookie=0x1060000000000, table=L3Forwarding, priority=200,ct_state=+rpl+trk,ip,nw_dst=192.168.78.102 actions=set_field:0a:00:00:00:00:01->eth_src,set_field:aa:bb:cc:dd:ee:f0->eth_dst,set_field:192.168.78.102->tun_dst,set_field:0x10/0xf0->reg0,goto_table:L3DecTTL",
+				"cookie=0x1060000000000, table=L3Forwarding, priority=199,ip,reg0=0x2000/0x2000,nw_dst=192.168.78.102 actions=set_field:0a:00:00:00:00:01->eth_src,set_field:aa:bb:cc:dd:ee:f0->eth_dst,set_field:192.168.78.102->tun_dst,set_field:0x10/0xf0->reg0,goto_table:L3DecTTL",
 				"cookie=0x1060000000000, table=SNATMark, priority=210,ct_state=+new+trk,ip,nw_dst=10.97.0.0/16 actions=ct(commit,table=SNAT,zone=65520,exec(set_field:0x20/0x20->ct_mark))",
 				"cookie=0x1060000000000, table=SNAT, priority=200,ct_state=+new+trk,ip,nw_dst=10.97.0.0/16 actions=ct(commit,table=L2ForwardingCalc,zone=65521,nat(src=192.168.77.100))",
 			},
@@ -2663,7 +2783,7 @@ func Test_client_InstallMulticlusterGatewayFlows(t *testing.T) {
 
 			cacheKey := fmt.Sprintf("cluster_%s", clusterID)
 
-			assert.NoError(t, fc.InstallMulticlusterGatewayFlows(clusterID, tc.peerConfigs, tc.tunnelPeerIP, tc.localGatewayIP, true))
+			assert.NoError(t, fc.InstallMulticlusterGatewayFlows(clusterID, tc.peerCIDRs, tc.tunnelPeerIP, tc.remoteGatewayIPs, tc.remoteProbeIPPeers, tc.localGatewayIP, true))
 			fCacheI, ok := fc.featureMulticluster.cachedFlows.Load(cacheKey)
 			require.True(t, ok)
 			assert.ElementsMatch(t, tc.expectedFlows, getFlowStrings(fCacheI))
@@ -2676,27 +2796,106 @@ func Test_client_InstallMulticlusterGatewayFlows(t *testing.T) {
 }
 
 func Test_client_InstallMulticlusterClassifierFlows(t *testing.T) {
-	ctrl := gomock.NewController(t)
-	m := oftest.NewMockOFEntryOperations(ctrl)
+	tunnelOFPort := uint32(200)
+	testCases := []struct {
+		name          string
+		isGateway     bool
+		expectedFlows []string
+	}{
+		{
+			name:      "Gateway",
+			isGateway: true,
+			expectedFlows: []string{
+				"cookie=0x1060000000000, table=Classifier, priority=210,in_port=200,dl_dst=aa:bb:cc:dd:ee:f0 actions=set_field:0x1/0xf->reg0,set_field:0x200/0x200->reg0,goto_table:UnSNAT",
+				"cookie=0x1010000000000, table=L2ForwardingCalc, priority=200,dl_dst=aa:bb:cc:dd:ee:f0 actions=set_field:0xc8->reg1,set_field:0x200000/0x600000->reg0,goto_table:IngressSecurityClassifier",
+				"cookie=0x1060000000000, table=Output, priority=210,reg1=0xc8,in_port=200 actions=IN_PORT",
+			},
+		},
+		{
+			name:      "regular Node",
+			isGateway: false,
+			expectedFlows: []string{
+				"cookie=0x1010000000000, table=L2ForwardingCalc, priority=200,dl_dst=aa:bb:cc:dd:ee:f0 actions=set_field:0xc8->reg1,set_field:0x200000/0x600000->reg0,goto_table:IngressSecurityClassifier",
+				"cookie=0x1060000000000, table=ConntrackCommit, priority=210,ct_state=+new+trk-snat,ip,reg0=0x10/0xf0,dl_dst=aa:bb:cc:dd:ee:f0 actions=ct(commit,table=Output,zone=65520,exec(move:NXM_NX_REG0[0..3]->NXM_NX_CT_MARK[0..3],move:NXM_NX_TUN_IPV4_DST->NXM_NX_CT_LABEL[96..127]))",
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			m := oftest.NewMockOFEntryOperations(ctrl)
 
-	fc := newFakeClient(m, true, false, config.K8sNode, config.TrafficEncapModeEncap, enableMulticluster)
-	defer resetPipelines()
+			fc := newFakeClient(m, true, false, config.K8sNode, config.TrafficEncapModeEncap, enableMulticluster)
+			defer resetPipelines()
 
-	tunnelOFPort := uint32(200)
-	expectedFlows := []string{
-		"cookie=0x1060000000000, table=Classifier, priority=210,in_port=200,dl_dst=aa:bb:cc:dd:ee:f0 actions=set_field:0x1/0xf->reg0,set_field:0x200/0x200->reg0,goto_table:UnSNAT",
-		"cookie=0x1010000000000, table=L2ForwardingCalc, priority=200,dl_dst=aa:bb:cc:dd:ee:f0 actions=set_field:0xc8->reg1,set_field:0x200000/0x600000->reg0,goto_table:IngressSecurityClassifier",
-		"cookie=0x1060000000000, table=Output, priority=210,reg1=0xc8,in_port=200 actions=IN_PORT",
+			m.EXPECT().AddAll(gomock.Any()).Return(nil).Times(1)
+
+			cacheKey := "multicluster-classifier"
+
+			assert.NoError(t, fc.InstallMulticlusterClassifierFlows(tunnelOFPort, tc.isGateway))
+			fCacheI, ok := fc.featureMulticluster.cachedFlows.Load(cacheKey)
+			require.True(t, ok)
+			assert.ElementsMatch(t, tc.expectedFlows, getFlowStrings(fCacheI))
+		})
 	}
+}
 
-	m.EXPECT().AddAll(gomock.Any()).Return(nil).Times(1)
+func Test_client_InstallMulticlusterPodFlows(t *testing.T) {
+	podIP := net.ParseIP("10.10.1.10")
+	tunnelPeerIP := net.ParseIP("192.168.77.101")
+	globalIP := net.ParseIP("172.30.0.10")
+
+	testCases := []struct {
+		name          string
+		tunnelPeerIP  net.IP
+		globalIP      net.IP
+		expectedFlows []string
+	}{
+		{
+			name:         "Pod on another Node",
+			tunnelPeerIP: tunnelPeerIP,
+			expectedFlows: []string{
+				"cookie=0x1060000000000, table=L3Forwarding, priority=210,ip,dl_dst=aa:bb:cc:dd:ee:f0,nw_dst=10.10.1.10 actions=set_field:0a:00:00:00:00:01->eth_src,set_field:192.168.77.101->tun_dst,set_field:0x10/0xf0->reg0,goto_table:L3DecTTL",
+			},
+		},
+		{
+			name:     "Pod with global IP",
+			globalIP: globalIP,
+			expectedFlows: []string{
+				"cookie=0x1060000000000, table=EndpointDNAT, priority=210,ct_state=+new+trk,ip,nw_dst=172.30.0.10 actions=ct(commit,table=AntreaPolicyEgressRule,zone=65520,nat(dst=10.10.1.10))",
+			},
+		},
+		{
+			name:         "Pod on another Node with global IP",
+			tunnelPeerIP: tunnelPeerIP,
+			globalIP:     globalIP,
+			expectedFlows: []string{
+				"cookie=0x1060000000000, table=L3Forwarding, priority=210,ip,dl_dst=aa:bb:cc:dd:ee:f0,nw_dst=10.10.1.10 actions=set_field:0a:00:00:00:00:01->eth_src,set_field:192.168.77.101->tun_dst,set_field:0x10/0xf0->reg0,goto_table:L3DecTTL",
+				"cookie=0x1060000000000, table=EndpointDNAT, priority=210,ct_state=+new+trk,ip,nw_dst=172.30.0.10 actions=ct(commit,table=AntreaPolicyEgressRule,zone=65520,nat(dst=10.10.1.10))",
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			m := oftest.NewMockOFEntryOperations(ctrl)
 
-	cacheKey := "multicluster-classifier"
+			fc := newFakeClient(m, true, false, config.K8sNode, config.TrafficEncapModeNoEncap, enableMulticluster)
+			defer resetPipelines()
 
-	assert.NoError(t, fc.InstallMulticlusterClassifierFlows(tunnelOFPort, true))
-	fCacheI, ok := fc.featureMulticluster.cachedFlows.Load(cacheKey)
-	require.True(t, ok)
-	assert.ElementsMatch(t, expectedFlows, getFlowStrings(fCacheI))
+			m.EXPECT().AddAll(gomock.Any()).Return(nil).Times(1)
+			m.EXPECT().DeleteAll(gomock.Any()).Return(nil).Times(1)
+
+			assert.NoError(t, fc.InstallMulticlusterPodFlows(podIP, tc.tunnelPeerIP, tc.globalIP))
+			fCacheI, ok := fc.featureMulticluster.cachedPodFlows.Load(podIP.String())
+			require.True(t, ok)
+			assert.ElementsMatch(t, tc.expectedFlows, getFlowStrings(fCacheI))
+
+			assert.NoError(t, fc.UninstallMulticlusterPodFlows(podIP.String()))
+			_, ok = fc.featureMulticluster.cachedPodFlows.Load(podIP.String())
+			require.False(t, ok)
+		})
+	}
 }
 
 func Test_client_RegisterPacketInHandler(t *testing.T) {
@@ -2752,7 +2951,9 @@ func Test_client_ReplayFlows(t *testing.T) {
 	_, peerServiceCIDRIPv4, _ := net.ParseCIDR("10.97.0.0/16")
 	tunnelPeerIP := net.ParseIP("192.168.78.101")
 	localGatewayMAC, _ := net.ParseMAC("0a:00:00:00:00:01")
-	addFlowInCache(fc.featureMulticluster.cachedFlows, "multiClusterFlows", fc.featureMulticluster.l3FwdFlowToRemoteGateway(localGatewayMAC, *peerServiceCIDRIPv4, tunnelPeerIP, tunnelPeerIP, true))
+	multiclusterFlows := []binding.Flow{fc.featureMulticluster.l3FwdFlowToRemoteCIDR(localGatewayMAC, *peerServiceCIDRIPv4, tunnelPeerIP, 0)}
+	multiclusterFlows = append(multiclusterFlows, fc.featureMulticluster.l3FwdFlowsToRemoteGateway(localGatewayMAC, tunnelPeerIP, tunnelPeerIP, true)...)
+	addFlowInCache(fc.featureMulticluster.cachedFlows, "multiClusterFlows", multiclusterFlows)
 	replayedFlows = append(replayedFlows,
 		"cookie=0x1060000000000, table=L3Forwarding, priority=200,ip,nw_dst=10.97.0.0/16 actions=set_field:0a:00:00:00:00:01->eth_src,set_field:aa:bb:cc:dd:ee:f0->eth_dst,set_field:192.168.78.101->tun_dst,set_field:0x10/0xf0->reg0,goto_table:L3DecTTL",
 		"cookie=0x1060000000000, table=L3Forwarding, priority=200,ct_state=+rpl+trk,ip,nw_dst=192.168.78.101 actions=set_field:0a:00:00:00:00:01->eth_src,set_field:aa:bb:cc:dd:ee:f0->eth_dst,set_field:192.168.78.101->tun_dst,set_field:0x10/0xf0->reg0,goto_table:L3DecTTL",
@@ -16,8 +16,10 @@ package openflow
 
 import (
 	"net"
+	"sync"
 
 	"antrea.io/libOpenflow/openflow15"
+	"antrea.io/libOpenflow/protocol"
 
 	"antrea.io/antrea/pkg/agent/openflow/cookie"
 	binding "antrea.io/antrea/pkg/ovs/openflow"
@@ -34,6 +36,9 @@ type featureExternalNodeConnectivity struct {
 	category        cookie.Category
 
 	uplinkFlowCache *flowCategoryCache
+	// uplinkPorts maps the name of a host interface to the OFPort of its uplink, and is used to
+	// install the Traceflow flows for the packets leaving the ExternalNode.
+	uplinkPorts sync.Map
 }
 
 func (f *featureExternalNodeConnectivity) getFeatureName() string {
@@ -204,13 +209,129 @@ func (f *featureExternalNodeConnectivity) addPolicyBypassFlows(flow binding.Flow
 	return nil
 }
 
+// flowsToTrace generates Traceflow specific flows for featureExternalNodeConnectivity. Only live-traffic Traceflow is
+// supported on ExternalNode. When packet is provided, a flow is added in ConntrackStateTable to mark the first packet
+// of the first connection that matches the provided packet as the Traceflow packet. If receiverOnly is false, the flow
+// matches in_port to be the provided ofPort (the paired host internal port of the sender ExternalEntity); otherwise it
+// matches the destination IP (the IP of the receiver ExternalEntity). The Traceflow packets output to an uplink keep
+// the DSCP bits so that they can be traced on the receiver Node, while the DSCP bits are cleared before the packets
+// are delivered to a host interface.
+func (f *featureExternalNodeConnectivity) flowsToTrace(dataplaneTag uint8,
+	ovsMetersAreSupported,
+	liveTraffic,
+	droppedOnly,
+	receiverOnly bool,
+	packet *binding.Packet,
+	ofPort uint32,
+	timeout uint16) []binding.Flow {
+	if !liveTraffic {
+		return nil
+	}
+	cookieID := f.cookieAllocator.Request(cookie.Traceflow).Raw()
+	var flows []binding.Flow
+	if packet != nil {
+		flowBuilder := ConntrackStateTable.ofTable.BuildFlow(priorityLow).
+			Cookie(cookieID).
+			MatchCTStateNew(true).
+			MatchCTStateTrk(true).
+			Action().LoadIPDSCP(dataplaneTag).
+			SetHardTimeout(timeout).
+			Action().NextTable()
+		if !receiverOnly {
+			flowBuilder = flowBuilder.MatchInPort(ofPort)
+		}
+		if packet.DestinationIP != nil {
+			flowBuilder = flowBuilder.MatchDstIP(packet.DestinationIP)
+		}
+		if packet.SourceIP != nil {
+			flowBuilder = flowBuilder.MatchSrcIP(packet.SourceIP)
+		}
+		switch packet.IPProto {
+		case protocol.Type_ICMP:
+			flowBuilder = flowBuilder.MatchProtocol(binding.ProtocolICMP)
+		case protocol.Type_IPv6ICMP:
+			flowBuilder = flowBuilder.MatchProtocol(binding.ProtocolICMPv6)
+		case protocol.Type_TCP:
+			if packet.IsIPv6 {
+				flowBuilder = flowBuilder.MatchProtocol(binding.ProtocolTCPv6)
+			} else {
+				flowBuilder = flowBuilder.MatchProtocol(binding.ProtocolTCP)
+			}
+		case protocol.Type_UDP:
+			if packet.IsIPv6 {
+				flowBuilder = flowBuilder.MatchProtocol(binding.ProtocolUDPv6)
+			} else {
+				flowBuilder = flowBuilder.MatchProtocol(binding.ProtocolUDP)
+			}
+		default:
+			flowBuilder = flowBuilder.MatchIPProtocolValue(packet.IsIPv6, packet.IPProto)
+		}
+		if packet.IPProto == protocol.Type_TCP || packet.IPProto == protocol.Type_UDP {
+			if packet.DestinationPort != 0 {
+				flowBuilder = flowBuilder.MatchDstPort(packet.DestinationPort, nil)
+			}
+			if packet.SourcePort != 0 {
+				flowBuilder = flowBuilder.MatchSrcPort(packet.SourcePort, nil)
+			}
+		}
+		flows = append(flows, flowBuilder.Done())
+	}
+
+	// Do not send to controller if captures only dropped packet.
+	ifDroppedOnly := func(fb binding.FlowBuilder) binding.FlowBuilder {
+		if !droppedOnly {
+			if ovsMetersAreSupported {
+				fb = fb.Action().Meter(PacketInMeterIDTF)
+			}
+			fb = fb.Action().SendToController([]byte{uint8(PacketInCategoryTF)}, false)
+		}
+		return fb
+	}
+	for _, ipProtocol := range f.ipProtocols {
+		// SendToController and Output if output port is an uplink.
+		f.uplinkPorts.Range(func(_, value interface{}) bool {
+			fb := OutputTable.ofTable.BuildFlow(priorityNormal+3).
+				Cookie(cookieID).
+				MatchRegFieldWithValue(TargetOFPortField, value.(uint32)).
+				MatchProtocol(ipProtocol).
+				MatchRegMark(OutputToOFPortRegMark).
+				MatchIPDSCP(dataplaneTag).
+				SetHardTimeout(timeout).
+				Action().OutputToRegField(TargetOFPortField)
+			fb = ifDroppedOnly(fb)
+			flows = append(flows, fb.Done())
+			return true
+		})
+		// SendToController and Output after clearing the DSCP bits if output port is a host interface.
+		fb := OutputTable.ofTable.BuildFlow(priorityNormal + 2).
+			Cookie(cookieID).
+			MatchProtocol(ipProtocol).
+			MatchRegMark(OutputToOFPortRegMark).
+			MatchIPDSCP(dataplaneTag).
+			SetHardTimeout(timeout)
+		fb = ifDroppedOnly(fb)
+		fb = fb.Action().LoadIPDSCP(0).
+			Action().OutputToRegField(TargetOFPortField)
+		flows = append(flows, fb.Done())
+	}
+	return flows
+}
+
 func (c *client) InstallVMUplinkFlows(hostIFName string, hostPort int32, uplinkPort int32) error {
 	flows := c.featureExternalNodeConnectivity.vmUplinkFlows(uint32(hostPort), uint32(uplinkPort))
-	return c.addFlows(c.featureExternalNodeConnectivity.uplinkFlowCache, hostIFName, flows)
+	if err := c.addFlows(c.featureExternalNodeConnectivity.uplinkFlowCache, hostIFName, flows); err != nil {
+		return err
+	}
+	c.featureExternalNodeConnectivity.uplinkPorts.Store(hostIFName, uint32(uplinkPort))
+	return nil
 }
 
 func (c *client) UninstallVMUplinkFlows(hostIFName string) error {
-	return c.deleteFlows(c.featureExternalNodeConnectivity.uplinkFlowCache, hostIFName)
+	if err := c.deleteFlows(c.featureExternalNodeConnectivity.uplinkFlowCache, hostIFName); err != nil {
+		return err
+	}
+	c.featureExternalNodeConnectivity.uplinkPorts.Delete(hostIFName)
+	return nil
 }
 
 func (c *client) InstallPolicyBypassFlows(protocol binding.Protocol, ipNet *net.IPNet, port uint16, isIngress bool) error {
@@ -226,4 +226,8 @@ var (
 
 	// Field to store the VLAN ID allocated for a L7 NetworkPolicy rule.
 	L7NPRuleVlanIDCTLabel = binding.NewCTLabel(64, 75)
+
+	// Field to store the IPv4 address of the local Multi-cluster Gateway selected for a cross-cluster connection on
+	// a regular Node.
+	MulticlusterGatewayCTLabel = binding.NewCTLabel(96, 127)
 )
@@ -228,6 +228,12 @@ func (f *featureNetworkPolicy) getRequiredTables() []*Table {
 		if f.enableL7NetworkPolicy {
 			tables = append(tables, TrafficControlTable) // For L7 NetworkPolicy.
 		}
+		if f.enableAuditMode {
+			tables = append(tables,
+				AntreaPolicyEgressAuditRuleTable,
+				AntreaPolicyIngressAuditRuleTable,
+			)
+		}
 		if f.enableMulticast {
 			tables = append(tables,
 				MulticastEgressRuleTable,
@@ -297,7 +303,10 @@ func (f *featureMulticluster) getRequiredTables() []*Table {
 	return []*Table{
 		ClassifierTable,
 		ConntrackTable,
+		EndpointDNATTable,
 		L3ForwardingTable,
+		L3DecTTLTable,
+		ConntrackCommitTable,
 		SNATTable,
 		UnSNATTable,
 		SNATMarkTable,
@@ -325,6 +334,7 @@ func (f *featureExternalNodeConnectivity) getRequiredTables() []*Table {
 // - featurePodConnectivity.
 // - featureNetworkPolicy.
 // - featureService.
+// - featureExternalNodeConnectivity.
 type traceableFeature interface {
 	// flowsToTrace returns the flows to be installed when a packet tracing request is created.
 	flowsToTrace(dataplaneTag uint8,
@@ -69,6 +69,7 @@ func newTestFeatureNetworkPolicy(nodeType config.NodeType, options ...clientOpti
 		enableMulticast:       o.enableMulticast,
 		enableAntreaPolicy:    o.enableAntreaPolicy,
 		enableL7NetworkPolicy: o.enableL7NetworkPolicy,
+		enableAuditMode:       o.enableAuditMode,
 	}
 }
 
@@ -284,6 +285,50 @@ func TestBuildPipeline(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:    "K8s Node, IPv4 only, with Antrea-native policy audit mode enabled",
+			ipStack: ipv4Only,
+			features: []feature{
+				newTestFeaturePodConnectivity(ipStackMap[ipv4Only]),
+				newTestFeatureNetworkPolicy(config.K8sNode, enableAuditMode),
+				newTestFeatureService(),
+				newTestFeatureEgress(),
+			},
+			expectedTables: map[binding.PipelineID][]*Table{
+				pipelineRoot: {
+					PipelineRootClassifierTable,
+				},
+				pipelineIP: {
+					ClassifierTable,
+					SpoofGuardTable,
+					UnSNATTable,
+					ConntrackTable,
+					ConntrackStateTable,
+					PreRoutingClassifierTable,
+					SessionAffinityTable,
+					ServiceLBTable,
+					EndpointDNATTable,
+					AntreaPolicyEgressAuditRuleTable,
+					AntreaPolicyEgressRuleTable,
+					EgressRuleTable,
+					EgressDefaultTable,
+					EgressMetricTable,
+					L3ForwardingTable,
+					EgressMarkTable,
+					L3DecTTLTable,
+					SNATMarkTable,
+					SNATTable,
+					L2ForwardingCalcTable,
+					AntreaPolicyIngressAuditRuleTable,
+					AntreaPolicyIngressRuleTable,
+					IngressRuleTable,
+					IngressDefaultTable,
+					IngressMetricTable,
+					ConntrackCommitTable,
+					OutputTable,
+				},
+			},
+		},
 		{
 			name:    "K8s Node, IPv4 only, with L7NetworkPolicy enabled",
 			ipStack: ipv6Only,
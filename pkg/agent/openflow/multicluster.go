@@ -15,7 +15,9 @@
 package openflow
 
 import (
+	"encoding/binary"
 	"net"
+	"sync"
 
 	"antrea.io/libOpenflow/openflow15"
 
@@ -40,13 +42,30 @@ type featureMulticluster struct {
 	ipProtocols     []binding.Protocol
 	dnatCtZones     map[binding.Protocol]int
 	snatCtZones     map[binding.Protocol]int
+	bridge          binding.Bridge
+	groupAllocator  GroupAllocator
+	groupCache      sync.Map
+	// gatewayGroupID is the ID of the select group used on a regular Node to hash
+	// cross-cluster connections across multiple local Gateways. It is allocated
+	// when there is more than one active Gateway for the first time.
+	gatewayGroupID binding.GroupIDType
+	// gatewaySlots saves the local Gateway assigned to each bucket of the select group.
+	gatewaySlots []net.IP
 }
 
+// multiclusterGatewaySlots is the number of buckets in the select group which hashes
+// cross-cluster connections across the local Gateways. The number of buckets never
+// changes with the number of Gateways, so a connection is always hashed to the same
+// bucket. Established connections are kept on their Gateways with the flows generated
+// by l3FwdFlowToSelectedGateway, so only the connections through a removed Gateway are
+// affected when the Gateways change.
+const multiclusterGatewaySlots = 64
+
 func (f *featureMulticluster) getFeatureName() string {
 	return "Multicluster"
 }
 
-func newFeatureMulticluster(cookieAllocator cookie.Allocator, ipProtocols []binding.Protocol) *featureMulticluster {
+func newFeatureMulticluster(cookieAllocator cookie.Allocator, ipProtocols []binding.Protocol, bridge binding.Bridge, groupAllocator GroupAllocator) *featureMulticluster {
 	snatCtZones := make(map[binding.Protocol]int)
 	dnatCtZones := make(map[binding.Protocol]int)
 	snatCtZones[ipProtocols[0]] = SNATCtZone
@@ -59,6 +78,8 @@ func newFeatureMulticluster(cookieAllocator cookie.Allocator, ipProtocols []bind
 		ipProtocols:     ipProtocols,
 		snatCtZones:     snatCtZones,
 		dnatCtZones:     dnatCtZones,
+		bridge:          bridge,
+		groupAllocator:  groupAllocator,
 	}
 }
 
@@ -75,35 +96,62 @@ func (f *featureMulticluster) initGroups() []binding.OFEntry {
 }
 
 func (f *featureMulticluster) replayGroups() []binding.OFEntry {
-	return nil
+	var groups []binding.OFEntry
+	f.groupCache.Range(func(id, value interface{}) bool {
+		group := value.(binding.Group)
+		group.Reset()
+		groups = append(groups, group)
+		return true
+	})
+	return groups
 }
 
 func (f *featureMulticluster) replayMeters() []binding.OFEntry {
 	return nil
 }
 
-func (f *featureMulticluster) l3FwdFlowToRemoteGateway(
+// l3FwdFlowToRemoteCIDR generates the flow to forward cross-cluster request packets
+// based on the peer CIDR. The packets are sent to tunnelPeer, or hashed across the
+// local Gateways with the select group when groupID is not zero.
+func (f *featureMulticluster) l3FwdFlowToRemoteCIDR(
 	localGatewayMAC net.HardwareAddr,
-	peerServiceCIDR net.IPNet,
+	peerCIDR net.IPNet,
 	tunnelPeer net.IP,
+	groupID binding.GroupIDType) binding.Flow {
+	ipProtocol := getIPProtocol(peerCIDR.IP)
+	flowBuilder := L3ForwardingTable.ofTable.BuildFlow(priorityNormal).
+		Cookie(f.cookieAllocator.Request(f.category).Raw()).
+		MatchProtocol(ipProtocol).
+		MatchDstIPNet(peerCIDR).
+		Action().SetSrcMAC(localGatewayMAC).                // Rewrite src MAC to local gateway MAC.
+		Action().SetDstMAC(GlobalVirtualMACForMulticluster) // Rewrite dst MAC to virtual MC MAC.
+	if groupID != 0 {
+		// Select a local Gateway as the tunnel destination in the group.
+		return flowBuilder.Action().LoadRegMark(ToTunnelRegMark).
+			Action().Group(groupID).
+			Done()
+	}
+	// Flow based tunnel. Set tunnel destination.
+	return flowBuilder.Action().SetTunnelDst(tunnelPeer).
+		Action().LoadRegMark(ToTunnelRegMark).
+		Action().GotoTable(L3DecTTLTable.GetID()).
+		Done()
+}
+
+// l3FwdFlowsToRemoteGateway generates the flows to forward cross-cluster reply
+// packets based on the remote Gateway IP, which is the source IP of the requests
+// after SNAT on the remote Gateway. The packets are always sent to the same
+// tunnelPeer, so that they go back through the Gateways which hold the conntrack
+// states of the connections.
+func (f *featureMulticluster) l3FwdFlowsToRemoteGateway(
+	localGatewayMAC net.HardwareAddr,
 	remoteGatewayIP net.IP,
+	tunnelPeer net.IP,
 	enableStretchedNetworkPolicy bool) []binding.Flow {
-	ipProtocol := getIPProtocol(peerServiceCIDR.IP)
+	ipProtocol := getIPProtocol(remoteGatewayIP)
 	cookieID := f.cookieAllocator.Request(f.category).Raw()
 	var flows []binding.Flow
 	flows = append(flows,
-		// This generates the flow to forward cross-cluster request packets based
-		// on Service ClusterIP range.
-		L3ForwardingTable.ofTable.BuildFlow(priorityNormal).
-			Cookie(cookieID).
-			MatchProtocol(ipProtocol).
-			MatchDstIPNet(peerServiceCIDR).
-			Action().SetSrcMAC(localGatewayMAC).                 // Rewrite src MAC to local gateway MAC.
-			Action().SetDstMAC(GlobalVirtualMACForMulticluster). // Rewrite dst MAC to virtual MC MAC.
-			Action().SetTunnelDst(tunnelPeer).                   // Flow based tunnel. Set tunnel destination.
-			Action().LoadRegMark(ToTunnelRegMark).
-			Action().GotoTable(L3DecTTLTable.GetID()).
-			Done(),
 		// This generates the flow to forward cross-cluster reply traffic based
 		// on Gateway IP.
 		L3ForwardingTable.ofTable.BuildFlow(priorityNormal).
@@ -139,6 +187,143 @@ func (f *featureMulticluster) l3FwdFlowToRemoteGateway(
 	return flows
 }
 
+// l3FwdFlowToRemoteProbeIP generates the flow to forward the probes sent by the local
+// Gateway Node to the probe IP of a remote Gateway, i.e. the IP of its antrea-gw0
+// interface, through the cross-cluster tunnel to tunnelPeer, so that the probes and
+// their replies test the tunnel between the Gateways.
+func (f *featureMulticluster) l3FwdFlowToRemoteProbeIP(
+	localGatewayMAC net.HardwareAddr,
+	remoteProbeIP net.IP,
+	tunnelPeer net.IP) binding.Flow {
+	ipProtocol := getIPProtocol(remoteProbeIP)
+	return L3ForwardingTable.ofTable.BuildFlow(priorityHigh).
+		Cookie(f.cookieAllocator.Request(f.category).Raw()).
+		MatchProtocol(ipProtocol).
+		MatchDstIP(remoteProbeIP).
+		Action().SetSrcMAC(localGatewayMAC).
+		Action().SetDstMAC(GlobalVirtualMACForMulticluster).
+		Action().SetTunnelDst(tunnelPeer).
+		Action().LoadRegMark(ToTunnelRegMark).
+		Action().GotoTable(L3DecTTLTable.GetID()).
+		Done()
+}
+
+// l3FwdFlowToSelectedGateway generates the flow to forward the packets of an established
+// cross-cluster connection to the local Gateway selected by its first packet, which is
+// stored in MulticlusterGatewayCTLabel. The flow has a higher priority than the flow
+// generated by l3FwdFlowToRemoteCIDR, so the connections are not moved to another
+// Gateway when the slots of the select group change.
+func (f *featureMulticluster) l3FwdFlowToSelectedGateway(
+	localGatewayMAC net.HardwareAddr,
+	peerCIDR net.IPNet,
+	tunnelPeer net.IP) binding.Flow {
+	ipProtocol := getIPProtocol(peerCIDR.IP)
+	tunnelPeerValue := uint64(binary.BigEndian.Uint32(tunnelPeer.To4()))
+	return L3ForwardingTable.ofTable.BuildFlow(priorityHigh).
+		Cookie(f.cookieAllocator.Request(f.category).Raw()).
+		MatchProtocol(ipProtocol).
+		MatchCTStateNew(false).
+		MatchCTStateRpl(false).
+		MatchCTStateTrk(true).
+		MatchDstIPNet(peerCIDR).
+		MatchCTLabelField(tunnelPeerValue<<(MulticlusterGatewayCTLabel.GetRange()[0]-64), 0, MulticlusterGatewayCTLabel).
+		Action().SetSrcMAC(localGatewayMAC).
+		Action().SetDstMAC(GlobalVirtualMACForMulticluster).
+		Action().SetTunnelDst(tunnelPeer).
+		Action().LoadRegMark(ToTunnelRegMark).
+		Action().GotoTable(L3DecTTLTable.GetID()).
+		Done()
+}
+
+// gatewayCommitFlows generates the flows to commit the first packets of cross-cluster
+// connections on a regular Node, with the IPv4 address of the selected local Gateway,
+// which is the tunnel destination of the packets, stored in MulticlusterGatewayCTLabel.
+// The flows also copy PktSourceField to ConnSourceCTMarkField like the default commit
+// flows in ConntrackCommitTable.
+func (f *featureMulticluster) gatewayCommitFlows() []binding.Flow {
+	cookieID := f.cookieAllocator.Request(f.category).Raw()
+	var flows []binding.Flow
+	for _, ipProtocol := range f.ipProtocols {
+		if ipProtocol != binding.ProtocolIP {
+			continue
+		}
+		flows = append(flows, ConntrackCommitTable.ofTable.BuildFlow(priorityHigh).
+			Cookie(cookieID).
+			MatchProtocol(ipProtocol).
+			MatchCTStateNew(true).
+			MatchCTStateTrk(true).
+			MatchCTStateSNAT(false).
+			MatchRegMark(ToTunnelRegMark).
+			MatchDstMAC(GlobalVirtualMACForMulticluster).
+			Action().CT(true, ConntrackCommitTable.GetNext(), f.dnatCtZones[ipProtocol], nil).
+			MoveToCtMarkField(PktSourceField, ConnSourceCTMarkField).
+			MoveToLabel(binding.NxmFieldTunIPv4Dst, &binding.Range{0, 31}, MulticlusterGatewayCTLabel.GetRange()).
+			CTDone().
+			Done())
+	}
+	return flows
+}
+
+// localGatewaysGroup generates the select group to hash cross-cluster connections
+// across the local Gateways on a regular Node, with one bucket for each slot.
+func (f *featureMulticluster) localGatewaysGroup(groupID binding.GroupIDType, gatewaySlots []net.IP) binding.Group {
+	group := f.bridge.NewGroup(groupID)
+	for _, tunnelPeer := range gatewaySlots {
+		group = group.Bucket().Weight(100).
+			SetTunnelDst(tunnelPeer).
+			ResubmitToTable(L3DecTTLTable.GetID()).
+			Done()
+	}
+	return group
+}
+
+// assignGatewaySlots assigns each of the multiclusterGatewaySlots slots to one of the
+// given Gateways, with the numbers of slots of any two Gateways differing by at most
+// one. A slot keeps its current Gateway unless the Gateway is removed or has more slots
+// than its share, so losing a Gateway only moves the slots of that Gateway, and adding
+// a Gateway only moves the slots it takes over.
+func assignGatewaySlots(currentSlots []net.IP, gateways []net.IP) []net.IP {
+	slots := make([]net.IP, multiclusterGatewaySlots)
+	if len(gateways) == 0 {
+		return slots
+	}
+	// Every Gateway gets minSlots slots, and extraSlots Gateways get one more slot.
+	minSlots := multiclusterGatewaySlots / len(gateways)
+	extraSlots := multiclusterGatewaySlots % len(gateways)
+	slotCounts := make(map[string]int, len(gateways))
+	for _, gateway := range gateways {
+		slotCounts[gateway.String()] = 0
+	}
+	for i, gateway := range currentSlots {
+		if i >= multiclusterGatewaySlots || gateway == nil {
+			break
+		}
+		count, ok := slotCounts[gateway.String()]
+		if !ok || count > minSlots || (count == minSlots && extraSlots == 0) {
+			continue
+		}
+		if count == minSlots {
+			extraSlots--
+		}
+		slots[i] = gateway
+		slotCounts[gateway.String()] = count + 1
+	}
+	for i := range slots {
+		if slots[i] != nil {
+			continue
+		}
+		leastUsed := gateways[0]
+		for _, gateway := range gateways[1:] {
+			if slotCounts[gateway.String()] < slotCounts[leastUsed.String()] {
+				leastUsed = gateway
+			}
+		}
+		slots[i] = leastUsed
+		slotCounts[leastUsed.String()]++
+	}
+	return slots
+}
+
 func (f *featureMulticluster) tunnelClassifierFlow(tunnelOFPort uint32) binding.Flow {
 	return ClassifierTable.ofTable.BuildFlow(priorityHigh).
 		Cookie(f.cookieAllocator.Request(f.category).Raw()).
@@ -219,3 +404,21 @@ func (f *featureMulticluster) l3FwdFlowToPodViaTun(
 		Action().GotoTable(L3DecTTLTable.GetID()).
 		Done()
 }
+
+// podGlobalIPDNATFlow generates the flow on a Multi-cluster Gateway to translate the
+// global IP of a Pod to the Pod IP for cross-cluster connections. The connections are
+// committed in DNAT CT zone, so that the reply packets are translated back by the
+// conntrack flows, and Traceflow reports the translated destination IP.
+func (f *featureMulticluster) podGlobalIPDNATFlow(globalIP net.IP, podIP net.IP) binding.Flow {
+	ipProtocol := getIPProtocol(podIP)
+	return EndpointDNATTable.ofTable.BuildFlow(priorityHigh).
+		Cookie(f.cookieAllocator.Request(f.category).Raw()).
+		MatchProtocol(ipProtocol).
+		MatchDstIP(globalIP).
+		MatchCTStateNew(true).
+		MatchCTStateTrk(true).
+		Action().CT(true, EndpointDNATTable.GetNext(), f.dnatCtZones[ipProtocol], nil).
+		DNAT(&binding.IPRange{StartIP: podIP, EndIP: podIP}, nil).
+		CTDone().
+		Done()
+}
@@ -22,6 +22,7 @@ import (
 	"sync"
 
 	"antrea.io/libOpenflow/openflow15"
+	"antrea.io/ofnet/ofctrl"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/klog/v2"
@@ -84,6 +85,8 @@ var (
 	// There could be other flows like default flow and Traceflow flows in the table. Only metric flows are supposed to
 	// have normal priority.
 	metricFlowIdentifier = fmt.Sprintf("priority=%d,", priorityNormal)
+	// auditFlowIdentifier is used to identify the action flows of the rules in the audit tables.
+	auditFlowIdentifier = "conj_id="
 
 	protocolTCP = v1beta2.ProtocolTCP
 	dnsPort     = int32(53)
@@ -674,6 +677,79 @@ type policyRuleConjunction struct {
 	ruleName     string
 	ruleTableID  uint8
 	ruleLogLabel string
+	// rateLimitMeters are the meters enforcing the rate limit of the rule, they're only set for rules with the
+	// RateLimit action.
+	rateLimitMeters *ruleRateLimitMeters
+}
+
+// ruleRateLimitMeters are the OpenFlow meters which drop the packets exceeding the rate limit of a rule with the
+// RateLimit action. The rate limit applies to each source separately: the sources are distributed among
+// rateLimitBuckets meters by the lowest bits of their IP addresses, and the metric flows of the rule reference the meter
+// of the bucket of the packet source. Hence a source exceeding the rate limit only affects the sources sharing its
+// bucket.
+type ruleRateLimitMeters struct {
+	// ids and meters are indexed by bucket.
+	ids    []binding.MeterIDType
+	meters []binding.Meter
+	// newConnectionsOnly indicates whether only the first packets of the connections are metered, i.e. the rate is
+	// of new connections instead of packets.
+	newConnectionsOnly bool
+}
+
+const (
+	// The IDs of the meters of rules with the RateLimit action are allocated from this range, which doesn't overlap
+	// with the meters used for Egress QoS and packet-in rate limiting.
+	rateLimitMeterIDMin binding.MeterIDType = 1024
+	rateLimitMeterIDMax binding.MeterIDType = 65535
+	// rateLimitBuckets is the number of meters of each rule with the RateLimit action. It must be a power of 2, as
+	// the bucket of a source is given by the lowest bits of its IP address. antrea-controller rejects the policies
+	// exceeding the number of rules for which meters can be allocated, which must be kept in sync with it.
+	rateLimitBuckets = 64
+)
+
+// rateLimitBucketIPNet returns the masked IP address matching the sources of the given bucket.
+func rateLimitBucketIPNet(bucket int, isIPv6 bool) net.IPNet {
+	size := net.IPv4len
+	if isIPv6 {
+		size = net.IPv6len
+	}
+	ipNet := net.IPNet{IP: make(net.IP, size), Mask: make(net.IPMask, size)}
+	ipNet.IP[size-1] = byte(bucket)
+	ipNet.Mask[size-1] = rateLimitBuckets - 1
+	return ipNet
+}
+
+// meterIDAllocator allocates the IDs of the meters of rules with the RateLimit action. Its zero value is ready to use.
+type meterIDAllocator struct {
+	mutex     sync.Mutex
+	allocated uint32
+	released  []binding.MeterIDType
+}
+
+func (a *meterIDAllocator) allocate() (binding.MeterIDType, error) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	if len(a.released) > 0 {
+		id := a.released[len(a.released)-1]
+		a.released = a.released[:len(a.released)-1]
+		return id, nil
+	}
+	id := rateLimitMeterIDMin + binding.MeterIDType(a.allocated)
+	if id > rateLimitMeterIDMax {
+		return 0, fmt.Errorf("no meter ID available for rate limiting, the maximum number of rate-limited rules is %d", (rateLimitMeterIDMax-rateLimitMeterIDMin+1)/rateLimitBuckets)
+	}
+	a.allocated++
+	return id, nil
+}
+
+func (a *meterIDAllocator) release(id binding.MeterIDType) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	a.released = append(a.released, id)
+}
+
+func isRuleRateLimitMeterID(id int) bool {
+	return id >= int(rateLimitMeterIDMin) && id <= int(rateLimitMeterIDMax)
 }
 
 // clause groups conjunctive match flows. Matches in a clause represent source addresses(for fromClause), or destination
@@ -1161,17 +1237,25 @@ func (c *client) InstallPolicyRuleFlows(rule *types.PolicyRule) error {
 	c.replayMutex.RLock()
 	defer c.replayMutex.RUnlock()
 
-	conj := c.featureNetworkPolicy.calculateActionFlowChangesForRule(rule)
+	conj, err := c.featureNetworkPolicy.calculateActionFlowChangesForRule(rule)
+	if err != nil {
+		return err
+	}
 
 	c.featureNetworkPolicy.conjMatchFlowLock.Lock()
 	defer c.featureNetworkPolicy.conjMatchFlowLock.Unlock()
 	ctxChanges := c.featureNetworkPolicy.calculateMatchFlowChangesForRule(conj, rule)
 
+	// The meters must be installed before the flows referencing them.
+	if err := c.featureNetworkPolicy.installRateLimitMeters(conj); err != nil {
+		return err
+	}
 	var flowMessages []*openflow15.FlowMod
 	for _, fm := range append(conj.metricFlows, conj.actionFlows...) {
 		flowMessages = append(flowMessages, fm)
 	}
 	if err := c.ofEntryOperations.AddAll(flowMessages); err != nil {
+		c.featureNetworkPolicy.uninstallRateLimitMeters(conj)
 		return err
 	}
 	if err := c.featureNetworkPolicy.applyConjunctiveMatchFlows(ctxChanges); err != nil {
@@ -1183,13 +1267,14 @@ func (c *client) InstallPolicyRuleFlows(rule *types.PolicyRule) error {
 }
 
 // calculateActionFlowChangesForRule calculates and updates the actionFlows for the conjunction corresponded to the ofPolicyRule.
-func (f *featureNetworkPolicy) calculateActionFlowChangesForRule(rule *types.PolicyRule) *policyRuleConjunction {
+// For rules with the RateLimit action, it also allocates the meter enforcing the rate limit, which is not installed yet.
+func (f *featureNetworkPolicy) calculateActionFlowChangesForRule(rule *types.PolicyRule) (*policyRuleConjunction, error) {
 	ruleOfID := rule.FlowID
 	// Check if the policyRuleConjunction is added into cache or not. If yes, return nil.
 	conj := f.getPolicyRuleConjunction(ruleOfID)
 	if conj != nil {
 		klog.V(2).Infof("PolicyRuleConjunction %d is already added in cache", ruleOfID)
-		return nil
+		return nil, nil
 	}
 	conj = &policyRuleConjunction{
 		id:           ruleOfID,
@@ -1209,7 +1294,11 @@ func (f *featureNetworkPolicy) calculateActionFlowChangesForRule(rule *types.Pol
 		// Install action flows.
 		var actionFlows []binding.Flow
 		var metricFlows []binding.Flow
-		if rule.IsAntreaNetworkPolicyRule() && *rule.Action == crdv1beta1.RuleActionDrop {
+		if isAntreaPolicyAuditTable(rule.TableID) {
+			// Traffic matching rules of policies in Audit mode is only logged and counted by the action flows, and
+			// it is then resubmitted to the table enforcing the policies.
+			actionFlows = append(actionFlows, f.conjunctionActionAuditFlow(ruleOfID, ruleTable, rule.Priority, getRuleDisposition(rule)))
+		} else if rule.IsAntreaNetworkPolicyRule() && *rule.Action == crdv1beta1.RuleActionDrop {
 			metricFlows = append(metricFlows, f.denyRuleMetricFlow(ruleOfID, isIngress, rule.TableID))
 			actionFlows = append(actionFlows, f.conjunctionActionDenyFlow(ruleOfID, ruleTable, rule.Priority, DispositionDrop, rule.EnableLogging))
 		} else if rule.IsAntreaNetworkPolicyRule() && *rule.Action == crdv1beta1.RuleActionReject {
@@ -1218,13 +1307,120 @@ func (f *featureNetworkPolicy) calculateActionFlowChangesForRule(rule *types.Pol
 		} else if rule.IsAntreaNetworkPolicyRule() && *rule.Action == crdv1beta1.RuleActionPass {
 			actionFlows = append(actionFlows, f.conjunctionActionPassFlow(ruleOfID, ruleTable, rule.Priority, rule.EnableLogging))
 		} else {
-			metricFlows = append(metricFlows, f.allowRulesMetricFlows(ruleOfID, isIngress, rule.TableID)...)
+			// Traffic matching rules with the RateLimit action is allowed like for Allow rules, while the meters
+			// referenced by the metric flows drop the packets exceeding the rate limit.
+			if rule.IsAntreaNetworkPolicyRule() && *rule.Action == crdv1beta1.RuleActionRateLimit {
+				rateLimitMeters, err := f.newRuleRateLimitMeters(rule.RateLimit)
+				if err != nil {
+					return nil, err
+				}
+				conj.rateLimitMeters = rateLimitMeters
+			}
+			metricFlows = append(metricFlows, f.allowRulesMetricFlows(ruleOfID, isIngress, rule.TableID, conj.rateLimitMeters)...)
 			actionFlows = append(actionFlows, f.conjunctionActionFlow(ruleOfID, ruleTable, dropTable.GetNext(), rule.Priority, rule.EnableLogging, rule.L7RuleVlanID)...)
 		}
 		conj.actionFlows = GetFlowModMessages(actionFlows, binding.AddMessage)
 		conj.metricFlows = GetFlowModMessages(metricFlows, binding.AddMessage)
 	}
-	return conj
+	return conj, nil
+}
+
+// getRuleDisposition returns the disposition which would be applied to the traffic matching the rule if it was enforced.
+func getRuleDisposition(rule *types.PolicyRule) uint32 {
+	if rule.Action == nil {
+		return DispositionAllow
+	}
+	switch *rule.Action {
+	case crdv1beta1.RuleActionDrop:
+		return DispositionDrop
+	case crdv1beta1.RuleActionReject:
+		return DispositionRej
+	case crdv1beta1.RuleActionPass:
+		return DispositionPass
+	default:
+		return DispositionAllow
+	}
+}
+
+// newRuleRateLimitMeters allocates the IDs and generates the meters enforcing the provided rate limit, one for each
+// bucket of sources.
+func (f *featureNetworkPolicy) newRuleRateLimitMeters(rateLimit *v1beta2.RuleRateLimit) (*ruleRateLimitMeters, error) {
+	if !f.ovsMetersAreSupported {
+		return nil, fmt.Errorf("action RateLimit requires OVS meters, which are not supported by the datapath")
+	}
+	if rateLimit == nil {
+		return nil, fmt.Errorf("rate limit of rule with action RateLimit is not set")
+	}
+	rate := rateLimit.PacketsPerSecond
+	newConnectionsOnly := false
+	if rateLimit.NewConnectionsPerSecond > 0 {
+		rate = rateLimit.NewConnectionsPerSecond
+		newConnectionsOnly = true
+	}
+	if rate <= 0 {
+		return nil, fmt.Errorf("invalid rate limit %d", rate)
+	}
+	flags := ofctrl.MeterPktps
+	if rateLimit.Burst > 0 {
+		flags |= ofctrl.MeterBurst
+	}
+	rateLimitMeters := &ruleRateLimitMeters{newConnectionsOnly: newConnectionsOnly}
+	for bucket := 0; bucket < rateLimitBuckets; bucket++ {
+		id, err := f.meterIDAllocator.allocate()
+		if err != nil {
+			for _, allocated := range rateLimitMeters.ids {
+				f.meterIDAllocator.release(allocated)
+			}
+			return nil, err
+		}
+		meter := f.bridge.NewMeter(id, flags).
+			MeterBand().
+			MeterType(ofctrl.MeterDrop).
+			Rate(uint32(rate)).
+			Burst(uint32(rateLimit.Burst)).
+			Done()
+		rateLimitMeters.ids = append(rateLimitMeters.ids, id)
+		rateLimitMeters.meters = append(rateLimitMeters.meters, meter)
+	}
+	return rateLimitMeters, nil
+}
+
+// installRateLimitMeters installs the meters of the policyRuleConjunction if it has some. If the installation fails,
+// the installed meters are deleted and all the meter IDs are released.
+func (f *featureNetworkPolicy) installRateLimitMeters(conj *policyRuleConjunction) error {
+	if conj == nil || conj.rateLimitMeters == nil {
+		return nil
+	}
+	// Openflow bundle message doesn't support meter, the meters are added individually.
+	for i, meter := range conj.rateLimitMeters.meters {
+		if err := meter.Add(); err != nil {
+			for j := 0; j < i; j++ {
+				if err := conj.rateLimitMeters.meters[j].Delete(); err != nil {
+					klog.ErrorS(err, "Error when deleting rate limit OF Meter", "meterID", conj.rateLimitMeters.ids[j])
+				}
+			}
+			for _, id := range conj.rateLimitMeters.ids {
+				f.meterIDAllocator.release(id)
+			}
+			return fmt.Errorf("error when installing rate limit OF Meter %d: %w", conj.rateLimitMeters.ids[i], err)
+		}
+	}
+	return nil
+}
+
+// uninstallRateLimitMeters deletes the meters of the policyRuleConjunction if it has some, and releases their IDs.
+func (f *featureNetworkPolicy) uninstallRateLimitMeters(conj *policyRuleConjunction) {
+	if conj == nil || conj.rateLimitMeters == nil {
+		return
+	}
+	for i, meter := range conj.rateLimitMeters.meters {
+		id := conj.rateLimitMeters.ids[i]
+		if err := meter.Delete(); err != nil {
+			klog.ErrorS(err, "Error when deleting rate limit OF Meter", "meterID", id)
+		}
+		f.rateLimitedPackets.Delete(id)
+		f.meterIDAllocator.release(id)
+	}
 }
 
 // calculateMatchFlowChangesForRule calculates the contextChanges for the policyRule, and updates the context status in case of batch install.
@@ -1313,9 +1509,26 @@ func (c *client) BatchInstallPolicyRuleFlows(ofPolicyRules []*types.PolicyRule)
 
 	var allFlowMessages []*openflow15.FlowMod
 	var conjunctions []*policyRuleConjunction
+	var meterConjunctions []*policyRuleConjunction
+	uninstallMeters := func() {
+		for _, conj := range meterConjunctions {
+			c.featureNetworkPolicy.uninstallRateLimitMeters(conj)
+		}
+	}
 
 	for _, rule := range ofPolicyRules {
-		conj := c.featureNetworkPolicy.calculateActionFlowChangesForRule(rule)
+		conj, err := c.featureNetworkPolicy.calculateActionFlowChangesForRule(rule)
+		if err == nil {
+			err = c.featureNetworkPolicy.installRateLimitMeters(conj)
+		}
+		if err != nil {
+			uninstallMeters()
+			c.featureNetworkPolicy.globalConjMatchFlowCache = map[string]*conjMatchFlowContext{}
+			return err
+		}
+		if conj != nil && conj.rateLimitMeters != nil {
+			meterConjunctions = append(meterConjunctions, conj)
+		}
 		c.featureNetworkPolicy.addRuleToConjunctiveMatch(conj, rule)
 		for _, msg := range append(conj.actionFlows, conj.metricFlows...) {
 			allFlowMessages = append(allFlowMessages, msg)
@@ -1345,6 +1558,7 @@ func (c *client) BatchInstallPolicyRuleFlows(ofPolicyRules []*types.PolicyRule)
 		// Reset the global conjunctive match flow cache since the OpenFlow bundle, which contains
 		// all the match flows to be installed, was not applied successfully.
 		c.featureNetworkPolicy.globalConjMatchFlowCache = map[string]*conjMatchFlowContext{}
+		uninstallMeters()
 		return err
 	}
 	// Update conjMatchFlowContexts as the expected status.
@@ -1458,7 +1672,7 @@ func (c *policyRuleConjunction) calculateClauses(rule *types.PolicyRule) (uint8,
 		c.fromClause = c.newClause(fromID, nClause, ruleTable, defaultTable)
 	}
 	if rule.To != nil {
-		if isEgressRule || (rule.IsAntreaNetworkPolicyRule() && !containsLabelIdentityAddress(rule.From)) {
+		if isEgressRule || isAntreaPolicyAuditTable(rule.TableID) || (rule.IsAntreaNetworkPolicyRule() && !containsLabelIdentityAddress(rule.From)) {
 			defaultTable = nil
 		} else {
 			defaultTable = dropTable
@@ -1591,6 +1805,8 @@ func (c *client) UninstallPolicyRuleFlows(ruleID uint32) ([]string, error) {
 		return nil, err
 	}
 
+	// The meters are deleted after the flows referencing them.
+	c.featureNetworkPolicy.uninstallRateLimitMeters(conj)
 	c.featureNetworkPolicy.policyCache.Delete(conj)
 	return staleOFPriorities, nil
 }
@@ -1946,6 +2162,15 @@ func parseAllowFlow(flowMap map[string]string) (uint32, types.RuleMetric) {
 	return uint32(id), m
 }
 
+func parseAuditFlow(flowMap map[string]string) (uint32, types.RuleMetric) {
+	// example audit flow format:
+	// table=AntreaPolicyEgressAuditRule, n_packets=3, n_bytes=222, priority=14900,conj_id=5 actions=set_field:0x5->reg5,...
+	m := parseFlowMetric(flowMap)
+	m.Sessions = m.Packets
+	id, _ := strconv.ParseUint(flowMap["conj_id"], 10, 32)
+	return uint32(id), m
+}
+
 func parseFlowToMap(flow string) map[string]string {
 	split := strings.Split(flow, ",")
 	flowMap := make(map[string]string)
@@ -2062,6 +2287,41 @@ func (c *client) NetworkPolicyMetrics() map[uint32]*types.RuleMetric {
 	// flows to get the correct number of total packets.
 	collectMetricsFromFlows(EgressMetricTable, parseMetricFlow)
 	collectMetricsFromFlows(IngressMetricTable, parseMetricFlow)
+	if c.enableAntreaPolicy && c.enableAuditMode {
+		// The rules of policies in Audit mode have no metric flows, the traffic matching them is counted by their
+		// action flows which only match the first packet of the connections.
+		for _, table := range GetAntreaPolicyAuditTables() {
+			dumpedFlows, _ := c.ovsctlClient.DumpTableFlows(table.ofTable.GetID())
+			for _, flow := range dumpedFlows {
+				if !strings.Contains(flow, auditFlowIdentifier) {
+					continue
+				}
+				flowMap := parseFlowToMap(flow)
+				ruleID, metric := parseAuditFlow(flowMap)
+				if accMetric, ok := result[ruleID]; ok {
+					accMetric.Merge(&metric)
+				} else {
+					result[ruleID] = &metric
+				}
+			}
+		}
+	}
+	// The packets dropped by the meters of rules with the RateLimit action are counted by the meters instead of flows.
+	for _, obj := range c.featureNetworkPolicy.policyCache.List() {
+		conj := obj.(*policyRuleConjunction)
+		if conj.rateLimitMeters == nil {
+			continue
+		}
+		metric, ok := result[conj.id]
+		if !ok {
+			continue
+		}
+		for _, id := range conj.rateLimitMeters.ids {
+			if dropped, ok := c.featureNetworkPolicy.rateLimitedPackets.Load(id); ok {
+				metric.RateLimitedPackets += dropped.(uint64)
+			}
+		}
+	}
 	return result
 }
 
@@ -2087,11 +2347,17 @@ type featureNetworkPolicy struct {
 	// OVS pipeline. The key is the next table used in the second bucket, and the value is the Openflow group.
 	loggingGroupCache sync.Map
 	groupAllocator    GroupAllocator
+	// meterIDAllocator allocates the IDs of the meters of rules with the RateLimit action.
+	meterIDAllocator meterIDAllocator
+	// rateLimitedPackets stores the number of packets dropped by the meters of rules with the RateLimit action. The key
+	// is the meter ID, and the value is the packet count which is updated periodically with the meter stats.
+	rateLimitedPackets sync.Map
 
 	ovsMetersAreSupported bool
 	enableDenyTracking    bool
 	enableAntreaPolicy    bool
 	enableL7NetworkPolicy bool
+	enableAuditMode       bool
 	enableMulticast       bool
 	proxyAll              bool
 	ctZoneSrcField        *binding.RegField
@@ -2116,6 +2382,7 @@ func newFeatureNetworkPolicy(
 	enableDenyTracking,
 	enableAntreaPolicy bool,
 	enableL7NetworkPolicy bool,
+	enableAuditMode bool,
 	enableMulticast bool,
 	proxyAll bool,
 	connectUplinkToBridge bool,
@@ -2127,6 +2394,7 @@ func newFeatureNetworkPolicy(
 		bridge:                   bridge,
 		nodeType:                 nodeType,
 		enableL7NetworkPolicy:    enableL7NetworkPolicy,
+		enableAuditMode:          enableAuditMode,
 		l7NetworkPolicyConfig:    l7NetworkPolicyConfig,
 		globalConjMatchFlowCache: make(map[string]*conjMatchFlowContext),
 		policyCache:              cache.NewIndexer(policyConjKeyFunc, cache.Indexers{priorityIndex: priorityIndexFunc}),
@@ -2146,6 +2414,9 @@ func (f *featureNetworkPolicy) initFlows() []*openflow15.FlowMod {
 	f.egressTables = map[uint8]struct{}{EgressRuleTable.GetID(): {}, EgressDefaultTable.GetID(): {}}
 	if f.enableAntreaPolicy {
 		f.egressTables[AntreaPolicyEgressRuleTable.GetID()] = struct{}{}
+		if f.enableAuditMode {
+			f.egressTables[AntreaPolicyEgressAuditRuleTable.GetID()] = struct{}{}
+		}
 		if f.enableMulticast {
 			f.egressTables[MulticastEgressRuleTable.GetID()] = struct{}{}
 		}
@@ -2158,6 +2429,9 @@ func (f *featureNetworkPolicy) initFlows() []*openflow15.FlowMod {
 		}
 	}
 	flows = append(flows, f.skipPolicyRuleCheckFlows()...)
+	if f.enableAntreaPolicy && f.enableAuditMode {
+		flows = append(flows, f.skipAuditRuleCheckFlows()...)
+	}
 	flows = append(flows, f.initLoggingFlows()...)
 	return GetFlowModMessages(flows, binding.AddMessage)
 }
@@ -2211,6 +2485,41 @@ func (f *featureNetworkPolicy) skipPolicyRuleCheckFlows() []binding.Flow {
 	return flows
 }
 
+// skipAuditRuleCheckFlows generates the flows to forward the packets in an established or related connections to the
+// metric table in the same stage directly, so that only the first packet of a connection is audited by the rules of
+// Antrea-native policies in Audit mode.
+func (f *featureNetworkPolicy) skipAuditRuleCheckFlows() []binding.Flow {
+	var flows []binding.Flow
+	cookieID := f.cookieAllocator.Request(f.category).Raw()
+	for _, ipProtocol := range f.ipProtocols {
+		for _, item := range []struct {
+			table       *Table
+			metricTable *Table
+		}{
+			{AntreaPolicyEgressAuditRuleTable, EgressMetricTable},
+			{AntreaPolicyIngressAuditRuleTable, IngressMetricTable},
+		} {
+			flows = append(flows,
+				item.table.ofTable.BuildFlow(priorityTopAntreaPolicy).
+					Cookie(cookieID).
+					MatchProtocol(ipProtocol).
+					MatchCTStateNew(false).
+					MatchCTStateEst(true).
+					Action().GotoTable(item.metricTable.GetID()).
+					Done(),
+				item.table.ofTable.BuildFlow(priorityTopAntreaPolicy).
+					Cookie(cookieID).
+					MatchProtocol(ipProtocol).
+					MatchCTStateNew(false).
+					MatchCTStateRel(true).
+					Action().GotoTable(item.metricTable.GetID()).
+					Done(),
+			)
+		}
+	}
+	return flows
+}
+
 func (f *featureNetworkPolicy) l7NPTrafficControlFlows() []binding.Flow {
 	cookieID := f.cookieAllocator.Request(f.category).Raw()
 	vlanMask := uint16(openflow15.OFPVID_PRESENT)
@@ -2275,6 +2584,9 @@ func (f *featureNetworkPolicy) initGroups() []binding.OFEntry {
 	if f.enableMulticast {
 		candidateTables = append(candidateTables, MulticastEgressMetricTable, MulticastIngressMetricTable)
 	}
+	if f.enableAntreaPolicy && f.enableAuditMode {
+		candidateTables = append(candidateTables, AntreaPolicyEgressRuleTable, AntreaPolicyIngressRuleTable)
+	}
 	for _, nextTable := range candidateTables {
 		groupKey := fmt.Sprintf("%d", nextTable.GetID())
 		obj, ok := f.loggingGroupCache.Load(groupKey)
@@ -2302,7 +2614,18 @@ func (f *featureNetworkPolicy) initGroups() []binding.OFEntry {
 }
 
 func (f *featureNetworkPolicy) replayMeters() []binding.OFEntry {
-	return nil
+	var meters []binding.OFEntry
+	for _, obj := range f.policyCache.List() {
+		conj := obj.(*policyRuleConjunction)
+		if conj.rateLimitMeters == nil {
+			continue
+		}
+		for _, meter := range conj.rateLimitMeters.meters {
+			meter.Reset()
+			meters = append(meters, meter)
+		}
+	}
+	return meters
 }
 
 func (f *featureNetworkPolicy) getLoggingAndResubmitGroupID(nextTable uint8) binding.GroupIDType {
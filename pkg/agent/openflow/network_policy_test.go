@@ -1103,6 +1103,32 @@ func TestParseMetricFlow(t *testing.T) {
 	}
 }
 
+func TestParseAuditFlow(t *testing.T) {
+	flow := "table=AntreaPolicyIngressAuditRule, n_packets=3, n_bytes=222, priority=14900,conj_id=5 actions=set_field:0x5->reg6,set_field:0x800/0x1800->reg0,group:4"
+	rule, metric := parseAuditFlow(parseFlowToMap(flow))
+	assert.Equal(t, uint32(5), rule)
+	assert.Equal(t, uint64(222), metric.Bytes)
+	assert.Equal(t, uint64(3), metric.Packets)
+	assert.Equal(t, uint64(3), metric.Sessions)
+}
+
+func TestGetRuleDisposition(t *testing.T) {
+	actionReject := crdv1beta1.RuleActionReject
+	actionPass := crdv1beta1.RuleActionPass
+	for _, tc := range []struct {
+		action   *crdv1beta1.RuleAction
+		expected uint32
+	}{
+		{action: nil, expected: DispositionAllow},
+		{action: &actionAllow, expected: DispositionAllow},
+		{action: &actionDrop, expected: DispositionDrop},
+		{action: &actionReject, expected: DispositionRej},
+		{action: &actionPass, expected: DispositionPass},
+	} {
+		assert.Equal(t, tc.expected, getRuleDisposition(&types.PolicyRule{Action: tc.action}))
+	}
+}
+
 func TestNetworkPolicyMetrics(t *testing.T) {
 	tests := []struct {
 		name         string
@@ -1561,3 +1587,34 @@ func Test_NewDNSPacketInConjunction(t *testing.T) {
 	t.Run("With OVS meters", func(t *testing.T) { runTests(t, true) })
 	t.Run("Without OVS meters", func(t *testing.T) { runTests(t, false) })
 }
+
+func TestMeterIDAllocator(t *testing.T) {
+	var allocator meterIDAllocator
+	id1, err := allocator.allocate()
+	require.NoError(t, err)
+	assert.Equal(t, rateLimitMeterIDMin, id1)
+	id2, err := allocator.allocate()
+	require.NoError(t, err)
+	assert.Equal(t, rateLimitMeterIDMin+1, id2)
+	assert.True(t, isRuleRateLimitMeterID(int(id2)))
+
+	allocator.release(id1)
+	id3, err := allocator.allocate()
+	require.NoError(t, err)
+	assert.Equal(t, id1, id3, "Released meter ID should be reused")
+
+	allocator.allocated = uint32(rateLimitMeterIDMax - rateLimitMeterIDMin + 1)
+	_, err = allocator.allocate()
+	assert.ErrorContains(t, err, "no meter ID available")
+}
+
+func TestRateLimitBucketIPNet(t *testing.T) {
+	ipNet := rateLimitBucketIPNet(5, false)
+	assert.True(t, ipNet.Contains(net.ParseIP("10.10.0.5")))
+	assert.True(t, ipNet.Contains(net.ParseIP("10.10.1.69")))
+	assert.False(t, ipNet.Contains(net.ParseIP("10.10.0.6")))
+
+	ipNet = rateLimitBucketIPNet(5, true)
+	assert.True(t, ipNet.Contains(net.ParseIP("fd00:10:244::45")))
+	assert.False(t, ipNet.Contains(net.ParseIP("fd00:10:244::46")))
+}
@@ -148,11 +148,12 @@ var (
 	DNATTable = newTable("DNAT", stagePreRouting, pipelineIP)
 
 	// Tables in stageEgressSecurity:
-	EgressSecurityClassifierTable = newTable("EgressSecurityClassifier", stageEgressSecurity, pipelineIP)
-	AntreaPolicyEgressRuleTable   = newTable("AntreaPolicyEgressRule", stageEgressSecurity, pipelineIP)
-	EgressRuleTable               = newTable("EgressRule", stageEgressSecurity, pipelineIP)
-	EgressDefaultTable            = newTable("EgressDefaultRule", stageEgressSecurity, pipelineIP)
-	EgressMetricTable             = newTable("EgressMetric", stageEgressSecurity, pipelineIP)
+	EgressSecurityClassifierTable    = newTable("EgressSecurityClassifier", stageEgressSecurity, pipelineIP)
+	AntreaPolicyEgressAuditRuleTable = newTable("AntreaPolicyEgressAuditRule", stageEgressSecurity, pipelineIP)
+	AntreaPolicyEgressRuleTable      = newTable("AntreaPolicyEgressRule", stageEgressSecurity, pipelineIP)
+	EgressRuleTable                  = newTable("EgressRule", stageEgressSecurity, pipelineIP)
+	EgressDefaultTable               = newTable("EgressDefaultRule", stageEgressSecurity, pipelineIP)
+	EgressMetricTable                = newTable("EgressMetric", stageEgressSecurity, pipelineIP)
 
 	// Tables in stageRouting:
 	L3ForwardingTable = newTable("L3Forwarding", stageRouting, pipelineIP)
@@ -169,11 +170,12 @@ var (
 	TrafficControlTable   = newTable("TrafficControl", stageSwitching, pipelineIP)
 
 	// Tables in stageIngressSecurity:
-	IngressSecurityClassifierTable = newTable("IngressSecurityClassifier", stageIngressSecurity, pipelineIP)
-	AntreaPolicyIngressRuleTable   = newTable("AntreaPolicyIngressRule", stageIngressSecurity, pipelineIP)
-	IngressRuleTable               = newTable("IngressRule", stageIngressSecurity, pipelineIP)
-	IngressDefaultTable            = newTable("IngressDefaultRule", stageIngressSecurity, pipelineIP)
-	IngressMetricTable             = newTable("IngressMetric", stageIngressSecurity, pipelineIP)
+	IngressSecurityClassifierTable    = newTable("IngressSecurityClassifier", stageIngressSecurity, pipelineIP)
+	AntreaPolicyIngressAuditRuleTable = newTable("AntreaPolicyIngressAuditRule", stageIngressSecurity, pipelineIP)
+	AntreaPolicyIngressRuleTable      = newTable("AntreaPolicyIngressRule", stageIngressSecurity, pipelineIP)
+	IngressRuleTable                  = newTable("IngressRule", stageIngressSecurity, pipelineIP)
+	IngressDefaultTable               = newTable("IngressDefaultRule", stageIngressSecurity, pipelineIP)
+	IngressMetricTable                = newTable("IngressMetric", stageIngressSecurity, pipelineIP)
 
 	// Tables in stageConntrack:
 	ConntrackCommitTable = newTable("ConntrackCommit", stageConntrack, pipelineIP)
@@ -325,6 +327,16 @@ func GetAntreaPolicyIngressTables() []*Table {
 	}
 }
 
+// GetAntreaPolicyAuditTables returns the tables in which the rules of Antrea-native policies in Audit mode are
+// evaluated. Packets matching these rules are only logged and counted before being resubmitted to the tables enforcing
+// the policies.
+func GetAntreaPolicyAuditTables() []*Table {
+	return []*Table{
+		AntreaPolicyEgressAuditRuleTable,
+		AntreaPolicyIngressAuditRuleTable,
+	}
+}
+
 func GetAntreaPolicyBaselineTierTables() []*Table {
 	return []*Table{
 		EgressDefaultTable,
@@ -425,6 +437,7 @@ type client struct {
 	enableDSR                  bool
 	enableAntreaPolicy         bool
 	enableL7NetworkPolicy      bool
+	enableAuditMode            bool
 	enableDenyTracking         bool
 	enableEgress               bool
 	enableEgressTrafficShaping bool
@@ -486,8 +499,9 @@ type client struct {
 func (c *client) Run(stopCh <-chan struct{}) {
 	// Start PacketIn
 	c.StartPacketInHandler(stopCh)
-	// Start OVS meter stats collection
-	if c.enablePrometheusMetrics {
+	// Start OVS meter stats collection. The stats are also required to report the packets dropped by rules with the
+	// RateLimit action.
+	if c.enablePrometheusMetrics || c.enableAntreaPolicy {
 		if c.ovsMetersAreSupported {
 			klog.Info("Start collecting OVS meter stats")
 			go wait.Until(c.getMeterStats, time.Second*30, stopCh)
@@ -1714,7 +1728,10 @@ func (f *featurePodConnectivity) arpNormalFlow() binding.Flow {
 		Done()
 }
 
-func (f *featureNetworkPolicy) allowRulesMetricFlows(conjunctionID uint32, ingress bool, tableID uint8) []binding.Flow {
+// allowRulesMetricFlows generates the metric flows of a rule which allows traffic. If rateLimitMeters is not nil, the
+// packets matched by the flows are metered with the meter of the bucket of their source, and dropped if they exceed the
+// rate limit.
+func (f *featureNetworkPolicy) allowRulesMetricFlows(conjunctionID uint32, ingress bool, tableID uint8, rateLimitMeters *ruleRateLimitMeters) []binding.Flow {
 	cookieID := f.cookieAllocator.Request(f.category).Raw()
 	metricTable := IngressMetricTable
 	offset := 0
@@ -1732,14 +1749,28 @@ func (f *featureNetworkPolicy) allowRulesMetricFlows(conjunctionID uint32, ingre
 	if f.enableMulticast && tableID == MulticastIngressRuleTable.GetID() {
 		metricTable = MulticastIngressMetricTable
 	}
-	metricFlow := func(isCTNew bool, protocol binding.Protocol) binding.Flow {
+	metricFlowBuilder := func(isCTNew bool, protocol binding.Protocol) binding.FlowBuilder {
 		return metricTable.ofTable.BuildFlow(priorityNormal).
 			Cookie(cookieID).
 			MatchProtocol(protocol).
 			MatchCTStateNew(isCTNew).
-			MatchCTLabelField(0, uint64(conjunctionID)<<offset, field).
-			Action().NextTable().
-			Done()
+			MatchCTLabelField(0, uint64(conjunctionID)<<offset, field)
+	}
+	metricFlows := func(isCTNew bool, protocol binding.Protocol) []binding.Flow {
+		// When the rate limit is of new connections, only the first packets of the connections are metered.
+		if rateLimitMeters == nil || (!isCTNew && rateLimitMeters.newConnectionsOnly) {
+			return []binding.Flow{metricFlowBuilder(isCTNew, protocol).Action().NextTable().Done()}
+		}
+		// Each flow matches the sources of a bucket, and meters the packets with the meter of the bucket.
+		flows := make([]binding.Flow, 0, rateLimitBuckets)
+		for bucket, meterID := range rateLimitMeters.ids {
+			flows = append(flows, metricFlowBuilder(isCTNew, protocol).
+				MatchSrcIPNet(rateLimitBucketIPNet(bucket, protocol == binding.ProtocolIPv6)).
+				Action().Meter(uint32(meterID)).
+				Action().NextTable().
+				Done())
+		}
+		return flows
 	}
 	var flows []binding.Flow
 	// Unlike rules for unicast traffic, each IGMP and multicast rule uses single metric flow to track stats
@@ -1758,7 +1789,8 @@ func (f *featureNetworkPolicy) allowRulesMetricFlows(conjunctionID uint32, ingre
 	// session.
 	// The flow matching 'ct_state=-new' tracks the byte/packet count of an established connection (both directions).
 	for _, ipProtocol := range f.ipProtocols {
-		flows = append(flows, metricFlow(true, ipProtocol), metricFlow(false, ipProtocol))
+		flows = append(flows, metricFlows(true, ipProtocol)...)
+		flows = append(flows, metricFlows(false, ipProtocol)...)
 	}
 	return flows
 }
@@ -1998,6 +2030,39 @@ func (f *featureNetworkPolicy) conjunctionActionPassFlow(conjunctionID uint32, t
 		Done()
 }
 
+// conjunctionActionAuditFlow generates the flow to log the packet with the disposition of the rule if policyRuleConjunction
+// ID is matched in an audit table. The packet is then resubmitted to the table enforcing Antrea-native policies of the
+// same stage, regardless of the disposition.
+func (f *featureNetworkPolicy) conjunctionActionAuditFlow(conjunctionID uint32, table binding.Table, priority *uint16, disposition uint32) binding.Flow {
+	conjReg := TFIngressConjIDField
+	nextTable := AntreaPolicyIngressRuleTable
+	tableID := table.GetID()
+	if _, ok := f.egressTables[tableID]; ok {
+		conjReg = TFEgressConjIDField
+		nextTable = AntreaPolicyEgressRuleTable
+	}
+	return table.BuildFlow(*priority).
+		Cookie(f.cookieAllocator.Request(f.category).Raw()).
+		MatchConjID(conjunctionID).
+		Action().LoadToRegField(conjReg, conjunctionID).
+		Action().LoadToRegField(APDispositionField, disposition).
+		Action().LoadToRegField(PacketInOperationField, PacketInNPLoggingOperation).
+		Action().LoadToRegField(PacketInTableField, uint32(tableID)).
+		Action().Group(f.getLoggingAndResubmitGroupID(nextTable.GetID())).
+		Done()
+}
+
+// isAntreaPolicyAuditTable returns whether the table is one of the tables in which the rules of Antrea-native policies
+// in Audit mode are evaluated.
+func isAntreaPolicyAuditTable(tableID uint8) bool {
+	for _, table := range GetAntreaPolicyAuditTables() {
+		if table.IsInitialized() && table.GetID() == tableID {
+			return true
+		}
+	}
+	return false
+}
+
 func (c *client) Disconnect() error {
 	return c.bridge.Disconnect()
 }
@@ -2938,6 +3003,7 @@ func NewClient(bridgeName string,
 	enableProxy bool,
 	enableAntreaPolicy bool,
 	enableL7NetworkPolicy bool,
+	enableAuditMode bool,
 	enableEgress bool,
 	enableEgressTrafficShaping bool,
 	enableDenyTracking bool,
@@ -2961,6 +3027,7 @@ func NewClient(bridgeName string,
 		enableDSR:                  enableDSR,
 		enableAntreaPolicy:         enableAntreaPolicy,
 		enableL7NetworkPolicy:      enableL7NetworkPolicy,
+		enableAuditMode:            enableAuditMode,
 		enableDenyTracking:         enableDenyTracking,
 		enableEgress:               enableEgress,
 		enableEgressTrafficShaping: enableEgressTrafficShaping,
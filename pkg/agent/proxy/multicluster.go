@@ -0,0 +1,76 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	mccommon "antrea.io/antrea/multicluster/controllers/multicluster/common"
+	k8sproxy "antrea.io/antrea/third_party/proxy"
+)
+
+// filterLocalClusterEndpoints selects the Endpoints of an Antrea Multi-cluster Service which
+// prefers the local cluster. The Endpoints of the local cluster are either the ClusterIP of the
+// exported Service (ClusterIP type Endpoints), or the Endpoints of the exported Service (PodIP
+// type Endpoints). If the exported Service has ready Endpoints in the local cluster, only the
+// Endpoints of the local cluster are returned. Otherwise, the Endpoints of the other member
+// clusters are returned, so the traffic fails over to the other member clusters without waiting
+// for the local cluster's Endpoints to be withdrawn from the ClusterSet.
+func (p *proxier) filterLocalClusterEndpoints(svcPortName k8sproxy.ServicePortName, endpoints map[string]k8sproxy.Endpoint) map[string]k8sproxy.Endpoint {
+	exportedSvcPortName := svcPortName
+	exportedSvcPortName.Name = strings.TrimPrefix(svcPortName.Name, mccommon.AntreaMCSPrefix)
+
+	localIPs := sets.New[string]()
+	for _, ep := range p.endpointsMap[exportedSvcPortName] {
+		if ep.IsReady() {
+			localIPs.Insert(ep.IP())
+		}
+	}
+	exportedSvcPort, exportedSvcExists := p.serviceMap[exportedSvcPortName]
+	if localIPs.Len() == 0 {
+		// The exported Service has no ready Endpoints in the local cluster, so its ClusterIP
+		// must not be used as an Endpoint of the Multi-cluster Service.
+		if !exportedSvcExists {
+			return endpoints
+		}
+		exportedSvcIP := exportedSvcPort.ClusterIP().String()
+		return filterEndpointsMap(endpoints, func(ep k8sproxy.Endpoint) bool {
+			return ep.IP() != exportedSvcIP
+		})
+	}
+	if exportedSvcExists {
+		localIPs.Insert(exportedSvcPort.ClusterIP().String())
+	}
+	localEndpoints := filterEndpointsMap(endpoints, func(ep k8sproxy.Endpoint) bool {
+		return localIPs.Has(ep.IP())
+	})
+	if len(localEndpoints) == 0 {
+		// The Endpoints of the local cluster have not been imported yet.
+		return endpoints
+	}
+	return localEndpoints
+}
+
+func filterEndpointsMap(endpoints map[string]k8sproxy.Endpoint, predicate func(k8sproxy.Endpoint) bool) map[string]k8sproxy.Endpoint {
+	filteredEndpoints := make(map[string]k8sproxy.Endpoint, len(endpoints))
+	for key, ep := range endpoints {
+		if predicate(ep) {
+			filteredEndpoints[key] = ep
+		}
+	}
+	return filteredEndpoints
+}
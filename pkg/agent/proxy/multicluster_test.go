@@ -0,0 +1,119 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	apimachinerytypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"antrea.io/antrea/pkg/agent/proxy/types"
+	k8sproxy "antrea.io/antrea/third_party/proxy"
+)
+
+func TestFilterLocalClusterEndpoints(t *testing.T) {
+	mcSvcPortName := k8sproxy.ServicePortName{
+		NamespacedName: apimachinerytypes.NamespacedName{Namespace: "default", Name: "antrea-mc-nginx"},
+		Port:           "http",
+		Protocol:       v1.ProtocolTCP,
+	}
+	exportedSvcPortName := k8sproxy.ServicePortName{
+		NamespacedName: apimachinerytypes.NamespacedName{Namespace: "default", Name: "nginx"},
+		Port:           "http",
+		Protocol:       v1.ProtocolTCP,
+	}
+	exportedSvcInfo := k8sproxy.NewBaseServiceInfo(net.ParseIP("10.96.0.10"), 80, v1.ProtocolTCP, 0, v1.LoadBalancerStatus{}, "", 0, nil, nil, 0, false, false, nil, "")
+	newEndpoint := func(endpoint string, ready bool) k8sproxy.Endpoint {
+		return &k8sproxy.BaseEndpointInfo{Endpoint: endpoint, Ready: ready}
+	}
+	// The Endpoints of a Multi-cluster Service with ClusterIP type Endpoints.
+	clusterIPEndpoints := map[string]k8sproxy.Endpoint{
+		"10.96.0.10:80": newEndpoint("10.96.0.10:80", true),
+		"10.97.0.10:80": newEndpoint("10.97.0.10:80", true),
+		"10.98.0.10:80": newEndpoint("10.98.0.10:80", true),
+	}
+	// The Endpoints of a Multi-cluster Service with PodIP type Endpoints.
+	podIPEndpoints := map[string]k8sproxy.Endpoint{
+		"10.10.0.11:8080": newEndpoint("10.10.0.11:8080", true),
+		"10.10.1.12:8080": newEndpoint("10.10.1.12:8080", true),
+		"10.20.0.11:8080": newEndpoint("10.20.0.11:8080", true),
+	}
+
+	testCases := []struct {
+		name              string
+		serviceMap        k8sproxy.ServiceMap
+		endpointsMap      types.EndpointsMap
+		mcEndpoints       map[string]k8sproxy.Endpoint
+		expectedEndpoints sets.Set[string]
+	}{
+		{
+			name:       "ClusterIP type Endpoints with ready local Endpoints",
+			serviceMap: k8sproxy.ServiceMap{exportedSvcPortName: exportedSvcInfo},
+			endpointsMap: types.EndpointsMap{exportedSvcPortName: {
+				"10.10.0.11:8080": newEndpoint("10.10.0.11:8080", true),
+			}},
+			mcEndpoints:       clusterIPEndpoints,
+			expectedEndpoints: sets.New[string]("10.96.0.10:80"),
+		},
+		{
+			name:       "ClusterIP type Endpoints without ready local Endpoints",
+			serviceMap: k8sproxy.ServiceMap{exportedSvcPortName: exportedSvcInfo},
+			endpointsMap: types.EndpointsMap{exportedSvcPortName: {
+				"10.10.0.11:8080": newEndpoint("10.10.0.11:8080", false),
+			}},
+			mcEndpoints:       clusterIPEndpoints,
+			expectedEndpoints: sets.New[string]("10.97.0.10:80", "10.98.0.10:80"),
+		},
+		{
+			name:              "ClusterIP type Endpoints without exported Service",
+			mcEndpoints:       clusterIPEndpoints,
+			expectedEndpoints: sets.New[string]("10.96.0.10:80", "10.97.0.10:80", "10.98.0.10:80"),
+		},
+		{
+			name:       "PodIP type Endpoints with ready local Endpoints",
+			serviceMap: k8sproxy.ServiceMap{exportedSvcPortName: exportedSvcInfo},
+			endpointsMap: types.EndpointsMap{exportedSvcPortName: {
+				"10.10.0.11:8080": newEndpoint("10.10.0.11:8080", true),
+				"10.10.1.12:8080": newEndpoint("10.10.1.12:8080", true),
+			}},
+			mcEndpoints:       podIPEndpoints,
+			expectedEndpoints: sets.New[string]("10.10.0.11:8080", "10.10.1.12:8080"),
+		},
+		{
+			name:       "local Endpoints not imported yet",
+			serviceMap: k8sproxy.ServiceMap{exportedSvcPortName: exportedSvcInfo},
+			endpointsMap: types.EndpointsMap{exportedSvcPortName: {
+				"10.10.2.13:8080": newEndpoint("10.10.2.13:8080", true),
+			}},
+			mcEndpoints:       podIPEndpoints,
+			expectedEndpoints: sets.New[string]("10.10.0.11:8080", "10.10.1.12:8080", "10.20.0.11:8080"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			p := &proxier{
+				serviceMap:   tc.serviceMap,
+				endpointsMap: tc.endpointsMap,
+			}
+			endpoints := p.filterLocalClusterEndpoints(mcSvcPortName, tc.mcEndpoints)
+			assert.Equal(t, tc.expectedEndpoints, sets.KeySet(endpoints))
+		})
+	}
+}
@@ -723,6 +723,9 @@ func (p *proxier) installServices() {
 			p.endpointsInstalledMap[svcPortName] = endpointsInstalled
 		}
 		endpointsToInstall := p.endpointsMap[svcPortName]
+		if svcInfo.IsNested && svcInfo.PreferLocalCluster {
+			endpointsToInstall = p.filterLocalClusterEndpoints(svcPortName, endpointsToInstall)
+		}
 
 		installedSvcPort, ok := p.serviceInstalledMap[svcPortName]
 		var pSvcInfo *types.ServiceInfo
@@ -35,6 +35,10 @@ type ServiceInfo struct {
 	// Currently it's true for Antrea Multi-cluster Service, determined by whether
 	// there is an Antrea Multi-cluster specific annotation.
 	IsNested bool
+	// PreferLocalCluster means the Endpoints in the local cluster are preferred for
+	// an Antrea Multi-cluster Service, determined by the traffic policy annotation
+	// of the Service.
+	PreferLocalCluster bool
 	// The load balancer mode specified in annotations.
 	LoadBalancerMode *config.LoadBalancerMode
 }
@@ -55,6 +59,7 @@ func getLoadBalancerMode(service *corev1.Service) *config.LoadBalancerMode {
 func NewServiceInfo(port *corev1.ServicePort, service *corev1.Service, baseInfo *k8sproxy.BaseServiceInfo) k8sproxy.ServicePort {
 	info := &ServiceInfo{BaseServiceInfo: baseInfo}
 	info.IsNested = mccommon.IsMulticlusterService(service)
+	info.PreferLocalCluster = mccommon.IsMulticlusterServicePreferLocal(service)
 	info.LoadBalancerMode = getLoadBalancerMode(service)
 	if utilnet.IsIPv6(baseInfo.ClusterIP()) {
 		info.OFProtocol = openflow.ProtocolTCPv6
@@ -175,6 +175,17 @@ func addUp(stats *statsv1alpha1.TrafficStats, inc *agenttypes.RuleMetric) {
 	stats.Sessions += int64(inc.Sessions)
 	stats.Packets += int64(inc.Packets)
 	stats.Bytes += int64(inc.Bytes)
+	stats.RateLimitedPackets += int64(inc.RateLimitedPackets)
+}
+
+// rateLimitedPacketsDiff returns the increase of the count of packets dropped by rate limits. As the count is
+// collected from OVS meters, it can be reset independently of the flow stats, e.g. when the meters are reinstalled,
+// in which case the current count is the increase.
+func rateLimitedPacketsDiff(cur, last int64) int64 {
+	if cur < last {
+		return cur
+	}
+	return cur - last
 }
 
 func isIdenticalMulticastGroupMap(a, b map[string][]cpv1beta.PodReference) bool {
@@ -328,9 +339,10 @@ func calculateRuleDiff(curStatsMap, lastStatsMap map[types.UID]map[string]*stats
 					ruleTrafficStats := statsv1alpha1.RuleTrafficStats{
 						Name: name,
 						TrafficStats: statsv1alpha1.TrafficStats{
-							Bytes:    curRuleStats.Bytes - lastRuleStats.Bytes,
-							Sessions: curRuleStats.Sessions - lastRuleStats.Sessions,
-							Packets:  curRuleStats.Packets - lastRuleStats.Packets,
+							Bytes:              curRuleStats.Bytes - lastRuleStats.Bytes,
+							Sessions:           curRuleStats.Sessions - lastRuleStats.Sessions,
+							Packets:            curRuleStats.Packets - lastRuleStats.Packets,
+							RateLimitedPackets: rateLimitedPacketsDiff(curRuleStats.RateLimitedPackets, lastRuleStats.RateLimitedPackets),
 						},
 					}
 					stats = append(stats, ruleTrafficStats)
@@ -364,9 +376,10 @@ func calculateDiff(curStatsMap, lastStatsMap map[types.UID]*statsv1alpha1.Traffi
 			stats = curStats
 		} else {
 			stats = &statsv1alpha1.TrafficStats{
-				Packets:  curStats.Packets - lastStats.Packets,
-				Sessions: curStats.Sessions - lastStats.Sessions,
-				Bytes:    curStats.Bytes - lastStats.Bytes,
+				Packets:            curStats.Packets - lastStats.Packets,
+				Sessions:           curStats.Sessions - lastStats.Sessions,
+				Bytes:              curStats.Bytes - lastStats.Bytes,
+				RateLimitedPackets: rateLimitedPacketsDiff(curStats.RateLimitedPackets, lastStats.RateLimitedPackets),
 			}
 		}
 		// If the statistics of the NetworkPolicy remain unchanged, no need to report it.
@@ -819,6 +819,67 @@ func TestCalculateRuleDiff(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "rate-limited rule",
+			lastStats: map[types.UID]map[string]*statsv1alpha1.TrafficStats{
+				"uid1": {
+					"rule1": {
+						Bytes:              100,
+						Packets:            10,
+						Sessions:           10,
+						RateLimitedPackets: 4,
+					},
+					"rule2": {
+						Bytes:              100,
+						Packets:            10,
+						Sessions:           1,
+						RateLimitedPackets: 8,
+					},
+				},
+			},
+			curStats: map[types.UID]map[string]*statsv1alpha1.TrafficStats{
+				"uid1": {
+					"rule1": {
+						Bytes:              150,
+						Packets:            15,
+						Sessions:           15,
+						RateLimitedPackets: 7,
+					},
+					// The meter of the rule has been reinstalled.
+					"rule2": {
+						Bytes:              120,
+						Packets:            12,
+						Sessions:           1,
+						RateLimitedPackets: 2,
+					},
+				},
+			},
+			expectedStatsList: []cpv1beta.NetworkPolicyStats{
+				{
+					NetworkPolicy: cpv1beta.NetworkPolicyReference{UID: "uid1"},
+					RuleTrafficStats: []statsv1alpha1.RuleTrafficStats{
+						{
+							Name: "rule1",
+							TrafficStats: statsv1alpha1.TrafficStats{
+								Bytes:              50,
+								Packets:            5,
+								Sessions:           5,
+								RateLimitedPackets: 3,
+							},
+						},
+						{
+							Name: "rule2",
+							TrafficStats: statsv1alpha1.TrafficStats{
+								Bytes:              20,
+								Packets:            2,
+								Sessions:           0,
+								RateLimitedPackets: 2,
+							},
+						},
+					},
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
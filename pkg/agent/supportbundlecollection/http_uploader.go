@@ -0,0 +1,220 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package supportbundlecollection
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"k8s.io/klog/v2"
+
+	cpv1b2 "antrea.io/antrea/pkg/apis/controlplane/v1beta2"
+)
+
+// httpChunkSize is the size of the chunks of resumable uploads. Bundle files which are not
+// larger than one chunk are uploaded with a single PUT request. Declared as variable for testing.
+var httpChunkSize int64 = 8 * 1024 * 1024
+
+const (
+	httpTimeout = 60 * time.Second
+	// apiKeyHeader is the header used to send the API key to the file server.
+	apiKeyHeader = "X-API-Key"
+	// statusResumeIncomplete is returned by the file server for each chunk which does not
+	// complete a resumable upload.
+	statusResumeIncomplete = http.StatusPermanentRedirect
+	// maxHTTPUploadStalls is the maximum number of consecutive chunks after which the file server
+	// may report no progress, before the upload is aborted.
+	maxHTTPUploadStalls = 3
+)
+
+// httpUploader uploads bundle files with PUT requests to https://<host>/<path>/<fileName>.
+//
+// Large bundle files are uploaded in chunks, each chunk being sent with a
+// "Content-Range: bytes <first>-<last>/<size>" header. The file server responds to each chunk
+// which does not complete the upload with 308 (Resume Incomplete). Before uploading the chunks,
+// the uploader sends an empty PUT request with a "Content-Range: bytes */<size>" header to query
+// how many bytes have been received by a previous attempt: the file server responds with 308 and
+// a "Range: bytes=0-<last>" header, or without the Range header if nothing has been received.
+// The upload is aborted if the file server reports no progress for maxHTTPUploadStalls chunks in
+// a row.
+type httpUploader struct {
+	// client is declared as a field for testing.
+	client *http.Client
+}
+
+func newHTTPUploader() *httpUploader {
+	return &httpUploader{
+		client: &http.Client{Timeout: httpTimeout},
+	}
+}
+
+func (u *httpUploader) upload(fileServer *url.URL, fileName string, serverAuth *cpv1b2.BundleServerAuthConfiguration, bundle *bundleFile) (string, error) {
+	target := *fileServer
+	target.Path = path.Join(fileServer.Path, fileName)
+	location := target.String()
+	checksum, err := hex.DecodeString(bundle.checksum)
+	if err != nil {
+		return "", fmt.Errorf("invalid checksum of bundle file: %w", err)
+	}
+	// The digest of the whole bundle file, as defined in RFC 9530. It is sent with each request,
+	// including the chunks of resumable uploads.
+	digest := fmt.Sprintf("sha-256=:%s:", base64.StdEncoding.EncodeToString(checksum))
+	newRequest := func(body io.Reader, contentLength int64) (*http.Request, error) {
+		req, err := http.NewRequest(http.MethodPut, location, body)
+		if err != nil {
+			return nil, err
+		}
+		req.ContentLength = contentLength
+		req.Header.Set("Content-Type", "application/gzip")
+		req.Header.Set("Repr-Digest", digest)
+		setAuthHeader(req, serverAuth)
+		return req, nil
+	}
+
+	if bundle.size <= httpChunkSize {
+		if _, err := bundle.Seek(0, io.SeekStart); err != nil {
+			return "", err
+		}
+		req, err := newRequest(io.LimitReader(bundle, bundle.size), bundle.size)
+		if err != nil {
+			return "", err
+		}
+		if _, err := u.do(req, http.StatusOK, http.StatusCreated, http.StatusNoContent); err != nil {
+			return "", err
+		}
+		return location, nil
+	}
+
+	req, err := newRequest(http.NoBody, 0)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Range", fmt.Sprintf("bytes */%d", bundle.size))
+	resp, err := u.do(req, statusResumeIncomplete, http.StatusOK, http.StatusCreated, http.StatusNoContent)
+	if err != nil {
+		return "", fmt.Errorf("error when querying upload status: %w", err)
+	}
+	if resp.StatusCode != statusResumeIncomplete {
+		klog.InfoS("Support bundle has already been uploaded", "location", location)
+		return location, nil
+	}
+	offset, err := parseReceivedRange(resp.Header.Get("Range"))
+	if err != nil {
+		return "", err
+	}
+	if offset > 0 {
+		klog.InfoS("Resuming support bundle upload", "location", location, "offset", offset)
+	}
+	stalls := 0
+	for offset < bundle.size {
+		chunkSize := bundle.size - offset
+		if chunkSize > httpChunkSize {
+			chunkSize = httpChunkSize
+		}
+		if _, err := bundle.Seek(offset, io.SeekStart); err != nil {
+			return "", err
+		}
+		req, err := newRequest(io.LimitReader(bundle, chunkSize), chunkSize)
+		if err != nil {
+			return "", err
+		}
+		req.Header.Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", offset, offset+chunkSize-1, bundle.size))
+		if offset+chunkSize < bundle.size {
+			resp, err = u.do(req, statusResumeIncomplete)
+		} else {
+			resp, err = u.do(req, http.StatusOK, http.StatusCreated, http.StatusNoContent)
+		}
+		if err != nil {
+			return "", fmt.Errorf("error when uploading bytes %d-%d: %w", offset, offset+chunkSize-1, err)
+		}
+		if resp.StatusCode != statusResumeIncomplete {
+			break
+		}
+		// The file server may have persisted fewer bytes than it received. If it persists none of
+		// them repeatedly, e.g. because a proxy drops the Range header, the upload is aborted instead
+		// of sending the same bytes forever.
+		received, err := parseReceivedRange(resp.Header.Get("Range"))
+		if err != nil {
+			return "", err
+		}
+		if received > offset+chunkSize {
+			return "", fmt.Errorf("file server reported %d bytes received while only %d bytes were sent", received, offset+chunkSize)
+		}
+		if received <= offset {
+			stalls++
+			if stalls >= maxHTTPUploadStalls {
+				return "", fmt.Errorf("file server did not make progress after %d chunks, %d bytes received", stalls, received)
+			}
+		} else {
+			stalls = 0
+		}
+		offset = received
+	}
+	return location, nil
+}
+
+// do sends the request and returns an error if the response status code is not one of the
+// expected ones.
+func (u *httpUploader) do(req *http.Request, expectedStatusCodes ...int) (*http.Response, error) {
+	resp, err := u.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	// Drain the body so that the connection can be reused.
+	io.Copy(io.Discard, resp.Body)
+	for _, code := range expectedStatusCodes {
+		if resp.StatusCode == code {
+			return resp, nil
+		}
+	}
+	return nil, fmt.Errorf("unexpected response status from file server: %s", resp.Status)
+}
+
+// parseReceivedRange parses a "Range: bytes=0-<last>" header and returns the number of bytes
+// which have been received by the file server.
+func parseReceivedRange(value string) (int64, error) {
+	if value == "" {
+		return 0, nil
+	}
+	last, found := strings.CutPrefix(value, "bytes=0-")
+	if !found {
+		return 0, fmt.Errorf("invalid Range header %q in response", value)
+	}
+	n, err := strconv.ParseInt(last, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid Range header %q in response: %w", value, err)
+	}
+	return n + 1, nil
+}
+
+func setAuthHeader(req *http.Request, serverAuth *cpv1b2.BundleServerAuthConfiguration) {
+	switch {
+	case serverAuth.BearerToken != "":
+		req.Header.Set("Authorization", "Bearer "+serverAuth.BearerToken)
+	case serverAuth.APIKey != "":
+		req.Header.Set(apiKeyHeader, serverAuth.APIKey)
+	case serverAuth.BasicAuthentication != nil:
+		req.SetBasicAuth(serverAuth.BasicAuthentication.Username, serverAuth.BasicAuthentication.Password)
+	}
+}
@@ -0,0 +1,256 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package supportbundlecollection
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cpv1b2 "antrea.io/antrea/pkg/apis/controlplane/v1beta2"
+)
+
+// fakeHTTPFileServer implements the resumable upload protocol expected by httpUploader. It can
+// be configured to fail the upload after receiving a number of chunks.
+type fakeHTTPFileServer struct {
+	mutex sync.Mutex
+	// files maps the path of each file to the bytes received so far.
+	files map[string][]byte
+	// completed tracks the files which have been fully uploaded.
+	completed   map[string]bool
+	failAfter   int
+	chunks      int
+	authHeaders []string
+	// discardChunks makes the server acknowledge chunks without persisting them.
+	discardChunks bool
+}
+
+func newFakeHTTPFileServer() *fakeHTTPFileServer {
+	return &fakeHTTPFileServer{
+		files:     map[string][]byte{},
+		completed: map[string]bool{},
+		failAfter: -1,
+	}
+}
+
+func (s *fakeHTTPFileServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if r.Method != http.MethodPut {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	s.authHeaders = append(s.authHeaders, r.Header.Get("Authorization")+r.Header.Get(apiKeyHeader))
+	body, _ := io.ReadAll(r.Body)
+	contentRange := r.Header.Get("Content-Range")
+	if contentRange == "" {
+		s.files[r.URL.Path] = body
+		s.completed[r.URL.Path] = true
+		w.WriteHeader(http.StatusCreated)
+		return
+	}
+	var size int64
+	if _, err := fmt.Sscanf(contentRange, "bytes */%d", &size); err == nil {
+		if s.completed[r.URL.Path] {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		s.writeResumeIncomplete(w, r.URL.Path)
+		return
+	}
+	var first, last int64
+	if _, err := fmt.Sscanf(contentRange, "bytes %d-%d/%d", &first, &last, &size); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	if s.failAfter >= 0 && s.chunks >= s.failAfter {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		return
+	}
+	s.chunks++
+	if s.discardChunks {
+		s.writeResumeIncomplete(w, r.URL.Path)
+		return
+	}
+	if first != int64(len(s.files[r.URL.Path])) {
+		w.WriteHeader(http.StatusRequestedRangeNotSatisfiable)
+		return
+	}
+	s.files[r.URL.Path] = append(s.files[r.URL.Path], body...)
+	if last+1 == size {
+		s.completed[r.URL.Path] = true
+		w.WriteHeader(http.StatusCreated)
+		return
+	}
+	s.writeResumeIncomplete(w, r.URL.Path)
+}
+
+func (s *fakeHTTPFileServer) writeResumeIncomplete(w http.ResponseWriter, path string) {
+	if received := len(s.files[path]); received > 0 {
+		w.Header().Set("Range", fmt.Sprintf("bytes=0-%d", received-1))
+	}
+	w.WriteHeader(statusResumeIncomplete)
+}
+
+func newTestBundleFile(t *testing.T, size int) (*bundleFile, []byte) {
+	content := bytes.Repeat([]byte("0123456789"), size/10+1)[:size]
+	bundle, err := newBundleFile(bytes.NewReader(content))
+	require.NoError(t, err)
+	return bundle, content
+}
+
+func TestHTTPUploader(t *testing.T) {
+	defer func(chunkSize int64) {
+		httpChunkSize = chunkSize
+	}(httpChunkSize)
+	httpChunkSize = 100
+
+	testCases := []struct {
+		name               string
+		size               int
+		serverAuth         *cpv1b2.BundleServerAuthConfiguration
+		failAfter          int
+		expectedAuthHeader string
+		expectedErr        string
+	}{
+		{
+			name:               "single request with bearer token",
+			size:               50,
+			serverAuth:         &cpv1b2.BundleServerAuthConfiguration{BearerToken: "token"},
+			failAfter:          -1,
+			expectedAuthHeader: "Bearer token",
+		},
+		{
+			name:               "chunked upload with API key",
+			size:               450,
+			serverAuth:         &cpv1b2.BundleServerAuthConfiguration{APIKey: "key"},
+			failAfter:          -1,
+			expectedAuthHeader: "key",
+		},
+		{
+			name:        "chunked upload interrupted",
+			size:        450,
+			serverAuth:  &cpv1b2.BundleServerAuthConfiguration{APIKey: "key"},
+			failAfter:   2,
+			expectedErr: "error when uploading bytes 200-299: unexpected response status from file server: 503 Service Unavailable",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			fileServer := newFakeHTTPFileServer()
+			fileServer.failAfter = tc.failAfter
+			server := httptest.NewTLSServer(fileServer)
+			defer server.Close()
+			serverURL, _ := url.Parse(server.URL + "/bundles")
+			uploader := &httpUploader{client: server.Client()}
+			bundle, content := newTestBundleFile(t, tc.size)
+
+			location, err := uploader.upload(serverURL, "vm1_b1.tar.gz", tc.serverAuth, bundle)
+			if tc.expectedErr != "" {
+				assert.EqualError(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, server.URL+"/bundles/vm1_b1.tar.gz", location)
+			assert.Equal(t, content, fileServer.files["/bundles/vm1_b1.tar.gz"])
+			for _, header := range fileServer.authHeaders {
+				assert.Equal(t, tc.expectedAuthHeader, header)
+			}
+		})
+	}
+}
+
+func TestHTTPUploaderResume(t *testing.T) {
+	defer func(chunkSize int64) {
+		httpChunkSize = chunkSize
+	}(httpChunkSize)
+	httpChunkSize = 100
+
+	fileServer := newFakeHTTPFileServer()
+	fileServer.failAfter = 2
+	server := httptest.NewTLSServer(fileServer)
+	defer server.Close()
+	serverURL, _ := url.Parse(server.URL)
+	uploader := &httpUploader{client: server.Client()}
+	bundle, content := newTestBundleFile(t, 450)
+	serverAuth := &cpv1b2.BundleServerAuthConfiguration{BearerToken: "token"}
+
+	_, err := uploader.upload(serverURL, "vm1_b1.tar.gz", serverAuth, bundle)
+	require.Error(t, err)
+	assert.Len(t, fileServer.files["/vm1_b1.tar.gz"], 200)
+
+	// The second attempt only uploads the remaining chunks.
+	fileServer.failAfter = -1
+	fileServer.chunks = 0
+	_, err = uploader.upload(serverURL, "vm1_b1.tar.gz", serverAuth, bundle)
+	require.NoError(t, err)
+	assert.Equal(t, 3, fileServer.chunks)
+	assert.Equal(t, content, fileServer.files["/vm1_b1.tar.gz"])
+
+	// A completed upload is not uploaded again.
+	fileServer.chunks = 0
+	_, err = uploader.upload(serverURL, "vm1_b1.tar.gz", serverAuth, bundle)
+	require.NoError(t, err)
+	assert.Equal(t, 0, fileServer.chunks)
+}
+
+func TestHTTPUploaderWithoutProgress(t *testing.T) {
+	defer func(chunkSize int64) {
+		httpChunkSize = chunkSize
+	}(httpChunkSize)
+	httpChunkSize = 100
+
+	fileServer := newFakeHTTPFileServer()
+	fileServer.discardChunks = true
+	server := httptest.NewTLSServer(fileServer)
+	defer server.Close()
+	serverURL, _ := url.Parse(server.URL)
+	uploader := &httpUploader{client: server.Client()}
+	bundle, _ := newTestBundleFile(t, 450)
+
+	_, err := uploader.upload(serverURL, "vm1_b1.tar.gz", &cpv1b2.BundleServerAuthConfiguration{}, bundle)
+	assert.EqualError(t, err, "file server did not make progress after 3 chunks, 0 bytes received")
+	assert.Equal(t, maxHTTPUploadStalls, fileServer.chunks)
+}
+
+func TestParseReceivedRange(t *testing.T) {
+	for _, tc := range []struct {
+		value       string
+		expected    int64
+		expectedErr string
+	}{
+		{value: "", expected: 0},
+		{value: "bytes=0-99", expected: 100},
+		{value: "bytes=100-199", expectedErr: `invalid Range header "bytes=100-199" in response`},
+		{value: "bytes=0-x", expectedErr: `invalid Range header "bytes=0-x" in response`},
+	} {
+		received, err := parseReceivedRange(tc.value)
+		if tc.expectedErr != "" {
+			assert.True(t, strings.HasPrefix(err.Error(), tc.expectedErr))
+			continue
+		}
+		require.NoError(t, err)
+		assert.Equal(t, tc.expected, received)
+	}
+}
@@ -0,0 +1,239 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package supportbundlecollection
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"path"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"k8s.io/klog/v2"
+
+	cpv1b2 "antrea.io/antrea/pkg/apis/controlplane/v1beta2"
+)
+
+// s3PartSize is the size of the parts of multipart uploads. Bundle files which are not larger
+// than one part are uploaded with a single PutObject request. S3 requires all parts except the
+// last one to be at least 5 MiB. Declared as variable for testing.
+var s3PartSize int64 = 8 * 1024 * 1024
+
+const (
+	defaultS3Region = "us-east-1"
+	// s3ChecksumMetadataKey is the key of the user-defined object metadata which stores the
+	// SHA-256 digest of the bundle file.
+	s3ChecksumMetadataKey = "sha256"
+)
+
+// s3API is the subset of the S3 API used to upload bundle files. It is defined to assist unit
+// testing.
+type s3API interface {
+	PutObject(ctx context.Context, params *s3.PutObjectInput, optFns ...func(*s3.Options)) (*s3.PutObjectOutput, error)
+	CreateMultipartUpload(ctx context.Context, params *s3.CreateMultipartUploadInput, optFns ...func(*s3.Options)) (*s3.CreateMultipartUploadOutput, error)
+	UploadPart(ctx context.Context, params *s3.UploadPartInput, optFns ...func(*s3.Options)) (*s3.UploadPartOutput, error)
+	ListParts(ctx context.Context, params *s3.ListPartsInput, optFns ...func(*s3.Options)) (*s3.ListPartsOutput, error)
+	CompleteMultipartUpload(ctx context.Context, params *s3.CompleteMultipartUploadInput, optFns ...func(*s3.Options)) (*s3.CompleteMultipartUploadOutput, error)
+	AbortMultipartUpload(ctx context.Context, params *s3.AbortMultipartUploadInput, optFns ...func(*s3.Options)) (*s3.AbortMultipartUploadOutput, error)
+}
+
+func newS3Client(region, endpoint, accessKeyID, secretAccessKey string) s3API {
+	options := s3.Options{
+		Region: region,
+		Credentials: aws.CredentialsProviderFunc(func(ctx context.Context) (aws.Credentials, error) {
+			return aws.Credentials{AccessKeyID: accessKeyID, SecretAccessKey: secretAccessKey}, nil
+		}),
+	}
+	if endpoint != "" {
+		// S3-compatible object storages usually do not support virtual-hosted-style requests.
+		options.EndpointResolver = s3.EndpointResolverFromURL(endpoint)
+		options.UsePathStyle = true
+	}
+	return s3.New(options)
+}
+
+// multipartUpload is an in-progress multipart upload. It is kept across upload attempts so
+// that a failed upload can be resumed from the last uploaded part.
+type multipartUpload struct {
+	uploadID string
+	checksum string
+}
+
+type s3Uploader struct {
+	// newClient is declared as a field for testing.
+	newClient func(region, endpoint, accessKeyID, secretAccessKey string) s3API
+	mutex     sync.Mutex
+	// multipartUploads maps "<bucket>/<key>" to the in-progress multipart upload of the object.
+	multipartUploads map[string]*multipartUpload
+}
+
+func newS3Uploader() *s3Uploader {
+	return &s3Uploader{
+		newClient:        newS3Client,
+		multipartUploads: map[string]*multipartUpload{},
+	}
+}
+
+// upload uploads the bundle file to s3://<bucket>/<prefix>/<fileName>. The access key ID and
+// the secret access key are read from the basic authentication username and password.
+func (u *s3Uploader) upload(fileServer *url.URL, fileName string, serverAuth *cpv1b2.BundleServerAuthConfiguration, bundle *bundleFile) (string, error) {
+	if serverAuth.BasicAuthentication == nil {
+		return "", fmt.Errorf("BasicAuthentication is required to upload support bundle to S3")
+	}
+	query := fileServer.Query()
+	region := query.Get("region")
+	if region == "" {
+		region = defaultS3Region
+	}
+	client := u.newClient(region, query.Get("endpoint"), serverAuth.BasicAuthentication.Username, serverAuth.BasicAuthentication.Password)
+	bucket := fileServer.Host
+	key := strings.TrimPrefix(path.Join(fileServer.Path, fileName), "/")
+	location := fmt.Sprintf("s3://%s/%s", bucket, key)
+
+	ctx := context.TODO()
+	if bundle.size <= s3PartSize {
+		if _, err := bundle.Seek(0, io.SeekStart); err != nil {
+			return "", err
+		}
+		if _, err := client.PutObject(ctx, &s3.PutObjectInput{
+			Bucket:        aws.String(bucket),
+			Key:           aws.String(key),
+			Body:          io.LimitReader(bundle, bundle.size),
+			ContentLength: bundle.size,
+			Metadata:      map[string]string{s3ChecksumMetadataKey: bundle.checksum},
+		}); err != nil {
+			return "", fmt.Errorf("error when putting object %s: %w", location, err)
+		}
+		return location, nil
+	}
+	if err := u.multipartUpload(ctx, client, bucket, key, bundle); err != nil {
+		return "", fmt.Errorf("error when uploading object %s: %w", location, err)
+	}
+	return location, nil
+}
+
+func (u *s3Uploader) getMultipartUpload(ctx context.Context, client s3API, bucket, key, checksum string) (string, error) {
+	u.mutex.Lock()
+	defer u.mutex.Unlock()
+	uploadKey := bucket + "/" + key
+	if mu, ok := u.multipartUploads[uploadKey]; ok {
+		if mu.checksum == checksum {
+			return mu.uploadID, nil
+		}
+		// The previous upload of this object is for another bundle file and can never be
+		// completed.
+		if _, err := client.AbortMultipartUpload(ctx, &s3.AbortMultipartUploadInput{
+			Bucket:   aws.String(bucket),
+			Key:      aws.String(key),
+			UploadId: aws.String(mu.uploadID),
+		}); err != nil {
+			klog.ErrorS(err, "Failed to abort stale multipart upload", "bucket", bucket, "key", key, "uploadID", mu.uploadID)
+		}
+		delete(u.multipartUploads, uploadKey)
+	}
+	output, err := client.CreateMultipartUpload(ctx, &s3.CreateMultipartUploadInput{
+		Bucket:   aws.String(bucket),
+		Key:      aws.String(key),
+		Metadata: map[string]string{s3ChecksumMetadataKey: checksum},
+	})
+	if err != nil {
+		return "", fmt.Errorf("error when creating multipart upload: %w", err)
+	}
+	u.multipartUploads[uploadKey] = &multipartUpload{uploadID: aws.ToString(output.UploadId), checksum: checksum}
+	return aws.ToString(output.UploadId), nil
+}
+
+func (u *s3Uploader) deleteMultipartUpload(bucket, key string) {
+	u.mutex.Lock()
+	defer u.mutex.Unlock()
+	delete(u.multipartUploads, bucket+"/"+key)
+}
+
+// multipartUpload uploads the bundle file in parts of s3PartSize. The parts which have been
+// uploaded by a previous attempt are skipped.
+func (u *s3Uploader) multipartUpload(ctx context.Context, client s3API, bucket, key string, bundle *bundleFile) error {
+	uploadID, err := u.getMultipartUpload(ctx, client, bucket, key, bundle.checksum)
+	if err != nil {
+		return err
+	}
+	uploadedParts := map[int32]s3types.Part{}
+	listInput := &s3.ListPartsInput{
+		Bucket:   aws.String(bucket),
+		Key:      aws.String(key),
+		UploadId: aws.String(uploadID),
+	}
+	for {
+		output, err := client.ListParts(ctx, listInput)
+		if err != nil {
+			var notFound *s3types.NoSuchUpload
+			if errors.As(err, &notFound) {
+				// The upload has been aborted or cleaned up, e.g. by a bucket lifecycle rule.
+				u.deleteMultipartUpload(bucket, key)
+			}
+			return fmt.Errorf("error when listing uploaded parts: %w", err)
+		}
+		for _, part := range output.Parts {
+			uploadedParts[part.PartNumber] = part
+		}
+		if !output.IsTruncated {
+			break
+		}
+		listInput.PartNumberMarker = output.NextPartNumberMarker
+	}
+
+	var completedParts []s3types.CompletedPart
+	for offset, partNumber := int64(0), int32(1); offset < bundle.size; offset, partNumber = offset+s3PartSize, partNumber+1 {
+		partSize := bundle.size - offset
+		if partSize > s3PartSize {
+			partSize = s3PartSize
+		}
+		if part, ok := uploadedParts[partNumber]; ok && part.Size == partSize {
+			klog.V(2).InfoS("Skipping uploaded part", "bucket", bucket, "key", key, "part", partNumber)
+			completedParts = append(completedParts, s3types.CompletedPart{ETag: part.ETag, PartNumber: partNumber})
+			continue
+		}
+		if _, err := bundle.Seek(offset, io.SeekStart); err != nil {
+			return err
+		}
+		output, err := client.UploadPart(ctx, &s3.UploadPartInput{
+			Bucket:        aws.String(bucket),
+			Key:           aws.String(key),
+			UploadId:      aws.String(uploadID),
+			PartNumber:    partNumber,
+			Body:          io.LimitReader(bundle, partSize),
+			ContentLength: partSize,
+		})
+		if err != nil {
+			return fmt.Errorf("error when uploading part %d: %w", partNumber, err)
+		}
+		completedParts = append(completedParts, s3types.CompletedPart{ETag: output.ETag, PartNumber: partNumber})
+	}
+	if _, err := client.CompleteMultipartUpload(ctx, &s3.CompleteMultipartUploadInput{
+		Bucket:          aws.String(bucket),
+		Key:             aws.String(key),
+		UploadId:        aws.String(uploadID),
+		MultipartUpload: &s3types.CompletedMultipartUpload{Parts: completedParts},
+	}); err != nil {
+		return fmt.Errorf("error when completing multipart upload: %w", err)
+	}
+	u.deleteMultipartUpload(bucket, key)
+	return nil
+}
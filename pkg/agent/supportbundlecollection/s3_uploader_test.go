@@ -0,0 +1,262 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package supportbundlecollection
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+	"sort"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cpv1b2 "antrea.io/antrea/pkg/apis/controlplane/v1beta2"
+)
+
+// fakeS3 is an in-memory S3 implementation. It can be configured to fail part uploads after a
+// number of parts have been uploaded.
+type fakeS3 struct {
+	region   string
+	endpoint string
+	objects  map[string][]byte
+	metadata map[string]map[string]string
+	// uploads maps each upload ID to its uploaded parts.
+	uploads       map[string]map[int32][]byte
+	uploadCount   int
+	failAfter     int
+	uploadedParts int
+}
+
+func newFakeS3() *fakeS3 {
+	return &fakeS3{
+		objects:   map[string][]byte{},
+		metadata:  map[string]map[string]string{},
+		uploads:   map[string]map[int32][]byte{},
+		failAfter: -1,
+	}
+}
+
+func (f *fakeS3) PutObject(ctx context.Context, params *s3.PutObjectInput, optFns ...func(*s3.Options)) (*s3.PutObjectOutput, error) {
+	data, _ := io.ReadAll(params.Body)
+	key := aws.ToString(params.Bucket) + "/" + aws.ToString(params.Key)
+	f.objects[key] = data
+	f.metadata[key] = params.Metadata
+	return &s3.PutObjectOutput{}, nil
+}
+
+func (f *fakeS3) CreateMultipartUpload(ctx context.Context, params *s3.CreateMultipartUploadInput, optFns ...func(*s3.Options)) (*s3.CreateMultipartUploadOutput, error) {
+	f.uploadCount++
+	uploadID := fmt.Sprintf("upload-%d", f.uploadCount)
+	f.uploads[uploadID] = map[int32][]byte{}
+	f.metadata[aws.ToString(params.Bucket)+"/"+aws.ToString(params.Key)] = params.Metadata
+	return &s3.CreateMultipartUploadOutput{UploadId: aws.String(uploadID)}, nil
+}
+
+func (f *fakeS3) UploadPart(ctx context.Context, params *s3.UploadPartInput, optFns ...func(*s3.Options)) (*s3.UploadPartOutput, error) {
+	if f.failAfter >= 0 && f.uploadedParts >= f.failAfter {
+		return nil, fmt.Errorf("connection reset by peer")
+	}
+	parts, ok := f.uploads[aws.ToString(params.UploadId)]
+	if !ok {
+		return nil, &s3types.NoSuchUpload{}
+	}
+	data, _ := io.ReadAll(params.Body)
+	parts[params.PartNumber] = data
+	f.uploadedParts++
+	return &s3.UploadPartOutput{ETag: aws.String(fmt.Sprintf("etag-%d", params.PartNumber))}, nil
+}
+
+func (f *fakeS3) ListParts(ctx context.Context, params *s3.ListPartsInput, optFns ...func(*s3.Options)) (*s3.ListPartsOutput, error) {
+	parts, ok := f.uploads[aws.ToString(params.UploadId)]
+	if !ok {
+		return nil, &s3types.NoSuchUpload{}
+	}
+	output := &s3.ListPartsOutput{}
+	for partNumber, data := range parts {
+		output.Parts = append(output.Parts, s3types.Part{
+			PartNumber: partNumber,
+			Size:       int64(len(data)),
+			ETag:       aws.String(fmt.Sprintf("etag-%d", partNumber)),
+		})
+	}
+	sort.Slice(output.Parts, func(i, j int) bool {
+		return output.Parts[i].PartNumber < output.Parts[j].PartNumber
+	})
+	return output, nil
+}
+
+func (f *fakeS3) CompleteMultipartUpload(ctx context.Context, params *s3.CompleteMultipartUploadInput, optFns ...func(*s3.Options)) (*s3.CompleteMultipartUploadOutput, error) {
+	parts, ok := f.uploads[aws.ToString(params.UploadId)]
+	if !ok {
+		return nil, &s3types.NoSuchUpload{}
+	}
+	var data []byte
+	for _, part := range params.MultipartUpload.Parts {
+		data = append(data, parts[part.PartNumber]...)
+	}
+	f.objects[aws.ToString(params.Bucket)+"/"+aws.ToString(params.Key)] = data
+	delete(f.uploads, aws.ToString(params.UploadId))
+	return &s3.CompleteMultipartUploadOutput{}, nil
+}
+
+func (f *fakeS3) AbortMultipartUpload(ctx context.Context, params *s3.AbortMultipartUploadInput, optFns ...func(*s3.Options)) (*s3.AbortMultipartUploadOutput, error) {
+	delete(f.uploads, aws.ToString(params.UploadId))
+	return &s3.AbortMultipartUploadOutput{}, nil
+}
+
+func newTestS3Uploader(client *fakeS3) *s3Uploader {
+	uploader := newS3Uploader()
+	uploader.newClient = func(region, endpoint, accessKeyID, secretAccessKey string) s3API {
+		client.region = region
+		client.endpoint = endpoint
+		return client
+	}
+	return uploader
+}
+
+func TestS3Uploader(t *testing.T) {
+	defer func(partSize int64) {
+		s3PartSize = partSize
+	}(s3PartSize)
+	s3PartSize = 100
+	serverAuth := &cpv1b2.BundleServerAuthConfiguration{
+		BasicAuthentication: &cpv1b2.BasicAuthentication{Username: "access-key", Password: "secret-key"},
+	}
+
+	testCases := []struct {
+		name             string
+		url              string
+		size             int
+		serverAuth       *cpv1b2.BundleServerAuthConfiguration
+		expectedRegion   string
+		expectedEndpoint string
+		expectedLocation string
+		expectedErr      string
+	}{
+		{
+			name:             "single part",
+			url:              "s3://bundles",
+			size:             50,
+			serverAuth:       serverAuth,
+			expectedRegion:   defaultS3Region,
+			expectedLocation: "s3://bundles/vm1_b1.tar.gz",
+		},
+		{
+			name:             "multipart with custom endpoint",
+			url:              "s3://bundles/antrea?region=us-west-2&endpoint=https://minio.example.com:9000",
+			size:             450,
+			serverAuth:       serverAuth,
+			expectedRegion:   "us-west-2",
+			expectedEndpoint: "https://minio.example.com:9000",
+			expectedLocation: "s3://bundles/antrea/vm1_b1.tar.gz",
+		},
+		{
+			name:        "without basic authentication",
+			url:         "s3://bundles",
+			size:        50,
+			serverAuth:  &cpv1b2.BundleServerAuthConfiguration{BearerToken: "token"},
+			expectedErr: "BasicAuthentication is required to upload support bundle to S3",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			client := newFakeS3()
+			uploader := newTestS3Uploader(client)
+			fileServer, _ := url.Parse(tc.url)
+			bundle, content := newTestBundleFile(t, tc.size)
+
+			location, err := uploader.upload(fileServer, "vm1_b1.tar.gz", tc.serverAuth, bundle)
+			if tc.expectedErr != "" {
+				assert.EqualError(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedLocation, location)
+			assert.Equal(t, tc.expectedRegion, client.region)
+			assert.Equal(t, tc.expectedEndpoint, client.endpoint)
+			objectKey := location[len("s3://"):]
+			assert.Equal(t, content, client.objects[objectKey])
+			assert.Equal(t, map[string]string{s3ChecksumMetadataKey: bundle.checksum}, client.metadata[objectKey])
+			assert.Empty(t, client.uploads)
+			assert.Empty(t, uploader.multipartUploads)
+		})
+	}
+}
+
+func TestS3UploaderResume(t *testing.T) {
+	defer func(partSize int64) {
+		s3PartSize = partSize
+	}(s3PartSize)
+	s3PartSize = 100
+	serverAuth := &cpv1b2.BundleServerAuthConfiguration{
+		BasicAuthentication: &cpv1b2.BasicAuthentication{Username: "access-key", Password: "secret-key"},
+	}
+	fileServer, _ := url.Parse("s3://bundles")
+	client := newFakeS3()
+	client.failAfter = 2
+	uploader := newTestS3Uploader(client)
+	bundle, content := newTestBundleFile(t, 450)
+
+	_, err := uploader.upload(fileServer, "vm1_b1.tar.gz", serverAuth, bundle)
+	require.ErrorContains(t, err, "error when uploading part 3")
+	require.Len(t, uploader.multipartUploads, 1)
+
+	// The second attempt reuses the multipart upload and only uploads the remaining parts.
+	client.failAfter = -1
+	client.uploadedParts = 0
+	_, err = uploader.upload(fileServer, "vm1_b1.tar.gz", serverAuth, bundle)
+	require.NoError(t, err)
+	assert.Equal(t, 1, client.uploadCount)
+	assert.Equal(t, 3, client.uploadedParts)
+	assert.Equal(t, content, client.objects["bundles/vm1_b1.tar.gz"])
+	assert.Empty(t, uploader.multipartUploads)
+}
+
+func TestS3UploaderAbortStaleUpload(t *testing.T) {
+	defer func(partSize int64) {
+		s3PartSize = partSize
+	}(s3PartSize)
+	s3PartSize = 100
+	serverAuth := &cpv1b2.BundleServerAuthConfiguration{
+		BasicAuthentication: &cpv1b2.BasicAuthentication{Username: "access-key", Password: "secret-key"},
+	}
+	fileServer, _ := url.Parse("s3://bundles")
+	client := newFakeS3()
+	client.failAfter = 1
+	uploader := newTestS3Uploader(client)
+	oldBundle, _ := newTestBundleFile(t, 450)
+	_, err := uploader.upload(fileServer, "vm1_b1.tar.gz", serverAuth, oldBundle)
+	require.Error(t, err)
+
+	// A different bundle file with the same name cannot reuse the parts of the previous upload.
+	client.failAfter = -1
+	client.uploadedParts = 0
+	newContent := bytes.Repeat([]byte("a"), 300)
+	newBundle, err := newBundleFile(bytes.NewReader(newContent))
+	require.NoError(t, err)
+	_, err = uploader.upload(fileServer, "vm1_b1.tar.gz", serverAuth, newBundle)
+	require.NoError(t, err)
+	assert.Equal(t, 2, client.uploadCount)
+	assert.Equal(t, 3, client.uploadedParts)
+	assert.Equal(t, newContent, client.objects["bundles/vm1_b1.tar.gz"])
+	assert.Empty(t, client.uploads)
+}
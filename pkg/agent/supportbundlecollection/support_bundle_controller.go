@@ -16,6 +16,8 @@ package supportbundlecollection
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"io"
 	"net/url"
@@ -49,7 +51,9 @@ import (
 type ProtocolType string
 
 const (
-	sftpProtocol ProtocolType = "sftp"
+	sftpProtocol  ProtocolType = support.FileServerSchemeSFTP
+	s3Protocol    ProtocolType = support.FileServerSchemeS3
+	httpsProtocol ProtocolType = support.FileServerSchemeHTTPS
 
 	controllerName = "SupportBundleCollectionController"
 
@@ -79,6 +83,8 @@ type SupportBundleController struct {
 	v4Enabled                    bool
 	v6Enabled                    bool
 	sftpUploader                 uploader
+	s3Uploader                   uploader
+	httpsUploader                uploader
 }
 
 func NewSupportBundleController(nodeName string,
@@ -102,6 +108,8 @@ func NewSupportBundleController(nodeName string,
 		v4Enabled:             v4Enabled,
 		v6Enabled:             v6Enabled,
 		sftpUploader:          &sftpUploader{},
+		s3Uploader:            newS3Uploader(),
+		httpsUploader:         newHTTPUploader(),
 	}
 	return c
 }
@@ -224,57 +232,71 @@ func (c *SupportBundleController) syncSupportBundleCollection(key string) error
 		return nil
 	}
 
-	err := c.generateSupportBundle(supportBundle)
+	uploaded, err := c.generateSupportBundle(supportBundle)
 	if err != nil {
-		if updateErr := c.updateSupportBundleCollectionStatus(key, false, err); updateErr != nil {
+		if updateErr := c.updateSupportBundleCollectionStatus(key, false, nil, err); updateErr != nil {
 			return fmt.Errorf("failed to update failed collection status: %w", updateErr)
 		}
 		return fmt.Errorf("failed to generate support bundle: %w", err)
 	}
-	if updateErr := c.updateSupportBundleCollectionStatus(key, true, err); updateErr != nil {
+	if updateErr := c.updateSupportBundleCollectionStatus(key, true, uploaded, err); updateErr != nil {
 		return fmt.Errorf("failed to update complete collection status: %w", updateErr)
 	}
 
 	return nil
 }
 
-func (c *SupportBundleController) generateSupportBundle(supportBundle *cpv1b2.SupportBundleCollection) error {
+// uploadedBundle is a bundle file which has been uploaded to the file server.
+type uploadedBundle struct {
+	location string
+	// checksum is in the format "<algorithm>:<hex digest>".
+	checksum string
+}
+
+func (c *SupportBundleController) generateSupportBundle(supportBundle *cpv1b2.SupportBundleCollection) (*uploadedBundle, error) {
 	klog.V(2).InfoS("Generating support bundle collection", "name", supportBundle.Name)
 	basedir, err := afero.TempDir(defaultFS, "", "bundle_tmp_")
 	if err != nil {
-		return fmt.Errorf("error when creating temp dir: %w", err)
+		return nil, fmt.Errorf("error when creating temp dir: %w", err)
 	}
 	defer defaultFS.RemoveAll(basedir)
 
-	agentDumper := newAgentDumper(defaultFS, defaultExecutor, c.ovsCtlClient, c.aq, c.npq, supportBundle.SinceTime, c.v4Enabled, c.v6Enabled)
-	if err = agentDumper.DumpLog(basedir); err != nil {
-		return err
-	}
-	if err = agentDumper.DumpHostNetworkInfo(basedir); err != nil {
-		return err
-	}
-	if err = agentDumper.DumpFlows(basedir); err != nil {
-		return err
+	if err = support.ValidateProfile(supportBundle.Profile); err != nil {
+		return nil, err
 	}
-	if err = agentDumper.DumpNetworkPolicyResources(basedir); err != nil {
-		return err
+	// The seed is generated by antrea-controller for the collection, so that the pseudonyms are
+	// consistent across all the Nodes of the collection while they can't be reversed by users
+	// who can read the SupportBundleCollection.
+	if (len(supportBundle.RedactionPatterns) > 0 || supportBundle.AnonymizeIPs) && supportBundle.RedactionSeed == "" {
+		return nil, fmt.Errorf("no redaction seed provided for the collection")
 	}
-	if err = agentDumper.DumpAgentInfo(basedir); err != nil {
-		return err
-	}
-	if err = agentDumper.DumpHeapPprof(basedir); err != nil {
-		return err
+	redactor, err := support.NewRedactor(supportBundle.RedactionPatterns, supportBundle.AnonymizeIPs, supportBundle.RedactionSeed)
+	if err != nil {
+		return nil, err
 	}
-	if err = agentDumper.DumpGoroutinePprof(basedir); err != nil {
-		return err
+	agentDumper := newAgentDumper(defaultFS, defaultExecutor, c.ovsCtlClient, c.aq, c.npq, supportBundle.SinceTime, c.v4Enabled, c.v6Enabled)
+	dumps := support.SelectDumps(supportBundle.Profile,
+		support.ItemDump{Item: support.ItemLogs, Dump: agentDumper.DumpLog},
+		support.ItemDump{Item: support.ItemHostNetwork, Dump: agentDumper.DumpHostNetworkInfo},
+		support.ItemDump{Item: support.ItemFlows, Dump: agentDumper.DumpFlows},
+		support.ItemDump{Item: support.ItemNetworkPolicies, Dump: agentDumper.DumpNetworkPolicyResources},
+		support.ItemDump{Item: support.ItemInfo, Dump: agentDumper.DumpAgentInfo},
+		support.ItemDump{Item: support.ItemPprof, Dump: agentDumper.DumpHeapPprof},
+		support.ItemDump{Item: support.ItemPprof, Dump: agentDumper.DumpGoroutinePprof},
+		support.ItemDump{Item: support.ItemOVSPorts, Dump: agentDumper.DumpOVSPorts},
+	)
+	for _, dump := range dumps {
+		if err = dump(basedir); err != nil {
+			return nil, err
+		}
 	}
-	if err = agentDumper.DumpOVSPorts(basedir); err != nil {
-		return err
+	if err = redactor.RedactDir(defaultFS, basedir); err != nil {
+		return nil, fmt.Errorf("error when redacting support bundle: %w", err)
 	}
 
 	outputFile, err := afero.TempFile(defaultFS, "", "bundle_*.tar.gz")
 	if err != nil {
-		return fmt.Errorf("error when creating temp file: %w", err)
+		return nil, fmt.Errorf("error when creating temp file: %w", err)
 	}
 	defer func() {
 		if err = outputFile.Close(); err != nil {
@@ -287,121 +309,150 @@ func (c *SupportBundleController) generateSupportBundle(supportBundle *cpv1b2.Su
 	}()
 	klog.V(2).InfoS("Compressing support bundle collection", "name", supportBundle.Name)
 	if _, err = compress.PackDir(defaultFS, basedir, outputFile); err != nil {
-		return fmt.Errorf("error when packaging support bundle: %w", err)
+		return nil, fmt.Errorf("error when packaging support bundle: %w", err)
 	}
 
 	return c.uploadSupportBundle(supportBundle, outputFile)
 }
 
-func (c *SupportBundleController) uploadSupportBundle(supportBundle *cpv1b2.SupportBundleCollection, outputFile afero.File) error {
+func (c *SupportBundleController) uploadSupportBundle(supportBundle *cpv1b2.SupportBundleCollection, outputFile afero.File) (*uploadedBundle, error) {
 	klog.V(2).InfoS("Uploading support bundle collection", "name", supportBundle.Name)
-	uploader, err := c.getUploaderByProtocol(sftpProtocol)
+	// fileServer.URL should be like: 10.92.23.154:22/path, sftp://10.92.23.154:22/path,
+	// s3://bucket/path?region=us-west-2 or https://example.com/path
+	parsedURL, err := support.ParseFileServerURL(supportBundle.FileServer.URL)
 	if err != nil {
-		return fmt.Errorf("failed to upload support bundle while getting uploader: %v", err)
+		return nil, fmt.Errorf("failed to upload support bundle while parsing upload URL: %v", err)
 	}
-	if _, err := outputFile.Seek(0, 0); err != nil {
-		return fmt.Errorf("failed to upload support bundle to file server while setting offset: %v", err)
+	uploader, err := c.getUploaderByProtocol(ProtocolType(parsedURL.Scheme))
+	if err != nil {
+		return nil, fmt.Errorf("failed to upload support bundle while getting uploader: %v", err)
 	}
-	// fileServer.URL should be like: 10.92.23.154:22/path or sftp://10.92.23.154:22/path
-	parsedURL, err := parseUploadUrl(supportBundle.FileServer.URL)
+	bundle, err := newBundleFile(outputFile)
 	if err != nil {
-		return fmt.Errorf("failed to upload support bundle while parsing upload URL: %v", err)
+		return nil, fmt.Errorf("failed to upload support bundle while computing checksum: %v", err)
 	}
+	fileName := c.nodeName + "_" + supportBundle.Name + ".tar.gz"
 	triesLeft := uploadToFileServerTries
-	var uploadErr error
 	for triesLeft > 0 {
-		if uploadErr = c.uploadToFileServer(uploader, supportBundle.Name, parsedURL, &supportBundle.Authentication, outputFile); uploadErr == nil {
-			return nil
+		location, uploadErr := uploader.upload(parsedURL, fileName, &supportBundle.Authentication, bundle)
+		if uploadErr == nil {
+			klog.InfoS("Uploaded support bundle", "location", location, "checksum", bundle.checksum)
+			return &uploadedBundle{location: location, checksum: "sha256:" + bundle.checksum}, nil
 		}
 		triesLeft--
 		if triesLeft == 0 {
-			return fmt.Errorf("failed to upload support bundle after %d attempts", uploadToFileServerTries)
+			return nil, fmt.Errorf("failed to upload support bundle after %d attempts", uploadToFileServerTries)
 		}
 		klog.InfoS("Failed to upload support bundle", "UploadError", uploadErr, "TriesLeft", triesLeft)
 		time.Sleep(uploadToFileServerRetryDelay)
 	}
-	return nil
+	return nil, nil
 }
 
-func parseUploadUrl(uploadUrl string) (*url.URL, error) {
-	parsedURL, err := url.Parse(uploadUrl)
-	if err != nil {
-		parsedURL, err = url.Parse("sftp://" + uploadUrl)
-		if err != nil {
-			return nil, err
-		}
-	}
-	if parsedURL.Scheme != "sftp" {
-		return nil, fmt.Errorf("not sftp protocol")
+func (c *SupportBundleController) getUploaderByProtocol(protocol ProtocolType) (uploader, error) {
+	switch protocol {
+	case sftpProtocol:
+		return c.sftpUploader, nil
+	case s3Protocol:
+		return c.s3Uploader, nil
+	case httpsProtocol:
+		return c.httpsUploader, nil
 	}
-	return parsedURL, nil
+	return nil, fmt.Errorf("unsupported protocol %s", protocol)
 }
 
-func (c *SupportBundleController) uploadToFileServer(up uploader, bundleName string, parsedURL *url.URL, serverAuth *cpv1b2.BundleServerAuthConfiguration, tarGzFile io.Reader) error {
-	joinedPath := path.Join(parsedURL.Path, c.nodeName+"_"+bundleName+".tar.gz")
-	cfg := &ssh.ClientConfig{
-		User: serverAuth.BasicAuthentication.Username,
-		Auth: []ssh.AuthMethod{ssh.Password(serverAuth.BasicAuthentication.Password)},
-		// #nosec G106: skip host key check here and users can specify their own checks if needed
-		HostKeyCallback: ssh.InsecureIgnoreHostKey(),
-		Timeout:         time.Second,
-	}
-	return up.upload(parsedURL.Host, joinedPath, cfg, tarGzFile)
+// bundleFile is a packaged support bundle file to be uploaded to the file server.
+type bundleFile struct {
+	io.ReadSeeker
+	size int64
+	// checksum is the hex-encoded SHA-256 digest of the file.
+	checksum string
 }
 
-func (c *SupportBundleController) getUploaderByProtocol(protocol ProtocolType) (uploader, error) {
-	if protocol == sftpProtocol {
-		return c.sftpUploader, nil
+func newBundleFile(file io.ReadSeeker) (*bundleFile, error) {
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return nil, err
 	}
-	return nil, fmt.Errorf("unsupported protocol %s", protocol)
+	hash := sha256.New()
+	size, err := io.Copy(hash, file)
+	if err != nil {
+		return nil, err
+	}
+	return &bundleFile{
+		ReadSeeker: file,
+		size:       size,
+		checksum:   hex.EncodeToString(hash.Sum(nil)),
+	}, nil
 }
 
 type uploader interface {
-	upload(addr string, path string, config *ssh.ClientConfig, tarGzFile io.Reader) error
+	// upload uploads the bundle file with the provided name to the file server, and returns the
+	// URL of the uploaded file. Uploaders should resume the upload of a bundle file which has
+	// been partially uploaded by a previous attempt whenever the file server supports it.
+	upload(fileServer *url.URL, fileName string, serverAuth *cpv1b2.BundleServerAuthConfiguration, bundle *bundleFile) (string, error)
 }
 
 type sftpUploader struct {
 }
 
-func (uploader *sftpUploader) upload(address string, path string, config *ssh.ClientConfig, tarGzFile io.Reader) error {
-	conn, err := ssh.Dial("tcp", address, config)
+func (uploader *sftpUploader) upload(fileServer *url.URL, fileName string, serverAuth *cpv1b2.BundleServerAuthConfiguration, bundle *bundleFile) (string, error) {
+	if serverAuth.BasicAuthentication == nil {
+		return "", fmt.Errorf("BasicAuthentication is required to upload support bundle with sftp")
+	}
+	config := &ssh.ClientConfig{
+		User: serverAuth.BasicAuthentication.Username,
+		Auth: []ssh.AuthMethod{ssh.Password(serverAuth.BasicAuthentication.Password)},
+		// #nosec G106: skip host key check here and users can specify their own checks if needed
+		HostKeyCallback: ssh.InsecureIgnoreHostKey(),
+		Timeout:         time.Second,
+	}
+	filePath := path.Join(fileServer.Path, fileName)
+	if _, err := bundle.Seek(0, io.SeekStart); err != nil {
+		return "", err
+	}
+	conn, err := ssh.Dial("tcp", fileServer.Host, config)
 	if err != nil {
-		return fmt.Errorf("error when connecting to fs server: %w", err)
+		return "", fmt.Errorf("error when connecting to fs server: %w", err)
 	}
 	sftpClient, err := sftp.NewClient(conn)
 	if err != nil {
-		return fmt.Errorf("error when setting up sftp client: %w", err)
+		return "", fmt.Errorf("error when setting up sftp client: %w", err)
 	}
 	defer func() {
 		if err := sftpClient.Close(); err != nil {
 			klog.ErrorS(err, "Error when closing sftp client")
 		}
 	}()
-	targetFile, err := sftpClient.Create(path)
+	targetFile, err := sftpClient.Create(filePath)
 	if err != nil {
-		return fmt.Errorf("error when creating target file on remote: %v", err)
+		return "", fmt.Errorf("error when creating target file on remote: %v", err)
 	}
 	defer func() {
 		if err := targetFile.Close(); err != nil {
 			klog.ErrorS(err, "Error when closing target file on remote")
 		}
 	}()
-	if written, err := io.Copy(targetFile, tarGzFile); err != nil {
-		return fmt.Errorf("error when copying target file: %v, written: %d", err, written)
+	if written, err := io.Copy(targetFile, io.LimitReader(bundle, bundle.size)); err != nil {
+		return "", fmt.Errorf("error when copying target file: %v, written: %d", err, written)
 	}
-	klog.InfoS("Successfully upload file to path", "filePath", path)
-	return nil
+	klog.InfoS("Successfully upload file to path", "filePath", filePath)
+	location := url.URL{Scheme: support.FileServerSchemeSFTP, Host: fileServer.Host, Path: filePath}
+	return location.String(), nil
 }
 
-func (c *SupportBundleController) updateSupportBundleCollectionStatus(key string, complete bool, genErr error) error {
+func (c *SupportBundleController) updateSupportBundleCollectionStatus(key string, complete bool, uploaded *uploadedBundle, genErr error) error {
 	antreaClient, err := c.antreaClientGetter.GetAntreaClient()
 	if err != nil {
 		return fmt.Errorf("failed to get antrea client: %w", err)
 	}
-	var errMsg string
+	var errMsg, location, checksum string
 	if genErr != nil {
 		errMsg = genErr.Error()
 	}
+	if uploaded != nil {
+		location = uploaded.location
+		checksum = uploaded.checksum
+	}
 	if updateErr := antreaClient.ControlplaneV1beta2().SupportBundleCollections().UpdateStatus(context.TODO(), key, &cpv1b2.SupportBundleCollectionStatus{
 		ObjectMeta: metav1.ObjectMeta{
 			Name: key,
@@ -413,6 +464,8 @@ func (c *SupportBundleController) updateSupportBundleCollectionStatus(key string
 				NodeType:      string(c.supportBundleNodeType),
 				Completed:     complete,
 				Error:         errMsg,
+				Checksum:      checksum,
+				Location:      location,
 			},
 		},
 	}); updateErr != nil {
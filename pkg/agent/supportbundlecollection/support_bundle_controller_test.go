@@ -16,13 +16,15 @@ package supportbundlecollection
 
 import (
 	"fmt"
-	"io"
+	"net/url"
+	"path"
+	"strings"
 	"testing"
 
 	"github.com/spf13/afero"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
-	"golang.org/x/crypto/ssh"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	k8stesting "k8s.io/client-go/testing"
@@ -68,6 +70,7 @@ func TestSupportBundleCollectionAdd(t *testing.T) {
 		name                    string
 		supportBundleCollection *cpv1b2.SupportBundleCollection
 		expectedCompleted       bool
+		expectedLocation        string
 		agentDumper             *mockAgentDumper
 		uploader                uploader
 	}{
@@ -75,6 +78,7 @@ func TestSupportBundleCollectionAdd(t *testing.T) {
 			name:                    "Add SupportBundleCollection",
 			supportBundleCollection: generateSupportbundleCollection("supportBundle1", "sftp://10.220.175.92:22/root/supportbundle"),
 			expectedCompleted:       true,
+			expectedLocation:        "sftp://10.220.175.92:22/root/supportbundle/vm1_supportBundle1.tar.gz",
 			agentDumper:             &mockAgentDumper{},
 			uploader:                &testUploader{},
 		},
@@ -87,11 +91,27 @@ func TestSupportBundleCollectionAdd(t *testing.T) {
 		},
 		{
 			name:                    "Add SupportBundleCollection with unsupported url prefix",
-			supportBundleCollection: generateSupportbundleCollection("supportBundle3", "https://10.220.175.92:22/root/supportbundle"),
+			supportBundleCollection: generateSupportbundleCollection("supportBundle3", "ftp://10.220.175.92:22/root/supportbundle"),
 			expectedCompleted:       false,
 			agentDumper:             &mockAgentDumper{},
 			uploader:                &testUploader{},
 		},
+		{
+			name:                    "Add SupportBundleCollection with s3 url",
+			supportBundleCollection: generateSupportbundleCollection("supportBundle16", "s3://bundles/antrea?region=us-west-2"),
+			expectedCompleted:       true,
+			expectedLocation:        "s3://bundles/antrea/vm1_supportBundle16.tar.gz",
+			agentDumper:             &mockAgentDumper{},
+			uploader:                &testUploader{},
+		},
+		{
+			name:                    "Add SupportBundleCollection with https url",
+			supportBundleCollection: generateSupportbundleCollection("supportBundle17", "https://10.220.175.92:8443/supportbundle"),
+			expectedCompleted:       true,
+			expectedLocation:        "https://10.220.175.92:8443/supportbundle/vm1_supportBundle17.tar.gz",
+			agentDumper:             &mockAgentDumper{},
+			uploader:                &testUploader{},
+		},
 		{
 			name:                    "Add SupportBundleCollection with retry logics",
 			supportBundleCollection: generateSupportbundleCollection("supportBundle4", "10.220.175.92:22/root/supportbundle"),
@@ -155,6 +175,38 @@ func TestSupportBundleCollectionAdd(t *testing.T) {
 			agentDumper:             &mockAgentDumper{dumpGoroutinePprofErr: fmt.Errorf("failed to dump goroutine Pprof")},
 			uploader:                &testUploader{},
 		},
+		{
+			name: "SupportBundleCollection with perf profile does not dump log",
+			supportBundleCollection: withProfileAndRedaction(generateSupportbundleCollection("supportBundle13", "sftp://10.220.175.92:22/root/supportbundle"),
+				support.ProfilePerf, []string{"customer-[a-z]+"}, true),
+			expectedCompleted: true,
+			agentDumper:       &mockAgentDumper{dumpLogErr: fmt.Errorf("failed to dump log")},
+			uploader:          &testUploader{},
+		},
+		{
+			name: "SupportBundleCollection with unsupported profile",
+			supportBundleCollection: withProfileAndRedaction(generateSupportbundleCollection("supportBundle14", "sftp://10.220.175.92:22/root/supportbundle"),
+				"foo", nil, false),
+			expectedCompleted: false,
+			agentDumper:       &mockAgentDumper{},
+			uploader:          &testUploader{},
+		},
+		{
+			name: "SupportBundleCollection with invalid redaction pattern",
+			supportBundleCollection: withProfileAndRedaction(generateSupportbundleCollection("supportBundle15", "sftp://10.220.175.92:22/root/supportbundle"),
+				"", []string{"customer-("}, false),
+			expectedCompleted: false,
+			agentDumper:       &mockAgentDumper{},
+			uploader:          &testUploader{},
+		},
+		{
+			name: "SupportBundleCollection with redaction but without seed",
+			supportBundleCollection: withoutRedactionSeed(withProfileAndRedaction(generateSupportbundleCollection("supportBundle16", "sftp://10.220.175.92:22/root/supportbundle"),
+				"", nil, true)),
+			expectedCompleted: false,
+			agentDumper:       &mockAgentDumper{},
+			uploader:          &testUploader{},
+		},
 	}
 
 	for _, tt := range testcases {
@@ -167,6 +219,8 @@ func TestSupportBundleCollectionAdd(t *testing.T) {
 			}()
 			controller, clientset := newFakeController(t)
 			controller.sftpUploader = tt.uploader
+			controller.s3Uploader = tt.uploader
+			controller.httpsUploader = tt.uploader
 			var bundleStatus *cpv1b2.SupportBundleCollectionStatus
 			clientset.AddReactor("update", "supportbundlecollections/status", k8stesting.ReactionFunc(func(action k8stesting.Action) (bool, runtime.Object, error) {
 				bundleStatus = action.(k8stesting.UpdateAction).GetObject().(*cpv1b2.SupportBundleCollectionStatus)
@@ -176,6 +230,12 @@ func TestSupportBundleCollectionAdd(t *testing.T) {
 			controller.addSupportBundleCollection(tt.supportBundleCollection)
 			controller.syncSupportBundleCollection(tt.supportBundleCollection.Name)
 			assert.Equal(t, tt.expectedCompleted, bundleStatus.Nodes[0].Completed)
+			if tt.expectedCompleted {
+				assert.True(t, strings.HasPrefix(bundleStatus.Nodes[0].Checksum, "sha256:"))
+			}
+			if tt.expectedLocation != "" {
+				assert.Equal(t, tt.expectedLocation, bundleStatus.Nodes[0].Location)
+			}
 		})
 	}
 }
@@ -191,17 +251,25 @@ func TestSupportBundleCollectionDelete(t *testing.T) {
 type testUploader struct {
 }
 
-func (uploader *testUploader) upload(address string, path string, config *ssh.ClientConfig, tarGzFile io.Reader) error {
+func (uploader *testUploader) upload(fileServer *url.URL, fileName string, serverAuth *cpv1b2.BundleServerAuthConfiguration, bundle *bundleFile) (string, error) {
 	klog.Info("Called test uploader")
-	return nil
+	location := url.URL{Scheme: fileServer.Scheme, Host: fileServer.Host, Path: path.Join(fileServer.Path, fileName)}
+	return location.String(), nil
 }
 
 type testFailedUploader struct {
 }
 
-func (uploader *testFailedUploader) upload(address string, path string, config *ssh.ClientConfig, tarGzFile io.Reader) error {
+func (uploader *testFailedUploader) upload(fileServer *url.URL, fileName string, serverAuth *cpv1b2.BundleServerAuthConfiguration, bundle *bundleFile) (string, error) {
 	klog.Info("Called test uploader for failed case")
-	return fmt.Errorf("uploader failed")
+	return "", fmt.Errorf("uploader failed")
+}
+
+func TestNewBundleFile(t *testing.T) {
+	bundle, err := newBundleFile(strings.NewReader("hello"))
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), bundle.size)
+	assert.Equal(t, "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824", bundle.checksum)
 }
 
 func generateSupportbundleCollection(name string, url string) *cpv1b2.SupportBundleCollection {
@@ -222,6 +290,21 @@ func generateSupportbundleCollection(name string, url string) *cpv1b2.SupportBun
 	}
 }
 
+func withProfileAndRedaction(collection *cpv1b2.SupportBundleCollection, profile string, patterns []string, anonymizeIPs bool) *cpv1b2.SupportBundleCollection {
+	collection.Profile = profile
+	collection.RedactionPatterns = patterns
+	collection.AnonymizeIPs = anonymizeIPs
+	if len(patterns) > 0 || anonymizeIPs {
+		collection.RedactionSeed = "0123456789abcdef0123456789abcdef"
+	}
+	return collection
+}
+
+func withoutRedactionSeed(collection *cpv1b2.SupportBundleCollection) *cpv1b2.SupportBundleCollection {
+	collection.RedactionSeed = ""
+	return collection
+}
+
 type mockAgentDumper struct {
 	dumpLogErr                    error
 	dumpFlowsErr                  error
@@ -24,6 +24,27 @@ const (
 	// NodeWireGuardPublicAnnotationKey represents the key of the Node's WireGuard public key in the Annotations of the Node.
 	NodeWireGuardPublicAnnotationKey string = "node.antrea.io/wireguard-public-key"
 
+	// NodeWireGuardPublicKeyCertificateAnnotationKey represents the key of the certificate issued by antrea-controller
+	// for the Node's WireGuard public key in the Annotations of the Node.
+	NodeWireGuardPublicKeyCertificateAnnotationKey string = "node.antrea.io/wireguard-public-key-certificate"
+
+	// NodeWireGuardPublicKeyActivationTimeAnnotationKey represents the key of the time (in RFC3339 format) at which
+	// the Node's current WireGuard public key took effect in the Annotations of the Node. It is used to schedule the
+	// next key rotation across antrea-agent restarts.
+	NodeWireGuardPublicKeyActivationTimeAnnotationKey string = "node.antrea.io/wireguard-public-key-activation-time"
+
+	// NodeWireGuardNextPublicKeyAnnotationKey represents the key of the WireGuard public key the Node is going to
+	// rotate to in the Annotations of the Node.
+	NodeWireGuardNextPublicKeyAnnotationKey string = "node.antrea.io/wireguard-next-public-key"
+
+	// NodeWireGuardNextPublicKeyCertificateAnnotationKey represents the key of the certificate issued by
+	// antrea-controller for the Node's next WireGuard public key in the Annotations of the Node.
+	NodeWireGuardNextPublicKeyCertificateAnnotationKey string = "node.antrea.io/wireguard-next-public-key-certificate"
+
+	// NodeWireGuardNextPublicKeyActivationTimeAnnotationKey represents the key of the time (in RFC3339 format) at
+	// which the Node's next WireGuard public key takes effect in the Annotations of the Node.
+	NodeWireGuardNextPublicKeyActivationTimeAnnotationKey string = "node.antrea.io/wireguard-next-public-key-activation-time"
+
 	// NodeMaxEgressIPsAnnotationKey represents the key of maximum Egress IP number in the Annotations of the Node.
 	NodeMaxEgressIPsAnnotationKey string = "node.antrea.io/max-egress-ips"
 
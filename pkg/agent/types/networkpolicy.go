@@ -15,6 +15,8 @@
 package types
 
 import (
+	"time"
+
 	"k8s.io/apimachinery/pkg/util/sets"
 
 	"antrea.io/antrea/pkg/apis/controlplane/v1beta2"
@@ -104,6 +106,8 @@ type PolicyRule struct {
 	PolicyRef     *v1beta2.NetworkPolicyReference
 	EnableLogging bool
 	LogLabel      string
+	// RateLimit is only set for rules with the RateLimit action.
+	RateLimit *v1beta2.RuleRateLimit
 }
 
 // IsAntreaNetworkPolicyRule returns if a PolicyRule is created for Antrea NetworkPolicy types.
@@ -156,12 +160,30 @@ func (bp ByPriority) Less(i, j int) bool { return bp[i].Less(bp[j]) }
 
 type RuleMetric struct {
 	Bytes, Packets, Sessions uint64
+	// RateLimitedPackets is the number of packets dropped by the rate limit of a rule with the RateLimit action.
+	RateLimitedPackets uint64
 }
 
 func (m *RuleMetric) Merge(m1 *RuleMetric) {
 	m.Bytes += m1.Bytes
 	m.Packets += m1.Packets
 	m.Sessions += m1.Sessions
+	m.RateLimitedPackets += m1.RateLimitedPackets
+}
+
+// AuditRecord describes the traffic which would have been denied by a rule of an Antrea-native policy in Audit mode.
+type AuditRecord struct {
+	PolicyRef       string
+	RuleName        string
+	Direction       string
+	Disposition     string
+	SourceIP        string
+	DestinationIP   string
+	Protocol        string
+	DestinationPort string
+	// Count is the number of audited connections.
+	Count    int64
+	LastSeen time.Time
 }
 
 // A BitRange is a representation of a range of values from base value with a
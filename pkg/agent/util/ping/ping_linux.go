@@ -0,0 +1,120 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ping
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"sync/atomic"
+	"time"
+
+	"golang.org/x/net/icmp"
+	"golang.org/x/net/ipv4"
+	"golang.org/x/sys/unix"
+)
+
+const (
+	ipv4HeaderLen    = 20
+	ipv4MaxHeaderLen = 60
+	icmpHeaderLen    = 8
+)
+
+var (
+	echoID = os.Getpid() & 0xffff
+	// echoSeq makes the echo requests sent by concurrent callers distinguishable, as
+	// every raw ICMP socket receives all the echo replies.
+	echoSeq uint32
+)
+
+// Echo sends an ICMP echo request to the IPv4 address dstIP and waits for the reply until
+// the timeout expires. size is the size of the IP packet carrying the request, which has
+// the Don't Fragment flag set, so the request is dropped instead of fragmented when it
+// exceeds the path MTU. It returns the round-trip time of the request.
+func Echo(dstIP net.IP, size int, timeout time.Duration) (time.Duration, error) {
+	if dstIP.To4() == nil {
+		return 0, fmt.Errorf("IPv6 address %s is not supported", dstIP)
+	}
+	if size < ipv4HeaderLen+icmpHeaderLen {
+		return 0, fmt.Errorf("packet size %d is smaller than the ICMP echo request header", size)
+	}
+	conn, err := net.ListenPacket("ip4:icmp", "0.0.0.0")
+	if err != nil {
+		return 0, fmt.Errorf("failed to create ICMP socket: %w", err)
+	}
+	defer conn.Close()
+	rawConn, err := conn.(*net.IPConn).SyscallConn()
+	if err != nil {
+		return 0, err
+	}
+	var sockErr error
+	if err := rawConn.Control(func(fd uintptr) {
+		// IP_PMTUDISC_PROBE sets the Don't Fragment flag and ignores the path MTU cached
+		// in the kernel, which may be out of date.
+		sockErr = unix.SetsockoptInt(int(fd), unix.IPPROTO_IP, unix.IP_MTU_DISCOVER, unix.IP_PMTUDISC_PROBE)
+	}); err != nil {
+		return 0, err
+	}
+	if sockErr != nil {
+		return 0, fmt.Errorf("failed to set Don't Fragment flag on ICMP socket: %w", sockErr)
+	}
+
+	seq := int(atomic.AddUint32(&echoSeq, 1) & 0xffff)
+	request, err := newEchoRequest(echoID, seq, size-ipv4HeaderLen-icmpHeaderLen)
+	if err != nil {
+		return 0, err
+	}
+	if err := conn.SetDeadline(time.Now().Add(timeout)); err != nil {
+		return 0, err
+	}
+	start := time.Now()
+	if _, err := conn.WriteTo(request, &net.IPAddr{IP: dstIP}); err != nil {
+		return 0, err
+	}
+	buf := make([]byte, size+ipv4MaxHeaderLen)
+	for {
+		n, peer, err := conn.ReadFrom(buf)
+		if err != nil {
+			return 0, err
+		}
+		if peerAddr, ok := peer.(*net.IPAddr); !ok || !peerAddr.IP.Equal(dstIP) {
+			continue
+		}
+		if isEchoReply(buf[:n], echoID, seq) {
+			return time.Since(start), nil
+		}
+	}
+}
+
+func newEchoRequest(id, seq, dataLen int) ([]byte, error) {
+	msg := icmp.Message{
+		Type: ipv4.ICMPTypeEcho,
+		Body: &icmp.Echo{
+			ID:   id,
+			Seq:  seq,
+			Data: make([]byte, dataLen),
+		},
+	}
+	return msg.Marshal(nil)
+}
+
+func isEchoReply(b []byte, id, seq int) bool {
+	msg, err := icmp.ParseMessage(ipv4.ICMPTypeEchoReply.Protocol(), b)
+	if err != nil || msg.Type != ipv4.ICMPTypeEchoReply {
+		return false
+	}
+	echo, ok := msg.Body.(*icmp.Echo)
+	return ok && echo.ID == id && echo.Seq == seq
+}
@@ -0,0 +1,75 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ping
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/icmp"
+	"golang.org/x/net/ipv4"
+)
+
+func TestNewEchoRequest(t *testing.T) {
+	b, err := newEchoRequest(100, 1, 1472)
+	require.NoError(t, err)
+	assert.Len(t, b, 1480)
+	msg, err := icmp.ParseMessage(ipv4.ICMPTypeEcho.Protocol(), b)
+	require.NoError(t, err)
+	assert.Equal(t, ipv4.ICMPTypeEcho, msg.Type)
+	assert.Equal(t, &icmp.Echo{ID: 100, Seq: 1, Data: make([]byte, 1472)}, msg.Body)
+}
+
+func TestIsEchoReply(t *testing.T) {
+	newMessage := func(typ ipv4.ICMPType, id, seq int) []byte {
+		msg := icmp.Message{Type: typ, Body: &icmp.Echo{ID: id, Seq: seq, Data: []byte("data")}}
+		b, err := msg.Marshal(nil)
+		require.NoError(t, err)
+		return b
+	}
+	tests := []struct {
+		name     string
+		message  []byte
+		expected bool
+	}{
+		{
+			name:     "matching reply",
+			message:  newMessage(ipv4.ICMPTypeEchoReply, 100, 1),
+			expected: true,
+		},
+		{
+			name:    "request",
+			message: newMessage(ipv4.ICMPTypeEcho, 100, 1),
+		},
+		{
+			name:    "reply with different ID",
+			message: newMessage(ipv4.ICMPTypeEchoReply, 101, 1),
+		},
+		{
+			name:    "reply with different sequence",
+			message: newMessage(ipv4.ICMPTypeEchoReply, 100, 2),
+		},
+		{
+			name:    "invalid message",
+			message: []byte{0},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, isEchoReply(tt.message, 100, 1))
+		})
+	}
+}
@@ -59,10 +59,14 @@ var (
 type client struct {
 	wgClient                wgctrlClient
 	nodeName                string
-	privateKey              wgtypes.Key
 	peerPublicKeyByNodeName *sync.Map
 	wireGuardConfig         *config.WireGuardConfig
 	gatewayConfig           *config.GatewayConfig
+	// keyMutex protects privateKey and nextPrivateKey, which are rotated by the WireGuard key controller while
+	// other goroutines may configure the device, and serializes the key updates of the device.
+	keyMutex       sync.Mutex
+	privateKey     wgtypes.Key
+	nextPrivateKey wgtypes.Key
 }
 
 func New(nodeConfig *config.NodeConfig, wireGuardConfig *config.WireGuardConfig) (Interface, error) {
@@ -139,6 +143,8 @@ func (client *client) Init(ipv4 net.IP, ipv6 net.IP) (string, error) {
 	if err != nil {
 		return "", err
 	}
+	client.keyMutex.Lock()
+	defer client.keyMutex.Unlock()
 	client.privateKey = wgDev.PrivateKey
 	// WireGuard private key will be persistent across agent restarts. So we only need to
 	// generate a new private key if it is empty (all zero).
@@ -253,6 +259,35 @@ func (client *client) DeletePeer(nodeName string) error {
 	return nil
 }
 
+func (client *client) GenerateNextKey() (string, error) {
+	newPkey, err := wgtypes.GeneratePrivateKey()
+	if err != nil {
+		return "", err
+	}
+	client.keyMutex.Lock()
+	defer client.keyMutex.Unlock()
+	client.nextPrivateKey = newPkey
+	return newPkey.PublicKey().String(), nil
+}
+
+func (client *client) ActivateNextKey() (string, error) {
+	client.keyMutex.Lock()
+	defer client.keyMutex.Unlock()
+	if client.nextPrivateKey == zeroKey {
+		return "", fmt.Errorf("no next WireGuard private key generated")
+	}
+	cfg := wgtypes.Config{
+		PrivateKey:   &client.nextPrivateKey,
+		ReplacePeers: false,
+	}
+	if err := client.wgClient.ConfigureDevice(client.wireGuardConfig.Name, cfg); err != nil {
+		return "", err
+	}
+	client.privateKey = client.nextPrivateKey
+	client.nextPrivateKey = zeroKey
+	return client.privateKey.PublicKey().String(), nil
+}
+
 func (client *client) CleanUp() error {
 	if err := netlink.LinkDel(&netlink.Device{
 		LinkAttrs: netlink.LinkAttrs{
@@ -33,7 +33,8 @@ import (
 )
 
 type fakeWireGuardClient struct {
-	peers map[wgtypes.Key]wgtypes.Peer
+	privateKey wgtypes.Key
+	peers      map[wgtypes.Key]wgtypes.Peer
 }
 
 func (f *fakeWireGuardClient) Close() error {
@@ -50,11 +51,15 @@ func (f *fakeWireGuardClient) Device(name string) (*wgtypes.Device, error) {
 		res = append(res, p)
 	}
 	return &wgtypes.Device{
-		Peers: res,
+		PrivateKey: f.privateKey,
+		Peers:      res,
 	}, nil
 }
 
 func (f *fakeWireGuardClient) ConfigureDevice(name string, cfg wgtypes.Config) error {
+	if cfg.PrivateKey != nil {
+		f.privateKey = *cfg.PrivateKey
+	}
 	for _, c := range cfg.Peers {
 		if c.Remove {
 			delete(f.peers, c.PublicKey)
@@ -470,3 +475,58 @@ func Test_Init(t *testing.T) {
 		})
 	}
 }
+
+func Test_RotateKey(t *testing.T) {
+	client := getFakeClient()
+	wgClient := &fakeWireGuardClient{peers: map[wgtypes.Key]wgtypes.Peer{}}
+	client.wgClient = wgClient
+	currentKey, err := wgtypes.GeneratePrivateKey()
+	require.NoError(t, err)
+	client.privateKey = currentKey
+	wgClient.privateKey = currentKey
+
+	_, err = client.ActivateNextKey()
+	assert.EqualError(t, err, "no next WireGuard private key generated")
+
+	nextPublicKey, err := client.GenerateNextKey()
+	require.NoError(t, err)
+	assert.NotEqual(t, currentKey.PublicKey().String(), nextPublicKey)
+	// Generating the next key must not affect the key in use.
+	assert.Equal(t, currentKey, wgClient.privateKey)
+
+	activatedPublicKey, err := client.ActivateNextKey()
+	require.NoError(t, err)
+	assert.Equal(t, nextPublicKey, activatedPublicKey)
+	assert.Equal(t, nextPublicKey, wgClient.privateKey.PublicKey().String())
+	assert.Equal(t, wgClient.privateKey, client.privateKey)
+
+	_, err = client.ActivateNextKey()
+	assert.Error(t, err)
+}
+
+func Test_RotateKeyConcurrently(t *testing.T) {
+	client := getFakeClient()
+	wgClient := &fakeWireGuardClient{peers: map[wgtypes.Key]wgtypes.Peer{}}
+	client.wgClient = wgClient
+
+	// The key controller generates and activates keys while the agent may initialize the device, which must not
+	// race with each other (run with -race).
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			_, err := client.GenerateNextKey()
+			assert.NoError(t, err)
+		}()
+		go func() {
+			defer wg.Done()
+			// An error is expected if there is no next key to activate.
+			client.ActivateNextKey() //nolint:errcheck
+		}()
+	}
+	wg.Wait()
+	client.keyMutex.Lock()
+	defer client.keyMutex.Unlock()
+	assert.Equal(t, wgClient.privateKey, client.privateKey)
+}
@@ -32,6 +32,12 @@ type Interface interface {
 	RemoveStalePeers(currentPeerPublickeys map[string]string) error
 	// DeletePeer deletes the WireGuard peer by Node name.
 	DeletePeer(nodeName string) error
+	// GenerateNextKey generates the private key which the WireGuard device will switch to upon the next call of
+	// ActivateNextKey, and returns its public key. The previously generated next key, if any, is discarded.
+	GenerateNextKey() (string, error)
+	// ActivateNextKey configures the WireGuard device with the private key generated by GenerateNextKey, and returns
+	// its public key.
+	ActivateNextKey() (string, error)
 	// CleanUp cleans the network interface on the host created by WireGuard client.
 	CleanUp() error
 }
@@ -0,0 +1,59 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package wireguard
+
+import (
+	"crypto/x509"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"antrea.io/antrea/pkg/agent/types"
+	wgutil "antrea.io/antrea/pkg/util/wireguard"
+)
+
+// PeerPublicKey returns the WireGuard public key to use for the provided peer Node at the provided time, based on the
+// Node's annotations. An empty key is returned if the Node hasn't published any key yet. If the Node has announced a
+// next public key which is not active yet, the activation time of the next key is returned as well, so that the caller
+// can switch to it at the same time as the peer Node does.
+// If roots is not nil, the returned public key must be certified by one of them for the peer Node, otherwise an error
+// is returned.
+func PeerPublicKey(node *corev1.Node, roots *x509.CertPool, now time.Time) (string, time.Time, error) {
+	publicKey := node.Annotations[types.NodeWireGuardPublicAnnotationKey]
+	certificate := node.Annotations[types.NodeWireGuardPublicKeyCertificateAnnotationKey]
+	var nextActivationTime time.Time
+	if nextPublicKey := node.Annotations[types.NodeWireGuardNextPublicKeyAnnotationKey]; nextPublicKey != "" {
+		// The next key is ignored if its activation time is invalid, as it's unclear when the peer Node switches to it.
+		if activationTime, err := time.Parse(time.RFC3339, node.Annotations[types.NodeWireGuardNextPublicKeyActivationTimeAnnotationKey]); err == nil {
+			if now.Before(activationTime) {
+				nextActivationTime = activationTime
+			} else {
+				publicKey = nextPublicKey
+				certificate = node.Annotations[types.NodeWireGuardNextPublicKeyCertificateAnnotationKey]
+			}
+		}
+	}
+	if publicKey == "" || roots == nil {
+		return publicKey, nextActivationTime, nil
+	}
+	if certificate == "" {
+		return "", nextActivationTime, fmt.Errorf("WireGuard public key of Node %s is not certified", node.Name)
+	}
+	if err := wgutil.VerifyPublicKeyCertificate([]byte(certificate), roots, node.Name, publicKey, now); err != nil {
+		return "", nextActivationTime, fmt.Errorf("invalid certificate for WireGuard public key of Node %s: %w", node.Name, err)
+	}
+	return publicKey, nextActivationTime, nil
+}
@@ -0,0 +1,219 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package wireguard
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.zx2c4.com/wireguard/wgctrl/wgtypes"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	certutil "k8s.io/client-go/util/cert"
+
+	"antrea.io/antrea/pkg/agent/types"
+	wgutil "antrea.io/antrea/pkg/util/wireguard"
+)
+
+type testCA struct {
+	certificate *x509.Certificate
+	key         *ecdsa.PrivateKey
+}
+
+func newTestCA(t *testing.T, now time.Time) *testCA {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "antrea-wireguard-ca"},
+		NotBefore:             now.Add(-time.Hour),
+		NotAfter:              now.Add(24 * time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	certificate, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return &testCA{certificate: certificate, key: key}
+}
+
+func (ca *testCA) certify(t *testing.T, nodeName, publicKey string, now time.Time) string {
+	csrPEM, err := wgutil.NewPublicKeyCertificateRequest(nodeName, publicKey)
+	require.NoError(t, err)
+	block, _ := pem.Decode(csrPEM)
+	req, err := x509.ParseCertificateRequest(block.Bytes)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      req.Subject,
+		URIs:         req.URIs,
+		NotBefore:    now.Add(-time.Minute),
+		NotAfter:     now.Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.certificate, req.PublicKey, ca.key)
+	require.NoError(t, err)
+	return string(pem.EncodeToMemory(&pem.Block{Type: certutil.CertificateBlockType, Bytes: der}))
+}
+
+func newTestPublicKey(t *testing.T) string {
+	privateKey, err := wgtypes.GeneratePrivateKey()
+	require.NoError(t, err)
+	return privateKey.PublicKey().String()
+}
+
+func TestPeerPublicKey(t *testing.T) {
+	now := time.Now().UTC().Truncate(time.Second)
+	ca := newTestCA(t, now)
+	roots := x509.NewCertPool()
+	roots.AddCert(ca.certificate)
+	currentKey := newTestPublicKey(t)
+	nextKey := newTestPublicKey(t)
+	currentCertificate := ca.certify(t, "node-1", currentKey, now)
+	nextCertificate := ca.certify(t, "node-1", nextKey, now)
+	activationTime := now.Add(time.Minute)
+
+	tests := []struct {
+		name                       string
+		annotations                map[string]string
+		roots                      *x509.CertPool
+		now                        time.Time
+		expectedPublicKey          string
+		expectedNextActivationTime time.Time
+		expectedErr                string
+	}{
+		{
+			name:        "no public key",
+			annotations: map[string]string{},
+			roots:       roots,
+			now:         now,
+		},
+		{
+			name: "current key without verification",
+			annotations: map[string]string{
+				types.NodeWireGuardPublicAnnotationKey: currentKey,
+			},
+			now:               now,
+			expectedPublicKey: currentKey,
+		},
+		{
+			name: "current key without certificate",
+			annotations: map[string]string{
+				types.NodeWireGuardPublicAnnotationKey: currentKey,
+			},
+			roots:       roots,
+			now:         now,
+			expectedErr: "WireGuard public key of Node node-1 is not certified",
+		},
+		{
+			name: "current key with certificate",
+			annotations: map[string]string{
+				types.NodeWireGuardPublicAnnotationKey:               currentKey,
+				types.NodeWireGuardPublicKeyCertificateAnnotationKey: currentCertificate,
+			},
+			roots:             roots,
+			now:               now,
+			expectedPublicKey: currentKey,
+		},
+		{
+			name: "current key with certificate of another key",
+			annotations: map[string]string{
+				types.NodeWireGuardPublicAnnotationKey:               currentKey,
+				types.NodeWireGuardPublicKeyCertificateAnnotationKey: nextCertificate,
+			},
+			roots:       roots,
+			now:         now,
+			expectedErr: "invalid certificate for WireGuard public key of Node node-1",
+		},
+		{
+			name: "next key not active yet",
+			annotations: map[string]string{
+				types.NodeWireGuardPublicAnnotationKey:                      currentKey,
+				types.NodeWireGuardPublicKeyCertificateAnnotationKey:        currentCertificate,
+				types.NodeWireGuardNextPublicKeyAnnotationKey:               nextKey,
+				types.NodeWireGuardNextPublicKeyCertificateAnnotationKey:    nextCertificate,
+				types.NodeWireGuardNextPublicKeyActivationTimeAnnotationKey: activationTime.Format(time.RFC3339),
+			},
+			roots:                      roots,
+			now:                        now,
+			expectedPublicKey:          currentKey,
+			expectedNextActivationTime: activationTime,
+		},
+		{
+			name: "next key active",
+			annotations: map[string]string{
+				types.NodeWireGuardPublicAnnotationKey:                      currentKey,
+				types.NodeWireGuardPublicKeyCertificateAnnotationKey:        currentCertificate,
+				types.NodeWireGuardNextPublicKeyAnnotationKey:               nextKey,
+				types.NodeWireGuardNextPublicKeyCertificateAnnotationKey:    nextCertificate,
+				types.NodeWireGuardNextPublicKeyActivationTimeAnnotationKey: activationTime.Format(time.RFC3339),
+			},
+			roots:             roots,
+			now:               activationTime,
+			expectedPublicKey: nextKey,
+		},
+		{
+			name: "next key active without certificate",
+			annotations: map[string]string{
+				types.NodeWireGuardPublicAnnotationKey:                      currentKey,
+				types.NodeWireGuardPublicKeyCertificateAnnotationKey:        currentCertificate,
+				types.NodeWireGuardNextPublicKeyAnnotationKey:               nextKey,
+				types.NodeWireGuardNextPublicKeyActivationTimeAnnotationKey: activationTime.Format(time.RFC3339),
+			},
+			roots:       roots,
+			now:         activationTime.Add(time.Second),
+			expectedErr: "WireGuard public key of Node node-1 is not certified",
+		},
+		{
+			name: "next key with invalid activation time",
+			annotations: map[string]string{
+				types.NodeWireGuardPublicAnnotationKey:                      currentKey,
+				types.NodeWireGuardNextPublicKeyAnnotationKey:               nextKey,
+				types.NodeWireGuardNextPublicKeyActivationTimeAnnotationKey: "invalid",
+			},
+			now:               now,
+			expectedPublicKey: currentKey,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			node := &corev1.Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "node-1",
+					Annotations: tt.annotations,
+				},
+			}
+			publicKey, nextActivationTime, err := PeerPublicKey(node, tt.roots, tt.now)
+			if tt.expectedErr != "" {
+				assert.ErrorContains(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedPublicKey, publicKey)
+			assert.Equal(t, tt.expectedNextActivationTime, nextActivationTime)
+		})
+	}
+}
@@ -53,6 +53,21 @@ func (m *MockInterface) EXPECT() *MockInterfaceMockRecorder {
 	return m.recorder
 }
 
+// ActivateNextKey mocks base method.
+func (m *MockInterface) ActivateNextKey() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ActivateNextKey")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ActivateNextKey indicates an expected call of ActivateNextKey.
+func (mr *MockInterfaceMockRecorder) ActivateNextKey() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ActivateNextKey", reflect.TypeOf((*MockInterface)(nil).ActivateNextKey))
+}
+
 // CleanUp mocks base method.
 func (m *MockInterface) CleanUp() error {
 	m.ctrl.T.Helper()
@@ -81,6 +96,21 @@ func (mr *MockInterfaceMockRecorder) DeletePeer(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePeer", reflect.TypeOf((*MockInterface)(nil).DeletePeer), arg0)
 }
 
+// GenerateNextKey mocks base method.
+func (m *MockInterface) GenerateNextKey() (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateNextKey")
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GenerateNextKey indicates an expected call of GenerateNextKey.
+func (mr *MockInterfaceMockRecorder) GenerateNextKey() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateNextKey", reflect.TypeOf((*MockInterface)(nil).GenerateNextKey))
+}
+
 // Init mocks base method.
 func (m *MockInterface) Init(arg0, arg1 net.IP) (string, error) {
 	m.ctrl.T.Helper()
@@ -19,6 +19,7 @@ import (
 	"reflect"
 
 	"antrea.io/antrea/pkg/agent/apiserver/handlers/agentinfo"
+	"antrea.io/antrea/pkg/agent/apiserver/handlers/auditreport"
 	"antrea.io/antrea/pkg/agent/apiserver/handlers/memberlist"
 	"antrea.io/antrea/pkg/agent/apiserver/handlers/multicast"
 	"antrea.io/antrea/pkg/agent/apiserver/handlers/ovsflows"
@@ -41,6 +42,7 @@ import (
 	"antrea.io/antrea/pkg/antctl/transform/version"
 	cpv1beta "antrea.io/antrea/pkg/apis/controlplane/v1beta2"
 	systemv1beta1 "antrea.io/antrea/pkg/apis/system/v1beta1"
+	"antrea.io/antrea/pkg/apiserver/handlers/policyanalysis"
 	controllerinforest "antrea.io/antrea/pkg/apiserver/registry/system/controllerinfo"
 	"antrea.io/antrea/pkg/client/clientset/versioned/scheme"
 	controllernetworkpolicy "antrea.io/antrea/pkg/controller/networkpolicy"
@@ -603,6 +605,68 @@ $ antctl get podmulticaststats pod -n namespace`,
 			},
 			transformedResponse: reflect.TypeOf(memberlist.Response{}),
 		},
+		{
+			use:     "auditreport",
+			aliases: []string{"ar"},
+			short:   "Print the traffic which would have been denied by Antrea-native policies in Audit mode",
+			long:    "Print the traffic which would have been dropped or rejected by the rules of Antrea-native policies in Audit mode on the local Node, the most recently seen first",
+			example: `  Get the traffic audited by all the policies in Audit mode
+  $ antctl get auditreport
+  Get the traffic audited by a specific Antrea ClusterNetworkPolicy
+  $ antctl get auditreport -p AntreaClusterNetworkPolicy:acnp1
+  Get the traffic audited by a specific Antrea NetworkPolicy
+  $ antctl get auditreport -p AntreaNetworkPolicy:ns1/annp1
+`,
+			commandGroup: get,
+			agentEndpoint: &endpoint{
+				nonResourceEndpoint: &nonResourceEndpoint{
+					path: "/auditreport",
+					params: []flagInfo{
+						{
+							name:      "policy",
+							usage:     "Only get the records of the policy, in the same format as in the audit logs, e.g. AntreaClusterNetworkPolicy:acnp1.",
+							shorthand: "p",
+						},
+					},
+					outputType: multiple,
+				},
+			},
+			transformedResponse: reflect.TypeOf(auditreport.Response{}),
+		},
+		{
+			use:     "policyanalysis",
+			aliases: []string{"pa"},
+			short:   "Print the shadowed, redundant and conflicting rules of Antrea-native policies",
+			long:    "Print the rules of Antrea-native policies which can never be matched because a rule with a higher precedence matches all their traffic (Shadowed if the actions differ, Redundant if they are the same), and the rules whose action may conflict with a rule of another policy with the same priority (Conflict)",
+			example: `  Get all the findings of the analysis
+  $ antctl get policyanalysis
+  Get the findings in which a specific Antrea ClusterNetworkPolicy is involved
+  $ antctl get policyanalysis -p AntreaClusterNetworkPolicy:acnp1
+  Get the shadowed rules
+  $ antctl get policyanalysis -T Shadowed
+`,
+			commandGroup: get,
+			controllerEndpoint: &endpoint{
+				nonResourceEndpoint: &nonResourceEndpoint{
+					path: "/policyanalysis",
+					params: []flagInfo{
+						{
+							name:      "policy",
+							usage:     "Only get the findings in which the policy is involved, in the same format as in the audit logs, e.g. AntreaClusterNetworkPolicy:acnp1.",
+							shorthand: "p",
+						},
+						{
+							name:            "type",
+							usage:           "Only get the findings of the type.",
+							shorthand:       "T",
+							supportedValues: []string{"Shadowed", "Redundant", "Conflict"},
+						},
+					},
+					outputType: multiple,
+				},
+			},
+			transformedResponse: reflect.TypeOf(policyanalysis.Response{}),
+		},
 	},
 	rawCommands: []rawCommand{
 		{
@@ -22,13 +22,13 @@ import (
 
 	"github.com/spf13/cobra"
 	"gopkg.in/yaml.v3"
+	authenticationv1 "k8s.io/api/authentication/v1"
 	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
-	"k8s.io/apimachinery/pkg/util/wait"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	k8syaml "sigs.k8s.io/yaml"
 
@@ -42,6 +42,21 @@ const (
 	ClusterSetJoinConfigKind       = "ClusterSetJoinConfig"
 
 	CreateByAntctlAnnotation = "multicluster.antrea.io/created-by-antctl"
+	// MemberTokenExpirationAnnotation is added to the Secret of a member token to record when
+	// the token expires.
+	MemberTokenExpirationAnnotation = "multicluster.antrea.io/token-expiration"
+
+	// DefaultMemberTokenTTL is the default lifetime of a member token. A member token is only
+	// used by member clusters to join the ClusterSet, after which the leader cluster issues
+	// short-lived credentials to the member clusters.
+	DefaultMemberTokenTTL = 24 * time.Hour
+
+	// memberBootstrapRole only allows the ServiceAccounts of member tokens to announce member
+	// clusters to the leader cluster.
+	memberBootstrapRole = "antrea-mc-member-bootstrap-role"
+	// rootCAConfigMap is the ConfigMap published in every Namespace by Kubernetes, which
+	// includes the CA bundle to verify the API server.
+	rootCAConfigMap = "kube-root-ca.crt"
 
 	DefaultMemberNamespace = "kube-system"
 	DefaultLeaderNamespace = "antrea-multicluster"
@@ -191,7 +206,24 @@ func ConvertMemberTokenSecret(secret *corev1.Secret) *corev1.Secret {
 	return s
 }
 
-func CreateMemberToken(cmd *cobra.Command, k8sClient client.Client, name string, namespace string, createdRes *[]map[string]interface{}) error {
+var createServiceAccountToken = func(ctx context.Context, k8sClient client.Client, serviceAccount *corev1.ServiceAccount, expirationSeconds int64) (*authenticationv1.TokenRequest, error) {
+	tokenRequest := &authenticationv1.TokenRequest{
+		Spec: authenticationv1.TokenRequestSpec{
+			ExpirationSeconds: &expirationSeconds,
+		},
+	}
+	if err := k8sClient.SubResource("token").Create(ctx, serviceAccount, tokenRequest); err != nil {
+		return nil, err
+	}
+	return tokenRequest, nil
+}
+
+// CreateMemberToken creates a member token which expires after the given ttl. The token is
+// saved in a Secret, and its ServiceAccount is only allowed to announce member clusters to the
+// leader cluster. The Secret and the RoleBinding are owned by the ServiceAccount, so they are
+// garbage collected when the leader cluster deletes the ServiceAccount after a member cluster
+// which joined with the token leaves the ClusterSet.
+func CreateMemberToken(cmd *cobra.Command, k8sClient client.Client, name string, namespace string, ttl time.Duration, createdRes *[]map[string]interface{}) error {
 	var createErr error
 	serviceAccount := newServiceAccount(name, namespace)
 	createErr = k8sClient.Create(context.TODO(), serviceAccount)
@@ -209,8 +241,20 @@ func CreateMemberToken(cmd *cobra.Command, k8sClient client.Client, name string,
 		unstructuredSA["kind"] = serviceAccount.Kind
 		*createdRes = append(*createdRes, unstructuredSA)
 	}
+	if err := k8sClient.Get(context.TODO(), types.NamespacedName{Namespace: namespace, Name: name}, serviceAccount); err != nil {
+		return err
+	}
+	ownerReferences := []metav1.OwnerReference{
+		{
+			APIVersion: "v1",
+			Kind:       "ServiceAccount",
+			Name:       serviceAccount.Name,
+			UID:        serviceAccount.UID,
+		},
+	}
 
 	roleBinding := newRoleBinding(name, name, namespace)
+	roleBinding.OwnerReferences = ownerReferences
 	createErr = k8sClient.Create(context.TODO(), roleBinding)
 	if createErr != nil {
 		if !apierrors.IsAlreadyExists(createErr) {
@@ -227,26 +271,35 @@ func CreateMemberToken(cmd *cobra.Command, k8sClient client.Client, name string,
 		*createdRes = append(*createdRes, unstructuredRoleBinding)
 	}
 
-	secret := newSecret(name, name, namespace)
-	createErr = k8sClient.Create(context.TODO(), secret)
-	if createErr != nil {
-		if !apierrors.IsAlreadyExists(createErr) {
-			fmt.Fprintf(cmd.ErrOrStderr(), "Failed to create Secret \"%s\": %s\n", name, createErr.Error())
-			return createErr
-		}
+	if err := k8sClient.Get(context.TODO(), types.NamespacedName{Namespace: namespace, Name: name}, &corev1.Secret{}); err == nil {
 		fmt.Fprintf(cmd.OutOrStdout(), "Secret \"%s\" already exists\n", name)
-	} else {
-		fmt.Fprintf(cmd.OutOrStdout(), "Secret \"%s\" created\n", secret.Name)
-		unstructuredSecret, _ := runtime.DefaultUnstructuredConverter.ToUnstructured(secret)
-		unstructuredSecret["apiVersion"] = secret.APIVersion
-		unstructuredSecret["kind"] = secret.Kind
-		*createdRes = append(*createdRes, unstructuredSecret)
-	}
-	// It will take one or two seconds to wait for the Data.token to be created.
-	if err := waitForSecretReady(k8sClient, name, namespace); err != nil {
+		return nil
+	} else if !apierrors.IsNotFound(err) {
 		return err
 	}
-
+	rootCA := &corev1.ConfigMap{}
+	if err := k8sClient.Get(context.TODO(), types.NamespacedName{Namespace: namespace, Name: rootCAConfigMap}, rootCA); err != nil {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Failed to get ConfigMap \"%s\": %s\n", rootCAConfigMap, err.Error())
+		return err
+	}
+	tokenRequest, err := createServiceAccountToken(context.TODO(), k8sClient, serviceAccount, int64(ttl.Seconds()))
+	if err != nil {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Failed to create token for ServiceAccount \"%s\": %s\n", name, err.Error())
+		return err
+	}
+	expiration := tokenRequest.Status.ExpirationTimestamp.Format(time.RFC3339)
+	secret := newSecret(name, namespace, []byte(rootCA.Data["ca.crt"]), []byte(tokenRequest.Status.Token), expiration)
+	secret.OwnerReferences = ownerReferences
+	createErr = k8sClient.Create(context.TODO(), secret)
+	if createErr != nil {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Failed to create Secret \"%s\": %s\n", name, createErr.Error())
+		return createErr
+	}
+	fmt.Fprintf(cmd.OutOrStdout(), "Secret \"%s\" created, the token expires at %s\n", secret.Name, expiration)
+	unstructuredSecret, _ := runtime.DefaultUnstructuredConverter.ToUnstructured(secret)
+	unstructuredSecret["apiVersion"] = secret.APIVersion
+	unstructuredSecret["kind"] = secret.Kind
+	*createdRes = append(*createdRes, unstructuredSecret)
 	return nil
 }
 
@@ -327,22 +380,6 @@ func DeleteMemberToken(cmd *cobra.Command, k8sClient client.Client, name string,
 	return nil
 }
 
-func waitForSecretReady(client client.Client, secretName string, namespace string) error {
-	return wait.PollImmediate(
-		1*time.Second,
-		5*time.Second,
-		func() (bool, error) {
-			secret := &corev1.Secret{}
-			if err := client.Get(context.TODO(), types.NamespacedName{Name: secretName, Namespace: namespace}, secret); err != nil {
-				if apierrors.IsNotFound(err) {
-					return false, nil
-				}
-				return false, err
-			}
-			return string(secret.Data["token"]) != "", nil
-		})
-}
-
 func newClusterSet(name, namespace, leaderServer, secret, memberClusterID, leaderClusterID, leaderNamespace string) *mcv1alpha2.ClusterSet {
 	clusterSet := &mcv1alpha2.ClusterSet{
 		TypeMeta: metav1.TypeMeta{
@@ -390,7 +427,7 @@ func newRoleBinding(name string, saName string, namespace string) *rbacv1.RoleBi
 		RoleRef: rbacv1.RoleRef{
 			APIGroup: "rbac.authorization.k8s.io",
 			Kind:     "Role",
-			Name:     "antrea-mc-member-cluster-role",
+			Name:     memberBootstrapRole,
 		},
 		Subjects: []rbacv1.Subject{
 			{
@@ -402,7 +439,7 @@ func newRoleBinding(name string, saName string, namespace string) *rbacv1.RoleBi
 	}
 }
 
-func newSecret(name string, saName string, namespace string) *corev1.Secret {
+func newSecret(name string, namespace string, caData []byte, token []byte, expiration string) *corev1.Secret {
 	return &corev1.Secret{
 		TypeMeta: metav1.TypeMeta{
 			APIVersion: "v1",
@@ -412,11 +449,16 @@ func newSecret(name string, saName string, namespace string) *corev1.Secret {
 			Name:      name,
 			Namespace: namespace,
 			Annotations: map[string]string{
-				"kubernetes.io/service-account.name": saName,
-				CreateByAntctlAnnotation:             "true",
+				CreateByAntctlAnnotation:        "true",
+				MemberTokenExpirationAnnotation: expiration,
 			},
 		},
-		Type: "kubernetes.io/service-account-token",
+		Data: map[string][]byte{
+			corev1.ServiceAccountRootCAKey:    caData,
+			corev1.ServiceAccountNamespaceKey: []byte(namespace),
+			corev1.ServiceAccountTokenKey:     token,
+		},
+		Type: corev1.SecretTypeOpaque,
 	}
 }
 
@@ -18,12 +18,16 @@ import (
 	"bytes"
 	"context"
 	"testing"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	authenticationv1 "k8s.io/api/authentication/v1"
 	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
@@ -282,12 +286,51 @@ func TestCreateMemberToken(t *testing.T) {
 				obj = append(obj, tt.existingSecret)
 			}
 			fakeClient := fake.NewClientBuilder().WithScheme(multiclusterscheme.Scheme).WithObjects(obj...).Build()
-			_ = CreateMemberToken(cmd, fakeClient, "membertoken", "default", &createdRes)
+			_ = CreateMemberToken(cmd, fakeClient, "membertoken", "default", DefaultMemberTokenTTL, &createdRes)
 			assert.Equal(t, tt.expectedResLen, len(createdRes))
 		})
 	}
 }
 
+func TestCreateMemberTokenSecret(t *testing.T) {
+	expiration := metav1.NewTime(time.Now().Add(time.Hour).Truncate(time.Second))
+	var requestedExpirationSeconds int64
+	defer func(f func(context.Context, client.Client, *corev1.ServiceAccount, int64) (*authenticationv1.TokenRequest, error)) {
+		createServiceAccountToken = f
+	}(createServiceAccountToken)
+	createServiceAccountToken = func(ctx context.Context, k8sClient client.Client, serviceAccount *corev1.ServiceAccount, expirationSeconds int64) (*authenticationv1.TokenRequest, error) {
+		requestedExpirationSeconds = expirationSeconds
+		return &authenticationv1.TokenRequest{
+			Status: authenticationv1.TokenRequestStatus{Token: "12345", ExpirationTimestamp: expiration},
+		}, nil
+	}
+	rootCA := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: rootCAConfigMap},
+		Data:       map[string]string{"ca.crt": "abcde"},
+	}
+	fakeClient := fake.NewClientBuilder().WithScheme(multiclusterscheme.Scheme).WithObjects(rootCA).Build()
+	cmd := &cobra.Command{}
+	createdRes := []map[string]interface{}{}
+
+	require.NoError(t, CreateMemberToken(cmd, fakeClient, "membertoken", "default", time.Hour, &createdRes))
+	assert.Equal(t, 3, len(createdRes))
+	assert.Equal(t, int64(3600), requestedExpirationSeconds)
+
+	secret := &corev1.Secret{}
+	require.NoError(t, fakeClient.Get(context.TODO(), types.NamespacedName{Namespace: "default", Name: "membertoken"}, secret))
+	assert.Equal(t, corev1.SecretTypeOpaque, secret.Type)
+	assert.Equal(t, map[string][]byte{"ca.crt": []byte("abcde"), "namespace": []byte("default"), "token": []byte("12345")}, secret.Data)
+	assert.Equal(t, expiration.Format(time.RFC3339), secret.Annotations[MemberTokenExpirationAnnotation])
+	require.Len(t, secret.OwnerReferences, 1)
+	assert.Equal(t, "membertoken", secret.OwnerReferences[0].Name)
+
+	roleBinding := &rbacv1.RoleBinding{}
+	require.NoError(t, fakeClient.Get(context.TODO(), types.NamespacedName{Namespace: "default", Name: "membertoken"}, roleBinding))
+	assert.Equal(t, memberBootstrapRole, roleBinding.RoleRef.Name)
+	require.Len(t, roleBinding.OwnerReferences, 1)
+	assert.Equal(t, "membertoken", roleBinding.OwnerReferences[0].Name)
+}
+
 func TestDeleteMemberToken(t *testing.T) {
 	secretContent := []byte(`apiVersion: v1
 kind: Secret
@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 	corev1 "k8s.io/api/core/v1"
@@ -31,6 +32,7 @@ import (
 type memberTokenOptions struct {
 	namespace string
 	output    string
+	ttl       time.Duration
 	k8sClient client.Client
 }
 
@@ -41,12 +43,17 @@ var memberTokenExamples = strings.Trim(`
   $ antctl mc create membertoken cluster-east-token -n antrea-multicluster
 # Create a member token and save the Secret manifest to a file
   $ antctl mc create membertoken cluster-east-token -n antrea-multicluster -o token-secret.yml
+# Create a member token which expires in 2 hours
+  $ antctl mc create membertoken cluster-east-token -n antrea-multicluster --ttl 2h
 `, "\n")
 
 func (o *memberTokenOptions) validateAndComplete(cmd *cobra.Command) error {
 	if o.namespace == "" {
 		return fmt.Errorf("Namespace must be specified")
 	}
+	if o.ttl <= 0 {
+		return fmt.Errorf("ttl must be positive")
+	}
 	var err error
 	if o.k8sClient == nil {
 		o.k8sClient, err = common.NewClient(cmd)
@@ -62,7 +69,7 @@ func NewMemberTokenCmd() *cobra.Command {
 		Use:     "membertoken",
 		Args:    cobra.MaximumNArgs(1),
 		Short:   "Create a member token in a leader cluster",
-		Long:    "Create a member token in a leader cluster, which will be saved in a Secret. A ServiceAccount and a RoleBinding will be created too.",
+		Long:    "Create a member token in a leader cluster, which will be saved in a Secret. A ServiceAccount and a RoleBinding will be created too. The token expires after the specified ttl, and is only used by member clusters to join the ClusterSet.",
 		Example: memberTokenExamples,
 		RunE:    memberTokenRunE,
 	}
@@ -71,6 +78,7 @@ func NewMemberTokenCmd() *cobra.Command {
 	memberTokenOpts = o
 	command.Flags().StringVarP(&o.namespace, "namespace", "n", "", "Namespace of the ClusterSet")
 	command.Flags().StringVarP(&o.output, "output-file", "o", "", "Output file to save the token Secret manifest")
+	command.Flags().DurationVar(&o.ttl, "ttl", common.DefaultMemberTokenTTL, "Lifetime of the token")
 
 	return command
 }
@@ -92,7 +100,7 @@ func memberTokenRunE(cmd *cobra.Command, args []string) error {
 		}
 	}()
 
-	if createErr = common.CreateMemberToken(cmd, memberTokenOpts.k8sClient, args[0], memberTokenOpts.namespace, &createdRes); createErr != nil {
+	if createErr = common.CreateMemberToken(cmd, memberTokenOpts.k8sClient, args[0], memberTokenOpts.namespace, memberTokenOpts.ttl, &createdRes); createErr != nil {
 		return createErr
 	}
 
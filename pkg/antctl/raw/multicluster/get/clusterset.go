@@ -34,6 +34,7 @@ type clusterSetOptions struct {
 	namespace     string
 	outputFormat  string
 	allNamespaces bool
+	health        bool
 	k8sClient     client.Client
 }
 
@@ -50,6 +51,8 @@ Get all ClusterSets and print them in JSON format
 $ antctl mc get clusterset -o json
 Get the specified ClusterSet
 $ antctl mc get clusterset <CLUSTERSET_ID>
+Get the connectivity matrix between the Gateways of member clusters in the specified ClusterSet
+$ antctl mc get clusterset <CLUSTERSET_ID> --health
 `, "\n")
 
 func (o *clusterSetOptions) validateAndComplete(cmd *cobra.Command) error {
@@ -87,6 +90,7 @@ func NewClusterSetCommand() *cobra.Command {
 	cmdClusterSet.Flags().StringVarP(&o.namespace, "namespace", "n", "", "Namespace of ClusterSets")
 	cmdClusterSet.Flags().StringVarP(&o.outputFormat, "output", "o", "", "Output format. Supported formats: json|yaml")
 	cmdClusterSet.Flags().BoolVarP(&o.allNamespaces, "all-namespaces", "A", false, "If present, list ClusterSets across all Namespaces")
+	cmdClusterSet.Flags().BoolVar(&o.health, "health", false, "If present, print the connectivity matrix between the Gateways of member clusters")
 
 	return cmdClusterSet
 }
@@ -133,7 +137,11 @@ func runEClusterSet(cmd *cobra.Command, args []string) error {
 		return nil
 	}
 
-	err = output(clusterSets, false, optionsClusterSet.outputFormat, cmd.OutOrStdout(), clusterset.Transform)
+	transform := clusterset.Transform
+	if optionsClusterSet.health {
+		transform = clusterset.HealthTransform
+	}
+	err = output(clusterSets, false, optionsClusterSet.outputFormat, cmd.OutOrStdout(), transform)
 	if err != nil {
 		return err
 	}
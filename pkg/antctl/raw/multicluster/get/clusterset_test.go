@@ -17,12 +17,14 @@ package get
 import (
 	"bytes"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	mcv1alpha1 "antrea.io/antrea/multicluster/apis/multicluster/v1alpha1"
 	mcv1alpha2 "antrea.io/antrea/multicluster/apis/multicluster/v1alpha2"
 	mcscheme "antrea.io/antrea/pkg/antctl/raw/multicluster/scheme"
 )
@@ -45,6 +47,7 @@ func TestGetClusterSet(t *testing.T) {
 		args                []string
 		output              string
 		allNamespaces       bool
+		health              bool
 		donotFake           bool
 		expectedOutput      string
 	}{
@@ -109,6 +112,58 @@ func TestGetClusterSet(t *testing.T) {
 			},
 			expectedOutput: "CLUSTER-ID NAMESPACE   CLUSTERSET-ID   TYPE   STATUS REASON   \n<NONE>     default     clusterset-name <NONE> <NONE> <NONE>   \ncluster-a  kube-system clusterset-1    Ready  True   Connected\n",
 		},
+		{
+			name:   "get connectivity matrix of ClusterSet",
+			args:   []string{"clusterset-1"},
+			health: true,
+			existingClusterSets: &mcv1alpha2.ClusterSetList{
+				Items: []mcv1alpha2.ClusterSet{
+					{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: "default",
+							Name:      "clusterset-1",
+						},
+						Status: mcv1alpha2.ClusterSetStatus{
+							Connectivity: []mcv1alpha2.ClusterConnectivity{
+								{
+									ClusterID: "cluster-a",
+									PeerGateways: []mcv1alpha1.PeerGatewayStatus{
+										{
+											ClusterID:            "cluster-b",
+											GatewayIP:            "172.18.0.10",
+											TunnelConnectivity:   mcv1alpha1.GatewayConnectivityDegraded,
+											TunnelLatency:        &metav1.Duration{Duration: 2547 * time.Microsecond},
+											TunnelPacketLoss:     20,
+											UnderlayConnectivity: mcv1alpha1.GatewayConnectivityHealthy,
+											UnderlayLatency:      &metav1.Duration{Duration: 2123 * time.Microsecond},
+										},
+										{
+											ClusterID:            "cluster-c",
+											GatewayIP:            "172.19.0.10",
+											UnderlayConnectivity: mcv1alpha1.GatewayConnectivityUnreachable,
+											UnderlayPacketLoss:   100,
+										},
+									},
+								},
+								{
+									ClusterID: "cluster-b",
+									PeerGateways: []mcv1alpha1.PeerGatewayStatus{
+										{
+											ClusterID:            "cluster-a",
+											GatewayIP:            "172.17.0.10",
+											UnderlayConnectivity: mcv1alpha1.GatewayConnectivityDegraded,
+											UnderlayLatency:      &metav1.Duration{Duration: 3 * time.Millisecond},
+											UnderlayPacketLoss:   20,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedOutput: "NAMESPACE CLUSTERSET-ID SOURCE-CLUSTER cluster-a      cluster-b        cluster-c  \ndefault   clusterset-1  cluster-a      -              Degraded (2.5ms) Unreachable\ndefault   clusterset-1  cluster-b      Degraded (3ms) -                Unknown    \n",
+		},
 		{
 			name:           "get all ClusterSets but empty result",
 			allNamespaces:  true,
@@ -148,6 +203,7 @@ func TestGetClusterSet(t *testing.T) {
 			if tt.output != "" {
 				optionsClusterSet.outputFormat = tt.output
 			}
+			optionsClusterSet.health = tt.health
 			err := cmd.Execute()
 			if err != nil {
 				assert.Equal(t, tt.expectedOutput, err.Error())
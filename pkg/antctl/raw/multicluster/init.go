@@ -115,7 +115,7 @@ func initRunE(cmd *cobra.Command, args []string) error {
 
 	var tokenSecret *corev1.Secret
 	if initOpts.createToken {
-		if err := common.CreateMemberToken(cmd, initOpts.k8sClient, defaultToken, initOpts.namespace, &createdRes); err != nil {
+		if err := common.CreateMemberToken(cmd, initOpts.k8sClient, defaultToken, initOpts.namespace, common.DefaultMemberTokenTTL, &createdRes); err != nil {
 			fmt.Fprintf(cmd.ErrOrStderr(), "Failed to create member token. You may run command \"antctl mc create membertoken\" to create a token.\n")
 			return err
 		}
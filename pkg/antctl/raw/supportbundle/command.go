@@ -16,6 +16,7 @@ package supportbundle
 
 import (
 	"bufio"
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -47,6 +48,7 @@ import (
 	systemv1beta1 "antrea.io/antrea/pkg/apis/system/v1beta1"
 	antrea "antrea.io/antrea/pkg/client/clientset/versioned"
 	systemclientset "antrea.io/antrea/pkg/client/clientset/versioned/typed/system/v1beta1"
+	"antrea.io/antrea/pkg/support"
 )
 
 const (
@@ -67,6 +69,9 @@ var option = &struct {
 	nodeListFile   string
 	since          string
 	insecure       bool
+	profile        string
+	redactPatterns []string
+	anonymizeIPs   bool
 }{}
 
 var defaultFS = afero.NewOsFs()
@@ -92,6 +97,10 @@ var remoteControllerExample = strings.Trim(`
   $ antctl supportbundle '*worker*' -l kubernetes.io/os=linux
   Generate support bundles of the controller and agents on all Nodes and save them to specific dir
   $ antctl supportbundle -d ~/Downloads
+  Generate support bundles with only the NetworkPolicy resources of the controller and agents on all Nodes
+  $ antctl supportbundle --profile policy-only
+  Generate support bundles of the controller and agents on all Nodes with IP addresses and customer hostnames redacted
+  $ antctl supportbundle --anonymize-ips --redact 'customer-[a-z0-9]+\.example\.com'
 `, "\n")
 
 func init() {
@@ -100,6 +109,9 @@ func init() {
 		Short: "Generate support bundle",
 	}
 
+	Command.Flags().StringVar(&option.profile, "profile", "", fmt.Sprintf("collection profile which selects the information collected in the support bundles, supported profiles are: %s. Defaults to all", strings.Join(support.Profiles(), ", ")))
+	Command.Flags().StringArrayVar(&option.redactPatterns, "redact", nil, "regular expression whose matches are replaced with pseudonyms in the support bundles, can be specified multiple times")
+	Command.Flags().BoolVar(&option.anonymizeIPs, "anonymize-ips", false, "replace IP addresses with pseudonyms in the support bundles")
 	if runtime.Mode == runtime.ModeAgent {
 		Command.RunE = agentRunE
 		Command.Long = "Generate the support bundle of current Antrea agent."
@@ -132,8 +144,37 @@ func setupSupportBundleClient(cmd *cobra.Command) (systemclientset.SupportBundle
 	return client.SupportBundles(), err
 }
 
+// newRedaction validates the redaction options and returns the redaction settings which are sent
+// to the Antrea components, or nil if nothing should be redacted. A random seed is generated so
+// that pseudonyms are consistent across all the bundles generated by one antctl invocation, but
+// cannot be correlated across invocations.
+func newRedaction() (*systemv1beta1.BundleRedaction, error) {
+	if err := support.ValidateProfile(option.profile); err != nil {
+		return nil, err
+	}
+	if len(option.redactPatterns) == 0 && !option.anonymizeIPs {
+		return nil, nil
+	}
+	if err := support.ValidatePatterns(option.redactPatterns); err != nil {
+		return nil, err
+	}
+	seed, err := support.NewRedactionSeed()
+	if err != nil {
+		return nil, err
+	}
+	return &systemv1beta1.BundleRedaction{
+		Patterns:     option.redactPatterns,
+		AnonymizeIPs: option.anonymizeIPs,
+		Seed:         seed,
+	}, nil
+}
+
 func localSupportBundleRequest(cmd *cobra.Command, mode string, writer io.Writer) error {
 	ctx := cmd.Context()
+	redaction, err := newRedaction()
+	if err != nil {
+		return err
+	}
 	client, err := getSupportBundleClient(cmd)
 	if err != nil {
 		return fmt.Errorf("error when creating system client: %w", err)
@@ -142,6 +183,8 @@ func localSupportBundleRequest(cmd *cobra.Command, mode string, writer io.Writer
 		ObjectMeta: metav1.ObjectMeta{
 			Name: mode,
 		},
+		Profile:   option.profile,
+		Redaction: redaction,
 	}, metav1.CreateOptions{}); err != nil {
 		return fmt.Errorf("error when creating the support bundle: %w", err)
 	}
@@ -176,12 +219,14 @@ func controllerLocalRunE(cmd *cobra.Command, _ []string) error {
 	return localSupportBundleRequest(cmd, runtime.ModeController, os.Stdout)
 }
 
-func request(ctx context.Context, component string, client systemclientset.SupportBundleInterface) error {
+func request(ctx context.Context, component string, client systemclientset.SupportBundleInterface, redaction *systemv1beta1.BundleRedaction) error {
 	_, err := client.Create(ctx, &systemv1beta1.SupportBundle{
 		ObjectMeta: metav1.ObjectMeta{
 			Name: component,
 		},
-		Since: option.since,
+		Since:     option.since,
+		Profile:   option.profile,
+		Redaction: redaction,
 	}, metav1.CreateOptions{})
 	return err
 }
@@ -237,6 +282,7 @@ func requestAll(
 	agentClients map[string]systemclientset.SupportBundleInterface,
 	controllerClient systemclientset.SupportBundleInterface,
 	bar *pb.ProgressBar,
+	redaction *systemv1beta1.BundleRedaction,
 ) map[string]error {
 	return mapClients(
 		ctx,
@@ -245,10 +291,10 @@ func requestAll(
 		controllerClient,
 		bar,
 		func(ctx context.Context, nodeName string, c systemclientset.SupportBundleInterface) error {
-			return request(ctx, runtime.ModeAgent, c)
+			return request(ctx, runtime.ModeAgent, c, redaction)
 		},
 		func(ctx context.Context, nodeName string, c systemclientset.SupportBundleInterface) error {
-			return request(ctx, runtime.ModeController, c)
+			return request(ctx, runtime.ModeController, c, redaction)
 		},
 	)
 }
@@ -560,6 +606,10 @@ func getClusterInfo(w io.Writer, k8sClient kubernetes.Interface) error {
 
 func controllerRemoteRunE(cmd *cobra.Command, args []string) error {
 	ctx := cmd.Context()
+	redaction, err := newRedaction()
+	if err != nil {
+		return err
+	}
 	if option.dir == "" {
 		cwd, _ := os.Getwd()
 		option.dir = filepath.Join(cwd, "support-bundles_"+time.Now().Format(timeFormat))
@@ -643,12 +693,23 @@ func controllerRemoteRunE(cmd *cobra.Command, args []string) error {
 		return err
 	}
 	defer f.Close()
-	err = getClusterInfo(f, k8sClientset)
-	if err != nil {
+	// The cluster information is collected by antctl itself, so it must be redacted locally,
+	// with the same seed as the bundles.
+	var clusterInfo bytes.Buffer
+	if err := getClusterInfo(&clusterInfo, k8sClientset); err != nil {
+		return err
+	}
+	var redactor *support.Redactor
+	if redaction != nil {
+		if redactor, err = support.NewRedactor(redaction.Patterns, redaction.AnonymizeIPs, redaction.Seed); err != nil {
+			return err
+		}
+	}
+	if _, err := f.Write(redactor.Redact(clusterInfo.Bytes())); err != nil {
 		return err
 	}
 
-	results := requestAll(ctx, agentClients, controllerClient, bar)
+	results := requestAll(ctx, agentClients, controllerClient, bar, redaction)
 	results = downloadAll(ctx, agentClients, controllerClient, dir, bar, results)
 	return processResults(results, dir)
 }
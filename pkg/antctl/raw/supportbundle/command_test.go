@@ -154,6 +154,62 @@ func TestLocalSupportBundleRequest(t *testing.T) {
 	assert.Contains(t, writer.String(), expected)
 }
 
+func TestLocalSupportBundleRequestWithProfileAndRedaction(t *testing.T) {
+	client := createFakeSupportBundleClient()
+	getSupportBundleClient = func(cmd *cobra.Command) (systemclientset.SupportBundleInterface, error) {
+		return client, nil
+	}
+	defer func() {
+		getSupportBundleClient = setupSupportBundleClient
+		option.profile = ""
+		option.redactPatterns = nil
+		option.anonymizeIPs = false
+	}()
+	option.profile = "datapath"
+	option.redactPatterns = []string{"customer-[a-z]+"}
+	option.anonymizeIPs = true
+	cmd := &cobra.Command{}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	cmd.SetContext(ctx)
+	require.NoError(t, localSupportBundleRequest(cmd, "agent", new(bytes.Buffer)))
+
+	supportBundle, err := client.Get(ctx, "agent", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "datapath", supportBundle.Profile)
+	require.NotNil(t, supportBundle.Redaction)
+	assert.Equal(t, []string{"customer-[a-z]+"}, supportBundle.Redaction.Patterns)
+	assert.True(t, supportBundle.Redaction.AnonymizeIPs)
+	assert.Len(t, supportBundle.Redaction.Seed, 32)
+}
+
+func TestNewRedaction(t *testing.T) {
+	defer func() {
+		option.profile = ""
+		option.redactPatterns = nil
+		option.anonymizeIPs = false
+	}()
+	redaction, err := newRedaction()
+	require.NoError(t, err)
+	assert.Nil(t, redaction)
+
+	option.profile = "foo"
+	_, err = newRedaction()
+	assert.ErrorContains(t, err, "unsupported profile")
+
+	option.profile = "perf"
+	option.redactPatterns = []string{"customer-("}
+	_, err = newRedaction()
+	assert.ErrorContains(t, err, "invalid redaction pattern")
+
+	option.redactPatterns = []string{"customer-[a-z]+"}
+	redaction1, err := newRedaction()
+	require.NoError(t, err)
+	redaction2, err := newRedaction()
+	require.NoError(t, err)
+	assert.NotEqual(t, redaction1.Seed, redaction2.Seed, "each invocation should use a different seed")
+}
+
 func TestCreateControllerClient(t *testing.T) {
 	ctx := context.Background()
 	tests := []struct {
@@ -276,7 +332,7 @@ func TestRequest(t *testing.T) {
 	// Make sure that the test does not hang even in case of failure:
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
-	results := requestAll(ctx, agentClients, controllerClient, bar)
+	results := requestAll(ctx, agentClients, controllerClient, bar, nil)
 	//results[""] corresponds to error received for controller node
 	assert.Equal(t, map[string]error{"": nil, "node-1": nil, "node-3": nil}, results)
 }
@@ -302,7 +358,7 @@ func TestDownload(t *testing.T) {
 	// Make sure that the test does not hang even in case of failure:
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
-	resultMap := requestAll(ctx, agentClients, controllerClient, bar)
+	resultMap := requestAll(ctx, agentClients, controllerClient, bar, nil)
 	results := downloadAll(ctx, agentClients, controllerClient, option.dir, bar, resultMap)
 	//results[""] corresponds to error received for controller node
 	require.Equal(t, map[string]error{"": nil, "node-1": nil, "node-3": nil}, results)
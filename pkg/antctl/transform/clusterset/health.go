@@ -0,0 +1,155 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clusterset
+
+import (
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	mcv1alpha1 "antrea.io/antrea/multicluster/apis/multicluster/v1alpha1"
+	mcv1alpha2 "antrea.io/antrea/multicluster/apis/multicluster/v1alpha2"
+	"antrea.io/antrea/pkg/antctl/transform/common"
+)
+
+// HealthResponse is a row of the connectivity matrix of a ClusterSet. Each row shows the
+// connectivity from the Gateways of a source member cluster to the Gateways of all other
+// member clusters.
+type HealthResponse struct {
+	Namespace     string            `json:"namespace" yaml:"namespace"`
+	ClusterSetID  string            `json:"clusterSetID" yaml:"clusterSetID"`
+	SourceCluster string            `json:"sourceCluster" yaml:"sourceCluster"`
+	Peers         map[string]string `json:"peers" yaml:"peers"`
+	// peerClusters is the sorted IDs of all peer clusters in the matrix, which are used as
+	// the columns of the table.
+	peerClusters []string
+}
+
+// HealthTransform transforms ClusterSets into a connectivity matrix, in which the rows are
+// the source member clusters and the columns are the peer member clusters.
+func HealthTransform(r interface{}, single bool) (interface{}, error) {
+	if single {
+		return healthListTransform([]mcv1alpha2.ClusterSet{r.(mcv1alpha2.ClusterSet)})
+	}
+	return healthListTransform(r)
+}
+
+func healthListTransform(l interface{}) (interface{}, error) {
+	clusterSets := l.([]mcv1alpha2.ClusterSet)
+	// All rows must have the same columns, so the peer clusters of all ClusterSets are
+	// collected first.
+	peerClusterSet := sets.New[string]()
+	for _, clusterSet := range clusterSets {
+		for _, connectivity := range clusterSet.Status.Connectivity {
+			peerClusterSet.Insert(connectivity.ClusterID)
+			for _, peerGateway := range connectivity.PeerGateways {
+				peerClusterSet.Insert(peerGateway.ClusterID)
+			}
+		}
+	}
+	peerClusters := sets.List(peerClusterSet)
+
+	var result []interface{}
+	for _, clusterSet := range clusterSets {
+		if len(clusterSet.Status.Connectivity) == 0 {
+			// When the ClusterSet has no connectivity status, we should print it with empty status.
+			result = append(result, HealthResponse{
+				Namespace:    clusterSet.Namespace,
+				ClusterSetID: clusterSet.Name,
+				Peers:        map[string]string{},
+				peerClusters: peerClusters,
+			})
+			continue
+		}
+		for _, connectivity := range clusterSet.Status.Connectivity {
+			result = append(result, healthObjectTransform(clusterSet, connectivity, peerClusters))
+		}
+	}
+	return result, nil
+}
+
+func healthObjectTransform(clusterSet mcv1alpha2.ClusterSet, connectivity mcv1alpha2.ClusterConnectivity, peerClusters []string) HealthResponse {
+	peerGateways := map[string][]mcv1alpha1.PeerGatewayStatus{}
+	for _, peerGateway := range connectivity.PeerGateways {
+		peerGateways[peerGateway.ClusterID] = append(peerGateways[peerGateway.ClusterID], peerGateway)
+	}
+	peers := make(map[string]string, len(peerClusters))
+	for _, peerCluster := range peerClusters {
+		switch {
+		case peerCluster == connectivity.ClusterID:
+			peers[peerCluster] = "-"
+		case len(peerGateways[peerCluster]) == 0:
+			peers[peerCluster] = "Unknown"
+		default:
+			peers[peerCluster] = summarizePeerGateways(peerGateways[peerCluster])
+		}
+	}
+	return HealthResponse{
+		Namespace:     clusterSet.Namespace,
+		ClusterSetID:  clusterSet.Name,
+		SourceCluster: connectivity.ClusterID,
+		Peers:         peers,
+		peerClusters:  peerClusters,
+	}
+}
+
+var connectivitySeverity = map[mcv1alpha1.GatewayConnectivity]int{
+	mcv1alpha1.GatewayConnectivityHealthy:     0,
+	mcv1alpha1.GatewayConnectivityDegraded:    1,
+	mcv1alpha1.GatewayConnectivityUnreachable: 2,
+}
+
+// summarizePeerGateways returns the worst connectivity and the maximum latency among the
+// Gateways of a peer cluster, e.g. "Healthy (2.1ms)". The tunnel connectivity of a peer
+// Gateway is used when the tunnel is probed, otherwise the underlay connectivity is used.
+func summarizePeerGateways(peerGateways []mcv1alpha1.PeerGatewayStatus) string {
+	connectivity := mcv1alpha1.GatewayConnectivityHealthy
+	var latency time.Duration
+	for _, peerGateway := range peerGateways {
+		peerConnectivity, peerLatency := peerGateway.UnderlayConnectivity, peerGateway.UnderlayLatency
+		if peerGateway.TunnelConnectivity != "" {
+			peerConnectivity, peerLatency = peerGateway.TunnelConnectivity, peerGateway.TunnelLatency
+		}
+		if connectivitySeverity[peerConnectivity] > connectivitySeverity[connectivity] {
+			connectivity = peerConnectivity
+		}
+		if peerLatency != nil && peerLatency.Duration > latency {
+			latency = peerLatency.Duration
+		}
+	}
+	if connectivity == mcv1alpha1.GatewayConnectivityUnreachable || latency == 0 {
+		return string(connectivity)
+	}
+	return fmt.Sprintf("%s (%s)", connectivity, latency.Round(100*time.Microsecond))
+}
+
+var _ common.TableOutput = new(HealthResponse)
+
+func (r HealthResponse) GetTableHeader() []string {
+	return append([]string{"NAMESPACE", "CLUSTERSET-ID", "SOURCE-CLUSTER"}, r.peerClusters...)
+}
+
+func (r HealthResponse) GetTableRow(maxColumnLength int) []string {
+	row := []string{r.Namespace, r.ClusterSetID, r.SourceCluster}
+	for _, peerCluster := range r.peerClusters {
+		row = append(row, r.Peers[peerCluster])
+	}
+	return row
+}
+
+func (r HealthResponse) SortRows() bool {
+	return true
+}
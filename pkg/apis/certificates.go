@@ -19,4 +19,11 @@ const (
 	AntreaOrganizationName = "antrea.io"
 	// AntreaIPsecCSRSignerName is the signer name for signing IPsec certificates for antrea-agents.
 	AntreaIPsecCSRSignerName = "antrea.io/antrea-agent-ipsec-tunnel"
+	// AntreaWireGuardCSRSignerName is the signer name for certifying WireGuard public keys of antrea-agents.
+	AntreaWireGuardCSRSignerName = "antrea.io/antrea-agent-wireguard-key"
+	// AntreaWireGuardCAName is the name of the Secret and the ConfigMap storing the CA which certifies WireGuard
+	// public keys of antrea-agents.
+	AntreaWireGuardCAName = "antrea-wireguard-ca"
+	// AntreaWireGuardCAConfigMapKey is the key of the CA certificate in the ConfigMap.
+	AntreaWireGuardCAConfigMapKey = "ca.crt"
 )
@@ -234,6 +234,9 @@ type NetworkPolicy struct {
 	TierPriority *int32
 	// Reference to the original NetworkPolicy that the internal NetworkPolicy is created for.
 	SourceRef *NetworkPolicyReference
+	// EnforcementMode specifies whether the rules are enforced or only audited.
+	// An empty value means the rules are enforced, which is the case for K8s NetworkPolicy.
+	EnforcementMode crdv1beta1.PolicyEnforcementMode
 }
 
 // Direction defines traffic direction of NetworkPolicyRule.
@@ -277,6 +280,11 @@ type NetworkPolicyRule struct {
 	L7Protocols []L7Protocol
 	// LogLabel is a user-defined arbitrary string which will be printed in the NetworkPolicy logs.
 	LogLabel string
+	// L7DenyResponse specifies the responses sent to clients whose layer 7 requests are denied by this rule.
+	L7DenyResponse *L7DenyResponse
+	// RateLimit specifies the rate above which the traffic matching this rule is dropped. It is only set when
+	// Action is RateLimit.
+	RateLimit *RuleRateLimit
 }
 
 // Protocol defines network protocols supported for things like container ports.
@@ -328,13 +336,16 @@ type Service struct {
 
 // L7Protocol defines application layer protocol to match.
 type L7Protocol struct {
-	HTTP *HTTPProtocol
-	TLS  *TLSProtocol
+	HTTP  *HTTPProtocol
+	TLS   *TLSProtocol
+	GRPC  *GRPCProtocol
+	DNS   *DNSProtocol
+	Kafka *KafkaProtocol
 }
 
-// HTTPProtocol matches HTTP requests with specific host, method, and path. All
-// fields could be used alone or together. If all fields are not provided, this
-// matches all HTTP requests.
+// HTTPProtocol matches HTTP requests with specific host, method, path, headers
+// and query parameters. All fields could be used alone or together. If all fields
+// are not provided, this matches all HTTP requests.
 type HTTPProtocol struct {
 	// Host represents the hostname present in the URI or the HTTP Host header to match.
 	// It does not contain the port associated with the host.
@@ -344,6 +355,50 @@ type HTTPProtocol struct {
 	Method string
 	// Path represents the URI path to match (Ex. "/index.html", "/admin").
 	Path string
+	// PathMatchType specifies how Path is matched. If not set, Path supports wildcards: a
+	// leading "*" means suffix match and a trailing "*" means prefix match, otherwise the
+	// path is matched exactly.
+	PathMatchType HTTPMatchType
+	// Headers represents the HTTP request headers to match. A request must match all of them.
+	Headers []HTTPHeaderMatch
+	// QueryParams represents the query parameters of the URI to match. A request must match
+	// all of them.
+	QueryParams []HTTPQueryParamMatch
+}
+
+// HTTPMatchType specifies how a value of HTTP requests is matched.
+type HTTPMatchType string
+
+const (
+	// HTTPMatchTypeExact matches the whole value exactly.
+	HTTPMatchTypeExact HTTPMatchType = "Exact"
+	// HTTPMatchTypePrefix matches values starting with the provided value.
+	HTTPMatchTypePrefix HTTPMatchType = "Prefix"
+	// HTTPMatchTypeRegex matches values with the provided regular expression, which must
+	// match the whole value.
+	HTTPMatchTypeRegex HTTPMatchType = "Regex"
+)
+
+// HTTPHeaderMatch matches an HTTP request header.
+type HTTPHeaderMatch struct {
+	// Name is the name of the header to match (Ex. "X-Tenant-ID"). It is case-insensitive.
+	Name string
+	// Value is the value of the header to match. If not set, the header only needs to be
+	// present in the request.
+	Value string
+	// MatchType specifies how Value is matched. Defaults to Exact.
+	MatchType HTTPMatchType
+}
+
+// HTTPQueryParamMatch matches a query parameter of the URI of an HTTP request.
+type HTTPQueryParamMatch struct {
+	// Name is the name of the query parameter to match. It is case-sensitive.
+	Name string
+	// Value is the value of the query parameter to match. If not set, the query parameter
+	// only needs to be present in the URI.
+	Value string
+	// MatchType specifies how Value is matched. Defaults to Exact.
+	MatchType HTTPMatchType
 }
 
 // TLSProtocol matches TLS handshake packets with specific SNI. If the field is not provided, this
@@ -353,6 +408,74 @@ type TLSProtocol struct {
 	SNI string `json:"sni,omitempty" protobuf:"bytes,1,opt,name=sni"`
 }
 
+// GRPCProtocol matches gRPC requests with specific service and method. All
+// fields could be used alone or together. If all fields are not provided, this
+// matches all gRPC requests.
+type GRPCProtocol struct {
+	// Service represents the fully-qualified name of the gRPC service to match, including the package name
+	// (Ex. "helloworld.Greeter").
+	Service string
+	// Method represents the name of the gRPC method to match (Ex. "SayHello").
+	Method string
+}
+
+// DNSProtocol matches DNS queries with specific query name. If the field is not provided, this matches all
+// DNS queries.
+type DNSProtocol struct {
+	// QueryName represents the domain name in the question of the DNS query to match (Ex. "*.example.com").
+	QueryName string
+}
+
+// KafkaProtocol matches Kafka requests with specific API key and topic. All
+// fields could be used alone or together. If all fields are not provided, this
+// matches all Kafka requests.
+type KafkaProtocol struct {
+	// APIKey represents the type of the Kafka request to match (Ex. "produce", "fetch").
+	APIKey string
+	// Topic represents the name of the Kafka topic to match.
+	Topic string
+}
+
+// RuleRateLimit defines the rate limit enforced by a rule with the RateLimit action. Exactly one of
+// PacketsPerSecond and NewConnectionsPerSecond is set.
+type RuleRateLimit struct {
+	// PacketsPerSecond is the maximum rate of packets matching the rule per source.
+	PacketsPerSecond int32
+	// NewConnectionsPerSecond is the maximum rate of new connections matching the rule per source.
+	NewConnectionsPerSecond int32
+	// Burst is the number of packets or new connections allowed in excess of the rate.
+	Burst int32
+}
+
+// L7DenyResponse defines the responses sent to clients whose layer 7 requests are denied.
+type L7DenyResponse struct {
+	// HTTP specifies the response sent to denied HTTP requests.
+	HTTP *HTTPDenyResponse
+	// TLS specifies the alert sent to clients whose TLS handshakes are denied.
+	TLS *TLSDenyResponse
+}
+
+// HTTPDenyResponse defines the "403 Forbidden" response sent to denied HTTP requests.
+type HTTPDenyResponse struct {
+	// Body is the body of the response.
+	Body string
+}
+
+// TLSDenyResponse defines the fatal alert sent to clients whose TLS handshakes are denied.
+type TLSDenyResponse struct {
+	// Alert is the description of the alert.
+	Alert TLSAlert
+}
+
+// TLSAlert is the description of a TLS alert.
+type TLSAlert string
+
+const (
+	TLSAlertAccessDenied     TLSAlert = "AccessDenied"
+	TLSAlertHandshakeFailure TLSAlert = "HandshakeFailure"
+	TLSAlertUnrecognizedName TLSAlert = "UnrecognizedName"
+)
+
 // NetworkPolicyPeer describes a peer of NetworkPolicyRules.
 // It could contain one of the subfields or a combination of them.
 type NetworkPolicyPeer struct {
@@ -516,6 +639,15 @@ type SupportBundleCollection struct {
 	SinceTime      string
 	FileServer     BundleFileServer
 	Authentication BundleServerAuthConfiguration
+	// Profile selects the information collected in the bundle. Empty means all.
+	Profile string
+	// RedactionPatterns are regular expressions whose matches are redacted from the bundle.
+	RedactionPatterns []string
+	// AnonymizeIPs replaces the IP addresses in the bundle with pseudonyms.
+	AnonymizeIPs bool
+	// RedactionSeed is the secret seed of the pseudonyms, which is shared by all the Nodes of the
+	// collection so that the pseudonyms are consistent across their bundles.
+	RedactionSeed string
 }
 
 // BundleFileServer specifies the bundle file server information.
@@ -574,4 +706,9 @@ type SupportBundleCollectionNodeStatus struct {
 	Completed bool
 	// Error is the reason for which the SupportBundleCollection is failed on the Node.
 	Error string
+	// Checksum is the checksum of the bundle file uploaded by the Node, in the format
+	// "<algorithm>:<hex digest>", e.g. "sha256:2cf24dba...".
+	Checksum string
+	// Location is the URL of the bundle file uploaded by the Node.
+	Location string
 }
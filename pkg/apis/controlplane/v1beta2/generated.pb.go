@@ -327,10 +327,38 @@ func (m *ClusterGroupMembers) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ClusterGroupMembers proto.InternalMessageInfo
 
+func (m *DNSProtocol) Reset()      { *m = DNSProtocol{} }
+func (*DNSProtocol) ProtoMessage() {}
+func (*DNSProtocol) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fbaa7d016762fa1d, []int{10}
+}
+func (m *DNSProtocol) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DNSProtocol) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *DNSProtocol) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DNSProtocol.Merge(m, src)
+}
+func (m *DNSProtocol) XXX_Size() int {
+	return m.Size()
+}
+func (m *DNSProtocol) XXX_DiscardUnknown() {
+	xxx_messageInfo_DNSProtocol.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DNSProtocol proto.InternalMessageInfo
+
 func (m *EgressGroup) Reset()      { *m = EgressGroup{} }
 func (*EgressGroup) ProtoMessage() {}
 func (*EgressGroup) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{10}
+	return fileDescriptor_fbaa7d016762fa1d, []int{11}
 }
 func (m *EgressGroup) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -358,7 +386,7 @@ var xxx_messageInfo_EgressGroup proto.InternalMessageInfo
 func (m *EgressGroupList) Reset()      { *m = EgressGroupList{} }
 func (*EgressGroupList) ProtoMessage() {}
 func (*EgressGroupList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{11}
+	return fileDescriptor_fbaa7d016762fa1d, []int{12}
 }
 func (m *EgressGroupList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -386,7 +414,7 @@ var xxx_messageInfo_EgressGroupList proto.InternalMessageInfo
 func (m *EgressGroupPatch) Reset()      { *m = EgressGroupPatch{} }
 func (*EgressGroupPatch) ProtoMessage() {}
 func (*EgressGroupPatch) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{12}
+	return fileDescriptor_fbaa7d016762fa1d, []int{13}
 }
 func (m *EgressGroupPatch) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -414,7 +442,7 @@ var xxx_messageInfo_EgressGroupPatch proto.InternalMessageInfo
 func (m *ExternalEntityReference) Reset()      { *m = ExternalEntityReference{} }
 func (*ExternalEntityReference) ProtoMessage() {}
 func (*ExternalEntityReference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{13}
+	return fileDescriptor_fbaa7d016762fa1d, []int{14}
 }
 func (m *ExternalEntityReference) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -439,10 +467,38 @@ func (m *ExternalEntityReference) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ExternalEntityReference proto.InternalMessageInfo
 
+func (m *GRPCProtocol) Reset()      { *m = GRPCProtocol{} }
+func (*GRPCProtocol) ProtoMessage() {}
+func (*GRPCProtocol) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fbaa7d016762fa1d, []int{15}
+}
+func (m *GRPCProtocol) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GRPCProtocol) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *GRPCProtocol) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GRPCProtocol.Merge(m, src)
+}
+func (m *GRPCProtocol) XXX_Size() int {
+	return m.Size()
+}
+func (m *GRPCProtocol) XXX_DiscardUnknown() {
+	xxx_messageInfo_GRPCProtocol.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GRPCProtocol proto.InternalMessageInfo
+
 func (m *GroupAssociation) Reset()      { *m = GroupAssociation{} }
 func (*GroupAssociation) ProtoMessage() {}
 func (*GroupAssociation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{14}
+	return fileDescriptor_fbaa7d016762fa1d, []int{16}
 }
 func (m *GroupAssociation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -470,7 +526,7 @@ var xxx_messageInfo_GroupAssociation proto.InternalMessageInfo
 func (m *GroupMember) Reset()      { *m = GroupMember{} }
 func (*GroupMember) ProtoMessage() {}
 func (*GroupMember) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{15}
+	return fileDescriptor_fbaa7d016762fa1d, []int{17}
 }
 func (m *GroupMember) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -498,7 +554,7 @@ var xxx_messageInfo_GroupMember proto.InternalMessageInfo
 func (m *GroupMembers) Reset()      { *m = GroupMembers{} }
 func (*GroupMembers) ProtoMessage() {}
 func (*GroupMembers) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{16}
+	return fileDescriptor_fbaa7d016762fa1d, []int{18}
 }
 func (m *GroupMembers) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -526,7 +582,7 @@ var xxx_messageInfo_GroupMembers proto.InternalMessageInfo
 func (m *GroupReference) Reset()      { *m = GroupReference{} }
 func (*GroupReference) ProtoMessage() {}
 func (*GroupReference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{17}
+	return fileDescriptor_fbaa7d016762fa1d, []int{19}
 }
 func (m *GroupReference) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -551,10 +607,66 @@ func (m *GroupReference) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GroupReference proto.InternalMessageInfo
 
+func (m *HTTPDenyResponse) Reset()      { *m = HTTPDenyResponse{} }
+func (*HTTPDenyResponse) ProtoMessage() {}
+func (*HTTPDenyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fbaa7d016762fa1d, []int{20}
+}
+func (m *HTTPDenyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HTTPDenyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *HTTPDenyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HTTPDenyResponse.Merge(m, src)
+}
+func (m *HTTPDenyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *HTTPDenyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_HTTPDenyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HTTPDenyResponse proto.InternalMessageInfo
+
+func (m *HTTPHeaderMatch) Reset()      { *m = HTTPHeaderMatch{} }
+func (*HTTPHeaderMatch) ProtoMessage() {}
+func (*HTTPHeaderMatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fbaa7d016762fa1d, []int{21}
+}
+func (m *HTTPHeaderMatch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HTTPHeaderMatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *HTTPHeaderMatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HTTPHeaderMatch.Merge(m, src)
+}
+func (m *HTTPHeaderMatch) XXX_Size() int {
+	return m.Size()
+}
+func (m *HTTPHeaderMatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_HTTPHeaderMatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HTTPHeaderMatch proto.InternalMessageInfo
+
 func (m *HTTPProtocol) Reset()      { *m = HTTPProtocol{} }
 func (*HTTPProtocol) ProtoMessage() {}
 func (*HTTPProtocol) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{18}
+	return fileDescriptor_fbaa7d016762fa1d, []int{22}
 }
 func (m *HTTPProtocol) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -579,10 +691,38 @@ func (m *HTTPProtocol) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_HTTPProtocol proto.InternalMessageInfo
 
+func (m *HTTPQueryParamMatch) Reset()      { *m = HTTPQueryParamMatch{} }
+func (*HTTPQueryParamMatch) ProtoMessage() {}
+func (*HTTPQueryParamMatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fbaa7d016762fa1d, []int{23}
+}
+func (m *HTTPQueryParamMatch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HTTPQueryParamMatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *HTTPQueryParamMatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HTTPQueryParamMatch.Merge(m, src)
+}
+func (m *HTTPQueryParamMatch) XXX_Size() int {
+	return m.Size()
+}
+func (m *HTTPQueryParamMatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_HTTPQueryParamMatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HTTPQueryParamMatch proto.InternalMessageInfo
+
 func (m *IPBlock) Reset()      { *m = IPBlock{} }
 func (*IPBlock) ProtoMessage() {}
 func (*IPBlock) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{19}
+	return fileDescriptor_fbaa7d016762fa1d, []int{24}
 }
 func (m *IPBlock) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -610,7 +750,7 @@ var xxx_messageInfo_IPBlock proto.InternalMessageInfo
 func (m *IPGroupAssociation) Reset()      { *m = IPGroupAssociation{} }
 func (*IPGroupAssociation) ProtoMessage() {}
 func (*IPGroupAssociation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{20}
+	return fileDescriptor_fbaa7d016762fa1d, []int{25}
 }
 func (m *IPGroupAssociation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -638,7 +778,7 @@ var xxx_messageInfo_IPGroupAssociation proto.InternalMessageInfo
 func (m *IPNet) Reset()      { *m = IPNet{} }
 func (*IPNet) ProtoMessage() {}
 func (*IPNet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{21}
+	return fileDescriptor_fbaa7d016762fa1d, []int{26}
 }
 func (m *IPNet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -663,10 +803,66 @@ func (m *IPNet) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_IPNet proto.InternalMessageInfo
 
+func (m *KafkaProtocol) Reset()      { *m = KafkaProtocol{} }
+func (*KafkaProtocol) ProtoMessage() {}
+func (*KafkaProtocol) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fbaa7d016762fa1d, []int{27}
+}
+func (m *KafkaProtocol) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *KafkaProtocol) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *KafkaProtocol) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KafkaProtocol.Merge(m, src)
+}
+func (m *KafkaProtocol) XXX_Size() int {
+	return m.Size()
+}
+func (m *KafkaProtocol) XXX_DiscardUnknown() {
+	xxx_messageInfo_KafkaProtocol.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KafkaProtocol proto.InternalMessageInfo
+
+func (m *L7DenyResponse) Reset()      { *m = L7DenyResponse{} }
+func (*L7DenyResponse) ProtoMessage() {}
+func (*L7DenyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fbaa7d016762fa1d, []int{28}
+}
+func (m *L7DenyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *L7DenyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *L7DenyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_L7DenyResponse.Merge(m, src)
+}
+func (m *L7DenyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *L7DenyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_L7DenyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_L7DenyResponse proto.InternalMessageInfo
+
 func (m *L7Protocol) Reset()      { *m = L7Protocol{} }
 func (*L7Protocol) ProtoMessage() {}
 func (*L7Protocol) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{22}
+	return fileDescriptor_fbaa7d016762fa1d, []int{29}
 }
 func (m *L7Protocol) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -694,7 +890,7 @@ var xxx_messageInfo_L7Protocol proto.InternalMessageInfo
 func (m *MulticastGroupInfo) Reset()      { *m = MulticastGroupInfo{} }
 func (*MulticastGroupInfo) ProtoMessage() {}
 func (*MulticastGroupInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{23}
+	return fileDescriptor_fbaa7d016762fa1d, []int{30}
 }
 func (m *MulticastGroupInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -722,7 +918,7 @@ var xxx_messageInfo_MulticastGroupInfo proto.InternalMessageInfo
 func (m *NamedPort) Reset()      { *m = NamedPort{} }
 func (*NamedPort) ProtoMessage() {}
 func (*NamedPort) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{24}
+	return fileDescriptor_fbaa7d016762fa1d, []int{31}
 }
 func (m *NamedPort) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -750,7 +946,7 @@ var xxx_messageInfo_NamedPort proto.InternalMessageInfo
 func (m *NetworkPolicy) Reset()      { *m = NetworkPolicy{} }
 func (*NetworkPolicy) ProtoMessage() {}
 func (*NetworkPolicy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{25}
+	return fileDescriptor_fbaa7d016762fa1d, []int{32}
 }
 func (m *NetworkPolicy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -778,7 +974,7 @@ var xxx_messageInfo_NetworkPolicy proto.InternalMessageInfo
 func (m *NetworkPolicyList) Reset()      { *m = NetworkPolicyList{} }
 func (*NetworkPolicyList) ProtoMessage() {}
 func (*NetworkPolicyList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{26}
+	return fileDescriptor_fbaa7d016762fa1d, []int{33}
 }
 func (m *NetworkPolicyList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -806,7 +1002,7 @@ var xxx_messageInfo_NetworkPolicyList proto.InternalMessageInfo
 func (m *NetworkPolicyNodeStatus) Reset()      { *m = NetworkPolicyNodeStatus{} }
 func (*NetworkPolicyNodeStatus) ProtoMessage() {}
 func (*NetworkPolicyNodeStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{27}
+	return fileDescriptor_fbaa7d016762fa1d, []int{34}
 }
 func (m *NetworkPolicyNodeStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -834,7 +1030,7 @@ var xxx_messageInfo_NetworkPolicyNodeStatus proto.InternalMessageInfo
 func (m *NetworkPolicyPeer) Reset()      { *m = NetworkPolicyPeer{} }
 func (*NetworkPolicyPeer) ProtoMessage() {}
 func (*NetworkPolicyPeer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{28}
+	return fileDescriptor_fbaa7d016762fa1d, []int{35}
 }
 func (m *NetworkPolicyPeer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -862,7 +1058,7 @@ var xxx_messageInfo_NetworkPolicyPeer proto.InternalMessageInfo
 func (m *NetworkPolicyReference) Reset()      { *m = NetworkPolicyReference{} }
 func (*NetworkPolicyReference) ProtoMessage() {}
 func (*NetworkPolicyReference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{29}
+	return fileDescriptor_fbaa7d016762fa1d, []int{36}
 }
 func (m *NetworkPolicyReference) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -890,7 +1086,7 @@ var xxx_messageInfo_NetworkPolicyReference proto.InternalMessageInfo
 func (m *NetworkPolicyRule) Reset()      { *m = NetworkPolicyRule{} }
 func (*NetworkPolicyRule) ProtoMessage() {}
 func (*NetworkPolicyRule) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{30}
+	return fileDescriptor_fbaa7d016762fa1d, []int{37}
 }
 func (m *NetworkPolicyRule) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -918,7 +1114,7 @@ var xxx_messageInfo_NetworkPolicyRule proto.InternalMessageInfo
 func (m *NetworkPolicyStats) Reset()      { *m = NetworkPolicyStats{} }
 func (*NetworkPolicyStats) ProtoMessage() {}
 func (*NetworkPolicyStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{31}
+	return fileDescriptor_fbaa7d016762fa1d, []int{38}
 }
 func (m *NetworkPolicyStats) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -946,7 +1142,7 @@ var xxx_messageInfo_NetworkPolicyStats proto.InternalMessageInfo
 func (m *NetworkPolicyStatus) Reset()      { *m = NetworkPolicyStatus{} }
 func (*NetworkPolicyStatus) ProtoMessage() {}
 func (*NetworkPolicyStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{32}
+	return fileDescriptor_fbaa7d016762fa1d, []int{39}
 }
 func (m *NetworkPolicyStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -974,7 +1170,7 @@ var xxx_messageInfo_NetworkPolicyStatus proto.InternalMessageInfo
 func (m *NodeReference) Reset()      { *m = NodeReference{} }
 func (*NodeReference) ProtoMessage() {}
 func (*NodeReference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{33}
+	return fileDescriptor_fbaa7d016762fa1d, []int{40}
 }
 func (m *NodeReference) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1002,7 +1198,7 @@ var xxx_messageInfo_NodeReference proto.InternalMessageInfo
 func (m *NodeStatsSummary) Reset()      { *m = NodeStatsSummary{} }
 func (*NodeStatsSummary) ProtoMessage() {}
 func (*NodeStatsSummary) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{34}
+	return fileDescriptor_fbaa7d016762fa1d, []int{41}
 }
 func (m *NodeStatsSummary) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1030,7 +1226,7 @@ var xxx_messageInfo_NodeStatsSummary proto.InternalMessageInfo
 func (m *PaginationGetOptions) Reset()      { *m = PaginationGetOptions{} }
 func (*PaginationGetOptions) ProtoMessage() {}
 func (*PaginationGetOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{35}
+	return fileDescriptor_fbaa7d016762fa1d, []int{42}
 }
 func (m *PaginationGetOptions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1058,7 +1254,7 @@ var xxx_messageInfo_PaginationGetOptions proto.InternalMessageInfo
 func (m *PodReference) Reset()      { *m = PodReference{} }
 func (*PodReference) ProtoMessage() {}
 func (*PodReference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{36}
+	return fileDescriptor_fbaa7d016762fa1d, []int{43}
 }
 func (m *PodReference) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1083,10 +1279,38 @@ func (m *PodReference) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_PodReference proto.InternalMessageInfo
 
+func (m *RuleRateLimit) Reset()      { *m = RuleRateLimit{} }
+func (*RuleRateLimit) ProtoMessage() {}
+func (*RuleRateLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fbaa7d016762fa1d, []int{44}
+}
+func (m *RuleRateLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RuleRateLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *RuleRateLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RuleRateLimit.Merge(m, src)
+}
+func (m *RuleRateLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *RuleRateLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_RuleRateLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RuleRateLimit proto.InternalMessageInfo
+
 func (m *Service) Reset()      { *m = Service{} }
 func (*Service) ProtoMessage() {}
 func (*Service) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{37}
+	return fileDescriptor_fbaa7d016762fa1d, []int{45}
 }
 func (m *Service) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1114,7 +1338,7 @@ var xxx_messageInfo_Service proto.InternalMessageInfo
 func (m *ServiceReference) Reset()      { *m = ServiceReference{} }
 func (*ServiceReference) ProtoMessage() {}
 func (*ServiceReference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{38}
+	return fileDescriptor_fbaa7d016762fa1d, []int{46}
 }
 func (m *ServiceReference) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1142,7 +1366,7 @@ var xxx_messageInfo_ServiceReference proto.InternalMessageInfo
 func (m *SupportBundleCollection) Reset()      { *m = SupportBundleCollection{} }
 func (*SupportBundleCollection) ProtoMessage() {}
 func (*SupportBundleCollection) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{39}
+	return fileDescriptor_fbaa7d016762fa1d, []int{47}
 }
 func (m *SupportBundleCollection) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1170,7 +1394,7 @@ var xxx_messageInfo_SupportBundleCollection proto.InternalMessageInfo
 func (m *SupportBundleCollectionList) Reset()      { *m = SupportBundleCollectionList{} }
 func (*SupportBundleCollectionList) ProtoMessage() {}
 func (*SupportBundleCollectionList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{40}
+	return fileDescriptor_fbaa7d016762fa1d, []int{48}
 }
 func (m *SupportBundleCollectionList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1198,7 +1422,7 @@ var xxx_messageInfo_SupportBundleCollectionList proto.InternalMessageInfo
 func (m *SupportBundleCollectionNodeStatus) Reset()      { *m = SupportBundleCollectionNodeStatus{} }
 func (*SupportBundleCollectionNodeStatus) ProtoMessage() {}
 func (*SupportBundleCollectionNodeStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{41}
+	return fileDescriptor_fbaa7d016762fa1d, []int{49}
 }
 func (m *SupportBundleCollectionNodeStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1226,7 +1450,7 @@ var xxx_messageInfo_SupportBundleCollectionNodeStatus proto.InternalMessageInfo
 func (m *SupportBundleCollectionStatus) Reset()      { *m = SupportBundleCollectionStatus{} }
 func (*SupportBundleCollectionStatus) ProtoMessage() {}
 func (*SupportBundleCollectionStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{42}
+	return fileDescriptor_fbaa7d016762fa1d, []int{50}
 }
 func (m *SupportBundleCollectionStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1251,10 +1475,38 @@ func (m *SupportBundleCollectionStatus) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_SupportBundleCollectionStatus proto.InternalMessageInfo
 
+func (m *TLSDenyResponse) Reset()      { *m = TLSDenyResponse{} }
+func (*TLSDenyResponse) ProtoMessage() {}
+func (*TLSDenyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fbaa7d016762fa1d, []int{51}
+}
+func (m *TLSDenyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TLSDenyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *TLSDenyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TLSDenyResponse.Merge(m, src)
+}
+func (m *TLSDenyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *TLSDenyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TLSDenyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TLSDenyResponse proto.InternalMessageInfo
+
 func (m *TLSProtocol) Reset()      { *m = TLSProtocol{} }
 func (*TLSProtocol) ProtoMessage() {}
 func (*TLSProtocol) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{43}
+	return fileDescriptor_fbaa7d016762fa1d, []int{52}
 }
 func (m *TLSProtocol) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1290,18 +1542,25 @@ func init() {
 	proto.RegisterType((*BundleFileServer)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.BundleFileServer")
 	proto.RegisterType((*BundleServerAuthConfiguration)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.BundleServerAuthConfiguration")
 	proto.RegisterType((*ClusterGroupMembers)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.ClusterGroupMembers")
+	proto.RegisterType((*DNSProtocol)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.DNSProtocol")
 	proto.RegisterType((*EgressGroup)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.EgressGroup")
 	proto.RegisterType((*EgressGroupList)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.EgressGroupList")
 	proto.RegisterType((*EgressGroupPatch)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.EgressGroupPatch")
 	proto.RegisterType((*ExternalEntityReference)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.ExternalEntityReference")
+	proto.RegisterType((*GRPCProtocol)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.GRPCProtocol")
 	proto.RegisterType((*GroupAssociation)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.GroupAssociation")
 	proto.RegisterType((*GroupMember)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.GroupMember")
 	proto.RegisterType((*GroupMembers)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.GroupMembers")
 	proto.RegisterType((*GroupReference)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.GroupReference")
+	proto.RegisterType((*HTTPDenyResponse)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.HTTPDenyResponse")
+	proto.RegisterType((*HTTPHeaderMatch)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.HTTPHeaderMatch")
 	proto.RegisterType((*HTTPProtocol)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.HTTPProtocol")
+	proto.RegisterType((*HTTPQueryParamMatch)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.HTTPQueryParamMatch")
 	proto.RegisterType((*IPBlock)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.IPBlock")
 	proto.RegisterType((*IPGroupAssociation)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.IPGroupAssociation")
 	proto.RegisterType((*IPNet)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.IPNet")
+	proto.RegisterType((*KafkaProtocol)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.KafkaProtocol")
+	proto.RegisterType((*L7DenyResponse)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.L7DenyResponse")
 	proto.RegisterType((*L7Protocol)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.L7Protocol")
 	proto.RegisterType((*MulticastGroupInfo)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.MulticastGroupInfo")
 	proto.RegisterType((*NamedPort)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.NamedPort")
@@ -1317,12 +1576,14 @@ func init() {
 	proto.RegisterType((*NodeStatsSummary)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.NodeStatsSummary")
 	proto.RegisterType((*PaginationGetOptions)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.PaginationGetOptions")
 	proto.RegisterType((*PodReference)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.PodReference")
+	proto.RegisterType((*RuleRateLimit)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.RuleRateLimit")
 	proto.RegisterType((*Service)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.Service")
 	proto.RegisterType((*ServiceReference)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.ServiceReference")
 	proto.RegisterType((*SupportBundleCollection)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.SupportBundleCollection")
 	proto.RegisterType((*SupportBundleCollectionList)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.SupportBundleCollectionList")
 	proto.RegisterType((*SupportBundleCollectionNodeStatus)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.SupportBundleCollectionNodeStatus")
 	proto.RegisterType((*SupportBundleCollectionStatus)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.SupportBundleCollectionStatus")
+	proto.RegisterType((*TLSDenyResponse)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.TLSDenyResponse")
 	proto.RegisterType((*TLSProtocol)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.TLSProtocol")
 }
 
@@ -1331,186 +1592,224 @@ func init() {
 }
 
 var fileDescriptor_fbaa7d016762fa1d = []byte{
-	// 2862 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x1b, 0x4b, 0x6f, 0x24, 0xc5,
-	0x79, 0xdb, 0x33, 0x63, 0x7b, 0xbe, 0x19, 0x7b, 0xbd, 0x65, 0x60, 0x1d, 0x60, 0xed, 0xa5, 0x49,
-	0xd0, 0x26, 0x22, 0x33, 0xd8, 0x01, 0x76, 0xc3, 0x4b, 0xf1, 0x78, 0xbd, 0x66, 0x82, 0x6d, 0x86,
-	0x9a, 0x01, 0x24, 0x08, 0x84, 0x76, 0x77, 0xcd, 0xb8, 0xb3, 0x3d, 0x5d, 0x4d, 0x75, 0x8d, 0x59,
-	0x73, 0x88, 0x88, 0x48, 0x0e, 0xe4, 0x45, 0x94, 0x4b, 0xc4, 0x2d, 0xb7, 0x5c, 0xf2, 0x0b, 0x38,
-	0x85, 0x43, 0x24, 0x8e, 0x44, 0x51, 0x14, 0x4e, 0x56, 0xd6, 0x51, 0x88, 0x38, 0xe4, 0x92, 0x5b,
-	0x36, 0x8a, 0x14, 0x55, 0x75, 0xf5, 0x73, 0x66, 0xd6, 0x3b, 0xb6, 0xd7, 0x91, 0xc2, 0x9e, 0x3c,
-	0xfd, 0xbd, 0xab, 0xea, 0xfb, 0xea, 0x7b, 0x74, 0x1b, 0x9e, 0x31, 0x5c, 0xce, 0x88, 0x51, 0xb1,
-	0x69, 0x35, 0xf8, 0x55, 0xf5, 0xae, 0x76, 0xaa, 0x86, 0x67, 0xfb, 0x55, 0x93, 0xba, 0x9c, 0x51,
-	0xc7, 0x73, 0x0c, 0x97, 0x54, 0x77, 0x16, 0xb7, 0x08, 0x37, 0x96, 0xaa, 0x1d, 0xe2, 0x12, 0x66,
-	0x70, 0x62, 0x55, 0x3c, 0x46, 0x39, 0x45, 0x95, 0x80, 0xeb, 0xbb, 0x36, 0x55, 0xbf, 0x2a, 0xde,
-	0xd5, 0x4e, 0x45, 0xf0, 0x57, 0x92, 0xfc, 0x15, 0xc5, 0x7f, 0xef, 0xa5, 0xe1, 0xfa, 0x7c, 0x6e,
-	0x70, 0xbf, 0xba, 0xb3, 0x68, 0x38, 0xde, 0xb6, 0xb1, 0x98, 0xd5, 0x74, 0xef, 0xd7, 0x3b, 0x36,
-	0xdf, 0xee, 0x6d, 0x55, 0x4c, 0xda, 0xad, 0x76, 0x68, 0x87, 0x56, 0x25, 0x78, 0xab, 0xd7, 0x96,
-	0x4f, 0xf2, 0x41, 0xfe, 0x52, 0xe4, 0x8f, 0x5e, 0xbd, 0xe4, 0x4b, 0x2d, 0x9e, 0xdd, 0x35, 0xcc,
-	0x6d, 0xdb, 0x25, 0x6c, 0x37, 0xd6, 0xd5, 0x25, 0xdc, 0xa8, 0xee, 0xf4, 0x2b, 0xa9, 0x0e, 0xe3,
-	0x62, 0x3d, 0x97, 0xdb, 0x5d, 0xd2, 0xc7, 0xf0, 0xf8, 0x41, 0x0c, 0xbe, 0xb9, 0x4d, 0xba, 0x46,
-	0x1f, 0xdf, 0x37, 0x86, 0xf1, 0xf5, 0xb8, 0xed, 0x54, 0x6d, 0x97, 0xfb, 0x9c, 0x65, 0x99, 0xf4,
-	0xbf, 0x6b, 0x50, 0x5e, 0xb6, 0x2c, 0x46, 0x7c, 0x7f, 0x8d, 0xd1, 0x9e, 0x87, 0xde, 0x80, 0x49,
-	0xb1, 0x12, 0xcb, 0xe0, 0xc6, 0x9c, 0x76, 0x5e, 0xbb, 0x50, 0x5a, 0x7a, 0xa4, 0x12, 0x08, 0xae,
-	0x24, 0x05, 0xc7, 0x67, 0x22, 0xa8, 0x2b, 0x3b, 0x8b, 0x95, 0xe7, 0xb7, 0xbe, 0x47, 0x4c, 0xbe,
-	0x41, 0xb8, 0x51, 0x43, 0x1f, 0xef, 0x2d, 0x9c, 0xda, 0xdf, 0x5b, 0x80, 0x18, 0x86, 0x23, 0xa9,
-	0xa8, 0x07, 0xe5, 0x8e, 0x50, 0xb5, 0x41, 0xba, 0x5b, 0x84, 0xf9, 0x73, 0x63, 0xe7, 0x73, 0x17,
-	0x4a, 0x4b, 0x4f, 0x8e, 0x78, 0xec, 0x95, 0xb5, 0x58, 0x46, 0xed, 0x2e, 0xa5, 0xb0, 0x9c, 0x00,
-	0xfa, 0x38, 0xa5, 0x46, 0xff, 0xa3, 0x06, 0x33, 0xc9, 0x95, 0xae, 0xdb, 0x3e, 0x47, 0xdf, 0xe9,
-	0x5b, 0x6d, 0xe5, 0xd6, 0x56, 0x2b, 0xb8, 0xe5, 0x5a, 0x67, 0x94, 0xea, 0xc9, 0x10, 0x92, 0x58,
-	0xa9, 0x01, 0x05, 0x9b, 0x93, 0x6e, 0xb8, 0xc4, 0xa7, 0x46, 0x5d, 0x62, 0xd2, 0xdc, 0xda, 0x94,
-	0x52, 0x54, 0xa8, 0x0b, 0x91, 0x38, 0x90, 0xac, 0xbf, 0x97, 0x83, 0x33, 0x49, 0xb2, 0x86, 0xc1,
-	0xcd, 0xed, 0x13, 0x38, 0xc4, 0x1f, 0x6a, 0x70, 0xc6, 0xb0, 0x2c, 0x62, 0xad, 0x1d, 0xf3, 0x51,
-	0x7e, 0x49, 0xa9, 0x15, 0xab, 0x4a, 0x4b, 0xc7, 0xfd, 0x0a, 0xd1, 0x8f, 0x35, 0x98, 0x65, 0xa4,
-	0x4b, 0x77, 0x32, 0x86, 0xe4, 0x8e, 0x6e, 0xc8, 0x7d, 0xca, 0x90, 0x59, 0xdc, 0x2f, 0x1f, 0x0f,
-	0x52, 0xaa, 0x7f, 0xae, 0xc1, 0xf4, 0xb2, 0xe7, 0x39, 0x36, 0xb1, 0x5a, 0xf4, 0xff, 0x3c, 0x9a,
-	0xfe, 0xac, 0x01, 0x4a, 0xaf, 0xf5, 0x04, 0xe2, 0xc9, 0x4c, 0xc7, 0xd3, 0x33, 0x23, 0xc7, 0x53,
-	0xca, 0xe0, 0x21, 0x11, 0xf5, 0x93, 0x1c, 0xcc, 0xa6, 0x09, 0xef, 0xc4, 0xd4, 0xff, 0x2e, 0xa6,
-	0xde, 0x84, 0xd9, 0x9a, 0xe1, 0xdb, 0xe6, 0x72, 0x8f, 0x6f, 0x13, 0x97, 0xdb, 0xa6, 0xc1, 0x6d,
-	0xea, 0xa2, 0x87, 0x61, 0xb2, 0xe7, 0x13, 0xe6, 0x1a, 0x5d, 0x22, 0x0f, 0xa3, 0x18, 0xfb, 0xcd,
-	0x8b, 0x0a, 0x8e, 0x23, 0x0a, 0x41, 0xed, 0x19, 0xbe, 0xff, 0x16, 0x65, 0xd6, 0xdc, 0x58, 0x9a,
-	0xba, 0xa1, 0xe0, 0x38, 0xa2, 0xd0, 0x17, 0x61, 0xa6, 0xd6, 0x73, 0x2d, 0x87, 0x5c, 0xb1, 0x1d,
-	0xd2, 0x24, 0x6c, 0x87, 0x30, 0x74, 0x0e, 0x72, 0x3d, 0xe6, 0x28, 0x55, 0x25, 0xc5, 0x9c, 0x7b,
-	0x11, 0xaf, 0x63, 0x01, 0xd7, 0xdf, 0x1f, 0x83, 0x73, 0x01, 0x4f, 0x40, 0x2f, 0xac, 0x5d, 0xa1,
-	0x6e, 0xdb, 0xee, 0xf4, 0x58, 0x60, 0xf0, 0x63, 0x50, 0xda, 0x22, 0x06, 0x23, 0xac, 0x45, 0xaf,
-	0x12, 0x57, 0x09, 0x9a, 0x55, 0x82, 0x4a, 0xb5, 0x18, 0x85, 0x93, 0x74, 0xe8, 0x21, 0x18, 0x37,
-	0x3c, 0xfb, 0x39, 0xb2, 0xab, 0xec, 0x9e, 0x56, 0x1c, 0xe3, 0xcb, 0x8d, 0xfa, 0x73, 0x64, 0x17,
-	0x2b, 0x2c, 0xfa, 0xb9, 0x06, 0xb3, 0x5b, 0xfd, 0xfb, 0x34, 0x97, 0x93, 0x8e, 0xba, 0x32, 0xea,
-	0x99, 0x0d, 0xd8, 0xf2, 0xda, 0x59, 0x71, 0x6e, 0x03, 0x10, 0x78, 0x90, 0x62, 0xfd, 0xd7, 0x79,
-	0x98, 0x5d, 0x71, 0x7a, 0x3e, 0x27, 0x2c, 0xe5, 0x5c, 0xb7, 0x3f, 0x8a, 0x7e, 0xa0, 0xc1, 0x0c,
-	0x69, 0xb7, 0x89, 0xc9, 0xed, 0x1d, 0x72, 0x8c, 0x41, 0x34, 0xa7, 0xb4, 0xce, 0xac, 0x66, 0x84,
-	0xe3, 0x3e, 0x75, 0xe8, 0xfb, 0x70, 0x26, 0x82, 0xd5, 0x1b, 0x35, 0x87, 0x9a, 0x57, 0xc3, 0xf8,
-	0x79, 0x6c, 0x54, 0x1b, 0xea, 0x8d, 0x4d, 0xc2, 0xe3, 0x10, 0x5e, 0xcd, 0xca, 0xc5, 0xfd, 0xaa,
-	0xd0, 0x25, 0x28, 0x73, 0xca, 0x0d, 0x27, 0x5c, 0x7e, 0xfe, 0xbc, 0x76, 0x21, 0x17, 0xdf, 0xeb,
-	0xad, 0x04, 0x0e, 0xa7, 0x28, 0xd1, 0x12, 0x80, 0x7c, 0x6e, 0x18, 0x1d, 0xe2, 0xcf, 0x15, 0x24,
-	0x5f, 0xb4, 0xdf, 0xad, 0x08, 0x83, 0x13, 0x54, 0xc2, 0xb7, 0xcd, 0x1e, 0x63, 0xc4, 0xe5, 0xe2,
-	0x79, 0x6e, 0x5c, 0x32, 0x45, 0xbe, 0xbd, 0x12, 0xa3, 0x70, 0x92, 0x4e, 0xff, 0x4c, 0x83, 0xd2,
-	0x6a, 0xe7, 0x0b, 0x50, 0x79, 0xfe, 0x41, 0x83, 0xd3, 0x89, 0x85, 0x9e, 0x40, 0xa2, 0x7c, 0x23,
-	0x9d, 0x28, 0x47, 0x5e, 0x61, 0xc2, 0xda, 0x21, 0x59, 0xf2, 0xa7, 0x39, 0x98, 0x49, 0x50, 0x05,
-	0x29, 0xd2, 0x02, 0xa0, 0xd1, 0xbe, 0x1f, 0xeb, 0x19, 0x26, 0xe4, 0xde, 0x49, 0x93, 0x03, 0xd2,
-	0xa4, 0x03, 0x67, 0x57, 0xaf, 0x71, 0x91, 0xee, 0x9c, 0x55, 0x97, 0xdb, 0x7c, 0x17, 0x93, 0x36,
-	0x61, 0xc4, 0x35, 0x09, 0x3a, 0x0f, 0xf9, 0x44, 0x9a, 0x2c, 0x2b, 0xd1, 0xf9, 0x4d, 0x91, 0x22,
-	0x25, 0x06, 0x55, 0xa1, 0x28, 0xfe, 0xfa, 0x9e, 0x61, 0x12, 0x95, 0x67, 0xce, 0x28, 0xb2, 0xe2,
-	0x66, 0x88, 0xc0, 0x31, 0x8d, 0xfe, 0x6f, 0x0d, 0x66, 0xa4, 0xfa, 0x65, 0xdf, 0xa7, 0xa6, 0x1d,
-	0x64, 0xb8, 0x13, 0xa9, 0x8f, 0x66, 0x0c, 0xa5, 0x51, 0xad, 0xff, 0xd0, 0xa5, 0xa0, 0xe4, 0x8e,
-	0x36, 0x29, 0xbe, 0xdc, 0x97, 0x33, 0xf2, 0x71, 0x9f, 0x46, 0xfd, 0xc3, 0x3c, 0x94, 0x12, 0x9b,
-	0x8f, 0x5e, 0x86, 0x9c, 0x47, 0x2d, 0xb5, 0xe6, 0x91, 0x7b, 0xbc, 0x06, 0xb5, 0x62, 0x33, 0x26,
-	0x44, 0x55, 0x21, 0x20, 0x42, 0x22, 0x7a, 0x57, 0x83, 0x69, 0x92, 0x3a, 0x55, 0x79, 0x3a, 0xa5,
-	0xa5, 0xb5, 0x91, 0xe3, 0x79, 0xb0, 0x6f, 0xd4, 0xd0, 0xfe, 0xde, 0xc2, 0x74, 0x06, 0x99, 0x51,
-	0x89, 0x1e, 0x82, 0x9c, 0xed, 0x05, 0x6e, 0x5d, 0xae, 0xdd, 0x25, 0x0c, 0xac, 0x37, 0xfc, 0x1b,
-	0x7b, 0x0b, 0xc5, 0x7a, 0x43, 0x35, 0x9e, 0x58, 0x10, 0xa0, 0xd7, 0xa1, 0xe0, 0x51, 0xc6, 0x45,
-	0xb2, 0x11, 0x27, 0xf2, 0xcd, 0x51, 0x6d, 0x14, 0x9e, 0x66, 0x35, 0x28, 0xe3, 0xf1, 0x8d, 0x23,
-	0x9e, 0x7c, 0x1c, 0x88, 0x45, 0xaf, 0x42, 0xde, 0xa5, 0x16, 0x91, 0x39, 0xa9, 0xb4, 0xf4, 0xf4,
-	0xc8, 0xe2, 0xa9, 0x45, 0xe2, 0x85, 0x4f, 0xca, 0x10, 0x10, 0x20, 0x29, 0x14, 0x75, 0x60, 0xc2,
-	0x27, 0x6c, 0xc7, 0x36, 0x83, 0xf4, 0x55, 0x5a, 0xfa, 0xd6, 0xa8, 0xf2, 0x9b, 0x01, 0x7b, 0xac,
-	0xa2, 0xb4, 0xbf, 0xb7, 0x30, 0x11, 0x42, 0x43, 0xe9, 0xfa, 0x07, 0x79, 0x28, 0xdf, 0x29, 0x88,
-	0xee, 0x14, 0x44, 0x83, 0x0a, 0xa2, 0xdf, 0x68, 0x30, 0x9d, 0xbe, 0x97, 0xd2, 0x57, 0xb3, 0x76,
-	0xf0, 0xd5, 0x1c, 0xdd, 0xf6, 0x63, 0x43, 0x6f, 0xfb, 0x1a, 0xe4, 0x7a, 0xb6, 0x25, 0x3b, 0x83,
-	0x62, 0xed, 0x91, 0xa8, 0x95, 0xa9, 0x5f, 0xbe, 0xb1, 0xb7, 0xf0, 0xc0, 0xb0, 0x11, 0x22, 0xdf,
-	0xf5, 0x88, 0x5f, 0x79, 0xb1, 0x7e, 0x19, 0x0b, 0x66, 0xfd, 0x6d, 0x28, 0x3f, 0xdb, 0x6a, 0x35,
-	0x1a, 0x8c, 0x72, 0x6a, 0x52, 0x47, 0x68, 0xdd, 0xa6, 0x3e, 0xcf, 0xe6, 0x98, 0x67, 0xa9, 0xcf,
-	0xb1, 0xc4, 0x88, 0x46, 0xa6, 0x4b, 0xf8, 0x36, 0xb5, 0xb2, 0x8d, 0xcc, 0x86, 0x84, 0x62, 0x85,
-	0x15, 0x92, 0x3c, 0x83, 0x6f, 0x2b, 0xf3, 0x22, 0x49, 0x0d, 0x83, 0x6f, 0x63, 0x89, 0xd1, 0x3f,
-	0xd2, 0x60, 0x42, 0x9d, 0x2b, 0x7a, 0x19, 0xf2, 0xa6, 0x6d, 0x31, 0x15, 0x38, 0x87, 0xf4, 0xa4,
-	0x48, 0xc9, 0x4a, 0xfd, 0x32, 0xc6, 0x52, 0x20, 0x7a, 0x0d, 0xc6, 0xc9, 0x35, 0x93, 0x78, 0x5c,
-	0x05, 0xca, 0x21, 0x45, 0x47, 0xab, 0x5c, 0x95, 0xc2, 0xb0, 0x12, 0xaa, 0xff, 0x47, 0x03, 0x54,
-	0x6f, 0x7c, 0x71, 0x53, 0x68, 0x1b, 0x0a, 0x72, 0x83, 0xd0, 0x83, 0x30, 0x66, 0x7b, 0x72, 0xad,
-	0xe5, 0xda, 0xec, 0xfe, 0xde, 0xc2, 0x58, 0xbd, 0x91, 0x4e, 0x2d, 0x63, 0xb6, 0x27, 0x82, 0xd7,
-	0x63, 0xa4, 0x6d, 0x5f, 0x5b, 0x27, 0x6e, 0x87, 0x6f, 0x4b, 0x0f, 0x2a, 0xc4, 0xc1, 0xdb, 0x48,
-	0xe0, 0x70, 0x8a, 0x52, 0xff, 0x9d, 0x06, 0xb0, 0x7e, 0x31, 0x72, 0xd3, 0x57, 0x20, 0xbf, 0xcd,
-	0xb9, 0x77, 0xd8, 0x54, 0x9d, 0x74, 0xf9, 0x20, 0x83, 0x08, 0x08, 0x96, 0x32, 0xd1, 0x4b, 0x90,
-	0xe3, 0x8e, 0xaf, 0x12, 0xf4, 0xc8, 0xf7, 0x6a, 0x6b, 0xbd, 0x19, 0x49, 0x96, 0x45, 0x40, 0x6b,
-	0xbd, 0x89, 0x85, 0x40, 0xfd, 0x03, 0x0d, 0xd0, 0x46, 0xcf, 0x11, 0x8d, 0xb5, 0xcf, 0xe5, 0xf6,
-	0xd5, 0xdd, 0x36, 0x45, 0x0f, 0x42, 0x41, 0xf6, 0x18, 0x2a, 0xe4, 0xa2, 0x94, 0x19, 0x1c, 0x4a,
-	0x80, 0x43, 0xaf, 0x43, 0xde, 0xa3, 0xd6, 0xa1, 0xc7, 0xcf, 0xa9, 0xd2, 0x24, 0x0e, 0x45, 0x6a,
-	0xf9, 0x58, 0xca, 0xd5, 0xdf, 0xd3, 0xa0, 0x18, 0xa5, 0x6d, 0x19, 0xba, 0x94, 0x05, 0x97, 0x40,
-	0x21, 0x49, 0xcf, 0x38, 0x96, 0x98, 0x5b, 0xb8, 0x9c, 0x2e, 0xc1, 0xa4, 0xa7, 0xf6, 0x41, 0x5d,
-	0x01, 0xf7, 0x47, 0x93, 0x1a, 0x05, 0xbf, 0x91, 0xf8, 0x8d, 0x23, 0x6a, 0xfd, 0x1f, 0x39, 0x98,
-	0xda, 0x24, 0xfc, 0x2d, 0xca, 0xae, 0x36, 0xa8, 0x63, 0x9b, 0xbb, 0x27, 0x10, 0x4d, 0x6d, 0x28,
-	0xb0, 0x9e, 0x43, 0xc2, 0x0d, 0x5e, 0x1e, 0xb9, 0x26, 0x49, 0xda, 0x8b, 0x7b, 0x0e, 0x89, 0xcf,
-	0x51, 0x3c, 0xf9, 0x38, 0x10, 0x8f, 0x9e, 0x86, 0xd3, 0x46, 0x6a, 0x22, 0x19, 0xe4, 0xce, 0xa2,
-	0x0c, 0x99, 0xd3, 0xe9, 0x61, 0xa5, 0x8f, 0xb3, 0xb4, 0xe8, 0x82, 0xd8, 0x54, 0x9b, 0x32, 0x51,
-	0x40, 0x8a, 0xc4, 0xa7, 0xd5, 0xca, 0xc1, 0x86, 0x06, 0x30, 0x1c, 0x61, 0xd1, 0xa3, 0x50, 0xe6,
-	0x36, 0x61, 0x21, 0x46, 0xa6, 0xbb, 0x42, 0x6d, 0x46, 0xa6, 0xc8, 0x04, 0x1c, 0xa7, 0xa8, 0x90,
-	0x0f, 0x45, 0x9f, 0xf6, 0x98, 0x2c, 0x7e, 0x54, 0xf9, 0x74, 0xe5, 0x68, 0x5b, 0x11, 0x79, 0xdd,
-	0x94, 0x48, 0x74, 0xcd, 0x50, 0x38, 0x8e, 0xf5, 0xe8, 0x7f, 0xd2, 0xe0, 0x4c, 0x8a, 0xe9, 0x04,
-	0xda, 0xea, 0xad, 0x74, 0x5b, 0xfd, 0xf4, 0x91, 0x16, 0x39, 0xa4, 0xb1, 0xfe, 0xa7, 0x06, 0x67,
-	0x53, 0x74, 0xa2, 0x4a, 0x6d, 0x72, 0x83, 0xf7, 0x7c, 0xf4, 0x30, 0x4c, 0x8a, 0x6a, 0x75, 0x73,
-	0xc0, 0xd4, 0x73, 0x53, 0xc1, 0x71, 0x44, 0x21, 0x2a, 0x17, 0xf5, 0xb6, 0xcf, 0xa6, 0xae, 0x8c,
-	0xb9, 0x44, 0xe5, 0xb2, 0x16, 0x61, 0x70, 0x82, 0x0a, 0x7d, 0x1b, 0x10, 0x23, 0x86, 0x63, 0xbf,
-	0x2d, 0x1f, 0xaf, 0x18, 0xb6, 0xd3, 0x63, 0x44, 0x46, 0xe2, 0x64, 0xed, 0x5e, 0xc5, 0x8b, 0x70,
-	0x1f, 0x05, 0x1e, 0xc0, 0x85, 0xbe, 0x0a, 0x13, 0x5d, 0xe2, 0xfb, 0xa2, 0x02, 0xca, 0x4b, 0x63,
-	0x4f, 0x2b, 0x01, 0x13, 0x1b, 0x01, 0x18, 0x87, 0x78, 0xf9, 0x16, 0x2b, 0xb5, 0xe8, 0x06, 0x21,
-	0x0c, 0x5d, 0x84, 0x29, 0x23, 0xf1, 0x6a, 0xcb, 0x9f, 0xd3, 0xa4, 0xd3, 0x9f, 0xd9, 0xdf, 0x5b,
-	0x98, 0x4a, 0xbe, 0xf3, 0xf2, 0x71, 0x9a, 0x0e, 0x11, 0x98, 0xb4, 0x3d, 0x55, 0x64, 0x06, 0x47,
-	0x75, 0x71, 0xf4, 0xfc, 0x2d, 0xf9, 0xe3, 0x0d, 0x8e, 0xaa, 0xcb, 0x48, 0x34, 0x5a, 0x80, 0x42,
-	0xfb, 0x4d, 0xcb, 0x0d, 0x83, 0xb1, 0x28, 0xce, 0xf2, 0xca, 0x0b, 0x97, 0x37, 0x7d, 0x1c, 0xc0,
-	0x11, 0x17, 0xb5, 0xa3, 0x6a, 0x01, 0xc2, 0xbe, 0xe8, 0xe8, 0x8d, 0x45, 0xa2, 0xfa, 0x0c, 0x65,
-	0xe3, 0x84, 0x1e, 0x71, 0x5b, 0x38, 0xc6, 0x16, 0x71, 0xea, 0x16, 0x11, 0x1d, 0x9c, 0x2d, 0xcb,
-	0xd6, 0xdc, 0x85, 0xa9, 0xe0, 0xb6, 0x58, 0x4f, 0xa3, 0x70, 0x96, 0x56, 0xff, 0x4c, 0x83, 0x7b,
-	0x06, 0x47, 0x23, 0x7a, 0x0c, 0xf2, 0xa2, 0x10, 0x54, 0xbe, 0xf7, 0x40, 0x78, 0x7f, 0xb7, 0x76,
-	0x3d, 0x72, 0x63, 0x6f, 0x21, 0x7d, 0x82, 0x02, 0x88, 0x25, 0xf9, 0xc8, 0xf3, 0x85, 0x28, 0x4f,
-	0xe4, 0x0e, 0x2a, 0x62, 0xf3, 0x47, 0x29, 0x62, 0x3f, 0x1a, 0xcf, 0x38, 0x9d, 0xb8, 0x73, 0xd1,
-	0x53, 0x50, 0xb4, 0x6c, 0x26, 0xda, 0x07, 0x1a, 0x8e, 0xe9, 0xe7, 0x43, 0x63, 0x2f, 0x87, 0x88,
-	0x1b, 0xc9, 0x07, 0x1c, 0x33, 0x20, 0x13, 0xf2, 0x6d, 0x46, 0xbb, 0xaa, 0x0c, 0x38, 0x5a, 0x42,
-	0x10, 0x31, 0x10, 0x2f, 0xfe, 0x0a, 0xa3, 0x5d, 0x2c, 0x85, 0xa3, 0xd7, 0x60, 0x8c, 0x53, 0x35,
-	0xda, 0x3f, 0x06, 0x15, 0xa0, 0x54, 0x8c, 0xb5, 0x28, 0x1e, 0xe3, 0x54, 0x44, 0x8f, 0x9f, 0xf6,
-	0xd9, 0x8b, 0x87, 0xf4, 0xd9, 0x38, 0x7a, 0x22, 0x47, 0x8d, 0x44, 0xcb, 0x97, 0x32, 0x99, 0x3c,
-	0x13, 0xa7, 0xfa, 0xbe, 0xcc, 0xf4, 0x12, 0x8c, 0x1b, 0xc1, 0x99, 0x8c, 0xcb, 0x33, 0x79, 0x46,
-	0xbe, 0x04, 0x09, 0x0f, 0xe3, 0x91, 0x9b, 0x7c, 0x72, 0xc2, 0x2c, 0xf5, 0xa5, 0xc9, 0x62, 0x45,
-	0x1c, 0x70, 0xc0, 0x83, 0x95, 0x34, 0xf4, 0x24, 0x4c, 0x11, 0xd7, 0xd8, 0x72, 0xc8, 0x3a, 0xed,
-	0x74, 0x6c, 0xb7, 0x33, 0x37, 0x21, 0xef, 0xba, 0xbb, 0x95, 0x29, 0x53, 0xab, 0x49, 0x24, 0x4e,
-	0xd3, 0x0e, 0xca, 0xcb, 0x93, 0x23, 0xe4, 0xe5, 0xd0, 0xcd, 0x8b, 0x43, 0xdd, 0xfc, 0x4d, 0x28,
-	0x39, 0x51, 0xf9, 0xea, 0xcf, 0x81, 0x3c, 0x8d, 0x27, 0x46, 0x3d, 0x8d, 0xb8, 0x02, 0x8e, 0x9b,
-	0xd0, 0x18, 0xe6, 0xe3, 0xa4, 0x0e, 0x71, 0x2c, 0x0e, 0xed, 0xc8, 0x5b, 0x62, 0xae, 0x94, 0xce,
-	0x31, 0xeb, 0x0a, 0x8e, 0x23, 0x0a, 0xfd, 0xfd, 0x1c, 0xa0, 0x94, 0x47, 0x89, 0x4c, 0xe5, 0xa3,
-	0x77, 0x35, 0x98, 0x72, 0x93, 0x60, 0x95, 0x8c, 0x8f, 0xab, 0x2c, 0x88, 0x8e, 0x27, 0x8d, 0x4f,
-	0xeb, 0x44, 0x1e, 0x94, 0x39, 0x33, 0xda, 0x6d, 0xdb, 0x94, 0x56, 0xa9, 0xa0, 0x7c, 0xfc, 0x26,
-	0x36, 0xc8, 0xef, 0x85, 0x2a, 0xe1, 0xf7, 0x42, 0x95, 0x56, 0x82, 0x3b, 0x31, 0x2c, 0x48, 0x40,
-	0x71, 0x4a, 0x03, 0x7a, 0x47, 0x83, 0x19, 0x51, 0xb2, 0x25, 0x49, 0xd4, 0x98, 0xe3, 0x89, 0x5b,
-	0x57, 0x8b, 0x33, 0x12, 0xe2, 0xd6, 0x2a, 0x8b, 0xc1, 0x7d, 0xda, 0xf4, 0xbf, 0x69, 0x30, 0xdb,
-	0x77, 0x22, 0xbd, 0x93, 0x98, 0x33, 0x39, 0x50, 0x10, 0xb5, 0x47, 0x98, 0x72, 0xd7, 0x8e, 0x74,
-	0xd6, 0x71, 0xd5, 0x13, 0xd7, 0x49, 0x02, 0xe6, 0xe3, 0x40, 0x89, 0xbe, 0x08, 0x53, 0xa9, 0x91,
-	0xde, 0xc1, 0x73, 0x6e, 0xfd, 0xc3, 0x02, 0xcc, 0x84, 0x72, 0xfd, 0x66, 0xaf, 0xdb, 0x35, 0xd8,
-	0x49, 0x74, 0x09, 0x3f, 0xd2, 0xe0, 0x74, 0xd2, 0x31, 0xed, 0x68, 0x8b, 0x6a, 0x47, 0xda, 0xa2,
-	0xc0, 0x37, 0xce, 0x2a, 0xdd, 0xa7, 0x37, 0xd3, 0x2a, 0x70, 0x56, 0x27, 0xfa, 0xad, 0x06, 0xf7,
-	0x07, 0x5a, 0xd4, 0x8b, 0xd9, 0x0c, 0x87, 0x72, 0xd4, 0xe3, 0x30, 0xea, 0xcb, 0xca, 0xa8, 0xfb,
-	0x97, 0x6f, 0xa2, 0x0f, 0xdf, 0xd4, 0x1a, 0xf4, 0x2b, 0x0d, 0xee, 0x0e, 0x08, 0xb2, 0x76, 0xe6,
-	0x8f, 0xcd, 0xce, 0x73, 0xca, 0xce, 0xbb, 0x97, 0x07, 0x29, 0xc2, 0x83, 0xf5, 0x8b, 0x7e, 0xa7,
-	0x1b, 0x76, 0xe4, 0xb2, 0xb4, 0x3a, 0x84, 0x31, 0xfd, 0x2d, 0x7d, 0x5c, 0x13, 0x45, 0x38, 0x1c,
-	0xeb, 0xd1, 0x5f, 0x83, 0xbb, 0x1a, 0x46, 0xc7, 0x76, 0x65, 0x89, 0xbd, 0x46, 0xf8, 0xf3, 0x9e,
-	0xf8, 0xe1, 0x07, 0x03, 0xb3, 0x4e, 0xe0, 0xf6, 0xb9, 0xe4, 0xc0, 0xac, 0x43, 0xb0, 0xc4, 0xa0,
-	0x07, 0xa1, 0xe0, 0xd8, 0x5d, 0x9b, 0xab, 0x16, 0x20, 0x0a, 0xa7, 0x75, 0x01, 0xc4, 0x01, 0x4e,
-	0x37, 0xa0, 0x9c, 0x6c, 0xf7, 0x6f, 0xc7, 0x5b, 0xa3, 0xdf, 0xe7, 0x20, 0x9c, 0x87, 0xa3, 0x47,
-	0x13, 0x7d, 0x7e, 0xa0, 0x62, 0xee, 0xe0, 0x1e, 0x1f, 0x6d, 0xaa, 0x09, 0xc3, 0xd8, 0x01, 0x71,
-	0xda, 0xe3, 0xb6, 0x53, 0x09, 0x3e, 0x78, 0xac, 0xd4, 0x5d, 0xfe, 0x3c, 0x6b, 0x72, 0x66, 0xbb,
-	0x9d, 0x60, 0x66, 0x93, 0x98, 0x47, 0x7c, 0x05, 0x26, 0x88, 0x2b, 0x87, 0x17, 0xb2, 0x9a, 0x2a,
-	0x04, 0x33, 0xfb, 0xd5, 0x00, 0x84, 0x43, 0x9c, 0xe8, 0x9f, 0x6d, 0xb3, 0xeb, 0x89, 0x8a, 0x56,
-	0x56, 0x9c, 0x85, 0xa0, 0x7f, 0xae, 0xaf, 0x6c, 0x34, 0x64, 0x95, 0x1b, 0x61, 0x43, 0xca, 0x95,
-	0xf0, 0x3d, 0x45, 0x82, 0x52, 0xc0, 0x70, 0x84, 0x95, 0x94, 0x1d, 0x25, 0x73, 0x3c, 0x41, 0xb9,
-	0x16, 0xc9, 0x54, 0x58, 0x74, 0x49, 0xbd, 0xb4, 0x56, 0x1d, 0x8f, 0x2c, 0x50, 0x8a, 0x99, 0xf7,
-	0xce, 0xe1, 0xb4, 0x2c, 0x45, 0x29, 0x96, 0xe7, 0x33, 0x53, 0x2e, 0x6f, 0x32, 0x5e, 0x5e, 0x33,
-	0x00, 0xe1, 0x10, 0x87, 0x2a, 0x00, 0x3e, 0x33, 0xd5, 0xaa, 0x65, 0x31, 0x52, 0xa8, 0x4d, 0x8b,
-	0xdb, 0xac, 0x19, 0x41, 0x71, 0x82, 0x42, 0x27, 0x30, 0x93, 0xed, 0x49, 0x6e, 0x87, 0xbb, 0xbc,
-	0x9f, 0x87, 0xb3, 0xcd, 0x9e, 0x27, 0x0e, 0x2a, 0xf8, 0xb4, 0x66, 0x85, 0x3a, 0x8e, 0x2a, 0xb3,
-	0x6f, 0xff, 0xa5, 0xfd, 0x2a, 0x14, 0xc9, 0x35, 0xcf, 0x66, 0xc4, 0x5a, 0x0e, 0xfd, 0xed, 0x6b,
-	0xb7, 0xa6, 0xa2, 0x65, 0x77, 0x49, 0xbc, 0xb4, 0xd5, 0x50, 0x08, 0x8e, 0xe5, 0x89, 0xbd, 0xf0,
-	0x6d, 0xd7, 0x24, 0x82, 0x54, 0x35, 0x39, 0x11, 0x43, 0x33, 0x44, 0xe0, 0x98, 0x46, 0x34, 0x92,
-	0xed, 0xe8, 0x63, 0x24, 0xe9, 0x83, 0x87, 0x68, 0x24, 0xb3, 0x1f, 0x35, 0xc5, 0x3b, 0x10, 0xc3,
-	0x70, 0x42, 0x0f, 0xfa, 0x99, 0x06, 0xd3, 0x46, 0xfa, 0x7b, 0xa2, 0xe0, 0xe5, 0xdb, 0xc6, 0xe1,
-	0x54, 0x0f, 0xf9, 0x36, 0xaa, 0x76, 0x8f, 0xb2, 0x63, 0x3a, 0xf3, 0x61, 0x51, 0x46, 0xb9, 0xfe,
-	0xb9, 0x06, 0xf7, 0x0d, 0xf1, 0x88, 0x13, 0x18, 0xfe, 0x38, 0xe9, 0xe1, 0xcf, 0xc8, 0xe5, 0xcd,
-	0x10, 0xcb, 0x87, 0x8c, 0x81, 0x7e, 0x39, 0x06, 0x0f, 0x0c, 0xe1, 0x38, 0xf4, 0x40, 0xe8, 0x49,
-	0x98, 0x0a, 0x7f, 0x27, 0xc3, 0x30, 0x2e, 0xa6, 0x93, 0x48, 0x9c, 0xa6, 0x0d, 0x55, 0xc9, 0x0b,
-	0x2b, 0xd7, 0xaf, 0x2a, 0xb8, 0xb4, 0x42, 0x0a, 0xe1, 0xe1, 0x26, 0xed, 0x7a, 0x0e, 0xe1, 0x24,
-	0xe8, 0xd2, 0x27, 0x63, 0x0f, 0x5f, 0x09, 0x11, 0x38, 0xa6, 0x11, 0x49, 0x8a, 0x30, 0x46, 0x99,
-	0xf4, 0xb0, 0xc4, 0x3c, 0x7b, 0x55, 0x00, 0x71, 0x80, 0xd3, 0xff, 0xa5, 0xc1, 0xb9, 0x21, 0x9b,
-	0x72, 0x62, 0x55, 0xee, 0x4e, 0xba, 0xca, 0x7d, 0xe1, 0x98, 0xdc, 0xe0, 0xc0, 0x7a, 0xf7, 0x61,
-	0x28, 0x25, 0x5e, 0x12, 0xa0, 0x73, 0x90, 0xf3, 0x5d, 0x3b, 0xfb, 0x41, 0x62, 0x73, 0xb3, 0x8e,
-	0x05, 0xbc, 0xd6, 0xfa, 0xf8, 0xfa, 0xfc, 0xa9, 0x4f, 0xae, 0xcf, 0x9f, 0xfa, 0xf4, 0xfa, 0xfc,
-	0xa9, 0x77, 0xf6, 0xe7, 0xb5, 0x8f, 0xf7, 0xe7, 0xb5, 0x4f, 0xf6, 0xe7, 0xb5, 0x4f, 0xf7, 0xe7,
-	0xb5, 0xbf, 0xec, 0xcf, 0x6b, 0xbf, 0xf8, 0xeb, 0xfc, 0xa9, 0x57, 0x2a, 0xa3, 0xfd, 0xa7, 0xc6,
-	0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0xcb, 0x02, 0x8d, 0x03, 0xda, 0x31, 0x00, 0x00,
+	// 3457 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xed, 0x1b, 0x4b, 0x70, 0x23, 0x47,
+	0x35, 0xb2, 0x2c, 0x7f, 0x5a, 0xb6, 0xd7, 0x6e, 0x67, 0xb3, 0x26, 0xc9, 0xee, 0x26, 0x03, 0xa4,
+	0x02, 0x15, 0xe4, 0xec, 0xb2, 0xc9, 0x2e, 0xd9, 0x64, 0x89, 0xa5, 0xf5, 0x3a, 0x22, 0xb6, 0xa3,
+	0x6d, 0x39, 0x4b, 0x91, 0x1f, 0x19, 0x4b, 0x2d, 0x79, 0xe2, 0x91, 0x66, 0x76, 0x66, 0xe4, 0xac,
+	0xf7, 0x40, 0x85, 0x02, 0x0e, 0x09, 0x9f, 0x70, 0xa3, 0xc2, 0x81, 0xe2, 0x46, 0x51, 0xc5, 0x81,
+	0x2b, 0xb9, 0x71, 0xa0, 0x2a, 0x07, 0x0e, 0xa1, 0x80, 0x22, 0xa7, 0x14, 0x84, 0x02, 0x8a, 0x0b,
+	0x45, 0x71, 0x23, 0x14, 0x55, 0xf4, 0xeb, 0xee, 0xe9, 0xe9, 0x1e, 0x49, 0xeb, 0x95, 0xec, 0x35,
+	0x14, 0xd9, 0x83, 0xca, 0xd2, 0x7b, 0xaf, 0xdf, 0x7b, 0xdd, 0xfd, 0x5e, 0xbf, 0x4f, 0xb7, 0xd1,
+	0x05, 0xbb, 0x1d, 0x05, 0xd4, 0x2e, 0x38, 0xde, 0xa2, 0xf8, 0xb6, 0xe8, 0x6f, 0x37, 0x17, 0x6d,
+	0xdf, 0x09, 0x17, 0x6b, 0x1e, 0x03, 0x78, 0xae, 0xef, 0xda, 0x6d, 0xba, 0xb8, 0x73, 0x6a, 0x93,
+	0x46, 0xf6, 0xe9, 0xc5, 0x26, 0x6d, 0xd3, 0xc0, 0x8e, 0x68, 0xbd, 0xe0, 0x07, 0x5e, 0xe4, 0xe1,
+	0x82, 0x18, 0xf5, 0x65, 0xc7, 0x93, 0xdf, 0x0a, 0x6c, 0x7c, 0x01, 0xc6, 0x17, 0xf4, 0xf1, 0x05,
+	0x39, 0xfe, 0xee, 0x73, 0xfd, 0xe5, 0x85, 0x91, 0x1d, 0x85, 0x4c, 0x90, 0xed, 0xfa, 0x5b, 0xf6,
+	0xa9, 0xb4, 0xa4, 0xbb, 0x3f, 0xd3, 0x74, 0xa2, 0xad, 0xce, 0x26, 0x63, 0xdb, 0x5a, 0x6c, 0x7a,
+	0x4d, 0x6f, 0x91, 0x83, 0x37, 0x3b, 0x0d, 0xfe, 0x8b, 0xff, 0xe0, 0xdf, 0x24, 0xf9, 0x99, 0xed,
+	0x73, 0x21, 0x97, 0xe2, 0x3b, 0x2d, 0xbb, 0xb6, 0xe5, 0x30, 0x66, 0xbb, 0x89, 0xac, 0x16, 0x53,
+	0x86, 0x89, 0xea, 0x12, 0xb2, 0xd8, 0x6f, 0x54, 0xd0, 0x69, 0x47, 0x4e, 0x8b, 0x76, 0x0d, 0x78,
+	0x74, 0xaf, 0x01, 0x61, 0x6d, 0x8b, 0xb6, 0xec, 0xae, 0x71, 0x9f, 0xed, 0x37, 0xae, 0x13, 0x39,
+	0xee, 0xa2, 0xd3, 0x8e, 0xc2, 0x28, 0x48, 0x0f, 0xb2, 0xfe, 0x92, 0x41, 0x53, 0x4b, 0xf5, 0x7a,
+	0x40, 0xc3, 0x70, 0x25, 0xf0, 0x3a, 0x3e, 0x7e, 0x19, 0x4d, 0xc0, 0x4c, 0xea, 0x76, 0x64, 0x2f,
+	0x64, 0xee, 0xcb, 0x3c, 0x98, 0x3f, 0xfd, 0x70, 0x41, 0x30, 0x2e, 0xe8, 0x8c, 0x93, 0x3d, 0x01,
+	0x6a, 0xb6, 0x17, 0x85, 0x67, 0x36, 0x5f, 0xa1, 0xb5, 0x68, 0x8d, 0xfd, 0x2a, 0xe2, 0x77, 0xde,
+	0x3f, 0x79, 0xc7, 0x07, 0xef, 0x9f, 0x44, 0x09, 0x8c, 0x28, 0xae, 0xb8, 0x83, 0xa6, 0x9a, 0x20,
+	0x6a, 0x8d, 0xb6, 0x36, 0x69, 0x10, 0x2e, 0x8c, 0xdc, 0x97, 0x65, 0x52, 0xce, 0x0f, 0xb8, 0xed,
+	0x85, 0x95, 0x84, 0x47, 0xf1, 0x4e, 0x29, 0x70, 0x4a, 0x03, 0x86, 0xc4, 0x10, 0x63, 0xfd, 0x3a,
+	0x83, 0x66, 0xf5, 0x99, 0xae, 0x3a, 0x61, 0x84, 0x5f, 0xe8, 0x9a, 0x6d, 0xe1, 0xe6, 0x66, 0x0b,
+	0xa3, 0xf9, 0x5c, 0x67, 0xa5, 0xe8, 0x89, 0x18, 0xa2, 0xcd, 0xd4, 0x46, 0x39, 0x27, 0xa2, 0xad,
+	0x78, 0x8a, 0x8f, 0x0f, 0x3a, 0x45, 0x5d, 0xdd, 0xe2, 0xb4, 0x14, 0x94, 0x2b, 0x03, 0x4b, 0x22,
+	0x38, 0x5b, 0xaf, 0x67, 0xd1, 0x9c, 0x4e, 0x56, 0xb1, 0xa3, 0xda, 0xd6, 0x21, 0x6c, 0xe2, 0xd7,
+	0x33, 0x68, 0xce, 0xae, 0xd7, 0x69, 0x7d, 0xe5, 0x80, 0xb7, 0xf2, 0x63, 0x52, 0x2c, 0xcc, 0xca,
+	0xe4, 0x4e, 0xba, 0x05, 0xe2, 0x37, 0x32, 0x68, 0x3e, 0xa0, 0x2d, 0x6f, 0x27, 0xa5, 0x48, 0x76,
+	0xff, 0x8a, 0xdc, 0x23, 0x15, 0x99, 0x27, 0xdd, 0xfc, 0x49, 0x2f, 0xa1, 0xd6, 0x5f, 0x33, 0x68,
+	0x66, 0xc9, 0xf7, 0x5d, 0x87, 0xd6, 0x37, 0xbc, 0xff, 0x73, 0x6f, 0xfa, 0x5d, 0x06, 0x61, 0x73,
+	0xae, 0x87, 0xe0, 0x4f, 0x35, 0xd3, 0x9f, 0x2e, 0x0c, 0xec, 0x4f, 0x86, 0xc2, 0x7d, 0x3c, 0xea,
+	0x9b, 0x59, 0x34, 0x6f, 0x12, 0xde, 0xf6, 0xa9, 0xff, 0x9e, 0x4f, 0x5d, 0x45, 0xf3, 0x45, 0x3b,
+	0x74, 0x6a, 0x4b, 0x9d, 0x68, 0x8b, 0xb2, 0xf0, 0x57, 0xb3, 0x23, 0xc7, 0x6b, 0xe3, 0x87, 0xd0,
+	0x44, 0x27, 0xa4, 0x41, 0xdb, 0x6e, 0x51, 0xbe, 0x19, 0x93, 0x89, 0xdd, 0x3c, 0x2b, 0xe1, 0x44,
+	0x51, 0x00, 0xb5, 0x6f, 0x87, 0xe1, 0xab, 0x5e, 0x50, 0x67, 0xcb, 0x69, 0x50, 0x57, 0x24, 0x9c,
+	0x28, 0x0a, 0xeb, 0x14, 0x9a, 0x2d, 0x76, 0xda, 0x75, 0x97, 0x5e, 0x72, 0x5c, 0x5a, 0xa5, 0xc1,
+	0x0e, 0x0d, 0xf0, 0x71, 0x94, 0xed, 0x04, 0xae, 0x14, 0x95, 0x97, 0x83, 0xb3, 0xcf, 0x92, 0x55,
+	0x02, 0x70, 0xeb, 0xcd, 0x11, 0x74, 0x5c, 0x8c, 0x11, 0xf4, 0xa0, 0x6d, 0xc9, 0x6b, 0x37, 0x9c,
+	0x66, 0x27, 0x10, 0x0a, 0x3f, 0x82, 0xf2, 0x9b, 0xd4, 0x0e, 0x68, 0xb0, 0xe1, 0x6d, 0xd3, 0xb6,
+	0x64, 0x34, 0x2f, 0x19, 0xe5, 0x8b, 0x09, 0x8a, 0xe8, 0x74, 0xf8, 0x01, 0x34, 0xc6, 0x56, 0xf6,
+	0x69, 0xba, 0x2b, 0xf5, 0x9e, 0x91, 0x23, 0xc6, 0x96, 0x2a, 0x65, 0x06, 0x25, 0x12, 0x8b, 0xbf,
+	0xc3, 0xf6, 0x6c, 0xb3, 0x7b, 0x9d, 0xd8, 0x9e, 0x81, 0xa1, 0x96, 0x06, 0xdd, 0xb3, 0x1e, 0x4b,
+	0x5e, 0x3c, 0x06, 0xfb, 0xd6, 0x03, 0x41, 0x7a, 0x09, 0xb6, 0x7e, 0x38, 0x8a, 0xe6, 0x4b, 0x6e,
+	0x27, 0x8c, 0x68, 0x60, 0x18, 0xd7, 0xad, 0xf7, 0xa2, 0xaf, 0xb2, 0x38, 0x4f, 0x1b, 0x0d, 0x86,
+	0x70, 0x76, 0xe8, 0x01, 0x3a, 0xd1, 0x82, 0x94, 0x3a, 0xbb, 0x9c, 0x62, 0x4e, 0xba, 0xc4, 0xe1,
+	0xaf, 0xa0, 0x39, 0x05, 0x2b, 0x57, 0x8a, 0xae, 0x57, 0xdb, 0x8e, 0xfd, 0xe7, 0x91, 0x41, 0x75,
+	0x28, 0x57, 0xd6, 0x69, 0x94, 0xb8, 0xf0, 0x72, 0x9a, 0x2f, 0xe9, 0x16, 0x85, 0xcf, 0xa1, 0xa9,
+	0xc8, 0x8b, 0x6c, 0x37, 0x9e, 0xfe, 0x28, 0x5b, 0xe9, 0x6c, 0x72, 0xae, 0x6f, 0x68, 0x38, 0x62,
+	0x50, 0xe2, 0xd3, 0x08, 0xf1, 0xdf, 0x15, 0xbb, 0x49, 0xc3, 0x85, 0x1c, 0x1f, 0xa7, 0xd6, 0x7b,
+	0x43, 0x61, 0x88, 0x46, 0x05, 0xb6, 0x5d, 0xeb, 0x04, 0x01, 0xdb, 0x7d, 0xf8, 0xbd, 0x30, 0xc6,
+	0x07, 0x29, 0xdb, 0x2e, 0x25, 0x28, 0xa2, 0xd3, 0x59, 0x17, 0x50, 0xfe, 0xe2, 0x7a, 0xb5, 0x02,
+	0x69, 0x68, 0xcd, 0x73, 0xf1, 0x22, 0x9a, 0xbc, 0xda, 0x61, 0x5b, 0xbf, 0x9e, 0xf8, 0xf4, 0x9c,
+	0xe4, 0x31, 0x79, 0x39, 0x46, 0x90, 0x84, 0xc6, 0xfa, 0x73, 0x06, 0xe5, 0x97, 0x9b, 0x1f, 0x81,
+	0xcc, 0xf5, 0x57, 0x19, 0x74, 0x44, 0x9b, 0xe8, 0x21, 0x04, 0xda, 0x97, 0xcd, 0x40, 0x3b, 0xf0,
+	0x0c, 0x35, 0x6d, 0xfb, 0x44, 0xd9, 0x6f, 0x65, 0xd1, 0xac, 0x46, 0x25, 0x42, 0x6c, 0x1d, 0x21,
+	0x4f, 0xad, 0xfb, 0x81, 0xee, 0xa1, 0xc6, 0xf7, 0x76, 0x98, 0xed, 0x11, 0x66, 0x5d, 0x74, 0x6c,
+	0xf9, 0x5a, 0x04, 0xe1, 0xd2, 0x5d, 0x66, 0x87, 0x78, 0xb4, 0x4b, 0x68, 0x83, 0x32, 0x4f, 0xad,
+	0x51, 0x7c, 0x1f, 0x1a, 0xd5, 0xc2, 0xec, 0x94, 0x64, 0x3d, 0xca, 0xbd, 0x91, 0x63, 0xc0, 0x73,
+	0xe1, 0x6f, 0xe8, 0xdb, 0x35, 0x2a, 0xe3, 0x94, 0xf2, 0xdc, 0xf5, 0x18, 0x41, 0x12, 0x1a, 0xcb,
+	0x46, 0x53, 0x2b, 0xa4, 0x52, 0x52, 0xae, 0xff, 0x29, 0x34, 0xce, 0x42, 0xf5, 0x8e, 0x53, 0x8b,
+	0xa5, 0x1c, 0x91, 0xc3, 0xc7, 0xab, 0x02, 0x4c, 0x62, 0x3c, 0x04, 0x44, 0xb6, 0xe5, 0x5b, 0x5e,
+	0x3d, 0x1d, 0x10, 0xd7, 0x38, 0x94, 0x48, 0xac, 0xf5, 0x2f, 0x16, 0x05, 0xf8, 0x0c, 0x97, 0xc2,
+	0xd0, 0xab, 0x39, 0x22, 0x08, 0x1f, 0x4a, 0x0a, 0x37, 0x6b, 0x4b, 0x89, 0x72, 0x89, 0x87, 0xce,
+	0x56, 0xf9, 0x68, 0xb5, 0x0f, 0x49, 0xfc, 0x59, 0x4a, 0xf1, 0x27, 0x5d, 0x12, 0xad, 0xb7, 0x47,
+	0x51, 0x5e, 0xdb, 0x5f, 0xfc, 0x45, 0x94, 0xf5, 0xd9, 0x92, 0x89, 0x39, 0x0f, 0x5c, 0x86, 0x56,
+	0xd8, 0xba, 0x2a, 0x35, 0xc6, 0x21, 0xf1, 0x01, 0x08, 0x70, 0xc4, 0x5f, 0x63, 0x25, 0x0f, 0x35,
+	0x0c, 0x87, 0xef, 0x4b, 0xfe, 0xf4, 0xca, 0xc0, 0x47, 0x46, 0x6f, 0xf3, 0x2b, 0x62, 0x26, 0x6f,
+	0x26, 0x85, 0x4c, 0x89, 0x64, 0x46, 0x91, 0x75, 0x7c, 0xe1, 0x39, 0x53, 0xc5, 0x3b, 0x41, 0xc1,
+	0x72, 0x25, 0xfc, 0x90, 0x59, 0x5f, 0xb9, 0x22, 0x6b, 0x63, 0x02, 0x04, 0xf8, 0x25, 0x94, 0xf3,
+	0xbd, 0x20, 0x82, 0x78, 0x08, 0x3b, 0xf2, 0xb9, 0x41, 0x75, 0x04, 0x63, 0xae, 0x57, 0x18, 0x87,
+	0xe4, 0x50, 0x83, 0x5f, 0xec, 0x50, 0xe3, 0x6c, 0xf1, 0xf3, 0xcc, 0x55, 0xbc, 0x3a, 0xe5, 0x61,
+	0x33, 0x7f, 0xfa, 0x89, 0x81, 0xd9, 0xb3, 0xb1, 0xc9, 0xc4, 0x27, 0xb8, 0x97, 0x01, 0x88, 0x33,
+	0xc5, 0xcd, 0xc4, 0x49, 0xc6, 0x38, 0xff, 0x27, 0x07, 0xe5, 0x1f, 0x3b, 0x93, 0x12, 0x91, 0xef,
+	0xe5, 0x62, 0xd6, 0x5b, 0xa3, 0x68, 0xea, 0x76, 0xce, 0x76, 0x3b, 0x67, 0xeb, 0x95, 0xb3, 0xfd,
+	0x88, 0xf9, 0xbb, 0x79, 0x2e, 0x99, 0xa7, 0x7f, 0x66, 0xef, 0xd3, 0x5f, 0x05, 0x94, 0x91, 0xbe,
+	0x01, 0xa5, 0xc8, 0xaa, 0x2d, 0xa7, 0xce, 0x8b, 0x97, 0xc9, 0xe2, 0xc3, 0xaa, 0xda, 0x2a, 0x5f,
+	0x64, 0x3e, 0x7d, 0x7f, 0xbf, 0x2e, 0x67, 0xb4, 0xeb, 0xd3, 0xb0, 0xc0, 0x88, 0x08, 0x0c, 0xb6,
+	0xce, 0xa0, 0xd9, 0xa7, 0x36, 0x36, 0x2a, 0x17, 0x69, 0x9b, 0x1d, 0x26, 0xa1, 0xef, 0xb5, 0x43,
+	0x2e, 0x79, 0xd3, 0xab, 0xef, 0xa6, 0x43, 0x59, 0x91, 0xc1, 0x08, 0xc7, 0x58, 0xdf, 0x67, 0xa9,
+	0x16, 0x0c, 0x7b, 0x8a, 0xda, 0x75, 0x1a, 0xac, 0xf1, 0xac, 0x64, 0xef, 0x00, 0xf8, 0x71, 0x94,
+	0xdb, 0xb1, 0xdd, 0x4e, 0x3c, 0x25, 0x75, 0x38, 0x5c, 0x01, 0x20, 0x11, 0x38, 0xfc, 0x24, 0x9a,
+	0x6c, 0x01, 0xbf, 0x0d, 0xa6, 0xa7, 0x9c, 0x9a, 0x15, 0xaf, 0xd3, 0x5a, 0x8c, 0x60, 0x13, 0x9c,
+	0x06, 0xf9, 0x0a, 0x40, 0x92, 0x41, 0xd6, 0x4f, 0xb3, 0x68, 0x0a, 0x90, 0x2a, 0x6e, 0x32, 0xcd,
+	0xb6, 0xbc, 0x30, 0x4a, 0x6b, 0xf6, 0x14, 0x83, 0x11, 0x8e, 0xb9, 0xd9, 0x70, 0x09, 0x9c, 0x7c,
+	0x3b, 0xda, 0x92, 0x7a, 0x29, 0x4e, 0x2c, 0x2d, 0xdb, 0x22, 0x1c, 0x83, 0x57, 0xd1, 0x34, 0xfc,
+	0x55, 0x8a, 0x71, 0xfb, 0x9c, 0x2c, 0x3e, 0x20, 0x49, 0xa7, 0x2b, 0x3a, 0xb2, 0x7b, 0x1a, 0xe6,
+	0x60, 0xfc, 0x0a, 0x1a, 0xdf, 0xe2, 0x4b, 0x0c, 0xf6, 0x0a, 0x2e, 0xf6, 0xf9, 0x41, 0x5d, 0x2c,
+	0xb5, 0x4b, 0x49, 0xca, 0x20, 0x80, 0x21, 0x89, 0x05, 0xe0, 0xeb, 0x28, 0xcf, 0x8b, 0x86, 0x8a,
+	0x1d, 0xd8, 0x2c, 0xa5, 0x1d, 0xe3, 0xf2, 0x4a, 0xc3, 0xc8, 0xbb, 0xac, 0xd8, 0x08, 0x99, 0xca,
+	0x5f, 0x12, 0x44, 0x48, 0x74, 0x61, 0xd6, 0x0f, 0x58, 0x92, 0xd7, 0x63, 0xe4, 0xff, 0x8e, 0x4d,
+	0xfd, 0x3c, 0x83, 0xc6, 0xe5, 0x11, 0xc4, 0xb2, 0x84, 0xd1, 0x9a, 0x53, 0x0f, 0xe4, 0x19, 0x3f,
+	0xe4, 0xa1, 0xa7, 0xe6, 0x52, 0x62, 0x9e, 0x48, 0x38, 0x43, 0xfc, 0x22, 0x1a, 0xa3, 0xd7, 0x6a,
+	0xd4, 0x8f, 0xe4, 0x99, 0x3e, 0x24, 0x6b, 0x65, 0xbc, 0xcb, 0x9c, 0x19, 0x91, 0x4c, 0xad, 0x7f,
+	0x67, 0x10, 0x2e, 0x57, 0x3e, 0xba, 0xd9, 0x5e, 0x03, 0xe5, 0xf8, 0x02, 0x31, 0x9b, 0x19, 0x71,
+	0x7c, 0x3e, 0xd7, 0xa9, 0xe2, 0x3c, 0x1b, 0x3c, 0x52, 0xae, 0x98, 0x59, 0x10, 0x43, 0x43, 0x9c,
+	0xf1, 0x03, 0xda, 0x70, 0xae, 0xad, 0xd2, 0x76, 0x93, 0xb9, 0x3c, 0xd8, 0x57, 0x2e, 0x89, 0x33,
+	0x15, 0x0d, 0x47, 0x0c, 0x4a, 0xeb, 0x05, 0x34, 0xfd, 0xb4, 0xdd, 0xd8, 0xb6, 0xd5, 0xf9, 0x93,
+	0x74, 0xa7, 0x32, 0x37, 0xec, 0x4e, 0x31, 0x5b, 0x8e, 0x3c, 0xdf, 0xa9, 0xa5, 0x6d, 0x79, 0x03,
+	0x80, 0x44, 0xe0, 0xac, 0x5f, 0xb2, 0xd0, 0xb2, 0x7a, 0xd6, 0x38, 0xaf, 0x5f, 0x62, 0xe7, 0x5b,
+	0x14, 0xf9, 0x72, 0xf7, 0x9e, 0x1c, 0xc6, 0x65, 0x75, 0x7e, 0x22, 0xa5, 0x02, 0x28, 0xe1, 0x7c,
+	0xf1, 0x73, 0x28, 0x1b, 0xb9, 0xa1, 0xcc, 0x58, 0x07, 0x3e, 0x81, 0x36, 0x56, 0xab, 0x06, 0x77,
+	0x9e, 0x19, 0x33, 0x20, 0x01, 0xa6, 0xd6, 0x6f, 0xb2, 0x08, 0xad, 0x9e, 0x55, 0x4b, 0xf5, 0x9c,
+	0x31, 0x95, 0xc7, 0x87, 0x99, 0x4a, 0xcc, 0xab, 0x6b, 0x1a, 0x57, 0xf4, 0x69, 0x9c, 0x1f, 0x62,
+	0x1a, 0x8a, 0xb3, 0x31, 0x05, 0xd0, 0xb9, 0x19, 0xf8, 0x35, 0xd9, 0x44, 0x1c, 0x58, 0x67, 0xbd,
+	0xc4, 0x13, 0x3a, 0x03, 0x84, 0x70, 0x9e, 0xa0, 0x73, 0xbd, 0x2d, 0x92, 0x9c, 0x21, 0x74, 0xd6,
+	0xfa, 0x46, 0x42, 0x67, 0x06, 0x20, 0xc0, 0x10, 0x52, 0xfc, 0x6d, 0xb0, 0xd1, 0x61, 0x73, 0x70,
+	0xc3, 0xc0, 0x8b, 0x93, 0x60, 0xa5, 0x1c, 0x44, 0x04, 0x5b, 0xeb, 0x2d, 0x76, 0xd6, 0xac, 0x75,
+	0x5c, 0xe8, 0x73, 0x86, 0x11, 0xf7, 0xbf, 0x72, 0xbb, 0xe1, 0x81, 0x85, 0xf3, 0x96, 0x8d, 0x74,
+	0x04, 0x65, 0xe1, 0xc2, 0xab, 0x05, 0x0e, 0xcc, 0x99, 0xd5, 0x4c, 0x43, 0xdf, 0x06, 0x1a, 0x65,
+	0x58, 0x12, 0xa2, 0x19, 0x47, 0xc2, 0xf9, 0x5a, 0xaf, 0x67, 0xd0, 0xa4, 0x2a, 0x51, 0x78, 0x48,
+	0x67, 0x7f, 0xb9, 0x46, 0x39, 0x9d, 0x3e, 0x88, 0x08, 0xc7, 0xdc, 0x44, 0x22, 0x76, 0x0e, 0x4d,
+	0xf8, 0x72, 0x2d, 0x64, 0x78, 0xb9, 0x57, 0x35, 0xce, 0x25, 0xfc, 0x43, 0xed, 0x3b, 0x51, 0xd4,
+	0xd6, 0xdf, 0x47, 0xd1, 0x34, 0x3b, 0x92, 0x5e, 0xf5, 0x82, 0xed, 0x8a, 0xe7, 0x3a, 0xb5, 0xdd,
+	0x43, 0x38, 0x8e, 0xd9, 0x39, 0x18, 0x74, 0x5c, 0x1a, 0x2f, 0xf0, 0xd2, 0xc0, 0xf5, 0x97, 0xae,
+	0x2f, 0x61, 0x9c, 0x92, 0x7d, 0x84, 0x5f, 0xac, 0xcc, 0xe3, 0xec, 0xf1, 0x13, 0xe8, 0x88, 0x6d,
+	0x5c, 0x10, 0x89, 0x3a, 0x61, 0x92, 0x9f, 0xb9, 0x47, 0xcc, 0xbb, 0xa3, 0x90, 0xa4, 0x69, 0xf1,
+	0x83, 0xb0, 0xa8, 0x8e, 0x17, 0x40, 0xb1, 0x0c, 0xf6, 0x9f, 0x29, 0x4e, 0x89, 0x05, 0x15, 0x30,
+	0xa2, 0xb0, 0xf8, 0x0c, 0x2b, 0x09, 0x1c, 0x1a, 0xc4, 0x18, 0x6e, 0xd3, 0xb9, 0xe2, 0x2c, 0x2f,
+	0x07, 0x34, 0x38, 0x31, 0xa8, 0x70, 0x88, 0x26, 0x43, 0xaf, 0x13, 0xf0, 0x42, 0x4f, 0x96, 0x8a,
+	0x97, 0xf6, 0xb7, 0x14, 0xca, 0xea, 0xa6, 0x21, 0xb1, 0xa8, 0xc6, 0xcc, 0x49, 0x22, 0x07, 0xbf,
+	0xc6, 0x12, 0x67, 0xca, 0x3c, 0x81, 0xfd, 0x6c, 0xb1, 0x62, 0x61, 0x0d, 0xca, 0xe0, 0x71, 0x6e,
+	0x31, 0x57, 0xe4, 0x1a, 0x1e, 0x59, 0x36, 0xd1, 0xcc, 0x70, 0xce, 0xdf, 0xe0, 0xa5, 0x48, 0x50,
+	0x97, 0x0f, 0x44, 0x4e, 0x15, 0x84, 0x16, 0xa9, 0xe1, 0x24, 0x2d, 0xce, 0xfa, 0x6d, 0x06, 0xcd,
+	0x19, 0x7a, 0x1f, 0x42, 0xa3, 0x74, 0xd3, 0x6c, 0x94, 0x3e, 0xb1, 0xaf, 0x75, 0xee, 0xd3, 0x2a,
+	0xfd, 0x47, 0x06, 0x1d, 0x33, 0xe8, 0xa0, 0x29, 0x50, 0x8d, 0xec, 0xa8, 0x13, 0xc2, 0xcd, 0x16,
+	0x34, 0x07, 0xd6, 0x7b, 0xdc, 0x83, 0xad, 0x4b, 0x38, 0x51, 0x14, 0x50, 0x28, 0xca, 0xf7, 0x1f,
+	0x70, 0x37, 0x34, 0x62, 0x16, 0x8a, 0x2b, 0x0a, 0x43, 0x34, 0x2a, 0xfc, 0x05, 0x84, 0xd9, 0x34,
+	0x5c, 0xe7, 0x3a, 0xff, 0x79, 0xc9, 0x76, 0xdc, 0x4e, 0x20, 0x72, 0xcd, 0x89, 0xe2, 0xdd, 0x72,
+	0x2c, 0x26, 0x5d, 0x14, 0xa4, 0xc7, 0x28, 0xe8, 0xf3, 0xb1, 0x22, 0x30, 0x84, 0x82, 0x73, 0xd4,
+	0xec, 0xf3, 0xad, 0x09, 0x30, 0x89, 0xf1, 0xfc, 0x5d, 0x83, 0x31, 0xe9, 0x0a, 0xa5, 0x01, 0x3e,
+	0x8b, 0xa6, 0x6d, 0xed, 0xb1, 0x43, 0xc8, 0xe6, 0x0c, 0x7e, 0x37, 0x07, 0x05, 0x88, 0xfe, 0x0a,
+	0x22, 0x24, 0x26, 0x1d, 0xa6, 0x68, 0xc2, 0xf1, 0x65, 0x4d, 0x2f, 0xb6, 0xea, 0xec, 0xe0, 0x39,
+	0x28, 0x1f, 0x9f, 0x2c, 0xb0, 0x2a, 0xe6, 0x15, 0x6b, 0x7c, 0x12, 0xe5, 0x1a, 0x57, 0x21, 0xae,
+	0x89, 0xf3, 0x80, 0x87, 0x8f, 0x4b, 0x97, 0x2f, 0xae, 0xb3, 0xbd, 0xe4, 0x70, 0x1c, 0x41, 0xa9,
+	0x2e, 0x3b, 0x2e, 0x71, 0x1b, 0x6a, 0xff, 0x7d, 0x1c, 0xad, 0xd8, 0x8f, 0x79, 0x13, 0x4d, 0x0e,
+	0x1c, 0x58, 0xae, 0xbd, 0x49, 0xdd, 0x72, 0x1d, 0x6e, 0xe8, 0xd8, 0x61, 0x21, 0xaa, 0xae, 0x69,
+	0x71, 0x60, 0xad, 0x9a, 0x28, 0x92, 0xa6, 0x85, 0x8b, 0x96, 0xbb, 0x7a, 0x1f, 0x08, 0xf8, 0x11,
+	0x34, 0x0a, 0x75, 0xb7, 0xb4, 0xbd, 0xfb, 0xe3, 0x10, 0x22, 0xcb, 0x0e, 0x73, 0x07, 0x79, 0xe9,
+	0xc1, 0xc9, 0x07, 0xee, 0x18, 0xab, 0x50, 0x95, 0xdd, 0xab, 0x67, 0x30, 0xba, 0x9f, 0x9e, 0xc1,
+	0x8f, 0x27, 0x52, 0x46, 0x07, 0xc7, 0x3e, 0x7e, 0x1c, 0x4d, 0xd6, 0x9d, 0x00, 0xba, 0x35, 0x5e,
+	0x7c, 0x71, 0x7b, 0x22, 0x56, 0xf6, 0x62, 0x8c, 0xf8, 0x50, 0xff, 0x41, 0x92, 0x01, 0xb8, 0x86,
+	0x46, 0x1b, 0x81, 0xd7, 0x92, 0xd9, 0xd9, 0xfe, 0x62, 0x12, 0xf8, 0x40, 0x32, 0xf9, 0x4b, 0x8c,
+	0x2d, 0xe1, 0xcc, 0x59, 0x81, 0x35, 0x12, 0x79, 0x32, 0x4f, 0x3b, 0x00, 0x11, 0x48, 0x8a, 0x18,
+	0xd9, 0xf0, 0x08, 0x63, 0x0c, 0xde, 0x13, 0x9a, 0x36, 0x7b, 0x76, 0x48, 0x9b, 0x4d, 0xbc, 0x47,
+	0x19, 0xaa, 0x62, 0xcd, 0xaf, 0xe9, 0x53, 0xa1, 0x2e, 0xc9, 0x36, 0xba, 0x82, 0xe3, 0x15, 0x56,
+	0x7c, 0x88, 0x3d, 0x19, 0xe3, 0x7b, 0x72, 0x81, 0x17, 0x1e, 0xf1, 0x66, 0x3c, 0x7c, 0x73, 0xa1,
+	0x05, 0x36, 0x58, 0x8c, 0x21, 0x92, 0x1b, 0x3e, 0x8f, 0xa6, 0x69, 0xdb, 0xde, 0x74, 0xe9, 0xaa,
+	0xd7, 0x6c, 0x3a, 0xed, 0x26, 0x0f, 0x63, 0x13, 0xc5, 0xa3, 0x71, 0xa3, 0x63, 0x59, 0x47, 0x12,
+	0x93, 0xb6, 0x57, 0x6a, 0x30, 0x31, 0x40, 0x6a, 0x10, 0x9b, 0xf9, 0x64, 0x5f, 0x33, 0xbf, 0x8a,
+	0xf2, 0xae, 0xaa, 0x2a, 0xc2, 0x05, 0xc4, 0x77, 0xe3, 0xb1, 0x41, 0x77, 0x23, 0x29, 0x4c, 0x92,
+	0x1e, 0x46, 0x02, 0x0b, 0x89, 0x2e, 0x03, 0xb6, 0xc5, 0xf5, 0x9a, 0xfc, 0x94, 0x58, 0xc8, 0x9b,
+	0x31, 0x66, 0x55, 0xc2, 0x89, 0xa2, 0xc0, 0xd7, 0xd1, 0x8c, 0x6b, 0x54, 0x71, 0x0b, 0x53, 0xdc,
+	0x2c, 0x2f, 0x0c, 0xae, 0xa3, 0x51, 0x5d, 0xf1, 0x7b, 0x00, 0x13, 0x46, 0x52, 0x92, 0xf0, 0x2b,
+	0x68, 0x12, 0xde, 0x36, 0xae, 0x3a, 0x2d, 0x27, 0x5a, 0x98, 0x1e, 0xae, 0x00, 0x00, 0x8b, 0x20,
+	0x31, 0x13, 0x91, 0xf0, 0xa8, 0x9f, 0x24, 0x61, 0x6f, 0xbd, 0x99, 0x45, 0xd8, 0xf0, 0x1c, 0x88,
+	0xc8, 0x21, 0x5c, 0x88, 0x4c, 0xb7, 0x75, 0xb0, 0x4c, 0x3a, 0x0e, 0x2a, 0x03, 0x53, 0x66, 0x68,
+	0xe2, 0x4d, 0x99, 0xd8, 0x67, 0x89, 0x63, 0x60, 0x37, 0x1a, 0x4e, 0x8d, 0x6b, 0x25, 0x0f, 0x9f,
+	0x47, 0x6f, 0xa0, 0x03, 0x7f, 0x29, 0x5b, 0x88, 0x5f, 0xca, 0x16, 0x36, 0xb4, 0xd1, 0x5a, 0x0f,
+	0x5a, 0x83, 0x12, 0x43, 0x02, 0xe4, 0x7f, 0xb3, 0x90, 0x1d, 0xeb, 0x24, 0xb2, 0x7b, 0xfe, 0xd8,
+	0xcd, 0x8b, 0x25, 0x29, 0x0e, 0x49, 0x1b, 0x24, 0x8d, 0x21, 0x5d, 0xd2, 0xac, 0x3f, 0x65, 0xd0,
+	0x7c, 0xd7, 0x8e, 0x74, 0x0e, 0xe3, 0xfa, 0xc2, 0x45, 0x39, 0xc8, 0xb1, 0xe2, 0xd4, 0x62, 0x65,
+	0x5f, 0x7b, 0x9d, 0x64, 0x77, 0x49, 0x3e, 0x08, 0x30, 0x96, 0x43, 0x70, 0x21, 0xd6, 0x29, 0x56,
+	0x59, 0xe9, 0x37, 0x45, 0x7b, 0x37, 0x13, 0xad, 0xb7, 0x73, 0x68, 0x36, 0xe6, 0x1b, 0x56, 0x3b,
+	0xad, 0x96, 0x1d, 0x1c, 0x46, 0x41, 0xf6, 0x0d, 0x56, 0x14, 0xe8, 0x86, 0xe9, 0xa8, 0x25, 0x2a,
+	0xee, 0x6b, 0x89, 0x84, 0x6d, 0x1c, 0x8b, 0x0b, 0x8b, 0x75, 0x53, 0x04, 0x49, 0xcb, 0xc4, 0x3f,
+	0xc9, 0xa0, 0x7b, 0x85, 0x14, 0xf9, 0x24, 0x29, 0x35, 0x42, 0x1a, 0xea, 0x41, 0x28, 0xf5, 0x09,
+	0xa9, 0xd4, 0xbd, 0x4b, 0x37, 0x90, 0x47, 0x6e, 0xa8, 0x0d, 0xfe, 0x5e, 0x06, 0x1d, 0x15, 0x04,
+	0x69, 0x3d, 0x47, 0x0f, 0x4c, 0xcf, 0xe3, 0x52, 0xcf, 0xa3, 0x4b, 0xbd, 0x04, 0x91, 0xde, 0xf2,
+	0xa1, 0xb4, 0x6c, 0xc5, 0xcd, 0x0f, 0xd9, 0xb8, 0x1f, 0x58, 0x99, 0xee, 0xee, 0x49, 0x92, 0xfb,
+	0x29, 0x1c, 0x49, 0xe4, 0x58, 0x2f, 0xa2, 0x3b, 0x2b, 0x36, 0x8b, 0xae, 0xbc, 0x94, 0x58, 0xa1,
+	0xd1, 0x33, 0x3e, 0x7c, 0x09, 0xc5, 0x9d, 0x45, 0x53, 0x98, 0x7d, 0x56, 0xbf, 0xb3, 0x60, 0x75,
+	0x04, 0xc7, 0x40, 0x57, 0xc6, 0xe5, 0xb1, 0x40, 0x94, 0x3a, 0xca, 0x9d, 0xc4, 0x61, 0x2e, 0x70,
+	0xf0, 0x18, 0x41, 0xef, 0xac, 0xdc, 0x8a, 0xf7, 0x0e, 0xac, 0x32, 0x9d, 0x36, 0xe2, 0x0a, 0xbe,
+	0x88, 0x66, 0x19, 0x66, 0x9b, 0x46, 0x61, 0x85, 0x06, 0x55, 0xca, 0x16, 0xaa, 0x2e, 0x1b, 0x35,
+	0xea, 0xc4, 0xab, 0xa4, 0xf0, 0xa4, 0x6b, 0x04, 0xfe, 0x12, 0x3a, 0xd6, 0xa6, 0xaf, 0x96, 0xbc,
+	0x76, 0x5b, 0x24, 0x9b, 0x1a, 0x33, 0xd1, 0xd5, 0x3d, 0x29, 0x99, 0xb1, 0xfa, 0xb1, 0x27, 0x19,
+	0xe9, 0x37, 0x1e, 0x96, 0x6e, 0xb3, 0x13, 0xb0, 0x5d, 0xce, 0x72, 0x46, 0x6a, 0xe9, 0x8a, 0x00,
+	0x24, 0x02, 0x67, 0xfd, 0x22, 0x8b, 0xe2, 0xeb, 0x63, 0x7c, 0x46, 0x6b, 0x15, 0x89, 0xa5, 0x5b,
+	0xd8, 0xbb, 0x4d, 0x84, 0xd7, 0x65, 0x93, 0x6a, 0x64, 0x8f, 0xf3, 0x07, 0xfe, 0x85, 0xa1, 0x20,
+	0xfe, 0x85, 0xa1, 0x50, 0x6e, 0x47, 0xcf, 0x04, 0xd5, 0x28, 0x60, 0xf9, 0x96, 0x68, 0x2b, 0x6a,
+	0x2d, 0xad, 0x4f, 0xa2, 0x71, 0xda, 0xe6, 0xfd, 0x2f, 0xa9, 0x38, 0xbf, 0xe2, 0x5e, 0x16, 0x20,
+	0x12, 0xe3, 0xa0, 0x05, 0xe3, 0xd4, 0x5a, 0xbe, 0xba, 0xc7, 0xca, 0x89, 0x16, 0x4c, 0xb9, 0xb4,
+	0x56, 0xe1, 0x55, 0x8a, 0xc2, 0xc6, 0x94, 0xa5, 0xf8, 0x5a, 0x5f, 0xa3, 0x04, 0x18, 0x51, 0x58,
+	0x4e, 0xd9, 0x94, 0x3c, 0xc7, 0x34, 0xca, 0x15, 0xc5, 0x53, 0x62, 0xa1, 0x03, 0xcf, 0x1b, 0x82,
+	0xb2, 0x62, 0x95, 0x7d, 0x12, 0xf3, 0x25, 0x58, 0xdc, 0xb1, 0x37, 0x28, 0x61, 0x7a, 0x61, 0x50,
+	0xe3, 0xd3, 0x9b, 0x48, 0xa6, 0x57, 0x15, 0x20, 0x12, 0xe3, 0x70, 0x01, 0x21, 0xf6, 0x55, 0xce,
+	0x9a, 0x27, 0x93, 0xb9, 0xe2, 0x0c, 0x9c, 0xd2, 0x55, 0x05, 0x25, 0x1a, 0x85, 0x45, 0xd1, 0x6c,
+	0xba, 0xa6, 0xbc, 0x15, 0x6e, 0xf0, 0xb3, 0x1c, 0x3a, 0x56, 0xed, 0xf8, 0xb0, 0x51, 0xe2, 0xb1,
+	0x6c, 0xc9, 0x73, 0x5d, 0x59, 0x26, 0xdd, 0xfa, 0x60, 0xf4, 0x3c, 0x9a, 0xa4, 0xd7, 0x7c, 0x56,
+	0x97, 0xd5, 0x97, 0x62, 0x7b, 0xfb, 0xf4, 0xcd, 0x89, 0xd8, 0x70, 0x5a, 0x34, 0x99, 0xda, 0x72,
+	0xcc, 0x84, 0x24, 0xfc, 0x60, 0x2d, 0x42, 0x87, 0x2d, 0x1b, 0x90, 0xca, 0x22, 0x55, 0x0d, 0xa8,
+	0xc6, 0x08, 0x92, 0xd0, 0x40, 0x23, 0xa0, 0xa1, 0x9e, 0x17, 0xcb, 0x36, 0xf8, 0xc0, 0x8d, 0x80,
+	0xf4, 0x33, 0xe5, 0x64, 0x05, 0x12, 0x18, 0xd1, 0xe4, 0xe0, 0x6f, 0x67, 0xd0, 0x8c, 0x6d, 0xbe,
+	0x10, 0x16, 0x7d, 0xf2, 0xb5, 0xe1, 0x44, 0xf7, 0x79, 0xed, 0x5c, 0xbc, 0x4b, 0xea, 0x31, 0x93,
+	0x7a, 0x2a, 0x9c, 0x12, 0x0e, 0x0d, 0x21, 0x76, 0x14, 0x80, 0x82, 0xb2, 0x88, 0x53, 0x0d, 0xa1,
+	0x8a, 0x00, 0x93, 0x18, 0x8f, 0x4b, 0x68, 0x8e, 0x2d, 0xb5, 0xa8, 0xd1, 0x2a, 0x76, 0x04, 0xef,
+	0x7f, 0xc0, 0x73, 0xa0, 0xb6, 0x3a, 0x0a, 0x6f, 0x2c, 0x48, 0x1a, 0x49, 0xba, 0xe9, 0xc1, 0xf3,
+	0xec, 0xb6, 0xd7, 0xde, 0x6d, 0x39, 0xd7, 0x69, 0xb9, 0x12, 0x72, 0x27, 0x9a, 0x48, 0x3c, 0x6f,
+	0x49, 0xc3, 0x11, 0x83, 0x12, 0xfe, 0xb7, 0xe3, 0x9e, 0x3e, 0xb6, 0x7b, 0x08, 0x6d, 0x46, 0xd7,
+	0x6c, 0x33, 0x0e, 0x9c, 0x60, 0xf6, 0xd1, 0xbc, 0x4f, 0xc3, 0xf1, 0x6f, 0x23, 0xe8, 0xfe, 0x3e,
+	0x23, 0x86, 0x6e, 0x3d, 0xb2, 0xaa, 0x3a, 0xfe, 0xae, 0x1f, 0x18, 0x49, 0x39, 0xa3, 0x23, 0x89,
+	0x49, 0x1b, 0x8b, 0xd2, 0x6e, 0xb9, 0x0d, 0x51, 0xe2, 0x78, 0x8d, 0x29, 0xc0, 0x17, 0x6b, 0x5e,
+	0xcb, 0x77, 0x69, 0x44, 0x45, 0x3f, 0x68, 0x22, 0xf1, 0xc5, 0x52, 0x8c, 0x20, 0x09, 0x0d, 0xc4,
+	0x3a, 0x1a, 0x04, 0x5e, 0xc0, 0x7d, 0x41, 0xbb, 0xbc, 0x59, 0x06, 0x20, 0x11, 0x38, 0xd0, 0xa1,
+	0xb6, 0x45, 0x6b, 0xdb, 0x61, 0xa7, 0x25, 0x6d, 0x55, 0xe9, 0x50, 0x92, 0x70, 0xa2, 0x28, 0x44,
+	0xcd, 0x2c, 0x3d, 0x6c, 0x3c, 0x5d, 0x33, 0x4b, 0x67, 0x50, 0x14, 0xd6, 0x3f, 0x33, 0xe8, 0x78,
+	0x9f, 0x05, 0x3f, 0xb4, 0x1a, 0x66, 0xc7, 0xac, 0x61, 0x2e, 0x1f, 0x90, 0x89, 0xed, 0x59, 0xcd,
+	0x14, 0xd1, 0x91, 0xd4, 0x45, 0x2a, 0xdb, 0xc0, 0x9c, 0xed, 0xd2, 0x20, 0x7e, 0xd7, 0x12, 0x3f,
+	0xa3, 0xca, 0x2d, 0x01, 0x10, 0x12, 0x09, 0x36, 0x80, 0x7f, 0x27, 0x82, 0xce, 0x7a, 0x08, 0xe5,
+	0xb5, 0x5b, 0x4c, 0xf8, 0x67, 0x8d, 0xb0, 0xed, 0xa4, 0xff, 0x59, 0xa3, 0xba, 0x5e, 0x26, 0x00,
+	0x2f, 0x6e, 0xbc, 0xf3, 0x87, 0x13, 0x77, 0xbc, 0xcb, 0x3e, 0xef, 0xb1, 0xcf, 0x6b, 0x1f, 0x9c,
+	0xc8, 0xbc, 0xc3, 0x3e, 0xef, 0xb2, 0xcf, 0x7b, 0xec, 0xf3, 0x7b, 0xf6, 0xf9, 0xee, 0x1f, 0x4f,
+	0xdc, 0xf1, 0x5c, 0x61, 0xb0, 0xff, 0x62, 0xfd, 0x0f, 0x22, 0xef, 0x38, 0xb5, 0xf6, 0x3a, 0x00,
+	0x00,
 }
 
 func (m *AddressGroup) Marshal() (dAtA []byte, err error) {
@@ -1999,6 +2298,34 @@ func (m *ClusterGroupMembers) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DNSProtocol) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DNSProtocol) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DNSProtocol) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.QueryName)
+	copy(dAtA[i:], m.QueryName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.QueryName)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *EgressGroup) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2187,7 +2514,7 @@ func (m *ExternalEntityReference) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *GroupAssociation) Marshal() (dAtA []byte, err error) {
+func (m *GRPCProtocol) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2197,35 +2524,68 @@ func (m *GroupAssociation) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GroupAssociation) MarshalTo(dAtA []byte) (int, error) {
+func (m *GRPCProtocol) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GroupAssociation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GRPCProtocol) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.AssociatedGroups) > 0 {
-		for iNdEx := len(m.AssociatedGroups) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.AssociatedGroups[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenerated(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	{
-		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
+	i -= len(m.Method)
+	copy(dAtA[i:], m.Method)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Method)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Service)
+	copy(dAtA[i:], m.Service)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Service)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *GroupAssociation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GroupAssociation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GroupAssociation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AssociatedGroups) > 0 {
+		for iNdEx := len(m.AssociatedGroups) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AssociatedGroups[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
 		i -= size
 		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
@@ -2436,6 +2796,72 @@ func (m *GroupReference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *HTTPDenyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HTTPDenyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HTTPDenyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Body)
+	copy(dAtA[i:], m.Body)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Body)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *HTTPHeaderMatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HTTPHeaderMatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HTTPHeaderMatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.MatchType)
+	copy(dAtA[i:], m.MatchType)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.MatchType)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Value)
+	copy(dAtA[i:], m.Value)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Value)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *HTTPProtocol) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2456,6 +2882,39 @@ func (m *HTTPProtocol) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.QueryParams) > 0 {
+		for iNdEx := len(m.QueryParams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.QueryParams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Headers) > 0 {
+		for iNdEx := len(m.Headers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Headers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	i -= len(m.PathMatchType)
+	copy(dAtA[i:], m.PathMatchType)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.PathMatchType)))
+	i--
+	dAtA[i] = 0x22
 	i -= len(m.Path)
 	copy(dAtA[i:], m.Path)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Path)))
@@ -2474,6 +2933,44 @@ func (m *HTTPProtocol) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *HTTPQueryParamMatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HTTPQueryParamMatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HTTPQueryParamMatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.MatchType)
+	copy(dAtA[i:], m.MatchType)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.MatchType)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Value)
+	copy(dAtA[i:], m.Value)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Value)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *IPBlock) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2601,6 +3098,86 @@ func (m *IPNet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *KafkaProtocol) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KafkaProtocol) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KafkaProtocol) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Topic)
+	copy(dAtA[i:], m.Topic)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Topic)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.APIKey)
+	copy(dAtA[i:], m.APIKey)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.APIKey)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *L7DenyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *L7DenyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *L7DenyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TLS != nil {
+		{
+			size, err := m.TLS.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.HTTP != nil {
+		{
+			size, err := m.HTTP.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *L7Protocol) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2621,6 +3198,42 @@ func (m *L7Protocol) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Kafka != nil {
+		{
+			size, err := m.Kafka.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.DNS != nil {
+		{
+			size, err := m.DNS.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.GRPC != nil {
+		{
+			size, err := m.GRPC.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.TLS != nil {
 		{
 			size, err := m.TLS.MarshalToSizedBuffer(dAtA[:i])
@@ -2746,6 +3359,11 @@ func (m *NetworkPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.EnforcementMode)
+	copy(dAtA[i:], m.EnforcementMode)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.EnforcementMode)))
+	i--
+	dAtA[i] = 0x3a
 	if m.SourceRef != nil {
 		{
 			size, err := m.SourceRef.MarshalToSizedBuffer(dAtA[:i])
@@ -3035,8 +3653,32 @@ func (m *NetworkPolicyRule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	i -= len(m.LogLabel)
-	copy(dAtA[i:], m.LogLabel)
+	if m.RateLimit != nil {
+		{
+			size, err := m.RateLimit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.L7DenyResponse != nil {
+		{
+			size, err := m.L7DenyResponse.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	i -= len(m.LogLabel)
+	copy(dAtA[i:], m.LogLabel)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.LogLabel)))
 	i--
 	dAtA[i] = 0x5a
@@ -3411,6 +4053,38 @@ func (m *PodReference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RuleRateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RuleRateLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RuleRateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Burst))
+	i--
+	dAtA[i] = 0x18
+	i = encodeVarintGenerated(dAtA, i, uint64(m.NewConnectionsPerSecond))
+	i--
+	dAtA[i] = 0x10
+	i = encodeVarintGenerated(dAtA, i, uint64(m.PacketsPerSecond))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
 func (m *Service) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3541,6 +4215,33 @@ func (m *SupportBundleCollection) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.RedactionSeed)
+	copy(dAtA[i:], m.RedactionSeed)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.RedactionSeed)))
+	i--
+	dAtA[i] = 0x4a
+	i--
+	if m.AnonymizeIPs {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x40
+	if len(m.RedactionPatterns) > 0 {
+		for iNdEx := len(m.RedactionPatterns) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RedactionPatterns[iNdEx])
+			copy(dAtA[i:], m.RedactionPatterns[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.RedactionPatterns[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	i -= len(m.Profile)
+	copy(dAtA[i:], m.Profile)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Profile)))
+	i--
+	dAtA[i] = 0x32
 	{
 		size, err := m.Authentication.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -3656,6 +4357,16 @@ func (m *SupportBundleCollectionNodeStatus) MarshalToSizedBuffer(dAtA []byte) (i
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.Location)
+	copy(dAtA[i:], m.Location)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Location)))
+	i--
+	dAtA[i] = 0x3a
+	i -= len(m.Checksum)
+	copy(dAtA[i:], m.Checksum)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Checksum)))
+	i--
+	dAtA[i] = 0x32
 	i -= len(m.Error)
 	copy(dAtA[i:], m.Error)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Error)))
@@ -3734,6 +4445,34 @@ func (m *SupportBundleCollectionStatus) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *TLSDenyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TLSDenyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TLSDenyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Alert)
+	copy(dAtA[i:], m.Alert)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Alert)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *TLSProtocol) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3954,6 +4693,17 @@ func (m *ClusterGroupMembers) Size() (n int) {
 	return n
 }
 
+func (m *DNSProtocol) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.QueryName)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *EgressGroup) Size() (n int) {
 	if m == nil {
 		return 0
@@ -4024,6 +4774,19 @@ func (m *ExternalEntityReference) Size() (n int) {
 	return n
 }
 
+func (m *GRPCProtocol) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Service)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Method)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *GroupAssociation) Size() (n int) {
 	if m == nil {
 		return 0
@@ -4119,6 +4882,32 @@ func (m *GroupReference) Size() (n int) {
 	return n
 }
 
+func (m *HTTPDenyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Body)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *HTTPHeaderMatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Value)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.MatchType)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *HTTPProtocol) Size() (n int) {
 	if m == nil {
 		return 0
@@ -4131,6 +4920,35 @@ func (m *HTTPProtocol) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = len(m.Path)
 	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.PathMatchType)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Headers) > 0 {
+		for _, e := range m.Headers {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.QueryParams) > 0 {
+		for _, e := range m.QueryParams {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *HTTPQueryParamMatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Value)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.MatchType)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -4182,6 +5000,36 @@ func (m *IPNet) Size() (n int) {
 	return n
 }
 
+func (m *KafkaProtocol) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.APIKey)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Topic)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *L7DenyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HTTP != nil {
+		l = m.HTTP.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.TLS != nil {
+		l = m.TLS.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
 func (m *L7Protocol) Size() (n int) {
 	if m == nil {
 		return 0
@@ -4196,6 +5044,18 @@ func (m *L7Protocol) Size() (n int) {
 		l = m.TLS.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.GRPC != nil {
+		l = m.GRPC.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.DNS != nil {
+		l = m.DNS.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Kafka != nil {
+		l = m.Kafka.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -4260,6 +5120,8 @@ func (m *NetworkPolicy) Size() (n int) {
 		l = m.SourceRef.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	l = len(m.EnforcementMode)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -4390,6 +5252,14 @@ func (m *NetworkPolicyRule) Size() (n int) {
 	}
 	l = len(m.LogLabel)
 	n += 1 + l + sovGenerated(uint64(l))
+	if m.L7DenyResponse != nil {
+		l = m.L7DenyResponse.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.RateLimit != nil {
+		l = m.RateLimit.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -4499,6 +5369,18 @@ func (m *PodReference) Size() (n int) {
 	return n
 }
 
+func (m *RuleRateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.PacketsPerSecond))
+	n += 1 + sovGenerated(uint64(m.NewConnectionsPerSecond))
+	n += 1 + sovGenerated(uint64(m.Burst))
+	return n
+}
+
 func (m *Service) Size() (n int) {
 	if m == nil {
 		return 0
@@ -4565,6 +5447,17 @@ func (m *SupportBundleCollection) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = m.Authentication.Size()
 	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Profile)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.RedactionPatterns) > 0 {
+		for _, s := range m.RedactionPatterns {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	n += 2
+	l = len(m.RedactionSeed)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -4600,6 +5493,10 @@ func (m *SupportBundleCollectionNodeStatus) Size() (n int) {
 	n += 2
 	l = len(m.Error)
 	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Checksum)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Location)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -4620,6 +5517,17 @@ func (m *SupportBundleCollectionStatus) Size() (n int) {
 	return n
 }
 
+func (m *TLSDenyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Alert)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *TLSProtocol) Size() (n int) {
 	if m == nil {
 		return 0
@@ -4803,6 +5711,16 @@ func (this *ClusterGroupMembers) String() string {
 	}, "")
 	return s
 }
+func (this *DNSProtocol) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DNSProtocol{`,
+		`QueryName:` + fmt.Sprintf("%v", this.QueryName) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *EgressGroup) String() string {
 	if this == nil {
 		return "nil"
@@ -4868,6 +5786,17 @@ func (this *ExternalEntityReference) String() string {
 	}, "")
 	return s
 }
+func (this *GRPCProtocol) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GRPCProtocol{`,
+		`Service:` + fmt.Sprintf("%v", this.Service) + `,`,
+		`Method:` + fmt.Sprintf("%v", this.Method) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *GroupAssociation) String() string {
 	if this == nil {
 		return "nil"
@@ -4941,14 +5870,61 @@ func (this *GroupReference) String() string {
 	}, "")
 	return s
 }
+func (this *HTTPDenyResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HTTPDenyResponse{`,
+		`Body:` + fmt.Sprintf("%v", this.Body) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HTTPHeaderMatch) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HTTPHeaderMatch{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`MatchType:` + fmt.Sprintf("%v", this.MatchType) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *HTTPProtocol) String() string {
 	if this == nil {
 		return "nil"
 	}
+	repeatedStringForHeaders := "[]HTTPHeaderMatch{"
+	for _, f := range this.Headers {
+		repeatedStringForHeaders += strings.Replace(strings.Replace(f.String(), "HTTPHeaderMatch", "HTTPHeaderMatch", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForHeaders += "}"
+	repeatedStringForQueryParams := "[]HTTPQueryParamMatch{"
+	for _, f := range this.QueryParams {
+		repeatedStringForQueryParams += strings.Replace(strings.Replace(f.String(), "HTTPQueryParamMatch", "HTTPQueryParamMatch", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForQueryParams += "}"
 	s := strings.Join([]string{`&HTTPProtocol{`,
 		`Host:` + fmt.Sprintf("%v", this.Host) + `,`,
 		`Method:` + fmt.Sprintf("%v", this.Method) + `,`,
 		`Path:` + fmt.Sprintf("%v", this.Path) + `,`,
+		`PathMatchType:` + fmt.Sprintf("%v", this.PathMatchType) + `,`,
+		`Headers:` + repeatedStringForHeaders + `,`,
+		`QueryParams:` + repeatedStringForQueryParams + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HTTPQueryParamMatch) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HTTPQueryParamMatch{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`MatchType:` + fmt.Sprintf("%v", this.MatchType) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -4996,22 +5972,47 @@ func (this *IPNet) String() string {
 	}, "")
 	return s
 }
-func (this *L7Protocol) String() string {
+func (this *KafkaProtocol) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&L7Protocol{`,
-		`HTTP:` + strings.Replace(this.HTTP.String(), "HTTPProtocol", "HTTPProtocol", 1) + `,`,
-		`TLS:` + strings.Replace(this.TLS.String(), "TLSProtocol", "TLSProtocol", 1) + `,`,
+	s := strings.Join([]string{`&KafkaProtocol{`,
+		`APIKey:` + fmt.Sprintf("%v", this.APIKey) + `,`,
+		`Topic:` + fmt.Sprintf("%v", this.Topic) + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *MulticastGroupInfo) String() string {
+func (this *L7DenyResponse) String() string {
 	if this == nil {
 		return "nil"
 	}
-	repeatedStringForPods := "[]PodReference{"
+	s := strings.Join([]string{`&L7DenyResponse{`,
+		`HTTP:` + strings.Replace(this.HTTP.String(), "HTTPDenyResponse", "HTTPDenyResponse", 1) + `,`,
+		`TLS:` + strings.Replace(this.TLS.String(), "TLSDenyResponse", "TLSDenyResponse", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *L7Protocol) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&L7Protocol{`,
+		`HTTP:` + strings.Replace(this.HTTP.String(), "HTTPProtocol", "HTTPProtocol", 1) + `,`,
+		`TLS:` + strings.Replace(this.TLS.String(), "TLSProtocol", "TLSProtocol", 1) + `,`,
+		`GRPC:` + strings.Replace(this.GRPC.String(), "GRPCProtocol", "GRPCProtocol", 1) + `,`,
+		`DNS:` + strings.Replace(this.DNS.String(), "DNSProtocol", "DNSProtocol", 1) + `,`,
+		`Kafka:` + strings.Replace(this.Kafka.String(), "KafkaProtocol", "KafkaProtocol", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MulticastGroupInfo) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForPods := "[]PodReference{"
 	for _, f := range this.Pods {
 		repeatedStringForPods += strings.Replace(strings.Replace(f.String(), "PodReference", "PodReference", 1), `&`, ``, 1) + ","
 	}
@@ -5051,6 +6052,7 @@ func (this *NetworkPolicy) String() string {
 		`Priority:` + valueToStringGenerated(this.Priority) + `,`,
 		`TierPriority:` + valueToStringGenerated(this.TierPriority) + `,`,
 		`SourceRef:` + strings.Replace(this.SourceRef.String(), "NetworkPolicyReference", "NetworkPolicyReference", 1) + `,`,
+		`EnforcementMode:` + fmt.Sprintf("%v", this.EnforcementMode) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -5147,6 +6149,8 @@ func (this *NetworkPolicyRule) String() string {
 		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
 		`L7Protocols:` + repeatedStringForL7Protocols + `,`,
 		`LogLabel:` + fmt.Sprintf("%v", this.LogLabel) + `,`,
+		`L7DenyResponse:` + strings.Replace(this.L7DenyResponse.String(), "L7DenyResponse", "L7DenyResponse", 1) + `,`,
+		`RateLimit:` + strings.Replace(this.RateLimit.String(), "RuleRateLimit", "RuleRateLimit", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -5250,6 +6254,18 @@ func (this *PodReference) String() string {
 	}, "")
 	return s
 }
+func (this *RuleRateLimit) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RuleRateLimit{`,
+		`PacketsPerSecond:` + fmt.Sprintf("%v", this.PacketsPerSecond) + `,`,
+		`NewConnectionsPerSecond:` + fmt.Sprintf("%v", this.NewConnectionsPerSecond) + `,`,
+		`Burst:` + fmt.Sprintf("%v", this.Burst) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *Service) String() string {
 	if this == nil {
 		return "nil"
@@ -5289,6 +6305,10 @@ func (this *SupportBundleCollection) String() string {
 		`SinceTime:` + fmt.Sprintf("%v", this.SinceTime) + `,`,
 		`FileServer:` + strings.Replace(strings.Replace(this.FileServer.String(), "BundleFileServer", "BundleFileServer", 1), `&`, ``, 1) + `,`,
 		`Authentication:` + strings.Replace(strings.Replace(this.Authentication.String(), "BundleServerAuthConfiguration", "BundleServerAuthConfiguration", 1), `&`, ``, 1) + `,`,
+		`Profile:` + fmt.Sprintf("%v", this.Profile) + `,`,
+		`RedactionPatterns:` + fmt.Sprintf("%v", this.RedactionPatterns) + `,`,
+		`AnonymizeIPs:` + fmt.Sprintf("%v", this.AnonymizeIPs) + `,`,
+		`RedactionSeed:` + fmt.Sprintf("%v", this.RedactionSeed) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -5319,6 +6339,8 @@ func (this *SupportBundleCollectionNodeStatus) String() string {
 		`NodeType:` + fmt.Sprintf("%v", this.NodeType) + `,`,
 		`Completed:` + fmt.Sprintf("%v", this.Completed) + `,`,
 		`Error:` + fmt.Sprintf("%v", this.Error) + `,`,
+		`Checksum:` + fmt.Sprintf("%v", this.Checksum) + `,`,
+		`Location:` + fmt.Sprintf("%v", this.Location) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -5339,6 +6361,16 @@ func (this *SupportBundleCollectionStatus) String() string {
 	}, "")
 	return s
 }
+func (this *TLSDenyResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TLSDenyResponse{`,
+		`Alert:` + fmt.Sprintf("%v", this.Alert) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *TLSProtocol) String() string {
 	if this == nil {
 		return "nil"
@@ -6681,6 +7713,88 @@ func (m *ClusterGroupMembers) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *DNSProtocol) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DNSProtocol: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DNSProtocol: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueryName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *EgressGroup) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -7180,6 +8294,120 @@ func (m *ExternalEntityReference) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *GRPCProtocol) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GRPCProtocol: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GRPCProtocol: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Service = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Method = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *GroupAssociation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -7911,7 +9139,7 @@ func (m *GroupReference) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HTTPProtocol) Unmarshal(dAtA []byte) error {
+func (m *HTTPDenyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7934,15 +9162,15 @@ func (m *HTTPProtocol) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HTTPProtocol: wiretype end group for non-group")
+			return fmt.Errorf("proto: HTTPDenyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HTTPProtocol: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HTTPDenyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Host", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Body", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7970,45 +9198,751 @@ func (m *HTTPProtocol) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Host = string(dAtA[iNdEx:postIndex])
+			m.Body = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HTTPHeaderMatch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HTTPHeaderMatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HTTPHeaderMatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MatchType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MatchType = HTTPMatchType(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HTTPProtocol) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HTTPProtocol: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HTTPProtocol: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Host", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Host = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Method = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PathMatchType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PathMatchType = HTTPMatchType(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Headers = append(m.Headers, HTTPHeaderMatch{})
+			if err := m.Headers[len(m.Headers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueryParams = append(m.QueryParams, HTTPQueryParamMatch{})
+			if err := m.QueryParams[len(m.QueryParams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HTTPQueryParamMatch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HTTPQueryParamMatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HTTPQueryParamMatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MatchType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MatchType = HTTPMatchType(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IPBlock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IPBlock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IPBlock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CIDR", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CIDR.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Except", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Except = append(m.Except, IPNet{})
+			if err := m.Except[len(m.Except)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IPGroupAssociation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IPGroupAssociation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IPGroupAssociation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Method = string(dAtA[iNdEx:postIndex])
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AssociatedGroups", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -8018,23 +9952,25 @@ func (m *HTTPProtocol) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Path = string(dAtA[iNdEx:postIndex])
+			m.AssociatedGroups = append(m.AssociatedGroups, GroupReference{})
+			if err := m.AssociatedGroups[len(m.AssociatedGroups)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8057,7 +9993,7 @@ func (m *HTTPProtocol) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IPBlock) Unmarshal(dAtA []byte) error {
+func (m *IPNet) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8080,17 +10016,17 @@ func (m *IPBlock) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IPBlock: wiretype end group for non-group")
+			return fmt.Errorf("proto: IPNet: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IPBlock: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IPNet: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CIDR", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IP", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -8100,30 +10036,100 @@ func (m *IPBlock) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CIDR.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.IP = append(m.IP[:0], dAtA[iNdEx:postIndex]...)
+			if m.IP == nil {
+				m.IP = []byte{}
 			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrefixLength", wireType)
+			}
+			m.PrefixLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PrefixLength |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KafkaProtocol) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KafkaProtocol: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KafkaProtocol: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Except", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field APIKey", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -8133,25 +10139,55 @@ func (m *IPBlock) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Except = append(m.Except, IPNet{})
-			if err := m.Except[len(m.Except)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.APIKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Topic = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8174,7 +10210,7 @@ func (m *IPBlock) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IPGroupAssociation) Unmarshal(dAtA []byte) error {
+func (m *L7DenyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8197,15 +10233,15 @@ func (m *IPGroupAssociation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IPGroupAssociation: wiretype end group for non-group")
+			return fmt.Errorf("proto: L7DenyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IPGroupAssociation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: L7DenyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HTTP", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8232,13 +10268,16 @@ func (m *IPGroupAssociation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.HTTP == nil {
+				m.HTTP = &HTTPDenyResponse{}
+			}
+			if err := m.HTTP.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssociatedGroups", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8265,8 +10304,10 @@ func (m *IPGroupAssociation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AssociatedGroups = append(m.AssociatedGroups, GroupReference{})
-			if err := m.AssociatedGroups[len(m.AssociatedGroups)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.TLS == nil {
+				m.TLS = &TLSDenyResponse{}
+			}
+			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8291,7 +10332,7 @@ func (m *IPGroupAssociation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IPNet) Unmarshal(dAtA []byte) error {
+func (m *L7Protocol) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8314,17 +10355,17 @@ func (m *IPNet) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IPNet: wiretype end group for non-group")
+			return fmt.Errorf("proto: L7Protocol: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IPNet: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: L7Protocol: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IP", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HTTP", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -8334,31 +10375,69 @@ func (m *IPNet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HTTP == nil {
+				m.HTTP = &HTTPProtocol{}
+			}
+			if err := m.HTTP.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IP = append(m.IP[:0], dAtA[iNdEx:postIndex]...)
-			if m.IP == nil {
-				m.IP = []byte{}
+			if m.TLS == nil {
+				m.TLS = &TLSProtocol{}
+			}
+			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrefixLength", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GRPC", wireType)
 			}
-			m.PrefixLength = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -8368,64 +10447,31 @@ func (m *IPNet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PrefixLength |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *L7Protocol) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.GRPC == nil {
+				m.GRPC = &GRPCProtocol{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.GRPC.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: L7Protocol: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: L7Protocol: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HTTP", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DNS", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8452,16 +10498,16 @@ func (m *L7Protocol) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.HTTP == nil {
-				m.HTTP = &HTTPProtocol{}
+			if m.DNS == nil {
+				m.DNS = &DNSProtocol{}
 			}
-			if err := m.HTTP.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DNS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kafka", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8488,10 +10534,10 @@ func (m *L7Protocol) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TLS == nil {
-				m.TLS = &TLSProtocol{}
+			if m.Kafka == nil {
+				m.Kafka = &KafkaProtocol{}
 			}
-			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Kafka.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8961,6 +11007,38 @@ func (m *NetworkPolicy) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnforcementMode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EnforcementMode = antrea_io_antrea_pkg_apis_crd_v1beta1.PolicyEnforcementMode(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -10051,6 +12129,78 @@ func (m *NetworkPolicyRule) Unmarshal(dAtA []byte) error {
 			}
 			m.LogLabel = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field L7DenyResponse", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.L7DenyResponse == nil {
+				m.L7DenyResponse = &L7DenyResponse{}
+			}
+			if err := m.L7DenyResponse.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RateLimit == nil {
+				m.RateLimit = &RuleRateLimit{}
+			}
+			if err := m.RateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -10819,8 +12969,115 @@ func (m *PodReference) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RuleRateLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RuleRateLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RuleRateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketsPerSecond", wireType)
+			}
+			m.PacketsPerSecond = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PacketsPerSecond |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewConnectionsPerSecond", wireType)
+			}
+			m.NewConnectionsPerSecond = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NewConnectionsPerSecond |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burst", wireType)
+			}
+			m.Burst = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Burst |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -11420,6 +13677,122 @@ func (m *SupportBundleCollection) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Profile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Profile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedactionPatterns", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RedactionPatterns = append(m.RedactionPatterns, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnonymizeIPs", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AnonymizeIPs = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedactionSeed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RedactionSeed = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -11735,6 +14108,70 @@ func (m *SupportBundleCollectionNodeStatus) Unmarshal(dAtA []byte) error {
 			}
 			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Checksum = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Location", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Location = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -11873,6 +14310,88 @@ func (m *SupportBundleCollectionStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TLSDenyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TLSDenyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TLSDenyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Alert", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Alert = TLSAlert(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *TLSProtocol) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -235,6 +235,9 @@ type NetworkPolicy struct {
 	TierPriority *int32 `json:"tierPriority,omitempty" protobuf:"varint,5,opt,name=tierPriority"`
 	// Reference to the original NetworkPolicy that the internal NetworkPolicy is created for.
 	SourceRef *NetworkPolicyReference `json:"sourceRef,omitempty" protobuf:"bytes,6,opt,name=sourceRef"`
+	// EnforcementMode specifies whether the rules are enforced or only audited.
+	// An empty value means the rules are enforced, which is the case for K8s NetworkPolicy.
+	EnforcementMode crdv1beta1.PolicyEnforcementMode `json:"enforcementMode,omitempty" protobuf:"bytes,7,opt,name=enforcementMode,casttype=antrea.io/antrea/pkg/apis/crd/v1beta1.PolicyEnforcementMode"`
 }
 
 // Direction defines traffic direction of NetworkPolicyRule.
@@ -277,6 +280,11 @@ type NetworkPolicyRule struct {
 	L7Protocols []L7Protocol `json:"l7Protocols,omitempty" protobuf:"bytes,10,rep,name=l7Protocols"`
 	// LogLabel is a user-defined arbitrary string which will be printed in the NetworkPolicy logs.
 	LogLabel string `json:"logLabel,omitempty" protobuf:"bytes,11,opt,name=logLabel"`
+	// L7DenyResponse specifies the responses sent to clients whose layer 7 requests are denied by this rule.
+	L7DenyResponse *L7DenyResponse `json:"l7DenyResponse,omitempty" protobuf:"bytes,12,opt,name=l7DenyResponse"`
+	// RateLimit specifies the rate above which the traffic matching this rule is dropped. It is only set when
+	// Action is RateLimit.
+	RateLimit *RuleRateLimit `json:"rateLimit,omitempty" protobuf:"bytes,13,opt,name=rateLimit"`
 }
 
 // Protocol defines network protocols supported for things like container ports.
@@ -328,12 +336,15 @@ type Service struct {
 
 // L7Protocol defines application layer protocol to match.
 type L7Protocol struct {
-	HTTP *HTTPProtocol `json:"http,omitempty" protobuf:"bytes,1,opt,name=http"`
-	TLS  *TLSProtocol  `json:"tls,omitempty" protobuf:"bytes,2,opt,name=tls"`
+	HTTP  *HTTPProtocol  `json:"http,omitempty" protobuf:"bytes,1,opt,name=http"`
+	TLS   *TLSProtocol   `json:"tls,omitempty" protobuf:"bytes,2,opt,name=tls"`
+	GRPC  *GRPCProtocol  `json:"grpc,omitempty" protobuf:"bytes,3,opt,name=grpc"`
+	DNS   *DNSProtocol   `json:"dns,omitempty" protobuf:"bytes,4,opt,name=dns"`
+	Kafka *KafkaProtocol `json:"kafka,omitempty" protobuf:"bytes,5,opt,name=kafka"`
 }
 
-// HTTPProtocol matches HTTP requests with specific host, method, and path. All fields could be used alone or together.
-// If all fields are not provided, it matches all HTTP requests.
+// HTTPProtocol matches HTTP requests with specific host, method, path, headers and query parameters. All fields could
+// be used alone or together. If all fields are not provided, it matches all HTTP requests.
 type HTTPProtocol struct {
 	// Host represents the hostname present in the URI or the HTTP Host header to match.
 	// It does not contain the port associated with the host.
@@ -343,6 +354,50 @@ type HTTPProtocol struct {
 	Method string `json:"method,omitempty" protobuf:"bytes,2,opt,name=method"`
 	// Path represents the URI path to match (Ex. "/index.html", "/admin").
 	Path string `json:"path,omitempty" protobuf:"bytes,3,opt,name=path"`
+	// PathMatchType specifies how Path is matched. If not set, Path supports wildcards: a
+	// leading "*" means suffix match and a trailing "*" means prefix match, otherwise the
+	// path is matched exactly.
+	PathMatchType HTTPMatchType `json:"pathMatchType,omitempty" protobuf:"bytes,4,opt,name=pathMatchType,casttype=HTTPMatchType"`
+	// Headers represents the HTTP request headers to match. A request must match all of them.
+	Headers []HTTPHeaderMatch `json:"headers,omitempty" protobuf:"bytes,5,rep,name=headers"`
+	// QueryParams represents the query parameters of the URI to match. A request must match
+	// all of them.
+	QueryParams []HTTPQueryParamMatch `json:"queryParams,omitempty" protobuf:"bytes,6,rep,name=queryParams"`
+}
+
+// HTTPMatchType specifies how a value of HTTP requests is matched.
+type HTTPMatchType string
+
+const (
+	// HTTPMatchTypeExact matches the whole value exactly.
+	HTTPMatchTypeExact HTTPMatchType = "Exact"
+	// HTTPMatchTypePrefix matches values starting with the provided value.
+	HTTPMatchTypePrefix HTTPMatchType = "Prefix"
+	// HTTPMatchTypeRegex matches values with the provided regular expression, which must
+	// match the whole value.
+	HTTPMatchTypeRegex HTTPMatchType = "Regex"
+)
+
+// HTTPHeaderMatch matches an HTTP request header.
+type HTTPHeaderMatch struct {
+	// Name is the name of the header to match (Ex. "X-Tenant-ID"). It is case-insensitive.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Value is the value of the header to match. If not set, the header only needs to be
+	// present in the request.
+	Value string `json:"value,omitempty" protobuf:"bytes,2,opt,name=value"`
+	// MatchType specifies how Value is matched. Defaults to Exact.
+	MatchType HTTPMatchType `json:"matchType,omitempty" protobuf:"bytes,3,opt,name=matchType,casttype=HTTPMatchType"`
+}
+
+// HTTPQueryParamMatch matches a query parameter of the URI of an HTTP request.
+type HTTPQueryParamMatch struct {
+	// Name is the name of the query parameter to match. It is case-sensitive.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Value is the value of the query parameter to match. If not set, the query parameter
+	// only needs to be present in the URI.
+	Value string `json:"value,omitempty" protobuf:"bytes,2,opt,name=value"`
+	// MatchType specifies how Value is matched. Defaults to Exact.
+	MatchType HTTPMatchType `json:"matchType,omitempty" protobuf:"bytes,3,opt,name=matchType,casttype=HTTPMatchType"`
 }
 
 // TLSProtocol matches TLS handshake packets with specific SNI. If the field is not provided, this
@@ -352,6 +407,72 @@ type TLSProtocol struct {
 	SNI string `json:"sni,omitempty" protobuf:"bytes,1,opt,name=sni"`
 }
 
+// GRPCProtocol matches gRPC requests with specific service and method. All fields could be used alone or together.
+// If all fields are not provided, it matches all gRPC requests.
+type GRPCProtocol struct {
+	// Service represents the fully-qualified name of the gRPC service to match, including the package name
+	// (Ex. "helloworld.Greeter").
+	Service string `json:"service,omitempty" protobuf:"bytes,1,opt,name=service"`
+	// Method represents the name of the gRPC method to match (Ex. "SayHello").
+	Method string `json:"method,omitempty" protobuf:"bytes,2,opt,name=method"`
+}
+
+// DNSProtocol matches DNS queries with specific query name. If the field is not provided, this matches all
+// DNS queries.
+type DNSProtocol struct {
+	// QueryName represents the domain name in the question of the DNS query to match (Ex. "*.example.com").
+	QueryName string `json:"queryName,omitempty" protobuf:"bytes,1,opt,name=queryName"`
+}
+
+// KafkaProtocol matches Kafka requests with specific API key and topic. All fields could be used alone or together.
+// If all fields are not provided, it matches all Kafka requests.
+type KafkaProtocol struct {
+	// APIKey represents the type of the Kafka request to match (Ex. "produce", "fetch").
+	APIKey string `json:"apiKey,omitempty" protobuf:"bytes,1,opt,name=apiKey"`
+	// Topic represents the name of the Kafka topic to match.
+	Topic string `json:"topic,omitempty" protobuf:"bytes,2,opt,name=topic"`
+}
+
+// RuleRateLimit defines the rate limit enforced by a rule with the RateLimit action. Exactly one of
+// PacketsPerSecond and NewConnectionsPerSecond is set.
+type RuleRateLimit struct {
+	// PacketsPerSecond is the maximum rate of packets matching the rule per source.
+	PacketsPerSecond int32 `json:"packetsPerSecond,omitempty" protobuf:"varint,1,opt,name=packetsPerSecond"`
+	// NewConnectionsPerSecond is the maximum rate of new connections matching the rule per source.
+	NewConnectionsPerSecond int32 `json:"newConnectionsPerSecond,omitempty" protobuf:"varint,2,opt,name=newConnectionsPerSecond"`
+	// Burst is the number of packets or new connections allowed in excess of the rate.
+	Burst int32 `json:"burst,omitempty" protobuf:"varint,3,opt,name=burst"`
+}
+
+// L7DenyResponse defines the responses sent to clients whose layer 7 requests are denied.
+type L7DenyResponse struct {
+	// HTTP specifies the response sent to denied HTTP requests.
+	HTTP *HTTPDenyResponse `json:"http,omitempty" protobuf:"bytes,1,opt,name=http"`
+	// TLS specifies the alert sent to clients whose TLS handshakes are denied.
+	TLS *TLSDenyResponse `json:"tls,omitempty" protobuf:"bytes,2,opt,name=tls"`
+}
+
+// HTTPDenyResponse defines the "403 Forbidden" response sent to denied HTTP requests.
+type HTTPDenyResponse struct {
+	// Body is the body of the response.
+	Body string `json:"body,omitempty" protobuf:"bytes,1,opt,name=body"`
+}
+
+// TLSDenyResponse defines the fatal alert sent to clients whose TLS handshakes are denied.
+type TLSDenyResponse struct {
+	// Alert is the description of the alert.
+	Alert TLSAlert `json:"alert,omitempty" protobuf:"bytes,1,opt,name=alert,casttype=TLSAlert"`
+}
+
+// TLSAlert is the description of a TLS alert.
+type TLSAlert string
+
+const (
+	TLSAlertAccessDenied     TLSAlert = "AccessDenied"
+	TLSAlertHandshakeFailure TLSAlert = "HandshakeFailure"
+	TLSAlertUnrecognizedName TLSAlert = "UnrecognizedName"
+)
+
 // NetworkPolicyPeer describes a peer of NetworkPolicyRules.
 // It could be a list of names of AddressGroups and/or a list of IPBlock.
 type NetworkPolicyPeer struct {
@@ -537,6 +658,11 @@ type SupportBundleCollectionNodeStatus struct {
 	// The phase in which a SupportBundleCollection is on the Node.
 	Completed bool   `json:"completed,omitempty" protobuf:"varint,4,opt,name=completed"`
 	Error     string `json:"error,omitempty" protobuf:"bytes,5,opt,name=error"`
+	// Checksum is the checksum of the bundle file uploaded by the Node, in the format
+	// "<algorithm>:<hex digest>", e.g. "sha256:2cf24dba...".
+	Checksum string `json:"checksum,omitempty" protobuf:"bytes,6,opt,name=checksum"`
+	// Location is the URL of the bundle file uploaded by the Node.
+	Location string `json:"location,omitempty" protobuf:"bytes,7,opt,name=location"`
 }
 
 type BundleFileServer struct {
@@ -567,6 +693,15 @@ type SupportBundleCollection struct {
 	SinceTime         string                        `json:"sinceTime,omitempty" protobuf:"bytes,3,opt,name=sinceTime"`
 	FileServer        BundleFileServer              `json:"fileServer,omitempty" protobuf:"bytes,4,opt,name=fileServer"`
 	Authentication    BundleServerAuthConfiguration `json:"authentication,omitempty" protobuf:"bytes,5,opt,name=authentication"`
+	// Profile selects the information collected in the bundle. Empty means all.
+	Profile string `json:"profile,omitempty" protobuf:"bytes,6,opt,name=profile"`
+	// RedactionPatterns are regular expressions whose matches are redacted from the bundle.
+	RedactionPatterns []string `json:"redactionPatterns,omitempty" protobuf:"bytes,7,rep,name=redactionPatterns"`
+	// AnonymizeIPs replaces the IP addresses in the bundle with pseudonyms.
+	AnonymizeIPs bool `json:"anonymizeIPs,omitempty" protobuf:"varint,8,opt,name=anonymizeIPs"`
+	// RedactionSeed is the secret seed of the pseudonyms, which is shared by all the Nodes of the
+	// collection so that the pseudonyms are consistent across their bundles.
+	RedactionSeed string `json:"redactionSeed,omitempty" protobuf:"bytes,9,opt,name=redactionSeed"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
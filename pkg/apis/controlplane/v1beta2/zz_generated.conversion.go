@@ -139,6 +139,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*DNSProtocol)(nil), (*controlplane.DNSProtocol)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_DNSProtocol_To_controlplane_DNSProtocol(a.(*DNSProtocol), b.(*controlplane.DNSProtocol), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*controlplane.DNSProtocol)(nil), (*DNSProtocol)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_controlplane_DNSProtocol_To_v1beta2_DNSProtocol(a.(*controlplane.DNSProtocol), b.(*DNSProtocol), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*EgressGroup)(nil), (*controlplane.EgressGroup)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_EgressGroup_To_controlplane_EgressGroup(a.(*EgressGroup), b.(*controlplane.EgressGroup), scope)
 	}); err != nil {
@@ -179,6 +189,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*GRPCProtocol)(nil), (*controlplane.GRPCProtocol)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_GRPCProtocol_To_controlplane_GRPCProtocol(a.(*GRPCProtocol), b.(*controlplane.GRPCProtocol), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*controlplane.GRPCProtocol)(nil), (*GRPCProtocol)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_controlplane_GRPCProtocol_To_v1beta2_GRPCProtocol(a.(*controlplane.GRPCProtocol), b.(*GRPCProtocol), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*GroupAssociation)(nil), (*controlplane.GroupAssociation)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_GroupAssociation_To_controlplane_GroupAssociation(a.(*GroupAssociation), b.(*controlplane.GroupAssociation), scope)
 	}); err != nil {
@@ -219,6 +239,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*HTTPDenyResponse)(nil), (*controlplane.HTTPDenyResponse)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_HTTPDenyResponse_To_controlplane_HTTPDenyResponse(a.(*HTTPDenyResponse), b.(*controlplane.HTTPDenyResponse), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*controlplane.HTTPDenyResponse)(nil), (*HTTPDenyResponse)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_controlplane_HTTPDenyResponse_To_v1beta2_HTTPDenyResponse(a.(*controlplane.HTTPDenyResponse), b.(*HTTPDenyResponse), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*HTTPHeaderMatch)(nil), (*controlplane.HTTPHeaderMatch)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_HTTPHeaderMatch_To_controlplane_HTTPHeaderMatch(a.(*HTTPHeaderMatch), b.(*controlplane.HTTPHeaderMatch), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*controlplane.HTTPHeaderMatch)(nil), (*HTTPHeaderMatch)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_controlplane_HTTPHeaderMatch_To_v1beta2_HTTPHeaderMatch(a.(*controlplane.HTTPHeaderMatch), b.(*HTTPHeaderMatch), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*HTTPProtocol)(nil), (*controlplane.HTTPProtocol)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_HTTPProtocol_To_controlplane_HTTPProtocol(a.(*HTTPProtocol), b.(*controlplane.HTTPProtocol), scope)
 	}); err != nil {
@@ -229,6 +269,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*HTTPQueryParamMatch)(nil), (*controlplane.HTTPQueryParamMatch)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_HTTPQueryParamMatch_To_controlplane_HTTPQueryParamMatch(a.(*HTTPQueryParamMatch), b.(*controlplane.HTTPQueryParamMatch), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*controlplane.HTTPQueryParamMatch)(nil), (*HTTPQueryParamMatch)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_controlplane_HTTPQueryParamMatch_To_v1beta2_HTTPQueryParamMatch(a.(*controlplane.HTTPQueryParamMatch), b.(*HTTPQueryParamMatch), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*IPBlock)(nil), (*controlplane.IPBlock)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_IPBlock_To_controlplane_IPBlock(a.(*IPBlock), b.(*controlplane.IPBlock), scope)
 	}); err != nil {
@@ -259,6 +309,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*KafkaProtocol)(nil), (*controlplane.KafkaProtocol)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_KafkaProtocol_To_controlplane_KafkaProtocol(a.(*KafkaProtocol), b.(*controlplane.KafkaProtocol), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*controlplane.KafkaProtocol)(nil), (*KafkaProtocol)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_controlplane_KafkaProtocol_To_v1beta2_KafkaProtocol(a.(*controlplane.KafkaProtocol), b.(*KafkaProtocol), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*L7DenyResponse)(nil), (*controlplane.L7DenyResponse)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_L7DenyResponse_To_controlplane_L7DenyResponse(a.(*L7DenyResponse), b.(*controlplane.L7DenyResponse), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*controlplane.L7DenyResponse)(nil), (*L7DenyResponse)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_controlplane_L7DenyResponse_To_v1beta2_L7DenyResponse(a.(*controlplane.L7DenyResponse), b.(*L7DenyResponse), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*L7Protocol)(nil), (*controlplane.L7Protocol)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_L7Protocol_To_controlplane_L7Protocol(a.(*L7Protocol), b.(*controlplane.L7Protocol), scope)
 	}); err != nil {
@@ -409,6 +479,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*RuleRateLimit)(nil), (*controlplane.RuleRateLimit)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_RuleRateLimit_To_controlplane_RuleRateLimit(a.(*RuleRateLimit), b.(*controlplane.RuleRateLimit), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*controlplane.RuleRateLimit)(nil), (*RuleRateLimit)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_controlplane_RuleRateLimit_To_v1beta2_RuleRateLimit(a.(*controlplane.RuleRateLimit), b.(*RuleRateLimit), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Service)(nil), (*controlplane.Service)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_Service_To_controlplane_Service(a.(*Service), b.(*controlplane.Service), scope)
 	}); err != nil {
@@ -469,6 +549,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*TLSDenyResponse)(nil), (*controlplane.TLSDenyResponse)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_TLSDenyResponse_To_controlplane_TLSDenyResponse(a.(*TLSDenyResponse), b.(*controlplane.TLSDenyResponse), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*controlplane.TLSDenyResponse)(nil), (*TLSDenyResponse)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_controlplane_TLSDenyResponse_To_v1beta2_TLSDenyResponse(a.(*controlplane.TLSDenyResponse), b.(*TLSDenyResponse), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*TLSProtocol)(nil), (*controlplane.TLSProtocol)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_TLSProtocol_To_controlplane_TLSProtocol(a.(*TLSProtocol), b.(*controlplane.TLSProtocol), scope)
 	}); err != nil {
@@ -719,6 +809,26 @@ func Convert_controlplane_ClusterGroupMembers_To_v1beta2_ClusterGroupMembers(in
 	return autoConvert_controlplane_ClusterGroupMembers_To_v1beta2_ClusterGroupMembers(in, out, s)
 }
 
+func autoConvert_v1beta2_DNSProtocol_To_controlplane_DNSProtocol(in *DNSProtocol, out *controlplane.DNSProtocol, s conversion.Scope) error {
+	out.QueryName = in.QueryName
+	return nil
+}
+
+// Convert_v1beta2_DNSProtocol_To_controlplane_DNSProtocol is an autogenerated conversion function.
+func Convert_v1beta2_DNSProtocol_To_controlplane_DNSProtocol(in *DNSProtocol, out *controlplane.DNSProtocol, s conversion.Scope) error {
+	return autoConvert_v1beta2_DNSProtocol_To_controlplane_DNSProtocol(in, out, s)
+}
+
+func autoConvert_controlplane_DNSProtocol_To_v1beta2_DNSProtocol(in *controlplane.DNSProtocol, out *DNSProtocol, s conversion.Scope) error {
+	out.QueryName = in.QueryName
+	return nil
+}
+
+// Convert_controlplane_DNSProtocol_To_v1beta2_DNSProtocol is an autogenerated conversion function.
+func Convert_controlplane_DNSProtocol_To_v1beta2_DNSProtocol(in *controlplane.DNSProtocol, out *DNSProtocol, s conversion.Scope) error {
+	return autoConvert_controlplane_DNSProtocol_To_v1beta2_DNSProtocol(in, out, s)
+}
+
 func autoConvert_v1beta2_EgressGroup_To_controlplane_EgressGroup(in *EgressGroup, out *controlplane.EgressGroup, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	out.GroupMembers = *(*[]controlplane.GroupMember)(unsafe.Pointer(&in.GroupMembers))
@@ -809,6 +919,28 @@ func Convert_controlplane_ExternalEntityReference_To_v1beta2_ExternalEntityRefer
 	return autoConvert_controlplane_ExternalEntityReference_To_v1beta2_ExternalEntityReference(in, out, s)
 }
 
+func autoConvert_v1beta2_GRPCProtocol_To_controlplane_GRPCProtocol(in *GRPCProtocol, out *controlplane.GRPCProtocol, s conversion.Scope) error {
+	out.Service = in.Service
+	out.Method = in.Method
+	return nil
+}
+
+// Convert_v1beta2_GRPCProtocol_To_controlplane_GRPCProtocol is an autogenerated conversion function.
+func Convert_v1beta2_GRPCProtocol_To_controlplane_GRPCProtocol(in *GRPCProtocol, out *controlplane.GRPCProtocol, s conversion.Scope) error {
+	return autoConvert_v1beta2_GRPCProtocol_To_controlplane_GRPCProtocol(in, out, s)
+}
+
+func autoConvert_controlplane_GRPCProtocol_To_v1beta2_GRPCProtocol(in *controlplane.GRPCProtocol, out *GRPCProtocol, s conversion.Scope) error {
+	out.Service = in.Service
+	out.Method = in.Method
+	return nil
+}
+
+// Convert_controlplane_GRPCProtocol_To_v1beta2_GRPCProtocol is an autogenerated conversion function.
+func Convert_controlplane_GRPCProtocol_To_v1beta2_GRPCProtocol(in *controlplane.GRPCProtocol, out *GRPCProtocol, s conversion.Scope) error {
+	return autoConvert_controlplane_GRPCProtocol_To_v1beta2_GRPCProtocol(in, out, s)
+}
+
 func autoConvert_v1beta2_GroupAssociation_To_controlplane_GroupAssociation(in *GroupAssociation, out *controlplane.GroupAssociation, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	out.AssociatedGroups = *(*[]controlplane.GroupReference)(unsafe.Pointer(&in.AssociatedGroups))
@@ -915,10 +1047,57 @@ func Convert_controlplane_GroupReference_To_v1beta2_GroupReference(in *controlpl
 	return autoConvert_controlplane_GroupReference_To_v1beta2_GroupReference(in, out, s)
 }
 
+func autoConvert_v1beta2_HTTPDenyResponse_To_controlplane_HTTPDenyResponse(in *HTTPDenyResponse, out *controlplane.HTTPDenyResponse, s conversion.Scope) error {
+	out.Body = in.Body
+	return nil
+}
+
+// Convert_v1beta2_HTTPDenyResponse_To_controlplane_HTTPDenyResponse is an autogenerated conversion function.
+func Convert_v1beta2_HTTPDenyResponse_To_controlplane_HTTPDenyResponse(in *HTTPDenyResponse, out *controlplane.HTTPDenyResponse, s conversion.Scope) error {
+	return autoConvert_v1beta2_HTTPDenyResponse_To_controlplane_HTTPDenyResponse(in, out, s)
+}
+
+func autoConvert_controlplane_HTTPDenyResponse_To_v1beta2_HTTPDenyResponse(in *controlplane.HTTPDenyResponse, out *HTTPDenyResponse, s conversion.Scope) error {
+	out.Body = in.Body
+	return nil
+}
+
+// Convert_controlplane_HTTPDenyResponse_To_v1beta2_HTTPDenyResponse is an autogenerated conversion function.
+func Convert_controlplane_HTTPDenyResponse_To_v1beta2_HTTPDenyResponse(in *controlplane.HTTPDenyResponse, out *HTTPDenyResponse, s conversion.Scope) error {
+	return autoConvert_controlplane_HTTPDenyResponse_To_v1beta2_HTTPDenyResponse(in, out, s)
+}
+
+func autoConvert_v1beta2_HTTPHeaderMatch_To_controlplane_HTTPHeaderMatch(in *HTTPHeaderMatch, out *controlplane.HTTPHeaderMatch, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Value = in.Value
+	out.MatchType = controlplane.HTTPMatchType(in.MatchType)
+	return nil
+}
+
+// Convert_v1beta2_HTTPHeaderMatch_To_controlplane_HTTPHeaderMatch is an autogenerated conversion function.
+func Convert_v1beta2_HTTPHeaderMatch_To_controlplane_HTTPHeaderMatch(in *HTTPHeaderMatch, out *controlplane.HTTPHeaderMatch, s conversion.Scope) error {
+	return autoConvert_v1beta2_HTTPHeaderMatch_To_controlplane_HTTPHeaderMatch(in, out, s)
+}
+
+func autoConvert_controlplane_HTTPHeaderMatch_To_v1beta2_HTTPHeaderMatch(in *controlplane.HTTPHeaderMatch, out *HTTPHeaderMatch, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Value = in.Value
+	out.MatchType = HTTPMatchType(in.MatchType)
+	return nil
+}
+
+// Convert_controlplane_HTTPHeaderMatch_To_v1beta2_HTTPHeaderMatch is an autogenerated conversion function.
+func Convert_controlplane_HTTPHeaderMatch_To_v1beta2_HTTPHeaderMatch(in *controlplane.HTTPHeaderMatch, out *HTTPHeaderMatch, s conversion.Scope) error {
+	return autoConvert_controlplane_HTTPHeaderMatch_To_v1beta2_HTTPHeaderMatch(in, out, s)
+}
+
 func autoConvert_v1beta2_HTTPProtocol_To_controlplane_HTTPProtocol(in *HTTPProtocol, out *controlplane.HTTPProtocol, s conversion.Scope) error {
 	out.Host = in.Host
 	out.Method = in.Method
 	out.Path = in.Path
+	out.PathMatchType = controlplane.HTTPMatchType(in.PathMatchType)
+	out.Headers = *(*[]controlplane.HTTPHeaderMatch)(unsafe.Pointer(&in.Headers))
+	out.QueryParams = *(*[]controlplane.HTTPQueryParamMatch)(unsafe.Pointer(&in.QueryParams))
 	return nil
 }
 
@@ -931,6 +1110,9 @@ func autoConvert_controlplane_HTTPProtocol_To_v1beta2_HTTPProtocol(in *controlpl
 	out.Host = in.Host
 	out.Method = in.Method
 	out.Path = in.Path
+	out.PathMatchType = HTTPMatchType(in.PathMatchType)
+	out.Headers = *(*[]HTTPHeaderMatch)(unsafe.Pointer(&in.Headers))
+	out.QueryParams = *(*[]HTTPQueryParamMatch)(unsafe.Pointer(&in.QueryParams))
 	return nil
 }
 
@@ -939,6 +1121,30 @@ func Convert_controlplane_HTTPProtocol_To_v1beta2_HTTPProtocol(in *controlplane.
 	return autoConvert_controlplane_HTTPProtocol_To_v1beta2_HTTPProtocol(in, out, s)
 }
 
+func autoConvert_v1beta2_HTTPQueryParamMatch_To_controlplane_HTTPQueryParamMatch(in *HTTPQueryParamMatch, out *controlplane.HTTPQueryParamMatch, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Value = in.Value
+	out.MatchType = controlplane.HTTPMatchType(in.MatchType)
+	return nil
+}
+
+// Convert_v1beta2_HTTPQueryParamMatch_To_controlplane_HTTPQueryParamMatch is an autogenerated conversion function.
+func Convert_v1beta2_HTTPQueryParamMatch_To_controlplane_HTTPQueryParamMatch(in *HTTPQueryParamMatch, out *controlplane.HTTPQueryParamMatch, s conversion.Scope) error {
+	return autoConvert_v1beta2_HTTPQueryParamMatch_To_controlplane_HTTPQueryParamMatch(in, out, s)
+}
+
+func autoConvert_controlplane_HTTPQueryParamMatch_To_v1beta2_HTTPQueryParamMatch(in *controlplane.HTTPQueryParamMatch, out *HTTPQueryParamMatch, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Value = in.Value
+	out.MatchType = HTTPMatchType(in.MatchType)
+	return nil
+}
+
+// Convert_controlplane_HTTPQueryParamMatch_To_v1beta2_HTTPQueryParamMatch is an autogenerated conversion function.
+func Convert_controlplane_HTTPQueryParamMatch_To_v1beta2_HTTPQueryParamMatch(in *controlplane.HTTPQueryParamMatch, out *HTTPQueryParamMatch, s conversion.Scope) error {
+	return autoConvert_controlplane_HTTPQueryParamMatch_To_v1beta2_HTTPQueryParamMatch(in, out, s)
+}
+
 func autoConvert_v1beta2_IPBlock_To_controlplane_IPBlock(in *IPBlock, out *controlplane.IPBlock, s conversion.Scope) error {
 	if err := Convert_v1beta2_IPNet_To_controlplane_IPNet(&in.CIDR, &out.CIDR, s); err != nil {
 		return err
@@ -1009,9 +1215,56 @@ func Convert_controlplane_IPNet_To_v1beta2_IPNet(in *controlplane.IPNet, out *IP
 	return autoConvert_controlplane_IPNet_To_v1beta2_IPNet(in, out, s)
 }
 
+func autoConvert_v1beta2_KafkaProtocol_To_controlplane_KafkaProtocol(in *KafkaProtocol, out *controlplane.KafkaProtocol, s conversion.Scope) error {
+	out.APIKey = in.APIKey
+	out.Topic = in.Topic
+	return nil
+}
+
+// Convert_v1beta2_KafkaProtocol_To_controlplane_KafkaProtocol is an autogenerated conversion function.
+func Convert_v1beta2_KafkaProtocol_To_controlplane_KafkaProtocol(in *KafkaProtocol, out *controlplane.KafkaProtocol, s conversion.Scope) error {
+	return autoConvert_v1beta2_KafkaProtocol_To_controlplane_KafkaProtocol(in, out, s)
+}
+
+func autoConvert_controlplane_KafkaProtocol_To_v1beta2_KafkaProtocol(in *controlplane.KafkaProtocol, out *KafkaProtocol, s conversion.Scope) error {
+	out.APIKey = in.APIKey
+	out.Topic = in.Topic
+	return nil
+}
+
+// Convert_controlplane_KafkaProtocol_To_v1beta2_KafkaProtocol is an autogenerated conversion function.
+func Convert_controlplane_KafkaProtocol_To_v1beta2_KafkaProtocol(in *controlplane.KafkaProtocol, out *KafkaProtocol, s conversion.Scope) error {
+	return autoConvert_controlplane_KafkaProtocol_To_v1beta2_KafkaProtocol(in, out, s)
+}
+
+func autoConvert_v1beta2_L7DenyResponse_To_controlplane_L7DenyResponse(in *L7DenyResponse, out *controlplane.L7DenyResponse, s conversion.Scope) error {
+	out.HTTP = (*controlplane.HTTPDenyResponse)(unsafe.Pointer(in.HTTP))
+	out.TLS = (*controlplane.TLSDenyResponse)(unsafe.Pointer(in.TLS))
+	return nil
+}
+
+// Convert_v1beta2_L7DenyResponse_To_controlplane_L7DenyResponse is an autogenerated conversion function.
+func Convert_v1beta2_L7DenyResponse_To_controlplane_L7DenyResponse(in *L7DenyResponse, out *controlplane.L7DenyResponse, s conversion.Scope) error {
+	return autoConvert_v1beta2_L7DenyResponse_To_controlplane_L7DenyResponse(in, out, s)
+}
+
+func autoConvert_controlplane_L7DenyResponse_To_v1beta2_L7DenyResponse(in *controlplane.L7DenyResponse, out *L7DenyResponse, s conversion.Scope) error {
+	out.HTTP = (*HTTPDenyResponse)(unsafe.Pointer(in.HTTP))
+	out.TLS = (*TLSDenyResponse)(unsafe.Pointer(in.TLS))
+	return nil
+}
+
+// Convert_controlplane_L7DenyResponse_To_v1beta2_L7DenyResponse is an autogenerated conversion function.
+func Convert_controlplane_L7DenyResponse_To_v1beta2_L7DenyResponse(in *controlplane.L7DenyResponse, out *L7DenyResponse, s conversion.Scope) error {
+	return autoConvert_controlplane_L7DenyResponse_To_v1beta2_L7DenyResponse(in, out, s)
+}
+
 func autoConvert_v1beta2_L7Protocol_To_controlplane_L7Protocol(in *L7Protocol, out *controlplane.L7Protocol, s conversion.Scope) error {
 	out.HTTP = (*controlplane.HTTPProtocol)(unsafe.Pointer(in.HTTP))
 	out.TLS = (*controlplane.TLSProtocol)(unsafe.Pointer(in.TLS))
+	out.GRPC = (*controlplane.GRPCProtocol)(unsafe.Pointer(in.GRPC))
+	out.DNS = (*controlplane.DNSProtocol)(unsafe.Pointer(in.DNS))
+	out.Kafka = (*controlplane.KafkaProtocol)(unsafe.Pointer(in.Kafka))
 	return nil
 }
 
@@ -1023,6 +1276,9 @@ func Convert_v1beta2_L7Protocol_To_controlplane_L7Protocol(in *L7Protocol, out *
 func autoConvert_controlplane_L7Protocol_To_v1beta2_L7Protocol(in *controlplane.L7Protocol, out *L7Protocol, s conversion.Scope) error {
 	out.HTTP = (*HTTPProtocol)(unsafe.Pointer(in.HTTP))
 	out.TLS = (*TLSProtocol)(unsafe.Pointer(in.TLS))
+	out.GRPC = (*GRPCProtocol)(unsafe.Pointer(in.GRPC))
+	out.DNS = (*DNSProtocol)(unsafe.Pointer(in.DNS))
+	out.Kafka = (*KafkaProtocol)(unsafe.Pointer(in.Kafka))
 	return nil
 }
 
@@ -1094,6 +1350,7 @@ func autoConvert_v1beta2_NetworkPolicy_To_controlplane_NetworkPolicy(in *Network
 	out.Priority = (*float64)(unsafe.Pointer(in.Priority))
 	out.TierPriority = (*int32)(unsafe.Pointer(in.TierPriority))
 	out.SourceRef = (*controlplane.NetworkPolicyReference)(unsafe.Pointer(in.SourceRef))
+	out.EnforcementMode = v1beta1.PolicyEnforcementMode(in.EnforcementMode)
 	return nil
 }
 
@@ -1119,6 +1376,7 @@ func autoConvert_controlplane_NetworkPolicy_To_v1beta2_NetworkPolicy(in *control
 	out.Priority = (*float64)(unsafe.Pointer(in.Priority))
 	out.TierPriority = (*int32)(unsafe.Pointer(in.TierPriority))
 	out.SourceRef = (*NetworkPolicyReference)(unsafe.Pointer(in.SourceRef))
+	out.EnforcementMode = v1beta1.PolicyEnforcementMode(in.EnforcementMode)
 	return nil
 }
 
@@ -1275,6 +1533,8 @@ func autoConvert_v1beta2_NetworkPolicyRule_To_controlplane_NetworkPolicyRule(in
 	out.Name = in.Name
 	out.L7Protocols = *(*[]controlplane.L7Protocol)(unsafe.Pointer(&in.L7Protocols))
 	out.LogLabel = in.LogLabel
+	out.L7DenyResponse = (*controlplane.L7DenyResponse)(unsafe.Pointer(in.L7DenyResponse))
+	out.RateLimit = (*controlplane.RuleRateLimit)(unsafe.Pointer(in.RateLimit))
 	return nil
 }
 
@@ -1309,6 +1569,8 @@ func autoConvert_controlplane_NetworkPolicyRule_To_v1beta2_NetworkPolicyRule(in
 	out.AppliedToGroups = *(*[]string)(unsafe.Pointer(&in.AppliedToGroups))
 	out.L7Protocols = *(*[]L7Protocol)(unsafe.Pointer(&in.L7Protocols))
 	out.LogLabel = in.LogLabel
+	out.L7DenyResponse = (*L7DenyResponse)(unsafe.Pointer(in.L7DenyResponse))
+	out.RateLimit = (*RuleRateLimit)(unsafe.Pointer(in.RateLimit))
 	return nil
 }
 
@@ -1484,6 +1746,30 @@ func Convert_controlplane_PodReference_To_v1beta2_PodReference(in *controlplane.
 	return autoConvert_controlplane_PodReference_To_v1beta2_PodReference(in, out, s)
 }
 
+func autoConvert_v1beta2_RuleRateLimit_To_controlplane_RuleRateLimit(in *RuleRateLimit, out *controlplane.RuleRateLimit, s conversion.Scope) error {
+	out.PacketsPerSecond = in.PacketsPerSecond
+	out.NewConnectionsPerSecond = in.NewConnectionsPerSecond
+	out.Burst = in.Burst
+	return nil
+}
+
+// Convert_v1beta2_RuleRateLimit_To_controlplane_RuleRateLimit is an autogenerated conversion function.
+func Convert_v1beta2_RuleRateLimit_To_controlplane_RuleRateLimit(in *RuleRateLimit, out *controlplane.RuleRateLimit, s conversion.Scope) error {
+	return autoConvert_v1beta2_RuleRateLimit_To_controlplane_RuleRateLimit(in, out, s)
+}
+
+func autoConvert_controlplane_RuleRateLimit_To_v1beta2_RuleRateLimit(in *controlplane.RuleRateLimit, out *RuleRateLimit, s conversion.Scope) error {
+	out.PacketsPerSecond = in.PacketsPerSecond
+	out.NewConnectionsPerSecond = in.NewConnectionsPerSecond
+	out.Burst = in.Burst
+	return nil
+}
+
+// Convert_controlplane_RuleRateLimit_To_v1beta2_RuleRateLimit is an autogenerated conversion function.
+func Convert_controlplane_RuleRateLimit_To_v1beta2_RuleRateLimit(in *controlplane.RuleRateLimit, out *RuleRateLimit, s conversion.Scope) error {
+	return autoConvert_controlplane_RuleRateLimit_To_v1beta2_RuleRateLimit(in, out, s)
+}
+
 func autoConvert_v1beta2_Service_To_controlplane_Service(in *Service, out *controlplane.Service, s conversion.Scope) error {
 	out.Protocol = (*controlplane.Protocol)(unsafe.Pointer(in.Protocol))
 	out.Port = (*intstr.IntOrString)(unsafe.Pointer(in.Port))
@@ -1552,6 +1838,10 @@ func autoConvert_v1beta2_SupportBundleCollection_To_controlplane_SupportBundleCo
 	if err := Convert_v1beta2_BundleServerAuthConfiguration_To_controlplane_BundleServerAuthConfiguration(&in.Authentication, &out.Authentication, s); err != nil {
 		return err
 	}
+	out.Profile = in.Profile
+	out.RedactionPatterns = *(*[]string)(unsafe.Pointer(&in.RedactionPatterns))
+	out.AnonymizeIPs = in.AnonymizeIPs
+	out.RedactionSeed = in.RedactionSeed
 	return nil
 }
 
@@ -1570,6 +1860,10 @@ func autoConvert_controlplane_SupportBundleCollection_To_v1beta2_SupportBundleCo
 	if err := Convert_controlplane_BundleServerAuthConfiguration_To_v1beta2_BundleServerAuthConfiguration(&in.Authentication, &out.Authentication, s); err != nil {
 		return err
 	}
+	out.Profile = in.Profile
+	out.RedactionPatterns = *(*[]string)(unsafe.Pointer(&in.RedactionPatterns))
+	out.AnonymizeIPs = in.AnonymizeIPs
+	out.RedactionSeed = in.RedactionSeed
 	return nil
 }
 
@@ -1606,6 +1900,8 @@ func autoConvert_v1beta2_SupportBundleCollectionNodeStatus_To_controlplane_Suppo
 	out.NodeType = controlplane.SupportBundleCollectionNodeType(in.NodeType)
 	out.Completed = in.Completed
 	out.Error = in.Error
+	out.Checksum = in.Checksum
+	out.Location = in.Location
 	return nil
 }
 
@@ -1620,6 +1916,8 @@ func autoConvert_controlplane_SupportBundleCollectionNodeStatus_To_v1beta2_Suppo
 	out.NodeType = string(in.NodeType)
 	out.Completed = in.Completed
 	out.Error = in.Error
+	out.Checksum = in.Checksum
+	out.Location = in.Location
 	return nil
 }
 
@@ -1650,6 +1948,26 @@ func Convert_controlplane_SupportBundleCollectionStatus_To_v1beta2_SupportBundle
 	return autoConvert_controlplane_SupportBundleCollectionStatus_To_v1beta2_SupportBundleCollectionStatus(in, out, s)
 }
 
+func autoConvert_v1beta2_TLSDenyResponse_To_controlplane_TLSDenyResponse(in *TLSDenyResponse, out *controlplane.TLSDenyResponse, s conversion.Scope) error {
+	out.Alert = controlplane.TLSAlert(in.Alert)
+	return nil
+}
+
+// Convert_v1beta2_TLSDenyResponse_To_controlplane_TLSDenyResponse is an autogenerated conversion function.
+func Convert_v1beta2_TLSDenyResponse_To_controlplane_TLSDenyResponse(in *TLSDenyResponse, out *controlplane.TLSDenyResponse, s conversion.Scope) error {
+	return autoConvert_v1beta2_TLSDenyResponse_To_controlplane_TLSDenyResponse(in, out, s)
+}
+
+func autoConvert_controlplane_TLSDenyResponse_To_v1beta2_TLSDenyResponse(in *controlplane.TLSDenyResponse, out *TLSDenyResponse, s conversion.Scope) error {
+	out.Alert = TLSAlert(in.Alert)
+	return nil
+}
+
+// Convert_controlplane_TLSDenyResponse_To_v1beta2_TLSDenyResponse is an autogenerated conversion function.
+func Convert_controlplane_TLSDenyResponse_To_v1beta2_TLSDenyResponse(in *controlplane.TLSDenyResponse, out *TLSDenyResponse, s conversion.Scope) error {
+	return autoConvert_controlplane_TLSDenyResponse_To_v1beta2_TLSDenyResponse(in, out, s)
+}
+
 func autoConvert_v1beta2_TLSProtocol_To_controlplane_TLSProtocol(in *TLSProtocol, out *controlplane.TLSProtocol, s conversion.Scope) error {
 	out.SNI = in.SNI
 	return nil
@@ -765,8 +765,8 @@ type ExternalNode struct {
 
 // ExternalNodeSpec defines the desired state for ExternalNode.
 type ExternalNodeSpec struct {
-	// Only one network interface is supported now.
-	// Other interfaces except interfaces[0] will be ignored if there are more than one interfaces.
+	// An ExternalEntity is generated for each network interface. Names of the
+	// interfaces must be unique, and can be empty only if there is a single interface.
 	Interfaces []NetworkInterface `json:"interfaces,omitempty"`
 }
 
@@ -774,6 +774,11 @@ type NetworkInterface struct {
 	Name string `json:"name,omitempty"`
 
 	IPs []string `json:"ips,omitempty"`
+
+	// Labels are added to the ExternalEntity generated for the interface, in addition
+	// to the labels of the ExternalNode. They take precedence over the labels of the
+	// ExternalNode with the same keys.
+	Labels map[string]string `json:"labels,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -823,6 +828,23 @@ type SupportBundleCollectionSpec struct {
 	SinceTime      string                        `json:"sinceTime,omitempty"`
 	FileServer     BundleFileServer              `json:"fileServer"`
 	Authentication BundleServerAuthConfiguration `json:"authentication"`
+	// Profile is the name of the collection profile, which selects the information collected in
+	// the bundle. Supported values are "all", "policy-only", "datapath" and "perf".
+	// Default is "all".
+	Profile string `json:"profile,omitempty"`
+	// Redaction specifies how sensitive information is redacted from the collected files before
+	// they leave the Nodes. Nothing is redacted if it is not set.
+	Redaction *BundleRedaction `json:"redaction,omitempty"`
+}
+
+// BundleRedaction specifies how sensitive information is redacted from a support bundle.
+type BundleRedaction struct {
+	// Patterns is a list of regular expressions in RE2 syntax. Each match is replaced with a
+	// pseudonym, which is consistent within the SupportBundleCollection.
+	Patterns []string `json:"patterns,omitempty"`
+	// AnonymizeIPs replaces each IPv4 and IPv6 address with a pseudonym, which is consistent
+	// within the SupportBundleCollection. Loopback and unspecified addresses are kept.
+	AnonymizeIPs bool `json:"anonymizeIPs,omitempty"`
 }
 
 type SupportBundleCollectionStatus struct {
@@ -832,6 +854,23 @@ type SupportBundleCollectionStatus struct {
 	DesiredNodes int32 `json:"desiredNodes"`
 	// Represents the latest available observations of a SupportBundleCollection current state.
 	Conditions []SupportBundleCollectionCondition `json:"conditions"`
+	// Bundles lists the bundle files which have been uploaded to the file server.
+	Bundles []CollectedBundle `json:"bundles,omitempty"`
+}
+
+// CollectedBundle describes a bundle file uploaded by a Node or an ExternalNode.
+type CollectedBundle struct {
+	// The name of the Node or ExternalNode which uploaded the bundle file.
+	NodeName string `json:"nodeName"`
+	// The Namespace of the ExternalNode which uploaded the bundle file. It is empty for Nodes.
+	NodeNamespace string `json:"nodeNamespace,omitempty"`
+	// The type of the Node which uploaded the bundle file. The values include Node and ExternalNode.
+	NodeType string `json:"nodeType"`
+	// Location is the URL of the bundle file on the file server.
+	Location string `json:"location,omitempty"`
+	// Checksum is the checksum of the bundle file, in the format "<algorithm>:<hex digest>",
+	// e.g. "sha256:2cf24dba...".
+	Checksum string `json:"checksum,omitempty"`
 }
 
 type SupportBundleCollectionConditionType string
@@ -135,6 +135,27 @@ func (in *BundleNodes) DeepCopy() *BundleNodes {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BundleRedaction) DeepCopyInto(out *BundleRedaction) {
+	*out = *in
+	if in.Patterns != nil {
+		in, out := &in.Patterns, &out.Patterns
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BundleRedaction.
+func (in *BundleRedaction) DeepCopy() *BundleRedaction {
+	if in == nil {
+		return nil
+	}
+	out := new(BundleRedaction)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BundleServerAuthConfiguration) DeepCopyInto(out *BundleServerAuthConfiguration) {
 	*out = *in
@@ -156,6 +177,22 @@ func (in *BundleServerAuthConfiguration) DeepCopy() *BundleServerAuthConfigurati
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CollectedBundle) DeepCopyInto(out *CollectedBundle) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CollectedBundle.
+func (in *CollectedBundle) DeepCopy() *CollectedBundle {
+	if in == nil {
+		return nil
+	}
+	out := new(CollectedBundle)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterNetworkPolicy) DeepCopyInto(out *ClusterNetworkPolicy) {
 	*out = *in
@@ -535,6 +572,13 @@ func (in *NetworkInterface) DeepCopyInto(out *NetworkInterface) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	return
 }
 
@@ -1073,6 +1117,11 @@ func (in *SupportBundleCollectionSpec) DeepCopyInto(out *SupportBundleCollection
 	}
 	out.FileServer = in.FileServer
 	in.Authentication.DeepCopyInto(&out.Authentication)
+	if in.Redaction != nil {
+		in, out := &in.Redaction, &out.Redaction
+		*out = new(BundleRedaction)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1096,6 +1145,11 @@ func (in *SupportBundleCollectionStatus) DeepCopyInto(out *SupportBundleCollecti
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Bundles != nil {
+		in, out := &in.Bundles, &out.Bundles
+		*out = make([]CollectedBundle, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
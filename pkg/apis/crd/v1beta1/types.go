@@ -389,6 +389,11 @@ type NetworkPolicySpec struct {
 	// Priority specfies the order of the NetworkPolicy relative to other
 	// NetworkPolicies.
 	Priority float64 `json:"priority"`
+	// EnforcementMode specifies whether the rules of this NetworkPolicy are
+	// enforced or only audited. In Audit mode, the rules are evaluated but the
+	// traffic they match is only logged and counted. Defaults to Enforce.
+	// +optional
+	EnforcementMode PolicyEnforcementMode `json:"enforcementMode,omitempty"`
 	// Select workloads on which the rules will be applied to. Cannot be set in
 	// conjunction with AppliedTo in each rule.
 	// +optional
@@ -408,6 +413,19 @@ type NetworkPolicySpec struct {
 // NetworkPolicyPhase defines the phase in which a NetworkPolicy is.
 type NetworkPolicyPhase string
 
+// PolicyEnforcementMode defines how the rules of an Antrea-native policy are
+// applied to the traffic they match.
+type PolicyEnforcementMode string
+
+const (
+	// PolicyEnforcementModeEnforce means the actions of the rules are applied to
+	// the traffic.
+	PolicyEnforcementModeEnforce PolicyEnforcementMode = "Enforce"
+	// PolicyEnforcementModeAudit means the traffic matching the rules is logged
+	// and counted, but the actions of the rules are not applied to it.
+	PolicyEnforcementModeAudit PolicyEnforcementMode = "Audit"
+)
+
 // NetworkPolicyConditionType describes the condition types of NetworkPolicies.
 type NetworkPolicyConditionType string
 
@@ -429,6 +447,18 @@ const (
 	NetworkPolicyConditionRealizable NetworkPolicyConditionType = "Realizable"
 	// NetworkPolicyConditionRealizationFailure reports information about a failure when realizing the NetworkPolicy on a Node.
 	NetworkPolicyConditionRealizationFailure NetworkPolicyConditionType = "RealizationFailure"
+	// NetworkPolicyConditionShadowedRules reports the rules of the NetworkPolicy which can never be matched because
+	// a rule with a higher precedence and a different action matches all their traffic.
+	NetworkPolicyConditionShadowedRules NetworkPolicyConditionType = "ShadowedRules"
+	// NetworkPolicyConditionRedundantRules reports the rules of the NetworkPolicy which can never be matched because
+	// a rule with a higher precedence and the same action matches all their traffic.
+	NetworkPolicyConditionRedundantRules NetworkPolicyConditionType = "RedundantRules"
+	// NetworkPolicyConditionConflictingRules reports the rules of the NetworkPolicy whose action may conflict with the
+	// action of a rule of another NetworkPolicy with the same priority, for some traffic.
+	NetworkPolicyConditionConflictingRules NetworkPolicyConditionType = "ConflictingRules"
+	// NetworkPolicyConditionInvalidRuleSchedules reports the rules of the NetworkPolicy whose schedules are invalid.
+	// These rules are never enforced if they allow traffic, and always enforced otherwise.
+	NetworkPolicyConditionInvalidRuleSchedules NetworkPolicyConditionType = "InvalidRuleSchedules"
 )
 
 // NetworkPolicyCondition describes the state of a NetworkPolicy at a certain point.
@@ -460,6 +490,14 @@ type NetworkPolicyStatus struct {
 	DesiredNodesRealized int32 `json:"desiredNodesRealized"`
 	// Represents the latest available observations of a NetworkPolicy current state.
 	Conditions []NetworkPolicyCondition `json:"conditions"`
+	// The next time at which a rule with a schedule is activated or deactivated.
+	// It is not set if no rule of the NetworkPolicy has a schedule.
+	// +optional
+	NextScheduleTransitionTime *metav1.Time `json:"nextScheduleTransitionTime,omitempty"`
+	// The enforcement mode of the NetworkPolicy. It is only set when the
+	// NetworkPolicy is not enforced, i.e. it is in Audit mode.
+	// +optional
+	EnforcementMode PolicyEnforcementMode `json:"enforcementMode,omitempty"`
 }
 
 // Rule describes the traffic allowed to/from the workloads selected by
@@ -482,6 +520,10 @@ type Rule struct {
 	// traffic will be allowed if the layer 7 criteria is also matched, otherwise it will be dropped. Therefore, any
 	// rules after a layer 7 rule will not be enforced for the traffic.
 	L7Protocols []L7Protocol `json:"l7Protocols,omitempty"`
+	// L7DenyResponse specifies the responses sent to clients whose layer 7 requests are denied by the rule. It can
+	// only be set when L7Protocols is set. If not set, denied requests are dropped and their connections are reset.
+	// +optional
+	L7DenyResponse *L7DenyResponse `json:"l7DenyResponse,omitempty"`
 	// Rule is matched if traffic originates from workloads selected by
 	// this field. If this field is empty, this rule matches all sources.
 	// +optional
@@ -492,13 +534,15 @@ type Rule struct {
 	// +optional
 	To []NetworkPolicyPeer `json:"to,omitempty"`
 	// Rule is matched if traffic is intended for a Service listed in this field.
-	// Currently, only ClusterIP types Services are supported in this field.
+	// Services with a ClusterIP and a selector are matched by their ClusterIPs,
+	// which requires AntreaProxy to be enabled. ExternalName Services are matched
+	// by their external names, and headless and selectorless Services are matched
+	// by the addresses and ports of their Endpoints.
 	// When scope is set to ClusterSet, it matches traffic intended for a multi-cluster
 	// Service listed in this field. Service name and Namespace provided should match
 	// the original exported Service.
-	// This field can only be used when AntreaProxy is enabled. This field can't be used
-	// with To or Ports. If this field and To are both empty or missing, this rule matches
-	// all destinations.
+	// This field can't be used with To or Ports. If this field and To are both empty
+	// or missing, this rule matches all destinations.
 	// +optional
 	ToServices []PeerService `json:"toServices,omitempty"`
 	// Name describes the intention of this rule.
@@ -516,6 +560,60 @@ type Rule struct {
 	// conjunction with NetworkPolicySpec/ClusterNetworkPolicySpec.AppliedTo.
 	// +optional
 	AppliedTo []AppliedTo `json:"appliedTo,omitempty"`
+	// Schedule restricts the rule to be enforced only during the time windows
+	// it defines. If not set, the rule is always enforced.
+	// +optional
+	Schedule *RuleSchedule `json:"schedule,omitempty"`
+	// RateLimit specifies the rate above which the traffic matching the rule is
+	// dropped. It must be set if and only if Action is RateLimit.
+	// +optional
+	RateLimit *RuleRateLimit `json:"rateLimit,omitempty"`
+}
+
+// RuleRateLimit defines the rate limit enforced by a rule with the RateLimit
+// action. Exactly one of PacketsPerSecond and NewConnectionsPerSecond must be
+// set. The limit is enforced on each Node by 64 meters per rule, and the
+// sources of the traffic matching the rule are distributed among the meters by
+// the 6 lowest bits of their IP addresses. Hence the limit is not strictly per
+// source: all the sources whose IP addresses share these bits share the same
+// limit. As the meters available on a Node are limited, at most 1008 rules with
+// the RateLimit action are supported in the cluster.
+type RuleRateLimit struct {
+	// PacketsPerSecond is the maximum rate of packets matching the rule per
+	// group of sources sharing a meter.
+	// +optional
+	PacketsPerSecond int32 `json:"packetsPerSecond,omitempty"`
+	// NewConnectionsPerSecond is the maximum rate of new connections matching
+	// the rule per group of sources sharing a meter. Packets of established
+	// connections are not limited.
+	// +optional
+	NewConnectionsPerSecond int32 `json:"newConnectionsPerSecond,omitempty"`
+	// Burst is the number of packets or new connections allowed in excess of
+	// the rate. Defaults to 0.
+	// +optional
+	Burst int32 `json:"burst,omitempty"`
+}
+
+// RuleSchedule defines the time windows during which a rule is enforced.
+type RuleSchedule struct {
+	// TimeZone is the IANA name of the time zone the windows are evaluated in,
+	// e.g. "America/Los_Angeles". Defaults to UTC.
+	// +optional
+	TimeZone string `json:"timeZone,omitempty"`
+	// Windows are the time windows during which the rule is enforced. The rule
+	// is enforced if the current time is within any of the windows.
+	Windows []ScheduleWindow `json:"windows"`
+}
+
+// ScheduleWindow defines a recurring time window.
+type ScheduleWindow struct {
+	// Start is a standard 5-field cron expression ("minute hour day-of-month
+	// month day-of-week") which specifies when the window starts, e.g.
+	// "0 22 * * 6" for every Saturday at 22:00.
+	Start string `json:"start"`
+	// Duration is the length of the window, in the format accepted by Go's
+	// time.ParseDuration, e.g. "2h30m".
+	Duration string `json:"duration"`
 }
 
 // NetworkPolicyPeer describes the grouping selector of workloads.
@@ -575,7 +673,8 @@ type NetworkPolicyPeer struct {
 	// +optional
 	NodeSelector *metav1.LabelSelector `json:"nodeSelector,omitempty"`
 	// Define scope of the Pod/NamespaceSelector(s) of this peer.
-	// Can only be used in ingress NetworkPolicyPeers.
+	// In egress NetworkPolicyPeers, ClusterSet scope can only be used in rules
+	// whose action is Drop or Reject.
 	// Defaults to "Cluster".
 	// +optional
 	Scope PeerScope `json:"scope,omitempty"`
@@ -692,6 +791,9 @@ const (
 	// RuleActionReject indicates that the traffic matching the rule must be rejected and the
 	// client will receive a response.
 	RuleActionReject RuleAction = "Reject"
+	// RuleActionRateLimit indicates that the traffic matching the rule is allowed within
+	// the limit specified by the rule's RateLimit, and dropped above it.
+	RuleActionRateLimit RuleAction = "RateLimit"
 
 	IGMPQuery    int32 = 0x11
 	IGMPReportV1 int32 = 0x12
@@ -735,6 +837,11 @@ type ClusterNetworkPolicySpec struct {
 	// Priority specfies the order of the ClusterNetworkPolicy relative to
 	// other AntreaClusterNetworkPolicies.
 	Priority float64 `json:"priority"`
+	// EnforcementMode specifies whether the rules of this ClusterNetworkPolicy are
+	// enforced or only audited. In Audit mode, the rules are evaluated but the
+	// traffic they match is only logged and counted. Defaults to Enforce.
+	// +optional
+	EnforcementMode PolicyEnforcementMode `json:"enforcementMode,omitempty"`
 	// Select workloads on which the rules will be applied to. Cannot be set in
 	// conjunction with AppliedTo in each rule.
 	// +optional
@@ -804,12 +911,15 @@ type IGMPProtocol struct {
 }
 
 type L7Protocol struct {
-	HTTP *HTTPProtocol `json:"http,omitempty"`
-	TLS  *TLSProtocol  `json:"tls,omitempty"`
+	HTTP  *HTTPProtocol  `json:"http,omitempty"`
+	TLS   *TLSProtocol   `json:"tls,omitempty"`
+	GRPC  *GRPCProtocol  `json:"grpc,omitempty"`
+	DNS   *DNSProtocol   `json:"dns,omitempty"`
+	Kafka *KafkaProtocol `json:"kafka,omitempty"`
 }
 
-// HTTPProtocol matches HTTP requests with specific host, method, and path. All fields could be used alone or together.
-// If all fields are not provided, it matches all HTTP requests.
+// HTTPProtocol matches HTTP requests with specific host, method, path, headers and query parameters. All fields could
+// be used alone or together. If all fields are not provided, it matches all HTTP requests.
 type HTTPProtocol struct {
 	// Host represents the hostname present in the URI or the HTTP Host header to match.
 	// It does not contain the port associated with the host.
@@ -819,6 +929,50 @@ type HTTPProtocol struct {
 	Method string `json:"method,omitempty"`
 	// Path represents the URI path to match (Ex. "/index.html", "/admin").
 	Path string `json:"path,omitempty"`
+	// PathMatchType specifies how Path is matched. If not set, Path supports wildcards: a
+	// leading "*" means suffix match and a trailing "*" means prefix match, otherwise the
+	// path is matched exactly.
+	PathMatchType HTTPMatchType `json:"pathMatchType,omitempty"`
+	// Headers represents the HTTP request headers to match. A request must match all of them.
+	Headers []HTTPHeaderMatch `json:"headers,omitempty"`
+	// QueryParams represents the query parameters of the URI to match. A request must match
+	// all of them.
+	QueryParams []HTTPQueryParamMatch `json:"queryParams,omitempty"`
+}
+
+// HTTPMatchType specifies how a value of HTTP requests is matched.
+type HTTPMatchType string
+
+const (
+	// HTTPMatchTypeExact matches the whole value exactly.
+	HTTPMatchTypeExact HTTPMatchType = "Exact"
+	// HTTPMatchTypePrefix matches values starting with the provided value.
+	HTTPMatchTypePrefix HTTPMatchType = "Prefix"
+	// HTTPMatchTypeRegex matches values with the provided regular expression, which must
+	// match the whole value.
+	HTTPMatchTypeRegex HTTPMatchType = "Regex"
+)
+
+// HTTPHeaderMatch matches an HTTP request header.
+type HTTPHeaderMatch struct {
+	// Name is the name of the header to match (Ex. "X-Tenant-ID"). It is case-insensitive.
+	Name string `json:"name"`
+	// Value is the value of the header to match. If not set, the header only needs to be
+	// present in the request.
+	Value string `json:"value,omitempty"`
+	// MatchType specifies how Value is matched. Defaults to Exact.
+	MatchType HTTPMatchType `json:"matchType,omitempty"`
+}
+
+// HTTPQueryParamMatch matches a query parameter of the URI of an HTTP request.
+type HTTPQueryParamMatch struct {
+	// Name is the name of the query parameter to match. It is case-sensitive.
+	Name string `json:"name"`
+	// Value is the value of the query parameter to match. If not set, the query parameter
+	// only needs to be present in the URI.
+	Value string `json:"value,omitempty"`
+	// MatchType specifies how Value is matched. Defaults to Exact.
+	MatchType HTTPMatchType `json:"matchType,omitempty"`
 }
 
 // TLSProtocol matches TLS handshake packets with specific SNI. If the field is not provided, this
@@ -828,6 +982,63 @@ type TLSProtocol struct {
 	SNI string `json:"sni,omitempty"`
 }
 
+// GRPCProtocol matches gRPC requests with specific service and method. All fields could be used alone or together.
+// If all fields are not provided, it matches all gRPC requests.
+type GRPCProtocol struct {
+	// Service represents the fully-qualified name of the gRPC service to match, including the package name
+	// (Ex. "helloworld.Greeter").
+	Service string `json:"service,omitempty"`
+	// Method represents the name of the gRPC method to match (Ex. "SayHello").
+	Method string `json:"method,omitempty"`
+}
+
+// DNSProtocol matches DNS queries with specific query name. If the field is not provided, this matches all
+// DNS queries.
+type DNSProtocol struct {
+	// QueryName represents the domain name in the question of the DNS query to match (Ex. "*.example.com").
+	QueryName string `json:"queryName,omitempty"`
+}
+
+// KafkaProtocol matches Kafka requests with specific API key and topic. All fields could be used alone or together.
+// If all fields are not provided, it matches all Kafka requests.
+type KafkaProtocol struct {
+	// APIKey represents the type of the Kafka request to match. It could be produce, fetch, listOffsets,
+	// metadata, offsetCommit, offsetFetch, findCoordinator, joinGroup, heartbeat, leaveGroup, syncGroup,
+	// describeGroups, listGroups, saslHandshake, apiVersions, createTopics and deleteTopics.
+	APIKey string `json:"apiKey,omitempty"`
+	// Topic represents the name of the Kafka topic to match.
+	Topic string `json:"topic,omitempty"`
+}
+
+// L7DenyResponse defines the responses sent to clients whose layer 7 requests are denied.
+type L7DenyResponse struct {
+	// HTTP specifies the response sent to denied HTTP requests.
+	HTTP *HTTPDenyResponse `json:"http,omitempty"`
+	// TLS specifies the alert sent to clients whose TLS handshakes are denied.
+	TLS *TLSDenyResponse `json:"tls,omitempty"`
+}
+
+// HTTPDenyResponse defines the "403 Forbidden" response sent to denied HTTP requests.
+type HTTPDenyResponse struct {
+	// Body is the body of the response. If not set, the response has no body.
+	Body string `json:"body,omitempty"`
+}
+
+// TLSDenyResponse defines the fatal alert sent to clients whose TLS handshakes are denied.
+type TLSDenyResponse struct {
+	// Alert is the description of the alert. Defaults to AccessDenied.
+	Alert TLSAlert `json:"alert,omitempty"`
+}
+
+// TLSAlert is the description of a TLS alert.
+type TLSAlert string
+
+const (
+	TLSAlertAccessDenied     TLSAlert = "AccessDenied"
+	TLSAlertHandshakeFailure TLSAlert = "HandshakeFailure"
+	TLSAlertUnrecognizedName TLSAlert = "UnrecognizedName"
+)
+
 // +genclient
 // +genclient:nonNamespaced
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -921,6 +1132,9 @@ type EgressList struct {
 type TraceflowPhase string
 
 const (
+	// Pending means the Traceflow is waiting for its linked Traceflow in another member
+	// cluster of a Multi-cluster ClusterSet to start.
+	Pending   TraceflowPhase = "Pending"
 	Running   TraceflowPhase = "Running"
 	Succeeded TraceflowPhase = "Succeeded"
 	Failed    TraceflowPhase = "Failed"
@@ -1027,9 +1241,17 @@ type Source struct {
 	Namespace string `json:"namespace,omitempty"`
 	// Pod is the source pod.
 	Pod string `json:"pod,omitempty"`
+	// ExternalEntity is the source ExternalEntity, exclusive with source pod.
+	// ExternalEntity as the source is supported only for live-traffic Traceflow.
+	ExternalEntity string `json:"externalEntity,omitempty"`
 	// IP is the source IPv4 or IPv6 address. IP as the source is supported
 	// only for live-traffic Traceflow.
 	IP string `json:"ip,omitempty"`
+	// ClusterID is the ID of the member cluster of the source Pod, when the
+	// source Pod is in another member cluster of a Multi-cluster ClusterSet.
+	// It is set in the linked Traceflow created by Antrea Multi-cluster in the
+	// destination member cluster of a cross-cluster Traceflow.
+	ClusterID string `json:"clusterID,omitempty"`
 }
 
 // Destination describes the destination spec of the traceflow.
@@ -1040,8 +1262,15 @@ type Destination struct {
 	Pod string `json:"pod,omitempty"`
 	// Service is the destination service, exclusive with destination pod.
 	Service string `json:"service,omitempty"`
+	// ExternalEntity is the destination ExternalEntity, exclusive with
+	// destination pod and service.
+	ExternalEntity string `json:"externalEntity,omitempty"`
 	// IP is the destination IPv4 or IPv6 address.
 	IP string `json:"ip,omitempty"`
+	// ClusterID is the ID of the member cluster of the destination Pod or
+	// Service, when the destination is in another member cluster of a
+	// Multi-cluster ClusterSet.
+	ClusterID string `json:"clusterID,omitempty"`
 }
 
 // IPHeader describes spec of an IPv4 header.
@@ -1121,6 +1350,9 @@ type TraceflowStatus struct {
 	StartTime *metav1.Time `json:"startTime,omitempty"`
 	// DataplaneTag is a tag to identify a traceflow session across Nodes.
 	DataplaneTag int8 `json:"dataplaneTag,omitempty"`
+	// DestinationIP is the IP of the destination of a cross-cluster Traceflow,
+	// which is resolved in the destination member cluster.
+	DestinationIP string `json:"destinationIP,omitempty"`
 	// Results is the collection of all observations on different nodes.
 	Results []NodeResult `json:"results,omitempty"`
 	// CapturedPacket is the captured packet in live-traffic Traceflow.
@@ -1128,6 +1360,10 @@ type TraceflowStatus struct {
 }
 
 type NodeResult struct {
+	// ClusterID is the ID of the member cluster of the Node, which is set
+	// only for the results reported in another member cluster for a
+	// cross-cluster Traceflow.
+	ClusterID string `json:"clusterID,omitempty" yaml:"clusterID,omitempty"`
 	// Node is the node of the observation.
 	Node string `json:"node,omitempty" yaml:"node,omitempty"`
 	// Role of the node like sender, receiver, etc.
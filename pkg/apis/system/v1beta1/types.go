@@ -38,4 +38,19 @@ type SupportBundle struct {
 	Since    string       `json:"since,omitempty"`
 	Size     uint32       `json:"size,omitempty"`
 	Filepath string       `json:"-"`
+	// Profile selects the information collected in the bundle. Empty means all.
+	Profile string `json:"profile,omitempty"`
+	// Redaction specifies how sensitive information is redacted from the bundle.
+	Redaction *BundleRedaction `json:"redaction,omitempty"`
+}
+
+// BundleRedaction specifies how sensitive information is redacted from a support bundle.
+type BundleRedaction struct {
+	// Patterns is a list of regular expressions whose matches are replaced with pseudonyms.
+	Patterns []string `json:"patterns,omitempty"`
+	// AnonymizeIPs replaces IP addresses with pseudonyms.
+	AnonymizeIPs bool `json:"anonymizeIPs,omitempty"`
+	// Seed is used to derive the pseudonyms. The same seed always generates the same pseudonym
+	// for a given value, so that bundles collected from different components can be correlated.
+	Seed string `json:"seed,omitempty"`
 }
@@ -0,0 +1,84 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policyanalysis
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"antrea.io/antrea/pkg/antctl/transform/common"
+	"antrea.io/antrea/pkg/controller/networkpolicy"
+)
+
+// Response describes the response struct of policyanalysis command.
+type Response struct {
+	Type        string `json:"type,omitempty"`
+	Policy      string `json:"policy,omitempty"`
+	Rule        string `json:"rule,omitempty"`
+	Direction   string `json:"direction,omitempty"`
+	OtherPolicy string `json:"otherPolicy,omitempty"`
+	OtherRule   string `json:"otherRule,omitempty"`
+}
+
+func generateResponse(finding *networkpolicy.PolicyAnalysisFinding) Response {
+	return Response{
+		Type:        string(finding.Type),
+		Policy:      finding.Rule.Policy,
+		Rule:        finding.Rule.Rule,
+		Direction:   string(finding.Rule.Direction),
+		OtherPolicy: finding.OtherRule.Policy,
+		OtherRule:   finding.OtherRule.Rule,
+	}
+}
+
+// HandleFunc returns the function which can handle queries issued by the policyanalysis command. It returns the
+// shadowed, redundant and conflicting rules of Antrea-native policies. The findings can be filtered by type, and by
+// policy, using the same reference format as in the audit logs (e.g. "AntreaClusterNetworkPolicy:acnp1"), in which
+// case the findings in which the policy is involved are returned.
+func HandleFunc(paq networkpolicy.PolicyAnalysisQuerier) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		policy := r.URL.Query().Get("policy")
+		findingType := r.URL.Query().Get("type")
+		responses := []Response{}
+		findings := paq.AnalyzePolicies()
+		for i := range findings {
+			if policy != "" && findings[i].Rule.Policy != policy && findings[i].OtherRule.Policy != policy {
+				continue
+			}
+			if findingType != "" && string(findings[i].Type) != findingType {
+				continue
+			}
+			responses = append(responses, generateResponse(&findings[i]))
+		}
+		if err := json.NewEncoder(w).Encode(responses); err != nil {
+			http.Error(w, "Failed to encode response: "+err.Error(), http.StatusInternalServerError)
+		}
+	}
+}
+
+var _ common.TableOutput = (*Response)(nil)
+
+func (r Response) GetTableHeader() []string {
+	return []string{"TYPE", "POLICY", "RULE", "DIRECTION", "OTHER-POLICY", "OTHER-RULE"}
+}
+
+func (r Response) GetTableRow(_ int) []string {
+	return []string{r.Type, r.Policy, r.Rule, r.Direction, r.OtherPolicy, r.OtherRule}
+}
+
+// SortRows returns false as the findings are already sorted by the precedence of the rules.
+func (r Response) SortRows() bool {
+	return false
+}
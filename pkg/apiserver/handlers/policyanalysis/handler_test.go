@@ -0,0 +1,106 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policyanalysis
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"antrea.io/antrea/pkg/apis/controlplane"
+	"antrea.io/antrea/pkg/controller/networkpolicy"
+	queriertesting "antrea.io/antrea/pkg/controller/networkpolicy/testing"
+)
+
+func TestPolicyAnalysisQuery(t *testing.T) {
+	findings := []networkpolicy.PolicyAnalysisFinding{
+		{
+			Type:      networkpolicy.PolicyAnalysisShadowed,
+			Rule:      networkpolicy.PolicyRuleReference{Policy: "AntreaClusterNetworkPolicy:acnp2", Rule: "allow-web", Direction: controlplane.DirectionIn},
+			OtherRule: networkpolicy.PolicyRuleReference{Policy: "AntreaClusterNetworkPolicy:acnp1", Rule: "drop-all", Direction: controlplane.DirectionIn},
+		},
+		{
+			Type:      networkpolicy.PolicyAnalysisConflict,
+			Rule:      networkpolicy.PolicyRuleReference{Policy: "AntreaNetworkPolicy:ns1/annp2", Rule: "drop-db", Direction: controlplane.DirectionOut},
+			OtherRule: networkpolicy.PolicyRuleReference{Policy: "AntreaNetworkPolicy:ns1/annp1", Rule: "allow-db", Direction: controlplane.DirectionOut},
+		},
+	}
+	shadowedResponse := Response{
+		Type:        "Shadowed",
+		Policy:      "AntreaClusterNetworkPolicy:acnp2",
+		Rule:        "allow-web",
+		Direction:   "In",
+		OtherPolicy: "AntreaClusterNetworkPolicy:acnp1",
+		OtherRule:   "drop-all",
+	}
+	conflictResponse := Response{
+		Type:        "Conflict",
+		Policy:      "AntreaNetworkPolicy:ns1/annp2",
+		Rule:        "drop-db",
+		Direction:   "Out",
+		OtherPolicy: "AntreaNetworkPolicy:ns1/annp1",
+		OtherRule:   "allow-db",
+	}
+
+	tests := []struct {
+		name             string
+		query            string
+		expectedResponse []Response
+	}{
+		{
+			name:             "get all findings",
+			expectedResponse: []Response{shadowedResponse, conflictResponse},
+		},
+		{
+			name:             "get findings of a policy",
+			query:            "?policy=AntreaNetworkPolicy:ns1/annp1",
+			expectedResponse: []Response{conflictResponse},
+		},
+		{
+			name:             "get findings of a type",
+			query:            "?type=Shadowed",
+			expectedResponse: []Response{shadowedResponse},
+		},
+		{
+			name:             "get findings of a policy without findings",
+			query:            "?policy=AntreaClusterNetworkPolicy:acnp3",
+			expectedResponse: []Response{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			q := queriertesting.NewMockPolicyAnalysisQuerier(ctrl)
+			q.EXPECT().AnalyzePolicies().Return(findings)
+			handler := HandleFunc(q)
+
+			req, err := http.NewRequest(http.MethodGet, tt.query, nil)
+			require.NoError(t, err)
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, req)
+			assert.Equal(t, http.StatusOK, recorder.Code)
+
+			var received []Response
+			err = json.Unmarshal(recorder.Body.Bytes(), &received)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedResponse, received)
+		})
+	}
+}
@@ -40,18 +40,25 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.BundleFileServer":                  schema_pkg_apis_controlplane_v1beta2_BundleFileServer(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.BundleServerAuthConfiguration":     schema_pkg_apis_controlplane_v1beta2_BundleServerAuthConfiguration(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.ClusterGroupMembers":               schema_pkg_apis_controlplane_v1beta2_ClusterGroupMembers(ref),
+		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.DNSProtocol":                       schema_pkg_apis_controlplane_v1beta2_DNSProtocol(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.EgressGroup":                       schema_pkg_apis_controlplane_v1beta2_EgressGroup(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.EgressGroupList":                   schema_pkg_apis_controlplane_v1beta2_EgressGroupList(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.EgressGroupPatch":                  schema_pkg_apis_controlplane_v1beta2_EgressGroupPatch(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.ExternalEntityReference":           schema_pkg_apis_controlplane_v1beta2_ExternalEntityReference(ref),
+		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.GRPCProtocol":                      schema_pkg_apis_controlplane_v1beta2_GRPCProtocol(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.GroupAssociation":                  schema_pkg_apis_controlplane_v1beta2_GroupAssociation(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.GroupMember":                       schema_pkg_apis_controlplane_v1beta2_GroupMember(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.GroupMembers":                      schema_pkg_apis_controlplane_v1beta2_GroupMembers(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.GroupReference":                    schema_pkg_apis_controlplane_v1beta2_GroupReference(ref),
+		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.HTTPDenyResponse":                  schema_pkg_apis_controlplane_v1beta2_HTTPDenyResponse(ref),
+		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.HTTPHeaderMatch":                   schema_pkg_apis_controlplane_v1beta2_HTTPHeaderMatch(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.HTTPProtocol":                      schema_pkg_apis_controlplane_v1beta2_HTTPProtocol(ref),
+		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.HTTPQueryParamMatch":               schema_pkg_apis_controlplane_v1beta2_HTTPQueryParamMatch(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.IPBlock":                           schema_pkg_apis_controlplane_v1beta2_IPBlock(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.IPGroupAssociation":                schema_pkg_apis_controlplane_v1beta2_IPGroupAssociation(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.IPNet":                             schema_pkg_apis_controlplane_v1beta2_IPNet(ref),
+		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.KafkaProtocol":                     schema_pkg_apis_controlplane_v1beta2_KafkaProtocol(ref),
+		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.L7DenyResponse":                    schema_pkg_apis_controlplane_v1beta2_L7DenyResponse(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.L7Protocol":                        schema_pkg_apis_controlplane_v1beta2_L7Protocol(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.MulticastGroupInfo":                schema_pkg_apis_controlplane_v1beta2_MulticastGroupInfo(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.NamedPort":                         schema_pkg_apis_controlplane_v1beta2_NamedPort(ref),
@@ -67,12 +74,14 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.NodeStatsSummary":                  schema_pkg_apis_controlplane_v1beta2_NodeStatsSummary(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.PaginationGetOptions":              schema_pkg_apis_controlplane_v1beta2_PaginationGetOptions(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.PodReference":                      schema_pkg_apis_controlplane_v1beta2_PodReference(ref),
+		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.RuleRateLimit":                     schema_pkg_apis_controlplane_v1beta2_RuleRateLimit(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.Service":                           schema_pkg_apis_controlplane_v1beta2_Service(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.ServiceReference":                  schema_pkg_apis_controlplane_v1beta2_ServiceReference(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.SupportBundleCollection":           schema_pkg_apis_controlplane_v1beta2_SupportBundleCollection(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.SupportBundleCollectionList":       schema_pkg_apis_controlplane_v1beta2_SupportBundleCollectionList(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.SupportBundleCollectionNodeStatus": schema_pkg_apis_controlplane_v1beta2_SupportBundleCollectionNodeStatus(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.SupportBundleCollectionStatus":     schema_pkg_apis_controlplane_v1beta2_SupportBundleCollectionStatus(ref),
+		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.TLSDenyResponse":                   schema_pkg_apis_controlplane_v1beta2_TLSDenyResponse(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.TLSProtocol":                       schema_pkg_apis_controlplane_v1beta2_TLSProtocol(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.AgentCondition":                             schema_pkg_apis_crd_v1beta1_AgentCondition(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.AntreaAgentInfo":                            schema_pkg_apis_crd_v1beta1_AntreaAgentInfo(ref),
@@ -87,6 +96,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.ClusterNetworkPolicyList":                   schema_pkg_apis_crd_v1beta1_ClusterNetworkPolicyList(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.ClusterNetworkPolicySpec":                   schema_pkg_apis_crd_v1beta1_ClusterNetworkPolicySpec(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.ControllerCondition":                        schema_pkg_apis_crd_v1beta1_ControllerCondition(ref),
+		"antrea.io/antrea/pkg/apis/crd/v1beta1.DNSProtocol":                                schema_pkg_apis_crd_v1beta1_DNSProtocol(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.Destination":                                schema_pkg_apis_crd_v1beta1_Destination(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.Egress":                                     schema_pkg_apis_crd_v1beta1_Egress(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.EgressCondition":                            schema_pkg_apis_crd_v1beta1_EgressCondition(ref),
@@ -97,12 +107,16 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.ExternalIPPoolList":                         schema_pkg_apis_crd_v1beta1_ExternalIPPoolList(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.ExternalIPPoolSpec":                         schema_pkg_apis_crd_v1beta1_ExternalIPPoolSpec(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.ExternalIPPoolStatus":                       schema_pkg_apis_crd_v1beta1_ExternalIPPoolStatus(ref),
+		"antrea.io/antrea/pkg/apis/crd/v1beta1.GRPCProtocol":                               schema_pkg_apis_crd_v1beta1_GRPCProtocol(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.Group":                                      schema_pkg_apis_crd_v1beta1_Group(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.GroupCondition":                             schema_pkg_apis_crd_v1beta1_GroupCondition(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.GroupList":                                  schema_pkg_apis_crd_v1beta1_GroupList(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.GroupSpec":                                  schema_pkg_apis_crd_v1beta1_GroupSpec(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.GroupStatus":                                schema_pkg_apis_crd_v1beta1_GroupStatus(ref),
+		"antrea.io/antrea/pkg/apis/crd/v1beta1.HTTPDenyResponse":                           schema_pkg_apis_crd_v1beta1_HTTPDenyResponse(ref),
+		"antrea.io/antrea/pkg/apis/crd/v1beta1.HTTPHeaderMatch":                            schema_pkg_apis_crd_v1beta1_HTTPHeaderMatch(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.HTTPProtocol":                               schema_pkg_apis_crd_v1beta1_HTTPProtocol(ref),
+		"antrea.io/antrea/pkg/apis/crd/v1beta1.HTTPQueryParamMatch":                        schema_pkg_apis_crd_v1beta1_HTTPQueryParamMatch(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.ICMPEchoRequestHeader":                      schema_pkg_apis_crd_v1beta1_ICMPEchoRequestHeader(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.ICMPProtocol":                               schema_pkg_apis_crd_v1beta1_ICMPProtocol(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.IGMPProtocol":                               schema_pkg_apis_crd_v1beta1_IGMPProtocol(ref),
@@ -111,6 +125,8 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.IPPoolUsage":                                schema_pkg_apis_crd_v1beta1_IPPoolUsage(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.IPRange":                                    schema_pkg_apis_crd_v1beta1_IPRange(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.IPv6Header":                                 schema_pkg_apis_crd_v1beta1_IPv6Header(ref),
+		"antrea.io/antrea/pkg/apis/crd/v1beta1.KafkaProtocol":                              schema_pkg_apis_crd_v1beta1_KafkaProtocol(ref),
+		"antrea.io/antrea/pkg/apis/crd/v1beta1.L7DenyResponse":                             schema_pkg_apis_crd_v1beta1_L7DenyResponse(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.L7Protocol":                                 schema_pkg_apis_crd_v1beta1_L7Protocol(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.NamespacedName":                             schema_pkg_apis_crd_v1beta1_NamespacedName(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.NetworkPolicy":                              schema_pkg_apis_crd_v1beta1_NetworkPolicy(ref),
@@ -129,9 +145,13 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.PeerNamespaces":                             schema_pkg_apis_crd_v1beta1_PeerNamespaces(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.PeerService":                                schema_pkg_apis_crd_v1beta1_PeerService(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.Rule":                                       schema_pkg_apis_crd_v1beta1_Rule(ref),
+		"antrea.io/antrea/pkg/apis/crd/v1beta1.RuleRateLimit":                              schema_pkg_apis_crd_v1beta1_RuleRateLimit(ref),
+		"antrea.io/antrea/pkg/apis/crd/v1beta1.RuleSchedule":                               schema_pkg_apis_crd_v1beta1_RuleSchedule(ref),
+		"antrea.io/antrea/pkg/apis/crd/v1beta1.ScheduleWindow":                             schema_pkg_apis_crd_v1beta1_ScheduleWindow(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.Source":                                     schema_pkg_apis_crd_v1beta1_Source(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.SubnetInfo":                                 schema_pkg_apis_crd_v1beta1_SubnetInfo(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.TCPHeader":                                  schema_pkg_apis_crd_v1beta1_TCPHeader(ref),
+		"antrea.io/antrea/pkg/apis/crd/v1beta1.TLSDenyResponse":                            schema_pkg_apis_crd_v1beta1_TLSDenyResponse(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.TLSProtocol":                                schema_pkg_apis_crd_v1beta1_TLSProtocol(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.Tier":                                       schema_pkg_apis_crd_v1beta1_Tier(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.TierList":                                   schema_pkg_apis_crd_v1beta1_TierList(ref),
@@ -153,6 +173,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"antrea.io/antrea/pkg/apis/stats/v1alpha1.PodReference":                            schema_pkg_apis_stats_v1alpha1_PodReference(ref),
 		"antrea.io/antrea/pkg/apis/stats/v1alpha1.RuleTrafficStats":                        schema_pkg_apis_stats_v1alpha1_RuleTrafficStats(ref),
 		"antrea.io/antrea/pkg/apis/stats/v1alpha1.TrafficStats":                            schema_pkg_apis_stats_v1alpha1_TrafficStats(ref),
+		"antrea.io/antrea/pkg/apis/system/v1beta1.BundleRedaction":                         schema_pkg_apis_system_v1beta1_BundleRedaction(ref),
 		"antrea.io/antrea/pkg/apis/system/v1beta1.SupportBundle":                           schema_pkg_apis_system_v1beta1_SupportBundle(ref),
 		"k8s.io/api/core/v1.AWSElasticBlockStoreVolumeSource":                              schema_k8sio_api_core_v1_AWSElasticBlockStoreVolumeSource(ref),
 		"k8s.io/api/core/v1.Affinity":                                                      schema_k8sio_api_core_v1_Affinity(ref),
@@ -901,6 +922,26 @@ func schema_pkg_apis_controlplane_v1beta2_ClusterGroupMembers(ref common.Referen
 	}
 }
 
+func schema_pkg_apis_controlplane_v1beta2_DNSProtocol(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DNSProtocol matches DNS queries with specific query name. If the field is not provided, this matches all DNS queries.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"queryName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "QueryName represents the domain name in the question of the DNS query to match (Ex. \"*.example.com\").",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_controlplane_v1beta2_EgressGroup(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1079,6 +1120,33 @@ func schema_pkg_apis_controlplane_v1beta2_ExternalEntityReference(ref common.Ref
 	}
 }
 
+func schema_pkg_apis_controlplane_v1beta2_GRPCProtocol(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "GRPCProtocol matches gRPC requests with specific service and method. All fields could be used alone or together. If all fields are not provided, it matches all gRPC requests.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"service": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Service represents the fully-qualified name of the gRPC service to match, including the package name (Ex. \"helloworld.Greeter\").",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"method": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Method represents the name of the gRPC method to match (Ex. \"SayHello\").",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_controlplane_v1beta2_GroupAssociation(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1312,11 +1380,67 @@ func schema_pkg_apis_controlplane_v1beta2_GroupReference(ref common.ReferenceCal
 	}
 }
 
+func schema_pkg_apis_controlplane_v1beta2_HTTPDenyResponse(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HTTPDenyResponse defines the \"403 Forbidden\" response sent to denied HTTP requests.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"body": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Body is the body of the response.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_controlplane_v1beta2_HTTPHeaderMatch(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HTTPHeaderMatch matches an HTTP request header.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the header to match (Ex. \"X-Tenant-ID\"). It is case-insensitive.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"value": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Value is the value of the header to match. If not set, the header only needs to be present in the request.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"matchType": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MatchType specifies how Value is matched. Defaults to Exact.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_controlplane_v1beta2_HTTPProtocol(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "HTTPProtocol matches HTTP requests with specific host, method, and path. All fields could be used alone or together. If all fields are not provided, it matches all HTTP requests.",
+				Description: "HTTPProtocol matches HTTP requests with specific host, method, path, headers and query parameters. All fields could be used alone or together. If all fields are not provided, it matches all HTTP requests.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"host": {
@@ -1340,9 +1464,82 @@ func schema_pkg_apis_controlplane_v1beta2_HTTPProtocol(ref common.ReferenceCallb
 							Format:      "",
 						},
 					},
+					"pathMatchType": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PathMatchType specifies how Path is matched. If not set, Path supports wildcards: a leading \"*\" means suffix match and a trailing \"*\" means prefix match, otherwise the path is matched exactly.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"headers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Headers represents the HTTP request headers to match. A request must match all of them.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.HTTPHeaderMatch"),
+									},
+								},
+							},
+						},
+					},
+					"queryParams": {
+						SchemaProps: spec.SchemaProps{
+							Description: "QueryParams represents the query parameters of the URI to match. A request must match all of them.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.HTTPQueryParamMatch"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
+		Dependencies: []string{
+			"antrea.io/antrea/pkg/apis/controlplane/v1beta2.HTTPHeaderMatch", "antrea.io/antrea/pkg/apis/controlplane/v1beta2.HTTPQueryParamMatch"},
+	}
+}
+
+func schema_pkg_apis_controlplane_v1beta2_HTTPQueryParamMatch(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HTTPQueryParamMatch matches a query parameter of the URI of an HTTP request.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the query parameter to match. It is case-sensitive.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"value": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Value is the value of the query parameter to match. If not set, the query parameter only needs to be present in the URI.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"matchType": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MatchType specifies how Value is matched. Defaults to Exact.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
 	}
 }
 
@@ -1457,6 +1654,60 @@ func schema_pkg_apis_controlplane_v1beta2_IPNet(ref common.ReferenceCallback) co
 	}
 }
 
+func schema_pkg_apis_controlplane_v1beta2_KafkaProtocol(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "KafkaProtocol matches Kafka requests with specific API key and topic. All fields could be used alone or together. If all fields are not provided, it matches all Kafka requests.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"apiKey": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIKey represents the type of the Kafka request to match (Ex. \"produce\", \"fetch\").",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"topic": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Topic represents the name of the Kafka topic to match.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_controlplane_v1beta2_L7DenyResponse(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "L7DenyResponse defines the responses sent to clients whose layer 7 requests are denied.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"http": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HTTP specifies the response sent to denied HTTP requests.",
+							Ref:         ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.HTTPDenyResponse"),
+						},
+					},
+					"tls": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TLS specifies the alert sent to clients whose TLS handshakes are denied.",
+							Ref:         ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.TLSDenyResponse"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"antrea.io/antrea/pkg/apis/controlplane/v1beta2.HTTPDenyResponse", "antrea.io/antrea/pkg/apis/controlplane/v1beta2.TLSDenyResponse"},
+	}
+}
+
 func schema_pkg_apis_controlplane_v1beta2_L7Protocol(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1474,11 +1725,26 @@ func schema_pkg_apis_controlplane_v1beta2_L7Protocol(ref common.ReferenceCallbac
 							Ref: ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.TLSProtocol"),
 						},
 					},
+					"grpc": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.GRPCProtocol"),
+						},
+					},
+					"dns": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.DNSProtocol"),
+						},
+					},
+					"kafka": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.KafkaProtocol"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"antrea.io/antrea/pkg/apis/controlplane/v1beta2.HTTPProtocol", "antrea.io/antrea/pkg/apis/controlplane/v1beta2.TLSProtocol"},
+			"antrea.io/antrea/pkg/apis/controlplane/v1beta2.DNSProtocol", "antrea.io/antrea/pkg/apis/controlplane/v1beta2.GRPCProtocol", "antrea.io/antrea/pkg/apis/controlplane/v1beta2.HTTPProtocol", "antrea.io/antrea/pkg/apis/controlplane/v1beta2.KafkaProtocol", "antrea.io/antrea/pkg/apis/controlplane/v1beta2.TLSProtocol"},
 	}
 }
 
@@ -1628,6 +1894,13 @@ func schema_pkg_apis_controlplane_v1beta2_NetworkPolicy(ref common.ReferenceCall
 							Ref:         ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.NetworkPolicyReference"),
 						},
 					},
+					"enforcementMode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EnforcementMode specifies whether the rules are enforced or only audited. An empty value means the rules are enforced, which is the case for K8s NetworkPolicy.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -1963,12 +2236,24 @@ func schema_pkg_apis_controlplane_v1beta2_NetworkPolicyRule(ref common.Reference
 							Format:      "",
 						},
 					},
+					"l7DenyResponse": {
+						SchemaProps: spec.SchemaProps{
+							Description: "L7DenyResponse specifies the responses sent to clients whose layer 7 requests are denied by this rule.",
+							Ref:         ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.L7DenyResponse"),
+						},
+					},
+					"rateLimit": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RateLimit specifies the rate above which the traffic matching this rule is dropped. It is only set when Action is RateLimit.",
+							Ref:         ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.RuleRateLimit"),
+						},
+					},
 				},
 				Required: []string{"enableLogging"},
 			},
 		},
 		Dependencies: []string{
-			"antrea.io/antrea/pkg/apis/controlplane/v1beta2.L7Protocol", "antrea.io/antrea/pkg/apis/controlplane/v1beta2.NetworkPolicyPeer", "antrea.io/antrea/pkg/apis/controlplane/v1beta2.Service"},
+			"antrea.io/antrea/pkg/apis/controlplane/v1beta2.L7DenyResponse", "antrea.io/antrea/pkg/apis/controlplane/v1beta2.L7Protocol", "antrea.io/antrea/pkg/apis/controlplane/v1beta2.NetworkPolicyPeer", "antrea.io/antrea/pkg/apis/controlplane/v1beta2.RuleRateLimit", "antrea.io/antrea/pkg/apis/controlplane/v1beta2.Service"},
 	}
 }
 
@@ -2243,6 +2528,40 @@ func schema_pkg_apis_controlplane_v1beta2_PodReference(ref common.ReferenceCallb
 	}
 }
 
+func schema_pkg_apis_controlplane_v1beta2_RuleRateLimit(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RuleRateLimit defines the rate limit enforced by a rule with the RateLimit action. Exactly one of PacketsPerSecond and NewConnectionsPerSecond is set.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"packetsPerSecond": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PacketsPerSecond is the maximum rate of packets matching the rule per source.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"newConnectionsPerSecond": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NewConnectionsPerSecond is the maximum rate of new connections matching the rule per source.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"burst": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Burst is the number of packets or new connections allowed in excess of the rate.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_controlplane_v1beta2_Service(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -2395,6 +2714,42 @@ func schema_pkg_apis_controlplane_v1beta2_SupportBundleCollection(ref common.Ref
 							Ref:     ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.BundleServerAuthConfiguration"),
 						},
 					},
+					"profile": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Profile selects the information collected in the bundle. Empty means all.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"redactionPatterns": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RedactionPatterns are regular expressions whose matches are redacted from the bundle.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"anonymizeIPs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AnonymizeIPs replaces the IP addresses in the bundle with pseudonyms.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"redactionSeed": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RedactionSeed is the secret seed of the pseudonyms, which is shared by all the Nodes of the collection so that the pseudonyms are consistent across their bundles.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -2493,6 +2848,20 @@ func schema_pkg_apis_controlplane_v1beta2_SupportBundleCollectionNodeStatus(ref
 							Format: "",
 						},
 					},
+					"checksum": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Checksum is the checksum of the bundle file uploaded by the Node, in the format \"<algorithm>:<hex digest>\", e.g. \"sha256:2cf24dba...\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"location": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Location is the URL of the bundle file uploaded by the Node.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -2548,6 +2917,26 @@ func schema_pkg_apis_controlplane_v1beta2_SupportBundleCollectionStatus(ref comm
 	}
 }
 
+func schema_pkg_apis_controlplane_v1beta2_TLSDenyResponse(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TLSDenyResponse defines the fatal alert sent to clients whose TLS handshakes are denied.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"alert": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Alert is the description of the alert.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_controlplane_v1beta2_TLSProtocol(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -3243,6 +3632,13 @@ func schema_pkg_apis_crd_v1beta1_ClusterNetworkPolicySpec(ref common.ReferenceCa
 							Format:      "double",
 						},
 					},
+					"enforcementMode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EnforcementMode specifies whether the rules of this ClusterNetworkPolicy are enforced or only audited. In Audit mode, the rules are evaluated but the traffic they match is only logged and counted. Defaults to Enforce.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 					"appliedTo": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Select workloads on which the rules will be applied to. Cannot be set in conjunction with AppliedTo in each rule.",
@@ -3346,6 +3742,26 @@ func schema_pkg_apis_crd_v1beta1_ControllerCondition(ref common.ReferenceCallbac
 	}
 }
 
+func schema_pkg_apis_crd_v1beta1_DNSProtocol(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DNSProtocol matches DNS queries with specific query name. If the field is not provided, this matches all DNS queries.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"queryName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "QueryName represents the domain name in the question of the DNS query to match (Ex. \"*.example.com\").",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_crd_v1beta1_Destination(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -3374,6 +3790,13 @@ func schema_pkg_apis_crd_v1beta1_Destination(ref common.ReferenceCallback) commo
 							Format:      "",
 						},
 					},
+					"externalEntity": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExternalEntity is the destination ExternalEntity, exclusive with destination pod and service.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 					"ip": {
 						SchemaProps: spec.SchemaProps{
 							Description: "IP is the destination IPv4 or IPv6 address.",
@@ -3809,6 +4232,33 @@ func schema_pkg_apis_crd_v1beta1_ExternalIPPoolStatus(ref common.ReferenceCallba
 	}
 }
 
+func schema_pkg_apis_crd_v1beta1_GRPCProtocol(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "GRPCProtocol matches gRPC requests with specific service and method. All fields could be used alone or together. If all fields are not provided, it matches all gRPC requests.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"service": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Service represents the fully-qualified name of the gRPC service to match, including the package name (Ex. \"helloworld.Greeter\").",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"method": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Method represents the name of the gRPC method to match (Ex. \"SayHello\").",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_crd_v1beta1_Group(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -4001,29 +4451,156 @@ func schema_pkg_apis_crd_v1beta1_GroupSpec(ref common.ReferenceCallback) common.
 							},
 						},
 					},
-				},
-			},
-		},
-		Dependencies: []string{
-			"antrea.io/antrea/pkg/apis/crd/v1beta1.IPBlock", "antrea.io/antrea/pkg/apis/crd/v1beta1.NamespacedName", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
-	}
-}
-
-func schema_pkg_apis_crd_v1beta1_GroupStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "GroupStatus represents information about the status of a Group.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"conditions": {
+				},
+			},
+		},
+		Dependencies: []string{
+			"antrea.io/antrea/pkg/apis/crd/v1beta1.IPBlock", "antrea.io/antrea/pkg/apis/crd/v1beta1.NamespacedName", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
+	}
+}
+
+func schema_pkg_apis_crd_v1beta1_GroupStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "GroupStatus represents information about the status of a Group.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"conditions": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("antrea.io/antrea/pkg/apis/crd/v1beta1.GroupCondition"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"antrea.io/antrea/pkg/apis/crd/v1beta1.GroupCondition"},
+	}
+}
+
+func schema_pkg_apis_crd_v1beta1_HTTPDenyResponse(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HTTPDenyResponse defines the \"403 Forbidden\" response sent to denied HTTP requests.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"body": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Body is the body of the response. If not set, the response has no body.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_crd_v1beta1_HTTPHeaderMatch(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HTTPHeaderMatch matches an HTTP request header.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the header to match (Ex. \"X-Tenant-ID\"). It is case-insensitive.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"value": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Value is the value of the header to match. If not set, the header only needs to be present in the request.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"matchType": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MatchType specifies how Value is matched. Defaults to Exact.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_crd_v1beta1_HTTPProtocol(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HTTPProtocol matches HTTP requests with specific host, method, path, headers and query parameters. All fields could be used alone or together. If all fields are not provided, it matches all HTTP requests.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"host": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Host represents the hostname present in the URI or the HTTP Host header to match. It does not contain the port associated with the host.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"method": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Method represents the HTTP method to match. It could be GET, POST, PUT, HEAD, DELETE, TRACE, OPTIONS, CONNECT and PATCH.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"path": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Path represents the URI path to match (Ex. \"/index.html\", \"/admin\").",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"pathMatchType": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PathMatchType specifies how Path is matched. If not set, Path supports wildcards: a leading \"*\" means suffix match and a trailing \"*\" means prefix match, otherwise the path is matched exactly.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"headers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Headers represents the HTTP request headers to match. A request must match all of them.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("antrea.io/antrea/pkg/apis/crd/v1beta1.HTTPHeaderMatch"),
+									},
+								},
+							},
+						},
+					},
+					"queryParams": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
+							Description: "QueryParams represents the query parameters of the URI to match. A request must match all of them.",
+							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("antrea.io/antrea/pkg/apis/crd/v1beta1.GroupCondition"),
+										Ref:     ref("antrea.io/antrea/pkg/apis/crd/v1beta1.HTTPQueryParamMatch"),
 									},
 								},
 							},
@@ -4033,39 +4610,41 @@ func schema_pkg_apis_crd_v1beta1_GroupStatus(ref common.ReferenceCallback) commo
 			},
 		},
 		Dependencies: []string{
-			"antrea.io/antrea/pkg/apis/crd/v1beta1.GroupCondition"},
+			"antrea.io/antrea/pkg/apis/crd/v1beta1.HTTPHeaderMatch", "antrea.io/antrea/pkg/apis/crd/v1beta1.HTTPQueryParamMatch"},
 	}
 }
 
-func schema_pkg_apis_crd_v1beta1_HTTPProtocol(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_crd_v1beta1_HTTPQueryParamMatch(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "HTTPProtocol matches HTTP requests with specific host, method, and path. All fields could be used alone or together. If all fields are not provided, it matches all HTTP requests.",
+				Description: "HTTPQueryParamMatch matches a query parameter of the URI of an HTTP request.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"host": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Host represents the hostname present in the URI or the HTTP Host header to match. It does not contain the port associated with the host.",
+							Description: "Name is the name of the query parameter to match. It is case-sensitive.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"method": {
+					"value": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Method represents the HTTP method to match. It could be GET, POST, PUT, HEAD, DELETE, TRACE, OPTIONS, CONNECT and PATCH.",
+							Description: "Value is the value of the query parameter to match. If not set, the query parameter only needs to be present in the URI.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"path": {
+					"matchType": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Path represents the URI path to match (Ex. \"/index.html\", \"/admin\").",
+							Description: "MatchType specifies how Value is matched. Defaults to Exact.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 				},
+				Required: []string{"name"},
 			},
 		},
 	}
@@ -4294,6 +4873,60 @@ func schema_pkg_apis_crd_v1beta1_IPv6Header(ref common.ReferenceCallback) common
 	}
 }
 
+func schema_pkg_apis_crd_v1beta1_KafkaProtocol(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "KafkaProtocol matches Kafka requests with specific API key and topic. All fields could be used alone or together. If all fields are not provided, it matches all Kafka requests.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"apiKey": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIKey represents the type of the Kafka request to match. It could be produce, fetch, listOffsets, metadata, offsetCommit, offsetFetch, findCoordinator, joinGroup, heartbeat, leaveGroup, syncGroup, describeGroups, listGroups, saslHandshake, apiVersions, createTopics and deleteTopics.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"topic": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Topic represents the name of the Kafka topic to match.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_crd_v1beta1_L7DenyResponse(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "L7DenyResponse defines the responses sent to clients whose layer 7 requests are denied.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"http": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HTTP specifies the response sent to denied HTTP requests.",
+							Ref:         ref("antrea.io/antrea/pkg/apis/crd/v1beta1.HTTPDenyResponse"),
+						},
+					},
+					"tls": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TLS specifies the alert sent to clients whose TLS handshakes are denied.",
+							Ref:         ref("antrea.io/antrea/pkg/apis/crd/v1beta1.TLSDenyResponse"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"antrea.io/antrea/pkg/apis/crd/v1beta1.HTTPDenyResponse", "antrea.io/antrea/pkg/apis/crd/v1beta1.TLSDenyResponse"},
+	}
+}
+
 func schema_pkg_apis_crd_v1beta1_L7Protocol(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -4310,11 +4943,26 @@ func schema_pkg_apis_crd_v1beta1_L7Protocol(ref common.ReferenceCallback) common
 							Ref: ref("antrea.io/antrea/pkg/apis/crd/v1beta1.TLSProtocol"),
 						},
 					},
+					"grpc": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("antrea.io/antrea/pkg/apis/crd/v1beta1.GRPCProtocol"),
+						},
+					},
+					"dns": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("antrea.io/antrea/pkg/apis/crd/v1beta1.DNSProtocol"),
+						},
+					},
+					"kafka": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("antrea.io/antrea/pkg/apis/crd/v1beta1.KafkaProtocol"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"antrea.io/antrea/pkg/apis/crd/v1beta1.HTTPProtocol", "antrea.io/antrea/pkg/apis/crd/v1beta1.TLSProtocol"},
+			"antrea.io/antrea/pkg/apis/crd/v1beta1.DNSProtocol", "antrea.io/antrea/pkg/apis/crd/v1beta1.GRPCProtocol", "antrea.io/antrea/pkg/apis/crd/v1beta1.HTTPProtocol", "antrea.io/antrea/pkg/apis/crd/v1beta1.KafkaProtocol", "antrea.io/antrea/pkg/apis/crd/v1beta1.TLSProtocol"},
 	}
 }
 
@@ -4589,7 +5237,7 @@ func schema_pkg_apis_crd_v1beta1_NetworkPolicyPeer(ref common.ReferenceCallback)
 					},
 					"scope": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Define scope of the Pod/NamespaceSelector(s) of this peer. Can only be used in ingress NetworkPolicyPeers. Defaults to \"Cluster\".",
+							Description: "Define scope of the Pod/NamespaceSelector(s) of this peer. In egress NetworkPolicyPeers, ClusterSet scope can only be used in rules whose action is Drop or Reject. Defaults to \"Cluster\".",
 							Type:        []string{"string"},
 							Format:      "",
 						},
@@ -4698,6 +5346,13 @@ func schema_pkg_apis_crd_v1beta1_NetworkPolicySpec(ref common.ReferenceCallback)
 							Format:      "double",
 						},
 					},
+					"enforcementMode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EnforcementMode specifies whether the rules of this NetworkPolicy are enforced or only audited. In Audit mode, the rules are evaluated but the traffic they match is only logged and counted. Defaults to Enforce.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 					"appliedTo": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Select workloads on which the rules will be applied to. Cannot be set in conjunction with AppliedTo in each rule.",
@@ -4802,12 +5457,25 @@ func schema_pkg_apis_crd_v1beta1_NetworkPolicyStatus(ref common.ReferenceCallbac
 							},
 						},
 					},
+					"nextScheduleTransitionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The next time at which a rule with a schedule is activated or deactivated. It is not set if no rule of the NetworkPolicy has a schedule.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"enforcementMode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The enforcement mode of the NetworkPolicy. It is only set when the NetworkPolicy is not enforced, i.e. it is in Audit mode.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"phase", "observedGeneration", "currentNodesRealized", "desiredNodesRealized", "conditions"},
 			},
 		},
 		Dependencies: []string{
-			"antrea.io/antrea/pkg/apis/crd/v1beta1.NetworkPolicyCondition"},
+			"antrea.io/antrea/pkg/apis/crd/v1beta1.NetworkPolicyCondition", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -5165,6 +5833,12 @@ func schema_pkg_apis_crd_v1beta1_Rule(ref common.ReferenceCallback) common.OpenA
 							},
 						},
 					},
+					"l7DenyResponse": {
+						SchemaProps: spec.SchemaProps{
+							Description: "L7DenyResponse specifies the responses sent to clients whose layer 7 requests are denied by the rule. It can only be set when L7Protocols is set. If not set, denied requests are dropped and their connections are reset.",
+							Ref:         ref("antrea.io/antrea/pkg/apis/crd/v1beta1.L7DenyResponse"),
+						},
+					},
 					"from": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Rule is matched if traffic originates from workloads selected by this field. If this field is empty, this rule matches all sources.",
@@ -5195,7 +5869,7 @@ func schema_pkg_apis_crd_v1beta1_Rule(ref common.ReferenceCallback) common.OpenA
 					},
 					"toServices": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Rule is matched if traffic is intended for a Service listed in this field. Currently, only ClusterIP types Services are supported in this field. When scope is set to ClusterSet, it matches traffic intended for a multi-cluster Service listed in this field. Service name and Namespace provided should match the original exported Service. This field can only be used when AntreaProxy is enabled. This field can't be used with To or Ports. If this field and To are both empty or missing, this rule matches all destinations.",
+							Description: "Rule is matched if traffic is intended for a Service listed in this field. Services with a ClusterIP and a selector are matched by their ClusterIPs, which requires AntreaProxy to be enabled. ExternalName Services are matched by their external names, and headless and selectorless Services are matched by the addresses and ports of their Endpoints. When scope is set to ClusterSet, it matches traffic intended for a multi-cluster Service listed in this field. Service name and Namespace provided should match the original exported Service. This field can't be used with To or Ports. If this field and To are both empty or missing, this rule matches all destinations.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -5243,12 +5917,125 @@ func schema_pkg_apis_crd_v1beta1_Rule(ref common.ReferenceCallback) common.OpenA
 							},
 						},
 					},
+					"schedule": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Schedule restricts the rule to be enforced only during the time windows it defines. If not set, the rule is always enforced.",
+							Ref:         ref("antrea.io/antrea/pkg/apis/crd/v1beta1.RuleSchedule"),
+						},
+					},
+					"rateLimit": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RateLimit specifies the rate above which the traffic matching the rule is dropped. It must be set if and only if Action is RateLimit.",
+							Ref:         ref("antrea.io/antrea/pkg/apis/crd/v1beta1.RuleRateLimit"),
+						},
+					},
 				},
 				Required: []string{"action"},
 			},
 		},
 		Dependencies: []string{
-			"antrea.io/antrea/pkg/apis/crd/v1beta1.AppliedTo", "antrea.io/antrea/pkg/apis/crd/v1beta1.L7Protocol", "antrea.io/antrea/pkg/apis/crd/v1beta1.NetworkPolicyPeer", "antrea.io/antrea/pkg/apis/crd/v1beta1.NetworkPolicyPort", "antrea.io/antrea/pkg/apis/crd/v1beta1.NetworkPolicyProtocol", "antrea.io/antrea/pkg/apis/crd/v1beta1.PeerService"},
+			"antrea.io/antrea/pkg/apis/crd/v1beta1.AppliedTo", "antrea.io/antrea/pkg/apis/crd/v1beta1.L7DenyResponse", "antrea.io/antrea/pkg/apis/crd/v1beta1.L7Protocol", "antrea.io/antrea/pkg/apis/crd/v1beta1.NetworkPolicyPeer", "antrea.io/antrea/pkg/apis/crd/v1beta1.NetworkPolicyPort", "antrea.io/antrea/pkg/apis/crd/v1beta1.NetworkPolicyProtocol", "antrea.io/antrea/pkg/apis/crd/v1beta1.PeerService", "antrea.io/antrea/pkg/apis/crd/v1beta1.RuleRateLimit", "antrea.io/antrea/pkg/apis/crd/v1beta1.RuleSchedule"},
+	}
+}
+
+func schema_pkg_apis_crd_v1beta1_RuleRateLimit(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RuleRateLimit defines the rate limit enforced by a rule with the RateLimit action. Exactly one of PacketsPerSecond and NewConnectionsPerSecond must be set. The limit is enforced on each Node by 64 meters per rule, and the sources of the traffic matching the rule are distributed among the meters by the 6 lowest bits of their IP addresses. Hence the limit is not strictly per source: all the sources whose IP addresses share these bits share the same limit. As the meters available on a Node are limited, at most 1008 rules with the RateLimit action are supported in the cluster.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"packetsPerSecond": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PacketsPerSecond is the maximum rate of packets matching the rule per group of sources sharing a meter.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"newConnectionsPerSecond": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NewConnectionsPerSecond is the maximum rate of new connections matching the rule per group of sources sharing a meter. Packets of established connections are not limited.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"burst": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Burst is the number of packets or new connections allowed in excess of the rate. Defaults to 0.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_crd_v1beta1_RuleSchedule(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RuleSchedule defines the time windows during which a rule is enforced.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"timeZone": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TimeZone is the IANA name of the time zone the windows are evaluated in, e.g. \"America/Los_Angeles\". Defaults to UTC.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"windows": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Windows are the time windows during which the rule is enforced. The rule is enforced if the current time is within any of the windows.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("antrea.io/antrea/pkg/apis/crd/v1beta1.ScheduleWindow"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"windows"},
+			},
+		},
+		Dependencies: []string{
+			"antrea.io/antrea/pkg/apis/crd/v1beta1.ScheduleWindow"},
+	}
+}
+
+func schema_pkg_apis_crd_v1beta1_ScheduleWindow(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ScheduleWindow defines a recurring time window.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"start": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Start is a standard 5-field cron expression (\"minute hour day-of-month month day-of-week\") which specifies when the window starts, e.g. \"0 22 * * 6\" for every Saturday at 22:00.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"duration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Duration is the length of the window, in the format accepted by Go's time.ParseDuration, e.g. \"2h30m\".",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"start", "duration"},
+			},
+		},
 	}
 }
 
@@ -5273,6 +6060,13 @@ func schema_pkg_apis_crd_v1beta1_Source(ref common.ReferenceCallback) common.Ope
 							Format:      "",
 						},
 					},
+					"externalEntity": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExternalEntity is the source ExternalEntity, exclusive with source pod. ExternalEntity as the source is supported only for live-traffic Traceflow.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 					"ip": {
 						SchemaProps: spec.SchemaProps{
 							Description: "IP is the source IPv4 or IPv6 address. IP as the source is supported only for live-traffic Traceflow.",
@@ -5357,6 +6151,26 @@ func schema_pkg_apis_crd_v1beta1_TCPHeader(ref common.ReferenceCallback) common.
 	}
 }
 
+func schema_pkg_apis_crd_v1beta1_TLSDenyResponse(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TLSDenyResponse defines the fatal alert sent to clients whose TLS handshakes are denied.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"alert": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Alert is the description of the alert. Defaults to AccessDenied.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_crd_v1beta1_TLSProtocol(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -6257,6 +7071,55 @@ func schema_pkg_apis_stats_v1alpha1_TrafficStats(ref common.ReferenceCallback) c
 							Format:      "int64",
 						},
 					},
+					"rateLimitedPackets": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RateLimitedPackets is the packets count dropped by the rate limit of the NetworkPolicy's rules with the RateLimit action.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_system_v1beta1_BundleRedaction(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "BundleRedaction specifies how sensitive information is redacted from a support bundle.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"patterns": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Patterns is a list of regular expressions whose matches are replaced with pseudonyms.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"anonymizeIPs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AnonymizeIPs replaces IP addresses with pseudonyms.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"seed": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Seed is used to derive the pseudonyms. The same seed always generates the same pseudonym for a given value, so that bundles collected from different components can be correlated.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -6313,11 +7176,24 @@ func schema_pkg_apis_system_v1beta1_SupportBundle(ref common.ReferenceCallback)
 							Format: "int64",
 						},
 					},
+					"profile": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Profile selects the information collected in the bundle. Empty means all.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"redaction": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Redaction specifies how sensitive information is redacted from the bundle.",
+							Ref:         ref("antrea.io/antrea/pkg/apis/system/v1beta1.BundleRedaction"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"antrea.io/antrea/pkg/apis/system/v1beta1.BundleRedaction", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
@@ -127,6 +127,17 @@ func (r *supportBundleREST) Create(ctx context.Context, obj runtime.Object, _ re
 	if requestBundle.Name != r.mode {
 		return nil, errors.NewForbidden(systemv1beta1.ControllerInfoVersionResource.GroupResource(), requestBundle.Name, fmt.Errorf("only resource name \"%s\" is allowed", r.mode))
 	}
+	if err := support.ValidateProfile(requestBundle.Profile); err != nil {
+		return nil, errors.NewBadRequest(err.Error())
+	}
+	var redactor *support.Redactor
+	if requestBundle.Redaction != nil {
+		var err error
+		redactor, err = support.NewRedactor(requestBundle.Redaction.Patterns, requestBundle.Redaction.AnonymizeIPs, requestBundle.Redaction.Seed)
+		if err != nil {
+			return nil, errors.NewBadRequest(err.Error())
+		}
+	}
 	r.statusLocker.Lock()
 	defer r.statusLocker.Unlock()
 
@@ -137,16 +148,17 @@ func (r *supportBundleREST) Create(ctx context.Context, obj runtime.Object, _ re
 	r.cache = &systemv1beta1.SupportBundle{
 		ObjectMeta: metav1.ObjectMeta{Name: r.mode},
 		Since:      requestBundle.Since,
+		Profile:    requestBundle.Profile,
 		Status:     systemv1beta1.SupportBundleStatusCollecting,
 	}
 	r.cancelFunc = cancelFunc
-	go func(since string) {
+	go func(since, profile string) {
 		var err error
 		var b *systemv1beta1.SupportBundle
 		if r.mode == modeAgent {
-			b, err = r.collectAgent(ctx, since)
+			b, err = r.collectAgent(ctx, since, profile, redactor)
 		} else if r.mode == modeController {
-			b, err = r.collectController(ctx, since)
+			b, err = r.collectController(ctx, since, profile, redactor)
 		}
 		func() {
 			r.statusLocker.Lock()
@@ -166,7 +178,7 @@ func (r *supportBundleREST) Create(ctx context.Context, obj runtime.Object, _ re
 		if err == nil {
 			r.clean(ctx, b.Filepath, bundleExpireDuration)
 		}
-	}(r.cache.Since)
+	}(r.cache.Since, r.cache.Profile)
 
 	return r.cache, nil
 }
@@ -211,7 +223,7 @@ func (r *supportBundleREST) NamespaceScoped() bool {
 	return false
 }
 
-func (r *supportBundleREST) collect(ctx context.Context, dumpers ...func(string) error) (*systemv1beta1.SupportBundle, error) {
+func (r *supportBundleREST) collect(ctx context.Context, profile string, redactor *support.Redactor, dumpers ...func(string) error) (*systemv1beta1.SupportBundle, error) {
 	basedir, err := afero.TempDir(defaultFS, "", "bundle_tmp_")
 	if err != nil {
 		return nil, fmt.Errorf("error when creating tempdir: %w", err)
@@ -222,6 +234,9 @@ func (r *supportBundleREST) collect(ctx context.Context, dumpers ...func(string)
 			return nil, err
 		}
 	}
+	if err := redactor.RedactDir(defaultFS, basedir); err != nil {
+		return nil, fmt.Errorf("error when redacting supportBundle: %w", err)
+	}
 	outputFile, err := afero.TempFile(defaultFS, "", "bundle_*.tar.gz")
 	if err != nil {
 		return nil, fmt.Errorf("error when creating output tarfile: %w", err)
@@ -255,34 +270,43 @@ func (r *supportBundleREST) collect(ctx context.Context, dumpers ...func(string)
 		Sum:      fmt.Sprintf("%x", hashSum),
 		Size:     uint32(fileSize),
 		Filepath: outputFile.Name(),
+		Profile:  profile,
 	}, nil
 }
 
-func (r *supportBundleREST) collectAgent(ctx context.Context, since string) (*systemv1beta1.SupportBundle, error) {
+func (r *supportBundleREST) collectAgent(ctx context.Context, since, profile string, redactor *support.Redactor) (*systemv1beta1.SupportBundle, error) {
 	dumper := newAgentDumper(defaultFS, defaultExecutor, r.ovsCtlClient, r.aq, r.npq, since, r.v4Enabled, r.v6Enabled)
 	return r.collect(
 		ctx,
-		dumper.DumpLog,
-		dumper.DumpHostNetworkInfo,
-		dumper.DumpFlows,
-		dumper.DumpNetworkPolicyResources,
-		dumper.DumpAgentInfo,
-		dumper.DumpHeapPprof,
-		dumper.DumpGoroutinePprof,
-		dumper.DumpOVSPorts,
-		dumper.DumpMemberlist,
+		profile,
+		redactor,
+		support.SelectDumps(profile,
+			support.ItemDump{Item: support.ItemLogs, Dump: dumper.DumpLog},
+			support.ItemDump{Item: support.ItemHostNetwork, Dump: dumper.DumpHostNetworkInfo},
+			support.ItemDump{Item: support.ItemFlows, Dump: dumper.DumpFlows},
+			support.ItemDump{Item: support.ItemNetworkPolicies, Dump: dumper.DumpNetworkPolicyResources},
+			support.ItemDump{Item: support.ItemInfo, Dump: dumper.DumpAgentInfo},
+			support.ItemDump{Item: support.ItemPprof, Dump: dumper.DumpHeapPprof},
+			support.ItemDump{Item: support.ItemPprof, Dump: dumper.DumpGoroutinePprof},
+			support.ItemDump{Item: support.ItemOVSPorts, Dump: dumper.DumpOVSPorts},
+			support.ItemDump{Item: support.ItemMemberlist, Dump: dumper.DumpMemberlist},
+		)...,
 	)
 }
 
-func (r *supportBundleREST) collectController(ctx context.Context, since string) (*systemv1beta1.SupportBundle, error) {
+func (r *supportBundleREST) collectController(ctx context.Context, since, profile string, redactor *support.Redactor) (*systemv1beta1.SupportBundle, error) {
 	dumper := support.NewControllerDumper(defaultFS, defaultExecutor, since)
 	return r.collect(
 		ctx,
-		dumper.DumpLog,
-		dumper.DumpNetworkPolicyResources,
-		dumper.DumpControllerInfo,
-		dumper.DumpHeapPprof,
-		dumper.DumpGoroutinePprof,
+		profile,
+		redactor,
+		support.SelectDumps(profile,
+			support.ItemDump{Item: support.ItemLogs, Dump: dumper.DumpLog},
+			support.ItemDump{Item: support.ItemNetworkPolicies, Dump: dumper.DumpNetworkPolicyResources},
+			support.ItemDump{Item: support.ItemInfo, Dump: dumper.DumpControllerInfo},
+			support.ItemDump{Item: support.ItemPprof, Dump: dumper.DumpHeapPprof},
+			support.ItemDump{Item: support.ItemPprof, Dump: dumper.DumpGoroutinePprof},
+		)...,
 	)
 }
 
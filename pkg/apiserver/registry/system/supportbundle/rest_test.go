@@ -15,8 +15,12 @@
 package supportbundle
 
 import (
+	"archive/tar"
+	"compress/gzip"
 	"context"
 	"fmt"
+	"io"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
@@ -134,7 +138,7 @@ func TestCollect(t *testing.T) {
 		dumper2Executed = true
 		return nil
 	}
-	collectedBundle, err := storage.SupportBundle.collect(context.TODO(), dumper1, dumper2)
+	collectedBundle, err := storage.SupportBundle.collect(context.TODO(), "", nil, dumper1, dumper2)
 	require.NoError(t, err)
 	require.NotEmpty(t, collectedBundle.Filepath)
 	defer defaultFS.Remove(collectedBundle.Filepath)
@@ -148,6 +152,56 @@ func TestCollect(t *testing.T) {
 	require.True(t, exist)
 }
 
+func TestCollectWithRedaction(t *testing.T) {
+	defaultFS = afero.NewMemMapFs()
+	defaultExecutor = new(testExec)
+	defer func() {
+		defaultFS = afero.NewOsFs()
+		defaultExecutor = exec.New()
+	}()
+
+	storage := NewControllerStorage()
+	dumper := func(basedir string) error {
+		return afero.WriteFile(defaultFS, filepath.Join(basedir, "logs"), []byte("Pod customer-pod has IP 10.10.0.1"), 0644)
+	}
+	redactor, err := support.NewRedactor([]string{`customer-[a-z]+`}, true, "seed")
+	require.NoError(t, err)
+	collectedBundle, err := storage.SupportBundle.collect(context.TODO(), support.ProfilePolicyOnly, redactor, dumper)
+	require.NoError(t, err)
+	defer defaultFS.Remove(collectedBundle.Filepath)
+	assert.Equal(t, support.ProfilePolicyOnly, collectedBundle.Profile)
+
+	f, err := defaultFS.Open(collectedBundle.Filepath)
+	require.NoError(t, err)
+	defer f.Close()
+	gzReader, err := gzip.NewReader(f)
+	require.NoError(t, err)
+	tarReader := tar.NewReader(gzReader)
+	header, err := tarReader.Next()
+	require.NoError(t, err)
+	require.Equal(t, "logs", filepath.Base(header.Name))
+	data, err := io.ReadAll(tarReader)
+	require.NoError(t, err)
+	assert.Equal(t, string(redactor.Redact([]byte("Pod customer-pod has IP 10.10.0.1"))), string(data))
+	assert.NotContains(t, string(data), "customer-pod")
+	assert.NotContains(t, string(data), "10.10.0.1")
+}
+
+func TestCreateValidation(t *testing.T) {
+	storage := NewControllerStorage()
+	_, err := storage.SupportBundle.Create(context.TODO(), &system.SupportBundle{
+		ObjectMeta: metav1.ObjectMeta{Name: modeController},
+		Profile:    "foo",
+	}, nil, nil)
+	assert.True(t, errors.IsBadRequest(err))
+	_, err = storage.SupportBundle.Create(context.TODO(), &system.SupportBundle{
+		ObjectMeta: metav1.ObjectMeta{Name: modeController},
+		Redaction:  &system.BundleRedaction{Patterns: []string{"foo("}},
+	}, nil, nil)
+	assert.True(t, errors.IsBadRequest(err))
+	assert.Equal(t, system.SupportBundleStatusNone, storage.SupportBundle.cache.Status)
+}
+
 func TestControllerStorage(t *testing.T) {
 	defaultFS = afero.NewMemMapFs()
 	defaultExecutor = new(testExec)
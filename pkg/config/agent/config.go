@@ -199,6 +199,8 @@ type AgentConfig struct {
 	AuditLogging AuditLoggingConfig `yaml:"auditLogging,omitempty"`
 	// Antrea's native secondary network configuration.
 	SecondaryNetwork SecondaryNetworkConfig `yaml:"secondaryNetwork,omitempty"`
+	// L7NetworkPolicy related configurations.
+	L7NetworkPolicy L7NetworkPolicyConfig `yaml:"l7NetworkPolicy,omitempty"`
 	// PacketInRate defines the OVS controller packet rate limits for different
 	// features. All features will apply this rate-limit individually on packet-in
 	// messages sent to antrea-agent. The number stands for the rate as packets per
@@ -244,6 +246,17 @@ type AntreaProxyConfig struct {
 type WireGuardConfig struct {
 	// The port for the WireGuard to receive traffic. Defaults to 51820.
 	Port int `yaml:"port,omitempty"`
+	// The interval at which the WireGuard private key of the Node is rotated, e.g. "24h". Key rotation is
+	// disabled when it is set to "0". Only applicable to in-cluster WireGuard encryption. Defaults to "0".
+	KeyRotationInterval string `yaml:"keyRotationInterval,omitempty"`
+	// How long the next WireGuard public key of the Node is announced to peer Nodes before the Node switches to
+	// it. It must be less than keyRotationInterval. Only applicable to in-cluster WireGuard encryption.
+	// Defaults to "1m".
+	KeyRotationOverlap string `yaml:"keyRotationOverlap,omitempty"`
+	// Only accept WireGuard public keys of peer Nodes which are certified by antrea-controller. The Node's own
+	// public keys will be submitted to antrea-controller for certification as well. It requires antrea-controller
+	// to enable the WireGuard key signer. Only applicable to in-cluster WireGuard encryption. Defaults to false.
+	VerifyPeerPublicKeys bool `yaml:"verifyPeerPublicKeys,omitempty"`
 }
 
 type NodePortLocalConfig struct {
@@ -328,6 +341,48 @@ type IPsecConfig struct {
 	// - psk (default): Use pre-shared key (PSK) for IKE authentication.
 	// - cert:          Use CA-signed certificates for IKE authentication.
 	AuthenticationMode string `yaml:"authenticationMode,omitempty"`
+	// The backend which issues IPsec certificates when the authentication mode is cert.
+	CertificateIssuer IPsecCertificateIssuerConfig `yaml:"certificateIssuer,omitempty"`
+}
+
+type IPsecCertificateIssuerConfig struct {
+	// The type of the certificate issuer. It has the following options:
+	// - kubernetesCSR (default): Request certificates with Kubernetes CertificateSigningRequests, which are
+	//                            signed by the Antrea signer "antrea.io/antrea-agent-ipsec-tunnel".
+	// - certManager:             Request certificates with cert-manager CertificateRequests, which are signed
+	//                            by a cert-manager Issuer or ClusterIssuer.
+	// - est:                     Request certificates from an Enrollment over Secure Transport (EST) server
+	//                            as described in RFC 7030.
+	Type string `yaml:"type,omitempty"`
+	// cert-manager issuer configuration, used when type is certManager.
+	CertManager IPsecCertManagerIssuerConfig `yaml:"certManager,omitempty"`
+	// EST issuer configuration, used when type is est.
+	EST IPsecESTIssuerConfig `yaml:"est,omitempty"`
+}
+
+type IPsecCertManagerIssuerConfig struct {
+	// The Namespace in which CertificateRequests are created. The default is antrea-agent's Namespace.
+	Namespace string `yaml:"namespace,omitempty"`
+	// The name of the cert-manager Issuer or ClusterIssuer which signs the certificates.
+	IssuerName string `yaml:"issuerName,omitempty"`
+	// The kind of the issuer, "Issuer" or "ClusterIssuer". Defaults to "Issuer".
+	IssuerKind string `yaml:"issuerKind,omitempty"`
+	// The API group of the issuer. Defaults to "cert-manager.io".
+	IssuerGroup string `yaml:"issuerGroup,omitempty"`
+	// The requested lifetime of the certificates, as a duration string, e.g. "2160h". If empty, the
+	// default lifetime of the issuer is used.
+	Duration string `yaml:"duration,omitempty"`
+}
+
+type IPsecESTIssuerConfig struct {
+	// The base URL of the EST server, e.g. "https://est.example.com/.well-known/est". It must use https.
+	ServerURL string `yaml:"serverURL,omitempty"`
+	// The path of the PEM-encoded CA bundle used to verify the certificate of the EST server. If empty,
+	// the system root CAs are used.
+	ServerCAFile string `yaml:"serverCAFile,omitempty"`
+	// The username for HTTP basic authentication with the EST server. The password must be passed to
+	// Antrea Agent through an environment variable: ANTREA_IPSEC_EST_PASSWORD.
+	Username string `yaml:"username,omitempty"`
 }
 
 type MulticlusterConfig struct {
@@ -392,6 +447,13 @@ type AuditLoggingConfig struct {
 	Compress *bool `yaml:"compress,omitempty"`
 }
 
+type L7NetworkPolicyConfig struct {
+	// The L7 engine which enforces the L7 rules. Valid values are "Suricata" and "Native". The "Native" engine
+	// runs in antrea-agent and supports HTTP and TLS only, and it doesn't work with the L7FlowExporter feature.
+	// Defaults to "Suricata".
+	Engine string `yaml:"engine,omitempty"`
+}
+
 type SecondaryNetworkConfig struct {
 	// Configuration of OVS bridges for secondary networks. At the moment, only a
 	// single OVS bridge is supported.
@@ -75,6 +75,8 @@ type ControllerConfig struct {
 	NodeIPAM NodeIPAMConfig `yaml:"nodeIPAM"`
 	// IPsec CSR signer configuration
 	IPsecCSRSignerConfig IPsecCSRSignerConfig `yaml:"ipsecCSRSigner"`
+	// WireGuard key signer configuration
+	WireGuardKeySignerConfig WireGuardKeySignerConfig `yaml:"wireGuardKeySigner"`
 	// Multicluster configuration options.
 	Multicluster MulticlusterConfig `yaml:"multicluster,omitempty"`
 }
@@ -89,6 +91,11 @@ type MulticlusterConfig struct {
 }
 
 type IPsecCSRSignerConfig struct {
+	// Enable the Antrea signer for IPsec certificates. It can be disabled when antrea-agents request
+	// IPsec certificates from an external issuer, in which case the ConfigMap named "antrea-ipsec-ca"
+	// must be provided with the CA bundle of the external issuer in the "ca.crt" key.
+	// Defaults to true.
+	Enable *bool `yaml:"enable,omitempty"`
 	// Indicates whether to use auto-generated self-signed CA certificate.
 	// If false, a Secret named "antrea-ipsec-ca" must be provided with the following keys:
 	//   tls.crt: <CA certificate>
@@ -99,3 +106,19 @@ type IPsecCSRSignerConfig struct {
 	// Defaults to true.
 	AutoApprove *bool `yaml:"autoApprove,omitempty"`
 }
+
+type WireGuardKeySignerConfig struct {
+	// Enable certifying the WireGuard public keys of Nodes, so that antrea-agents
+	// can only accept peer public keys vouched for by antrea-controller.
+	// Defaults to false.
+	Enable bool `yaml:"enable,omitempty"`
+	// Indicates whether to use auto-generated self-signed CA certificate.
+	// If false, a Secret named "antrea-wireguard-ca" must be provided with the following keys:
+	//   tls.crt: <CA certificate>
+	//   tls.key: <CA private key>
+	// Defaults to true.
+	SelfSignedCA *bool `yaml:"selfSignedCA,omitempty"`
+	// Antrea signer auto approve policy.
+	// Defaults to true.
+	AutoApprove *bool `yaml:"autoApprove,omitempty"`
+}
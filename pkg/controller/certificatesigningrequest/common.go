@@ -15,17 +15,25 @@
 package certificatesigningrequest
 
 import (
+	"context"
 	"crypto/x509"
 	"encoding/pem"
 	"fmt"
 	"sort"
+	"strings"
 	"time"
 
 	certificates "k8s.io/api/certificates/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	sautil "k8s.io/apiserver/pkg/authentication/serviceaccount"
+	clientset "k8s.io/client-go/kubernetes"
 	certutil "k8s.io/client-go/util/cert"
+	"k8s.io/klog/v2"
 
 	antreaapis "antrea.io/antrea/pkg/apis"
+	"antrea.io/antrea/pkg/util/env"
 )
 
 const (
@@ -39,8 +47,13 @@ const (
 )
 
 var (
+	antreaAgentServiceAccountName = strings.Join([]string{
+		"system", "serviceaccount", env.GetAntreaNamespace(), "antrea-agent",
+	}, ":")
+
 	errOrganizationNotAntrea    = fmt.Errorf("subject organization is not %s", antreaapis.AntreaOrganizationName)
 	errDNSSANNotMatchCommonName = fmt.Errorf("DNS subjectAltNames do not match subject common name")
+	errDNSSANNotAllowed         = fmt.Errorf("DNS subjectAltNames are not allowed")
 	errEmailSANNotAllowed       = fmt.Errorf("email subjectAltNames are not allowed")
 	errIPSANNotAllowed          = fmt.Errorf("IP subjectAltNames are not allowed")
 	errURISANNotAllowed         = fmt.Errorf("URI subjectAltNames are not allowed")
@@ -156,3 +169,48 @@ func (s sortedExtKeyUsage) Swap(i, j int) {
 func (s sortedExtKeyUsage) Less(i, j int) bool {
 	return s[i] < s[j]
 }
+
+// verifyNodeExists returns an error if the requested Node does not exist.
+func verifyNodeExists(client clientset.Interface, nodeName string) error {
+	_, err := client.CoreV1().Nodes().Get(context.TODO(), nodeName, metav1.GetOptions{})
+	if err != nil && apierrors.IsNotFound(err) {
+		return fmt.Errorf("requested Node %s not found", nodeName)
+	} else if err != nil {
+		return &transientError{err}
+	}
+	return nil
+}
+
+// verifyAgentIdentity verifies that the CertificateSigningRequest was created by the antrea-agent
+// running on the requested Node.
+func verifyAgentIdentity(client clientset.Interface, nodeName string, csr *certificates.CertificateSigningRequest) error {
+	if csr.Spec.Username != antreaAgentServiceAccountName {
+		return errUserUnauthorized
+	}
+	podNameValues, podUIDValues := csr.Spec.Extra[sautil.PodNameKey], csr.Spec.Extra[sautil.PodUIDKey]
+	if len(podNameValues) == 0 && len(podUIDValues) == 0 {
+		klog.Warning("Could not determine Pod identity from CertificateSigningRequest.",
+			" Enable K8s BoundServiceAccountTokenVolume feature gate to provide maximum security.")
+		return nil
+	}
+	if len(podNameValues) == 0 || len(podUIDValues) == 0 {
+		return errExtraFieldsRequired
+	}
+	podName, podUID := podNameValues[0], podUIDValues[0]
+	if podName == "" || podUID == "" {
+		return errExtraFieldsRequired
+	}
+	pod, err := client.CoreV1().Pods(env.GetAntreaNamespace()).Get(context.TODO(), podName, metav1.GetOptions{})
+	if err != nil && apierrors.IsNotFound(err) {
+		return fmt.Errorf("Pod %s not found", podName)
+	} else if err != nil {
+		return &transientError{err}
+	}
+	if pod.ObjectMeta.UID != types.UID(podUID) {
+		return errPodUIDMismatch
+	}
+	if pod.Spec.NodeName != nodeName {
+		return errPodNotOnNode
+	}
+	return nil
+}
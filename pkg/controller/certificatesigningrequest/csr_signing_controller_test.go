@@ -31,6 +31,7 @@ import (
 	"k8s.io/client-go/informers"
 	"k8s.io/client-go/kubernetes/fake"
 	certutil "k8s.io/client-go/util/cert"
+	csrutil "k8s.io/client-go/util/certificate/csr"
 )
 
 func TestIPsecCertificateApproverAndSigner(t *testing.T) {
@@ -137,3 +138,40 @@ func TestIPsecCertificateApproverAndSigner(t *testing.T) {
 		})
 	}
 }
+
+func TestNewCertificateTemplate(t *testing.T) {
+	req := &x509.CertificateRequest{
+		Subject: pkix.Name{
+			CommonName: "worker-node-1",
+		},
+	}
+	usages := []certificatesv1.KeyUsage{certificatesv1.UsageDigitalSignature}
+	tests := []struct {
+		name              string
+		expirationSeconds *int32
+		expectedLifetime  time.Duration
+	}{
+		{
+			name:             "default lifetime",
+			expectedLifetime: duration365d,
+		},
+		{
+			name:              "requested lifetime",
+			expirationSeconds: csrutil.DurationToExpirationSeconds(time.Hour),
+			expectedLifetime:  time.Hour,
+		},
+		{
+			name:              "requested lifetime longer than default",
+			expirationSeconds: csrutil.DurationToExpirationSeconds(2 * duration365d),
+			expectedLifetime:  duration365d,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			template, err := newCertificateTemplate(req, usages, tt.expirationSeconds)
+			require.NoError(t, err)
+			// NotBefore is backdated by 5 minutes to tolerate clock skew.
+			assert.Equal(t, tt.expectedLifetime+5*time.Minute, template.NotAfter.Sub(template.NotBefore).Round(time.Minute))
+		})
+	}
+}
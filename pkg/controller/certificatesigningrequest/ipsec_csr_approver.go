@@ -15,35 +15,22 @@
 package certificatesigningrequest
 
 import (
-	"context"
 	"crypto/x509"
 	"fmt"
 	"reflect"
-	"strings"
 
 	certificatesv1 "k8s.io/api/certificates/v1"
-	apierrors "k8s.io/apimachinery/pkg/api/errors"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
-	sautil "k8s.io/apiserver/pkg/authentication/serviceaccount"
 	clientset "k8s.io/client-go/kubernetes"
 	"k8s.io/klog/v2"
 
 	antreaapis "antrea.io/antrea/pkg/apis"
-	"antrea.io/antrea/pkg/util/env"
 )
 
 const (
 	ipsecCSRApproverName = "AntreaIPsecCSRApprover"
 )
 
-var (
-	antreaAgentServiceAccountName = strings.Join([]string{
-		"system", "serviceaccount", env.GetAntreaNamespace(), "antrea-agent",
-	}, ":")
-)
-
 type ipsecCSRApprover struct {
 	client clientset.Interface
 }
@@ -113,43 +100,9 @@ func (ic *ipsecCSRApprover) verifyCertificateRequest(req *x509.CertificateReques
 			return fmt.Errorf("unsupported key usage: %v", u)
 		}
 	}
-	_, err := ic.client.CoreV1().Nodes().Get(context.TODO(), req.Subject.CommonName, metav1.GetOptions{})
-	if err != nil && apierrors.IsNotFound(err) {
-		return fmt.Errorf("requested Node %s not found", req.Subject.CommonName)
-	} else if err != nil {
-		return &transientError{err}
-	}
-	return nil
+	return verifyNodeExists(ic.client, req.Subject.CommonName)
 }
 
 func (ic *ipsecCSRApprover) verifyIdentity(nodeName string, csr *certificatesv1.CertificateSigningRequest) error {
-	if csr.Spec.Username != antreaAgentServiceAccountName {
-		return errUserUnauthorized
-	}
-	podNameValues, podUIDValues := csr.Spec.Extra[sautil.PodNameKey], csr.Spec.Extra[sautil.PodUIDKey]
-	if len(podNameValues) == 0 && len(podUIDValues) == 0 {
-		klog.Warning("Could not determine Pod identity from CertificateSigningRequest.",
-			" Enable K8s BoundServiceAccountTokenVolume feature gate to provide maximum security.")
-		return nil
-	}
-	if len(podNameValues) == 0 || len(podUIDValues) == 0 {
-		return errExtraFieldsRequired
-	}
-	podName, podUID := podNameValues[0], podUIDValues[0]
-	if podName == "" || podUID == "" {
-		return errExtraFieldsRequired
-	}
-	pod, err := ic.client.CoreV1().Pods(env.GetAntreaNamespace()).Get(context.TODO(), podName, metav1.GetOptions{})
-	if err != nil && apierrors.IsNotFound(err) {
-		return fmt.Errorf("Pod %s not found", podName)
-	} else if err != nil {
-		return &transientError{err}
-	}
-	if pod.ObjectMeta.UID != types.UID(podUID) {
-		return errPodUIDMismatch
-	}
-	if pod.Spec.NodeName != nodeName {
-		return errPodNotOnNode
-	}
-	return nil
+	return verifyAgentIdentity(ic.client, nodeName, csr)
 }
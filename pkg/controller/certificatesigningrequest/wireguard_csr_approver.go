@@ -0,0 +1,106 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package certificatesigningrequest
+
+import (
+	"crypto/x509"
+	"fmt"
+	"reflect"
+
+	certificatesv1 "k8s.io/api/certificates/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	clientset "k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
+
+	antreaapis "antrea.io/antrea/pkg/apis"
+	wgutil "antrea.io/antrea/pkg/util/wireguard"
+)
+
+const (
+	wireGuardCSRApproverName = "AntreaWireGuardCSRApprover"
+)
+
+// wireGuardCSRApprover approves CertificateSigningRequests which certify the WireGuard public key
+// of a Node, when they are created by the antrea-agent running on that Node.
+type wireGuardCSRApprover struct {
+	client clientset.Interface
+}
+
+var wireGuardKeyUsages = sets.New[string](
+	string(certificatesv1.UsageDigitalSignature),
+)
+
+var _ approver = (*wireGuardCSRApprover)(nil)
+
+func (wc *wireGuardCSRApprover) recognize(csr *certificatesv1.CertificateSigningRequest) bool {
+	return csr.Spec.SignerName == antreaapis.AntreaWireGuardCSRSignerName
+}
+
+func (wc *wireGuardCSRApprover) verify(csr *certificatesv1.CertificateSigningRequest) (bool, error) {
+	var failedReasons []string
+	cr, err := decodeCertificateRequest(csr.Spec.Request)
+	if err != nil {
+		return false, err
+	}
+	if err := wc.verifyCertificateRequest(cr, csr.Spec.Usages); err != nil {
+		if _, ok := err.(*transientError); ok {
+			return false, err
+		}
+		failedReasons = append(failedReasons, err.Error())
+	}
+	if err := verifyAgentIdentity(wc.client, cr.Subject.CommonName, csr); err != nil {
+		if _, ok := err.(*transientError); ok {
+			return false, err
+		}
+		failedReasons = append(failedReasons, err.Error())
+	}
+
+	if len(failedReasons) > 0 {
+		klog.InfoS("Verifying CertificateSigningRequest for WireGuard failed", "reasons", failedReasons, "CSR", csr.Name)
+		return false, nil
+	}
+	return true, nil
+}
+
+func (wc *wireGuardCSRApprover) name() string {
+	return wireGuardCSRApproverName
+}
+
+func (wc *wireGuardCSRApprover) verifyCertificateRequest(req *x509.CertificateRequest, usages []certificatesv1.KeyUsage) error {
+	if !reflect.DeepEqual(req.Subject.Organization, []string{antreaapis.AntreaOrganizationName}) {
+		return errOrganizationNotAntrea
+	}
+	if req.Subject.CommonName == "" {
+		return errCommonNameRequired
+	}
+	if len(req.DNSNames) > 0 {
+		return errDNSSANNotAllowed
+	}
+	if len(req.IPAddresses) > 0 {
+		return errIPSANNotAllowed
+	}
+	if len(req.EmailAddresses) > 0 {
+		return errEmailSANNotAllowed
+	}
+	if _, err := wgutil.PublicKeyFromURIs(req.URIs); err != nil {
+		return err
+	}
+	for _, u := range usages {
+		if !wireGuardKeyUsages.Has(string(u)) {
+			return fmt.Errorf("unsupported key usage: %v", u)
+		}
+	}
+	return verifyNodeExists(wc.client, req.Subject.CommonName)
+}
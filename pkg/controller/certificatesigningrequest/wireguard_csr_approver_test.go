@@ -0,0 +1,244 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package certificatesigningrequest
+
+import (
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.zx2c4.com/wireguard/wgctrl/wgtypes"
+	certificatesv1 "k8s.io/api/certificates/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+
+	antreaapis "antrea.io/antrea/pkg/apis"
+	wgutil "antrea.io/antrea/pkg/util/wireguard"
+)
+
+func newTestWireGuardPublicKeyURI(t *testing.T) *url.URL {
+	privateKey, err := wgtypes.GeneratePrivateKey()
+	require.NoError(t, err)
+	uri, err := wgutil.PublicKeyURI(privateKey.PublicKey().String())
+	require.NoError(t, err)
+	return uri
+}
+
+func Test_validWireGuardCSR(t *testing.T) {
+	node := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "worker-node-1",
+		},
+	}
+	keyURI := newTestWireGuardPublicKeyURI(t)
+	otherURI, _ := url.Parse("https://antrea.io")
+	tests := []struct {
+		name        string
+		objects     []runtime.Object
+		cr          *x509.CertificateRequest
+		keyUsages   []certificatesv1.KeyUsage
+		expectedErr error
+	}{
+		{
+			name:    "valid CSR",
+			objects: []runtime.Object{node},
+			cr: &x509.CertificateRequest{
+				Subject: pkix.Name{
+					Organization: []string{"antrea.io"},
+					CommonName:   "worker-node-1",
+				},
+				URIs: []*url.URL{keyURI},
+			},
+			keyUsages: []certificatesv1.KeyUsage{
+				certificatesv1.UsageDigitalSignature,
+			},
+		},
+		{
+			name:    "Organization missing",
+			objects: []runtime.Object{node},
+			cr: &x509.CertificateRequest{
+				Subject: pkix.Name{
+					CommonName: "worker-node-1",
+				},
+				URIs: []*url.URL{keyURI},
+			},
+			expectedErr: errOrganizationNotAntrea,
+		},
+		{
+			name:    "requested Node not found",
+			objects: []runtime.Object{},
+			cr: &x509.CertificateRequest{
+				Subject: pkix.Name{
+					Organization: []string{"antrea.io"},
+					CommonName:   "worker-node-1",
+				},
+				URIs: []*url.URL{keyURI},
+			},
+			expectedErr: errors.New("requested Node worker-node-1 not found"),
+		},
+		{
+			name:    "DNS SAN should not be permitted",
+			objects: []runtime.Object{node},
+			cr: &x509.CertificateRequest{
+				Subject: pkix.Name{
+					Organization: []string{"antrea.io"},
+					CommonName:   "worker-node-1",
+				},
+				DNSNames: []string{"worker-node-1"},
+				URIs:     []*url.URL{keyURI},
+			},
+			expectedErr: errDNSSANNotAllowed,
+		},
+		{
+			name:    "public key missing",
+			objects: []runtime.Object{node},
+			cr: &x509.CertificateRequest{
+				Subject: pkix.Name{
+					Organization: []string{"antrea.io"},
+					CommonName:   "worker-node-1",
+				},
+			},
+			expectedErr: errors.New("expected exactly one URI subjectAltName, got 0"),
+		},
+		{
+			name:    "URI SAN is not a public key",
+			objects: []runtime.Object{node},
+			cr: &x509.CertificateRequest{
+				Subject: pkix.Name{
+					Organization: []string{"antrea.io"},
+					CommonName:   "worker-node-1",
+				},
+				URIs: []*url.URL{otherURI},
+			},
+			expectedErr: errors.New("URI subjectAltName https://antrea.io is not a WireGuard public key"),
+		},
+		{
+			name:    "key usages not match",
+			objects: []runtime.Object{node},
+			cr: &x509.CertificateRequest{
+				Subject: pkix.Name{
+					Organization: []string{"antrea.io"},
+					CommonName:   "worker-node-1",
+				},
+				URIs: []*url.URL{keyURI},
+			},
+			keyUsages: []certificatesv1.KeyUsage{
+				certificatesv1.UsageIPsecTunnel,
+			},
+			expectedErr: errors.New("unsupported key usage: ipsec tunnel"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := fake.NewSimpleClientset(tt.objects...)
+			wc := &wireGuardCSRApprover{
+				client: client,
+			}
+			err := wc.verifyCertificateRequest(tt.cr, tt.keyUsages)
+			if tt.expectedErr == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.expectedErr.Error())
+			}
+		})
+	}
+}
+
+func Test_wireGuardCertificateApprover_verify(t *testing.T) {
+	cr := x509.CertificateRequest{
+		Subject: pkix.Name{
+			Organization: []string{"antrea.io"},
+			CommonName:   "worker-node-1",
+		},
+		URIs: []*url.URL{newTestWireGuardPublicKeyURI(t)},
+	}
+	_, crBytes := x509CRtoPEM(t, &cr)
+	objects := []runtime.Object{
+		&corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "worker-node-1",
+			},
+		},
+		&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "kube-system",
+				Name:      "antrea-agent-8r5f9",
+				UID:       "1206ba75-7d75-474c-8110-99255502178c",
+			},
+			Spec: corev1.PodSpec{
+				NodeName: "worker-node-2",
+			},
+		},
+	}
+	tests := []struct {
+		name             string
+		podName          string
+		username         string
+		expectedApproved bool
+	}{
+		{
+			name:             "valid WireGuard CSR",
+			username:         "system:serviceaccount:kube-system:antrea-agent",
+			expectedApproved: true,
+		},
+		{
+			name:             "WireGuard CSR with unknown username",
+			username:         "system:serviceaccount:kube-system:user-1",
+			expectedApproved: false,
+		},
+		{
+			name:             "WireGuard CSR created by antrea-agent on another Node",
+			podName:          "antrea-agent-8r5f9",
+			username:         "system:serviceaccount:kube-system:antrea-agent",
+			expectedApproved: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			csr := &certificatesv1.CertificateSigningRequest{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "worker-node-1-wireguard",
+				},
+				Spec: certificatesv1.CertificateSigningRequestSpec{
+					Request:    crBytes,
+					SignerName: antreaapis.AntreaWireGuardCSRSignerName,
+					Usages: []certificatesv1.KeyUsage{
+						certificatesv1.UsageDigitalSignature,
+					},
+					Username: tt.username,
+				},
+			}
+			if tt.podName != "" {
+				csr.Spec.Extra = map[string]certificatesv1.ExtraValue{
+					"authentication.kubernetes.io/pod-name": {tt.podName},
+					"authentication.kubernetes.io/pod-uid":  {"1206ba75-7d75-474c-8110-99255502178c"},
+				}
+			}
+			wc := &wireGuardCSRApprover{
+				client: fake.NewSimpleClientset(objects...),
+			}
+			assert.True(t, wc.recognize(csr))
+			approved, err := wc.verify(csr)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedApproved, approved)
+		})
+	}
+}
@@ -147,16 +147,16 @@ func (c *ExternalNodeController) reconcileExternalNodes() error {
 	if err != nil {
 		return err
 	}
-	enUIDEENameMap := make(map[types.UID]string)
+	enUIDEENamesMap := make(map[types.UID]sets.Set[string])
 	for _, en := range externalNodes {
-		if err = c.addExternalNode(en); err != nil {
-			return err
+		if err := externalnode.ValidateInterfaces(en); err != nil {
+			klog.ErrorS(err, "Skipping invalid ExternalNode", "ExternalNode", klog.KObj(en))
+			continue
 		}
-		eeName, err := externalnode.GenExternalEntityName(en)
-		if err != nil {
+		if err = c.addExternalNode(en); err != nil {
 			return err
 		}
-		enUIDEENameMap[en.UID] = eeName
+		enUIDEENamesMap[en.UID] = genExternalEntityNames(en)
 	}
 	externalEntities, err := c.externalEntityLister.List(labels.Everything())
 	if err != nil {
@@ -165,8 +165,8 @@ func (c *ExternalNodeController) reconcileExternalNodes() error {
 	for _, ee := range externalEntities {
 		if (len(ee.OwnerReferences) > 0) && (ee.OwnerReferences[0].Kind == "ExternalNode") {
 			// Clean up stale ExternalEntities when ExternalNode no longer exists or
-			// when interface[0] name is changed.
-			if eeName, ok := enUIDEENameMap[ee.OwnerReferences[0].UID]; !ok || (ok && (eeName != ee.Name)) {
+			// when an interface is removed or renamed.
+			if eeNames, ok := enUIDEENamesMap[ee.OwnerReferences[0].UID]; !ok || !eeNames.Has(ee.Name) {
 				err = c.crdClient.CrdV1alpha2().ExternalEntities(ee.Namespace).Delete(context.TODO(), ee.Name, metav1.DeleteOptions{})
 				if err != nil {
 					return err
@@ -226,20 +226,16 @@ func (c *ExternalNodeController) syncExternalNode(key string) error {
 	}
 }
 
-// addExternalNode creates ExternalEntity for each NetworkInterface in the ExternalNode.
-// Only one interface is supported for now and there should be one ExternalEntity generated for one ExternalNode.
+// addExternalNode creates an ExternalEntity for each NetworkInterface in the ExternalNode.
 func (c *ExternalNodeController) addExternalNode(en *v1alpha1.ExternalNode) error {
-	eeName, err := externalnode.GenExternalEntityName(en)
+	ees, err := genExternalEntities(en)
 	if err != nil {
 		return err
 	}
-	ee, err := genExternalEntity(eeName, en)
-	if err != nil {
-		return err
-	}
-	err = c.createExternalEntity(ee)
-	if err != nil {
-		return err
+	for _, ee := range ees {
+		if err := c.createExternalEntity(ee); err != nil {
+			return err
+		}
 	}
 	c.syncedExternalNode.Add(en)
 	return nil
@@ -258,45 +254,44 @@ func (c *ExternalNodeController) updateExternalNode(preEn *v1alpha1.ExternalNode
 	if reflect.DeepEqual(preEn.Spec.Interfaces, curEn.Spec.Interfaces) && reflect.DeepEqual(preEn.Labels, curEn.Labels) {
 		return nil
 	}
-	// Delete the previous ExternalEntity and create a new one if the name of the generated ExternalEntity is changed.
-	// Otherwise, update the ExternalEntity.
-	preEEName, err := externalnode.GenExternalEntityName(preEn)
+	preEEs, err := genExternalEntities(preEn)
 	if err != nil {
 		return err
 	}
-	curEEName, err := externalnode.GenExternalEntityName(curEn)
+	curEEs, err := genExternalEntities(curEn)
 	if err != nil {
 		return err
 	}
-
-	if preEEName != curEEName {
-		if err = c.deleteExternalEntity(preEn.Namespace, preEEName); err != nil {
-			return err
+	preEEMap := make(map[string]*v1alpha2.ExternalEntity, len(preEEs))
+	for _, ee := range preEEs {
+		preEEMap[ee.Name] = ee
+	}
+	// Create or update the ExternalEntity of each interface, then delete the ExternalEntities
+	// of the interfaces which are removed or renamed.
+	for _, curEE := range curEEs {
+		preEE, ok := preEEMap[curEE.Name]
+		delete(preEEMap, curEE.Name)
+		if ok && reflect.DeepEqual(preEE.Labels, curEE.Labels) && endpointsEqual(preEE.Spec.Endpoints, curEE.Spec.Endpoints) {
+			continue
 		}
-		curEE, err := genExternalEntity(curEEName, curEn)
-		if err != nil {
+		if err = c.updateExternalEntity(curEE); err != nil {
 			return err
 		}
-		if err = c.createExternalEntity(curEE); err != nil {
+	}
+	for eeName := range preEEMap {
+		if err = c.deleteExternalEntity(preEn.Namespace, eeName); err != nil {
 			return err
 		}
-
-	} else {
-		preIPs := sets.New[string](preEn.Spec.Interfaces[0].IPs...)
-		curIPs := sets.New[string](curEn.Spec.Interfaces[0].IPs...)
-		if (!reflect.DeepEqual(preEn.Labels, curEn.Labels)) || (!preIPs.Equal(curIPs)) {
-			updatedEE, err := genExternalEntity(curEEName, curEn)
-			if err != nil {
-				return err
-			}
-			if err = c.updateExternalEntity(updatedEE); err != nil {
-				return err
-			}
-		}
 	}
 	c.syncedExternalNode.Update(curEn)
 	return nil
 }
+
+// endpointsEqual returns whether both lists have the same Endpoints, regardless of the order.
+func endpointsEqual(endpoints1, endpoints2 []v1alpha2.Endpoint) bool {
+	return sets.New[v1alpha2.Endpoint](endpoints1...).Equal(sets.New[v1alpha2.Endpoint](endpoints2...))
+}
+
 func (c *ExternalNodeController) updateExternalEntity(ee *v1alpha2.ExternalEntity) error {
 	// resourceVersion must be specified for update operation,
 	// so it gets the existing ExternalEntity and modifies the changed fields.
@@ -338,13 +333,10 @@ func (c *ExternalNodeController) deleteExternalNode(namespace string, name strin
 		return nil
 	}
 	en := obj.(*v1alpha1.ExternalNode)
-	eeName, err := externalnode.GenExternalEntityName(en)
-	if err != nil {
-		return err
-	}
-	err = c.deleteExternalEntity(namespace, eeName)
-	if err != nil {
-		return err
+	for eeName := range genExternalEntityNames(en) {
+		if err := c.deleteExternalEntity(namespace, eeName); err != nil {
+			return err
+		}
 	}
 	c.syncedExternalNode.Delete(en)
 	return nil
@@ -359,7 +351,33 @@ func (c *ExternalNodeController) deleteExternalEntity(namespace string, name str
 	return err
 }
 
-func genExternalEntity(eeName string, en *v1alpha1.ExternalNode) (*v1alpha2.ExternalEntity, error) {
+// genExternalEntityNames returns the names of the ExternalEntities generated for the interfaces
+// of the ExternalNode.
+func genExternalEntityNames(en *v1alpha1.ExternalNode) sets.Set[string] {
+	eeNames := sets.New[string]()
+	for _, iface := range en.Spec.Interfaces {
+		eeNames.Insert(externalnode.GenExternalEntityName(en.Name, iface.Name))
+	}
+	return eeNames
+}
+
+// genExternalEntities generates an ExternalEntity for each interface of the ExternalNode.
+func genExternalEntities(en *v1alpha1.ExternalNode) ([]*v1alpha2.ExternalEntity, error) {
+	if err := externalnode.ValidateInterfaces(en); err != nil {
+		return nil, err
+	}
+	ees := make([]*v1alpha2.ExternalEntity, 0, len(en.Spec.Interfaces))
+	for i := range en.Spec.Interfaces {
+		ee, err := genExternalEntity(en, &en.Spec.Interfaces[i])
+		if err != nil {
+			return nil, err
+		}
+		ees = append(ees, ee)
+	}
+	return ees, nil
+}
+
+func genExternalEntity(en *v1alpha1.ExternalNode, iface *v1alpha1.NetworkInterface) (*v1alpha2.ExternalEntity, error) {
 	ownerRef := &metav1.OwnerReference{
 		APIVersion: "crd.antrea.io/v1alpha1",
 		Kind:       externalnode.EntityOwnerKind,
@@ -367,23 +385,23 @@ func genExternalEntity(eeName string, en *v1alpha1.ExternalNode) (*v1alpha2.Exte
 		UID:        en.GetUID(),
 	}
 	endpoints := make([]v1alpha2.Endpoint, 0)
-	if len(en.Spec.Interfaces[0].IPs) == 0 {
+	if len(iface.IPs) == 0 {
 		// This should not happen since openAPIV3Schema checks it.
-		return nil, fmt.Errorf("failed to get IPs form Interfaces[0] from ExternalNode %s", en.Name)
+		return nil, fmt.Errorf("failed to get IPs from interface %q of ExternalNode %s", iface.Name, en.Name)
 	}
-	// Generate one/multiple endpoint(s) if one/multiple IP(s) are specified for interface[0]
-	for _, ip := range en.Spec.Interfaces[0].IPs {
+	// Generate one/multiple endpoint(s) if one/multiple IP(s) are specified for the interface.
+	for _, ip := range iface.IPs {
 		endpoints = append(endpoints, v1alpha2.Endpoint{
 			IP:   ip,
-			Name: en.Spec.Interfaces[0].Name,
+			Name: iface.Name,
 		})
 	}
 	ee := &v1alpha2.ExternalEntity{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:            eeName,
+			Name:            externalnode.GenExternalEntityName(en.Name, iface.Name),
 			Namespace:       en.Namespace,
 			OwnerReferences: []metav1.OwnerReference{*ownerRef},
-			Labels:          en.Labels,
+			Labels:          externalnode.GenExternalEntityLabels(en, iface),
 		},
 		Spec: v1alpha2.ExternalEntitySpec{
 			Endpoints:    endpoints,
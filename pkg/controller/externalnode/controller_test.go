@@ -140,6 +140,60 @@ func TestAddExternalNode(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "add-multiple-interfaces-with-labels",
+			externalNode: &v1alpha1.ExternalNode{
+				ObjectMeta: metav1.ObjectMeta{Name: "vm4", Namespace: "ns1", Labels: map[string]string{"en": "vm4", "role": "vm"}},
+				Spec: v1alpha1.ExternalNodeSpec{
+					Interfaces: []v1alpha1.NetworkInterface{
+						{Name: "eth0", IPs: []string{"1.1.1.5"}, Labels: map[string]string{"role": "management"}},
+						{Name: "eth1", IPs: []string{"2.2.2.5"}, Labels: map[string]string{"network": "data"}},
+					},
+				},
+			},
+			expectedEntities: []*v1alpha2.ExternalEntity{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "vm4-3e616",
+						Namespace: "ns1",
+						OwnerReferences: []metav1.OwnerReference{
+							{
+								APIVersion: "crd.antrea.io/v1alpha1",
+								Kind:       "ExternalNode",
+								Name:       "vm4",
+							},
+						},
+						Labels: map[string]string{"en": "vm4", "role": "management"},
+					},
+					Spec: v1alpha2.ExternalEntitySpec{
+						Endpoints: []v1alpha2.Endpoint{
+							{Name: "eth0", IP: "1.1.1.5"},
+						},
+						ExternalNode: "vm4",
+					},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "vm4-2cbd4",
+						Namespace: "ns1",
+						OwnerReferences: []metav1.OwnerReference{
+							{
+								APIVersion: "crd.antrea.io/v1alpha1",
+								Kind:       "ExternalNode",
+								Name:       "vm4",
+							},
+						},
+						Labels: map[string]string{"en": "vm4", "role": "vm", "network": "data"},
+					},
+					Spec: v1alpha2.ExternalEntitySpec{
+						Endpoints: []v1alpha2.Endpoint{
+							{Name: "eth1", IP: "2.2.2.5"},
+						},
+						ExternalNode: "vm4",
+					},
+				},
+			},
+		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
 			controller := newExternalNodeController([]runtime.Object{tc.externalNode})
@@ -409,6 +463,66 @@ func TestUpdateExternalNode(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "remove-interface",
+			externalNode: &v1alpha1.ExternalNode{
+				ObjectMeta: metav1.ObjectMeta{Name: "vm4", Namespace: "ns1", Labels: map[string]string{"en": "vm4"}},
+				Spec: v1alpha1.ExternalNodeSpec{
+					Interfaces: []v1alpha1.NetworkInterface{
+						{Name: "eth0", IPs: []string{"1.1.1.5"}},
+						{Name: "eth1", IPs: []string{"2.2.2.5"}, Labels: map[string]string{"network": "data"}},
+					},
+				},
+			},
+			existingEntity: &v1alpha2.ExternalEntity{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "vm4-2cbd4",
+					Namespace: "ns1",
+					OwnerReferences: []metav1.OwnerReference{
+						{
+							APIVersion: "crd.antrea.io/v1alpha1",
+							Kind:       "ExternalNode",
+							Name:       "vm4",
+						},
+					},
+					Labels: map[string]string{"en": "vm4", "network": "data"},
+				},
+				Spec: v1alpha2.ExternalEntitySpec{
+					Endpoints: []v1alpha2.Endpoint{
+						{Name: "eth1", IP: "2.2.2.5"},
+					},
+					ExternalNode: "vm4",
+				},
+			},
+			updatedExternalNode: &v1alpha1.ExternalNode{
+				ObjectMeta: metav1.ObjectMeta{Name: "vm4", Namespace: "ns1", Labels: map[string]string{"en": "vm4"}},
+				Spec: v1alpha1.ExternalNodeSpec{
+					Interfaces: []v1alpha1.NetworkInterface{
+						{Name: "eth0", IPs: []string{"1.1.1.5"}, Labels: map[string]string{"network": "management"}},
+					},
+				},
+			},
+			expectedEntity: &v1alpha2.ExternalEntity{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "vm4-3e616",
+					Namespace: "ns1",
+					OwnerReferences: []metav1.OwnerReference{
+						{
+							APIVersion: "crd.antrea.io/v1alpha1",
+							Kind:       "ExternalNode",
+							Name:       "vm4",
+						},
+					},
+					Labels: map[string]string{"en": "vm4", "network": "management"},
+				},
+				Spec: v1alpha2.ExternalEntitySpec{
+					Endpoints: []v1alpha2.Endpoint{
+						{Name: "eth0", IP: "1.1.1.5"},
+					},
+					ExternalNode: "vm4",
+				},
+			},
+		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
 			controller := newExternalNodeController([]runtime.Object{tc.externalNode})
@@ -569,6 +683,40 @@ func TestReconcileExternalNodes(t *testing.T) {
 	assert.False(t, ok)
 }
 
+func TestGenExternalEntitiesWithInvalidInterfaces(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		interfaces  []v1alpha1.NetworkInterface
+		expectedErr string
+	}{
+		{
+			name: "interface-without-name",
+			interfaces: []v1alpha1.NetworkInterface{
+				{Name: "eth0", IPs: []string{"1.1.1.2"}},
+				{IPs: []string{"2.2.2.2"}},
+			},
+			expectedErr: "interface name must be specified when ExternalNode vm1 has multiple interfaces",
+		},
+		{
+			name: "duplicate-interface-names",
+			interfaces: []v1alpha1.NetworkInterface{
+				{Name: "eth0", IPs: []string{"1.1.1.2"}},
+				{Name: "eth0", IPs: []string{"2.2.2.2"}},
+			},
+			expectedErr: "duplicate interface name eth0 in ExternalNode vm1",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			externalNode := &v1alpha1.ExternalNode{
+				ObjectMeta: metav1.ObjectMeta{Name: "vm1", Namespace: "ns1"},
+				Spec:       v1alpha1.ExternalNodeSpec{Interfaces: tc.interfaces},
+			}
+			_, err := genExternalEntities(externalNode)
+			assert.EqualError(t, err, tc.expectedErr)
+		})
+	}
+}
+
 func TestUpdateExternalEntity(t *testing.T) {
 	existingEntity := &v1alpha2.ExternalEntity{
 		ObjectMeta: metav1.ObjectMeta{
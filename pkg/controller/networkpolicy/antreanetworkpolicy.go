@@ -79,7 +79,18 @@ func (n *NetworkPolicyController) deleteANNP(old interface{}) {
 // does not commit the internal NetworkPolicy in store, instead returns an
 // instance to the caller.
 func (n *NetworkPolicyController) processAntreaNetworkPolicy(np *crdv1beta1.NetworkPolicy) (*antreatypes.NetworkPolicy, map[string]*antreatypes.AppliedToGroup, map[string]*antreatypes.AddressGroup) {
-	appliedToPerRule := len(np.Spec.AppliedTo) == 0
+	// A policy with ClusterSet scoped egress peers is converted to appliedTo per rule policy, as the
+	// ingress rules created for the egress rules are applied to the peers.
+	stretchedEgress := n.stretchNPEnabled && hasStretchedEgressRule(np.Spec.Egress)
+	appliedToPerRule := len(np.Spec.AppliedTo) == 0 || stretchedEgress
+	// ruleAppliedTo returns the appliedTo of a rule, which is the appliedTo in the policy spec
+	// if the policy is converted to appliedTo per rule policy.
+	ruleAppliedTo := func(rule *crdv1beta1.Rule) []crdv1beta1.AppliedTo {
+		if appliedToPerRule && len(np.Spec.AppliedTo) > 0 {
+			return np.Spec.AppliedTo
+		}
+		return rule.AppliedTo
+	}
 	// appliedToGroups tracks all distinct appliedToGroups referred to by the Antrea NetworkPolicy,
 	// either in the spec section or in ingress/egress rules.
 	// The span calculation and stale appliedToGroup cleanup logic would work seamlessly for both cases.
@@ -91,15 +102,20 @@ func (n *NetworkPolicyController) processAntreaNetworkPolicy(np *crdv1beta1.Netw
 	// labelIdentityInterface and added to this set. By the end of the function, this set will
 	// be used to remove any stale selector from the policy in the labelIdentityInterface.
 	var clusterSetScopeSelectorKeys sets.Set[string]
+	// schedules filters out the rules which are not active at this moment according to their schedules.
+	schedules := &scheduleEvaluator{now: n.clock.Now()}
 	// Create AppliedToGroup for each AppliedTo present in AntreaNetworkPolicy spec.
 	atgs := n.processAppliedTo(np.Namespace, np.Spec.AppliedTo)
 	appliedToGroups = mergeAppliedToGroups(appliedToGroups, atgs...)
 	// Compute NetworkPolicyRule for Ingress Rule.
 	for idx, ingressRule := range np.Spec.Ingress {
+		if !schedules.isActive(ingressRule.Name, ingressRule.Action, ingressRule.Schedule) {
+			continue
+		}
 		// Set default action to ALLOW to allow traffic.
 		services, namedPortExists := toAntreaServicesForCRD(ingressRule.Ports, ingressRule.Protocols)
 		// Create AppliedToGroup for each AppliedTo present in the ingress rule.
-		atgs := n.processAppliedTo(np.Namespace, ingressRule.AppliedTo)
+		atgs := n.processAppliedTo(np.Namespace, ruleAppliedTo(&np.Spec.Ingress[idx]))
 		appliedToGroups = mergeAppliedToGroups(appliedToGroups, atgs...)
 		peer, ags, selKeys := n.toAntreaPeerForCRD(ingressRule.From, np, controlplane.DirectionIn, namedPortExists)
 		if selKeys != nil {
@@ -117,18 +133,26 @@ func (n *NetworkPolicyController) processAntreaNetworkPolicy(np *crdv1beta1.Netw
 			AppliedToGroups: getAppliedToGroupNames(atgs),
 			L7Protocols:     toAntreaL7ProtocolsForCRD(ingressRule.L7Protocols),
 			LogLabel:        ingressRule.LogLabel,
+			L7DenyResponse:  toAntreaL7DenyResponseForCRD(ingressRule.L7DenyResponse),
+			RateLimit:       toAntreaRateLimitForCRD(ingressRule.RateLimit),
 		})
 	}
 	// Compute NetworkPolicyRule for Egress Rule.
 	for idx, egressRule := range np.Spec.Egress {
+		if !schedules.isActive(egressRule.Name, egressRule.Action, egressRule.Schedule) {
+			continue
+		}
 		// Set default action to ALLOW to allow traffic.
 		services, namedPortExists := toAntreaServicesForCRD(egressRule.Ports, egressRule.Protocols)
 		// Create AppliedToGroup for each AppliedTo present in the egress rule.
-		atgs := n.processAppliedTo(np.Namespace, egressRule.AppliedTo)
+		appliedTos := ruleAppliedTo(&np.Spec.Egress[idx])
+		atgs := n.processAppliedTo(np.Namespace, appliedTos)
 		appliedToGroups = mergeAppliedToGroups(appliedToGroups, atgs...)
 		var peer *controlplane.NetworkPolicyPeer
+		var svcPeers []svcRefPeer
 		if egressRule.ToServices != nil {
 			peer = n.svcRefToPeerForCRD(egressRule.ToServices, np.Namespace)
+			svcPeers = n.resolveSvcRefsForCRD(egressRule.ToServices, np.Namespace)
 		} else {
 			var ags []*antreatypes.AddressGroup
 			var selKeys sets.Set[string]
@@ -138,18 +162,37 @@ func (n *NetworkPolicyController) processAntreaNetworkPolicy(np *crdv1beta1.Netw
 				clusterSetScopeSelectorKeys = clusterSetScopeSelectorKeys.Union(selKeys)
 			}
 		}
-		rules = append(rules, controlplane.NetworkPolicyRule{
-			Direction:       controlplane.DirectionOut,
-			To:              *peer,
-			Services:        services,
-			Name:            egressRule.Name,
-			Action:          egressRule.Action,
-			Priority:        int32(idx),
-			EnableLogging:   egressRule.EnableLogging,
-			AppliedToGroups: getAppliedToGroupNames(atgs),
-			L7Protocols:     toAntreaL7ProtocolsForCRD(egressRule.L7Protocols),
-			LogLabel:        egressRule.LogLabel,
-		})
+		egressRuleForPeer := func(peer *controlplane.NetworkPolicyPeer, services []controlplane.Service) controlplane.NetworkPolicyRule {
+			return controlplane.NetworkPolicyRule{
+				Direction:       controlplane.DirectionOut,
+				To:              *peer,
+				Services:        services,
+				Name:            egressRule.Name,
+				Action:          egressRule.Action,
+				Priority:        int32(idx),
+				EnableLogging:   egressRule.EnableLogging,
+				AppliedToGroups: getAppliedToGroupNames(atgs),
+				L7Protocols:     toAntreaL7ProtocolsForCRD(egressRule.L7Protocols),
+				LogLabel:        egressRule.LogLabel,
+				L7DenyResponse:  toAntreaL7DenyResponseForCRD(egressRule.L7DenyResponse),
+				RateLimit:       toAntreaRateLimitForCRD(egressRule.RateLimit),
+			}
+		}
+		rules = append(rules, egressRuleForPeer(peer, services))
+		// The Services which cannot be matched by their ClusterIPs are resolved to additional peers, each of which is
+		// restricted to its own ports.
+		for _, svcPeer := range svcPeers {
+			rules = append(rules, egressRuleForPeer(svcPeer.peer, svcPeer.services))
+		}
+		if stretchedEgress && egressRule.ToServices == nil {
+			// The ingress rules created for egress rules are placed after the ingress rules of the policy.
+			stretchedRule, stretchedATGs, selKeys := n.toStretchedEgressRuleForCRD(&np.Spec.Egress[idx], appliedTos, np, services, int32(len(np.Spec.Ingress)+idx))
+			clusterSetScopeSelectorKeys = clusterSetScopeSelectorKeys.Union(selKeys)
+			if stretchedRule != nil {
+				rules = append(rules, *stretchedRule)
+				appliedToGroups = mergeAppliedToGroups(appliedToGroups, stretchedATGs...)
+			}
+		}
 	}
 	tierPriority := n.getTierPriority(np.Spec.Tier)
 	internalNetworkPolicy := &antreatypes.NetworkPolicy{
@@ -159,14 +202,17 @@ func (n *NetworkPolicyController) processAntreaNetworkPolicy(np *crdv1beta1.Netw
 			Name:      np.Name,
 			UID:       np.UID,
 		},
-		Name:             internalNetworkPolicyKeyFunc(np),
-		UID:              np.UID,
-		Generation:       np.Generation,
-		AppliedToGroups:  sets.List(sets.KeySet(appliedToGroups)),
-		Rules:            rules,
-		Priority:         &np.Spec.Priority,
-		TierPriority:     &tierPriority,
-		AppliedToPerRule: appliedToPerRule,
+		Name:                       internalNetworkPolicyKeyFunc(np),
+		UID:                        np.UID,
+		Generation:                 np.Generation,
+		AppliedToGroups:            sets.List(sets.KeySet(appliedToGroups)),
+		Rules:                      rules,
+		Priority:                   &np.Spec.Priority,
+		TierPriority:               &tierPriority,
+		AppliedToPerRule:           appliedToPerRule,
+		NextScheduleTransitionTime: schedules.nextTransition,
+		InvalidRuleSchedules:       schedules.invalidSchedules,
+		EnforcementMode:            np.Spec.EnforcementMode,
 	}
 	if n.stretchNPEnabled {
 		n.labelIdentityInterface.RemoveStalePolicySelectors(clusterSetScopeSelectorKeys, internalNetworkPolicyKeyFunc(np))
@@ -328,7 +328,10 @@ func (n *NetworkPolicyController) processClusterNetworkPolicy(cnp *crdv1beta1.Cl
 	// If one of the ACNP rule is a per-namespace rule (a peer in that rule has namespaces.Match set
 	// to Self), the policy will need to be converted to appliedTo per rule policy, as the appliedTo
 	// will be different for rules created for each namespace.
-	appliedToPerRule := len(cnp.Spec.AppliedTo) == 0 || hasPerNamespaceRule
+	// The same applies to a policy with ClusterSet scoped egress peers, as the ingress rules created
+	// for them are applied to the peers.
+	stretchedEgress := n.stretchNPEnabled && hasStretchedEgressRule(cnp.Spec.Egress)
+	appliedToPerRule := len(cnp.Spec.AppliedTo) == 0 || hasPerNamespaceRule || stretchedEgress
 	// appliedToGroups tracks all distinct appliedToGroups referred to by the ClusterNetworkPolicy,
 	// either in the spec section or in ingress/egress rules.
 	// The span calculation and stale appliedToGroup cleanup logic would work seamlessly for both cases.
@@ -363,14 +366,19 @@ func (n *NetworkPolicyController) processClusterNetworkPolicy(cnp *crdv1beta1.Cl
 		}
 	}
 	var rules []controlplane.NetworkPolicyRule
+	// schedules filters out the rules which are not active at this moment according to their schedules.
+	schedules := &scheduleEvaluator{now: n.clock.Now()}
 	processRules := func(cnpRules []crdv1beta1.Rule, direction controlplane.Direction) {
 		for idx, cnpRule := range cnpRules {
+			if !schedules.isActive(cnpRule.Name, cnpRule.Action, cnpRule.Schedule) {
+				continue
+			}
 			services, namedPortExists := toAntreaServicesForCRD(cnpRule.Ports, cnpRule.Protocols)
 			clusterPeers, perNSPeers := splitPeersByScope(cnpRule, direction)
-			addRule := func(peer *controlplane.NetworkPolicyPeer, ruleAddressGroups []*antreatypes.AddressGroup, dir controlplane.Direction, ruleAppliedTos []*antreatypes.AppliedToGroup) {
+			addRule := func(peer *controlplane.NetworkPolicyPeer, ruleServices []controlplane.Service, ruleAddressGroups []*antreatypes.AddressGroup, dir controlplane.Direction, ruleAppliedTos []*antreatypes.AppliedToGroup) {
 				rule := controlplane.NetworkPolicyRule{
 					Direction:       dir,
-					Services:        services,
+					Services:        ruleServices,
 					Name:            cnpRule.Name,
 					Action:          cnpRule.Action,
 					Priority:        int32(idx),
@@ -378,6 +386,8 @@ func (n *NetworkPolicyController) processClusterNetworkPolicy(cnp *crdv1beta1.Cl
 					AppliedToGroups: getAppliedToGroupNames(ruleAppliedTos),
 					L7Protocols:     toAntreaL7ProtocolsForCRD(cnpRule.L7Protocols),
 					LogLabel:        cnpRule.LogLabel,
+					L7DenyResponse:  toAntreaL7DenyResponseForCRD(cnpRule.L7DenyResponse),
+					RateLimit:       toAntreaRateLimitForCRD(cnpRule.RateLimit),
 				}
 				if dir == controlplane.DirectionIn {
 					rule.From = *peer
@@ -400,13 +410,27 @@ func (n *NetworkPolicyController) processClusterNetworkPolicy(cnp *crdv1beta1.Cl
 				ruleATGs := n.processClusterAppliedTo(ruleAppliedTos)
 				klog.V(4).InfoS("Adding a new cluster-level rule", "appliedTos", ruleATGs, "ClusterNetworkPolicy", klog.KObj(cnp))
 				if cnpRule.ToServices != nil {
-					addRule(n.svcRefToPeerForCRD(cnpRule.ToServices, ""), nil, direction, ruleATGs)
+					addRule(n.svcRefToPeerForCRD(cnpRule.ToServices, ""), services, nil, direction, ruleATGs)
+					// The Services which cannot be matched by their ClusterIPs are resolved to additional peers,
+					// each of which is restricted to its own ports.
+					for _, svcPeer := range n.resolveSvcRefsForCRD(cnpRule.ToServices, "") {
+						addRule(svcPeer.peer, svcPeer.services, nil, direction, ruleATGs)
+					}
 				} else {
 					peer, ags, selKeys := n.toAntreaPeerForCRD(clusterPeers, cnp, direction, namedPortExists)
 					if selKeys != nil {
 						clusterSetScopeSelectorKeys = clusterSetScopeSelectorKeys.Union(selKeys)
 					}
-					addRule(peer, ags, direction, ruleATGs)
+					addRule(peer, services, ags, direction, ruleATGs)
+					if stretchedEgress && direction == controlplane.DirectionOut {
+						// The ingress rules created for egress rules are placed after the ingress rules of the policy.
+						stretchedRule, atgs, selKeys := n.toStretchedEgressRuleForCRD(&cnpRules[idx], ruleAppliedTos, cnp, services, int32(len(cnp.Spec.Ingress)+idx))
+						clusterSetScopeSelectorKeys = clusterSetScopeSelectorKeys.Union(selKeys)
+						if stretchedRule != nil {
+							rules = append(rules, *stretchedRule)
+							appliedToGroups = mergeAppliedToGroups(appliedToGroups, atgs...)
+						}
+					}
 				}
 			}
 			if len(perNSPeers) > 0 {
@@ -416,7 +440,7 @@ func (n *NetworkPolicyController) processClusterNetworkPolicy(cnp *crdv1beta1.Cl
 						klog.V(4).Infof("Adding a new per-namespace rule with appliedTo %v for rule %d of %s", clusterAppliedToAffectedNS[i], idx, cnp.Name)
 						peer, ags, selKeys := n.toNamespacedPeerForCRD(perNSPeers, cnp, clusterAppliedToAffectedNS[i])
 						clusterSetScopeSelectorKeys = clusterSetScopeSelectorKeys.Union(selKeys)
-						addRule(peer, ags, direction, []*antreatypes.AppliedToGroup{atgForNamespace[i]})
+						addRule(peer, services, ags, direction, []*antreatypes.AppliedToGroup{atgForNamespace[i]})
 					}
 				} else {
 					// Create a rule for each affected Namespace of appliedTo at rule level
@@ -426,7 +450,7 @@ func (n *NetworkPolicyController) processClusterNetworkPolicy(cnp *crdv1beta1.Cl
 							klog.V(4).Infof("Adding a new per-namespace rule with appliedTo %v for rule %d of %s", atg, idx, cnp.Name)
 							peer, ags, selKeys := n.toNamespacedPeerForCRD(perNSPeers, cnp, at.ServiceAccount.Namespace)
 							clusterSetScopeSelectorKeys = clusterSetScopeSelectorKeys.Union(selKeys)
-							addRule(peer, ags, direction, []*antreatypes.AppliedToGroup{atg})
+							addRule(peer, services, ags, direction, []*antreatypes.AppliedToGroup{atg})
 						} else {
 							affectedNS := n.getAffectedNamespacesForAppliedTo(at)
 							for _, ns := range affectedNS {
@@ -434,7 +458,7 @@ func (n *NetworkPolicyController) processClusterNetworkPolicy(cnp *crdv1beta1.Cl
 								klog.V(4).Infof("Adding a new per-namespace rule with appliedTo %v for rule %d of %s", atg, idx, cnp.Name)
 								peer, ags, selKeys := n.toNamespacedPeerForCRD(perNSPeers, cnp, ns)
 								clusterSetScopeSelectorKeys = clusterSetScopeSelectorKeys.Union(selKeys)
-								addRule(peer, ags, direction, []*antreatypes.AppliedToGroup{atg})
+								addRule(peer, services, ags, direction, []*antreatypes.AppliedToGroup{atg})
 							}
 						}
 					}
@@ -459,12 +483,15 @@ func (n *NetworkPolicyController) processClusterNetworkPolicy(cnp *crdv1beta1.Cl
 			Name: cnp.Name,
 			UID:  cnp.UID,
 		},
-		UID:              cnp.UID,
-		AppliedToGroups:  sets.List(sets.KeySet(appliedToGroups)),
-		Rules:            rules,
-		Priority:         &cnp.Spec.Priority,
-		TierPriority:     &tierPriority,
-		AppliedToPerRule: appliedToPerRule,
+		UID:                        cnp.UID,
+		AppliedToGroups:            sets.List(sets.KeySet(appliedToGroups)),
+		Rules:                      rules,
+		Priority:                   &cnp.Spec.Priority,
+		TierPriority:               &tierPriority,
+		AppliedToPerRule:           appliedToPerRule,
+		NextScheduleTransitionTime: schedules.nextTransition,
+		InvalidRuleSchedules:       schedules.invalidSchedules,
+		EnforcementMode:            cnp.Spec.EnforcementMode,
 	}
 	if n.stretchNPEnabled {
 		n.labelIdentityInterface.RemoveStalePolicySelectors(clusterSetScopeSelectorKeys, internalNetworkPolicyKeyFunc(cnp))
@@ -18,6 +18,7 @@ import (
 	"fmt"
 	"net"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -25,10 +26,12 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/sets"
+	clocktesting "k8s.io/utils/clock/testing"
 
 	"antrea.io/antrea/multicluster/controllers/multicluster/common"
 	"antrea.io/antrea/pkg/apis/controlplane"
 	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
+	"antrea.io/antrea/pkg/controller/networkpolicy/store"
 	antreatypes "antrea.io/antrea/pkg/controller/types"
 	"antrea.io/antrea/pkg/util/k8s"
 )
@@ -1799,6 +1802,170 @@ func TestProcessClusterNetworkPolicy(t *testing.T) {
 	}
 }
 
+func TestProcessClusterNetworkPolicyWithSchedule(t *testing.T) {
+	cnp := getCNP()
+	cnp.Spec.Egress[0].Schedule = &crdv1beta1.RuleSchedule{
+		Windows: []crdv1beta1.ScheduleWindow{{Start: "0 22 * * 6", Duration: "4h"}},
+	}
+	tests := []struct {
+		name                   string
+		now                    time.Time
+		expectedDirections     []controlplane.Direction
+		expectedNextTransition time.Time
+	}{
+		{
+			name:                   "outside window",
+			now:                    time.Date(2024, 3, 2, 21, 0, 0, 0, time.UTC),
+			expectedDirections:     []controlplane.Direction{controlplane.DirectionIn},
+			expectedNextTransition: time.Date(2024, 3, 2, 22, 0, 0, 0, time.UTC),
+		},
+		{
+			name:                   "within window",
+			now:                    time.Date(2024, 3, 2, 22, 30, 0, 0, time.UTC),
+			expectedDirections:     []controlplane.Direction{controlplane.DirectionIn, controlplane.DirectionOut},
+			expectedNextTransition: time.Date(2024, 3, 3, 2, 0, 0, 0, time.UTC),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, c := newController(nil, nil)
+			c.clock = clocktesting.NewFakeClock(tt.now)
+			actualPolicy, _, _ := c.processClusterNetworkPolicy(cnp)
+			var directions []controlplane.Direction
+			for _, rule := range actualPolicy.Rules {
+				directions = append(directions, rule.Direction)
+			}
+			assert.Equal(t, tt.expectedDirections, directions)
+			require.NotNil(t, actualPolicy.NextScheduleTransitionTime)
+			assert.True(t, tt.expectedNextTransition.Equal(*actualPolicy.NextScheduleTransitionTime))
+		})
+	}
+}
+
+func TestProcessClusterNetworkPolicyWithInvalidSchedule(t *testing.T) {
+	dropAction := crdv1beta1.RuleActionDrop
+	cnp := getCNP()
+	cnp.Spec.Ingress[0].Name = "ingress-rule"
+	cnp.Spec.Ingress[0].Action = &dropAction
+	cnp.Spec.Egress[0].Name = "egress-rule"
+	invalidSchedule := &crdv1beta1.RuleSchedule{
+		Windows: []crdv1beta1.ScheduleWindow{{Start: "0 22 * * 6", Duration: "90s"}},
+	}
+	cnp.Spec.Ingress[0].Schedule = invalidSchedule
+	cnp.Spec.Egress[0].Schedule = invalidSchedule
+	_, c := newController(nil, nil)
+	actualPolicy, _, _ := c.processClusterNetworkPolicy(cnp)
+	var directions []controlplane.Direction
+	for _, rule := range actualPolicy.Rules {
+		directions = append(directions, rule.Direction)
+	}
+	// The Drop rule is still enforced, while the Allow rule is not.
+	assert.Equal(t, []controlplane.Direction{controlplane.DirectionIn}, directions)
+	assert.Nil(t, actualPolicy.NextScheduleTransitionTime)
+	require.Len(t, actualPolicy.InvalidRuleSchedules, 2)
+	assert.Contains(t, actualPolicy.InvalidRuleSchedules[0], "ingress-rule")
+	assert.Contains(t, actualPolicy.InvalidRuleSchedules[0], "always enforced")
+	assert.Contains(t, actualPolicy.InvalidRuleSchedules[1], "egress-rule")
+	assert.Contains(t, actualPolicy.InvalidRuleSchedules[1], "never enforced")
+}
+
+func TestProcessClusterNetworkPolicyInAuditMode(t *testing.T) {
+	cnp := getCNP()
+	cnp.Spec.EnforcementMode = crdv1beta1.PolicyEnforcementModeAudit
+	_, c := newController(nil, nil)
+	actualPolicy, _, _ := c.processClusterNetworkPolicy(cnp)
+	assert.Equal(t, crdv1beta1.PolicyEnforcementModeAudit, actualPolicy.EnforcementMode)
+	assert.Len(t, actualPolicy.Rules, 2)
+
+	var msg controlplane.NetworkPolicy
+	store.ToNetworkPolicyMsg(actualPolicy, &msg, true)
+	assert.Equal(t, crdv1beta1.PolicyEnforcementModeAudit, msg.EnforcementMode)
+}
+
+func TestProcessClusterNetworkPolicyWithStretchedEgressRule(t *testing.T) {
+	selectorA := metav1.LabelSelector{MatchLabels: map[string]string{"foo1": "bar1"}}
+	selectorB := metav1.LabelSelector{MatchLabels: map[string]string{"foo2": "bar2"}}
+	cnp := &crdv1beta1.ClusterNetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "cnpA", UID: "uidA"},
+		Spec: crdv1beta1.ClusterNetworkPolicySpec{
+			AppliedTo: []crdv1beta1.AppliedTo{
+				{PodSelector: &selectorA},
+			},
+			Priority: float64(10),
+			Egress: []crdv1beta1.Rule{
+				{
+					To: []crdv1beta1.NetworkPolicyPeer{
+						{
+							PodSelector: &selectorB,
+							Scope:       crdv1beta1.ScopeClusterSet,
+						},
+					},
+					Action: &dropAction,
+				},
+			},
+		},
+	}
+	atgA := getNormalizedUID(antreatypes.NewGroupSelector("", &selectorA, nil, nil, nil).NormalizedName)
+	atgB := getNormalizedUID(antreatypes.NewGroupSelector("", &selectorB, nil, nil, nil).NormalizedName)
+	egressRule := controlplane.NetworkPolicyRule{
+		Direction: controlplane.DirectionOut,
+		To: controlplane.NetworkPolicyPeer{
+			AddressGroups: []string{atgB},
+		},
+		Priority:        0,
+		Action:          &dropAction,
+		AppliedToGroups: []string{atgA},
+	}
+	tests := []struct {
+		name                    string
+		labelIdentities         map[string]uint32
+		expectedRules           []controlplane.NetworkPolicyRule
+		expectedAppliedToGroups []string
+	}{
+		{
+			name: "label identity matched",
+			labelIdentities: map[string]uint32{
+				"ns:kubernetes.io/metadata.name=testing,purpose=test&pod:foo1=bar1": 1,
+				"ns:kubernetes.io/metadata.name=testing,purpose=test&pod:foo2=bar2": 2,
+			},
+			expectedRules: []controlplane.NetworkPolicyRule{
+				egressRule,
+				{
+					Direction: controlplane.DirectionIn,
+					From: controlplane.NetworkPolicyPeer{
+						LabelIdentities: []uint32{1},
+					},
+					Priority:        0,
+					Action:          &dropAction,
+					AppliedToGroups: []string{atgB},
+				},
+			},
+			expectedAppliedToGroups: []string{atgA, atgB},
+		},
+		{
+			name: "no label identity matched",
+			labelIdentities: map[string]uint32{
+				"ns:kubernetes.io/metadata.name=testing,purpose=test&pod:foo2=bar2": 2,
+			},
+			expectedRules:           []controlplane.NetworkPolicyRule{egressRule},
+			expectedAppliedToGroups: []string{atgA},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, c := newController(nil, nil)
+			for label, id := range tt.labelIdentities {
+				c.labelIdentityInterface.AddLabelIdentity(label, id)
+			}
+			actualPolicy, actualAppliedToGroups, _ := c.processClusterNetworkPolicy(cnp)
+			assert.True(t, actualPolicy.AppliedToPerRule)
+			assert.ElementsMatch(t, tt.expectedRules, actualPolicy.Rules)
+			assert.ElementsMatch(t, tt.expectedAppliedToGroups, actualPolicy.AppliedToGroups)
+			assert.Len(t, actualAppliedToGroups, len(tt.expectedAppliedToGroups))
+		})
+	}
+}
+
 func TestAddCNP(t *testing.T) {
 	_, npc := newController(nil, nil)
 	cnp := getCNP()
@@ -43,13 +43,17 @@ var (
 // semanticIgnoreLastTransitionTime does semantic deep equality checks for
 // NetworkPolicyCondition but excludes LastTransitionTime. They are used when
 // comparing NetworkPolicyCondition in NetworkPolicyStatus objects to avoid
-// unnecessary updates caused different status generation time.
+// unnecessary updates caused different status generation time. Other times,
+// e.g. NextScheduleTransitionTime, are compared regardless of their locations.
 var semanticIgnoreLastTransitionTime = conversion.EqualitiesOrDie(
 	func(a, b crdv1beta1.NetworkPolicyCondition) bool {
 		a.LastTransitionTime = metav1.Date(2018, 1, 1, 0, 0, 0, 0, time.UTC)
 		b.LastTransitionTime = metav1.Date(2018, 1, 1, 0, 0, 0, 0, time.UTC)
 		return a == b
 	},
+	func(a, b metav1.Time) bool {
+		return a.Equal(&b)
+	},
 )
 
 // NetworkPolicyStatusEqual compares two NetworkPolicyStatus objects. It disregards
@@ -117,13 +121,78 @@ func toAntreaL7ProtocolsForCRD(l7Protocols []crdv1beta1.L7Protocol) []controlpla
 	var antreaL7Protocols []controlplane.L7Protocol
 	for _, l7p := range l7Protocols {
 		antreaL7Protocols = append(antreaL7Protocols, controlplane.L7Protocol{
-			HTTP: (*controlplane.HTTPProtocol)(l7p.HTTP),
-			TLS:  (*controlplane.TLSProtocol)(l7p.TLS),
+			HTTP:  toAntreaHTTPProtocolForCRD(l7p.HTTP),
+			TLS:   (*controlplane.TLSProtocol)(l7p.TLS),
+			GRPC:  (*controlplane.GRPCProtocol)(l7p.GRPC),
+			DNS:   (*controlplane.DNSProtocol)(l7p.DNS),
+			Kafka: (*controlplane.KafkaProtocol)(l7p.Kafka),
 		})
 	}
 	return antreaL7Protocols
 }
 
+// toAntreaHTTPProtocolForCRD converts a v1beta1.HTTPProtocol object to an Antrea
+// HTTPProtocol object.
+func toAntreaHTTPProtocolForCRD(http *crdv1beta1.HTTPProtocol) *controlplane.HTTPProtocol {
+	if http == nil {
+		return nil
+	}
+	antreaHTTP := &controlplane.HTTPProtocol{
+		Host:          http.Host,
+		Method:        http.Method,
+		Path:          http.Path,
+		PathMatchType: controlplane.HTTPMatchType(http.PathMatchType),
+	}
+	for _, header := range http.Headers {
+		antreaHTTP.Headers = append(antreaHTTP.Headers, controlplane.HTTPHeaderMatch{
+			Name:      header.Name,
+			Value:     header.Value,
+			MatchType: controlplane.HTTPMatchType(header.MatchType),
+		})
+	}
+	for _, param := range http.QueryParams {
+		antreaHTTP.QueryParams = append(antreaHTTP.QueryParams, controlplane.HTTPQueryParamMatch{
+			Name:      param.Name,
+			Value:     param.Value,
+			MatchType: controlplane.HTTPMatchType(param.MatchType),
+		})
+	}
+	return antreaHTTP
+}
+
+// toAntreaL7DenyResponseForCRD converts a v1beta1.L7DenyResponse object to an
+// Antrea L7DenyResponse object.
+func toAntreaL7DenyResponseForCRD(denyResponse *crdv1beta1.L7DenyResponse) *controlplane.L7DenyResponse {
+	if denyResponse == nil {
+		return nil
+	}
+	antreaDenyResponse := &controlplane.L7DenyResponse{}
+	if denyResponse.HTTP != nil {
+		antreaDenyResponse.HTTP = &controlplane.HTTPDenyResponse{Body: denyResponse.HTTP.Body}
+	}
+	if denyResponse.TLS != nil {
+		alert := controlplane.TLSAlert(denyResponse.TLS.Alert)
+		if alert == "" {
+			alert = controlplane.TLSAlertAccessDenied
+		}
+		antreaDenyResponse.TLS = &controlplane.TLSDenyResponse{Alert: alert}
+	}
+	return antreaDenyResponse
+}
+
+// toAntreaRateLimitForCRD converts a v1beta1.RuleRateLimit object to an
+// Antrea RuleRateLimit object.
+func toAntreaRateLimitForCRD(rateLimit *crdv1beta1.RuleRateLimit) *controlplane.RuleRateLimit {
+	if rateLimit == nil {
+		return nil
+	}
+	return &controlplane.RuleRateLimit{
+		PacketsPerSecond:        rateLimit.PacketsPerSecond,
+		NewConnectionsPerSecond: rateLimit.NewConnectionsPerSecond,
+		Burst:                   rateLimit.Burst,
+	}
+}
+
 // toAntreaIPBlockForCRD converts a crdv1beta1.IPBlock to an Antrea IPBlock.
 func toAntreaIPBlockForCRD(ipBlock *crdv1beta1.IPBlock) (*controlplane.IPBlock, error) {
 	// Convert the allowed IPBlock to networkpolicy.IPNet.
@@ -201,7 +270,9 @@ func (n *NetworkPolicyController) toAntreaPeerForCRD(peers []crdv1beta1.NetworkP
 			addressGroup := n.createAddressGroup(np.GetNamespace(), peer.PodSelector, peer.NamespaceSelector, peer.ExternalEntitySelector, nil)
 			addressGroups = append(addressGroups, addressGroup)
 		}
-		if n.stretchNPEnabled && peer.Scope == crdv1beta1.ScopeClusterSet {
+		// ClusterSet scoped egress peers are enforced by the member clusters of the destination
+		// Pods, see toStretchedEgressRuleForCRD.
+		if n.stretchNPEnabled && peer.Scope == crdv1beta1.ScopeClusterSet && dir == controlplane.DirectionIn {
 			newClusterSetScopeSelector := antreatypes.NewGroupSelector(np.GetNamespace(), peer.PodSelector, peer.NamespaceSelector, nil, nil)
 			clusterSetScopeSelectorKeys.Insert(newClusterSetScopeSelector.NormalizedName)
 			// In addition to getting the matched Label Identity IDs, AddSelector also registers the selector
@@ -254,6 +325,68 @@ func (n *NetworkPolicyController) toNamespacedPeerForCRD(peers []crdv1beta1.Netw
 	}, addressGroups, clusterSetScopeSelectorKeys
 }
 
+// toStretchedEgressRuleForCRD creates an ingress controlplane NetworkPolicyRule for a crdv1beta1 egress
+// rule with ClusterSet scoped peers. The destination Pods in other member clusters are unknown to
+// the source Node, while the label identity of the source Pod is carried in the tunnel metadata of
+// cross-cluster traffic. Hence such a rule is enforced on the destination Nodes instead: the returned
+// rule is applied to the local Pods selected by the ClusterSet scoped peers, and matches the traffic
+// from the label identities of the workloads selected by appliedTos. It returns a nil rule if no label
+// identity is matched yet or the rule has no ClusterSet scoped peer. The ClusterSet scoped selectors
+// will trigger the re-processing of the policy when a matched label identity is added.
+func (n *NetworkPolicyController) toStretchedEgressRuleForCRD(rule *crdv1beta1.Rule, appliedTos []crdv1beta1.AppliedTo,
+	np metav1.Object, services []controlplane.Service, priority int32) (*controlplane.NetworkPolicyRule, []*antreatypes.AppliedToGroup, sets.Set[string]) {
+	var peerAppliedTos []crdv1beta1.AppliedTo
+	for _, peer := range rule.To {
+		if peer.Scope == crdv1beta1.ScopeClusterSet {
+			peerAppliedTos = append(peerAppliedTos, crdv1beta1.AppliedTo{PodSelector: peer.PodSelector, NamespaceSelector: peer.NamespaceSelector})
+		}
+	}
+	if len(peerAppliedTos) == 0 {
+		return nil, nil, nil
+	}
+	clusterSetScopeSelectorKeys := sets.New[string]()
+	labelIdentities := sets.New[uint32]()
+	for _, at := range appliedTos {
+		newClusterSetScopeSelector := antreatypes.NewGroupSelector(np.GetNamespace(), at.PodSelector, at.NamespaceSelector, nil, nil)
+		clusterSetScopeSelectorKeys.Insert(newClusterSetScopeSelector.NormalizedName)
+		// In addition to getting the matched Label Identity IDs, AddSelector also registers the selector
+		// with the labelIdentityInterface.
+		labelIdentities.Insert(n.labelIdentityInterface.AddSelector(newClusterSetScopeSelector, internalNetworkPolicyKeyFunc(np))...)
+	}
+	if labelIdentities.Len() == 0 {
+		return nil, nil, clusterSetScopeSelectorKeys
+	}
+	var atgs []*antreatypes.AppliedToGroup
+	if np.GetNamespace() == "" {
+		atgs = n.processClusterAppliedTo(peerAppliedTos)
+	} else {
+		atgs = n.processAppliedTo(np.GetNamespace(), peerAppliedTos)
+	}
+	return &controlplane.NetworkPolicyRule{
+		Direction:       controlplane.DirectionIn,
+		From:            controlplane.NetworkPolicyPeer{LabelIdentities: sets.List(labelIdentities)},
+		Services:        services,
+		Name:            rule.Name,
+		Action:          rule.Action,
+		Priority:        priority,
+		EnableLogging:   rule.EnableLogging,
+		AppliedToGroups: getAppliedToGroupNames(atgs),
+		LogLabel:        rule.LogLabel,
+	}, atgs, clusterSetScopeSelectorKeys
+}
+
+// hasStretchedEgressRule returns true if any of the egress rules has a ClusterSet scoped peer.
+func hasStretchedEgressRule(egress []crdv1beta1.Rule) bool {
+	for _, rule := range egress {
+		for _, peer := range rule.To {
+			if peer.Scope == crdv1beta1.ScopeClusterSet {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 // svcRefToPeerForCRD creates an Antrea controlplane NetworkPolicyPeer from ServiceReferences in ToServices
 // or ToMulticlusterServices field of a crdv1beta1 NetworkPolicyPeer. For ANNP NetworkPolicyPeers, if
 // Namespace is not provided in the ServiceReference, the policy's Namespace will be assumed.
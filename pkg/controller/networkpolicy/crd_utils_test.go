@@ -226,6 +226,24 @@ func TestToAntreaL7ProtocolsForCRD(t *testing.T) {
 				{TLS: &controlplane.TLSProtocol{SNI: "test.com"}},
 			},
 		},
+		{
+			[]crdv1beta1.L7Protocol{
+				{HTTP: &crdv1beta1.HTTPProtocol{
+					Path:          "/api/v[0-9]+/tenants",
+					PathMatchType: crdv1beta1.HTTPMatchTypeRegex,
+					Headers:       []crdv1beta1.HTTPHeaderMatch{{Name: "X-Tenant-ID", Value: "foo"}},
+					QueryParams:   []crdv1beta1.HTTPQueryParamMatch{{Name: "version", Value: "v2", MatchType: crdv1beta1.HTTPMatchTypePrefix}},
+				}},
+			},
+			[]controlplane.L7Protocol{
+				{HTTP: &controlplane.HTTPProtocol{
+					Path:          "/api/v[0-9]+/tenants",
+					PathMatchType: controlplane.HTTPMatchTypeRegex,
+					Headers:       []controlplane.HTTPHeaderMatch{{Name: "X-Tenant-ID", Value: "foo"}},
+					QueryParams:   []controlplane.HTTPQueryParamMatch{{Name: "version", Value: "v2", MatchType: controlplane.HTTPMatchTypePrefix}},
+				}},
+			},
+		},
 	}
 	for _, table := range tables {
 		gotValue := toAntreaL7ProtocolsForCRD(table.l7Protocol)
@@ -233,6 +251,44 @@ func TestToAntreaL7ProtocolsForCRD(t *testing.T) {
 	}
 }
 
+func TestToAntreaL7DenyResponseForCRD(t *testing.T) {
+	tests := []struct {
+		name         string
+		denyResponse *crdv1beta1.L7DenyResponse
+		expected     *controlplane.L7DenyResponse
+	}{
+		{
+			name: "nil",
+		},
+		{
+			name:         "HTTP response",
+			denyResponse: &crdv1beta1.L7DenyResponse{HTTP: &crdv1beta1.HTTPDenyResponse{Body: "denied"}},
+			expected:     &controlplane.L7DenyResponse{HTTP: &controlplane.HTTPDenyResponse{Body: "denied"}},
+		},
+		{
+			name:         "TLS alert defaults to AccessDenied",
+			denyResponse: &crdv1beta1.L7DenyResponse{TLS: &crdv1beta1.TLSDenyResponse{}},
+			expected:     &controlplane.L7DenyResponse{TLS: &controlplane.TLSDenyResponse{Alert: controlplane.TLSAlertAccessDenied}},
+		},
+		{
+			name: "HTTP response and TLS alert",
+			denyResponse: &crdv1beta1.L7DenyResponse{
+				HTTP: &crdv1beta1.HTTPDenyResponse{},
+				TLS:  &crdv1beta1.TLSDenyResponse{Alert: crdv1beta1.TLSAlertUnrecognizedName},
+			},
+			expected: &controlplane.L7DenyResponse{
+				HTTP: &controlplane.HTTPDenyResponse{},
+				TLS:  &controlplane.TLSDenyResponse{Alert: controlplane.TLSAlertUnrecognizedName},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, toAntreaL7DenyResponseForCRD(tt.denyResponse))
+		})
+	}
+}
+
 func TestToAntreaIPBlockForCRD(t *testing.T) {
 	expIPNet := controlplane.IPNet{
 		IP:           ipStrToIPAddress("10.0.0.0"),
@@ -469,6 +525,22 @@ func TestToAntreaPeerForCRD(t *testing.T) {
 			direction:       controlplane.DirectionIn,
 			clusterSetScope: true,
 		},
+		{
+			name: "stretched-policy-peer-egress",
+			inPeers: []crdv1beta1.NetworkPolicyPeer{
+				{
+					PodSelector: &selectorA,
+					Scope:       crdv1beta1.ScopeClusterSet,
+				},
+			},
+			outPeer: controlplane.NetworkPolicyPeer{
+				AddressGroups: []string{
+					getNormalizedUID(antreatypes.NewGroupSelector("", &selectorA, nil, nil, nil).NormalizedName),
+				},
+			},
+			direction:       controlplane.DirectionOut,
+			clusterSetScope: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -36,13 +36,16 @@ import (
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/wait"
 	coreinformers "k8s.io/client-go/informers/core/v1"
+	discoveryinformers "k8s.io/client-go/informers/discovery/v1"
 	networkinginformers "k8s.io/client-go/informers/networking/v1"
 	clientset "k8s.io/client-go/kubernetes"
 	corelisters "k8s.io/client-go/listers/core/v1"
+	discoverylisters "k8s.io/client-go/listers/discovery/v1"
 	networkinglisters "k8s.io/client-go/listers/networking/v1"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/util/workqueue"
 	"k8s.io/klog/v2"
+	"k8s.io/utils/clock"
 	policyinformers "sigs.k8s.io/network-policy-api/pkg/client/informers/externalversions/apis/v1alpha1"
 	policylisters "sigs.k8s.io/network-policy-api/pkg/client/listers/apis/v1alpha1"
 
@@ -85,6 +88,9 @@ const (
 	ClusterGroupIndex = "clustergroup"
 	// GroupIndex is used to index Antrea NetworkPolicies by Group names.
 	GroupIndex = "group"
+	// ServiceIndex is used to index Antrea-native policies by the Services referred to in the toServices field of
+	// their rules.
+	ServiceIndex = "service"
 
 	// EnableNPLoggingAnnotationKey can be added to Namespace to enable logging K8s NP.
 	EnableNPLoggingAnnotationKey = "networkpolicy.antrea.io/enable-logging"
@@ -157,6 +163,14 @@ type NetworkPolicyController struct {
 	// serviceListerSynced is a function which returns true if the Service shared informer has been synced at least once.
 	serviceListerSynced cache.InformerSynced
 
+	// endpointSliceLister is able to list/get EndpointSlices and is populated by the shared informer passed to
+	// NewNetworkPolicyController. It is used to resolve the headless and selectorless Services referred to in
+	// toServices.
+	endpointSliceLister discoverylisters.EndpointSliceLister
+	// endpointSliceListerSynced is a function which returns true if the EndpointSlice shared informer has been synced
+	// at least once.
+	endpointSliceListerSynced cache.InformerSynced
+
 	networkPolicyInformer networkinginformers.NetworkPolicyInformer
 	// networkPolicyLister is able to list/get Network Policies and is populated by the shared informer passed to
 	// NewNetworkPolicyController.
@@ -261,6 +275,9 @@ type NetworkPolicyController struct {
 	// Enable Stretched Networkpolicy feature which allows Antrea-native policies to select peer
 	// from other clusters in a ClusterSet.
 	stretchNPEnabled bool
+	// clock is used to evaluate the schedules of Antrea-native policy rules. Added as a member to the struct to
+	// allow injection for testing.
+	clock clock.Clock
 	// heartbeatCh is an internal channel for testing. It's used to know whether all tasks have been
 	// processed, and to count executions of each function.
 	heartbeatCh chan heartbeat
@@ -339,6 +356,13 @@ var acnpIndexers = cache.Indexers{
 		}
 		return []string{}, nil
 	},
+	ServiceIndex: func(obj interface{}) ([]string, error) {
+		acnp, ok := obj.(*secv1beta1.ClusterNetworkPolicy)
+		if !ok {
+			return []string{}, nil
+		}
+		return servicesReferredInRules(acnp.Spec.Egress, ""), nil
+	},
 }
 
 var annpIndexers = cache.Indexers{
@@ -389,6 +413,13 @@ var annpIndexers = cache.Indexers{
 		}
 		return sets.List(groupNames), nil
 	},
+	ServiceIndex: func(obj interface{}) ([]string, error) {
+		annp, ok := obj.(*secv1beta1.NetworkPolicy)
+		if !ok {
+			return []string{}, nil
+		}
+		return servicesReferredInRules(annp.Spec.Egress, annp.Namespace), nil
+	},
 }
 
 // NewNetworkPolicyController returns a new *NetworkPolicyController.
@@ -398,6 +429,7 @@ func NewNetworkPolicyController(kubeClient clientset.Interface,
 	labelIdentityInterface labelidentity.Interface,
 	namespaceInformer coreinformers.NamespaceInformer,
 	serviceInformer coreinformers.ServiceInformer,
+	endpointSliceInformer discoveryinformers.EndpointSliceInformer,
 	networkPolicyInformer networkinginformers.NetworkPolicyInformer,
 	nodeInformer coreinformers.NodeInformer,
 	acnpInformer crdv1b1informers.ClusterNetworkPolicyInformer,
@@ -437,6 +469,7 @@ func NewNetworkPolicyController(kubeClient clientset.Interface,
 		labelIdentityInterface:         labelIdentityInterface,
 		stretchNPEnabled:               stretchedNPEnabled,
 		appliedToGroupNotifier:         newNotifier(),
+		clock:                          clock.RealClock{},
 	}
 	n.groupingInterface.AddEventHandler(appliedToGroupType, n.enqueueAppliedToGroup)
 	n.groupingInterface.AddEventHandler(addressGroupType, n.enqueueAddressGroup)
@@ -477,6 +510,8 @@ func NewNetworkPolicyController(kubeClient clientset.Interface,
 		n.serviceInformer = serviceInformer
 		n.serviceLister = serviceInformer.Lister()
 		n.serviceListerSynced = serviceInformer.Informer().HasSynced
+		n.endpointSliceLister = endpointSliceInformer.Lister()
+		n.endpointSliceListerSynced = endpointSliceInformer.Informer().HasSynced
 		n.nodeInformer = nodeInformer
 		n.nodeLister = nodeInformer.Lister()
 		n.nodeListerSynced = nodeInformer.Informer().HasSynced
@@ -512,6 +547,14 @@ func NewNetworkPolicyController(kubeClient clientset.Interface,
 			},
 			resyncPeriod,
 		)
+		endpointSliceInformer.Informer().AddEventHandlerWithResyncPeriod(
+			cache.ResourceEventHandlerFuncs{
+				AddFunc:    n.addEndpointSlice,
+				UpdateFunc: n.updateEndpointSlice,
+				DeleteFunc: n.deleteEndpointSlice,
+			},
+			resyncPeriod,
+		)
 		// Add handlers for Node events.
 		nodeInformer.Informer().AddEventHandlerWithResyncPeriod(
 			cache.ResourceEventHandlerFuncs{
@@ -851,10 +894,15 @@ func (n *NetworkPolicyController) deleteNetworkPolicy(old interface{}) {
 
 // addService retrieves all internal Groups which refers to this Service
 // and enqueues the group keys for further processing.
+// It also triggers processing of the Antrea-native policies which refer to
+// this Service in toServices if it is resolved to its external name or Endpoints.
 func (n *NetworkPolicyController) addService(obj interface{}) {
 	defer n.heartbeat("addService")
 	service := obj.(*v1.Service)
 	klog.V(2).Infof("Processing Service %s/%s ADD event", service.Namespace, service.Name)
+	if serviceNeedsResolution(service) {
+		n.triggerPolicyUpdatesForService(service.Namespace, service.Name)
+	}
 	// Find all internal Group keys which refers to this Service.
 	groupKeySet := n.filterInternalGroupsForService(service)
 	// Enqueue internal groups to its queue for group processing.
@@ -865,11 +913,16 @@ func (n *NetworkPolicyController) addService(obj interface{}) {
 
 // updatePod retrieves all internal Groups which refers to this Service
 // and enqueues the group keys for further processing.
+// It also triggers processing of the Antrea-native policies which refer to
+// this Service in toServices if it is resolved to its external name or Endpoints.
 func (n *NetworkPolicyController) updateService(oldObj, curObj interface{}) {
 	defer n.heartbeat("updateService")
 	oldService := oldObj.(*v1.Service)
 	curService := curObj.(*v1.Service)
 	klog.V(2).Infof("Processing Service %s/%s UPDATE event, selectors: %v", curService.Namespace, curService.Name, curService.Spec.Selector)
+	if serviceResolutionChanged(oldService, curService) {
+		n.triggerPolicyUpdatesForService(curService.Namespace, curService.Name)
+	}
 	// No need to trigger processing of groups if there is no change in the Service selectors.
 	if reflect.DeepEqual(oldService.Spec.Selector, curService.Spec.Selector) {
 		klog.V(4).Infof("No change in Service %s/%s. Skipping group evaluation.", curService.Namespace, curService.Name)
@@ -885,6 +938,8 @@ func (n *NetworkPolicyController) updateService(oldObj, curObj interface{}) {
 
 // deleteService retrieves all internal Groups which refers to this Service
 // and enqueues the group keys for further processing.
+// It also triggers processing of the Antrea-native policies which refer to
+// this Service in toServices if it is resolved to its external name or Endpoints.
 func (n *NetworkPolicyController) deleteService(old interface{}) {
 	service, ok := old.(*v1.Service)
 	if !ok {
@@ -902,6 +957,9 @@ func (n *NetworkPolicyController) deleteService(old interface{}) {
 	defer n.heartbeat("deleteService")
 
 	klog.V(2).Infof("Processing Service %s/%s DELETE event", service.Namespace, service.Name)
+	if serviceNeedsResolution(service) {
+		n.triggerPolicyUpdatesForService(service.Namespace, service.Name)
+	}
 	// Find all internal Group keys which refers to this Service.
 	groupKeySet := n.filterInternalGroupsForService(service)
 	// Enqueue internal groups to its queue for group processing.
@@ -943,7 +1001,7 @@ func (n *NetworkPolicyController) Run(stopCh <-chan struct{}) {
 	cacheSyncs := []cache.InformerSynced{n.networkPolicyListerSynced, n.groupingInterfaceSynced}
 	// Only wait for acnpListerSynced and annpListerSynced when AntreaPolicy feature gate is enabled.
 	if features.DefaultFeatureGate.Enabled(features.AntreaPolicy) {
-		cacheSyncs = append(cacheSyncs, n.acnpListerSynced, n.annpListerSynced, n.cgListerSynced)
+		cacheSyncs = append(cacheSyncs, n.acnpListerSynced, n.annpListerSynced, n.cgListerSynced, n.serviceListerSynced, n.endpointSliceListerSynced)
 	}
 	if !cache.WaitForNamedCacheSync(controllerName, stopCh, cacheSyncs...) {
 		return
@@ -1514,6 +1572,11 @@ func (n *NetworkPolicyController) syncInternalNetworkPolicy(key *controlplane.Ne
 		newInternalNetworkPolicy, newAppliedToGroups, newAddressGroups = n.processBaselineAdminNetworkPolicy(banp)
 	}
 
+	// Re-process the NetworkPolicy when one of its rules is activated or deactivated by its schedule.
+	if newInternalNetworkPolicy.NextScheduleTransitionTime != nil {
+		n.internalNetworkPolicyQueue.AddAfter(*key, newInternalNetworkPolicy.NextScheduleTransitionTime.Sub(n.clock.Now()))
+	}
+
 	// The NetworkPolicy must subscribe to the updates of AppliedToGroups before calculating span based on them,
 	// otherwise the calculated span may be outdated as AppliedToGroups can be updated concurrently and the
 	// NetworkPolicy wouldn't be notified.
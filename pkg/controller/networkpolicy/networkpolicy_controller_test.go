@@ -41,6 +41,7 @@ import (
 	k8stesting "k8s.io/client-go/testing"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/clock"
 	fakepolicyversioned "sigs.k8s.io/network-policy-api/pkg/client/clientset/versioned/fake"
 	policyv1a1informers "sigs.k8s.io/network-policy-api/pkg/client/informers/externalversions"
 
@@ -135,6 +136,7 @@ func newController(k8sObjects, crdObjects []runtime.Object) (*fake.Clientset, *n
 		labelIndex,
 		informerFactory.Core().V1().Namespaces(),
 		informerFactory.Core().V1().Services(),
+		informerFactory.Discovery().V1().EndpointSlices(),
 		informerFactory.Networking().V1().NetworkPolicies(),
 		informerFactory.Core().V1().Nodes(),
 		crdInformerFactory.Crd().V1beta1().ClusterNetworkPolicies(),
@@ -160,6 +162,8 @@ func newController(k8sObjects, crdObjects []runtime.Object) (*fake.Clientset, *n
 	npController.cgListerSynced = alwaysReady
 	npController.serviceLister = informerFactory.Core().V1().Services().Lister()
 	npController.serviceListerSynced = alwaysReady
+	npController.endpointSliceLister = informerFactory.Discovery().V1().EndpointSlices().Lister()
+	npController.endpointSliceListerSynced = alwaysReady
 	return client, &networkPolicyController{
 		npController,
 		informerFactory.Core().V1().Namespaces().Informer().GetStore(),
@@ -209,6 +213,8 @@ func newControllerWithoutEventHandler(k8sObjects, crdObjects []runtime.Object) (
 		kubeClient:                 client,
 		crdClient:                  crdClient,
 		namespaceLister:            namespaceInformer.Lister(),
+		serviceLister:              informerFactory.Core().V1().Services().Lister(),
+		endpointSliceLister:        informerFactory.Discovery().V1().EndpointSlices().Lister(),
 		networkPolicyInformer:      networkPolicyInformer,
 		networkPolicyLister:        networkPolicyInformer.Lister(),
 		networkPolicyListerSynced:  networkPolicyInformer.Informer().HasSynced,
@@ -234,6 +240,7 @@ func newControllerWithoutEventHandler(k8sObjects, crdObjects []runtime.Object) (
 		internalGroupQueue:         workqueue.NewNamedRateLimitingQueue(workqueue.NewItemExponentialFailureRateLimiter(minRetryDelay, maxRetryDelay), "internalGroup"),
 		groupingInterface:          groupEntityIndex,
 		appliedToGroupNotifier:     newNotifier(),
+		clock:                      clock.RealClock{},
 	}
 	npController.tierInformer.Informer().AddIndexers(tierIndexers)
 	npController.acnpInformer.Informer().AddIndexers(acnpIndexers)
@@ -0,0 +1,487 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkpolicy
+
+import (
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"antrea.io/antrea/pkg/apis/controlplane"
+	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
+	antreatypes "antrea.io/antrea/pkg/controller/types"
+)
+
+// PolicyAnalysisFindingType is the type of issue found by the analysis of the rules of Antrea-native policies.
+type PolicyAnalysisFindingType string
+
+const (
+	// PolicyAnalysisShadowed means a rule can never be matched because a rule with a higher precedence and a
+	// different action matches all its traffic.
+	PolicyAnalysisShadowed PolicyAnalysisFindingType = "Shadowed"
+	// PolicyAnalysisRedundant means a rule can never be matched because a rule with a higher precedence and the
+	// same action matches all its traffic. Deleting the rule doesn't change the behavior of the policies.
+	PolicyAnalysisRedundant PolicyAnalysisFindingType = "Redundant"
+	// PolicyAnalysisConflict means a rule may allow traffic which is dropped or rejected by a rule of another policy
+	// with the same Tier priority and the same policy priority, in which case which action is applied is undefined.
+	// The conflict is only potential: the rules apply to a common group and have overlapping peers and ports, but the
+	// group may not select any member.
+	PolicyAnalysisConflict PolicyAnalysisFindingType = "Conflict"
+)
+
+// PolicyAnalysisQuerier handles requests for antctl get policyanalysis.
+type PolicyAnalysisQuerier interface {
+	// AnalyzePolicies returns the issues found in the rules of Antrea-native policies.
+	AnalyzePolicies() []PolicyAnalysisFinding
+}
+
+// PolicyRuleReference identifies a rule of an Antrea-native policy.
+type PolicyRuleReference struct {
+	// Policy is the reference of the original policy, in the same format as in the audit logs, e.g.
+	// "AntreaClusterNetworkPolicy:acnp1".
+	Policy    string                 `json:"policy"`
+	Rule      string                 `json:"rule"`
+	Direction controlplane.Direction `json:"direction"`
+	// policyKey is the name of the internal NetworkPolicy, used to update the status of the original policy.
+	policyKey string
+}
+
+// PolicyAnalysisFinding describes an issue found in a rule of an Antrea-native policy.
+type PolicyAnalysisFinding struct {
+	Type PolicyAnalysisFindingType `json:"type"`
+	// Rule is the rule which is shadowed, redundant or conflicting.
+	Rule PolicyRuleReference `json:"rule"`
+	// OtherRule is the rule which shadows, makes redundant, or conflicts with Rule.
+	OtherRule PolicyRuleReference `json:"otherRule"`
+}
+
+// analyzedRule is a rule of an Antrea-native policy along with the information required to compare it with other
+// rules.
+type analyzedRule struct {
+	policy *antreatypes.NetworkPolicy
+	rule   *controlplane.NetworkPolicyRule
+	// appliedToGroups are the effective AppliedToGroups of the rule, which are either set in the rule or in the policy.
+	appliedToGroups sets.Set[string]
+	peer            *controlplane.NetworkPolicyPeer
+}
+
+func (r *analyzedRule) reference() PolicyRuleReference {
+	return PolicyRuleReference{
+		Policy:    r.policy.SourceRef.ToString(),
+		Rule:      r.rule.Name,
+		Direction: r.rule.Direction,
+		policyKey: r.policy.Name,
+	}
+}
+
+// precedes returns whether the rule is always evaluated before the other rule. Rules of different policies with the
+// same Tier priority and the same policy priority are not ordered.
+func (r *analyzedRule) precedes(other *analyzedRule) bool {
+	if *r.policy.TierPriority != *other.policy.TierPriority {
+		return *r.policy.TierPriority < *other.policy.TierPriority
+	}
+	if *r.policy.Priority != *other.policy.Priority {
+		return *r.policy.Priority < *other.policy.Priority
+	}
+	return r.policy.Name == other.policy.Name && r.rule.Priority < other.rule.Priority
+}
+
+// AnalyzePolicies implements PolicyAnalysisQuerier.
+func (c *StatusController) AnalyzePolicies() []PolicyAnalysisFinding {
+	var policies []*antreatypes.NetworkPolicy
+	for _, obj := range c.internalNetworkPolicyStore.List() {
+		policies = append(policies, obj.(*antreatypes.NetworkPolicy))
+	}
+	return analyzePolicies(policies)
+}
+
+// analyzePolicies finds the shadowed, redundant and conflicting rules of the provided Antrea-native policies. The
+// analysis only relies on the definition of the rules and not on the members of the groups: a rule is considered to
+// cover another rule only if it's applied to the same groups or more, and if its peers and ports include the other
+// rule's ones. This means some issues may not be found, but the shadowed and redundant rules found are certain. The
+// conflicts found are potential, as they only occur if the groups common to both rules have members.
+// Policies in Audit mode, and rules with layer 7 protocols are ignored, as their traffic is not enforced in the same
+// way as other rules.
+func analyzePolicies(policies []*antreatypes.NetworkPolicy) []PolicyAnalysisFinding {
+	var ingressRules, egressRules []*analyzedRule
+	for _, policy := range policies {
+		if !controlplane.IsSourceAntreaNativePolicy(policy.SourceRef) || policy.SyncError != nil ||
+			policy.EnforcementMode == crdv1beta1.PolicyEnforcementModeAudit ||
+			policy.TierPriority == nil || policy.Priority == nil {
+			continue
+		}
+		for i := range policy.Rules {
+			rule := &policy.Rules[i]
+			if len(rule.L7Protocols) > 0 {
+				continue
+			}
+			r := &analyzedRule{policy: policy, rule: rule}
+			if policy.AppliedToPerRule {
+				r.appliedToGroups = sets.New[string](rule.AppliedToGroups...)
+			} else {
+				r.appliedToGroups = sets.New[string](policy.AppliedToGroups...)
+			}
+			if rule.Direction == controlplane.DirectionIn {
+				r.peer = &rule.From
+				ingressRules = append(ingressRules, r)
+			} else {
+				r.peer = &rule.To
+				egressRules = append(egressRules, r)
+			}
+		}
+	}
+	findings := analyzeRules(ingressRules)
+	findings = append(findings, analyzeRules(egressRules)...)
+	return findings
+}
+
+// analyzeRules compares each rule with the rules evaluated before it, and with the rules which are not ordered
+// relatively to it. All the rules must have the same direction.
+func analyzeRules(rules []*analyzedRule) []PolicyAnalysisFinding {
+	sort.SliceStable(rules, func(i, j int) bool {
+		if *rules[i].policy.TierPriority != *rules[j].policy.TierPriority {
+			return *rules[i].policy.TierPriority < *rules[j].policy.TierPriority
+		}
+		if *rules[i].policy.Priority != *rules[j].policy.Priority {
+			return *rules[i].policy.Priority < *rules[j].policy.Priority
+		}
+		if rules[i].policy.Name != rules[j].policy.Name {
+			return rules[i].policy.Name < rules[j].policy.Name
+		}
+		return rules[i].rule.Priority < rules[j].rule.Priority
+	})
+	var findings []PolicyAnalysisFinding
+	for i, rule := range rules {
+		for j := 0; j < i; j++ {
+			other := rules[j]
+			if other.precedes(rule) {
+				if ruleCovers(other, rule) {
+					findingType := PolicyAnalysisShadowed
+					if ruleAction(other) == ruleAction(rule) {
+						findingType = PolicyAnalysisRedundant
+					}
+					findings = append(findings, PolicyAnalysisFinding{Type: findingType, Rule: rule.reference(), OtherRule: other.reference()})
+					// The rule is reported once, for the first rule covering it.
+					break
+				}
+			} else if other.policy.Name != rule.policy.Name && actionsConflict(ruleAction(other), ruleAction(rule)) && rulesOverlap(other, rule) {
+				findings = append(findings, PolicyAnalysisFinding{Type: PolicyAnalysisConflict, Rule: rule.reference(), OtherRule: other.reference()})
+			}
+		}
+	}
+	return findings
+}
+
+func ruleAction(r *analyzedRule) crdv1beta1.RuleAction {
+	if r.rule.Action == nil {
+		return crdv1beta1.RuleActionAllow
+	}
+	return *r.rule.Action
+}
+
+func actionsConflict(a1, a2 crdv1beta1.RuleAction) bool {
+	isDeny := func(a crdv1beta1.RuleAction) bool {
+		return a == crdv1beta1.RuleActionDrop || a == crdv1beta1.RuleActionReject
+	}
+	return (a1 == crdv1beta1.RuleActionAllow && isDeny(a2)) || (isDeny(a1) && a2 == crdv1beta1.RuleActionAllow)
+}
+
+// ruleCovers returns whether all the traffic matched by rule r2 is matched by rule r1.
+func ruleCovers(r1, r2 *analyzedRule) bool {
+	// A Pass rule skips the rules of the other Tiers, but the rules of the baseline Tier are still evaluated.
+	if ruleAction(r1) == crdv1beta1.RuleActionPass && *r2.policy.TierPriority == BaselineTierPriority &&
+		*r1.policy.TierPriority != BaselineTierPriority {
+		return false
+	}
+	if len(r2.appliedToGroups) == 0 || !r1.appliedToGroups.IsSuperset(r2.appliedToGroups) {
+		return false
+	}
+	return peerCovers(r1.peer, r2.peer) && servicesCover(r1.rule.Services, r2.rule.Services)
+}
+
+// rulesOverlap returns whether both rules apply to a common group and have overlapping peers and ports, in which case
+// the traffic of the members of this group, if any, may be matched by both rules.
+func rulesOverlap(r1, r2 *analyzedRule) bool {
+	if !r1.appliedToGroups.HasAny(sets.List(r2.appliedToGroups)...) {
+		return false
+	}
+	return peersOverlap(r1.peer, r2.peer) && servicesOverlap(r1.rule.Services, r2.rule.Services)
+}
+
+// isMatchAllPeer returns whether the peer matches all IPv4 and IPv6 addresses, which is the case of empty peers.
+func isMatchAllPeer(peer *controlplane.NetworkPolicyPeer) bool {
+	var matchAllIPv4, matchAllIPv6 bool
+	for _, ipBlock := range peer.IPBlocks {
+		if ipBlock.CIDR.PrefixLength != 0 || len(ipBlock.Except) > 0 {
+			continue
+		}
+		if net.IP(ipBlock.CIDR.IP).To4() != nil {
+			matchAllIPv4 = true
+		} else {
+			matchAllIPv6 = true
+		}
+	}
+	return matchAllIPv4 && matchAllIPv6
+}
+
+func peerCovers(p1, p2 *controlplane.NetworkPolicyPeer) bool {
+	if isMatchAllPeer(p1) {
+		return true
+	}
+	if !sets.New[string](p1.AddressGroups...).HasAll(p2.AddressGroups...) ||
+		!sets.New[string](p1.FQDNs...).HasAll(p2.FQDNs...) ||
+		!sets.New[uint32](p1.LabelIdentities...).HasAll(p2.LabelIdentities...) {
+		return false
+	}
+	for _, svc := range p2.ToServices {
+		found := false
+		for _, svc1 := range p1.ToServices {
+			if svc1 == svc {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	for i := range p2.IPBlocks {
+		found := false
+		for j := range p1.IPBlocks {
+			if ipBlockCovers(&p1.IPBlocks[j], &p2.IPBlocks[i]) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+func peersOverlap(p1, p2 *controlplane.NetworkPolicyPeer) bool {
+	if isMatchAllPeer(p1) || isMatchAllPeer(p2) {
+		return true
+	}
+	if sets.New[string](p1.AddressGroups...).HasAny(p2.AddressGroups...) ||
+		sets.New[string](p1.FQDNs...).HasAny(p2.FQDNs...) ||
+		sets.New[uint32](p1.LabelIdentities...).HasAny(p2.LabelIdentities...) {
+		return true
+	}
+	for _, svc1 := range p1.ToServices {
+		for _, svc2 := range p2.ToServices {
+			if svc1 == svc2 {
+				return true
+			}
+		}
+	}
+	for i := range p1.IPBlocks {
+		for j := range p2.IPBlocks {
+			// The IPBlock with the larger CIDR must have no exception for the overlap to be certain.
+			if ipBlockCovers(&p1.IPBlocks[i], &p2.IPBlocks[j]) || ipBlockCovers(&p2.IPBlocks[j], &p1.IPBlocks[i]) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// ipBlockCovers returns whether all the addresses of IPBlock b2 are included in IPBlock b1.
+func ipBlockCovers(b1, b2 *controlplane.IPBlock) bool {
+	if len(b1.Except) > 0 {
+		return false
+	}
+	cidr1 := ipNetToNet(&b1.CIDR)
+	cidr2 := ipNetToNet(&b2.CIDR)
+	if len(cidr1.IP) != len(cidr2.IP) {
+		return false
+	}
+	prefixLength1, _ := cidr1.Mask.Size()
+	prefixLength2, _ := cidr2.Mask.Size()
+	return prefixLength1 <= prefixLength2 && cidr1.Contains(cidr2.IP)
+}
+
+func ipNetToNet(ipNet *controlplane.IPNet) *net.IPNet {
+	ip := net.IP(ipNet.IP)
+	bits := 128
+	if ip.To4() != nil {
+		ip = ip.To4()
+		bits = 32
+	}
+	return &net.IPNet{IP: ip, Mask: net.CIDRMask(int(ipNet.PrefixLength), bits)}
+}
+
+func servicesCover(services1, services2 []controlplane.Service) bool {
+	// An empty list of Services matches all traffic.
+	if len(services1) == 0 {
+		return true
+	}
+	if len(services2) == 0 {
+		return false
+	}
+	for i := range services2 {
+		found := false
+		for j := range services1 {
+			if serviceCovers(&services1[j], &services2[i]) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+func servicesOverlap(services1, services2 []controlplane.Service) bool {
+	if len(services1) == 0 || len(services2) == 0 {
+		return true
+	}
+	for i := range services1 {
+		for j := range services2 {
+			if serviceCovers(&services1[i], &services2[j]) || serviceCovers(&services2[j], &services1[i]) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func serviceProtocol(s *controlplane.Service) controlplane.Protocol {
+	if s.Protocol == nil {
+		return controlplane.ProtocolTCP
+	}
+	return *s.Protocol
+}
+
+// serviceCovers returns whether all the traffic matched by Service s2 is matched by Service s1.
+func serviceCovers(s1, s2 *controlplane.Service) bool {
+	if serviceProtocol(s1) != serviceProtocol(s2) {
+		return false
+	}
+	switch serviceProtocol(s1) {
+	case controlplane.ProtocolICMP:
+		return int32PtrCovers(s1.ICMPType, s2.ICMPType) && (s1.ICMPType == nil || int32PtrCovers(s1.ICMPCode, s2.ICMPCode))
+	case controlplane.ProtocolIGMP:
+		return int32PtrCovers(s1.IGMPType, s2.IGMPType) && (s1.GroupAddress == "" || s1.GroupAddress == s2.GroupAddress)
+	}
+	return portRangeCovers(s1.Port, s1.EndPort, s2.Port, s2.EndPort) &&
+		portRangeCovers(int32PtrToIntOrString(s1.SrcPort), s1.SrcEndPort, int32PtrToIntOrString(s2.SrcPort), s2.SrcEndPort)
+}
+
+// int32PtrCovers returns whether the value v1, which matches all values when it's nil, matches v2.
+func int32PtrCovers(v1, v2 *int32) bool {
+	return v1 == nil || (v2 != nil && *v1 == *v2)
+}
+
+func int32PtrToIntOrString(v *int32) *intstr.IntOrString {
+	if v == nil {
+		return nil
+	}
+	port := intstr.FromInt(int(*v))
+	return &port
+}
+
+// portRangeCovers returns whether the port range [port1, endPort1] includes the port range [port2, endPort2]. A nil
+// port matches all ports. A named port only covers the same named port.
+func portRangeCovers(port1 *intstr.IntOrString, endPort1 *int32, port2 *intstr.IntOrString, endPort2 *int32) bool {
+	if port1 == nil {
+		return true
+	}
+	if port2 == nil {
+		return false
+	}
+	if port1.Type == intstr.String || port2.Type == intstr.String {
+		return port1.Type == port2.Type && port1.StrVal == port2.StrVal
+	}
+	start1, end1 := port1.IntVal, port1.IntVal
+	if endPort1 != nil {
+		end1 = *endPort1
+	}
+	start2, end2 := port2.IntVal, port2.IntVal
+	if endPort2 != nil {
+		end2 = *endPort2
+	}
+	return start1 <= start2 && end2 <= end1
+}
+
+// policyAnalysisConditions generates the conditions of a policy from the findings which concern it.
+func policyAnalysisConditions(policyKey string, findings []PolicyAnalysisFinding) []crdv1beta1.NetworkPolicyCondition {
+	messages := map[crdv1beta1.NetworkPolicyConditionType][]string{}
+	for _, finding := range findings {
+		switch {
+		case finding.Rule.policyKey == policyKey:
+			conditionType := analysisConditionTypes[finding.Type]
+			messages[conditionType] = append(messages[conditionType], findingMessage(finding.Type, finding.Rule, finding.OtherRule))
+		case finding.Type == PolicyAnalysisConflict && finding.OtherRule.policyKey == policyKey:
+			messages[crdv1beta1.NetworkPolicyConditionConflictingRules] = append(messages[crdv1beta1.NetworkPolicyConditionConflictingRules],
+				findingMessage(finding.Type, finding.OtherRule, finding.Rule))
+		}
+	}
+	var conditions []crdv1beta1.NetworkPolicyCondition
+	for _, conditionType := range []crdv1beta1.NetworkPolicyConditionType{
+		crdv1beta1.NetworkPolicyConditionShadowedRules,
+		crdv1beta1.NetworkPolicyConditionRedundantRules,
+		crdv1beta1.NetworkPolicyConditionConflictingRules,
+	} {
+		if len(messages[conditionType]) == 0 {
+			continue
+		}
+		sort.Strings(messages[conditionType])
+		message := fmt.Sprintf("%d rule(s): %s", len(messages[conditionType]), strings.Join(messages[conditionType], ", "))
+		if len(message) > maxConditionMessageLength {
+			message = fmt.Sprintf("%s...", message[:maxConditionMessageLength])
+		}
+		conditions = append(conditions, crdv1beta1.NetworkPolicyCondition{
+			Type:               conditionType,
+			Status:             v1.ConditionTrue,
+			LastTransitionTime: v1.Now(),
+			Reason:             analysisConditionReasons[conditionType],
+			Message:            message,
+		})
+	}
+	return conditions
+}
+
+var (
+	analysisConditionTypes = map[PolicyAnalysisFindingType]crdv1beta1.NetworkPolicyConditionType{
+		PolicyAnalysisShadowed:  crdv1beta1.NetworkPolicyConditionShadowedRules,
+		PolicyAnalysisRedundant: crdv1beta1.NetworkPolicyConditionRedundantRules,
+		PolicyAnalysisConflict:  crdv1beta1.NetworkPolicyConditionConflictingRules,
+	}
+	analysisConditionReasons = map[crdv1beta1.NetworkPolicyConditionType]string{
+		crdv1beta1.NetworkPolicyConditionShadowedRules:    "RulesShadowedByHigherPrecedenceRules",
+		crdv1beta1.NetworkPolicyConditionRedundantRules:   "RulesCoveredByHigherPrecedenceRules",
+		crdv1beta1.NetworkPolicyConditionConflictingRules: "RulesMayConflictWithSamePriorityRules",
+	}
+)
+
+func findingMessage(findingType PolicyAnalysisFindingType, rule, otherRule PolicyRuleReference) string {
+	switch findingType {
+	case PolicyAnalysisShadowed:
+		return fmt.Sprintf(`"%s" is shadowed by "%s" of %s`, rule.Rule, otherRule.Rule, otherRule.Policy)
+	case PolicyAnalysisRedundant:
+		return fmt.Sprintf(`"%s" is redundant with "%s" of %s`, rule.Rule, otherRule.Rule, otherRule.Policy)
+	default:
+		return fmt.Sprintf(`"%s" may conflict with "%s" of %s`, rule.Rule, otherRule.Rule, otherRule.Policy)
+	}
+}
@@ -0,0 +1,228 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkpolicy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"antrea.io/antrea/pkg/apis/controlplane"
+	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
+	antreatypes "antrea.io/antrea/pkg/controller/types"
+)
+
+func newAnalyzedPolicy(name string, tierPriority int32, priority float64, appliedToGroups []string, rules ...controlplane.NetworkPolicyRule) *antreatypes.NetworkPolicy {
+	for i := range rules {
+		rules[i].Priority = int32(i)
+	}
+	return &antreatypes.NetworkPolicy{
+		Name:            name,
+		SourceRef:       newAntreaClusterNetworkPolicyReference(name),
+		TierPriority:    &tierPriority,
+		Priority:        &priority,
+		AppliedToGroups: appliedToGroups,
+		Rules:           rules,
+	}
+}
+
+func newAnalyzedRule(name string, action crdv1beta1.RuleAction, from controlplane.NetworkPolicyPeer, services ...controlplane.Service) controlplane.NetworkPolicyRule {
+	return controlplane.NetworkPolicyRule{
+		Name:      name,
+		Direction: controlplane.DirectionIn,
+		From:      from,
+		Services:  services,
+		Action:    &action,
+	}
+}
+
+func newAnalyzedRuleReference(policy, rule string) PolicyRuleReference {
+	return PolicyRuleReference{
+		Policy:    "AntreaClusterNetworkPolicy:" + policy,
+		Rule:      rule,
+		Direction: controlplane.DirectionIn,
+		policyKey: policy,
+	}
+}
+
+func TestAnalyzePolicies(t *testing.T) {
+	int8080 := intstr.FromInt(8080)
+	int32For8000 := int32(8000)
+	int32For9000 := int32(9000)
+	tcpPort80 := controlplane.Service{Protocol: &protocolTCP, Port: &int80}
+	tcpPort8080 := controlplane.Service{Protocol: &protocolTCP, Port: &int8080}
+	tcpPortRange := controlplane.Service{Protocol: &protocolTCP, Port: &int1000, EndPort: &int32For9000}
+	tcpPortRangeNotCovered := controlplane.Service{Protocol: &protocolTCP, Port: &int1000, EndPort: &int32For8000}
+	ag1Peer := controlplane.NetworkPolicyPeer{AddressGroups: []string{"ag1"}}
+	ag2Peer := controlplane.NetworkPolicyPeer{AddressGroups: []string{"ag2"}}
+	ipBlock16Peer := controlplane.NetworkPolicyPeer{IPBlocks: []controlplane.IPBlock{newTestIPBlockFromCIDR("10.0.0.0/16")}}
+	ipBlock24Peer := controlplane.NetworkPolicyPeer{IPBlocks: []controlplane.IPBlock{newTestIPBlockFromCIDR("10.0.1.0/24")}}
+
+	tests := []struct {
+		name             string
+		policies         []*antreatypes.NetworkPolicy
+		expectedFindings []PolicyAnalysisFinding
+	}{
+		{
+			name: "rule shadowed by a rule of a policy with a higher priority",
+			policies: []*antreatypes.NetworkPolicy{
+				newAnalyzedPolicy("acnp1", 250, 1, []string{"atg1", "atg2"}, newAnalyzedRule("drop-all", crdv1beta1.RuleActionDrop, matchAllPeer)),
+				newAnalyzedPolicy("acnp2", 250, 2, []string{"atg1"}, newAnalyzedRule("allow-web", crdv1beta1.RuleActionAllow, ag1Peer, tcpPort80)),
+			},
+			expectedFindings: []PolicyAnalysisFinding{
+				{Type: PolicyAnalysisShadowed, Rule: newAnalyzedRuleReference("acnp2", "allow-web"), OtherRule: newAnalyzedRuleReference("acnp1", "drop-all")},
+			},
+		},
+		{
+			name: "rule shadowed by a rule of a higher Tier",
+			policies: []*antreatypes.NetworkPolicy{
+				newAnalyzedPolicy("acnp1", 100, 10, []string{"atg1"}, newAnalyzedRule("allow-range", crdv1beta1.RuleActionAllow, ipBlock16Peer, tcpPortRange)),
+				newAnalyzedPolicy("acnp2", 250, 1, []string{"atg1"}, newAnalyzedRule("drop-web", crdv1beta1.RuleActionDrop, ipBlock24Peer, tcpPort8080)),
+			},
+			expectedFindings: []PolicyAnalysisFinding{
+				{Type: PolicyAnalysisShadowed, Rule: newAnalyzedRuleReference("acnp2", "drop-web"), OtherRule: newAnalyzedRuleReference("acnp1", "allow-range")},
+			},
+		},
+		{
+			name: "redundant rule in the same policy",
+			policies: []*antreatypes.NetworkPolicy{
+				newAnalyzedPolicy("acnp1", 250, 1, []string{"atg1"},
+					newAnalyzedRule("allow-ag1", crdv1beta1.RuleActionAllow, ag1Peer),
+					newAnalyzedRule("allow-ag1-web", crdv1beta1.RuleActionAllow, ag1Peer, tcpPort80)),
+			},
+			expectedFindings: []PolicyAnalysisFinding{
+				{Type: PolicyAnalysisRedundant, Rule: newAnalyzedRuleReference("acnp1", "allow-ag1-web"), OtherRule: newAnalyzedRuleReference("acnp1", "allow-ag1")},
+			},
+		},
+		{
+			name: "rules not covered",
+			policies: []*antreatypes.NetworkPolicy{
+				newAnalyzedPolicy("acnp1", 250, 1, []string{"atg1"},
+					newAnalyzedRule("drop-ag1", crdv1beta1.RuleActionDrop, ag1Peer),
+					newAnalyzedRule("drop-range", crdv1beta1.RuleActionDrop, ipBlock24Peer, tcpPortRangeNotCovered)),
+				// Applied to more groups.
+				newAnalyzedPolicy("acnp2", 250, 2, []string{"atg1", "atg2"}, newAnalyzedRule("allow-ag1", crdv1beta1.RuleActionAllow, ag1Peer)),
+				// Different peer.
+				newAnalyzedPolicy("acnp3", 250, 3, []string{"atg1"}, newAnalyzedRule("allow-ag2", crdv1beta1.RuleActionAllow, ag2Peer)),
+				// Larger CIDR and port range.
+				newAnalyzedPolicy("acnp4", 250, 4, []string{"atg1"}, newAnalyzedRule("allow-range", crdv1beta1.RuleActionAllow, ipBlock16Peer, tcpPortRange)),
+			},
+		},
+		{
+			name: "Pass rule doesn't cover rules of the baseline Tier",
+			policies: []*antreatypes.NetworkPolicy{
+				newAnalyzedPolicy("acnp1", 250, 1, []string{"atg1"}, newAnalyzedRule("pass-all", crdv1beta1.RuleActionPass, matchAllPeer)),
+				newAnalyzedPolicy("acnp2", BaselineTierPriority, 1, []string{"atg1"}, newAnalyzedRule("drop-all", crdv1beta1.RuleActionDrop, matchAllPeer)),
+			},
+		},
+		{
+			name: "conflicting rules of policies with the same priority",
+			policies: []*antreatypes.NetworkPolicy{
+				newAnalyzedPolicy("acnp1", 250, 1, []string{"atg1"}, newAnalyzedRule("allow-web", crdv1beta1.RuleActionAllow, ag1Peer, tcpPort80)),
+				newAnalyzedPolicy("acnp2", 250, 1, []string{"atg1", "atg2"}, newAnalyzedRule("drop-ag1", crdv1beta1.RuleActionDrop, ag1Peer)),
+				newAnalyzedPolicy("acnp3", 250, 1, []string{"atg1"}, newAnalyzedRule("drop-ag2", crdv1beta1.RuleActionDrop, ag2Peer)),
+			},
+			expectedFindings: []PolicyAnalysisFinding{
+				{Type: PolicyAnalysisConflict, Rule: newAnalyzedRuleReference("acnp2", "drop-ag1"), OtherRule: newAnalyzedRuleReference("acnp1", "allow-web")},
+			},
+		},
+		{
+			name: "policies in Audit mode are ignored",
+			policies: []*antreatypes.NetworkPolicy{
+				func() *antreatypes.NetworkPolicy {
+					policy := newAnalyzedPolicy("acnp1", 250, 1, []string{"atg1"}, newAnalyzedRule("drop-all", crdv1beta1.RuleActionDrop, matchAllPeer))
+					policy.EnforcementMode = crdv1beta1.PolicyEnforcementModeAudit
+					return policy
+				}(),
+				newAnalyzedPolicy("acnp2", 250, 2, []string{"atg1"}, newAnalyzedRule("allow-web", crdv1beta1.RuleActionAllow, ag1Peer, tcpPort80)),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expectedFindings, analyzePolicies(tt.policies))
+		})
+	}
+}
+
+func TestPolicyAnalysisConditions(t *testing.T) {
+	findings := []PolicyAnalysisFinding{
+		{Type: PolicyAnalysisShadowed, Rule: newAnalyzedRuleReference("acnp2", "allow-web"), OtherRule: newAnalyzedRuleReference("acnp1", "drop-all")},
+		{Type: PolicyAnalysisConflict, Rule: newAnalyzedRuleReference("acnp3", "drop-ag1"), OtherRule: newAnalyzedRuleReference("acnp2", "allow-ag1")},
+	}
+	ignoreTime := func(conditions []crdv1beta1.NetworkPolicyCondition) []crdv1beta1.NetworkPolicyCondition {
+		for i := range conditions {
+			conditions[i].LastTransitionTime = v1.Time{}
+		}
+		return conditions
+	}
+	assert.Empty(t, policyAnalysisConditions("acnp1", findings))
+	assert.Equal(t, []crdv1beta1.NetworkPolicyCondition{
+		{
+			Type:    crdv1beta1.NetworkPolicyConditionShadowedRules,
+			Status:  v1.ConditionTrue,
+			Reason:  "RulesShadowedByHigherPrecedenceRules",
+			Message: `1 rule(s): "allow-web" is shadowed by "drop-all" of AntreaClusterNetworkPolicy:acnp1`,
+		},
+		{
+			Type:    crdv1beta1.NetworkPolicyConditionConflictingRules,
+			Status:  v1.ConditionTrue,
+			Reason:  "RulesMayConflictWithSamePriorityRules",
+			Message: `1 rule(s): "allow-ag1" may conflict with "drop-ag1" of AntreaClusterNetworkPolicy:acnp3`,
+		},
+	}, ignoreTime(policyAnalysisConditions("acnp2", findings)))
+	assert.Equal(t, []crdv1beta1.NetworkPolicyCondition{
+		{
+			Type:    crdv1beta1.NetworkPolicyConditionConflictingRules,
+			Status:  v1.ConditionTrue,
+			Reason:  "RulesMayConflictWithSamePriorityRules",
+			Message: `1 rule(s): "drop-ag1" may conflict with "allow-ag1" of AntreaClusterNetworkPolicy:acnp2`,
+		},
+	}, ignoreTime(policyAnalysisConditions("acnp3", findings)))
+}
+
+func newTestIPBlockFromCIDR(cidr string) controlplane.IPBlock {
+	ipNet, _ := cidrStrToIPNet(cidr)
+	return controlplane.IPBlock{CIDR: *ipNet}
+}
+
+func TestStatusControllerPolicyAnalysis(t *testing.T) {
+	acnp1 := newAnalyzedPolicy("acnp1", 250, 1, []string{"atg1"}, newAnalyzedRule("drop-all", crdv1beta1.RuleActionDrop, matchAllPeer))
+	acnp2 := newAnalyzedPolicy("acnp2", 250, 2, []string{"atg1"}, newAnalyzedRule("allow-web", crdv1beta1.RuleActionAllow, matchAllPeer))
+	statusController, _, _, networkPolicyStore, networkPolicyControl := newTestStatusController(toAntreaNetworkPolicy(acnp1), toAntreaNetworkPolicy(acnp2))
+	networkPolicyStore.Create(acnp1)
+	networkPolicyStore.Create(acnp2)
+
+	// The analysis is skipped if the policies haven't changed.
+	statusController.runPolicyAnalysis()
+	assert.Equal(t, 0, statusController.queue.Len())
+
+	statusController.analysisNeeded.Store(true)
+	statusController.runPolicyAnalysis()
+	assert.Equal(t, 2, statusController.queue.Len())
+	assert.NoError(t, statusController.syncHandler("acnp2"))
+	expectedConditions := append(GenerateNetworkPolicyCondition(nil), crdv1beta1.NetworkPolicyCondition{
+		Type:    crdv1beta1.NetworkPolicyConditionShadowedRules,
+		Status:  v1.ConditionTrue,
+		Reason:  "RulesShadowedByHigherPrecedenceRules",
+		Message: `1 rule(s): "allow-web" is shadowed by "drop-all" of AntreaClusterNetworkPolicy:acnp1`,
+	})
+	assert.True(t, NetworkPolicyStatusEqual(crdv1beta1.NetworkPolicyStatus{
+		Phase:      crdv1beta1.NetworkPolicyPending,
+		Conditions: expectedConditions,
+	}, *networkPolicyControl.getAntreaClusterNetworkPolicyStatus()))
+}
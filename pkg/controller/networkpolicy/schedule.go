@@ -0,0 +1,316 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkpolicy
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	// The antrea-controller image doesn't include the time zone database, which is required to evaluate the
+	// schedules in their time zones.
+	_ "time/tzdata"
+
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+
+	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
+)
+
+const (
+	// cronSearchHorizon is how far ahead the start of a window is searched for. A cron expression which doesn't
+	// match any time within it, e.g. "0 0 30 2 *", is considered to never match.
+	cronSearchHorizon = 5 * 365 * 24 * time.Hour
+	// maxScheduleTransitionLookups is the maximum number of overlapping windows looked up when calculating the
+	// time at which an active schedule is deactivated.
+	maxScheduleTransitionLookups = 1000
+)
+
+// cronField is the set of values matched by a field of a cron expression, one bit per value.
+type cronField uint64
+
+func (f cronField) has(v int) bool {
+	return f&(1<<uint(v)) != 0
+}
+
+// cronExpression is a parsed standard 5-field cron expression.
+type cronExpression struct {
+	minute     cronField
+	hour       cronField
+	dayOfMonth cronField
+	month      cronField
+	dayOfWeek  cronField
+	// Like cron, if both day-of-month and day-of-week are restricted, a day matches if either of them matches.
+	// Otherwise, only the restricted one is considered.
+	dayOfMonthStar bool
+	dayOfWeekStar  bool
+}
+
+// parseCronExpression parses a cron expression made of 5 space-separated fields: minute, hour, day of month, month
+// and day of week. Each field supports "*", values, ranges ("1-5"), lists ("1,3,5") and steps ("*/15", "0-30/10").
+// In the day-of-week field, both 0 and 7 are Sunday.
+func parseCronExpression(expr string) (*cronExpression, error) {
+	fields := strings.Fields(expr)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("expected 5 fields in cron expression %q, got %d", expr, len(fields))
+	}
+	var c cronExpression
+	var err error
+	if c.minute, err = parseCronField(fields[0], 0, 59); err != nil {
+		return nil, fmt.Errorf("invalid minute field: %w", err)
+	}
+	if c.hour, err = parseCronField(fields[1], 0, 23); err != nil {
+		return nil, fmt.Errorf("invalid hour field: %w", err)
+	}
+	if c.dayOfMonth, err = parseCronField(fields[2], 1, 31); err != nil {
+		return nil, fmt.Errorf("invalid day-of-month field: %w", err)
+	}
+	if c.month, err = parseCronField(fields[3], 1, 12); err != nil {
+		return nil, fmt.Errorf("invalid month field: %w", err)
+	}
+	if c.dayOfWeek, err = parseCronField(fields[4], 0, 7); err != nil {
+		return nil, fmt.Errorf("invalid day-of-week field: %w", err)
+	}
+	if c.dayOfWeek.has(7) {
+		c.dayOfWeek |= 1
+	}
+	c.dayOfMonthStar = strings.HasPrefix(fields[2], "*")
+	c.dayOfWeekStar = strings.HasPrefix(fields[4], "*")
+	return &c, nil
+}
+
+func parseCronField(field string, min, max int) (cronField, error) {
+	var f cronField
+	for _, item := range strings.Split(field, ",") {
+		rangeExpr, stepExpr, hasStep := strings.Cut(item, "/")
+		step := 1
+		if hasStep {
+			var err error
+			step, err = strconv.Atoi(stepExpr)
+			if err != nil || step <= 0 {
+				return 0, fmt.Errorf("invalid step %q", stepExpr)
+			}
+		}
+		var low, high int
+		if rangeExpr == "*" {
+			low, high = min, max
+		} else {
+			lowExpr, highExpr, isRange := strings.Cut(rangeExpr, "-")
+			var err error
+			if low, err = strconv.Atoi(lowExpr); err != nil {
+				return 0, fmt.Errorf("invalid value %q", lowExpr)
+			}
+			high = low
+			if isRange {
+				if high, err = strconv.Atoi(highExpr); err != nil {
+					return 0, fmt.Errorf("invalid value %q", highExpr)
+				}
+			} else if hasStep {
+				// "a/n" means from a to the maximum value every n.
+				high = max
+			}
+		}
+		if low < min || high > max || low > high {
+			return 0, fmt.Errorf("range %q is out of bounds [%d, %d]", rangeExpr, min, max)
+		}
+		for v := low; v <= high; v += step {
+			f |= 1 << uint(v)
+		}
+	}
+	return f, nil
+}
+
+func (c *cronExpression) matchDay(t time.Time) bool {
+	domMatch := c.dayOfMonth.has(t.Day())
+	dowMatch := c.dayOfWeek.has(int(t.Weekday()))
+	if c.dayOfMonthStar || c.dayOfWeekStar {
+		return domMatch && dowMatch
+	}
+	return domMatch || dowMatch
+}
+
+// next returns the first time strictly after t matched by the cron expression, in the location of t. It returns the
+// zero time if there is no such time within cronSearchHorizon.
+func (c *cronExpression) next(t time.Time) time.Time {
+	loc := t.Location()
+	// Start from the beginning of the next minute.
+	t = t.Add(time.Minute - time.Duration(t.Second())*time.Second - time.Duration(t.Nanosecond()))
+	limit := t.Add(cronSearchHorizon)
+	for t.Before(limit) {
+		if !c.month.has(int(t.Month())) {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, loc)
+			continue
+		}
+		if !c.matchDay(t) {
+			nextDay := time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, loc)
+			// Guard against going backwards when midnight doesn't exist in the location.
+			if !nextDay.After(t) {
+				nextDay = t.Add(time.Hour)
+			}
+			t = nextDay
+			continue
+		}
+		if !c.hour.has(t.Hour()) {
+			t = t.Add(time.Duration(60-t.Minute()) * time.Minute)
+			continue
+		}
+		if !c.minute.has(t.Minute()) {
+			t = t.Add(time.Minute)
+			continue
+		}
+		return t
+	}
+	return time.Time{}
+}
+
+type scheduleWindow struct {
+	start    *cronExpression
+	duration time.Duration
+}
+
+// compiledSchedule is the parsed form of a crdv1beta1.RuleSchedule.
+type compiledSchedule struct {
+	location *time.Location
+	windows  []scheduleWindow
+}
+
+func compileSchedule(schedule *crdv1beta1.RuleSchedule) (*compiledSchedule, error) {
+	location := time.UTC
+	if schedule.TimeZone != "" {
+		var err error
+		if location, err = time.LoadLocation(schedule.TimeZone); err != nil {
+			return nil, fmt.Errorf("invalid time zone %q: %w", schedule.TimeZone, err)
+		}
+	}
+	if len(schedule.Windows) == 0 {
+		return nil, fmt.Errorf("at least one window must be specified")
+	}
+	s := &compiledSchedule{location: location}
+	for _, w := range schedule.Windows {
+		start, err := parseCronExpression(w.Start)
+		if err != nil {
+			return nil, fmt.Errorf("invalid start %q: %w", w.Start, err)
+		}
+		duration, err := time.ParseDuration(w.Duration)
+		if err != nil {
+			return nil, fmt.Errorf("invalid duration %q: %w", w.Duration, err)
+		}
+		// The windows start at minute boundaries, requiring their durations to be whole minutes ensures they end at
+		// minute boundaries too.
+		if duration <= 0 || duration%time.Minute != 0 {
+			return nil, fmt.Errorf("duration %q must be a positive number of minutes", w.Duration)
+		}
+		s.windows = append(s.windows, scheduleWindow{start: start, duration: duration})
+	}
+	return s, nil
+}
+
+// activeWindowEnd returns the end of the earliest started window which contains t, or the zero time if t is not
+// within any window.
+func (s *compiledSchedule) activeWindowEnd(t time.Time) time.Time {
+	var end time.Time
+	for _, w := range s.windows {
+		// The earliest start of the window which contains t is the first one after t-duration.
+		start := w.start.next(t.Add(-w.duration))
+		if start.IsZero() || start.After(t) {
+			continue
+		}
+		if windowEnd := start.Add(w.duration); end.IsZero() || windowEnd.Before(end) {
+			end = windowEnd
+		}
+	}
+	return end
+}
+
+// evaluate returns whether the schedule is active at t, and the next time at which it is activated or deactivated.
+// The returned time is zero if the schedule never changes after t.
+func (s *compiledSchedule) evaluate(t time.Time) (bool, time.Time) {
+	t = t.In(s.location)
+	end := s.activeWindowEnd(t)
+	if end.IsZero() {
+		var next time.Time
+		for _, w := range s.windows {
+			if start := w.start.next(t); !start.IsZero() && (next.IsZero() || start.Before(next)) {
+				next = start
+			}
+		}
+		return false, next
+	}
+	// The schedule remains active while the windows overlap each other.
+	for i := 0; i < maxScheduleTransitionLookups; i++ {
+		nextEnd := s.activeWindowEnd(end)
+		if nextEnd.IsZero() {
+			break
+		}
+		end = nextEnd
+	}
+	return true, end
+}
+
+// scheduleEvaluator evaluates the schedules of the rules of a policy at a point in time, and tracks the earliest time
+// at which any of the rules is activated or deactivated.
+type scheduleEvaluator struct {
+	now            time.Time
+	nextTransition *time.Time
+	// invalidSchedules describes the rules whose schedules are invalid.
+	invalidSchedules []string
+}
+
+// isActive returns whether a rule with the action and the schedule should be enforced. A rule without schedule is
+// always enforced. As it's unknown when the user wants a rule with an invalid schedule to be enforced, the rule fails
+// closed: it's never enforced if it allows traffic (Allow and Pass actions), and always enforced otherwise (Drop,
+// Reject and RateLimit actions).
+func (e *scheduleEvaluator) isActive(ruleName string, action *crdv1beta1.RuleAction, schedule *crdv1beta1.RuleSchedule) bool {
+	if schedule == nil {
+		return true
+	}
+	s, err := compileSchedule(schedule)
+	if err != nil {
+		// This should not happen as the schedule has been validated by the webhook, unless the webhook was bypassed.
+		enforced := action != nil && *action != crdv1beta1.RuleActionAllow && *action != crdv1beta1.RuleActionPass
+		if enforced {
+			klog.ErrorS(err, "Invalid rule schedule, always enforcing the rule", "rule", ruleName)
+			e.invalidSchedules = append(e.invalidSchedules, fmt.Sprintf("%s (%v): always enforced", ruleName, err))
+		} else {
+			klog.ErrorS(err, "Invalid rule schedule, never enforcing the rule", "rule", ruleName)
+			e.invalidSchedules = append(e.invalidSchedules, fmt.Sprintf("%s (%v): never enforced", ruleName, err))
+		}
+		return enforced
+	}
+	active, next := s.evaluate(e.now)
+	if !next.IsZero() && (e.nextTransition == nil || next.Before(*e.nextTransition)) {
+		e.nextTransition = &next
+	}
+	return active
+}
+
+// invalidRuleSchedulesCondition generates the condition reporting the rules whose schedules are invalid, or nil if
+// there is no such rule.
+func invalidRuleSchedulesCondition(invalidSchedules []string) []crdv1beta1.NetworkPolicyCondition {
+	if len(invalidSchedules) == 0 {
+		return nil
+	}
+	message := fmt.Sprintf("%d rule(s): %s", len(invalidSchedules), strings.Join(invalidSchedules, ", "))
+	if len(message) > maxConditionMessageLength {
+		message = fmt.Sprintf("%s...", message[:maxConditionMessageLength])
+	}
+	return []crdv1beta1.NetworkPolicyCondition{{
+		Type:               crdv1beta1.NetworkPolicyConditionInvalidRuleSchedules,
+		Status:             v1.ConditionTrue,
+		LastTransitionTime: v1.Now(),
+		Reason:             "SchedulesIgnored",
+		Message:            message,
+	}}
+}
@@ -0,0 +1,300 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkpolicy
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
+)
+
+func mustParseTime(t *testing.T, value string) time.Time {
+	ts, err := time.Parse(time.RFC3339, value)
+	require.NoError(t, err)
+	return ts
+}
+
+func TestParseCronExpression(t *testing.T) {
+	tests := []struct {
+		expr        string
+		expectedErr string
+	}{
+		{expr: "0 22 * * 6"},
+		{expr: "*/15 8-18 1,15 1-12/2 1-5"},
+		{expr: "30 2 * * 7"},
+		{expr: "0 22 * *", expectedErr: "expected 5 fields"},
+		{expr: "60 22 * * *", expectedErr: "invalid minute field"},
+		{expr: "0 24 * * *", expectedErr: "invalid hour field"},
+		{expr: "0 0 0 * *", expectedErr: "invalid day-of-month field"},
+		{expr: "0 0 * 5-3 *", expectedErr: "invalid month field"},
+		{expr: "0 0 * * 1/0", expectedErr: "invalid day-of-week field"},
+		{expr: "0 0 * * mon", expectedErr: "invalid day-of-week field"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			_, err := parseCronExpression(tt.expr)
+			if tt.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.expectedErr)
+			}
+		})
+	}
+}
+
+func TestCronExpressionNext(t *testing.T) {
+	tests := []struct {
+		name     string
+		expr     string
+		from     string
+		expected string
+	}{
+		{
+			name:     "every 15 minutes",
+			expr:     "*/15 * * * *",
+			from:     "2024-03-01T10:15:00Z",
+			expected: "2024-03-01T10:30:00Z",
+		},
+		{
+			name:     "next day",
+			expr:     "0 9 * * *",
+			from:     "2024-03-01T09:00:30Z",
+			expected: "2024-03-02T09:00:00Z",
+		},
+		{
+			name:     "day of week",
+			expr:     "0 22 * * 6",
+			from:     "2024-03-01T10:00:00Z",
+			expected: "2024-03-02T22:00:00Z",
+		},
+		{
+			name:     "Sunday as 7",
+			expr:     "0 0 * * 7",
+			from:     "2024-03-01T10:00:00Z",
+			expected: "2024-03-03T00:00:00Z",
+		},
+		{
+			name:     "day of month or day of week",
+			expr:     "0 0 15 * 1",
+			from:     "2024-03-05T00:00:00Z",
+			expected: "2024-03-11T00:00:00Z",
+		},
+		{
+			name:     "leap day",
+			expr:     "0 0 29 2 *",
+			from:     "2024-03-01T00:00:00Z",
+			expected: "2028-02-29T00:00:00Z",
+		},
+		{
+			name: "never",
+			expr: "0 0 30 2 *",
+			from: "2024-03-01T00:00:00Z",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := parseCronExpression(tt.expr)
+			require.NoError(t, err)
+			next := c.next(mustParseTime(t, tt.from))
+			if tt.expected == "" {
+				assert.True(t, next.IsZero())
+			} else {
+				assert.Equal(t, mustParseTime(t, tt.expected), next.UTC())
+			}
+		})
+	}
+}
+
+func TestCompiledScheduleEvaluate(t *testing.T) {
+	tests := []struct {
+		name               string
+		schedule           *crdv1beta1.RuleSchedule
+		now                string
+		expectedActive     bool
+		expectedTransition string
+	}{
+		{
+			name: "before window",
+			schedule: &crdv1beta1.RuleSchedule{
+				Windows: []crdv1beta1.ScheduleWindow{{Start: "0 22 * * 6", Duration: "4h"}},
+			},
+			now:                "2024-03-02T21:59:00Z",
+			expectedActive:     false,
+			expectedTransition: "2024-03-02T22:00:00Z",
+		},
+		{
+			name: "start of window",
+			schedule: &crdv1beta1.RuleSchedule{
+				Windows: []crdv1beta1.ScheduleWindow{{Start: "0 22 * * 6", Duration: "4h"}},
+			},
+			now:                "2024-03-02T22:00:00Z",
+			expectedActive:     true,
+			expectedTransition: "2024-03-03T02:00:00Z",
+		},
+		{
+			name: "end of window",
+			schedule: &crdv1beta1.RuleSchedule{
+				Windows: []crdv1beta1.ScheduleWindow{{Start: "0 22 * * 6", Duration: "4h"}},
+			},
+			now:                "2024-03-03T02:00:00Z",
+			expectedActive:     false,
+			expectedTransition: "2024-03-09T22:00:00Z",
+		},
+		{
+			name: "time zone",
+			schedule: &crdv1beta1.RuleSchedule{
+				TimeZone: "America/New_York",
+				Windows:  []crdv1beta1.ScheduleWindow{{Start: "0 9 * * 1-5", Duration: "8h"}},
+			},
+			now:                "2024-03-04T13:30:00Z",
+			expectedActive:     false,
+			expectedTransition: "2024-03-04T14:00:00Z",
+		},
+		{
+			name: "overlapping windows",
+			schedule: &crdv1beta1.RuleSchedule{
+				Windows: []crdv1beta1.ScheduleWindow{
+					{Start: "0 * * * *", Duration: "90m"},
+					{Start: "0 3 * * *", Duration: "30m"},
+				},
+			},
+			now:            "2024-03-04T03:10:00Z",
+			expectedActive: true,
+		},
+		{
+			name: "adjacent windows",
+			schedule: &crdv1beta1.RuleSchedule{
+				Windows: []crdv1beta1.ScheduleWindow{
+					{Start: "0 8 * * *", Duration: "1h"},
+					{Start: "0 9 * * *", Duration: "2h"},
+				},
+			},
+			now:                "2024-03-04T08:30:00Z",
+			expectedActive:     true,
+			expectedTransition: "2024-03-04T11:00:00Z",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := compileSchedule(tt.schedule)
+			require.NoError(t, err)
+			active, transition := s.evaluate(mustParseTime(t, tt.now))
+			assert.Equal(t, tt.expectedActive, active)
+			if tt.expectedTransition != "" {
+				assert.Equal(t, mustParseTime(t, tt.expectedTransition), transition.UTC())
+			}
+		})
+	}
+}
+
+func TestCompileSchedule(t *testing.T) {
+	tests := []struct {
+		name        string
+		schedule    *crdv1beta1.RuleSchedule
+		expectedErr string
+	}{
+		{
+			name: "valid",
+			schedule: &crdv1beta1.RuleSchedule{
+				TimeZone: "Europe/Paris",
+				Windows:  []crdv1beta1.ScheduleWindow{{Start: "0 22 * * 6", Duration: "2h30m"}},
+			},
+		},
+		{
+			name: "invalid time zone",
+			schedule: &crdv1beta1.RuleSchedule{
+				TimeZone: "Mars/Olympus",
+				Windows:  []crdv1beta1.ScheduleWindow{{Start: "0 22 * * 6", Duration: "2h"}},
+			},
+			expectedErr: "invalid time zone",
+		},
+		{
+			name:        "no window",
+			schedule:    &crdv1beta1.RuleSchedule{},
+			expectedErr: "at least one window",
+		},
+		{
+			name: "invalid start",
+			schedule: &crdv1beta1.RuleSchedule{
+				Windows: []crdv1beta1.ScheduleWindow{{Start: "0 25 * * *", Duration: "2h"}},
+			},
+			expectedErr: "invalid start",
+		},
+		{
+			name: "invalid duration",
+			schedule: &crdv1beta1.RuleSchedule{
+				Windows: []crdv1beta1.ScheduleWindow{{Start: "0 22 * * *", Duration: "2 hours"}},
+			},
+			expectedErr: "invalid duration",
+		},
+		{
+			name: "duration not in minutes",
+			schedule: &crdv1beta1.RuleSchedule{
+				Windows: []crdv1beta1.ScheduleWindow{{Start: "0 22 * * *", Duration: "90s"}},
+			},
+			expectedErr: "must be a positive number of minutes",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := compileSchedule(tt.schedule)
+			if tt.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.expectedErr)
+			}
+		})
+	}
+}
+
+func TestScheduleEvaluatorIsActive(t *testing.T) {
+	e := &scheduleEvaluator{now: mustParseTime(t, "2024-03-02T22:30:00Z")}
+	allowAction := crdv1beta1.RuleActionAllow
+	passAction := crdv1beta1.RuleActionPass
+	dropAction := crdv1beta1.RuleActionDrop
+	rateLimitAction := crdv1beta1.RuleActionRateLimit
+	assert.True(t, e.isActive("no-schedule", &allowAction, nil))
+	assert.Nil(t, e.nextTransition)
+	assert.True(t, e.isActive("active", &allowAction, &crdv1beta1.RuleSchedule{
+		Windows: []crdv1beta1.ScheduleWindow{{Start: "0 22 * * 6", Duration: "4h"}},
+	}))
+	// An invalid schedule fails closed: rules allowing traffic are never enforced, the other ones are always
+	// enforced, and all of them are reported.
+	invalidSchedule := &crdv1beta1.RuleSchedule{
+		Windows: []crdv1beta1.ScheduleWindow{{Start: "0 25 * * *", Duration: "2h"}},
+	}
+	assert.False(t, e.isActive("invalid-allow", &allowAction, invalidSchedule))
+	assert.False(t, e.isActive("invalid-default", nil, invalidSchedule))
+	assert.False(t, e.isActive("invalid-pass", &passAction, invalidSchedule))
+	assert.True(t, e.isActive("invalid-drop", &dropAction, invalidSchedule))
+	assert.True(t, e.isActive("invalid-ratelimit", &rateLimitAction, invalidSchedule))
+	require.NotNil(t, e.nextTransition)
+	assert.Equal(t, mustParseTime(t, "2024-03-03T02:00:00Z"), e.nextTransition.UTC())
+	require.Len(t, e.invalidSchedules, 5)
+	assert.Contains(t, e.invalidSchedules[0], "invalid-allow (invalid start")
+	assert.Contains(t, e.invalidSchedules[0], "never enforced")
+	assert.Contains(t, e.invalidSchedules[3], "invalid-drop (invalid start")
+	assert.Contains(t, e.invalidSchedules[3], "always enforced")
+
+	conditions := invalidRuleSchedulesCondition(e.invalidSchedules[:1])
+	require.Len(t, conditions, 1)
+	assert.Equal(t, crdv1beta1.NetworkPolicyConditionInvalidRuleSchedules, conditions[0].Type)
+	assert.Contains(t, conditions[0].Message, "1 rule(s): invalid-allow (invalid start")
+	assert.Nil(t, invalidRuleSchedulesCondition(nil))
+}
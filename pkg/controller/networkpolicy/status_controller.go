@@ -20,12 +20,14 @@ import (
 	"sort"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"k8s.io/apimachinery/pkg/api/errors"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/tools/cache"
@@ -45,6 +47,8 @@ import (
 
 const (
 	statusControllerName = "NetworkPolicyStatusController"
+	// policyAnalysisInterval is the minimum interval between two analyses of the rules of Antrea-native policies.
+	policyAnalysisInterval = 30 * time.Second
 )
 
 var (
@@ -71,6 +75,13 @@ type StatusController struct {
 	statuses     map[string]map[string]*controlplane.NetworkPolicyNodeStatus
 	statusesLock sync.RWMutex
 
+	// analysisFindings are the issues found by the last analysis of the rules of Antrea-native policies. They are
+	// reported as conditions in the statuses of the policies they concern.
+	analysisFindings []PolicyAnalysisFinding
+	analysisLock     sync.RWMutex
+	// analysisNeeded indicates whether the internal NetworkPolicies have changed since the last analysis.
+	analysisNeeded atomic.Bool
+
 	// acnpListerSynced is a function which returns true if the ClusterNetworkPolicies shared informer has been synced at least once.
 	acnpListerSynced cache.InformerSynced
 	// annpListerSynced is a function which returns true if the AntreaNetworkPolicies shared informer has been synced at least once.
@@ -197,6 +208,7 @@ func (c *StatusController) Run(stopCh <-chan struct{}) {
 	}
 
 	go wait.NonSlidingUntil(c.watchInternalNetworkPolicy, 5*time.Second, stopCh)
+	go wait.Until(c.runPolicyAnalysis, policyAnalysisInterval, stopCh)
 
 	for i := 0; i < defaultWorkers; i++ {
 		go wait.Until(c.runWorker, time.Second, stopCh)
@@ -226,11 +238,40 @@ func (c *StatusController) watchInternalNetworkPolicy() {
 			if !controlplane.IsSourceAntreaNativePolicy(np.SourceRef) {
 				continue
 			}
+			c.analysisNeeded.Store(true)
 			c.queue.Add(np.Name)
 		}
 	}
 }
 
+// runPolicyAnalysis analyzes the rules of Antrea-native policies if they have changed since the last analysis, and
+// queues the policies concerned by the old or new findings so that their conditions are updated.
+func (c *StatusController) runPolicyAnalysis() {
+	if !c.analysisNeeded.Swap(false) {
+		return
+	}
+	findings := c.AnalyzePolicies()
+	c.analysisLock.Lock()
+	oldFindings := c.analysisFindings
+	c.analysisFindings = findings
+	c.analysisLock.Unlock()
+
+	keys := sets.New[string]()
+	for _, finding := range append(oldFindings, findings...) {
+		keys.Insert(finding.Rule.policyKey, finding.OtherRule.policyKey)
+	}
+	klog.V(2).InfoS("Analyzed the rules of Antrea-native policies", "findings", len(findings))
+	for key := range keys {
+		c.queue.Add(key)
+	}
+}
+
+func (c *StatusController) getPolicyAnalysisConditions(key string) []crdv1beta1.NetworkPolicyCondition {
+	c.analysisLock.RLock()
+	defer c.analysisLock.RUnlock()
+	return policyAnalysisConditions(key, c.analysisFindings)
+}
+
 func (c *StatusController) runWorker() {
 	for c.processNextWorkItem() {
 	}
@@ -280,6 +321,13 @@ func (c *StatusController) syncHandler(key string) error {
 			DesiredNodesRealized: int32(desiredNodes),
 			Conditions:           conditions,
 		}
+		if internalNP.NextScheduleTransitionTime != nil {
+			nextScheduleTransitionTime := v1.NewTime(*internalNP.NextScheduleTransitionTime)
+			status.NextScheduleTransitionTime = &nextScheduleTransitionTime
+		}
+		if internalNP.EnforcementMode == crdv1beta1.PolicyEnforcementModeAudit {
+			status.EnforcementMode = crdv1beta1.PolicyEnforcementModeAudit
+		}
 		klog.V(2).Infof("Updating NetworkPolicy %s status: %v", internalNP.SourceRef.ToString(), status)
 		if internalNP.SourceRef.Type == controlplane.AntreaNetworkPolicy {
 			return c.npControlInterface.UpdateAntreaNetworkPolicyStatus(internalNP.SourceRef.Namespace, internalNP.SourceRef.Name, status)
@@ -288,6 +336,8 @@ func (c *StatusController) syncHandler(key string) error {
 	}
 
 	conditions := GenerateNetworkPolicyCondition(internalNP.SyncError)
+	conditions = append(conditions, invalidRuleSchedulesCondition(internalNP.InvalidRuleSchedules)...)
+	conditions = append(conditions, c.getPolicyAnalysisConditions(key)...)
 	// It means the NetworkPolicy has been processed, and marked as unrealizable. It will enter unrealizable phase
 	// instead of being further realized. Antrea-agents will not process further.
 	if internalNP.SyncError != nil {
@@ -344,6 +344,9 @@ func TestUpdateAntreaNetworkPolicy(t *testing.T) {
 
 	annp1Updated := newInternalNetworkPolicy("annp1", 2, []string{"node1", "node2", "node3"}, newAntreaNetworkPolicyReference("ns1", "annp1"))
 	acnp1Updated := newInternalNetworkPolicy("acnp1", 3, []string{"node4", "node5"}, newAntreaClusterNetworkPolicyReference("acnp1"))
+	nextScheduleTransitionTime := time.Date(2024, 3, 2, 22, 0, 0, 0, time.UTC)
+	acnp1Updated.NextScheduleTransitionTime = &nextScheduleTransitionTime
+	annp1Updated.EnforcementMode = crdv1beta1.PolicyEnforcementModeAudit
 	networkPolicyStore.Update(annp1Updated)
 	networkPolicyStore.Update(acnp1Updated)
 	// TODO: Use a determinate mechanism.
@@ -354,13 +357,15 @@ func TestUpdateAntreaNetworkPolicy(t *testing.T) {
 		CurrentNodesRealized: 0,
 		DesiredNodesRealized: 3,
 		Conditions:           GenerateNetworkPolicyCondition(nil),
+		EnforcementMode:      crdv1beta1.PolicyEnforcementModeAudit,
 	}, *networkPolicyControl.getAntreaNetworkPolicyStatus()))
 	assert.True(t, NetworkPolicyStatusEqual(crdv1beta1.NetworkPolicyStatus{
-		Phase:                crdv1beta1.NetworkPolicyRealizing,
-		ObservedGeneration:   3,
-		CurrentNodesRealized: 0,
-		DesiredNodesRealized: 2,
-		Conditions:           GenerateNetworkPolicyCondition(nil),
+		Phase:                      crdv1beta1.NetworkPolicyRealizing,
+		ObservedGeneration:         3,
+		CurrentNodesRealized:       0,
+		DesiredNodesRealized:       2,
+		Conditions:                 GenerateNetworkPolicyCondition(nil),
+		NextScheduleTransitionTime: &v1.Time{Time: nextScheduleTransitionTime},
 	}, *networkPolicyControl.getAntreaClusterNetworkPolicyStatus()))
 }
 
@@ -14,11 +14,11 @@
 //
 
 // Code generated by MockGen. DO NOT EDIT.
-// Source: antrea.io/antrea/pkg/controller/networkpolicy (interfaces: EndpointQuerier)
+// Source: antrea.io/antrea/pkg/controller/networkpolicy (interfaces: EndpointQuerier,PolicyAnalysisQuerier)
 //
 // Generated by this command:
 //
-//	mockgen -copyright_file hack/boilerplate/license_header.raw.txt -destination pkg/controller/networkpolicy/testing/mock_networkpolicy.go -package testing antrea.io/antrea/pkg/controller/networkpolicy EndpointQuerier
+//	mockgen -copyright_file hack/boilerplate/license_header.raw.txt -destination pkg/controller/networkpolicy/testing/mock_networkpolicy.go -package testing antrea.io/antrea/pkg/controller/networkpolicy EndpointQuerier,PolicyAnalysisQuerier
 //
 // Package testing is a generated GoMock package.
 package testing
@@ -67,3 +67,40 @@ func (mr *MockEndpointQuerierMockRecorder) QueryNetworkPolicies(arg0, arg1 any)
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryNetworkPolicies", reflect.TypeOf((*MockEndpointQuerier)(nil).QueryNetworkPolicies), arg0, arg1)
 }
+
+// MockPolicyAnalysisQuerier is a mock of PolicyAnalysisQuerier interface.
+type MockPolicyAnalysisQuerier struct {
+	ctrl     *gomock.Controller
+	recorder *MockPolicyAnalysisQuerierMockRecorder
+}
+
+// MockPolicyAnalysisQuerierMockRecorder is the mock recorder for MockPolicyAnalysisQuerier.
+type MockPolicyAnalysisQuerierMockRecorder struct {
+	mock *MockPolicyAnalysisQuerier
+}
+
+// NewMockPolicyAnalysisQuerier creates a new mock instance.
+func NewMockPolicyAnalysisQuerier(ctrl *gomock.Controller) *MockPolicyAnalysisQuerier {
+	mock := &MockPolicyAnalysisQuerier{ctrl: ctrl}
+	mock.recorder = &MockPolicyAnalysisQuerierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPolicyAnalysisQuerier) EXPECT() *MockPolicyAnalysisQuerierMockRecorder {
+	return m.recorder
+}
+
+// AnalyzePolicies mocks base method.
+func (m *MockPolicyAnalysisQuerier) AnalyzePolicies() []networkpolicy.PolicyAnalysisFinding {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AnalyzePolicies")
+	ret0, _ := ret[0].([]networkpolicy.PolicyAnalysisFinding)
+	return ret0
+}
+
+// AnalyzePolicies indicates an expected call of AnalyzePolicies.
+func (mr *MockPolicyAnalysisQuerierMockRecorder) AnalyzePolicies() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AnalyzePolicies", reflect.TypeOf((*MockPolicyAnalysisQuerier)(nil).AnalyzePolicies))
+}
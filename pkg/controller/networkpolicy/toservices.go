@@ -0,0 +1,295 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkpolicy
+
+import (
+	"fmt"
+	"net"
+	"reflect"
+	"sort"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2"
+
+	"antrea.io/antrea/pkg/apis/controlplane"
+	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
+	"antrea.io/antrea/pkg/util/k8s"
+)
+
+// svcRefPeer is a NetworkPolicyPeer resolved from a Service referred to in the toServices field of a rule, along with
+// the Services (i.e. protocols and ports) the traffic to the peer must match.
+type svcRefPeer struct {
+	peer     *controlplane.NetworkPolicyPeer
+	services []controlplane.Service
+}
+
+// serviceNeedsResolution returns whether the traffic to a Service cannot be matched by the ClusterIP and the group ID
+// allocated to the Service by AntreaProxy, in which case the Service must be resolved to its external name or to its
+// Endpoints by antrea-controller.
+func serviceNeedsResolution(svc *v1.Service) bool {
+	return svc.Spec.Type == v1.ServiceTypeExternalName || svc.Spec.ClusterIP == v1.ClusterIPNone || len(svc.Spec.Selector) == 0
+}
+
+// serviceResolutionChanged returns whether a Service update changes the peers it is resolved to.
+func serviceResolutionChanged(oldSvc, curSvc *v1.Service) bool {
+	if !serviceNeedsResolution(oldSvc) && !serviceNeedsResolution(curSvc) {
+		return false
+	}
+	return oldSvc.Spec.Type != curSvc.Spec.Type ||
+		oldSvc.Spec.ExternalName != curSvc.Spec.ExternalName ||
+		oldSvc.Spec.ClusterIP != curSvc.Spec.ClusterIP ||
+		(len(oldSvc.Spec.Selector) == 0) != (len(curSvc.Spec.Selector) == 0) ||
+		!reflect.DeepEqual(oldSvc.Spec.Ports, curSvc.Spec.Ports)
+}
+
+// servicesReferredInRules returns the keys of the Services referred to in the toServices field of the rules. The
+// default Namespace is used for the Service references without Namespace.
+func servicesReferredInRules(rules []crdv1beta1.Rule, defaultNamespace string) []string {
+	svcKeys := sets.New[string]()
+	for _, rule := range rules {
+		for _, svcRef := range rule.ToServices {
+			if svcRef.Scope == crdv1beta1.ScopeClusterSet {
+				continue
+			}
+			svcNS := defaultNamespace
+			if svcRef.Namespace != "" {
+				svcNS = svcRef.Namespace
+			}
+			svcKeys.Insert(k8s.NamespacedName(svcNS, svcRef.Name))
+		}
+	}
+	return sets.List(svcKeys)
+}
+
+// resolveSvcRefsForCRD resolves the Services referred to in the toServices field of a rule which need to be resolved
+// by antrea-controller: ExternalName Services are resolved to their external names, which are then matched by the
+// FQDN policy implementation of antrea-agent, while headless and selectorless Services are resolved to the addresses
+// and ports of their Endpoints, as found in their EndpointSlices.
+// The returned peers complement the peer returned by svcRefToPeerForCRD, which still refers to all the Services.
+func (n *NetworkPolicyController) resolveSvcRefsForCRD(svcRefs []crdv1beta1.PeerService, defaultNamespace string) []svcRefPeer {
+	var peers []svcRefPeer
+	for _, svcRef := range svcRefs {
+		// Multi-cluster Services are always exported with a ClusterIP.
+		if svcRef.Scope == crdv1beta1.ScopeClusterSet {
+			continue
+		}
+		svcNS := defaultNamespace
+		if svcRef.Namespace != "" {
+			svcNS = svcRef.Namespace
+		}
+		svc, err := n.serviceLister.Services(svcNS).Get(svcRef.Name)
+		if err != nil {
+			// The Service will be resolved again when it is created.
+			klog.V(2).InfoS("Service referred to in toServices not found", "service", klog.KRef(svcNS, svcRef.Name))
+			continue
+		}
+		if !serviceNeedsResolution(svc) {
+			continue
+		}
+		if svc.Spec.Type == v1.ServiceTypeExternalName {
+			if peer := externalNameToPeer(svc); peer != nil {
+				peers = append(peers, *peer)
+			}
+			continue
+		}
+		peers = append(peers, n.endpointsToPeers(svc)...)
+	}
+	return peers
+}
+
+// externalNameToPeer converts the external name of an ExternalName Service to a peer. The traffic to the peer is
+// restricted to the ports of the Service if any is specified.
+func externalNameToPeer(svc *v1.Service) *svcRefPeer {
+	name := strings.ToLower(strings.TrimSuffix(svc.Spec.ExternalName, "."))
+	if name == "" {
+		return nil
+	}
+	peer := &controlplane.NetworkPolicyPeer{}
+	// Even though it is not recommended, the external name may be an IP address.
+	if ip := net.ParseIP(name); ip != nil {
+		peer.IPBlocks = []controlplane.IPBlock{ipToIPBlock(ip)}
+	} else {
+		peer.FQDNs = []string{name}
+	}
+	var services []controlplane.Service
+	for i := range svc.Spec.Ports {
+		port := intstr.FromInt(int(svc.Spec.Ports[i].Port))
+		services = append(services, controlplane.Service{
+			Protocol: toAntreaProtocol(&svc.Spec.Ports[i].Protocol),
+			Port:     &port,
+		})
+	}
+	return &svcRefPeer{peer: peer, services: services}
+}
+
+// endpointsToPeers converts the Endpoints of a Service to peers. The addresses of the EndpointSlices which have the
+// same ports are merged into the same peer.
+func (n *NetworkPolicyController) endpointsToPeers(svc *v1.Service) []svcRefPeer {
+	selector := labels.Set{discoveryv1.LabelServiceName: svc.Name}.AsSelector()
+	endpointSlices, err := n.endpointSliceLister.EndpointSlices(svc.Namespace).List(selector)
+	if err != nil {
+		klog.ErrorS(err, "Failed to list EndpointSlices of Service", "service", klog.KObj(svc))
+		return nil
+	}
+	addressesByPorts := map[string]sets.Set[string]{}
+	servicesByPorts := map[string][]controlplane.Service{}
+	for _, endpointSlice := range endpointSlices {
+		// The FQDN address type is deprecated and not supported.
+		if endpointSlice.AddressType != discoveryv1.AddressTypeIPv4 && endpointSlice.AddressType != discoveryv1.AddressTypeIPv6 {
+			continue
+		}
+		portsKey, services := endpointPortsToServices(endpointSlice.Ports)
+		addresses, exists := addressesByPorts[portsKey]
+		if !exists {
+			addresses = sets.New[string]()
+			addressesByPorts[portsKey] = addresses
+			servicesByPorts[portsKey] = services
+		}
+		for _, endpoint := range endpointSlice.Endpoints {
+			addresses.Insert(endpoint.Addresses...)
+		}
+	}
+	// Sort the peers and their addresses so that the resulting rules are stable.
+	portsKeys := sets.List(sets.KeySet(addressesByPorts))
+	peers := make([]svcRefPeer, 0, len(portsKeys))
+	for _, portsKey := range portsKeys {
+		peer := &controlplane.NetworkPolicyPeer{}
+		for _, address := range sets.List(addressesByPorts[portsKey]) {
+			if ip := net.ParseIP(address); ip != nil {
+				peer.IPBlocks = append(peer.IPBlocks, ipToIPBlock(ip))
+			}
+		}
+		if len(peer.IPBlocks) == 0 {
+			continue
+		}
+		peers = append(peers, svcRefPeer{peer: peer, services: servicesByPorts[portsKey]})
+	}
+	return peers
+}
+
+// endpointPortsToServices converts the ports of an EndpointSlice to Services, and returns a key identifying them. An
+// EndpointSlice without ports allows all ports.
+func endpointPortsToServices(endpointPorts []discoveryv1.EndpointPort) (string, []controlplane.Service) {
+	var services []controlplane.Service
+	var portKeys []string
+	for _, endpointPort := range endpointPorts {
+		service := controlplane.Service{Protocol: toAntreaProtocol(endpointPort.Protocol)}
+		portKey := string(*service.Protocol)
+		if endpointPort.Port != nil {
+			port := intstr.FromInt(int(*endpointPort.Port))
+			service.Port = &port
+			portKey = fmt.Sprintf("%s/%d", portKey, *endpointPort.Port)
+		}
+		services = append(services, service)
+		portKeys = append(portKeys, portKey)
+	}
+	sort.Strings(portKeys)
+	return strings.Join(portKeys, ","), services
+}
+
+func ipToIPBlock(ip net.IP) controlplane.IPBlock {
+	prefixLength := int32(net.IPv6len * 8)
+	if ip.To4() != nil {
+		prefixLength = net.IPv4len * 8
+	}
+	return controlplane.IPBlock{
+		CIDR:   controlplane.IPNet{IP: controlplane.IPAddress(ip), PrefixLength: prefixLength},
+		Except: []controlplane.IPNet{},
+	}
+}
+
+// triggerPolicyUpdatesForService triggers processing of the Antrea-native policies which refer to the Service in the
+// toServices field of their rules.
+func (n *NetworkPolicyController) triggerPolicyUpdatesForService(namespace, name string) {
+	svcKey := k8s.NamespacedName(namespace, name)
+	cnps, _ := n.acnpInformer.Informer().GetIndexer().ByIndex(ServiceIndex, svcKey)
+	for _, obj := range cnps {
+		n.enqueueInternalNetworkPolicy(getACNPReference(obj.(*crdv1beta1.ClusterNetworkPolicy)))
+	}
+	annps, _ := n.annpInformer.Informer().GetIndexer().ByIndex(ServiceIndex, svcKey)
+	for _, obj := range annps {
+		n.enqueueInternalNetworkPolicy(getANNPReference(obj.(*crdv1beta1.NetworkPolicy)))
+	}
+}
+
+// triggerPolicyUpdatesForEndpointSlice triggers processing of the Antrea-native policies which refer to the Service
+// owning the EndpointSlice, if the Service is resolved to its Endpoints.
+func (n *NetworkPolicyController) triggerPolicyUpdatesForEndpointSlice(endpointSlice *discoveryv1.EndpointSlice) {
+	svcName := endpointSlice.Labels[discoveryv1.LabelServiceName]
+	if svcName == "" {
+		return
+	}
+	svc, err := n.serviceLister.Services(endpointSlice.Namespace).Get(svcName)
+	if err != nil || !serviceNeedsResolution(svc) {
+		return
+	}
+	n.triggerPolicyUpdatesForService(endpointSlice.Namespace, svcName)
+}
+
+func (n *NetworkPolicyController) addEndpointSlice(obj interface{}) {
+	defer n.heartbeat("addEndpointSlice")
+	endpointSlice := obj.(*discoveryv1.EndpointSlice)
+	klog.V(2).InfoS("Processing EndpointSlice ADD event", "endpointSlice", klog.KObj(endpointSlice))
+	n.triggerPolicyUpdatesForEndpointSlice(endpointSlice)
+}
+
+func (n *NetworkPolicyController) updateEndpointSlice(oldObj, curObj interface{}) {
+	defer n.heartbeat("updateEndpointSlice")
+	oldEndpointSlice := oldObj.(*discoveryv1.EndpointSlice)
+	curEndpointSlice := curObj.(*discoveryv1.EndpointSlice)
+	// The conditions of the Endpoints don't affect the resolved peers.
+	if reflect.DeepEqual(oldEndpointSlice.Ports, curEndpointSlice.Ports) && endpointAddressesEqual(oldEndpointSlice, curEndpointSlice) {
+		return
+	}
+	klog.V(2).InfoS("Processing EndpointSlice UPDATE event", "endpointSlice", klog.KObj(curEndpointSlice))
+	n.triggerPolicyUpdatesForEndpointSlice(curEndpointSlice)
+}
+
+func (n *NetworkPolicyController) deleteEndpointSlice(old interface{}) {
+	endpointSlice, ok := old.(*discoveryv1.EndpointSlice)
+	if !ok {
+		tombstone, ok := old.(cache.DeletedFinalStateUnknown)
+		if !ok {
+			klog.Errorf("Error decoding object when deleting EndpointSlice, invalid type: %v", old)
+			return
+		}
+		endpointSlice, ok = tombstone.Obj.(*discoveryv1.EndpointSlice)
+		if !ok {
+			klog.Errorf("Error decoding object tombstone when deleting EndpointSlice, invalid type: %v", tombstone.Obj)
+			return
+		}
+	}
+	defer n.heartbeat("deleteEndpointSlice")
+	klog.V(2).InfoS("Processing EndpointSlice DELETE event", "endpointSlice", klog.KObj(endpointSlice))
+	n.triggerPolicyUpdatesForEndpointSlice(endpointSlice)
+}
+
+func endpointAddressesEqual(oldEndpointSlice, curEndpointSlice *discoveryv1.EndpointSlice) bool {
+	if len(oldEndpointSlice.Endpoints) != len(curEndpointSlice.Endpoints) {
+		return false
+	}
+	for i := range oldEndpointSlice.Endpoints {
+		if !reflect.DeepEqual(oldEndpointSlice.Endpoints[i].Addresses, curEndpointSlice.Endpoints[i].Addresses) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,317 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkpolicy
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/pointer"
+
+	"antrea.io/antrea/pkg/apis/controlplane"
+	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
+)
+
+func newTestService(namespace, name string, mutate func(*v1.Service)) *v1.Service {
+	svc := &v1.Service{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+		Spec: v1.ServiceSpec{
+			Type:      v1.ServiceTypeClusterIP,
+			ClusterIP: "10.96.0.10",
+			Selector:  map[string]string{"app": name},
+			Ports:     []v1.ServicePort{{Protocol: v1.ProtocolTCP, Port: 80}},
+		},
+	}
+	if mutate != nil {
+		mutate(svc)
+	}
+	return svc
+}
+
+func newTestEndpointSlice(namespace, name, svcName string, addressType discoveryv1.AddressType, ports []discoveryv1.EndpointPort, addresses ...string) *discoveryv1.EndpointSlice {
+	endpointSlice := &discoveryv1.EndpointSlice{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      name,
+			Labels:    map[string]string{discoveryv1.LabelServiceName: svcName},
+		},
+		AddressType: addressType,
+		Ports:       ports,
+	}
+	for _, address := range addresses {
+		endpointSlice.Endpoints = append(endpointSlice.Endpoints, discoveryv1.Endpoint{Addresses: []string{address}})
+	}
+	return endpointSlice
+}
+
+func newTestIPBlock(ip string) controlplane.IPBlock {
+	return ipToIPBlock(net.ParseIP(ip))
+}
+
+func TestResolveSvcRefsForCRD(t *testing.T) {
+	protocolTCP := controlplane.ProtocolTCP
+	protocolUDP := controlplane.ProtocolUDP
+	port53 := intstr.FromInt(53)
+	port80 := intstr.FromInt(80)
+	port5432 := intstr.FromInt(5432)
+	protocolTCPv1 := v1.ProtocolTCP
+	protocolUDPv1 := v1.ProtocolUDP
+	tcpPort80 := []discoveryv1.EndpointPort{{Protocol: &protocolTCPv1, Port: pointer.Int32(80)}}
+	dnsPorts := []discoveryv1.EndpointPort{
+		{Protocol: &protocolUDPv1, Port: pointer.Int32(53)},
+		{Protocol: &protocolTCPv1, Port: pointer.Int32(53)},
+	}
+	services := []*v1.Service{
+		newTestService("ns1", "regular", nil),
+		newTestService("ns1", "payments-db", func(svc *v1.Service) {
+			svc.Spec.Type = v1.ServiceTypeExternalName
+			svc.Spec.ClusterIP = ""
+			svc.Spec.Selector = nil
+			svc.Spec.ExternalName = "Payments.Example.com."
+			svc.Spec.Ports = []v1.ServicePort{{Protocol: v1.ProtocolTCP, Port: 5432}}
+		}),
+		newTestService("ns1", "legacy", func(svc *v1.Service) {
+			svc.Spec.Type = v1.ServiceTypeExternalName
+			svc.Spec.ClusterIP = ""
+			svc.Spec.Selector = nil
+			svc.Spec.ExternalName = "192.168.10.1"
+			svc.Spec.Ports = nil
+		}),
+		newTestService("ns1", "headless", func(svc *v1.Service) {
+			svc.Spec.ClusterIP = v1.ClusterIPNone
+		}),
+		newTestService("ns2", "selectorless", func(svc *v1.Service) {
+			svc.Spec.Selector = nil
+		}),
+	}
+	endpointSlices := []*discoveryv1.EndpointSlice{
+		newTestEndpointSlice("ns1", "regular-1", "regular", discoveryv1.AddressTypeIPv4, tcpPort80, "10.10.0.1"),
+		newTestEndpointSlice("ns1", "headless-1", "headless", discoveryv1.AddressTypeIPv4, tcpPort80, "10.10.1.2", "10.10.1.1"),
+		newTestEndpointSlice("ns1", "headless-2", "headless", discoveryv1.AddressTypeIPv4, tcpPort80, "10.10.1.3", "10.10.1.1"),
+		newTestEndpointSlice("ns1", "headless-3", "headless", discoveryv1.AddressTypeIPv4, dnsPorts, "10.10.1.4"),
+		newTestEndpointSlice("ns1", "headless-4", "headless", discoveryv1.AddressTypeFQDN, tcpPort80, "db.example.com"),
+		newTestEndpointSlice("ns2", "selectorless-1", "selectorless", discoveryv1.AddressTypeIPv6, nil, "2001:db8::1"),
+	}
+
+	tests := []struct {
+		name             string
+		svcRefs          []crdv1beta1.PeerService
+		defaultNamespace string
+		expectedPeers    []svcRefPeer
+	}{
+		{
+			name:    "Service with ClusterIP and selector",
+			svcRefs: []crdv1beta1.PeerService{{Namespace: "ns1", Name: "regular"}},
+		},
+		{
+			name:    "Service not found",
+			svcRefs: []crdv1beta1.PeerService{{Namespace: "ns1", Name: "missing"}},
+		},
+		{
+			name:    "ClusterSet scoped Service",
+			svcRefs: []crdv1beta1.PeerService{{Name: "headless", Scope: crdv1beta1.ScopeClusterSet}},
+		},
+		{
+			name:             "ExternalName Service",
+			svcRefs:          []crdv1beta1.PeerService{{Name: "payments-db"}},
+			defaultNamespace: "ns1",
+			expectedPeers: []svcRefPeer{
+				{
+					peer:     &controlplane.NetworkPolicyPeer{FQDNs: []string{"payments.example.com"}},
+					services: []controlplane.Service{{Protocol: &protocolTCP, Port: &port5432}},
+				},
+			},
+		},
+		{
+			name:    "ExternalName Service with IP",
+			svcRefs: []crdv1beta1.PeerService{{Namespace: "ns1", Name: "legacy"}},
+			expectedPeers: []svcRefPeer{
+				{
+					peer: &controlplane.NetworkPolicyPeer{IPBlocks: []controlplane.IPBlock{newTestIPBlock("192.168.10.1")}},
+				},
+			},
+		},
+		{
+			name:    "headless Service",
+			svcRefs: []crdv1beta1.PeerService{{Namespace: "ns1", Name: "headless"}},
+			expectedPeers: []svcRefPeer{
+				{
+					peer: &controlplane.NetworkPolicyPeer{IPBlocks: []controlplane.IPBlock{newTestIPBlock("10.10.1.4")}},
+					services: []controlplane.Service{
+						{Protocol: &protocolUDP, Port: &port53},
+						{Protocol: &protocolTCP, Port: &port53},
+					},
+				},
+				{
+					peer: &controlplane.NetworkPolicyPeer{IPBlocks: []controlplane.IPBlock{
+						newTestIPBlock("10.10.1.1"),
+						newTestIPBlock("10.10.1.2"),
+						newTestIPBlock("10.10.1.3"),
+					}},
+					services: []controlplane.Service{{Protocol: &protocolTCP, Port: &port80}},
+				},
+			},
+		},
+		{
+			name: "selectorless Service and Service with ClusterIP",
+			svcRefs: []crdv1beta1.PeerService{
+				{Namespace: "ns1", Name: "regular"},
+				{Namespace: "ns2", Name: "selectorless"},
+			},
+			expectedPeers: []svcRefPeer{
+				{
+					peer: &controlplane.NetworkPolicyPeer{IPBlocks: []controlplane.IPBlock{newTestIPBlock("2001:db8::1")}},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, c := newController(nil, nil)
+			for _, svc := range services {
+				require.NoError(t, c.serviceStore.Add(svc))
+			}
+			endpointSliceStore := c.informerFactory.Discovery().V1().EndpointSlices().Informer().GetStore()
+			for _, endpointSlice := range endpointSlices {
+				require.NoError(t, endpointSliceStore.Add(endpointSlice))
+			}
+			assert.Equal(t, tt.expectedPeers, c.resolveSvcRefsForCRD(tt.svcRefs, tt.defaultNamespace))
+		})
+	}
+}
+
+func TestServiceResolutionChanged(t *testing.T) {
+	tests := []struct {
+		name     string
+		oldSvc   *v1.Service
+		curSvc   *v1.Service
+		expected bool
+	}{
+		{
+			name:   "Service with ClusterIP and selector updated",
+			oldSvc: newTestService("ns1", "svc1", nil),
+			curSvc: newTestService("ns1", "svc1", func(svc *v1.Service) {
+				svc.Spec.Ports = []v1.ServicePort{{Protocol: v1.ProtocolTCP, Port: 443}}
+			}),
+			expected: false,
+		},
+		{
+			name:   "Service changed to ExternalName",
+			oldSvc: newTestService("ns1", "svc1", nil),
+			curSvc: newTestService("ns1", "svc1", func(svc *v1.Service) {
+				svc.Spec.Type = v1.ServiceTypeExternalName
+				svc.Spec.ExternalName = "svc1.example.com"
+			}),
+			expected: true,
+		},
+		{
+			name: "external name updated",
+			oldSvc: newTestService("ns1", "svc1", func(svc *v1.Service) {
+				svc.Spec.Type = v1.ServiceTypeExternalName
+				svc.Spec.ExternalName = "svc1.example.com"
+			}),
+			curSvc: newTestService("ns1", "svc1", func(svc *v1.Service) {
+				svc.Spec.Type = v1.ServiceTypeExternalName
+				svc.Spec.ExternalName = "svc2.example.com"
+			}),
+			expected: true,
+		},
+		{
+			name: "selector of headless Service updated",
+			oldSvc: newTestService("ns1", "svc1", func(svc *v1.Service) {
+				svc.Spec.ClusterIP = v1.ClusterIPNone
+			}),
+			curSvc: newTestService("ns1", "svc1", func(svc *v1.Service) {
+				svc.Spec.ClusterIP = v1.ClusterIPNone
+				svc.Spec.Selector = map[string]string{"app": "other"}
+			}),
+			expected: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, serviceResolutionChanged(tt.oldSvc, tt.curSvc))
+		})
+	}
+}
+
+func TestTriggerPolicyUpdatesForEndpointSlice(t *testing.T) {
+	allowAction := crdv1beta1.RuleActionAllow
+	annp := &crdv1beta1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "annp1", UID: "uid1"},
+		Spec: crdv1beta1.NetworkPolicySpec{
+			AppliedTo: []crdv1beta1.AppliedTo{{PodSelector: &metav1.LabelSelector{}}},
+			Egress: []crdv1beta1.Rule{
+				{
+					Action:     &allowAction,
+					ToServices: []crdv1beta1.PeerService{{Name: "headless"}, {Name: "regular"}},
+				},
+			},
+		},
+	}
+	acnp := &crdv1beta1.ClusterNetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "acnp1", UID: "uid2"},
+		Spec: crdv1beta1.ClusterNetworkPolicySpec{
+			AppliedTo: []crdv1beta1.AppliedTo{{PodSelector: &metav1.LabelSelector{}}},
+			Egress: []crdv1beta1.Rule{
+				{
+					Action:     &allowAction,
+					ToServices: []crdv1beta1.PeerService{{Namespace: "ns1", Name: "headless"}},
+				},
+			},
+		},
+	}
+	tests := []struct {
+		name         string
+		svcName      string
+		expectedKeys []controlplane.NetworkPolicyReference
+	}{
+		{
+			name:         "headless Service",
+			svcName:      "headless",
+			expectedKeys: []controlplane.NetworkPolicyReference{*getACNPReference(acnp), *getANNPReference(annp)},
+		},
+		{
+			name:    "Service with ClusterIP and selector",
+			svcName: "regular",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, c := newController(nil, nil)
+			require.NoError(t, c.serviceStore.Add(newTestService("ns1", "headless", func(svc *v1.Service) {
+				svc.Spec.ClusterIP = v1.ClusterIPNone
+			})))
+			require.NoError(t, c.serviceStore.Add(newTestService("ns1", "regular", nil)))
+			require.NoError(t, c.annpStore.Add(annp))
+			require.NoError(t, c.acnpStore.Add(acnp))
+
+			c.addEndpointSlice(newTestEndpointSlice("ns1", tt.svcName+"-1", tt.svcName, discoveryv1.AddressTypeIPv4, nil, "10.10.0.1"))
+			var keys []controlplane.NetworkPolicyReference
+			for c.internalNetworkPolicyQueue.Len() > 0 {
+				key, _ := c.internalNetworkPolicyQueue.Get()
+				keys = append(keys, key.(controlplane.NetworkPolicyReference))
+				c.internalNetworkPolicyQueue.Done(key)
+			}
+			assert.ElementsMatch(t, tt.expectedKeys, keys)
+		})
+	}
+}
@@ -27,6 +27,7 @@ import (
 	authenticationv1 "k8s.io/api/authentication/v1"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation"
@@ -86,8 +87,22 @@ var (
 	// allowedFQDNChars validates that the matchPattern field contains only valid DNS characters
 	// and the wildcard '*' character.
 	allowedFQDNChars = regexp.MustCompile("^[-0-9a-zA-Z.*]+$")
+	// allowedGRPCNameChars validates that the service and method fields of gRPC protocol contain only
+	// valid characters of Protobuf identifiers.
+	allowedGRPCNameChars = regexp.MustCompile("^[0-9a-zA-Z_.]+$")
+	// allowedKafkaTopicChars validates that the topic field of Kafka protocol contains only valid
+	// characters of Kafka topic names.
+	allowedKafkaTopicChars = regexp.MustCompile("^[-0-9a-zA-Z._]+$")
+	// allowedHTTPHeaderNameChars validates that the name of HTTP headers contains only valid
+	// characters of HTTP tokens.
+	allowedHTTPHeaderNameChars = regexp.MustCompile("^[-!#$%&'*+.^_`|~0-9a-zA-Z]+$")
 )
 
+// maxSupportedRateLimitRules is the maximum number of rules with the RateLimit action. It must match the number of
+// rules for which antrea-agent can allocate meters, i.e. the size of its meter ID range (64512) divided by the number
+// of meters of each rule (64).
+const maxSupportedRateLimitRules = 1008
+
 // RegisterAntreaPolicyValidator registers an Antrea-native policy validator
 // to the resource registry. A new validator must be registered by calling
 // this function before the Run phase of the APIServer.
@@ -495,6 +510,7 @@ func (v *antreaPolicyValidator) validatePolicy(curObj interface{}) (string, bool
 	var tier string
 	var ingress, egress []crdv1beta1.Rule
 	var specAppliedTo []crdv1beta1.AppliedTo
+	var enforcementMode crdv1beta1.PolicyEnforcementMode
 	switch curObj.(type) {
 	case *crdv1beta1.ClusterNetworkPolicy:
 		curACNP := curObj.(*crdv1beta1.ClusterNetworkPolicy)
@@ -502,12 +518,14 @@ func (v *antreaPolicyValidator) validatePolicy(curObj interface{}) (string, bool
 		ingress = curACNP.Spec.Ingress
 		egress = curACNP.Spec.Egress
 		specAppliedTo = curACNP.Spec.AppliedTo
+		enforcementMode = curACNP.Spec.EnforcementMode
 	case *crdv1beta1.NetworkPolicy:
 		curANNP := curObj.(*crdv1beta1.NetworkPolicy)
 		tier = curANNP.Spec.Tier
 		ingress = curANNP.Spec.Ingress
 		egress = curANNP.Spec.Egress
 		specAppliedTo = curANNP.Spec.AppliedTo
+		enforcementMode = curANNP.Spec.EnforcementMode
 	}
 	reason, allowed := v.validateTierForPolicy(tier)
 	if !allowed {
@@ -548,6 +566,26 @@ func (v *antreaPolicyValidator) validatePolicy(curObj interface{}) (string, bool
 	if !allowed {
 		return reason, allowed
 	}
+	reason, allowed = v.validateRuleSchedules(ingress, egress)
+	if !allowed {
+		return reason, allowed
+	}
+	reason, allowed = v.validateRuleRateLimits(specAppliedTo, ingress, egress)
+	if !allowed {
+		return reason, allowed
+	}
+	reason, allowed = v.validateRateLimitRuleBudget(curObj.(metav1.Object), ingress, egress)
+	if !allowed {
+		return reason, allowed
+	}
+	reason, allowed = v.validateEnforcementMode(enforcementMode, specAppliedTo, ingress, egress)
+	if !allowed {
+		return reason, allowed
+	}
+	reason, allowed = v.validateStretchedEgressRules(specAppliedTo, egress)
+	if !allowed {
+		return reason, allowed
+	}
 	if err := v.validatePort(ingress, egress); err != nil {
 		return err.Error(), false
 	}
@@ -862,6 +900,9 @@ func (v *antreaPolicyValidator) validateMulticastIGMP(ingressRules, egressRules
 func (v *antreaPolicyValidator) validateL7Protocols(ingressRules, egressRules []crdv1beta1.Rule) (string, bool) {
 	for _, r := range append(ingressRules, egressRules...) {
 		if len(r.L7Protocols) == 0 {
+			if r.L7DenyResponse != nil {
+				return "l7DenyResponse can only be used when layer 7 protocols are set", false
+			}
 			continue
 		}
 		if !features.DefaultFeatureGate.Enabled(features.L7NetworkPolicy) {
@@ -873,26 +914,325 @@ func (v *antreaPolicyValidator) validateL7Protocols(ingressRules, egressRules []
 		if len(r.ToServices) != 0 {
 			return "layer 7 protocols can not be used with toServices", false
 		}
-		haveHTTP := false
+		// tcpOnlyProtocol is the first layer 7 protocol in the rule which can only be carried over TCP.
+		tcpOnlyProtocol := ""
+		haveDNS := false
 		for _, p := range r.L7Protocols {
+			if tcpOnlyProtocol == "" {
+				switch {
+				case p.HTTP != nil:
+					tcpOnlyProtocol = "HTTP"
+				case p.GRPC != nil:
+					tcpOnlyProtocol = "gRPC"
+				case p.Kafka != nil:
+					tcpOnlyProtocol = "Kafka"
+				}
+			}
 			if p.HTTP != nil {
-				haveHTTP = true
+				if reason, allowed := validateHTTPProtocol(p.HTTP); !allowed {
+					return reason, false
+				}
+			}
+			if p.DNS != nil {
+				haveDNS = true
+				if p.DNS.QueryName != "" && !allowedFQDNChars.MatchString(p.DNS.QueryName) {
+					return fmt.Sprintf("invalid characters in DNS queryName: %s", p.DNS.QueryName), false
+				}
+			}
+			if p.GRPC != nil {
+				for _, name := range []string{p.GRPC.Service, p.GRPC.Method} {
+					if name != "" && !allowedGRPCNameChars.MatchString(name) {
+						return fmt.Sprintf("invalid characters in gRPC service or method: %s", name), false
+					}
+				}
+			}
+			if p.Kafka != nil && p.Kafka.Topic != "" && !allowedKafkaTopicChars.MatchString(p.Kafka.Topic) {
+				return fmt.Sprintf("invalid characters in Kafka topic: %s", p.Kafka.Topic), false
 			}
 		}
 		for _, port := range r.Ports {
-			if haveHTTP && (port.Protocol != nil && *port.Protocol != v1.ProtocolTCP) {
-				return "HTTP protocol can only be used when layer 4 protocol is TCP or unset", false
+			if tcpOnlyProtocol != "" && (port.Protocol != nil && *port.Protocol != v1.ProtocolTCP) {
+				return fmt.Sprintf("%s protocol can only be used when layer 4 protocol is TCP or unset", tcpOnlyProtocol), false
+			}
+			if haveDNS && (port.Protocol != nil && *port.Protocol != v1.ProtocolTCP && *port.Protocol != v1.ProtocolUDP) {
+				return "DNS protocol can only be used when layer 4 protocol is TCP, UDP or unset", false
+			}
+		}
+		for _, protocol := range r.Protocols {
+			if tcpOnlyProtocol != "" && (protocol.IGMP != nil || protocol.ICMP != nil) {
+				return fmt.Sprintf("%s protocol can not be used with protocol IGMP or ICMP", tcpOnlyProtocol), false
+			}
+			if haveDNS && (protocol.IGMP != nil || protocol.ICMP != nil) {
+				return "DNS protocol can not be used with protocol IGMP or ICMP", false
+			}
+		}
+		if r.L7DenyResponse != nil {
+			if reason, allowed := validateL7DenyResponse(r.L7DenyResponse, r.L7Protocols); !allowed {
+				return reason, false
+			}
+		}
+	}
+	return "", true
+}
+
+// validateL7DenyResponse validates that the responses to denied requests are only specified for
+// the layer 7 protocols matched by the rule.
+func validateL7DenyResponse(denyResponse *crdv1beta1.L7DenyResponse, l7Protocols []crdv1beta1.L7Protocol) (string, bool) {
+	haveHTTP, haveTLS := false, false
+	for _, p := range l7Protocols {
+		haveHTTP = haveHTTP || p.HTTP != nil
+		haveTLS = haveTLS || p.TLS != nil
+	}
+	if denyResponse.HTTP != nil && !haveHTTP {
+		return "HTTP deny response can only be used when HTTP protocol is set", false
+	}
+	if denyResponse.TLS != nil {
+		if !haveTLS {
+			return "TLS deny response can only be used when TLS protocol is set", false
+		}
+		switch denyResponse.TLS.Alert {
+		case "", crdv1beta1.TLSAlertAccessDenied, crdv1beta1.TLSAlertHandshakeFailure, crdv1beta1.TLSAlertUnrecognizedName:
+		default:
+			return fmt.Sprintf("invalid TLS alert %s", denyResponse.TLS.Alert), false
+		}
+	}
+	return "", true
+}
+
+// validateHTTPProtocol validates the path, headers and query parameters of an HTTP protocol.
+func validateHTTPProtocol(http *crdv1beta1.HTTPProtocol) (string, bool) {
+	if http.PathMatchType != "" && http.Path == "" {
+		return "pathMatchType can only be used when path is set", false
+	}
+	if reason, allowed := validateHTTPMatch("path", http.Path, http.PathMatchType); !allowed {
+		return reason, false
+	}
+	for _, header := range http.Headers {
+		if !allowedHTTPHeaderNameChars.MatchString(header.Name) {
+			return fmt.Sprintf("invalid HTTP header name: %q", header.Name), false
+		}
+		if reason, allowed := validateHTTPMatch(fmt.Sprintf("header %s", header.Name), header.Value, header.MatchType); !allowed {
+			return reason, false
+		}
+	}
+	for _, param := range http.QueryParams {
+		if param.Name == "" {
+			return "the name of HTTP query parameters must not be empty", false
+		}
+		if reason, allowed := validateHTTPMatch(fmt.Sprintf("query parameter %s", param.Name), param.Value, param.MatchType); !allowed {
+			return reason, false
+		}
+	}
+	return "", true
+}
+
+// validateHTTPMatch validates a value of HTTP requests to match with the provided match type.
+func validateHTTPMatch(field, value string, matchType crdv1beta1.HTTPMatchType) (string, bool) {
+	switch matchType {
+	case "", crdv1beta1.HTTPMatchTypeExact, crdv1beta1.HTTPMatchTypePrefix:
+	case crdv1beta1.HTTPMatchTypeRegex:
+		if value == "" {
+			return fmt.Sprintf("the regular expression of HTTP %s must not be empty", field), false
+		}
+		if _, err := regexp.Compile(value); err != nil {
+			return fmt.Sprintf("invalid regular expression for HTTP %s: %v", field, err), false
+		}
+	default:
+		return fmt.Sprintf("invalid match type %s for HTTP %s", matchType, field), false
+	}
+	return "", true
+}
+
+// validateRuleSchedules validates the schedules set in Antrea-native policy rules are valid.
+func (v *antreaPolicyValidator) validateRuleSchedules(ingress, egress []crdv1beta1.Rule) (string, bool) {
+	for _, r := range append(ingress, egress...) {
+		if r.Schedule == nil {
+			continue
+		}
+		if _, err := compileSchedule(r.Schedule); err != nil {
+			return fmt.Sprintf("invalid schedule in rule %s: %v", r.Name, err), false
+		}
+	}
+	return "", true
+}
+
+// validateRuleRateLimits validates the rate limits set in Antrea-native policy rules are valid, and only set for rules
+// with the RateLimit action. As Node policies are enforced with iptables, the RateLimit action is not supported for
+// them.
+func (v *antreaPolicyValidator) validateRuleRateLimits(specAppliedTo []crdv1beta1.AppliedTo, ingress, egress []crdv1beta1.Rule) (string, bool) {
+	for _, r := range append(ingress, egress...) {
+		if *r.Action != crdv1beta1.RuleActionRateLimit {
+			if r.RateLimit != nil {
+				return "rateLimit can only be used when action is RateLimit", false
 			}
+			continue
+		}
+		if r.RateLimit == nil {
+			return "rateLimit must be set when action is RateLimit", false
+		}
+		if isAppliedToNode(specAppliedTo) || isAppliedToNode(r.AppliedTo) {
+			return "action RateLimit is not supported for policies applied to Nodes", false
+		}
+		if (r.RateLimit.PacketsPerSecond > 0) == (r.RateLimit.NewConnectionsPerSecond > 0) {
+			return "exactly one of packetsPerSecond and newConnectionsPerSecond must be set in rateLimit", false
+		}
+		if r.RateLimit.PacketsPerSecond < 0 || r.RateLimit.NewConnectionsPerSecond < 0 || r.RateLimit.Burst < 0 {
+			return "the rate and burst of rateLimit must not be negative", false
 		}
 		for _, protocol := range r.Protocols {
-			if haveHTTP && (protocol.IGMP != nil || protocol.ICMP != nil) {
-				return "HTTP protocol can not be used with protocol IGMP or ICMP", false
+			if protocol.IGMP != nil {
+				return "protocol IGMP does not support RateLimit", false
+			}
+		}
+		for _, peer := range r.To {
+			if peer.IPBlock == nil {
+				continue
+			}
+			if ip, _, err := net.ParseCIDR(peer.IPBlock.CIDR); err == nil && ip.IsMulticast() {
+				return "multicast does not support action RateLimit", false
 			}
 		}
 	}
 	return "", true
 }
 
+// validateRateLimitRuleBudget validates the number of rules with the RateLimit action in all Antrea-native policies
+// doesn't exceed maxSupportedRateLimitRules. Each of these rules uses a fixed number of OVS meters on every Node it
+// is applied to, and the meter IDs available on a Node are limited. As the policies can be applied to the same Node,
+// the limit is enforced in the whole cluster.
+func (v *antreaPolicyValidator) validateRateLimitRuleBudget(curObj metav1.Object, ingress, egress []crdv1beta1.Rule) (string, bool) {
+	count := countRateLimitRules(ingress, egress)
+	if count == 0 {
+		return "", true
+	}
+	acnps, err := v.networkPolicyController.acnpLister.List(labels.Everything())
+	if err != nil {
+		return fmt.Sprintf("failed to list ClusterNetworkPolicies: %v", err), false
+	}
+	for _, acnp := range acnps {
+		if curObj.GetNamespace() != "" || acnp.Name != curObj.GetName() {
+			count += countRateLimitRules(acnp.Spec.Ingress, acnp.Spec.Egress)
+		}
+	}
+	annps, err := v.networkPolicyController.annpLister.List(labels.Everything())
+	if err != nil {
+		return fmt.Sprintf("failed to list NetworkPolicies: %v", err), false
+	}
+	for _, annp := range annps {
+		if annp.Namespace != curObj.GetNamespace() || annp.Name != curObj.GetName() {
+			count += countRateLimitRules(annp.Spec.Ingress, annp.Spec.Egress)
+		}
+	}
+	if count > maxSupportedRateLimitRules {
+		return fmt.Sprintf("maximum number of rules with action RateLimit supported: %d", maxSupportedRateLimitRules), false
+	}
+	return "", true
+}
+
+func countRateLimitRules(ingress, egress []crdv1beta1.Rule) int {
+	count := 0
+	for _, rules := range [][]crdv1beta1.Rule{ingress, egress} {
+		for _, r := range rules {
+			if r.Action != nil && *r.Action == crdv1beta1.RuleActionRateLimit {
+				count++
+			}
+		}
+	}
+	return count
+}
+
+// validateEnforcementMode validates the enforcement mode of Antrea-native policies. The Audit mode is implemented in
+// the OVS pipeline of unicast traffic, hence it is not supported for policies applied to Nodes, or with rules matching
+// layer 7, IGMP or multicast traffic.
+func (v *antreaPolicyValidator) validateEnforcementMode(mode crdv1beta1.PolicyEnforcementMode, specAppliedTo []crdv1beta1.AppliedTo, ingress, egress []crdv1beta1.Rule) (string, bool) {
+	switch mode {
+	case "", crdv1beta1.PolicyEnforcementModeEnforce:
+		return "", true
+	case crdv1beta1.PolicyEnforcementModeAudit:
+		if !features.DefaultFeatureGate.Enabled(features.AntreaPolicyAuditMode) {
+			return "enforcementMode Audit can only be used when AntreaPolicyAuditMode is enabled", false
+		}
+	default:
+		return fmt.Sprintf("invalid enforcementMode %s", mode), false
+	}
+	if isAppliedToNode(specAppliedTo) {
+		return "enforcementMode Audit is not supported for policies applied to Nodes", false
+	}
+	for _, r := range append(ingress, egress...) {
+		if isAppliedToNode(r.AppliedTo) {
+			return "enforcementMode Audit is not supported for policies applied to Nodes", false
+		}
+		if len(r.L7Protocols) > 0 {
+			return "enforcementMode Audit is not supported for policies with layer 7 rules", false
+		}
+		for _, protocol := range r.Protocols {
+			if protocol.IGMP != nil {
+				return "enforcementMode Audit is not supported for policies with IGMP rules", false
+			}
+		}
+		for _, peer := range r.To {
+			if peer.IPBlock == nil {
+				continue
+			}
+			if ip, _, err := net.ParseCIDR(peer.IPBlock.CIDR); err == nil && ip.IsMulticast() {
+				return "enforcementMode Audit is not supported for policies with multicast rules", false
+			}
+		}
+	}
+	return "", true
+}
+
+// validateStretchedEgressRules validates the egress rules with ClusterSet scoped peers. Such a rule is
+// enforced by the member clusters of the destination Pods on the traffic from the label identities of
+// the source Pods, hence only Pod and Namespace selectors can be used in its peers and appliedTo. As
+// the rule is enforced together with the ingress rules applied to the destination Pods, it can only
+// drop or reject traffic, otherwise it would bypass the ingress rules with lower priorities.
+func (v *antreaPolicyValidator) validateStretchedEgressRules(specAppliedTo []crdv1beta1.AppliedTo, egress []crdv1beta1.Rule) (string, bool) {
+	isSelectorOnlyAppliedTo := func(appliedTo []crdv1beta1.AppliedTo) bool {
+		for _, at := range appliedTo {
+			if at.ExternalEntitySelector != nil || at.Group != "" || at.ServiceAccount != nil || at.Service != nil || at.NodeSelector != nil {
+				return false
+			}
+		}
+		return true
+	}
+	for _, r := range egress {
+		hasClusterSetScopePeer := false
+		for _, peer := range r.To {
+			if peer.Scope != crdv1beta1.ScopeClusterSet {
+				continue
+			}
+			hasClusterSetScopePeer = true
+			if peer.PodSelector == nil && peer.NamespaceSelector == nil || peer.IPBlock != nil || peer.Namespaces != nil ||
+				peer.ExternalEntitySelector != nil || peer.Group != "" || peer.FQDN != "" || peer.ServiceAccount != nil || peer.NodeSelector != nil {
+				return "only podSelector and namespaceSelector can be used in ClusterSet scoped egress peers", false
+			}
+		}
+		if !hasClusterSetScopePeer {
+			continue
+		}
+		if r.Action == nil || (*r.Action != crdv1beta1.RuleActionDrop && *r.Action != crdv1beta1.RuleActionReject) {
+			return "egress rules with ClusterSet scoped peers can only use Drop or Reject action", false
+		}
+		if len(r.L7Protocols) > 0 {
+			return "layer 7 protocols can not be used in egress rules with ClusterSet scoped peers", false
+		}
+		if !isSelectorOnlyAppliedTo(specAppliedTo) || !isSelectorOnlyAppliedTo(r.AppliedTo) {
+			return "only podSelector and namespaceSelector can be used in appliedTo of egress rules with ClusterSet scoped peers", false
+		}
+	}
+	return "", true
+}
+
+func isAppliedToNode(appliedTo []crdv1beta1.AppliedTo) bool {
+	for _, at := range appliedTo {
+		if at.NodeSelector != nil {
+			return true
+		}
+	}
+	return false
+}
+
 // validateFQDNSelectors validates the toFQDN field set in Antrea-native policy egress rules are valid.
 func (v *antreaPolicyValidator) validateFQDNSelectors(egressRules []crdv1beta1.Rule) (string, bool) {
 	for _, r := range egressRules {
@@ -31,12 +31,13 @@ import (
 )
 
 var (
-	query       = crdv1beta1.IGMPQuery
-	report      = crdv1beta1.IGMPReportV1
-	allowAction = crdv1beta1.RuleActionAllow
-	dropAction  = crdv1beta1.RuleActionDrop
-	passAction  = crdv1beta1.RuleActionPass
-	portNum80   = int32(80)
+	query           = crdv1beta1.IGMPQuery
+	report          = crdv1beta1.IGMPReportV1
+	allowAction     = crdv1beta1.RuleActionAllow
+	dropAction      = crdv1beta1.RuleActionDrop
+	passAction      = crdv1beta1.RuleActionPass
+	rateLimitAction = crdv1beta1.RuleActionRateLimit
+	portNum80       = int32(80)
 )
 
 func TestValidateAntreaClusterNetworkPolicy(t *testing.T) {
@@ -1480,6 +1481,648 @@ func TestValidateAntreaClusterNetworkPolicy(t *testing.T) {
 			operation:      admv1.Create,
 			expectedReason: "HTTP protocol can not be used with protocol IGMP or ICMP",
 		},
+		{
+			name:         "acnp-l7protocols-gRPC-used-with-UDP",
+			featureGates: map[featuregate.Feature]bool{features.L7NetworkPolicy: true},
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "ingress-rule-l7protocols",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							Service: &crdv1beta1.NamespacedName{
+								Namespace: "foo1",
+								Name:      "bar1",
+							},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Action: &allowAction,
+							Ports: []crdv1beta1.NetworkPolicyPort{
+								{
+									Protocol: &k8sProtocolUDP,
+								},
+							},
+							L7Protocols: []crdv1beta1.L7Protocol{
+								{
+									GRPC: &crdv1beta1.GRPCProtocol{
+										Service: "helloworld.Greeter",
+										Method:  "SayHello",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "gRPC protocol can only be used when layer 4 protocol is TCP or unset",
+		},
+		{
+			name:         "acnp-l7protocols-Kafka-used-with-ICMP",
+			featureGates: map[featuregate.Feature]bool{features.L7NetworkPolicy: true},
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "ingress-rule-l7protocols",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							Service: &crdv1beta1.NamespacedName{
+								Namespace: "foo1",
+								Name:      "bar1",
+							},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Action: &allowAction,
+							Protocols: []crdv1beta1.NetworkPolicyProtocol{
+								{
+									ICMP: &crdv1beta1.ICMPProtocol{},
+								},
+							},
+							L7Protocols: []crdv1beta1.L7Protocol{
+								{
+									Kafka: &crdv1beta1.KafkaProtocol{
+										APIKey: "produce",
+										Topic:  "orders",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "Kafka protocol can not be used with protocol IGMP or ICMP",
+		},
+		{
+			name:         "acnp-l7protocols-Kafka-invalid-topic",
+			featureGates: map[featuregate.Feature]bool{features.L7NetworkPolicy: true},
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "ingress-rule-l7protocols",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							Service: &crdv1beta1.NamespacedName{
+								Namespace: "foo1",
+								Name:      "bar1",
+							},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Action: &allowAction,
+							L7Protocols: []crdv1beta1.L7Protocol{
+								{
+									Kafka: &crdv1beta1.KafkaProtocol{
+										APIKey: "produce",
+										Topic:  "orders;",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "invalid characters in Kafka topic: orders;",
+		},
+		{
+			name:         "acnp-l7protocols-DNS-used-with-UDP",
+			featureGates: map[featuregate.Feature]bool{features.L7NetworkPolicy: true},
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "ingress-rule-l7protocols",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							Service: &crdv1beta1.NamespacedName{
+								Namespace: "foo1",
+								Name:      "bar1",
+							},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Action: &allowAction,
+							Ports: []crdv1beta1.NetworkPolicyPort{
+								{
+									Protocol: &k8sProtocolUDP,
+								},
+							},
+							L7Protocols: []crdv1beta1.L7Protocol{
+								{
+									DNS: &crdv1beta1.DNSProtocol{
+										QueryName: "*.example.com",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "",
+		},
+		{
+			name:         "acnp-l7protocols-DNS-used-with-SCTP",
+			featureGates: map[featuregate.Feature]bool{features.L7NetworkPolicy: true},
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "ingress-rule-l7protocols",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							Service: &crdv1beta1.NamespacedName{
+								Namespace: "foo1",
+								Name:      "bar1",
+							},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Action: &allowAction,
+							Ports: []crdv1beta1.NetworkPolicyPort{
+								{
+									Protocol: &k8sProtocolSCTP,
+								},
+							},
+							L7Protocols: []crdv1beta1.L7Protocol{
+								{
+									DNS: &crdv1beta1.DNSProtocol{
+										QueryName: "*.example.com",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "DNS protocol can only be used when layer 4 protocol is TCP, UDP or unset",
+		},
+		{
+			name:         "acnp-l7protocols-DNS-used-with-ICMP",
+			featureGates: map[featuregate.Feature]bool{features.L7NetworkPolicy: true},
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "ingress-rule-l7protocols",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							Service: &crdv1beta1.NamespacedName{
+								Namespace: "foo1",
+								Name:      "bar1",
+							},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Action: &allowAction,
+							Protocols: []crdv1beta1.NetworkPolicyProtocol{
+								{
+									ICMP: &crdv1beta1.ICMPProtocol{},
+								},
+							},
+							L7Protocols: []crdv1beta1.L7Protocol{
+								{
+									DNS: &crdv1beta1.DNSProtocol{
+										QueryName: "*.example.com",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "DNS protocol can not be used with protocol IGMP or ICMP",
+		},
+		{
+			name:         "acnp-l7protocols-HTTP-invalid-path-regex",
+			featureGates: map[featuregate.Feature]bool{features.L7NetworkPolicy: true},
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "ingress-rule-l7protocols",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							Service: &crdv1beta1.NamespacedName{
+								Namespace: "foo1",
+								Name:      "bar1",
+							},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Action: &allowAction,
+							L7Protocols: []crdv1beta1.L7Protocol{
+								{
+									HTTP: &crdv1beta1.HTTPProtocol{
+										Path:          "/api/(v1",
+										PathMatchType: crdv1beta1.HTTPMatchTypeRegex,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "invalid regular expression for HTTP path: error parsing regexp: missing closing ): `/api/(v1`",
+		},
+		{
+			name:         "acnp-l7protocols-HTTP-invalid-header-name",
+			featureGates: map[featuregate.Feature]bool{features.L7NetworkPolicy: true},
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "ingress-rule-l7protocols",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							Service: &crdv1beta1.NamespacedName{
+								Namespace: "foo1",
+								Name:      "bar1",
+							},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Action: &allowAction,
+							L7Protocols: []crdv1beta1.L7Protocol{
+								{
+									HTTP: &crdv1beta1.HTTPProtocol{
+										Headers: []crdv1beta1.HTTPHeaderMatch{
+											{Name: "X-Tenant ID", Value: "foo"},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: `invalid HTTP header name: "X-Tenant ID"`,
+		},
+		{
+			name:         "acnp-l7protocols-HTTP-headers-query-params",
+			featureGates: map[featuregate.Feature]bool{features.L7NetworkPolicy: true},
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "ingress-rule-l7protocols",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							Service: &crdv1beta1.NamespacedName{
+								Namespace: "foo1",
+								Name:      "bar1",
+							},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Action: &allowAction,
+							L7Protocols: []crdv1beta1.L7Protocol{
+								{
+									HTTP: &crdv1beta1.HTTPProtocol{
+										Path:          "/api/",
+										PathMatchType: crdv1beta1.HTTPMatchTypePrefix,
+										Headers: []crdv1beta1.HTTPHeaderMatch{
+											{Name: "X-Tenant-ID", Value: "foo"},
+											{Name: "Authorization", Value: "Bearer ", MatchType: crdv1beta1.HTTPMatchTypePrefix},
+										},
+										QueryParams: []crdv1beta1.HTTPQueryParamMatch{
+											{Name: "id", Value: "[0-9]+", MatchType: crdv1beta1.HTTPMatchTypeRegex},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "",
+		},
+		{
+			name:         "acnp-l7protocols-deny-responses",
+			featureGates: map[featuregate.Feature]bool{features.L7NetworkPolicy: true},
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "ingress-rule-l7protocols",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							Service: &crdv1beta1.NamespacedName{
+								Namespace: "foo1",
+								Name:      "bar1",
+							},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Action: &allowAction,
+							L7Protocols: []crdv1beta1.L7Protocol{
+								{
+									HTTP: &crdv1beta1.HTTPProtocol{
+										Host: "test.com",
+									},
+								},
+								{
+									TLS: &crdv1beta1.TLSProtocol{
+										SNI: "test.com",
+									},
+								},
+							},
+							L7DenyResponse: &crdv1beta1.L7DenyResponse{
+								HTTP: &crdv1beta1.HTTPDenyResponse{Body: "denied"},
+								TLS:  &crdv1beta1.TLSDenyResponse{Alert: crdv1beta1.TLSAlertHandshakeFailure},
+							},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "",
+		},
+		{
+			name:         "acnp-l7protocols-HTTP-deny-response-without-HTTP",
+			featureGates: map[featuregate.Feature]bool{features.L7NetworkPolicy: true},
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "ingress-rule-l7protocols",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							Service: &crdv1beta1.NamespacedName{
+								Namespace: "foo1",
+								Name:      "bar1",
+							},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Action: &allowAction,
+							L7Protocols: []crdv1beta1.L7Protocol{
+								{
+									TLS: &crdv1beta1.TLSProtocol{
+										SNI: "test.com",
+									},
+								},
+							},
+							L7DenyResponse: &crdv1beta1.L7DenyResponse{
+								HTTP: &crdv1beta1.HTTPDenyResponse{},
+							},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "HTTP deny response can only be used when HTTP protocol is set",
+		},
+		{
+			name:         "acnp-deny-response-without-l7protocols",
+			featureGates: map[featuregate.Feature]bool{features.L7NetworkPolicy: true},
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "ingress-rule-l7protocols",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							Service: &crdv1beta1.NamespacedName{
+								Namespace: "foo1",
+								Name:      "bar1",
+							},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Action: &allowAction,
+							L7DenyResponse: &crdv1beta1.L7DenyResponse{
+								HTTP: &crdv1beta1.HTTPDenyResponse{},
+							},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "l7DenyResponse can only be used when layer 7 protocols are set",
+		},
+		{
+			name: "acnp-rule-schedule",
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "ingress-rule-schedule",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							PodSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"app": "database"},
+							},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Action: &allowAction,
+							Name:   "maintenance",
+							Schedule: &crdv1beta1.RuleSchedule{
+								TimeZone: "America/Los_Angeles",
+								Windows: []crdv1beta1.ScheduleWindow{
+									{Start: "0 22 * * 6", Duration: "4h"},
+								},
+							},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "",
+		},
+		{
+			name: "acnp-rule-schedule-invalid-start",
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "ingress-rule-schedule",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							PodSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"app": "database"},
+							},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Action: &allowAction,
+							Name:   "maintenance",
+							Schedule: &crdv1beta1.RuleSchedule{
+								Windows: []crdv1beta1.ScheduleWindow{
+									{Start: "0 22 * *", Duration: "4h"},
+								},
+							},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "invalid schedule in rule maintenance: invalid start \"0 22 * *\": expected 5 fields in cron expression \"0 22 * *\", got 4",
+		},
+		{
+			name: "acnp-rule-schedule-invalid-time-zone",
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "egress-rule-schedule",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							PodSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"app": "bastion"},
+							},
+						},
+					},
+					Egress: []crdv1beta1.Rule{
+						{
+							Action: &allowAction,
+							Name:   "maintenance",
+							Schedule: &crdv1beta1.RuleSchedule{
+								TimeZone: "Mars/Olympus",
+								Windows: []crdv1beta1.ScheduleWindow{
+									{Start: "0 22 * * 6", Duration: "4h"},
+								},
+							},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "invalid schedule in rule maintenance: invalid time zone \"Mars/Olympus\": unknown time zone Mars/Olympus",
+		},
+		{
+			name: "acnp-rule-rate-limit-valid",
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "ingress-rule-rate-limit",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							PodSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"app": "database"},
+							},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Action: &rateLimitAction,
+							RateLimit: &crdv1beta1.RuleRateLimit{
+								NewConnectionsPerSecond: 100,
+								Burst:                   20,
+							},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "",
+		},
+		{
+			name: "acnp-rule-rate-limit-missing",
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "ingress-rule-rate-limit",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							PodSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"app": "database"},
+							},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Action: &rateLimitAction,
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "rateLimit must be set when action is RateLimit",
+		},
+		{
+			name: "acnp-rule-rate-limit-with-allow",
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "ingress-rule-rate-limit",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							PodSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"app": "database"},
+							},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Action: &allowAction,
+							RateLimit: &crdv1beta1.RuleRateLimit{
+								PacketsPerSecond: 1000,
+							},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "rateLimit can only be used when action is RateLimit",
+		},
+		{
+			name: "acnp-rule-rate-limit-both-rates",
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "ingress-rule-rate-limit",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							PodSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"app": "database"},
+							},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Action: &rateLimitAction,
+							RateLimit: &crdv1beta1.RuleRateLimit{
+								PacketsPerSecond:        1000,
+								NewConnectionsPerSecond: 100,
+							},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "exactly one of packetsPerSecond and newConnectionsPerSecond must be set in rateLimit",
+		},
 		{
 			name:         "acnp-l7protocols-used-with-toService",
 			featureGates: map[featuregate.Feature]bool{features.L7NetworkPolicy: true},
@@ -1641,6 +2284,261 @@ func TestValidateAntreaClusterNetworkPolicy(t *testing.T) {
 			operation:      admv1.Create,
 			expectedReason: "protocol IGMP does not support Pass or Reject",
 		},
+		{
+			name: "acnp-audit-mode-valid",
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "audit-mode",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					EnforcementMode: crdv1beta1.PolicyEnforcementModeAudit,
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							PodSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"app": "database"},
+							},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Action: &dropAction,
+						},
+					},
+				},
+			},
+			featureGates:   map[featuregate.Feature]bool{features.AntreaPolicyAuditMode: true},
+			operation:      admv1.Create,
+			expectedReason: "",
+		},
+		{
+			name: "acnp-audit-mode-feature-disabled",
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "audit-mode",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					EnforcementMode: crdv1beta1.PolicyEnforcementModeAudit,
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							PodSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"app": "database"},
+							},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Action: &dropAction,
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "enforcementMode Audit can only be used when AntreaPolicyAuditMode is enabled",
+		},
+		{
+			name: "acnp-audit-mode-invalid",
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "audit-mode",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					EnforcementMode: "DryRun",
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							PodSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"app": "database"},
+							},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Action: &dropAction,
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "invalid enforcementMode DryRun",
+		},
+		{
+			name: "acnp-audit-mode-applied-to-node",
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "audit-mode",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					EnforcementMode: crdv1beta1.PolicyEnforcementModeAudit,
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							NodeSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"foo": "bar"},
+							},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Action: &dropAction,
+						},
+					},
+				},
+			},
+			featureGates:   map[featuregate.Feature]bool{features.AntreaPolicyAuditMode: true},
+			operation:      admv1.Create,
+			expectedReason: "enforcementMode Audit is not supported for policies applied to Nodes",
+		},
+		{
+			name: "acnp-audit-mode-l7-rule",
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "audit-mode",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					EnforcementMode: crdv1beta1.PolicyEnforcementModeAudit,
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							PodSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"app": "web"},
+							},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Action: &allowAction,
+							L7Protocols: []crdv1beta1.L7Protocol{
+								{HTTP: &crdv1beta1.HTTPProtocol{}},
+							},
+						},
+					},
+				},
+			},
+			featureGates:   map[featuregate.Feature]bool{features.L7NetworkPolicy: true, features.AntreaPolicyAuditMode: true},
+			operation:      admv1.Create,
+			expectedReason: "enforcementMode Audit is not supported for policies with layer 7 rules",
+		},
+		{
+			name: "acnp-stretched-egress-rule",
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "stretched-egress",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							PodSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"role": "tenant"},
+							},
+						},
+					},
+					Egress: []crdv1beta1.Rule{
+						{
+							Action: &dropAction,
+							To: []crdv1beta1.NetworkPolicyPeer{
+								{
+									PodSelector: &metav1.LabelSelector{
+										MatchLabels: map[string]string{"app": "db"},
+									},
+									Scope: crdv1beta1.ScopeClusterSet,
+								},
+							},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "",
+		},
+		{
+			name: "acnp-stretched-egress-rule-allow-action",
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "stretched-egress-allow",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							PodSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"role": "tenant"},
+							},
+						},
+					},
+					Egress: []crdv1beta1.Rule{
+						{
+							Action: &allowAction,
+							To: []crdv1beta1.NetworkPolicyPeer{
+								{
+									PodSelector: &metav1.LabelSelector{
+										MatchLabels: map[string]string{"app": "db"},
+									},
+									Scope: crdv1beta1.ScopeClusterSet,
+								},
+							},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "egress rules with ClusterSet scoped peers can only use Drop or Reject action",
+		},
+		{
+			name: "acnp-stretched-egress-rule-ipblock-peer",
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "stretched-egress-ipblock",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							PodSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"role": "tenant"},
+							},
+						},
+					},
+					Egress: []crdv1beta1.Rule{
+						{
+							Action: &dropAction,
+							To: []crdv1beta1.NetworkPolicyPeer{
+								{
+									IPBlock: &crdv1beta1.IPBlock{CIDR: "10.0.0.0/8"},
+									Scope:   crdv1beta1.ScopeClusterSet,
+								},
+							},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "only podSelector and namespaceSelector can be used in ClusterSet scoped egress peers",
+		},
+		{
+			name: "acnp-stretched-egress-rule-applied-to-service-account",
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "stretched-egress-sa",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							ServiceAccount: &crdv1beta1.NamespacedName{Namespace: "ns1", Name: "sa1"},
+						},
+					},
+					Egress: []crdv1beta1.Rule{
+						{
+							Action: &dropAction,
+							To: []crdv1beta1.NetworkPolicyPeer{
+								{
+									NamespaceSelector: &metav1.LabelSelector{
+										MatchLabels: map[string]string{"env": "prod"},
+									},
+									Scope: crdv1beta1.ScopeClusterSet,
+								},
+							},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "only podSelector and namespaceSelector can be used in appliedTo of egress rules with ClusterSet scoped peers",
+		},
 		// Update use same validate function as create. Only provide one update case here.
 		{
 			name: "acnp-non-existent-tier",
@@ -1753,6 +2651,81 @@ func TestValidateAntreaNetworkPolicy(t *testing.T) {
 	}
 }
 
+func TestValidateRateLimitRuleBudget(t *testing.T) {
+	newRateLimitRules := func(count int) []crdv1beta1.Rule {
+		rules := make([]crdv1beta1.Rule, count)
+		for i := range rules {
+			rules[i] = crdv1beta1.Rule{
+				Name:      fmt.Sprintf("rule-%d", i),
+				Action:    &rateLimitAction,
+				RateLimit: &crdv1beta1.RuleRateLimit{PacketsPerSecond: 100},
+			}
+		}
+		return rules
+	}
+	appliedTo := []crdv1beta1.AppliedTo{{PodSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}}}}
+	existANNP := &crdv1beta1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "annp-rate-limit", Namespace: "x"},
+		Spec: crdv1beta1.NetworkPolicySpec{
+			AppliedTo: appliedTo,
+			Ingress:   newRateLimitRules(maxSupportedRateLimitRules),
+		},
+	}
+	tests := []struct {
+		name           string
+		policy         interface{}
+		operation      admv1.Operation
+		expectedReason string
+	}{
+		{
+			name: "create-acnp-exceeding-budget",
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{Name: "acnp-rate-limit"},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: appliedTo,
+					Egress:    newRateLimitRules(1),
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: fmt.Sprintf("maximum number of rules with action RateLimit supported: %d", maxSupportedRateLimitRules),
+		},
+		{
+			name: "create-acnp-without-rate-limit",
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{Name: "acnp-allow"},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: appliedTo,
+					Egress:    []crdv1beta1.Rule{{Action: &allowAction}},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "",
+		},
+		{
+			name: "update-annp-within-budget",
+			policy: &crdv1beta1.NetworkPolicy{
+				ObjectMeta: existANNP.ObjectMeta,
+				Spec: crdv1beta1.NetworkPolicySpec{
+					AppliedTo: appliedTo,
+					Egress:    newRateLimitRules(maxSupportedRateLimitRules),
+				},
+			},
+			operation:      admv1.Update,
+			expectedReason: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, controller := newController(nil, nil)
+			controller.annpStore.Add(existANNP)
+			validator := NewNetworkPolicyValidator(controller.NetworkPolicyController)
+			actualReason, allowed := validator.validateAntreaPolicy(tt.policy, "", tt.operation, authenticationv1.UserInfo{})
+			assert.Equal(t, tt.expectedReason, actualReason)
+			assert.Equal(t, tt.expectedReason == "", allowed)
+		})
+	}
+}
+
 func TestValidateAntreaClusterGroup(t *testing.T) {
 	tests := []struct {
 		name           string
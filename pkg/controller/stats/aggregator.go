@@ -449,6 +449,7 @@ func addUp(stats *statsv1alpha1.TrafficStats, inc *statsv1alpha1.TrafficStats) {
 	stats.Sessions += inc.Sessions
 	stats.Packets += inc.Packets
 	stats.Bytes += inc.Bytes
+	stats.RateLimitedPackets += inc.RateLimitedPackets
 }
 
 func addRulesUp(ruleStats *[]statsv1alpha1.RuleTrafficStats, ruleSumStats *statsv1alpha1.TrafficStats, inc []statsv1alpha1.RuleTrafficStats) {
@@ -465,9 +466,10 @@ func addRulesUp(ruleStats *[]statsv1alpha1.RuleTrafficStats, ruleSumStats *stats
 		stats, exist := incMap[v.Name]
 		if exist {
 			(*ruleStats)[i].TrafficStats = statsv1alpha1.TrafficStats{
-				Packets:  v.TrafficStats.Packets + stats.Packets,
-				Bytes:    v.TrafficStats.Bytes + stats.Bytes,
-				Sessions: v.TrafficStats.Sessions + stats.Sessions,
+				Packets:            v.TrafficStats.Packets + stats.Packets,
+				Bytes:              v.TrafficStats.Bytes + stats.Bytes,
+				Sessions:           v.TrafficStats.Sessions + stats.Sessions,
+				RateLimitedPackets: v.TrafficStats.RateLimitedPackets + stats.RateLimitedPackets,
 			}
 		}
 		delete(incMap, v.Name)
@@ -45,6 +45,7 @@ import (
 	crdinformers "antrea.io/antrea/pkg/client/informers/externalversions/crd/v1alpha1"
 	crdlisters "antrea.io/antrea/pkg/client/listers/crd/v1alpha1"
 	"antrea.io/antrea/pkg/controller/types"
+	"antrea.io/antrea/pkg/support"
 	"antrea.io/antrea/pkg/util/k8s"
 )
 
@@ -395,7 +396,16 @@ func (c *Controller) createInternalSupportBundleCollection(bundle *v1alpha1.Supp
 		klog.ErrorS(err, "Failed to get authentication defined in the SupportBundleCollection CR", "name", bundle.Name, "authentication", bundle.Spec.Authentication)
 		return nil, err
 	}
-	internalBundleCollection := c.addInternalSupportBundleCollection(bundle, nodeSpan, authentication, metav1.NewTime(expiredAt))
+	// The redaction seed is generated for each collection and only kept in memory, so that the
+	// pseudonyms in the bundles can't be reversed by users who can read the CR.
+	var redactionSeed string
+	if bundle.Spec.Redaction != nil {
+		redactionSeed, err = support.NewRedactionSeed()
+		if err != nil {
+			return nil, err
+		}
+	}
+	internalBundleCollection := c.addInternalSupportBundleCollection(bundle, nodeSpan, authentication, redactionSeed, metav1.NewTime(expiredAt))
 	// Process the support bundle collection when time is up, this will create a CollectionFailure condition if the
 	// bundle collection is not completed in time because any Agent fails to upload the files and does not report
 	// the failure.
@@ -571,6 +581,7 @@ func (c *Controller) addInternalSupportBundleCollection(
 	bundleCollection *v1alpha1.SupportBundleCollection,
 	nodeSpan sets.Set[string],
 	authentication *controlplane.BundleServerAuthConfiguration,
+	redactionSeed string,
 	expiredAt metav1.Time) *types.SupportBundleCollection {
 	var processNodes bool
 	if bundleCollection.Spec.Nodes == nil {
@@ -602,6 +613,9 @@ func (c *Controller) addInternalSupportBundleCollection(
 		FileServer:     bundleCollection.Spec.FileServer,
 		ExpiredAt:      expiredAt,
 		Authentication: *authentication,
+		Profile:        bundleCollection.Spec.Profile,
+		Redaction:      bundleCollection.Spec.Redaction.DeepCopy(),
+		RedactionSeed:  redactionSeed,
 	}
 	_ = c.supportBundleCollectionStore.Create(internalBundleCollection)
 	return internalBundleCollection
@@ -704,6 +718,7 @@ func (c *Controller) updateStatus(internalBundleCollection *types.SupportBundleC
 	collectedNodes := 0
 	failedNodeReasons := make(map[string][]string)
 	failedNodes := 0
+	var bundles []v1alpha1.CollectedBundle
 	statuses := c.getNodeStatuses(internalBundleCollection.Name)
 	for _, status := range statuses {
 		nodeKey := getNodeKey(status)
@@ -714,6 +729,15 @@ func (c *Controller) updateStatus(internalBundleCollection *types.SupportBundleC
 		}
 		if status.Completed {
 			collectedNodes += 1
+			if status.Location != "" || status.Checksum != "" {
+				bundles = append(bundles, v1alpha1.CollectedBundle{
+					NodeName:      status.NodeName,
+					NodeNamespace: status.NodeNamespace,
+					NodeType:      string(status.NodeType),
+					Location:      status.Location,
+					Checksum:      status.Checksum,
+				})
+			}
 		} else {
 			failedNodes += 1
 			failedReason := status.Error
@@ -728,6 +752,14 @@ func (c *Controller) updateStatus(internalBundleCollection *types.SupportBundleC
 		}
 	}
 
+	// Sort the bundles to avoid unnecessary status updates.
+	sort.Slice(bundles, func(i, j int) bool {
+		if bundles[i].NodeNamespace != bundles[j].NodeNamespace {
+			return bundles[i].NodeNamespace < bundles[j].NodeNamespace
+		}
+		return bundles[i].NodeName < bundles[j].NodeName
+	})
+
 	newConditions := []v1alpha1.SupportBundleCollectionCondition{
 		// Mark the support bundle collection as started since the internal resource successfully created.
 		// It will not be added as a duplication if it already exists.
@@ -784,6 +816,7 @@ func (c *Controller) updateStatus(internalBundleCollection *types.SupportBundleC
 		CollectedNodes: int32(collectedNodes),
 		DesiredNodes:   int32(desiredNodes),
 		Conditions:     newConditions,
+		Bundles:        bundles,
 	}
 	klog.V(2).InfoS("Updating SupportBundleCollection status", "supportBundleCollection", internalBundleCollection.Name, "status", status)
 	return c.updateSupportBundleCollectionStatus(internalBundleCollection.Name, status)
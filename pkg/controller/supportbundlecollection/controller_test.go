@@ -17,6 +17,7 @@ package supportbundlecollection
 import (
 	"context"
 	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"sort"
 	"sync"
@@ -86,6 +87,9 @@ type bundleConfig struct {
 	conditions      []v1alpha1.SupportBundleCollectionCondition
 	phase           bundlePhase
 	createTime      *time.Time
+	profile         string
+	redaction       *v1alpha1.BundleRedaction
+	fileServerURL   string
 }
 
 func TestReconcileSupportBundles(t *testing.T) {
@@ -782,6 +786,7 @@ func TestCreateAndDeleteInternalSupportBundleCollection(t *testing.T) {
 		expectedAuth  controlplane.BundleServerAuthConfiguration
 		expectedError string
 		expectFailure bool
+		expectSeed    bool
 	}{
 		{
 			bundleConfig: bundleConfig{
@@ -851,8 +856,39 @@ func TestCreateAndDeleteInternalSupportBundleCollection(t *testing.T) {
 			},
 			expectFailure: true,
 		},
+		{
+			bundleConfig: bundleConfig{
+				name: "b6",
+				nodes: &bundleNodes{
+					names: []string{"n1"},
+				},
+				authType:  v1alpha1.APIKey,
+				redaction: &v1alpha1.BundleRedaction{AnonymizeIPs: true},
+			},
+			expectedNodes: sets.New[string]("n1"),
+			expectedAuth: controlplane.BundleServerAuthConfiguration{
+				APIKey: testKeyString,
+			},
+			expectSeed: true,
+		},
+		{
+			bundleConfig: bundleConfig{
+				name: "b7",
+				nodes: &bundleNodes{
+					names: []string{"n1"},
+				},
+				authType:  v1alpha1.APIKey,
+				redaction: &v1alpha1.BundleRedaction{Patterns: []string{"customer-[a-z]+"}},
+			},
+			expectedNodes: sets.New[string]("n1"),
+			expectedAuth: controlplane.BundleServerAuthConfiguration{
+				APIKey: testKeyString,
+			},
+			expectSeed: true,
+		},
 	}
 
+	seeds := sets.New[string]()
 	for _, tc := range testCases {
 		bundleConfig := tc.bundleConfig
 		if bundleConfig.secretName == "" {
@@ -892,6 +928,19 @@ func TestCreateAndDeleteInternalSupportBundleCollection(t *testing.T) {
 				internalBundle, _ := obj.(*types.SupportBundleCollection)
 				assert.Equal(t, tc.expectedNodes, internalBundle.NodeNames)
 				assert.Equal(t, tc.expectedAuth, internalBundle.Authentication)
+				if tc.expectSeed {
+					// The seed must be random for each collection and must not be readable from the CR.
+					require.NotEmpty(t, internalBundle.RedactionSeed)
+					assert.False(t, seeds.Has(internalBundle.RedactionSeed))
+					seeds.Insert(internalBundle.RedactionSeed)
+					updatedBundle, err := testClient.crdClient.CrdV1alpha1().SupportBundleCollections().Get(context.TODO(), bundle.Name, metav1.GetOptions{})
+					require.NoError(t, err)
+					data, err := json.Marshal(updatedBundle)
+					require.NoError(t, err)
+					assert.NotContains(t, string(data), internalBundle.RedactionSeed)
+				} else {
+					assert.Empty(t, internalBundle.RedactionSeed)
+				}
 			} else {
 				updatedBundle, err := testClient.crdClient.CrdV1alpha1().SupportBundleCollections().Get(context.TODO(), bundle.Name, metav1.GetOptions{})
 				require.NoError(t, err)
@@ -1101,6 +1150,8 @@ func TestAddInternalSupportBundleCollection(t *testing.T) {
 		nodeSpan                sets.Set[string]
 		processingNodes         bool
 		processingExternalNodes bool
+		profile                 string
+		redaction               *v1alpha1.BundleRedaction
 	}{
 		{
 			name:            "b1",
@@ -1112,6 +1163,13 @@ func TestAddInternalSupportBundleCollection(t *testing.T) {
 			externalNodes:           &bundleExternalNodes{namespace: "ns1"},
 			nodeSpan:                sets.New[string]("en1", "en2", "en3", "en4"),
 			processingExternalNodes: true,
+		}, {
+			name:            "b3",
+			nodes:           &bundleNodes{},
+			nodeSpan:        sets.New[string]("n1"),
+			processingNodes: true,
+			profile:         "policy-only",
+			redaction:       &v1alpha1.BundleRedaction{Patterns: []string{"customer-[a-z]+"}, AnonymizeIPs: true},
 		},
 	} {
 		bundleConfig := bundleConfig{
@@ -1121,12 +1179,17 @@ func TestAddInternalSupportBundleCollection(t *testing.T) {
 			authType:        v1alpha1.APIKey,
 			secretName:      "s1",
 			secretNamespace: "default",
+			profile:         tc.profile,
+			redaction:       tc.redaction,
 		}
 		bundleCollection := generateSupportBundleResource(bundleConfig)
-		controller.addInternalSupportBundleCollection(bundleCollection, tc.nodeSpan, authentication, expiredAt)
-		_, exists, err := controller.supportBundleCollectionStore.Get(tc.name)
+		controller.addInternalSupportBundleCollection(bundleCollection, tc.nodeSpan, authentication, "", expiredAt)
+		obj, exists, err := controller.supportBundleCollectionStore.Get(tc.name)
 		assert.NoError(t, err)
 		assert.True(t, exists)
+		internalBundleCollection := obj.(*types.SupportBundleCollection)
+		assert.Equal(t, tc.profile, internalBundleCollection.Profile)
+		assert.Equal(t, tc.redaction, internalBundleCollection.Redaction)
 		_, exists, err = controller.supportBundleCollectionAppliedToStore.GetByKey(tc.name)
 		assert.NoError(t, err)
 		assert.True(t, exists)
@@ -1371,6 +1434,19 @@ func TestSupportBundleCollectionStatusEqual(t *testing.T) {
 				},
 			},
 			equal: false,
+		}, {
+			oldStatus: v1alpha1.SupportBundleCollectionStatus{
+				DesiredNodes:   100,
+				CollectedNodes: 4,
+			},
+			newStatus: v1alpha1.SupportBundleCollectionStatus{
+				DesiredNodes:   100,
+				CollectedNodes: 4,
+				Bundles: []v1alpha1.CollectedBundle{
+					{NodeName: "n1", NodeType: "Node", Location: "s3://bundles/n1.tar.gz", Checksum: "sha256:abc"},
+				},
+			},
+			equal: false,
 		},
 	} {
 		for _, conditions := range [][]v1alpha1.SupportBundleCollectionCondition{
@@ -1687,6 +1763,10 @@ func TestUpdateStatus(t *testing.T) {
 			if nodeType == controlplane.SupportBundleCollectionNodeTypeExternalNode {
 				nodeStatus.NodeNamespace = namespace
 			}
+			if completed {
+				nodeStatus.Location = fmt.Sprintf("sftp://1.1.1.1/supportbundles/upload/%s_%s.tar.gz", nodeName, collectionName)
+				nodeStatus.Checksum = "sha256:" + nodeName
+			}
 			updateStatusFunc(collectionName, nodeStatus)
 		}
 	}
@@ -1728,6 +1808,21 @@ func TestUpdateStatus(t *testing.T) {
 		bundleCollection, err := controller.crdClient.CrdV1alpha1().SupportBundleCollections().Get(context.Background(), collectionName, metav1.GetOptions{})
 		assert.NoError(t, err)
 		assert.Equal(t, int32(desiredNodes), bundleCollection.Status.CollectedNodes)
+		require.Len(t, bundleCollection.Status.Bundles, desiredNodes)
+		// Bundles uploaded by Nodes are sorted before those uploaded by ExternalNodes.
+		assert.Equal(t, v1alpha1.CollectedBundle{
+			NodeName: "n1",
+			NodeType: string(controlplane.SupportBundleCollectionNodeTypeNode),
+			Location: "sftp://1.1.1.1/supportbundles/upload/n1_b1.tar.gz",
+			Checksum: "sha256:n1",
+		}, bundleCollection.Status.Bundles[0])
+		assert.Equal(t, v1alpha1.CollectedBundle{
+			NodeName:      "n0",
+			NodeNamespace: namespace,
+			NodeType:      string(controlplane.SupportBundleCollectionNodeTypeExternalNode),
+			Location:      "sftp://1.1.1.1/supportbundles/upload/n0_b1.tar.gz",
+			Checksum:      "sha256:n0",
+		}, bundleCollection.Status.Bundles[2])
 		checkCompletedStatus(bundleCollection)
 	})
 
@@ -1741,6 +1836,7 @@ func TestUpdateStatus(t *testing.T) {
 		bundleCollection, err := controller.crdClient.CrdV1alpha1().SupportBundleCollections().Get(context.Background(), collectionName, metav1.GetOptions{})
 		assert.NoError(t, err)
 		assert.Equal(t, int32(3), bundleCollection.Status.CollectedNodes)
+		assert.Len(t, bundleCollection.Status.Bundles, 3)
 		failureStatus := v1alpha1.SupportBundleCollectionCondition{
 			Type:    v1alpha1.CollectionFailure,
 			Status:  metav1.ConditionTrue,
@@ -1968,8 +2064,13 @@ func generateSupportBundleResource(b bundleConfig) *v1alpha1.SupportBundleCollec
 			},
 			ExpirationMinutes: 60,
 			SinceTime:         "2h",
+			Profile:           b.profile,
+			Redaction:         b.redaction,
 		},
 	}
+	if b.fileServerURL != "" {
+		bundle.Spec.FileServer.URL = b.fileServerURL
+	}
 	if b.createTime != nil {
 		bundle.ObjectMeta.CreationTimestamp = metav1.NewTime(*b.createTime)
 	}
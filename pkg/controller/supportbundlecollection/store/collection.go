@@ -100,6 +100,12 @@ func ToSupportBundleCollectionMsg(in *types.SupportBundleCollection, out *contro
 		URL: in.FileServer.URL,
 	}
 	out.Authentication = in.Authentication
+	out.Profile = in.Profile
+	if in.Redaction != nil {
+		out.RedactionPatterns = in.Redaction.Patterns
+		out.AnonymizeIPs = in.Redaction.AnonymizeIPs
+		out.RedactionSeed = in.RedactionSeed
+	}
 }
 
 // SupportBundleCollectionKeyFunc knows how to get the key of a SupportBundleCollection.
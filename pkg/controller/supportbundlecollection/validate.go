@@ -24,6 +24,7 @@ import (
 	"k8s.io/klog/v2"
 
 	crdv1alpha1 "antrea.io/antrea/pkg/apis/crd/v1alpha1"
+	"antrea.io/antrea/pkg/support"
 )
 
 func (c *Controller) Validate(review *admv1.AdmissionReview) *admv1.AdmissionResponse {
@@ -55,6 +56,22 @@ func (c *Controller) Validate(review *admv1.AdmissionReview) *admv1.AdmissionRes
 		return validationResult(allowed, msg)
 	}
 
+	if review.Request.Operation == admv1.Create {
+		klog.V(2).Info("Validating CREATE request for SupportBundleCollection")
+		if err := support.ValidateProfile(newObj.Spec.Profile); err != nil {
+			return validationResult(false, err.Error())
+		}
+		if newObj.Spec.Redaction != nil {
+			if err := support.ValidatePatterns(newObj.Spec.Redaction.Patterns); err != nil {
+				return validationResult(false, err.Error())
+			}
+		}
+		if err := validateFileServer(newObj.Spec.FileServer.URL, newObj.Spec.Authentication.AuthType); err != nil {
+			return validationResult(false, err.Error())
+		}
+		return validationResult(true, "")
+	}
+
 	if review.Request.Operation == admv1.Update {
 		klog.V(2).Info("Validating UPDATE request for SupportBundleCollection")
 		if isCollectionCompleted(&oldObj) {
@@ -66,6 +83,20 @@ func (c *Controller) Validate(review *admv1.AdmissionReview) *admv1.AdmissionRes
 	return &admv1.AdmissionResponse{Allowed: true}
 }
 
+// validateFileServer validates the file server URL, and checks that the authentication type is
+// supported by the scheme of the URL. SFTP and S3 file servers only support BasicAuthentication,
+// which provides the SSH credentials and the S3 access key respectively.
+func validateFileServer(rawURL string, authType crdv1alpha1.BundleServerAuthType) error {
+	parsedURL, err := support.ParseFileServerURL(rawURL)
+	if err != nil {
+		return err
+	}
+	if parsedURL.Scheme != support.FileServerSchemeHTTPS && authType != crdv1alpha1.BasicAuthentication {
+		return fmt.Errorf("authentication type %s is not supported by %s file server, only %s is supported", authType, parsedURL.Scheme, crdv1alpha1.BasicAuthentication)
+	}
+	return nil
+}
+
 func newAdmissionResponseForErr(err error) *admv1.AdmissionResponse {
 	return &admv1.AdmissionResponse{
 		Result: &metav1.Status{
@@ -56,6 +56,99 @@ func TestValidateSupportBundleCollection(t *testing.T) {
 		expectedResponse  *adminv1.AdmissionResponse
 	}{
 		{
+			name:             "create with profile and redaction",
+			requestOperation: adminv1.Create,
+			updatedCollection: &bundleConfig{
+				name: "b1",
+				nodes: &bundleNodes{
+					labels: map[string]string{"test": "selected"},
+				},
+				authType:  crdv1alpha1.APIKey,
+				profile:   "datapath",
+				redaction: &crdv1alpha1.BundleRedaction{Patterns: []string{"customer-[a-z]+"}, AnonymizeIPs: true},
+			},
+			expectedResponse: &adminv1.AdmissionResponse{Allowed: true},
+		}, {
+			name:             "create with unsupported profile",
+			requestOperation: adminv1.Create,
+			updatedCollection: &bundleConfig{
+				name: "b1",
+				nodes: &bundleNodes{
+					labels: map[string]string{"test": "selected"},
+				},
+				authType: crdv1alpha1.APIKey,
+				profile:  "foo",
+			},
+			expectedResponse: &adminv1.AdmissionResponse{
+				Allowed: false,
+				Result: &metav1.Status{
+					Message: `unsupported profile "foo", supported profiles are: all, datapath, perf, policy-only`,
+				},
+			},
+		}, {
+			name:             "create with invalid redaction pattern",
+			requestOperation: adminv1.Create,
+			updatedCollection: &bundleConfig{
+				name: "b1",
+				nodes: &bundleNodes{
+					labels: map[string]string{"test": "selected"},
+				},
+				authType:  crdv1alpha1.APIKey,
+				redaction: &crdv1alpha1.BundleRedaction{Patterns: []string{"customer-("}},
+			},
+			expectedResponse: &adminv1.AdmissionResponse{
+				Allowed: false,
+				Result: &metav1.Status{
+					Message: "invalid redaction pattern \"customer-(\": error parsing regexp: missing closing ): `customer-(`",
+				},
+			},
+		}, {
+			name:             "create with s3 file server",
+			requestOperation: adminv1.Create,
+			updatedCollection: &bundleConfig{
+				name: "b1",
+				nodes: &bundleNodes{
+					labels: map[string]string{"test": "selected"},
+				},
+				authType:      crdv1alpha1.BasicAuthentication,
+				fileServerURL: "s3://bundles/antrea?region=us-west-2",
+			},
+			expectedResponse: &adminv1.AdmissionResponse{Allowed: true},
+		}, {
+			name:             "create with unsupported file server scheme",
+			requestOperation: adminv1.Create,
+			updatedCollection: &bundleConfig{
+				name: "b1",
+				nodes: &bundleNodes{
+					labels: map[string]string{"test": "selected"},
+				},
+				authType:      crdv1alpha1.APIKey,
+				fileServerURL: "ftp://1.1.1.1/supportbundles",
+			},
+			expectedResponse: &adminv1.AdmissionResponse{
+				Allowed: false,
+				Result: &metav1.Status{
+					Message: `unsupported scheme "ftp" in file server URL, supported schemes are: sftp, s3, https`,
+				},
+			},
+		}, {
+			name:             "create with unsupported authentication type for sftp",
+			requestOperation: adminv1.Create,
+			updatedCollection: &bundleConfig{
+				name: "b1",
+				nodes: &bundleNodes{
+					labels: map[string]string{"test": "selected"},
+				},
+				authType:      crdv1alpha1.BearerToken,
+				fileServerURL: "1.1.1.1:22/supportbundles",
+			},
+			expectedResponse: &adminv1.AdmissionResponse{
+				Allowed: false,
+				Result: &metav1.Status{
+					Message: "authentication type BearerToken is not supported by sftp file server, only BasicAuthentication is supported",
+				},
+			},
+		}, {
 			name:             "update before started",
 			existsInCache:    false,
 			requestOperation: adminv1.Update,
@@ -215,7 +308,7 @@ func TestValidateSupportBundleCollection(t *testing.T) {
 			testClient.start(stopCh)
 			testClient.waitForSync(stopCh)
 			if tt.existsInCache {
-				controller.addInternalSupportBundleCollection(bundleCollection, nodeSpan, authentication, expiredAt)
+				controller.addInternalSupportBundleCollection(bundleCollection, nodeSpan, authentication, "", expiredAt)
 			}
 			oldBundleCollection := bundleCollection
 			if tt.existingStatus != nil {
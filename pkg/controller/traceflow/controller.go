@@ -60,7 +60,8 @@ const (
 	maxTagNum uint8 = 0b1110*tagStep + 0b11
 
 	// String set to TraceflowStatus.Reason.
-	traceflowTimeout = "Traceflow timeout"
+	traceflowTimeout  = "Traceflow timeout"
+	traceflowTagInUse = "Traceflow data plane tag in use"
 
 	// Traceflow timeout period.
 	defaultTimeoutDuration = time.Second * time.Duration(crdv1beta1.DefaultTraceflowTimeout)
@@ -80,7 +81,7 @@ type Controller struct {
 	traceflowListerSynced  cache.InformerSynced
 	queue                  workqueue.RateLimitingInterface
 	runningTraceflowsMutex sync.Mutex
-	runningTraceflows      map[uint8]string // tag->traceflowName if tf.Status.Phase is Running.
+	runningTraceflows      map[uint8]string // tag->traceflowName if tf.Status.Phase is Pending or Running.
 }
 
 // NewTraceflowController creates a new traceflow controller and adds podIP indexer to podInformer.
@@ -127,7 +128,7 @@ func (c *Controller) Run(stopCh <-chan struct{}) {
 		klog.Errorf("Failed to list all Antrea Traceflows")
 	}
 	for _, tf := range tfs {
-		if tf.Status.Phase == crdv1beta1.Running {
+		if (tf.Status.Phase == crdv1beta1.Running || tf.Status.Phase == crdv1beta1.Pending) && tf.Status.DataplaneTag != 0 {
 			if err := c.occupyTag(tf); err != nil {
 				klog.Errorf("Load Traceflow data plane tag failed %v+: %v", tf, err)
 			}
@@ -237,7 +238,13 @@ func (c *Controller) syncTraceflow(traceflowName string) error {
 	}
 	switch tf.Status.Phase {
 	case "":
-		err = c.startTraceflow(tf)
+		// The status of a linked Traceflow is initialized by Antrea Multi-cluster with
+		// the data plane tag allocated in the source member cluster.
+		if !isLinkedTraceflow(tf) {
+			err = c.startTraceflow(tf)
+		}
+	case crdv1beta1.Pending:
+		err = c.checkPendingTraceflow(tf)
 	case crdv1beta1.Running:
 		err = c.checkTraceflowStatus(tf)
 	case crdv1beta1.Failed:
@@ -257,13 +264,39 @@ func (c *Controller) startTraceflow(tf *crdv1beta1.Traceflow) error {
 		return nil
 	}
 
-	err = c.updateTraceflowStatus(tf, crdv1beta1.Running, "", tag)
+	phase := crdv1beta1.Running
+	if isCrossClusterTraceflow(tf) {
+		// A cross-cluster Traceflow is started after Antrea Multi-cluster has
+		// created the linked Traceflow in the destination member cluster.
+		phase = crdv1beta1.Pending
+	}
+	err = c.updateTraceflowStatus(tf, phase, "", tag)
 	if err != nil {
 		c.deallocateTag(tf.Name, tag)
 	}
 	return err
 }
 
+// checkPendingTraceflow is only called for Traceflows in the Pending phase.
+func (c *Controller) checkPendingTraceflow(tf *crdv1beta1.Traceflow) error {
+	if isLinkedTraceflow(tf) {
+		// The linked Traceflow must use the same data plane tag as the Traceflow in the
+		// source member cluster, as the tag is carried by the packet across clusters.
+		if err := c.occupyTag(tf); err != nil {
+			klog.ErrorS(err, "Failed to occupy data plane tag for linked Traceflow", "Traceflow", klog.KObj(tf), "tag", tf.Status.DataplaneTag)
+			return c.updateTraceflowStatus(tf, crdv1beta1.Failed, traceflowTagInUse, 0)
+		}
+		return c.updateTraceflowStatus(tf, crdv1beta1.Running, "", uint8(tf.Status.DataplaneTag))
+	}
+	// The cross-cluster Traceflow is moved to the Running phase by Antrea Multi-cluster
+	// when the linked Traceflow is started, so only check the timeout here.
+	if tf.CreationTimestamp.Add(getTraceflowTimeout(tf)).Before(time.Now()) {
+		c.deallocateTagForTF(tf)
+		return c.updateTraceflowStatus(tf, crdv1beta1.Failed, traceflowTimeout, 0)
+	}
+	return nil
+}
+
 // checkTraceflowStatus is only called for Traceflows in the Running phase
 func (c *Controller) checkTraceflowStatus(tf *crdv1beta1.Traceflow) error {
 	succeeded := false
@@ -276,18 +309,27 @@ func (c *Controller) checkTraceflowStatus(tf *crdv1beta1.Traceflow) error {
 	} else {
 		sender := false
 		receiver := false
+		crossCluster := isCrossClusterTraceflow(tf)
 		for i, nodeResult := range tf.Status.Results {
+			// Results of a cross-cluster Traceflow reported in the destination member
+			// cluster are merged by Antrea Multi-cluster with the ClusterID set.
+			remote := nodeResult.ClusterID != ""
 			for j, ob := range nodeResult.Observations {
 				if ob.Component == crdv1beta1.ComponentSpoofGuard {
 					sender = true
 				}
-				if ob.Action == crdv1beta1.ActionDelivered ||
-					ob.Action == crdv1beta1.ActionDropped ||
-					ob.Action == crdv1beta1.ActionRejected ||
-					ob.Action == crdv1beta1.ActionForwardedOutOfOverlay {
+				if ob.Action == crdv1beta1.ActionDropped ||
+					ob.Action == crdv1beta1.ActionRejected {
+					receiver = true
+				}
+				// A cross-cluster Traceflow is forwarded out of the overlay towards the
+				// Multi-cluster Gateway in the source member cluster, so it's completed
+				// only when the destination member cluster reports the result.
+				if (ob.Action == crdv1beta1.ActionDelivered ||
+					ob.Action == crdv1beta1.ActionForwardedOutOfOverlay) && (!crossCluster || remote) {
 					receiver = true
 				}
-				if ob.TranslatedDstIP != "" {
+				if ob.TranslatedDstIP != "" && !remote {
 					// Add Pod ns/name to observation if TranslatedDstIP (a.k.a. Service Endpoint address) is Pod IP.
 					pods, err := c.podInformer.Informer().GetIndexer().ByIndex(grouping.PodIPsIndex, ob.TranslatedDstIP)
 					if err != nil {
@@ -303,23 +345,21 @@ func (c *Controller) checkTraceflowStatus(tf *crdv1beta1.Traceflow) error {
 				}
 			}
 		}
-		// When the Source Pod is specified, the Traceflow should receive
-		// results from both the sender and the receiver. When the Source
-		// Pod is not specified (in live-traffic Traceflow), only the
+		// When the Source Pod or ExternalEntity is specified, the Traceflow
+		// should receive results from both the sender and the receiver.
+		// When neither is specified (in live-traffic Traceflow), only the
 		// receiver Node will report the results.
-		succeeded = (sender && receiver) || (receiver && tf.Spec.Source.Pod == "")
+		// A linked Traceflow of a cross-cluster Traceflow is sent from another member
+		// cluster, so there is no sender in the local cluster.
+		succeeded = (sender && receiver) || (receiver && tf.Spec.Source.Pod == "" && tf.Spec.Source.ExternalEntity == "") ||
+			(receiver && isLinkedTraceflow(tf))
 	}
 	if succeeded {
 		c.deallocateTagForTF(tf)
 		return c.updateTraceflowStatus(tf, crdv1beta1.Succeeded, "", 0)
 	}
 
-	var timeout time.Duration
-	if tf.Spec.Timeout != 0 {
-		timeout = time.Duration(tf.Spec.Timeout) * time.Second
-	} else {
-		timeout = defaultTimeoutDuration
-	}
+	timeout := getTraceflowTimeout(tf)
 	var startTime time.Time
 	if tf.Status.StartTime != nil {
 		startTime = tf.Status.StartTime.Time
@@ -336,6 +376,25 @@ func (c *Controller) checkTraceflowStatus(tf *crdv1beta1.Traceflow) error {
 	return nil
 }
 
+func getTraceflowTimeout(tf *crdv1beta1.Traceflow) time.Duration {
+	if tf.Spec.Timeout != 0 {
+		return time.Duration(tf.Spec.Timeout) * time.Second
+	}
+	return defaultTimeoutDuration
+}
+
+// isCrossClusterTraceflow returns whether the destination of the Traceflow is in another
+// member cluster of a Multi-cluster ClusterSet.
+func isCrossClusterTraceflow(tf *crdv1beta1.Traceflow) bool {
+	return tf.Spec.Destination.ClusterID != ""
+}
+
+// isLinkedTraceflow returns whether the Traceflow is created by Antrea Multi-cluster in the
+// destination member cluster of a cross-cluster Traceflow.
+func isLinkedTraceflow(tf *crdv1beta1.Traceflow) bool {
+	return tf.Spec.Source.ClusterID != ""
+}
+
 func (c *Controller) updateTraceflowStatus(tf *crdv1beta1.Traceflow, phase crdv1beta1.TraceflowPhase, reason string, dataPlaneTag uint8) error {
 	update := tf.DeepCopy()
 	update.Status.Phase = phase
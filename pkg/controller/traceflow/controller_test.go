@@ -147,6 +147,84 @@ func TestTraceflow(t *testing.T) {
 		assert.Equal(t, numRunningTraceflows(), 0)
 	})
 
+	t.Run("crossClusterTraceflow", func(t *testing.T) {
+		tf2 := crdv1beta1.Traceflow{
+			ObjectMeta: metav1.ObjectMeta{Name: "tf2", UID: "uid2"},
+			Spec: crdv1beta1.TraceflowSpec{
+				Source:      crdv1beta1.Source{Namespace: "ns1", Pod: "pod1"},
+				Destination: crdv1beta1.Destination{Namespace: "ns2", Pod: "pod2", ClusterID: "cluster-b"},
+			},
+		}
+		tfc.client.CrdV1beta1().Traceflows().Create(context.TODO(), &tf2, metav1.CreateOptions{})
+		res, _ := tfc.waitForTraceflow("tf2", crdv1beta1.Pending, time.Second)
+		require.NotNil(t, res)
+		// DataplaneTag should be allocated by Controller and kept in the Pending phase.
+		assert.True(t, res.Status.DataplaneTag > 0)
+		assert.Equal(t, numRunningTraceflows(), 1)
+
+		// Antrea Multi-cluster sets the Running phase when the linked Traceflow is started.
+		res.Status.Phase = crdv1beta1.Running
+		res.Status.Results = []crdv1beta1.NodeResult{
+			{
+				Observations: []crdv1beta1.Observation{
+					{Component: crdv1beta1.ComponentSpoofGuard},
+					{Action: crdv1beta1.ActionForwardedOutOfOverlay},
+				},
+			},
+		}
+		res, _ = tfc.client.CrdV1beta1().Traceflows().Update(context.TODO(), res, metav1.UpdateOptions{})
+		require.NotNil(t, res)
+		// The Traceflow is not completed until the destination member cluster reports the result.
+		_, err := tfc.waitForTraceflow("tf2", crdv1beta1.Succeeded, time.Second)
+		assert.Error(t, err)
+
+		res, _ = tfc.client.CrdV1beta1().Traceflows().Get(context.TODO(), "tf2", metav1.GetOptions{})
+		require.NotNil(t, res)
+		res.Status.Results = append(res.Status.Results, crdv1beta1.NodeResult{
+			ClusterID:    "cluster-b",
+			Observations: []crdv1beta1.Observation{{Action: crdv1beta1.ActionDelivered}},
+		})
+		tfc.client.CrdV1beta1().Traceflows().Update(context.TODO(), res, metav1.UpdateOptions{})
+		res, _ = tfc.waitForTraceflow("tf2", crdv1beta1.Succeeded, time.Second)
+		assert.NotNil(t, res)
+		assert.True(t, res.Status.DataplaneTag == 0)
+		assert.Equal(t, numRunningTraceflows(), 0)
+		tfc.client.CrdV1beta1().Traceflows().Delete(context.TODO(), "tf2", metav1.DeleteOptions{})
+	})
+
+	t.Run("linkedTraceflow", func(t *testing.T) {
+		tf3 := crdv1beta1.Traceflow{
+			ObjectMeta: metav1.ObjectMeta{Name: "cluster-a-tf3", UID: "uid3"},
+			Spec: crdv1beta1.TraceflowSpec{
+				Source:      crdv1beta1.Source{Namespace: "ns1", Pod: "pod1", ClusterID: "cluster-a"},
+				Destination: crdv1beta1.Destination{Namespace: "ns2", Pod: "pod2"},
+			},
+		}
+		res, _ := tfc.client.CrdV1beta1().Traceflows().Create(context.TODO(), &tf3, metav1.CreateOptions{})
+		require.NotNil(t, res)
+		// The status of a linked Traceflow is initialized by Antrea Multi-cluster.
+		res.Status.Phase = crdv1beta1.Pending
+		res.Status.DataplaneTag = int8(maxTagNum)
+		res.Status.DestinationIP = "10.10.1.2"
+		tfc.client.CrdV1beta1().Traceflows().UpdateStatus(context.TODO(), res, metav1.UpdateOptions{})
+		res, _ = tfc.waitForTraceflow("cluster-a-tf3", crdv1beta1.Running, time.Second)
+		require.NotNil(t, res)
+		// The data plane tag allocated in the source member cluster should be used.
+		assert.Equal(t, int8(maxTagNum), res.Status.DataplaneTag)
+		assert.Equal(t, numRunningTraceflows(), 1)
+
+		res.Status.Results = []crdv1beta1.NodeResult{
+			{
+				Observations: []crdv1beta1.Observation{{Action: crdv1beta1.ActionDelivered}},
+			},
+		}
+		tfc.client.CrdV1beta1().Traceflows().Update(context.TODO(), res, metav1.UpdateOptions{})
+		res, _ = tfc.waitForTraceflow("cluster-a-tf3", crdv1beta1.Succeeded, time.Second)
+		assert.NotNil(t, res)
+		assert.Equal(t, numRunningTraceflows(), 0)
+		tfc.client.CrdV1beta1().Traceflows().Delete(context.TODO(), "cluster-a-tf3", metav1.DeleteOptions{})
+	})
+
 	close(stopCh)
 }
 
@@ -68,23 +68,55 @@ func (c *Controller) Validate(review *admv1.AdmissionReview) *admv1.AdmissionRes
 }
 
 func (c *Controller) validate(tf *crdv1beta1.Traceflow) (allowed bool, deniedReason string) {
+	if allowed, deniedReason := validateCrossCluster(tf); !allowed {
+		return false, deniedReason
+	}
 	if !tf.Spec.LiveTraffic {
 		if tf.Spec.Source.Namespace == "" || tf.Spec.Source.Pod == "" {
 			return false, "source Pod must be specified in non-live-traffic Traceflow"
 		}
-		srcPod, err := c.podLister.Pods(tf.Spec.Source.Namespace).Get(tf.Spec.Source.Pod)
-		if err != nil {
-			if apierrors.IsNotFound(err) {
-				err = fmt.Errorf("requested source Pod %s not found", k8s.NamespacedName(tf.Spec.Source.Namespace, tf.Spec.Source.Pod))
+		// The source Pod of a linked Traceflow is in another member cluster.
+		if tf.Spec.Source.ClusterID == "" {
+			srcPod, err := c.podLister.Pods(tf.Spec.Source.Namespace).Get(tf.Spec.Source.Pod)
+			if err != nil {
+				if apierrors.IsNotFound(err) {
+					err = fmt.Errorf("requested source Pod %s not found", k8s.NamespacedName(tf.Spec.Source.Namespace, tf.Spec.Source.Pod))
+				}
+				return false, err.Error()
+			}
+			if srcPod.Spec.HostNetwork {
+				return false, "using hostNetwork Pod as source in non-live-traffic Traceflow is not supported"
 			}
-			return false, err.Error()
-		}
-		if srcPod.Spec.HostNetwork {
-			return false, "using hostNetwork Pod as source in non-live-traffic Traceflow is not supported"
 		}
 	}
-	if tf.Spec.Source.Pod == "" && tf.Spec.Destination.Pod == "" {
-		return false, fmt.Sprintf("Traceflow %s has neither source nor destination Pod specified", tf.Name)
+	if tf.Spec.Source.Pod != "" && tf.Spec.Source.ExternalEntity != "" {
+		return false, "source Pod and ExternalEntity cannot be specified at the same time"
+	}
+	if tf.Spec.Destination.ExternalEntity != "" && (tf.Spec.Destination.Pod != "" || tf.Spec.Destination.Service != "") {
+		return false, "destination ExternalEntity cannot be specified together with destination Pod or Service"
+	}
+	if tf.Spec.Source.Pod == "" && tf.Spec.Destination.Pod == "" &&
+		tf.Spec.Source.ExternalEntity == "" && tf.Spec.Destination.ExternalEntity == "" {
+		return false, fmt.Sprintf("Traceflow %s has neither source nor destination Pod or ExternalEntity specified", tf.Name)
+	}
+	return true, ""
+}
+
+func validateCrossCluster(tf *crdv1beta1.Traceflow) (allowed bool, deniedReason string) {
+	if tf.Spec.Source.ClusterID == "" && tf.Spec.Destination.ClusterID == "" {
+		return true, ""
+	}
+	if tf.Spec.Source.ClusterID != "" && tf.Spec.Destination.ClusterID != "" {
+		return false, "source and destination ClusterID cannot be specified at the same time"
+	}
+	if tf.Spec.LiveTraffic {
+		return false, "cross-cluster Traceflow is supported only for non-live-traffic Traceflow"
+	}
+	if tf.Spec.Destination.ClusterID != "" && tf.Spec.Destination.Pod == "" && tf.Spec.Destination.Service == "" {
+		return false, "destination Pod or Service must be specified in cross-cluster Traceflow"
+	}
+	if tf.Spec.Destination.ClusterID != "" && (tf.Spec.Destination.IP != "" || tf.Spec.Destination.ExternalEntity != "") {
+		return false, "destination IP or ExternalEntity cannot be specified in cross-cluster Traceflow"
 	}
 	return true, ""
 }
@@ -54,7 +54,42 @@ func TestControllerValidate(t *testing.T) {
 			newSpec: &crdv1beta1.TraceflowSpec{
 				LiveTraffic: true,
 			},
-			deniedReason: "Traceflow tf has neither source nor destination Pod specified",
+			deniedReason: "Traceflow tf has neither source nor destination Pod or ExternalEntity specified",
+		},
+		{
+			name: "Source Pod and ExternalEntity are exclusive",
+			newSpec: &crdv1beta1.TraceflowSpec{
+				LiveTraffic: true,
+				Source: crdv1beta1.Source{
+					Namespace:      "test-ns",
+					Pod:            "test-pod",
+					ExternalEntity: "test-ee",
+				},
+			},
+			deniedReason: "source Pod and ExternalEntity cannot be specified at the same time",
+		},
+		{
+			name: "Destination ExternalEntity and Service are exclusive",
+			newSpec: &crdv1beta1.TraceflowSpec{
+				LiveTraffic: true,
+				Destination: crdv1beta1.Destination{
+					Namespace:      "test-ns",
+					Service:        "test-svc",
+					ExternalEntity: "test-ee",
+				},
+			},
+			deniedReason: "destination ExternalEntity cannot be specified together with destination Pod or Service",
+		},
+		{
+			name: "Valid live-traffic request with source ExternalEntity",
+			newSpec: &crdv1beta1.TraceflowSpec{
+				LiveTraffic: true,
+				Source: crdv1beta1.Source{
+					Namespace:      "test-ns",
+					ExternalEntity: "test-ee",
+				},
+			},
+			allowed: true,
 		},
 		{
 			name: "Assigned source pod must exist",
@@ -97,6 +132,67 @@ func TestControllerValidate(t *testing.T) {
 			},
 			allowed: true,
 		},
+		{
+			name: "Cross-cluster Traceflow must be non-live-traffic",
+			newSpec: &crdv1beta1.TraceflowSpec{
+				LiveTraffic: true,
+				Source: crdv1beta1.Source{
+					Namespace: "test-ns",
+					Pod:       "test-pod",
+				},
+				Destination: crdv1beta1.Destination{
+					Namespace: "test-ns",
+					Pod:       "test-pod-b",
+					ClusterID: "cluster-b",
+				},
+			},
+			deniedReason: "cross-cluster Traceflow is supported only for non-live-traffic Traceflow",
+		},
+		{
+			name: "Cross-cluster Traceflow destination IP is not supported",
+			newSpec: &crdv1beta1.TraceflowSpec{
+				Source: crdv1beta1.Source{
+					Namespace: "test-ns",
+					Pod:       "test-pod",
+				},
+				Destination: crdv1beta1.Destination{
+					IP:        "10.0.0.2",
+					ClusterID: "cluster-b",
+				},
+			},
+			deniedReason: "destination Pod or Service must be specified in cross-cluster Traceflow",
+		},
+		{
+			name: "Source and destination ClusterID are exclusive",
+			newSpec: &crdv1beta1.TraceflowSpec{
+				Source: crdv1beta1.Source{
+					Namespace: "test-ns",
+					Pod:       "test-pod",
+					ClusterID: "cluster-a",
+				},
+				Destination: crdv1beta1.Destination{
+					Namespace: "test-ns",
+					Pod:       "test-pod-b",
+					ClusterID: "cluster-b",
+				},
+			},
+			deniedReason: "source and destination ClusterID cannot be specified at the same time",
+		},
+		{
+			name: "Valid linked Traceflow with source Pod in another member cluster",
+			newSpec: &crdv1beta1.TraceflowSpec{
+				Source: crdv1beta1.Source{
+					Namespace: "test-ns",
+					Pod:       "test-pod",
+					ClusterID: "cluster-a",
+				},
+				Destination: crdv1beta1.Destination{
+					Namespace: "test-ns",
+					Service:   "test-svc",
+				},
+			},
+			allowed: true,
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
@@ -15,10 +15,13 @@
 package types
 
 import (
+	"time"
+
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
 
 	"antrea.io/antrea/pkg/apis/controlplane"
+	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
 )
 
 // SpanMeta describes the span information of an object.
@@ -105,6 +108,14 @@ type NetworkPolicy struct {
 	AppliedToPerRule bool
 	// SyncError is the Error encountered when syncing this NetworkPolicy.
 	SyncError error
+	// NextScheduleTransitionTime is the next time at which a rule of the original Network Policy is activated or
+	// deactivated by its schedule. It is nil if no rule has a schedule.
+	NextScheduleTransitionTime *time.Time
+	// InvalidRuleSchedules describes the rules of the original Network Policy whose schedules are invalid, which
+	// are never enforced if they allow traffic, and always enforced otherwise.
+	InvalidRuleSchedules []string
+	// EnforcementMode specifies whether the rules are enforced or only audited. It is empty for K8s NetworkPolicy.
+	EnforcementMode crdv1beta1.PolicyEnforcementMode
 }
 
 // GetAddressGroups returns AddressGroups used by this NetworkPolicy.
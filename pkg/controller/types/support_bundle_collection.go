@@ -32,4 +32,10 @@ type SupportBundleCollection struct {
 	SinceTime      string
 	FileServer     v1alpha1.BundleFileServer
 	Authentication controlplane.BundleServerAuthConfiguration
+	Profile        string
+	Redaction      *v1alpha1.BundleRedaction
+	// RedactionSeed is the random seed of the pseudonyms of the collection. It's only kept in
+	// memory and sent to the Nodes, so it can't be read from the SupportBundleCollection and it's
+	// discarded when the collection is done.
+	RedactionSeed string
 }
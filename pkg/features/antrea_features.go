@@ -158,6 +158,11 @@ const (
 	// alpha: v1.15
 	// Enable layer 7 flow export on Pods and Namespaces
 	L7FlowExporter featuregate.Feature = "L7FlowExporter"
+
+	// alpha: v2.0
+	// Allows users to set Antrea-native policies in Audit mode, in which the traffic matching their rules is logged
+	// and counted instead of being enforced.
+	AntreaPolicyAuditMode featuregate.Feature = "AntreaPolicyAuditMode"
 )
 
 var (
@@ -199,6 +204,7 @@ var (
 		EgressSeparateSubnet:        {Default: false, PreRelease: featuregate.Alpha},
 		NodeNetworkPolicy:           {Default: false, PreRelease: featuregate.Alpha},
 		L7FlowExporter:              {Default: false, PreRelease: featuregate.Alpha},
+		AntreaPolicyAuditMode:       {Default: false, PreRelease: featuregate.Alpha},
 	}
 
 	// AgentGates consists of all known feature gates for the Antrea Agent.
@@ -229,6 +235,7 @@ var (
 		EgressSeparateSubnet,
 		NodeNetworkPolicy,
 		L7FlowExporter,
+		AntreaPolicyAuditMode,
 	)
 
 	// ControllerGates consists of all known feature gates for the Antrea Controller.
@@ -237,6 +244,7 @@ var (
 		AdminNetworkPolicy,
 		AntreaIPAM,
 		AntreaPolicy,
+		AntreaPolicyAuditMode,
 		Egress,
 		IPsecCertAuth,
 		L7NetworkPolicy,
@@ -276,6 +284,7 @@ var (
 		EgressSeparateSubnet:        {},
 		NodeNetworkPolicy:           {},
 		L7FlowExporter:              {},
+		AntreaPolicyAuditMode:       {},
 	}
 	// supportedFeaturesOnExternalNode records the features supported on an external
 	// Node. Antrea Agent checks the enabled features if it is running on an
@@ -287,6 +296,8 @@ var (
 		SupportBundleCollection: {},
 		L7NetworkPolicy:         {},
 		AdminNetworkPolicy:      {},
+		FlowExporter:            {},
+		Traceflow:               {},
 	}
 )
 
@@ -63,6 +63,7 @@ func newControllerMonitor(crdClient *fakeclientset.Clientset) *fakeController {
 	policyInformerFactory := policyv1a1informers.NewSharedInformerFactory(policyClient, informerDefaultResync)
 	namespaceInformer := informerFactory.Core().V1().Namespaces()
 	serviceInformer := informerFactory.Core().V1().Services()
+	endpointSliceInformer := informerFactory.Discovery().V1().EndpointSlices()
 	networkPolicyInformer := informerFactory.Networking().V1().NetworkPolicies()
 	nodeInformer := informerFactory.Core().V1().Nodes()
 	acnpInformer := crdInformerFactory.Crd().V1beta1().ClusterNetworkPolicies()
@@ -87,6 +88,7 @@ func newControllerMonitor(crdClient *fakeclientset.Clientset) *fakeController {
 		labelIdentityIndex,
 		namespaceInformer,
 		serviceInformer,
+		endpointSliceInformer,
 		networkPolicyInformer,
 		nodeInformer,
 		acnpInformer,
@@ -79,6 +79,7 @@ const (
 	NxmFieldSrcIPv6     = "NXM_NX_IPV6_SRC"
 	NxmFieldDstIPv6     = "NXM_NX_IPV6_DST"
 	NxmFieldTunIPv4Src  = "NXM_NX_TUN_IPV4_SRC"
+	NxmFieldTunIPv4Dst  = "NXM_NX_TUN_IPV4_DST"
 	NxmFieldEthType     = "NXM_OF_ETH_TYPE"
 	NxmFieldIPProto     = "NXM_OF_IP_PROTO"
 
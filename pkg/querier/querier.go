@@ -41,6 +41,9 @@ type AgentNetworkPolicyInfoQuerier interface {
 	GetAppliedNetworkPolicies(pod, namespace string, npFilter *NetworkPolicyQueryFilter) []cpv1beta.NetworkPolicy
 	GetNetworkPolicyByRuleFlowID(ruleFlowID uint32) *cpv1beta.NetworkPolicyReference
 	GetRuleByFlowID(ruleFlowID uint32) *types.PolicyRule
+	// GetAuditRecords returns the traffic which would have been denied by the rules of Antrea-native policies in
+	// Audit mode.
+	GetAuditRecords() []types.AuditRecord
 }
 
 type AgentMulticastInfoQuerier interface {
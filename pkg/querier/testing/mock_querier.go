@@ -128,6 +128,20 @@ func (mr *MockAgentNetworkPolicyInfoQuerierMockRecorder) GetAppliedToGroups() *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAppliedToGroups", reflect.TypeOf((*MockAgentNetworkPolicyInfoQuerier)(nil).GetAppliedToGroups))
 }
 
+// GetAuditRecords mocks base method.
+func (m *MockAgentNetworkPolicyInfoQuerier) GetAuditRecords() []types.AuditRecord {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAuditRecords")
+	ret0, _ := ret[0].([]types.AuditRecord)
+	return ret0
+}
+
+// GetAuditRecords indicates an expected call of GetAuditRecords.
+func (mr *MockAgentNetworkPolicyInfoQuerierMockRecorder) GetAuditRecords() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuditRecords", reflect.TypeOf((*MockAgentNetworkPolicyInfoQuerier)(nil).GetAuditRecords))
+}
+
 // GetControllerConnectionStatus mocks base method.
 func (m *MockAgentNetworkPolicyInfoQuerier) GetControllerConnectionStatus() bool {
 	m.ctrl.T.Helper()
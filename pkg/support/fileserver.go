@@ -0,0 +1,62 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package support
+
+import (
+	"fmt"
+	"net/url"
+)
+
+const (
+	// FileServerSchemeSFTP uploads bundle files with SFTP. It is used when the URL has no scheme.
+	FileServerSchemeSFTP = "sftp"
+	// FileServerSchemeS3 uploads bundle files to an S3 bucket, or to an S3-compatible object
+	// storage when the "endpoint" query parameter is set, e.g.
+	// s3://my-bucket/prefix?region=us-west-2&endpoint=https://minio.example.com:9000.
+	FileServerSchemeS3 = "s3"
+	// FileServerSchemeHTTPS uploads bundle files with HTTP PUT requests.
+	FileServerSchemeHTTPS = "https"
+)
+
+// ParseFileServerURL parses the URL of a bundle file server. The URL is set with format
+// scheme://host[:port][/path]. If scheme is not set, sftp is used.
+func ParseFileServerURL(rawURL string) (*url.URL, error) {
+	parsedURL, err := url.Parse(rawURL)
+	if err != nil || parsedURL.Scheme == "" {
+		parsedURL, err = url.Parse(FileServerSchemeSFTP + "://" + rawURL)
+		if err != nil {
+			return nil, err
+		}
+	}
+	switch parsedURL.Scheme {
+	case FileServerSchemeSFTP, FileServerSchemeHTTPS:
+		if parsedURL.Host == "" {
+			return nil, fmt.Errorf("host must be set in file server URL %q", rawURL)
+		}
+	case FileServerSchemeS3:
+		if parsedURL.Host == "" {
+			return nil, fmt.Errorf("bucket must be set in file server URL %q", rawURL)
+		}
+		if endpoint := parsedURL.Query().Get("endpoint"); endpoint != "" {
+			if endpointURL, err := url.Parse(endpoint); err != nil || endpointURL.Host == "" {
+				return nil, fmt.Errorf("invalid S3 endpoint %q in file server URL", endpoint)
+			}
+		}
+	default:
+		return nil, fmt.Errorf("unsupported scheme %q in file server URL, supported schemes are: %s, %s, %s",
+			parsedURL.Scheme, FileServerSchemeSFTP, FileServerSchemeS3, FileServerSchemeHTTPS)
+	}
+	return parsedURL, nil
+}
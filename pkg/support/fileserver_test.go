@@ -0,0 +1,96 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package support
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseFileServerURL(t *testing.T) {
+	for _, tc := range []struct {
+		name           string
+		url            string
+		expectedScheme string
+		expectedHost   string
+		expectedPath   string
+		expectedErr    string
+	}{
+		{
+			name:           "sftp",
+			url:            "sftp://10.220.175.92:22/root/supportbundle",
+			expectedScheme: "sftp",
+			expectedHost:   "10.220.175.92:22",
+			expectedPath:   "/root/supportbundle",
+		},
+		{
+			name:           "no scheme",
+			url:            "10.220.175.92:22/root/supportbundle",
+			expectedScheme: "sftp",
+			expectedHost:   "10.220.175.92:22",
+			expectedPath:   "/root/supportbundle",
+		},
+		{
+			name:           "no scheme and no port",
+			url:            "fileserver.example.com/root/supportbundle",
+			expectedScheme: "sftp",
+			expectedHost:   "fileserver.example.com",
+			expectedPath:   "/root/supportbundle",
+		},
+		{
+			name:           "s3",
+			url:            "s3://bundles/antrea?region=us-west-2&endpoint=https://minio.example.com:9000",
+			expectedScheme: "s3",
+			expectedHost:   "bundles",
+			expectedPath:   "/antrea",
+		},
+		{
+			name:           "https",
+			url:            "https://api.example.com:8443/v1/supportbundles",
+			expectedScheme: "https",
+			expectedHost:   "api.example.com:8443",
+			expectedPath:   "/v1/supportbundles",
+		},
+		{
+			name:        "http",
+			url:         "http://api.example.com/v1/supportbundles",
+			expectedErr: `unsupported scheme "http"`,
+		},
+		{
+			name:        "s3 without bucket",
+			url:         "s3:///antrea",
+			expectedErr: "bucket must be set",
+		},
+		{
+			name:        "s3 with invalid endpoint",
+			url:         "s3://bundles?endpoint=minio",
+			expectedErr: "invalid S3 endpoint",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			parsedURL, err := ParseFileServerURL(tc.url)
+			if tc.expectedErr != "" {
+				assert.ErrorContains(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedScheme, parsedURL.Scheme)
+			assert.Equal(t, tc.expectedHost, parsedURL.Host)
+			assert.Equal(t, tc.expectedPath, parsedURL.Path)
+		})
+	}
+}
@@ -0,0 +1,98 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package support
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// Item is a category of information which can be collected in a support bundle.
+type Item string
+
+const (
+	ItemLogs            Item = "logs"
+	ItemInfo            Item = "info"
+	ItemNetworkPolicies Item = "networkpolicies"
+	ItemFlows           Item = "flows"
+	ItemOVSPorts        Item = "ovsports"
+	ItemHostNetwork     Item = "hostnetwork"
+	ItemMemberlist      Item = "memberlist"
+	ItemPprof           Item = "pprof"
+)
+
+const (
+	// ProfileAll collects all the information. It is used when no profile is specified.
+	ProfileAll = "all"
+	// ProfilePolicyOnly collects the NetworkPolicy resources and the component info.
+	ProfilePolicyOnly = "policy-only"
+	// ProfileDatapath collects the information about the datapath: logs, OVS flows and ports,
+	// host network configuration and the Memberlist state.
+	ProfileDatapath = "datapath"
+	// ProfilePerf collects the heap and goroutine profiles.
+	ProfilePerf = "perf"
+)
+
+// profileItems maps each profile to the items it collects. ProfileAll is not included as it
+// collects everything.
+var profileItems = map[string]sets.Set[Item]{
+	ProfilePolicyOnly: sets.New[Item](ItemInfo, ItemNetworkPolicies),
+	ProfileDatapath:   sets.New[Item](ItemInfo, ItemLogs, ItemFlows, ItemOVSPorts, ItemHostNetwork, ItemMemberlist),
+	ProfilePerf:       sets.New[Item](ItemInfo, ItemPprof),
+}
+
+// Profiles returns the names of all the supported profiles.
+func Profiles() []string {
+	profiles := []string{ProfileAll}
+	for p := range profileItems {
+		profiles = append(profiles, p)
+	}
+	sort.Strings(profiles[1:])
+	return profiles
+}
+
+// ValidateProfile returns an error if the provided profile is not supported. An empty profile
+// is valid and equivalent to ProfileAll.
+func ValidateProfile(profile string) error {
+	if profile == "" || profile == ProfileAll {
+		return nil
+	}
+	if _, ok := profileItems[profile]; !ok {
+		return fmt.Errorf("unsupported profile %q, supported profiles are: %s", profile, strings.Join(Profiles(), ", "))
+	}
+	return nil
+}
+
+// ItemDump associates a dump function with the item it collects.
+type ItemDump struct {
+	Item Item
+	Dump func(basedir string) error
+}
+
+// SelectDumps returns the dump functions which should be run for the provided profile. The
+// profile must have been validated with ValidateProfile.
+func SelectDumps(profile string, dumps ...ItemDump) []func(basedir string) error {
+	items, ok := profileItems[profile]
+	var selected []func(basedir string) error
+	for _, d := range dumps {
+		if !ok || items.Has(d.Item) {
+			selected = append(selected, d.Dump)
+		}
+	}
+	return selected
+}
@@ -0,0 +1,82 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package support
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateProfile(t *testing.T) {
+	for _, p := range []string{"", ProfileAll, ProfilePolicyOnly, ProfileDatapath, ProfilePerf} {
+		assert.NoError(t, ValidateProfile(p))
+	}
+	assert.EqualError(t, ValidateProfile("foo"), `unsupported profile "foo", supported profiles are: all, datapath, perf, policy-only`)
+}
+
+func TestSelectDumps(t *testing.T) {
+	var dumped []Item
+	dumpFn := func(item Item) ItemDump {
+		return ItemDump{Item: item, Dump: func(string) error {
+			dumped = append(dumped, item)
+			return nil
+		}}
+	}
+	allDumps := []ItemDump{
+		dumpFn(ItemLogs),
+		dumpFn(ItemInfo),
+		dumpFn(ItemNetworkPolicies),
+		dumpFn(ItemFlows),
+		dumpFn(ItemOVSPorts),
+		dumpFn(ItemHostNetwork),
+		dumpFn(ItemMemberlist),
+		dumpFn(ItemPprof),
+	}
+	tests := []struct {
+		profile  string
+		expected []Item
+	}{
+		{
+			profile:  "",
+			expected: []Item{ItemLogs, ItemInfo, ItemNetworkPolicies, ItemFlows, ItemOVSPorts, ItemHostNetwork, ItemMemberlist, ItemPprof},
+		},
+		{
+			profile:  ProfileAll,
+			expected: []Item{ItemLogs, ItemInfo, ItemNetworkPolicies, ItemFlows, ItemOVSPorts, ItemHostNetwork, ItemMemberlist, ItemPprof},
+		},
+		{
+			profile:  ProfilePolicyOnly,
+			expected: []Item{ItemInfo, ItemNetworkPolicies},
+		},
+		{
+			profile:  ProfileDatapath,
+			expected: []Item{ItemLogs, ItemInfo, ItemFlows, ItemOVSPorts, ItemHostNetwork, ItemMemberlist},
+		},
+		{
+			profile:  ProfilePerf,
+			expected: []Item{ItemInfo, ItemPprof},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.profile, func(t *testing.T) {
+			dumped = nil
+			for _, dump := range SelectDumps(tt.profile, allDumps...) {
+				assert.NoError(t, dump(""))
+			}
+			assert.Equal(t, tt.expected, dumped)
+		})
+	}
+}
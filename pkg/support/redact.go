@@ -0,0 +1,280 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package support
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"regexp"
+
+	"github.com/spf13/afero"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// pseudonymLength is the number of hex characters of the HMAC used in pseudonyms.
+	pseudonymLength = 12
+	// binarySniffLength is the number of leading bytes inspected to decide whether a file is
+	// binary. It is the same heuristic as the one used by git.
+	binarySniffLength = 8000
+)
+
+var (
+	ipv4Candidate = regexp.MustCompile(`\b(?:\d{1,3}\.){3}\d{1,3}\b`)
+	// ipv6Candidate matches any sequence which may be an IPv6 address, including IPv4-mapped
+	// addresses. Matches are validated with net.ParseIP.
+	ipv6Candidate = regexp.MustCompile(`[0-9A-Fa-f.]*:[0-9A-Fa-f.]*:[0-9A-Fa-f:.]*`)
+	// netmaskContext matches the text preceding an IPv4 netmask, e.g. "netmask 255.255.255.0",
+	// "Mask:255.255.255.0" or "10.0.0.0/255.0.0.0".
+	netmaskContext = regexp.MustCompile(`(?i)(?:mask[ \t]*[:=]?[ \t]*|[0-9]/)$`)
+	gzipMagic      = []byte{0x1f, 0x8b}
+)
+
+// Redactor replaces sensitive information with pseudonyms. A pseudonym is derived from the
+// original value and the seed of the Redactor, so the same value is always replaced with the
+// same pseudonym, which preserves the ability to correlate information across files.
+// A nil *Redactor is valid and does not redact anything.
+type Redactor struct {
+	patterns     []*regexp.Regexp
+	anonymizeIPs bool
+	seed         []byte
+}
+
+// ValidatePatterns returns an error if any of the provided patterns is not a valid regular
+// expression.
+func ValidatePatterns(patterns []string) error {
+	for _, p := range patterns {
+		if p == "" {
+			return fmt.Errorf("redaction pattern must not be empty")
+		}
+		if _, err := regexp.Compile(p); err != nil {
+			return fmt.Errorf("invalid redaction pattern %q: %w", p, err)
+		}
+	}
+	return nil
+}
+
+// NewRedactionSeed returns a random seed for Redactors. The seed must be kept secret, as anyone
+// knowing it can reverse the pseudonyms of values with few possibilities, e.g. IP addresses, by
+// brute force.
+func NewRedactionSeed() (string, error) {
+	seed := make([]byte, 16)
+	if _, err := rand.Read(seed); err != nil {
+		return "", fmt.Errorf("error when generating redaction seed: %w", err)
+	}
+	return hex.EncodeToString(seed), nil
+}
+
+// NewRedactor returns a Redactor for the provided patterns. It returns nil if there is nothing
+// to redact.
+func NewRedactor(patterns []string, anonymizeIPs bool, seed string) (*Redactor, error) {
+	if len(patterns) == 0 && !anonymizeIPs {
+		return nil, nil
+	}
+	if err := ValidatePatterns(patterns); err != nil {
+		return nil, err
+	}
+	r := &Redactor{
+		anonymizeIPs: anonymizeIPs,
+		seed:         []byte(seed),
+	}
+	for _, p := range patterns {
+		r.patterns = append(r.patterns, regexp.MustCompile(p))
+	}
+	return r, nil
+}
+
+func (r *Redactor) pseudonym(prefix string, value []byte) []byte {
+	mac := hmac.New(sha256.New, r.seed)
+	mac.Write(value)
+	return []byte(prefix + hex.EncodeToString(mac.Sum(nil))[:pseudonymLength])
+}
+
+// keepIP returns true for the addresses which do not identify anything and are kept as is.
+func keepIP(ip net.IP) bool {
+	return ip.IsLoopback() || ip.IsUnspecified()
+}
+
+// isNetmask returns true if the IPv4 address at data[start:] is a netmask, i.e. it is a valid
+// mask value with a leading 255 octet, and it follows a netmask keyword or a slash. An address
+// which is only a valid mask value, e.g. 192.0.0.0, may identify a host.
+func isNetmask(ip net.IP, data []byte, start int) bool {
+	ip4 := ip.To4()
+	if ip4 == nil || ip4[0] != 255 {
+		return false
+	}
+	if _, bits := net.IPMask(ip4).Size(); bits == 0 {
+		return false
+	}
+	return netmaskContext.Match(data[max(0, start-16):start])
+}
+
+func (r *Redactor) redactIPv4(data []byte) []byte {
+	var out bytes.Buffer
+	last := 0
+	for _, loc := range ipv4Candidate.FindAllIndex(data, -1) {
+		start, end := loc[0], loc[1]
+		ip := net.ParseIP(string(data[start:end]))
+		if ip == nil || keepIP(ip) || isNetmask(ip, data, start) {
+			continue
+		}
+		out.Write(data[last:start])
+		out.Write(r.pseudonym("ipv4-", data[start:end]))
+		last = end
+	}
+	if last == 0 {
+		return data
+	}
+	out.Write(data[last:])
+	return out.Bytes()
+}
+
+func isWordByte(b byte) bool {
+	return b == '_' || b >= '0' && b <= '9' || b >= 'a' && b <= 'z' || b >= 'A' && b <= 'Z'
+}
+
+func (r *Redactor) redactIPv6(data []byte) []byte {
+	var out bytes.Buffer
+	last := 0
+	for _, loc := range ipv6Candidate.FindAllIndex(data, -1) {
+		start, end := loc[0], loc[1]
+		// Trailing dots and colons are punctuation rather than part of the address.
+		for end > start && (data[end-1] == '.' || data[end-1] == ':' && (end-start < 2 || data[end-2] != ':')) {
+			end--
+		}
+		// Skip candidates which are part of a longer word, e.g. a hostname.
+		if start > 0 && isWordByte(data[start-1]) || end < len(data) && isWordByte(data[end]) {
+			continue
+		}
+		ip := net.ParseIP(string(data[start:end]))
+		if ip == nil || keepIP(ip) {
+			continue
+		}
+		out.Write(data[last:start])
+		out.Write(r.pseudonym("ipv6-", data[start:end]))
+		last = end
+	}
+	if last == 0 {
+		return data
+	}
+	out.Write(data[last:])
+	return out.Bytes()
+}
+
+// Redact returns data with all the matches of the patterns and, if enabled, all the IP addresses
+// replaced with pseudonyms. Patterns are applied before IP anonymization.
+func (r *Redactor) Redact(data []byte) []byte {
+	if r == nil {
+		return data
+	}
+	for _, p := range r.patterns {
+		data = p.ReplaceAllFunc(data, func(match []byte) []byte {
+			return r.pseudonym("redacted-", match)
+		})
+	}
+	if r.anonymizeIPs {
+		// IPv6 goes first so that the IPv4 part of IPv4-mapped addresses is not replaced on
+		// its own.
+		data = r.redactIPv6(data)
+		data = r.redactIPv4(data)
+	}
+	return data
+}
+
+func isBinary(data []byte) bool {
+	if len(data) > binarySniffLength {
+		data = data[:binarySniffLength]
+	}
+	return bytes.IndexByte(data, 0) != -1
+}
+
+// redactFile returns the redacted content of a file, and false if the file can't be redacted.
+// Gzip-compressed text files, e.g. rotated logs, are decompressed, redacted and compressed
+// again.
+func (r *Redactor) redactFile(data []byte) ([]byte, bool, error) {
+	if !bytes.HasPrefix(data, gzipMagic) {
+		if isBinary(data) {
+			return nil, false, nil
+		}
+		return r.Redact(data), true, nil
+	}
+	gr, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, false, nil
+	}
+	decompressed, err := io.ReadAll(gr)
+	if err != nil || isBinary(decompressed) {
+		return nil, false, nil
+	}
+	redacted := r.Redact(decompressed)
+	if bytes.Equal(redacted, decompressed) {
+		return data, true, nil
+	}
+	var buf bytes.Buffer
+	gw := gzip.NewWriter(&buf)
+	if _, err := gw.Write(redacted); err != nil {
+		return nil, false, err
+	}
+	if err := gw.Close(); err != nil {
+		return nil, false, err
+	}
+	return buf.Bytes(), true, nil
+}
+
+// RedactDir redacts all the files under dir in place. Binary files, e.g. pprof profiles, can't
+// be redacted and are removed, so that no sensitive information is left in the directory.
+func (r *Redactor) RedactDir(fs afero.Fs, dir string) error {
+	if r == nil {
+		return nil
+	}
+	return afero.Walk(fs, dir, func(filePath string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+		data, err := afero.ReadFile(fs, filePath)
+		if err != nil {
+			return fmt.Errorf("error when reading file %s: %w", filePath, err)
+		}
+		redacted, ok, err := r.redactFile(data)
+		if err != nil {
+			return fmt.Errorf("error when redacting file %s: %w", filePath, err)
+		}
+		if !ok {
+			klog.InfoS("Removing file which cannot be redacted", "file", filePath)
+			if err := fs.Remove(filePath); err != nil {
+				return fmt.Errorf("error when removing file %s: %w", filePath, err)
+			}
+			return nil
+		}
+		if bytes.Equal(redacted, data) {
+			return nil
+		}
+		if err := afero.WriteFile(fs, filePath, redacted, info.Mode().Perm()); err != nil {
+			return fmt.Errorf("error when writing redacted file %s: %w", filePath, err)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,166 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package support
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRedactor(t *testing.T) {
+	r, err := NewRedactor(nil, false, "seed")
+	require.NoError(t, err)
+	assert.Nil(t, r)
+	assert.Equal(t, []byte("10.10.0.1"), r.Redact([]byte("10.10.0.1")))
+
+	_, err = NewRedactor([]string{"foo("}, false, "seed")
+	assert.ErrorContains(t, err, "invalid redaction pattern")
+	_, err = NewRedactor([]string{""}, false, "seed")
+	assert.ErrorContains(t, err, "must not be empty")
+}
+
+func TestRedact(t *testing.T) {
+	tests := []struct {
+		name         string
+		patterns     []string
+		anonymizeIPs bool
+		input        string
+		// kept are the substrings which must still be present after redaction.
+		kept []string
+		// redacted maps the substrings which must have been replaced to the prefix of their
+		// pseudonyms.
+		redacted map[string]string
+	}{
+		{
+			name:     "patterns",
+			patterns: []string{`customer-[a-z0-9]+`, `secret-[0-9]+`},
+			input:    "Pod customer-abc on Node customer-xyz uses secret-42 and 10.10.0.1",
+			kept:     []string{"Pod ", " on Node ", " uses ", "10.10.0.1"},
+			redacted: map[string]string{"customer-abc": "redacted-", "customer-xyz": "redacted-", "secret-42": "redacted-"},
+		},
+		{
+			name:         "IPv4",
+			anonymizeIPs: true,
+			input:        "nw_src=10.10.0.1,nw_dst=192.168.1.20 mask 255.255.255.0 lo 127.0.0.1 any 0.0.0.0 version 1.2.3.456",
+			kept:         []string{"nw_src=", ",nw_dst=", "255.255.255.0", "127.0.0.1", "0.0.0.0", "1.2.3.456"},
+			redacted:     map[string]string{"10.10.0.1": "ipv4-", "192.168.1.20": "ipv4-"},
+		},
+		{
+			name:         "IPv4 netmasks",
+			anonymizeIPs: true,
+			input:        "inet 10.0.0.0/255.0.0.0 Mask:255.255.0.0 host 192.0.0.0 dst 255.255.255.128",
+			kept:         []string{"inet ", "/255.0.0.0 Mask:255.255.0.0 host ", " dst "},
+			redacted:     map[string]string{"10.0.0.0": "ipv4-", "192.0.0.0": "ipv4-", "255.255.255.128": "ipv4-"},
+		},
+		{
+			name:         "IPv6",
+			anonymizeIPs: true,
+			input:        "ipv6_src=fd00:10:244::2 gw fe80::1. mapped ::ffff:10.1.2.3 lo ::1 mac aa:bb:cc:dd:ee:ff at 12:30:45",
+			kept:         []string{"ipv6_src=", " gw ", ". mapped ", "::1 mac", "aa:bb:cc:dd:ee:ff", "12:30:45"},
+			redacted:     map[string]string{"fd00:10:244::2": "ipv6-", "fe80::1": "ipv6-", "::ffff:10.1.2.3": "ipv6-"},
+		},
+		{
+			name:         "patterns before IPs",
+			patterns:     []string{`host-10\.0\.0\.1`},
+			anonymizeIPs: true,
+			input:        "host-10.0.0.1 10.0.0.1",
+			redacted:     map[string]string{"host-10.0.0.1": "redacted-", "10.0.0.1": "ipv4-"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := NewRedactor(tt.patterns, tt.anonymizeIPs, "seed")
+			require.NoError(t, err)
+			output := string(r.Redact([]byte(tt.input)))
+			for _, s := range tt.kept {
+				assert.Contains(t, output, s)
+			}
+			for s, prefix := range tt.redacted {
+				assert.NotContains(t, output, s)
+				assert.Contains(t, output, string(r.pseudonym(prefix, []byte(s))))
+			}
+		})
+	}
+}
+
+func TestRedactConsistentPseudonyms(t *testing.T) {
+	r1, err := NewRedactor([]string{`node-[0-9]+`}, true, "seed1")
+	require.NoError(t, err)
+	r2, err := NewRedactor([]string{`node-[0-9]+`}, true, "seed2")
+	require.NoError(t, err)
+
+	input := []byte("node-1 10.0.0.1")
+	output := r1.Redact(input)
+	assert.Equal(t, output, r1.Redact(input), "the same seed should always generate the same pseudonyms")
+	assert.Equal(t, "redacted-"+string(r1.pseudonym("", []byte("node-1")))+" ipv4-"+string(r1.pseudonym("", []byte("10.0.0.1"))), string(output))
+	assert.NotEqual(t, output, r2.Redact(input), "different seeds should generate different pseudonyms")
+	assert.NotEqual(t, r1.Redact([]byte("10.0.0.1")), r1.Redact([]byte("10.0.0.2")))
+}
+
+func gzipData(t *testing.T, data []byte) []byte {
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	_, err := w.Write(data)
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	return buf.Bytes()
+}
+
+func gunzipData(t *testing.T, data []byte) []byte {
+	r, err := gzip.NewReader(bytes.NewReader(data))
+	require.NoError(t, err)
+	decompressed, err := io.ReadAll(r)
+	require.NoError(t, err)
+	return decompressed
+}
+
+func TestRedactDir(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	textFile := filepath.Join(baseDir, "logs", "agent.log")
+	gzipFile := filepath.Join(baseDir, "logs", "ovs-vswitchd.log.1.gz")
+	binaryFile := filepath.Join(baseDir, "heap")
+	gzipBinaryFile := filepath.Join(baseDir, "profile")
+	require.NoError(t, afero.WriteFile(fs, textFile, []byte("Pod 10.0.0.1 created"), 0644))
+	require.NoError(t, afero.WriteFile(fs, gzipFile, gzipData(t, []byte("Port 10.0.0.1 added")), 0600))
+	require.NoError(t, afero.WriteFile(fs, binaryFile, []byte("10.0.0.1\x00\x01"), 0600))
+	require.NoError(t, afero.WriteFile(fs, gzipBinaryFile, gzipData(t, []byte("10.0.0.1\x00\x01")), 0600))
+
+	r, err := NewRedactor(nil, true, "seed")
+	require.NoError(t, err)
+	require.NoError(t, r.RedactDir(fs, baseDir))
+
+	pseudonym := "ipv4-" + string(r.pseudonym("", []byte("10.0.0.1")))
+	data, err := afero.ReadFile(fs, textFile)
+	require.NoError(t, err)
+	assert.Equal(t, "Pod "+pseudonym+" created", string(data))
+	data, err = afero.ReadFile(fs, gzipFile)
+	require.NoError(t, err)
+	assert.Equal(t, "Port "+pseudonym+" added", string(gunzipData(t, data)))
+	info, err := fs.Stat(gzipFile)
+	require.NoError(t, err)
+	assert.Equal(t, "-rw-------", info.Mode().String())
+	for _, f := range []string{binaryFile, gzipBinaryFile} {
+		exists, err := afero.Exists(fs, f)
+		require.NoError(t, err)
+		assert.False(t, exists, "Binary file %s should be removed", f)
+	}
+}
@@ -20,6 +20,8 @@ import (
 	"fmt"
 	"io"
 
+	"k8s.io/apimachinery/pkg/util/sets"
+
 	"antrea.io/antrea/pkg/apis/crd/v1alpha1"
 	"antrea.io/antrea/pkg/apis/crd/v1alpha2"
 	"antrea.io/antrea/pkg/util/k8s"
@@ -31,22 +33,56 @@ const (
 	interfaceNameLength = 5
 )
 
-func GenExternalEntityName(externalNode *v1alpha1.ExternalNode) (string, error) {
+// GenExternalEntityName generates the name of the ExternalEntity for the network interface
+// with the provided name on the ExternalNode. The name of the ExternalNode is used as is if
+// the interface name is empty.
+func GenExternalEntityName(externalNodeName string, ifName string) string {
+	if ifName == "" {
+		return externalNodeName
+	}
+	hash := sha1.New() // #nosec G401: not used for security purposes
+	io.WriteString(hash, ifName)
+	hashedIfName := hex.EncodeToString(hash.Sum(nil))
+	return externalNodeName + "-" + hashedIfName[:interfaceNameLength]
+}
+
+// GenExternalEntityLabels generates the labels of the ExternalEntity for the network interface
+// on the ExternalNode. Labels of the interface take precedence over labels of the ExternalNode.
+func GenExternalEntityLabels(externalNode *v1alpha1.ExternalNode, iface *v1alpha1.NetworkInterface) map[string]string {
+	if len(iface.Labels) == 0 {
+		return externalNode.Labels
+	}
+	labels := make(map[string]string, len(externalNode.Labels)+len(iface.Labels))
+	for k, v := range externalNode.Labels {
+		labels[k] = v
+	}
+	for k, v := range iface.Labels {
+		labels[k] = v
+	}
+	return labels
+}
+
+// ValidateInterfaces checks that an ExternalEntity name can be generated for each network
+// interface of the ExternalNode, and that the generated names are unique.
+func ValidateInterfaces(externalNode *v1alpha1.ExternalNode) error {
 	if len(externalNode.Spec.Interfaces) == 0 {
 		// This should not happen since openAPIV3Schema checks it.
-		return "", fmt.Errorf("failed to get interface from ExternalNode %s", externalNode.Name)
+		return fmt.Errorf("failed to get interface from ExternalNode %s", externalNode.Name)
 	}
-	// Only one network interface is supported now.
-	// Other interfaces except interfaces[0] will be ignored if there are more than one interfaces.
-	ifName := externalNode.Spec.Interfaces[0].Name
-	if ifName == "" {
-		return externalNode.Name, nil
-	} else {
-		hash := sha1.New() // #nosec G401: not used for security purposes
-		io.WriteString(hash, ifName)
-		hashedIfName := hex.EncodeToString(hash.Sum(nil))
-		return externalNode.Name + "-" + hashedIfName[:interfaceNameLength], nil
+	if len(externalNode.Spec.Interfaces) == 1 {
+		return nil
+	}
+	ifNames := sets.New[string]()
+	for _, iface := range externalNode.Spec.Interfaces {
+		if iface.Name == "" {
+			return fmt.Errorf("interface name must be specified when ExternalNode %s has multiple interfaces", externalNode.Name)
+		}
+		if ifNames.Has(iface.Name) {
+			return fmt.Errorf("duplicate interface name %s in ExternalNode %s", iface.Name, externalNode.Name)
+		}
+		ifNames.Insert(iface.Name)
 	}
+	return nil
 }
 
 func GenerateEntityNodeKey(externalEntity *v1alpha2.ExternalEntity) string {
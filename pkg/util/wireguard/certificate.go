@@ -0,0 +1,136 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package wireguard provides helpers to certify WireGuard public keys of Nodes.
+//
+// A WireGuard public key is a Curve25519 key which cannot be used to sign a
+// CertificateSigningRequest, nor be embedded in a X.509 certificate as its
+// subject public key. Instead, the public key is carried in a URI
+// subjectAltName of the form "urn:antrea:wireguard:<hex-encoded key>", and the
+// CertificateSigningRequest is signed with an ephemeral ECDSA key. A
+// certificate issued by the Antrea WireGuard CA for such a request vouches
+// that the public key belongs to the Node named by the subject common name.
+package wireguard
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"golang.zx2c4.com/wireguard/wgctrl/wgtypes"
+	certutil "k8s.io/client-go/util/cert"
+
+	antreaapis "antrea.io/antrea/pkg/apis"
+)
+
+const publicKeyURIPrefix = "urn:antrea:wireguard:"
+
+// PublicKeyURI returns the URI subjectAltName binding a WireGuard public key
+// (as encoded in the Node annotation) to a certificate.
+func PublicKeyURI(publicKey string) (*url.URL, error) {
+	key, err := wgtypes.ParseKey(publicKey)
+	if err != nil {
+		return nil, err
+	}
+	return url.Parse(publicKeyURIPrefix + hex.EncodeToString(key[:]))
+}
+
+// PublicKeyFromURIs returns the WireGuard public key carried by the provided
+// URI subjectAltNames. Exactly one of them must be a WireGuard public key URI.
+func PublicKeyFromURIs(uris []*url.URL) (string, error) {
+	if len(uris) != 1 {
+		return "", fmt.Errorf("expected exactly one URI subjectAltName, got %d", len(uris))
+	}
+	uri := uris[0].String()
+	if !strings.HasPrefix(uri, publicKeyURIPrefix) {
+		return "", fmt.Errorf("URI subjectAltName %s is not a WireGuard public key", uri)
+	}
+	keyBytes, err := hex.DecodeString(strings.TrimPrefix(uri, publicKeyURIPrefix))
+	if err != nil {
+		return "", fmt.Errorf("invalid WireGuard public key in URI subjectAltName %s: %v", uri, err)
+	}
+	key, err := wgtypes.NewKey(keyBytes)
+	if err != nil {
+		return "", err
+	}
+	return key.String(), nil
+}
+
+// NewPublicKeyCertificateRequest returns a PEM-encoded CertificateSigningRequest
+// for the WireGuard public key of the provided Node.
+func NewPublicKeyCertificateRequest(nodeName, publicKey string) ([]byte, error) {
+	uri, err := PublicKeyURI(publicKey)
+	if err != nil {
+		return nil, err
+	}
+	// The private key is only used to sign the request and is discarded afterwards.
+	signer, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	template := &x509.CertificateRequest{
+		Subject: pkix.Name{
+			CommonName:   nodeName,
+			Organization: []string{antreaapis.AntreaOrganizationName},
+		},
+		URIs: []*url.URL{uri},
+	}
+	der, err := x509.CreateCertificateRequest(rand.Reader, template, signer)
+	if err != nil {
+		return nil, err
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: certutil.CertificateRequestBlockType, Bytes: der}), nil
+}
+
+// VerifyPublicKeyCertificate verifies that the PEM-encoded certificate is
+// issued by one of the roots, is valid at the provided time, and certifies
+// publicKey as the WireGuard public key of the provided Node.
+func VerifyPublicKeyCertificate(certificatePEM []byte, roots *x509.CertPool, nodeName, publicKey string, now time.Time) error {
+	certs, err := certutil.ParseCertsPEM(certificatePEM)
+	if err != nil {
+		return err
+	}
+	cert := certs[0]
+	intermediates := x509.NewCertPool()
+	for _, c := range certs[1:] {
+		intermediates.AddCert(c)
+	}
+	if _, err := cert.Verify(x509.VerifyOptions{
+		Roots:         roots,
+		Intermediates: intermediates,
+		CurrentTime:   now,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+	}); err != nil {
+		return err
+	}
+	if cert.Subject.CommonName != nodeName {
+		return fmt.Errorf("certificate is issued for Node %s, not %s", cert.Subject.CommonName, nodeName)
+	}
+	certifiedKey, err := PublicKeyFromURIs(cert.URIs)
+	if err != nil {
+		return err
+	}
+	if certifiedKey != publicKey {
+		return fmt.Errorf("certificate does not certify public key %s", publicKey)
+	}
+	return nil
+}
@@ -0,0 +1,163 @@
+// Copyright 2024 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package wireguard
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.zx2c4.com/wireguard/wgctrl/wgtypes"
+	certutil "k8s.io/client-go/util/cert"
+)
+
+func newTestPublicKey(t *testing.T) string {
+	privateKey, err := wgtypes.GeneratePrivateKey()
+	require.NoError(t, err)
+	return privateKey.PublicKey().String()
+}
+
+func TestPublicKeyURI(t *testing.T) {
+	publicKey := newTestPublicKey(t)
+	uri, err := PublicKeyURI(publicKey)
+	require.NoError(t, err)
+	key, err := PublicKeyFromURIs([]*url.URL{uri})
+	require.NoError(t, err)
+	assert.Equal(t, publicKey, key)
+
+	_, err = PublicKeyURI("invalid")
+	assert.Error(t, err)
+}
+
+// signRequest signs the PEM-encoded CertificateSigningRequest with the
+// provided CA, the same way the Antrea Controller does.
+func signRequest(t *testing.T, csrPEM []byte, ca *x509.Certificate, caKey *ecdsa.PrivateKey, notBefore, notAfter time.Time) []byte {
+	block, _ := pem.Decode(csrPEM)
+	require.NotNil(t, block)
+	req, err := x509.ParseCertificateRequest(block.Bytes)
+	require.NoError(t, err)
+	require.NoError(t, req.CheckSignature())
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      req.Subject,
+		URIs:         req.URIs,
+		NotBefore:    notBefore,
+		NotAfter:     notAfter,
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca, req.PublicKey, caKey)
+	require.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: certutil.CertificateBlockType, Bytes: der})
+}
+
+func newTestCA(t *testing.T, now time.Time) (*x509.Certificate, *ecdsa.PrivateKey) {
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "antrea-wireguard-ca"},
+		NotBefore:             now.Add(-time.Hour),
+		NotAfter:              now.Add(24 * time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &caKey.PublicKey, caKey)
+	require.NoError(t, err)
+	ca, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return ca, caKey
+}
+
+func TestVerifyPublicKeyCertificate(t *testing.T) {
+	now := time.Now()
+	ca, caKey := newTestCA(t, now)
+	otherCA, otherCAKey := newTestCA(t, now)
+	roots := x509.NewCertPool()
+	roots.AddCert(ca)
+
+	publicKey := newTestPublicKey(t)
+	csrPEM, err := NewPublicKeyCertificateRequest("node-1", publicKey)
+	require.NoError(t, err)
+	certPEM := signRequest(t, csrPEM, ca, caKey, now.Add(-time.Minute), now.Add(time.Hour))
+
+	tests := []struct {
+		name        string
+		certPEM     []byte
+		nodeName    string
+		publicKey   string
+		now         time.Time
+		expectedErr string
+	}{
+		{
+			name:      "valid certificate",
+			certPEM:   certPEM,
+			nodeName:  "node-1",
+			publicKey: publicKey,
+			now:       now,
+		},
+		{
+			name:        "different Node",
+			certPEM:     certPEM,
+			nodeName:    "node-2",
+			publicKey:   publicKey,
+			now:         now,
+			expectedErr: "certificate is issued for Node node-1, not node-2",
+		},
+		{
+			name:        "different public key",
+			certPEM:     certPEM,
+			nodeName:    "node-1",
+			publicKey:   newTestPublicKey(t),
+			now:         now,
+			expectedErr: "certificate does not certify public key",
+		},
+		{
+			name:        "expired certificate",
+			certPEM:     certPEM,
+			nodeName:    "node-1",
+			publicKey:   publicKey,
+			now:         now.Add(2 * time.Hour),
+			expectedErr: "certificate has expired or is not yet valid",
+		},
+		{
+			name:        "unknown CA",
+			certPEM:     signRequest(t, csrPEM, otherCA, otherCAKey, now.Add(-time.Minute), now.Add(time.Hour)),
+			nodeName:    "node-1",
+			publicKey:   publicKey,
+			now:         now,
+			expectedErr: "certificate signed by unknown authority",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := VerifyPublicKeyCertificate(tt.certPEM, roots, tt.nodeName, tt.publicKey, tt.now)
+			if tt.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.expectedErr)
+			}
+		})
+	}
+}
@@ -2256,7 +2256,7 @@ func testTraceflowValidation(t *testing.T, data *TestData) {
 			spec: v1beta1.TraceflowSpec{
 				LiveTraffic: true,
 			},
-			deniedReason: "Traceflow {{name}} has neither source nor destination Pod specified",
+			deniedReason: "Traceflow {{name}} has neither source nor destination Pod or ExternalEntity specified",
 		},
 		{
 			name: "Assigned source pod must exist",
@@ -254,8 +254,7 @@ func setupVMAgentTest(t *testing.T, data *TestData) ([]vmInfo, error) {
 		t.Logf("Creating ExternalNode for VM: %s", vm.nodeName)
 		en, err := createExternalNodeCRD(data, vm.nodeName, vm.ifName, vm.ip)
 		require.NoError(t, err, "Failed to create ExternalNode")
-		vmList[i].eeName, err = externalnode.GenExternalEntityName(en)
-		require.NoError(t, err, "Failed to generate ExternalEntity Name for ExternalNode %s", en.Name)
+		vmList[i].eeName = externalnode.GenExternalEntityName(en.Name, en.Spec.Interfaces[0].Name)
 		startAntreaAgent(t, data, vm)
 	}
 	return vmList, nil